@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package AcrossSendFundsAndExecuteOnDstHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// AcrossSendFundsAndExecuteOnDstHookMetaData contains all meta data concerning the AcrossSendFundsAndExecuteOnDstHook contract.
+var AcrossSendFundsAndExecuteOnDstHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"spokePoolV3_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validator_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SPOKE_POOL_V3\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DATA_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60e060405234801561000f575f5ffd5b50604051611dab380380611dab83398101604081905261002e916100e6565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b038216158061009657506001600160a01b038116155b156100b457604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b0391821660a0521660c052610117565b80516001600160a01b03811681146100e1575f5ffd5b919050565b5f5f604083850312156100f7575f5ffd5b610100836100cb565b915061010e602084016100cb565b90509250929050565b60805160a05160c051611c556101565f395f610ef701525f81816101ae01528181610dce015261101801525f8181610204015261027a0152611c555ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c80635492e6771161009e5780638e1487761161006e5780638e14877614610263578063984d6e7a14610275578063d06a1e891461029c578063e445e7dd146102af578063e7745517146102c8575f5ffd5b80635492e67714610202578063685a943c1461022857806381cbe691146102305780638c4313c114610243575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d14610196578063352521b1146101a957806338d52e0f146101d05780633b5896bc146101e2575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f3660046114f6565b6102eb565b005b610144610154366004611556565b610359565b610144610167366004611571565b61037a565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a43660046114f6565b6103d3565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b61017e6001600160a01b0360025c1681565b6101f56101f03660046114f6565b61043a565b60405161012891906115cd565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61011e61023e366004611556565b610652565b61025661025136600461165a565b61066a565b6040516101289190611698565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b6101446102aa366004611556565b6107c4565b5f546102bb9060ff1681565b60405161012891906116aa565b6102db6102d636600461173a565b610841565b6040519015158152602001610128565b336001600160a01b038416146103145760405163e15e56c960e01b815260040160405180910390fd5b5f61031e8461084d565b905061032981610860565b156103475760405163945b63f560e01b815260040160405180910390fd5b61035281600161086d565b5050505050565b61036281610883565b50336004805c6001600160a01b0319168217905d5050565b5f6103848261084d565b905061038f816108b5565b8061039e575061039e81610860565b156103bc5760405163441e4c7360e11b815260040160405180910390fd5b5f6103c88260016108be565b905083815d50505050565b336001600160a01b038416146103fc5760405163e15e56c960e01b815260040160405180910390fd5b5f6104068461084d565b9050610411816108b5565b1561042f57604051630bbb04d960e11b815260040160405180910390fd5b610352816001610913565b60605f6104498686868661091f565b90508051600261045991906117c7565b6001600160401b03811115610470576104706116d0565b6040519080825280602002602001820160405280156104bc57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161048e5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161050594939291906117da565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061054757610547611822565b60209081029190910101525f5b81518110156105a85781818151811061056f5761056f611822565b60200260200101518382600161058591906117c7565b8151811061059557610595611822565b6020908102919091010152600101610554565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105ef94939291906117da565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161062e9190611836565b8151811061063e5761063e611822565b602002602001018190525050949350505050565b5f61066461065f8361084d565b6110dc565b92915050565b60606106ad83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250602092506110e9915050565b6106ee84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250603492506110e9915050565b61072f85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250604892506110e9915050565b61077086868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060bc92506110e9915050565b6040516bffffffffffffffffffffffff19606095861b8116602083015293851b8416603482015291841b8316604883015290921b16605c820152607001604051602081830303815290604052905092915050565b336001600160a01b0360045c16146107ef5760405163e15e56c960e01b815260040160405180910390fd5b5f6107f98261084d565b9050610804816108b5565b1580610816575061081481610860565b155b1561083457604051634bd439b560e11b815260040160405180910390fd5b61083d81611152565b5050565b5f6106648260d8611169565b5f5f61085883611195565b5c9392505050565b5f5f6108588360036108be565b5f6108798360036108be565b905081815d505050565b5f6003805c908261089383611849565b9190505d505f6108a283611195565b905060035c80825d505060035c92915050565b5f5f6108588360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6108798360026108be565b606060d9821015610943576040516308aec44b60e31b815260040160405180910390fd5b60408051610180810182525f8082526020820181905291810182905260608082018390526080820183905260a0820183905260c0820183905260e08201839052610100820183905261012082018390526101408201929092526101608101919091526109e384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250611201915050565b8152604080516020601f8601819004810282018101909252848152610a249186908690819084018382808284375f92019190915250602092506110e9915050565b6001600160a01b031660208083019190915260408051601f8601839004830281018301909152848152610a739186908690819084018382808284375f92019190915250603492506110e9915050565b6001600160a01b031660408083019190915280516020601f8601819004810282018101909252848152610ac29186908690819084018382808284375f92019190915250604892506110e9915050565b6001600160a01b03166060820152604080516020601f8601819004810282018101909252848152610b0f9186908690819084018382808284375f92019190915250605c9250611201915050565b6080820152604080516020601f8601819004810282018101909252848152610b539186908690819084018382808284375f92019190915250607c9250611201915050565b60a0820152604080516020601f8601819004810282018101909252848152610b979186908690819084018382808284375f92019190915250609c9250611201915050565b60c0820152604080516020601f8601819004810282018101909252848152610bdb9186908690819084018382808284375f9201919091525060bc92506110e9915050565b6001600160a01b031660e0820152604080516020601f8601819004810282018101909252848152610c289186908690819084018382808284375f9201919091525060d0925061125e915050565b63ffffffff16610100820152604080516020601f8601819004810282018101909252848152610c739186908690819084018382808284375f9201919091525060d4925061125e915050565b63ffffffff16610120820152604080516020601f8601819004810282018101909252848152610cbe9186908690819084018382808284375f9201919091525060d89250611169915050565b1515610140820152604080516020601f8601819004810282018101909252848152610d109186908690819084018382808284375f9201919091525060d99250610d0b915082905087611836565b6112ba565b61016082015261014081015115610e7b576040516381cbe69160e01b81526001600160a01b0386811660048301525f91908816906381cbe69190602401602060405180830381865afa158015610d68573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d8c9190611861565b90505f8260800151118015610da457505f8260a00151115b15610dc257610dbc8260a001518284608001516113c1565b60a08301525b808260800181815250507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166317fcb39b6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610e28573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610e4c9190611878565b6001600160a01b031682604001516001600160a01b0316148015610e705750815115155b15610e79578082525b505b80608001515f03610e9f576040516305112ab160e41b815260040160405180910390fd5b60208101516001600160a01b0316610eca57604051630f58648f60e01b815260040160405180910390fd5b6101608101515115610fc657604051630f65bac560e01b81526001600160a01b0386811660048301525f917f000000000000000000000000000000000000000000000000000000000000000090911690630f65bac5906024015f60405180830381865afa158015610f3d573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f19168201604052610f6491908101906118e0565b90505f5f5f5f5f866101600151806020019051810190610f849190611a04565b9450945094509450945084848484848a604051602001610fa996959493929190611b06565b60408051601f198184030181529190526101608801525050505050505b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610fdb57905050915060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001825f015181526020018683602001518460400151856060015186608001518760a001518860c001518960e001518a61010001518b61012001518c610160015160405160240161109e9b9a99989796959493929190611bb0565b60408051601f198184030181529190526020810180516001600160e01b0316631ebbd90b60e21b1790529052825183905f9061063e5761063e611822565b5f5f6108588360016108be565b5f6110f58260146117c7565b835110156111425760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b61115c815f610913565b611166815f61086d565b50565b5f82828151811061117c5761117c611822565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b826040516020016111e492919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f61120d8260206117c7565b835110156112555760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401611139565b50016020015190565b5f61126a8260046117c7565b835110156112b15760405162461bcd60e51b8152602060048201526014602482015273746f55696e7433325f6f75744f66426f756e647360601b6044820152606401611139565b50016004015190565b60608182601f0110156113005760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401611139565b61130a82846117c7565b8451101561134e5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401611139565b60608215801561136c5760405191505f8252602082016040526113b6565b6040519150601f8416801560200281840101858101878315602002848b0101015b818310156113a557805183526020928301920161138d565b5050858452601f01601f1916604052505b5090505b9392505050565b5f5f5f6113ce8686611471565b91509150815f036113f2578381816113e8576113e8611c34565b04925050506113ba565b81841161140957611409600385150260111861148d565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b0381168114611166575f5ffd5b5f5f83601f8401126114c2575f5ffd5b5081356001600160401b038111156114d8575f5ffd5b6020830191508360208285010111156114ef575f5ffd5b9250929050565b5f5f5f5f60608587031215611509575f5ffd5b84356115148161149e565b935060208501356115248161149e565b925060408501356001600160401b0381111561153e575f5ffd5b61154a878288016114b2565b95989497509550505050565b5f60208284031215611566575f5ffd5b81356113ba8161149e565b5f5f60408385031215611582575f5ffd5b8235915060208301356115948161149e565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561164e57868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906116389087018261159f565b95505060209384019391909101906001016115f3565b50929695505050505050565b5f5f6020838503121561166b575f5ffd5b82356001600160401b03811115611680575f5ffd5b61168c858286016114b2565b90969095509350505050565b602081525f6113ba602083018461159f565b60208101600383106116ca57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f191681016001600160401b038111828210171561170c5761170c6116d0565b604052919050565b5f6001600160401b0382111561172c5761172c6116d0565b50601f01601f191660200190565b5f6020828403121561174a575f5ffd5b81356001600160401b0381111561175f575f5ffd5b8201601f8101841361176f575f5ffd5b803561178261177d82611714565b6116e4565b818152856020838501011115611796575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b80820180821115610664576106646117b3565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b81810381811115610664576106646117b3565b5f6001820161185a5761185a6117b3565b5060010190565b5f60208284031215611871575f5ffd5b5051919050565b5f60208284031215611888575f5ffd5b81516113ba8161149e565b5f82601f8301126118a2575f5ffd5b81516118b061177d82611714565b8181528460208386010111156118c4575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f602082840312156118f0575f5ffd5b81516001600160401b03811115611905575f5ffd5b61191184828501611893565b949350505050565b5f6001600160401b03821115611931576119316116d0565b5060051b60200190565b5f82601f83011261194a575f5ffd5b815161195861177d82611919565b8082825260208201915060208360051b860101925085831115611979575f5ffd5b602085015b8381101561199f5780516119918161149e565b83526020928301920161197e565b5095945050505050565b5f82601f8301126119b8575f5ffd5b81516119c661177d82611919565b8082825260208201915060208360051b8601019250858311156119e7575f5ffd5b602085015b8381101561199f5780518352602092830192016119ec565b5f5f5f5f5f60a08688031215611a18575f5ffd5b85516001600160401b03811115611a2d575f5ffd5b611a3988828901611893565b95505060208601516001600160401b03811115611a54575f5ffd5b611a6088828901611893565b9450506040860151611a718161149e565b60608701519093506001600160401b03811115611a8c575f5ffd5b611a988882890161193b565b92505060808601516001600160401b03811115611ab3575f5ffd5b611abf888289016119a9565b9150509295509295909350565b5f8151808452602084019350602083015f5b82811015611afc578151865260209586019590910190600101611ade565b5093949350505050565b60c081525f611b1860c083018961159f565b8281036020840152611b2a818961159f565b6001600160a01b03881660408501528381036060850152865180825260208089019350909101905f5b81811015611b7a5783516001600160a01b0316835260209384019390920191600101611b53565b50508381036080850152611b8e8187611acc565b91505082810360a0840152611ba3818561159f565b9998505050505050505050565b6001600160a01b038c811682528b811660208301528a8116604083015289811660608301526080820189905260a0820188905260c08201879052851660e082015263ffffffff841661010082015263ffffffff83166101208201526101606101408201525f611c2361016083018461159f565b9d9c50505050505050505050505050565b634e487b7160e01b5f52601260045260245ffdfea164736f6c634300081e000a",
+}
+
+// AcrossSendFundsAndExecuteOnDstHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use AcrossSendFundsAndExecuteOnDstHookMetaData.ABI instead.
+var AcrossSendFundsAndExecuteOnDstHookABI = AcrossSendFundsAndExecuteOnDstHookMetaData.ABI
+
+// AcrossSendFundsAndExecuteOnDstHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use AcrossSendFundsAndExecuteOnDstHookMetaData.Bin instead.
+var AcrossSendFundsAndExecuteOnDstHookBin = AcrossSendFundsAndExecuteOnDstHookMetaData.Bin
+
+// DeployAcrossSendFundsAndExecuteOnDstHook deploys a new Ethereum contract, binding an instance of AcrossSendFundsAndExecuteOnDstHook to it.
+func DeployAcrossSendFundsAndExecuteOnDstHook(auth *bind.TransactOpts, backend bind.ContractBackend, spokePoolV3_ common.Address, validator_ common.Address) (common.Address, *types.Transaction, *AcrossSendFundsAndExecuteOnDstHook, error) {
+	parsed, err := AcrossSendFundsAndExecuteOnDstHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AcrossSendFundsAndExecuteOnDstHookBin), backend, spokePoolV3_, validator_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &AcrossSendFundsAndExecuteOnDstHook{AcrossSendFundsAndExecuteOnDstHookCaller: AcrossSendFundsAndExecuteOnDstHookCaller{contract: contract}, AcrossSendFundsAndExecuteOnDstHookTransactor: AcrossSendFundsAndExecuteOnDstHookTransactor{contract: contract}, AcrossSendFundsAndExecuteOnDstHookFilterer: AcrossSendFundsAndExecuteOnDstHookFilterer{contract: contract}}, nil
+}
+
+// AcrossSendFundsAndExecuteOnDstHook is an auto generated Go binding around an Ethereum contract.
+type AcrossSendFundsAndExecuteOnDstHook struct {
+	AcrossSendFundsAndExecuteOnDstHookCaller     // Read-only binding to the contract
+	AcrossSendFundsAndExecuteOnDstHookTransactor // Write-only binding to the contract
+	AcrossSendFundsAndExecuteOnDstHookFilterer   // Log filterer for contract events
+}
+
+// AcrossSendFundsAndExecuteOnDstHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type AcrossSendFundsAndExecuteOnDstHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AcrossSendFundsAndExecuteOnDstHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type AcrossSendFundsAndExecuteOnDstHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AcrossSendFundsAndExecuteOnDstHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type AcrossSendFundsAndExecuteOnDstHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// AcrossSendFundsAndExecuteOnDstHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type AcrossSendFundsAndExecuteOnDstHookSession struct {
+	Contract     *AcrossSendFundsAndExecuteOnDstHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// AcrossSendFundsAndExecuteOnDstHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type AcrossSendFundsAndExecuteOnDstHookCallerSession struct {
+	Contract *AcrossSendFundsAndExecuteOnDstHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                             // Call options to use throughout this session
+}
+
+// AcrossSendFundsAndExecuteOnDstHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type AcrossSendFundsAndExecuteOnDstHookTransactorSession struct {
+	Contract     *AcrossSendFundsAndExecuteOnDstHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                             // Transaction auth options to use throughout this session
+}
+
+// AcrossSendFundsAndExecuteOnDstHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type AcrossSendFundsAndExecuteOnDstHookRaw struct {
+	Contract *AcrossSendFundsAndExecuteOnDstHook // Generic contract binding to access the raw methods on
+}
+
+// AcrossSendFundsAndExecuteOnDstHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type AcrossSendFundsAndExecuteOnDstHookCallerRaw struct {
+	Contract *AcrossSendFundsAndExecuteOnDstHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// AcrossSendFundsAndExecuteOnDstHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type AcrossSendFundsAndExecuteOnDstHookTransactorRaw struct {
+	Contract *AcrossSendFundsAndExecuteOnDstHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewAcrossSendFundsAndExecuteOnDstHook creates a new instance of AcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewAcrossSendFundsAndExecuteOnDstHook(address common.Address, backend bind.ContractBackend) (*AcrossSendFundsAndExecuteOnDstHook, error) {
+	contract, err := bindAcrossSendFundsAndExecuteOnDstHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &AcrossSendFundsAndExecuteOnDstHook{AcrossSendFundsAndExecuteOnDstHookCaller: AcrossSendFundsAndExecuteOnDstHookCaller{contract: contract}, AcrossSendFundsAndExecuteOnDstHookTransactor: AcrossSendFundsAndExecuteOnDstHookTransactor{contract: contract}, AcrossSendFundsAndExecuteOnDstHookFilterer: AcrossSendFundsAndExecuteOnDstHookFilterer{contract: contract}}, nil
+}
+
+// NewAcrossSendFundsAndExecuteOnDstHookCaller creates a new read-only instance of AcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewAcrossSendFundsAndExecuteOnDstHookCaller(address common.Address, caller bind.ContractCaller) (*AcrossSendFundsAndExecuteOnDstHookCaller, error) {
+	contract, err := bindAcrossSendFundsAndExecuteOnDstHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AcrossSendFundsAndExecuteOnDstHookCaller{contract: contract}, nil
+}
+
+// NewAcrossSendFundsAndExecuteOnDstHookTransactor creates a new write-only instance of AcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewAcrossSendFundsAndExecuteOnDstHookTransactor(address common.Address, transactor bind.ContractTransactor) (*AcrossSendFundsAndExecuteOnDstHookTransactor, error) {
+	contract, err := bindAcrossSendFundsAndExecuteOnDstHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &AcrossSendFundsAndExecuteOnDstHookTransactor{contract: contract}, nil
+}
+
+// NewAcrossSendFundsAndExecuteOnDstHookFilterer creates a new log filterer instance of AcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewAcrossSendFundsAndExecuteOnDstHookFilterer(address common.Address, filterer bind.ContractFilterer) (*AcrossSendFundsAndExecuteOnDstHookFilterer, error) {
+	contract, err := bindAcrossSendFundsAndExecuteOnDstHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &AcrossSendFundsAndExecuteOnDstHookFilterer{contract: contract}, nil
+}
+
+// bindAcrossSendFundsAndExecuteOnDstHook binds a generic wrapper to an already deployed contract.
+func bindAcrossSendFundsAndExecuteOnDstHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := AcrossSendFundsAndExecuteOnDstHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.AcrossSendFundsAndExecuteOnDstHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.AcrossSendFundsAndExecuteOnDstHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.AcrossSendFundsAndExecuteOnDstHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SPOKEPOOLV3 is a free data retrieval call binding the contract method 0x352521b1.
+//
+// Solidity: function SPOKE_POOL_V3() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) SPOKEPOOLV3(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "SPOKE_POOL_V3")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SPOKEPOOLV3 is a free data retrieval call binding the contract method 0x352521b1.
+//
+// Solidity: function SPOKE_POOL_V3() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) SPOKEPOOLV3() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SPOKEPOOLV3(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SPOKEPOOLV3 is a free data retrieval call binding the contract method 0x352521b1.
+//
+// Solidity: function SPOKE_POOL_V3() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) SPOKEPOOLV3() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SPOKEPOOLV3(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) SUBTYPE() ([32]byte, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SUBTYPE(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SUBTYPE(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) Asset() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Asset(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) Asset() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Asset(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Build(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Build(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.DecodeUsePrevHookAmount(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.DecodeUsePrevHookAmount(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) ExecutionNonce() (*big.Int, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.ExecutionNonce(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.ExecutionNonce(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.GetOutAmount(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.GetOutAmount(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) HookType() (uint8, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.HookType(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) HookType() (uint8, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.HookType(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) Inspect(data []byte) ([]byte, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Inspect(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Inspect(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) LastCaller() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.LastCaller(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) LastCaller() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.LastCaller(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) SpToken() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SpToken(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) SpToken() (common.Address, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SpToken(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) Subtype() ([32]byte, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Subtype(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) Subtype() ([32]byte, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.Subtype(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _AcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) UsedShares() (*big.Int, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.UsedShares(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookCallerSession) UsedShares() (*big.Int, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.UsedShares(&_AcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.PostExecute(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.PostExecute(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.PreExecute(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.PreExecute(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.ResetExecutionState(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.ResetExecutionState(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SetExecutionContext(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SetExecutionContext(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SetOutAmount(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_AcrossSendFundsAndExecuteOnDstHook *AcrossSendFundsAndExecuteOnDstHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _AcrossSendFundsAndExecuteOnDstHook.Contract.SetOutAmount(&_AcrossSendFundsAndExecuteOnDstHook.TransactOpts, _outAmount, caller)
+}
@@ -32,12 +32,34 @@ var (
 // AcrossV3AdapterMetaData contains all meta data concerning the AcrossV3Adapter contract.
 var AcrossV3AdapterMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"acrossSpokePool_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"superDestinationExecutor_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ACROSS_SPOKE_POOL\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUPER_DESTINATION_EXECUTOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISuperDestinationExecutor\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"handleV3AcrossMessage\",\"inputs\":[{\"name\":\"tokenSent\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"message\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"AcrossFundsReceivedAndExecuted\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AcrossFundsReceivedButExecutionFailed\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AcrossFundsReceivedButNotEnoughBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SENDER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161083538038061083583398101604081905261002e9161009b565b6001600160a01b038216158061004b57506001600160a01b038116155b1561006957604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b039182166080521660a0526100cc565b80516001600160a01b0381168114610096575f5ffd5b919050565b5f5f604083850312156100ac575f5ffd5b6100b583610080565b91506100c360208401610080565b90509250929050565b60805160a05161073d6100f85f395f818160a0015261015f01525f8181605d015260cd015261073d5ff3fe608060405234801561000f575f5ffd5b506004361061003f575f3560e01c80633a5be8cb14610043578063d72b1de114610058578063f2ad82471461009b575b5f5ffd5b610056610051366004610329565b6100c2565b005b61007f7f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b03909116815260200160405180910390f35b61007f7f000000000000000000000000000000000000000000000000000000000000000081565b336001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000161461010b5760405163b78bd21b60e01b815260040160405180910390fd5b5f5f5f5f5f5f86806020019051810190610125919061051a565b949a509298509096509450925090506101486001600160a01b038b16858b6101d9565b60405163ed71d9d160e01b81526001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000169063ed71d9d1906101a0908d908890889088908d908d908a90600401610674565b5f604051808303815f87803b1580156101b7575f5ffd5b505af11580156101c9573d5f5f3e3d5ffd5b5050505050505050505050505050565b604080516001600160a01b038416602482015260448082018490528251808303909101815260649091019091526020810180516001600160e01b031663a9059cbb60e01b17905261022b908490610230565b505050565b5f5f60205f8451602086015f885af18061024f576040513d5f823e3d81fd5b50505f513d91508115610266578060011415610273565b6001600160a01b0384163b155b156102a057604051635274afe760e01b81526001600160a01b038516600482015260240160405180910390fd5b50505050565b6001600160a01b03811681146102ba575f5ffd5b50565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff811182821017156102fa576102fa6102bd565b604052919050565b5f67ffffffffffffffff82111561031b5761031b6102bd565b50601f01601f191660200190565b5f5f5f5f6080858703121561033c575f5ffd5b8435610347816102a6565b935060208501359250604085013561035e816102a6565b9150606085013567ffffffffffffffff811115610379575f5ffd5b8501601f81018713610389575f5ffd5b803561039c61039782610302565b6102d1565b8181528860208385010111156103b0575f5ffd5b816020840160208301375f6020838301015280935050505092959194509250565b5f82601f8301126103e0575f5ffd5b81516103ee61039782610302565b818152846020838601011115610402575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b8051610429816102a6565b919050565b5f67ffffffffffffffff821115610447576104476102bd565b5060051b60200190565b5f82601f830112610460575f5ffd5b815161046e6103978261042e565b8082825260208201915060208360051b86010192508583111561048f575f5ffd5b602085015b838110156104b55780516104a7816102a6565b835260209283019201610494565b5095945050505050565b5f82601f8301126104ce575f5ffd5b81516104dc6103978261042e565b8082825260208201915060208360051b8601019250858311156104fd575f5ffd5b602085015b838110156104b5578051835260209283019201610502565b5f5f5f5f5f5f60c0878903121561052f575f5ffd5b865167ffffffffffffffff811115610545575f5ffd5b61055189828a016103d1565b965050602087015167ffffffffffffffff81111561056d575f5ffd5b61057989828a016103d1565b9550506105886040880161041e565b9350606087015167ffffffffffffffff8111156105a3575f5ffd5b6105af89828a01610451565b935050608087015167ffffffffffffffff8111156105cb575f5ffd5b6105d789828a016104bf565b92505060a087015167ffffffffffffffff8111156105f3575f5ffd5b6105ff89828a016103d1565b9150509295509295509295565b5f8151808452602084019350602083015f5b8281101561063c57815186526020958601959091019060010161061e565b5093949350505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b6001600160a01b038881168252871660208083019190915260e06040830181905287519083018190525f91880190610100840190835b818110156106d15783516001600160a01b03168352602093840193909201916001016106aa565b505083810360608501526106e5818961060c565b91505082810360808401526106fa8187610646565b905082810360a084015261070e8186610646565b905082810360c08401526107228185610646565b9a995050505050505050505056fea164736f6c634300081e000a",
 }
 
 // AcrossV3AdapterABI is the input ABI used to generate the binding from.
 // Deprecated: Use AcrossV3AdapterMetaData.ABI instead.
 var AcrossV3AdapterABI = AcrossV3AdapterMetaData.ABI
 
+// AcrossV3AdapterBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use AcrossV3AdapterMetaData.Bin instead.
+var AcrossV3AdapterBin = AcrossV3AdapterMetaData.Bin
+
+// DeployAcrossV3Adapter deploys a new Ethereum contract, binding an instance of AcrossV3Adapter to it.
+func DeployAcrossV3Adapter(auth *bind.TransactOpts, backend bind.ContractBackend, acrossSpokePool_ common.Address, superDestinationExecutor_ common.Address) (common.Address, *types.Transaction, *AcrossV3Adapter, error) {
+	parsed, err := AcrossV3AdapterMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(AcrossV3AdapterBin), backend, acrossSpokePool_, superDestinationExecutor_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &AcrossV3Adapter{AcrossV3AdapterCaller: AcrossV3AdapterCaller{contract: contract}, AcrossV3AdapterTransactor: AcrossV3AdapterTransactor{contract: contract}, AcrossV3AdapterFilterer: AcrossV3AdapterFilterer{contract: contract}}, nil
+}
+
 // AcrossV3Adapter is an auto generated Go binding around an Ethereum contract.
 type AcrossV3Adapter struct {
 	AcrossV3AdapterCaller     // Read-only binding to the contract
@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ApproveAndAcrossSendFundsAndExecuteOnDstHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData contains all meta data concerning the ApproveAndAcrossSendFundsAndExecuteOnDstHook contract.
+var ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"spokePoolV3_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validator_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SPOKE_POOL_V3\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DATA_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60e060405234801561000f575f5ffd5b50604051611f2f380380611f2f83398101604081905261002e916100e6565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b038216158061009657506001600160a01b038116155b156100b457604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b0391821660a0521660c052610117565b80516001600160a01b03811681146100e1575f5ffd5b919050565b5f5f604083850312156100f7575f5ffd5b610100836100cb565b915061010e602084016100cb565b90509250929050565b60805160a05160c051611dcb6101645f395f610e3101525f81816101ae01528181610f590152818161100d015281816110f0015261152001525f8181610204015261027a0152611dcb5ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c80635492e6771161009e5780638e1487761161006e5780638e14877614610263578063984d6e7a14610275578063d06a1e891461029c578063e445e7dd146102af578063e7745517146102c8575f5ffd5b80635492e67714610202578063685a943c1461022857806381cbe691146102305780638c4313c114610243575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d14610196578063352521b1146101a957806338d52e0f146101d05780633b5896bc146101e2575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f366004611687565b6102eb565b005b6101446101543660046116e7565b610359565b610144610167366004611702565b61037a565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a4366004611687565b6103d3565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b61017e6001600160a01b0360025c1681565b6101f56101f0366004611687565b61043a565b604051610128919061175e565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61011e61023e3660046116e7565b61063f565b6102566102513660046117eb565b610657565b6040516101289190611829565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b6101446102aa3660046116e7565b6107b1565b5f546102bb9060ff1681565b604051610128919061183b565b6102db6102d63660046118cb565b61082e565b6040519015158152602001610128565b336001600160a01b038416146103145760405163e15e56c960e01b815260040160405180910390fd5b5f61031e8461083a565b90506103298161084d565b156103475760405163945b63f560e01b815260040160405180910390fd5b61035281600161085a565b5050505050565b61036281610870565b50336004805c6001600160a01b0319168217905d5050565b5f6103848261083a565b905061038f816108a2565b8061039e575061039e8161084d565b156103bc5760405163441e4c7360e11b815260040160405180910390fd5b5f6103c88260016108ab565b905083815d50505050565b336001600160a01b038416146103fc5760405163e15e56c960e01b815260040160405180910390fd5b5f6104068461083a565b9050610411816108a2565b1561042f57604051630bbb04d960e11b815260040160405180910390fd5b610352816001610900565b60605f6104498686868661090c565b9050805160026104599190611958565b6001600160401b0381111561047057610470611861565b6040519080825280602002602001820160405280156104a957816020015b610496611607565b81526020019060019003908161048e5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104f2949392919061196b565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610534576105346119b3565b60209081029190910101525f5b81518110156105955781818151811061055c5761055c6119b3565b6020026020010151838260016105729190611958565b81518110610582576105826119b3565b6020908102919091010152600101610541565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105dc949392919061196b565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161061b91906119c7565b8151811061062b5761062b6119b3565b602002602001018190525050949350505050565b5f61065161064c8361083a565b611176565b92915050565b606061069a83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060209250611183915050565b6106db84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250611183915050565b61071c85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060489250611183915050565b61075d86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060bc9250611183915050565b6040516bffffffffffffffffffffffff19606095861b8116602083015293851b8416603482015291841b8316604883015290921b16605c820152607001604051602081830303815290604052905092915050565b336001600160a01b0360045c16146107dc5760405163e15e56c960e01b815260040160405180910390fd5b5f6107e68261083a565b90506107f1816108a2565b158061080357506108018161084d565b155b1561082157604051634bd439b560e11b815260040160405180910390fd5b61082a816111ec565b5050565b5f6106518260d8611203565b5f5f6108458361122f565b5c9392505050565b5f5f6108458360036108ab565b5f6108668360036108ab565b905081815d505050565b5f6003805c9082610880836119da565b9190505d505f61088f8361122f565b905060035c80825d505060035c92915050565b5f5f6108458360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6108668360026108ab565b606060d9821015610930576040516308aec44b60e31b815260040160405180910390fd5b60408051610180810182525f8082526020820181905291810182905260608082018390526080820183905260a0820183905260c0820183905260e08201839052610100820183905261012082018390526101408201929092526101608101919091526109d084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920182905250925061129b915050565b8152604080516020601f8601819004810282018101909252848152610a119186908690819084018382808284375f9201919091525060209250611183915050565b6001600160a01b031660208083019190915260408051601f8601839004830281018301909152848152610a609186908690819084018382808284375f9201919091525060349250611183915050565b6001600160a01b031660408083019190915280516020601f8601819004810282018101909252848152610aaf9186908690819084018382808284375f9201919091525060489250611183915050565b6001600160a01b03166060820152604080516020601f8601819004810282018101909252848152610afc9186908690819084018382808284375f92019190915250605c925061129b915050565b6080820152604080516020601f8601819004810282018101909252848152610b409186908690819084018382808284375f92019190915250607c925061129b915050565b60a0820152604080516020601f8601819004810282018101909252848152610b849186908690819084018382808284375f92019190915250609c925061129b915050565b60c0820152604080516020601f8601819004810282018101909252848152610bc89186908690819084018382808284375f9201919091525060bc9250611183915050565b6001600160a01b031660e0820152604080516020601f8601819004810282018101909252848152610c159186908690819084018382808284375f9201919091525060d092506112f8915050565b63ffffffff16610100820152604080516020601f8601819004810282018101909252848152610c609186908690819084018382808284375f9201919091525060d492506112f8915050565b63ffffffff16610120820152604080516020601f8601819004810282018101909252848152610cab9186908690819084018382808284375f9201919091525060d89250611203915050565b1515610140820152604080516020601f8601819004810282018101909252848152610cfd9186908690819084018382808284375f9201919091525060d99250610cf89150829050876119c7565b611354565b61016082015261014081015115610db5576040516381cbe69160e01b81526001600160a01b0386811660048301525f91908816906381cbe69190602401602060405180830381865afa158015610d55573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d7991906119f2565b90505f8260800151118015610d9157505f8260a00151115b15610daf57610da98260a0015182846080015161145b565b60a08301525b60808201525b80608001515f03610dd9576040516305112ab160e41b815260040160405180910390fd5b60208101516001600160a01b0316610e0457604051630f58648f60e01b815260040160405180910390fd5b6101608101515115610f0057604051630f65bac560e01b81526001600160a01b0386811660048301525f917f000000000000000000000000000000000000000000000000000000000000000090911690630f65bac5906024015f60405180830381865afa158015610e77573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f19168201604052610e9e9190810190611a56565b90505f5f5f5f5f866101600151806020019051810190610ebe9190611b7a565b9450945094509450945084848484848a604051602001610ee396959493929190611c7c565b60408051601f198184030181529190526101608801525050505050505b60408051600480825260a0820190925290816020015b610f1e611607565b815260200190600190039081610f16579050509150604051806060016040528082604001516001600160a01b031681526020015f81526020017f00000000000000000000000000000000000000000000000000000000000000005f604051602401610f9e9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052825183905f90610fdc57610fdc6119b3565b6020026020010181905250604051806060016040528082604001516001600160a01b031681526020015f81526020017f000000000000000000000000000000000000000000000000000000000000000083608001516040516024016110569291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052825183906001908110611097576110976119b3565b60200260200101819052506110ac818661150b565b826002815181106110bf576110bf6119b3565b6020026020010181905250604051806060016040528082604001516001600160a01b031681526020015f81526020017f00000000000000000000000000000000000000000000000000000000000000005f6040516024016111359291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b179052905282518390600390811061062b5761062b6119b3565b5f5f6108458360016108ab565b5f61118f826014611958565b835110156111dc5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b6111f6815f610900565b611200815f61085a565b50565b5f828281518110611216576112166119b3565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161127e92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f6112a7826020611958565b835110156112ef5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064016111d3565b50016020015190565b5f611304826004611958565b8351101561134b5760405162461bcd60e51b8152602060048201526014602482015273746f55696e7433325f6f75744f66426f756e647360601b60448201526064016111d3565b50016004015190565b60608182601f01101561139a5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b60448201526064016111d3565b6113a48284611958565b845110156113e85760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b60448201526064016111d3565b6060821580156114065760405191505f825260208201604052611450565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101561143f578051835260209283019201611427565b5050858452601f01601f1916604052505b5090505b9392505050565b5f5f5f61146886866115da565b91509150815f0361148c5783818161148257611482611d26565b0492505050611454565b8184116114a3576114a360038515026011186115f6565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b611513611607565b60405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001845f015181526020018385602001518660400151876060015188608001518960a001518a60c001518b60e001518c61010001518d61012001518e61016001516040516024016115a69b9a99989796959493929190611d3a565b60408051601f198184030181529190526020810180516001600160e01b0316631ebbd90b60e21b1790529052905092915050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b60405180606001604052805f6001600160a01b031681526020015f8152602001606081525090565b6001600160a01b0381168114611200575f5ffd5b5f5f83601f840112611653575f5ffd5b5081356001600160401b03811115611669575f5ffd5b602083019150836020828501011115611680575f5ffd5b9250929050565b5f5f5f5f6060858703121561169a575f5ffd5b84356116a58161162f565b935060208501356116b58161162f565b925060408501356001600160401b038111156116cf575f5ffd5b6116db87828801611643565b95989497509550505050565b5f602082840312156116f7575f5ffd5b81356114548161162f565b5f5f60408385031215611713575f5ffd5b8235915060208301356117258161162f565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b828110156117df57868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906117c990870182611730565b9550506020938401939190910190600101611784565b50929695505050505050565b5f5f602083850312156117fc575f5ffd5b82356001600160401b03811115611811575f5ffd5b61181d85828601611643565b90969095509350505050565b602081525f6114546020830184611730565b602081016003831061185b57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f191681016001600160401b038111828210171561189d5761189d611861565b604052919050565b5f6001600160401b038211156118bd576118bd611861565b50601f01601f191660200190565b5f602082840312156118db575f5ffd5b81356001600160401b038111156118f0575f5ffd5b8201601f81018413611900575f5ffd5b803561191361190e826118a5565b611875565b818152856020838501011115611927575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561065157610651611944565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561065157610651611944565b5f600182016119eb576119eb611944565b5060010190565b5f60208284031215611a02575f5ffd5b5051919050565b5f82601f830112611a18575f5ffd5b8151611a2661190e826118a5565b818152846020838601011115611a3a575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f60208284031215611a66575f5ffd5b81516001600160401b03811115611a7b575f5ffd5b611a8784828501611a09565b949350505050565b5f6001600160401b03821115611aa757611aa7611861565b5060051b60200190565b5f82601f830112611ac0575f5ffd5b8151611ace61190e82611a8f565b8082825260208201915060208360051b860101925085831115611aef575f5ffd5b602085015b83811015611b15578051611b078161162f565b835260209283019201611af4565b5095945050505050565b5f82601f830112611b2e575f5ffd5b8151611b3c61190e82611a8f565b8082825260208201915060208360051b860101925085831115611b5d575f5ffd5b602085015b83811015611b15578051835260209283019201611b62565b5f5f5f5f5f60a08688031215611b8e575f5ffd5b85516001600160401b03811115611ba3575f5ffd5b611baf88828901611a09565b95505060208601516001600160401b03811115611bca575f5ffd5b611bd688828901611a09565b9450506040860151611be78161162f565b60608701519093506001600160401b03811115611c02575f5ffd5b611c0e88828901611ab1565b92505060808601516001600160401b03811115611c29575f5ffd5b611c3588828901611b1f565b9150509295509295909350565b5f8151808452602084019350602083015f5b82811015611c72578151865260209586019590910190600101611c54565b5093949350505050565b60c081525f611c8e60c0830189611730565b8281036020840152611ca08189611730565b6001600160a01b03881660408501528381036060850152865180825260208089019350909101905f5b81811015611cf05783516001600160a01b0316835260209384019390920191600101611cc9565b50508381036080850152611d048187611c42565b91505082810360a0840152611d198185611730565b9998505050505050505050565b634e487b7160e01b5f52601260045260245ffd5b6001600160a01b038c811682528b811660208301528a8116604083015289811660608301526080820189905260a0820188905260c08201879052851660e082015263ffffffff841661010082015263ffffffff83166101208201526101606101408201525f611dad610160830184611730565b9d9c5050505050505050505050505056fea164736f6c634300081e000a",
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData.ABI instead.
+var ApproveAndAcrossSendFundsAndExecuteOnDstHookABI = ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData.ABI
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData.Bin instead.
+var ApproveAndAcrossSendFundsAndExecuteOnDstHookBin = ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData.Bin
+
+// DeployApproveAndAcrossSendFundsAndExecuteOnDstHook deploys a new Ethereum contract, binding an instance of ApproveAndAcrossSendFundsAndExecuteOnDstHook to it.
+func DeployApproveAndAcrossSendFundsAndExecuteOnDstHook(auth *bind.TransactOpts, backend bind.ContractBackend, spokePoolV3_ common.Address, validator_ common.Address) (common.Address, *types.Transaction, *ApproveAndAcrossSendFundsAndExecuteOnDstHook, error) {
+	parsed, err := ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ApproveAndAcrossSendFundsAndExecuteOnDstHookBin), backend, spokePoolV3_, validator_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ApproveAndAcrossSendFundsAndExecuteOnDstHook{ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller: ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller{contract: contract}, ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor: ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor{contract: contract}, ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer: ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer{contract: contract}}, nil
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHook is an auto generated Go binding around an Ethereum contract.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHook struct {
+	ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller     // Read-only binding to the contract
+	ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor // Write-only binding to the contract
+	ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer   // Log filterer for contract events
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookSession struct {
+	Contract     *ApproveAndAcrossSendFundsAndExecuteOnDstHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                                 // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                             // Transaction auth options to use throughout this session
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession struct {
+	Contract *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                                       // Call options to use throughout this session
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession struct {
+	Contract     *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                                       // Transaction auth options to use throughout this session
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookRaw struct {
+	Contract *ApproveAndAcrossSendFundsAndExecuteOnDstHook // Generic contract binding to access the raw methods on
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerRaw struct {
+	Contract *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorRaw struct {
+	Contract *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewApproveAndAcrossSendFundsAndExecuteOnDstHook creates a new instance of ApproveAndAcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewApproveAndAcrossSendFundsAndExecuteOnDstHook(address common.Address, backend bind.ContractBackend) (*ApproveAndAcrossSendFundsAndExecuteOnDstHook, error) {
+	contract, err := bindApproveAndAcrossSendFundsAndExecuteOnDstHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndAcrossSendFundsAndExecuteOnDstHook{ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller: ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller{contract: contract}, ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor: ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor{contract: contract}, ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer: ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer{contract: contract}}, nil
+}
+
+// NewApproveAndAcrossSendFundsAndExecuteOnDstHookCaller creates a new read-only instance of ApproveAndAcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewApproveAndAcrossSendFundsAndExecuteOnDstHookCaller(address common.Address, caller bind.ContractCaller) (*ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller, error) {
+	contract, err := bindApproveAndAcrossSendFundsAndExecuteOnDstHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller{contract: contract}, nil
+}
+
+// NewApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor creates a new write-only instance of ApproveAndAcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor(address common.Address, transactor bind.ContractTransactor) (*ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor, error) {
+	contract, err := bindApproveAndAcrossSendFundsAndExecuteOnDstHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor{contract: contract}, nil
+}
+
+// NewApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer creates a new log filterer instance of ApproveAndAcrossSendFundsAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer(address common.Address, filterer bind.ContractFilterer) (*ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer, error) {
+	contract, err := bindApproveAndAcrossSendFundsAndExecuteOnDstHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndAcrossSendFundsAndExecuteOnDstHookFilterer{contract: contract}, nil
+}
+
+// bindApproveAndAcrossSendFundsAndExecuteOnDstHook binds a generic wrapper to an already deployed contract.
+func bindApproveAndAcrossSendFundsAndExecuteOnDstHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ApproveAndAcrossSendFundsAndExecuteOnDstHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SPOKEPOOLV3 is a free data retrieval call binding the contract method 0x352521b1.
+//
+// Solidity: function SPOKE_POOL_V3() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) SPOKEPOOLV3(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "SPOKE_POOL_V3")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SPOKEPOOLV3 is a free data retrieval call binding the contract method 0x352521b1.
+//
+// Solidity: function SPOKE_POOL_V3() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) SPOKEPOOLV3() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SPOKEPOOLV3(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SPOKEPOOLV3 is a free data retrieval call binding the contract method 0x352521b1.
+//
+// Solidity: function SPOKE_POOL_V3() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) SPOKEPOOLV3() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SPOKEPOOLV3(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SUBTYPE(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SUBTYPE(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) Asset() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Asset(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) Asset() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Asset(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Build(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Build(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ExecutionNonce(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ExecutionNonce(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.GetOutAmount(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.GetOutAmount(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) HookType() (uint8, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.HookType(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) HookType() (uint8, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.HookType(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Inspect(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Inspect(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) LastCaller() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.LastCaller(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) LastCaller() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.LastCaller(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) SpToken() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SpToken(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) SpToken() (common.Address, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SpToken(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) Subtype() ([32]byte, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Subtype(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) Subtype() ([32]byte, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.Subtype(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.UsedShares(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookCallerSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.UsedShares(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.PostExecute(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.PostExecute(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.PreExecute(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.PreExecute(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ResetExecutionState(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.ResetExecutionState(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SetExecutionContext(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SetExecutionContext(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SetOutAmount(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndAcrossSendFundsAndExecuteOnDstHook *ApproveAndAcrossSendFundsAndExecuteOnDstHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndAcrossSendFundsAndExecuteOnDstHook.Contract.SetOutAmount(&_ApproveAndAcrossSendFundsAndExecuteOnDstHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ApproveAndDeposit4626VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ApproveAndDeposit4626VaultHookMetaData contains all meta data concerning the ApproveAndDeposit4626VaultHook contract.
+var ApproveAndDeposit4626VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"dstChainId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vaultBank\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152600781526622a9219a1b191b60c91b6020909101525f805460ff191660011790557f6bd137f80bcc1600ee75e85074135d267cba030d0cda1f5fdfe642ca33d3d7bd60805260805161141f61007d5f395f81816101fc0152610284015261141f5ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063984d6e7a1161006e578063984d6e7a1461027f578063ac440f72146102a6578063d06a1e89146102b9578063e445e7dd146102cc578063e7745517146102e5575f5ffd5b8063685a943c14610220578063701ffc8b1461022857806381cbe6911461023a5780638c4313c11461024d5780638e1487761461026d575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806330c593f7146101bf57806338d52e0f146101c85780633b5896bc146101da5780635492e677146101fa575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a6101553660046110b4565b610308565b005b61015a61016a366004611111565b610382565b61015a61017d36600461112a565b6103a3565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba3660046110b4565b6103fc565b61013460065c81565b6101946001600160a01b0360025c1681565b6101ed6101e83660046110b4565b61046f565b60405161013e9190611182565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b6101345f5c81565b6101946001600160a01b0360055c1681565b610134610248366004611111565b610688565b61026061025b36600461120f565b6106a0565b60405161013e919061124e565b6101946001600160a01b0360015c1681565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b6101346102b4366004611274565b610762565b61015a6102c7366004611111565b61076c565b5f546102d89060ff1681565b60405161013e9190611327565b6102f86102f3366004611274565b6107e9565b604051901515815260200161013e565b336001600160a01b038416146103315760405163e15e56c960e01b815260040160405180910390fd5b5f61033b846107f5565b905061034681610808565b156103645760405163945b63f560e01b815260040160405180910390fd5b61036f816001610815565b61037b8585858561082b565b5050505050565b61038b8161088c565b50336004805c6001600160a01b0319168217905d5050565b5f6103ad826107f5565b90506103b8816108be565b806103c757506103c781610808565b156103e55760405163441e4c7360e11b815260040160405180910390fd5b5f6103f18260016108c7565b905083815d50505050565b336001600160a01b038416146104255760405163e15e56c960e01b815260040160405180910390fd5b5f61042f846107f5565b905061043a816108be565b1561045857604051630bbb04d960e11b815260040160405180910390fd5b61046381600161091c565b61037b85858585610928565b60605f61047e868686866109ca565b90508051600261048e9190611361565b67ffffffffffffffff8111156104a6576104a6611260565b6040519080825280602002602001820160405280156104f257816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104c45790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161053b9493929190611374565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061057d5761057d6113bc565b60209081029190910101525f5b81518110156105de578181815181106105a5576105a56113bc565b6020026020010151838260016105bb9190611361565b815181106105cb576105cb6113bc565b602090810291909101015260010161058a565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106259493929190611374565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161066491906113d0565b81518110610674576106746113bc565b602002602001018190525050949350505050565b5f61069a610695836107f5565b610e2b565b92915050565b60606106e083838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e3892505050565b61072184848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e40915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b5f61069a82610ea9565b336001600160a01b0360045c16146107975760405163e15e56c960e01b815260040160405180910390fd5b5f6107a1826107f5565b90506107ac816108be565b15806107be57506107bc81610808565b155b156107dc57604051634bd439b560e11b815260040160405180910390fd5b6107e581610eb5565b5050565b5f61069a826068610ecc565b5f5f61080083610ef8565b5c9392505050565b5f5f6108008360036108c7565b5f6108218360036108c7565b905081815d505050565b61088661083784610688565b6108768585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f6492505050565b61088091906113d0565b84610fdf565b50505050565b5f6003805c908261089c836113e3565b9190505d505f6108ab83610ef8565b905060035c80825d505060035c92915050565b5f5f6108008360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6108218360026108c7565b61096a6108808484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f6492505050565b6109a882828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e3892505050565b6001805c6001600160a01b0319166001600160a01b03831617905d5050505050565b60605f610a0b84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e3892505050565b90505f610a4f85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e40915050565b90505f610a9086868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610ea992505050565b90505f610ad487878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060689250610ecc915050565b90508015610b47576040516381cbe69160e01b81526001600160a01b0389811660048301528a16906381cbe69190602401602060405180830381865afa158015610b20573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b4491906113fb565b91505b815f03610b67576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0384161580610b8457506001600160a01b038316155b15610ba257604051630f58648f60e01b815260040160405180910390fd5b60408051600480825260a0820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610bb857905050604080516060810182526001600160a01b0380871682525f60208301819052835191891660248301526044820152929750919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186905f90610c5d57610c5d6113bc565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f81526020018584604051602401610caf9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906001908110610cf057610cf06113bc565b60200260200101819052506040518060600160405280856001600160a01b031681526020015f8152602001838a604051602401610d409291909182526001600160a01b0316602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b0316636e553f6560e01b1790529052855186906002908110610d8157610d816113bc565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f8152602001855f604051602401610dd39291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906003908110610e1457610e146113bc565b602002602001018190525050505050949350505050565b5f5f6108008360016108c7565b5f61069a8260205b5f610e4c826014611361565b83511015610e995760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f61069a826048610ff7565b610ebf815f61091c565b610ec9815f610815565b50565b5f828281518110610edf57610edf6113bc565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610f4792919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610f6e82610e38565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610fb4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610fd891906113fb565b9392505050565b5f610fe9826107f5565b90505f6103f18260016108c7565b5f611003826020611361565b8351101561104b5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610e90565b50016020015190565b80356001600160a01b038116811461106a575f5ffd5b919050565b5f5f83601f84011261107f575f5ffd5b50813567ffffffffffffffff811115611096575f5ffd5b6020830191508360208285010111156110ad575f5ffd5b9250929050565b5f5f5f5f606085870312156110c7575f5ffd5b6110d085611054565b93506110de60208601611054565b9250604085013567ffffffffffffffff8111156110f9575f5ffd5b6111058782880161106f565b95989497509550505050565b5f60208284031215611121575f5ffd5b610fd882611054565b5f5f6040838503121561113b575f5ffd5b8235915061114b60208401611054565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561120357868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906111ed90870182611154565b95505060209384019391909101906001016111a8565b50929695505050505050565b5f5f60208385031215611220575f5ffd5b823567ffffffffffffffff811115611236575f5ffd5b6112428582860161106f565b90969095509350505050565b602081525f610fd86020830184611154565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611284575f5ffd5b813567ffffffffffffffff81111561129a575f5ffd5b8201601f810184136112aa575f5ffd5b803567ffffffffffffffff8111156112c4576112c4611260565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156112f3576112f3611260565b60405281815282820160200186101561130a575f5ffd5b816020840160208301375f91810160200191909152949350505050565b602081016003831061134757634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561069a5761069a61134d565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561069a5761069a61134d565b5f600182016113f4576113f461134d565b5060010190565b5f6020828403121561140b575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// ApproveAndDeposit4626VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ApproveAndDeposit4626VaultHookMetaData.ABI instead.
+var ApproveAndDeposit4626VaultHookABI = ApproveAndDeposit4626VaultHookMetaData.ABI
+
+// ApproveAndDeposit4626VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ApproveAndDeposit4626VaultHookMetaData.Bin instead.
+var ApproveAndDeposit4626VaultHookBin = ApproveAndDeposit4626VaultHookMetaData.Bin
+
+// DeployApproveAndDeposit4626VaultHook deploys a new Ethereum contract, binding an instance of ApproveAndDeposit4626VaultHook to it.
+func DeployApproveAndDeposit4626VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ApproveAndDeposit4626VaultHook, error) {
+	parsed, err := ApproveAndDeposit4626VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ApproveAndDeposit4626VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ApproveAndDeposit4626VaultHook{ApproveAndDeposit4626VaultHookCaller: ApproveAndDeposit4626VaultHookCaller{contract: contract}, ApproveAndDeposit4626VaultHookTransactor: ApproveAndDeposit4626VaultHookTransactor{contract: contract}, ApproveAndDeposit4626VaultHookFilterer: ApproveAndDeposit4626VaultHookFilterer{contract: contract}}, nil
+}
+
+// ApproveAndDeposit4626VaultHook is an auto generated Go binding around an Ethereum contract.
+type ApproveAndDeposit4626VaultHook struct {
+	ApproveAndDeposit4626VaultHookCaller     // Read-only binding to the contract
+	ApproveAndDeposit4626VaultHookTransactor // Write-only binding to the contract
+	ApproveAndDeposit4626VaultHookFilterer   // Log filterer for contract events
+}
+
+// ApproveAndDeposit4626VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ApproveAndDeposit4626VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndDeposit4626VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ApproveAndDeposit4626VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndDeposit4626VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ApproveAndDeposit4626VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndDeposit4626VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ApproveAndDeposit4626VaultHookSession struct {
+	Contract     *ApproveAndDeposit4626VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                   // Call options to use throughout this session
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// ApproveAndDeposit4626VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ApproveAndDeposit4626VaultHookCallerSession struct {
+	Contract *ApproveAndDeposit4626VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                         // Call options to use throughout this session
+}
+
+// ApproveAndDeposit4626VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ApproveAndDeposit4626VaultHookTransactorSession struct {
+	Contract     *ApproveAndDeposit4626VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                         // Transaction auth options to use throughout this session
+}
+
+// ApproveAndDeposit4626VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ApproveAndDeposit4626VaultHookRaw struct {
+	Contract *ApproveAndDeposit4626VaultHook // Generic contract binding to access the raw methods on
+}
+
+// ApproveAndDeposit4626VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ApproveAndDeposit4626VaultHookCallerRaw struct {
+	Contract *ApproveAndDeposit4626VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ApproveAndDeposit4626VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ApproveAndDeposit4626VaultHookTransactorRaw struct {
+	Contract *ApproveAndDeposit4626VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewApproveAndDeposit4626VaultHook creates a new instance of ApproveAndDeposit4626VaultHook, bound to a specific deployed contract.
+func NewApproveAndDeposit4626VaultHook(address common.Address, backend bind.ContractBackend) (*ApproveAndDeposit4626VaultHook, error) {
+	contract, err := bindApproveAndDeposit4626VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndDeposit4626VaultHook{ApproveAndDeposit4626VaultHookCaller: ApproveAndDeposit4626VaultHookCaller{contract: contract}, ApproveAndDeposit4626VaultHookTransactor: ApproveAndDeposit4626VaultHookTransactor{contract: contract}, ApproveAndDeposit4626VaultHookFilterer: ApproveAndDeposit4626VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewApproveAndDeposit4626VaultHookCaller creates a new read-only instance of ApproveAndDeposit4626VaultHook, bound to a specific deployed contract.
+func NewApproveAndDeposit4626VaultHookCaller(address common.Address, caller bind.ContractCaller) (*ApproveAndDeposit4626VaultHookCaller, error) {
+	contract, err := bindApproveAndDeposit4626VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndDeposit4626VaultHookCaller{contract: contract}, nil
+}
+
+// NewApproveAndDeposit4626VaultHookTransactor creates a new write-only instance of ApproveAndDeposit4626VaultHook, bound to a specific deployed contract.
+func NewApproveAndDeposit4626VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*ApproveAndDeposit4626VaultHookTransactor, error) {
+	contract, err := bindApproveAndDeposit4626VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndDeposit4626VaultHookTransactor{contract: contract}, nil
+}
+
+// NewApproveAndDeposit4626VaultHookFilterer creates a new log filterer instance of ApproveAndDeposit4626VaultHook, bound to a specific deployed contract.
+func NewApproveAndDeposit4626VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*ApproveAndDeposit4626VaultHookFilterer, error) {
+	contract, err := bindApproveAndDeposit4626VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndDeposit4626VaultHookFilterer{contract: contract}, nil
+}
+
+// bindApproveAndDeposit4626VaultHook binds a generic wrapper to an already deployed contract.
+func bindApproveAndDeposit4626VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ApproveAndDeposit4626VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndDeposit4626VaultHook.Contract.ApproveAndDeposit4626VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.ApproveAndDeposit4626VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.ApproveAndDeposit4626VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndDeposit4626VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SUBTYPE(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SUBTYPE(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) Asset() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Asset(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) Asset() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Asset(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Build(&_ApproveAndDeposit4626VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Build(&_ApproveAndDeposit4626VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.DecodeAmount(&_ApproveAndDeposit4626VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.DecodeAmount(&_ApproveAndDeposit4626VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndDeposit4626VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndDeposit4626VaultHook.CallOpts, data)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) DstChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "dstChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) DstChainId() (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.DstChainId(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) DstChainId() (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.DstChainId(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.ExecutionNonce(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.ExecutionNonce(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.GetOutAmount(&_ApproveAndDeposit4626VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.GetOutAmount(&_ApproveAndDeposit4626VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) HookType() (uint8, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.HookType(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) HookType() (uint8, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.HookType(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Inspect(&_ApproveAndDeposit4626VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Inspect(&_ApproveAndDeposit4626VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) LastCaller() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.LastCaller(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.LastCaller(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) SpToken() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SpToken(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SpToken(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) Subtype() ([32]byte, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Subtype(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.Subtype(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.UsedShares(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.UsedShares(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCaller) VaultBank(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndDeposit4626VaultHook.contract.Call(opts, &out, "vaultBank")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) VaultBank() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.VaultBank(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookCallerSession) VaultBank() (common.Address, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.VaultBank(&_ApproveAndDeposit4626VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.PostExecute(&_ApproveAndDeposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.PostExecute(&_ApproveAndDeposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.PreExecute(&_ApproveAndDeposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.PreExecute(&_ApproveAndDeposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.ResetExecutionState(&_ApproveAndDeposit4626VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.ResetExecutionState(&_ApproveAndDeposit4626VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SetExecutionContext(&_ApproveAndDeposit4626VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SetExecutionContext(&_ApproveAndDeposit4626VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SetOutAmount(&_ApproveAndDeposit4626VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndDeposit4626VaultHook *ApproveAndDeposit4626VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndDeposit4626VaultHook.Contract.SetOutAmount(&_ApproveAndDeposit4626VaultHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ApproveAndFluidStakeHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ApproveAndFluidStakeHookMetaData contains all meta data concerning the ApproveAndFluidStakeHook contract.
+var ApproveAndFluidStakeHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260058152645374616b6560d81b6020909101525f805460ff191690557f46e0152631724883515adf5183d0ad68b0f3fdb4ea59b5bb258492ab0edd5ec760805260805161135e6100785f395f81816101e80152610292015261135e5ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c806360b5be29116100a95780638e1487761161006e5780638e1487761461027b578063cf0a61811461028d578063d06a1e89146102b4578063e445e7dd146102c7578063e7745517146102e0575f5ffd5b806360b5be291461020c578063685a943c1461022e57806381cbe6911461023657806384542a24146102495780638c4313c11461025b575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633b5896bc146101c65780635492e677146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610ff3565b6102f3565b005b61014f61015f366004611050565b61036d565b61014f610172366004611069565b61038e565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af366004610ff3565b6103e7565b6101896001600160a01b0360025c1681565b6101d96101d4366004610ff3565b61045a565b60405161013391906110c1565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b61021e60ff600160a81b60025c041681565b6040519015158152602001610133565b6101295f5c81565b610129610244366004611050565b610673565b61021e60ff600160a01b60025c041681565b61026e61026936600461114e565b61068b565b604051610133919061118d565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102c2366004611050565b61074d565b5f546102d39060ff1681565b604051610133919061119f565b61021e6102ee3660046111d9565b61079f565b336001600160a01b0384161461031c5760405163e15e56c960e01b815260040160405180910390fd5b5f610326846107ab565b9050610331816107be565b1561034f5760405163945b63f560e01b815260040160405180910390fd5b61035a8160016107cb565b610366858585856107e1565b5050505050565b61037681610842565b50336004805c6001600160a01b0319168217905d5050565b5f610398826107ab565b90506103a381610874565b806103b257506103b2816107be565b156103d05760405163441e4c7360e11b815260040160405180910390fd5b5f6103dc82600161087d565b905083815d50505050565b336001600160a01b038416146104105760405163e15e56c960e01b815260040160405180910390fd5b5f61041a846107ab565b905061042581610874565b1561044357604051630bbb04d960e11b815260040160405180910390fd5b61044e8160016108d2565b610366858585856108de565b60605f61046986868686610920565b90508051600261047991906112a0565b67ffffffffffffffff811115610491576104916111c5565b6040519080825280602002602001820160405280156104dd57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104af5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161052694939291906112b3565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610568576105686112fb565b60209081029190910101525f5b81518110156105c957818181518110610590576105906112fb565b6020026020010151838260016105a691906112a0565b815181106105b6576105b66112fb565b6020908102919091010152600101610575565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161061094939291906112b3565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161064f919061130f565b8151811061065f5761065f6112fb565b602002602001018190525050949350505050565b5f610685610680836107ab565b610d6f565b92915050565b60606106cb83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610d7c92505050565b61070c84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610d84915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b5f610757826107ab565b905061076281610874565b15806107745750610772816107be565b155b1561079257604051634bd439b560e11b815260040160405180910390fd5b61079b81610ded565b5050565b5f610685826068610e04565b5f5f6107b683610e30565b5c9392505050565b5f5f6107b683600361087d565b5f6107d783600361087d565b905081815d505050565b61083c6107ed84610673565b61082c8585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e9c92505050565b610836919061130f565b84610f17565b50505050565b5f6003805c908261085283611322565b9190505d505f61086183610e30565b905060035c80825d505060035c92915050565b5f5f6107b68360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107d783600261087d565b61083c6108368484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e9c92505050565b60605f61096184848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610d7c92505050565b90505f6109a585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610d84915050565b90505f6109e686868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f2f92505050565b90505f610a2a87878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060689250610e04915050565b90506001600160a01b0384161580610a4957506001600160a01b038316155b15610a6757604051630f58648f60e01b815260040160405180910390fd5b8015610ad8576040516381cbe69160e01b81526001600160a01b0389811660048301528a16906381cbe69190602401602060405180830381865afa158015610ab1573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610ad5919061133a565b91505b815f03610af8576040516305112ab160e41b815260040160405180910390fd5b60408051600480825260a0820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b0e57905050604080516060810182526001600160a01b0380871682525f60208301819052835191891660248301526044820152929750919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186905f90610bb357610bb36112fb565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f81526020018584604051602401610c059291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906001908110610c4657610c466112fb565b60200260200101819052506040518060600160405280856001600160a01b031681526020015f815260200183604051602401610c8491815260200190565b60408051601f198184030181529190526020810180516001600160e01b031663534a7e1d60e11b1790529052855186906002908110610cc557610cc56112fb565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f8152602001855f604051602401610d179291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906003908110610d5857610d586112fb565b602002602001018190525050505050949350505050565b5f5f6107b683600161087d565b5f6106858260205b5f610d908260146112a0565b83511015610ddd5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b610df7815f6108d2565b610e01815f6107cb565b50565b5f828281518110610e1757610e176112fb565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610e7f92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610ea682610d7c565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610eec573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610f10919061133a565b9392505050565b5f610f21826107ab565b90505f6103dc82600161087d565b5f6106858260485f610f428260206112a0565b83511015610f8a5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610dd4565b50016020015190565b80356001600160a01b0381168114610fa9575f5ffd5b919050565b5f5f83601f840112610fbe575f5ffd5b50813567ffffffffffffffff811115610fd5575f5ffd5b602083019150836020828501011115610fec575f5ffd5b9250929050565b5f5f5f5f60608587031215611006575f5ffd5b61100f85610f93565b935061101d60208601610f93565b9250604085013567ffffffffffffffff811115611038575f5ffd5b61104487828801610fae565b95989497509550505050565b5f60208284031215611060575f5ffd5b610f1082610f93565b5f5f6040838503121561107a575f5ffd5b8235915061108a60208401610f93565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561114257868503603f19018452815180516001600160a01b031686526020808201519087015260409081015160609187018290529061112c90870182611093565b95505060209384019391909101906001016110e7565b50929695505050505050565b5f5f6020838503121561115f575f5ffd5b823567ffffffffffffffff811115611175575f5ffd5b61118185828601610fae565b90969095509350505050565b602081525f610f106020830184611093565b60208101600383106111bf57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f602082840312156111e9575f5ffd5b813567ffffffffffffffff8111156111ff575f5ffd5b8201601f8101841361120f575f5ffd5b803567ffffffffffffffff811115611229576112296111c5565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611258576112586111c5565b60405281815282820160200186101561126f575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106855761068561128c565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106855761068561128c565b5f600182016113335761133361128c565b5060010190565b5f6020828403121561134a575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// ApproveAndFluidStakeHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ApproveAndFluidStakeHookMetaData.ABI instead.
+var ApproveAndFluidStakeHookABI = ApproveAndFluidStakeHookMetaData.ABI
+
+// ApproveAndFluidStakeHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ApproveAndFluidStakeHookMetaData.Bin instead.
+var ApproveAndFluidStakeHookBin = ApproveAndFluidStakeHookMetaData.Bin
+
+// DeployApproveAndFluidStakeHook deploys a new Ethereum contract, binding an instance of ApproveAndFluidStakeHook to it.
+func DeployApproveAndFluidStakeHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ApproveAndFluidStakeHook, error) {
+	parsed, err := ApproveAndFluidStakeHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ApproveAndFluidStakeHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ApproveAndFluidStakeHook{ApproveAndFluidStakeHookCaller: ApproveAndFluidStakeHookCaller{contract: contract}, ApproveAndFluidStakeHookTransactor: ApproveAndFluidStakeHookTransactor{contract: contract}, ApproveAndFluidStakeHookFilterer: ApproveAndFluidStakeHookFilterer{contract: contract}}, nil
+}
+
+// ApproveAndFluidStakeHook is an auto generated Go binding around an Ethereum contract.
+type ApproveAndFluidStakeHook struct {
+	ApproveAndFluidStakeHookCaller     // Read-only binding to the contract
+	ApproveAndFluidStakeHookTransactor // Write-only binding to the contract
+	ApproveAndFluidStakeHookFilterer   // Log filterer for contract events
+}
+
+// ApproveAndFluidStakeHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ApproveAndFluidStakeHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndFluidStakeHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ApproveAndFluidStakeHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndFluidStakeHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ApproveAndFluidStakeHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndFluidStakeHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ApproveAndFluidStakeHookSession struct {
+	Contract     *ApproveAndFluidStakeHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ApproveAndFluidStakeHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ApproveAndFluidStakeHookCallerSession struct {
+	Contract *ApproveAndFluidStakeHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// ApproveAndFluidStakeHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ApproveAndFluidStakeHookTransactorSession struct {
+	Contract     *ApproveAndFluidStakeHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// ApproveAndFluidStakeHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ApproveAndFluidStakeHookRaw struct {
+	Contract *ApproveAndFluidStakeHook // Generic contract binding to access the raw methods on
+}
+
+// ApproveAndFluidStakeHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ApproveAndFluidStakeHookCallerRaw struct {
+	Contract *ApproveAndFluidStakeHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ApproveAndFluidStakeHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ApproveAndFluidStakeHookTransactorRaw struct {
+	Contract *ApproveAndFluidStakeHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewApproveAndFluidStakeHook creates a new instance of ApproveAndFluidStakeHook, bound to a specific deployed contract.
+func NewApproveAndFluidStakeHook(address common.Address, backend bind.ContractBackend) (*ApproveAndFluidStakeHook, error) {
+	contract, err := bindApproveAndFluidStakeHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndFluidStakeHook{ApproveAndFluidStakeHookCaller: ApproveAndFluidStakeHookCaller{contract: contract}, ApproveAndFluidStakeHookTransactor: ApproveAndFluidStakeHookTransactor{contract: contract}, ApproveAndFluidStakeHookFilterer: ApproveAndFluidStakeHookFilterer{contract: contract}}, nil
+}
+
+// NewApproveAndFluidStakeHookCaller creates a new read-only instance of ApproveAndFluidStakeHook, bound to a specific deployed contract.
+func NewApproveAndFluidStakeHookCaller(address common.Address, caller bind.ContractCaller) (*ApproveAndFluidStakeHookCaller, error) {
+	contract, err := bindApproveAndFluidStakeHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndFluidStakeHookCaller{contract: contract}, nil
+}
+
+// NewApproveAndFluidStakeHookTransactor creates a new write-only instance of ApproveAndFluidStakeHook, bound to a specific deployed contract.
+func NewApproveAndFluidStakeHookTransactor(address common.Address, transactor bind.ContractTransactor) (*ApproveAndFluidStakeHookTransactor, error) {
+	contract, err := bindApproveAndFluidStakeHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndFluidStakeHookTransactor{contract: contract}, nil
+}
+
+// NewApproveAndFluidStakeHookFilterer creates a new log filterer instance of ApproveAndFluidStakeHook, bound to a specific deployed contract.
+func NewApproveAndFluidStakeHookFilterer(address common.Address, filterer bind.ContractFilterer) (*ApproveAndFluidStakeHookFilterer, error) {
+	contract, err := bindApproveAndFluidStakeHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndFluidStakeHookFilterer{contract: contract}, nil
+}
+
+// bindApproveAndFluidStakeHook binds a generic wrapper to an already deployed contract.
+func bindApproveAndFluidStakeHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ApproveAndFluidStakeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndFluidStakeHook.Contract.ApproveAndFluidStakeHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.ApproveAndFluidStakeHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.ApproveAndFluidStakeHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndFluidStakeHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) Asset() (common.Address, error) {
+	return _ApproveAndFluidStakeHook.Contract.Asset(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) Asset() (common.Address, error) {
+	return _ApproveAndFluidStakeHook.Contract.Asset(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndFluidStakeHook.Contract.Build(&_ApproveAndFluidStakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndFluidStakeHook.Contract.Build(&_ApproveAndFluidStakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndFluidStakeHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndFluidStakeHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndFluidStakeHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndFluidStakeHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndFluidStakeHook.Contract.ExecutionNonce(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndFluidStakeHook.Contract.ExecutionNonce(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndFluidStakeHook.Contract.GetOutAmount(&_ApproveAndFluidStakeHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndFluidStakeHook.Contract.GetOutAmount(&_ApproveAndFluidStakeHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) HookType() (uint8, error) {
+	return _ApproveAndFluidStakeHook.Contract.HookType(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) HookType() (uint8, error) {
+	return _ApproveAndFluidStakeHook.Contract.HookType(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndFluidStakeHook.Contract.Inspect(&_ApproveAndFluidStakeHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndFluidStakeHook.Contract.Inspect(&_ApproveAndFluidStakeHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) LastCaller() (common.Address, error) {
+	return _ApproveAndFluidStakeHook.Contract.LastCaller(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) LastCaller() (common.Address, error) {
+	return _ApproveAndFluidStakeHook.Contract.LastCaller(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) PostExecuteMutex() (bool, error) {
+	return _ApproveAndFluidStakeHook.Contract.PostExecuteMutex(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _ApproveAndFluidStakeHook.Contract.PostExecuteMutex(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) PreExecuteMutex() (bool, error) {
+	return _ApproveAndFluidStakeHook.Contract.PreExecuteMutex(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _ApproveAndFluidStakeHook.Contract.PreExecuteMutex(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) SpToken() (common.Address, error) {
+	return _ApproveAndFluidStakeHook.Contract.SpToken(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) SpToken() (common.Address, error) {
+	return _ApproveAndFluidStakeHook.Contract.SpToken(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) SubType() ([32]byte, error) {
+	return _ApproveAndFluidStakeHook.Contract.SubType(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) SubType() ([32]byte, error) {
+	return _ApproveAndFluidStakeHook.Contract.SubType(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) Subtype() ([32]byte, error) {
+	return _ApproveAndFluidStakeHook.Contract.Subtype(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) Subtype() ([32]byte, error) {
+	return _ApproveAndFluidStakeHook.Contract.Subtype(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndFluidStakeHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndFluidStakeHook.Contract.UsedShares(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookCallerSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndFluidStakeHook.Contract.UsedShares(&_ApproveAndFluidStakeHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.PostExecute(&_ApproveAndFluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.PostExecute(&_ApproveAndFluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.PreExecute(&_ApproveAndFluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.PreExecute(&_ApproveAndFluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.ResetExecutionState(&_ApproveAndFluidStakeHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.ResetExecutionState(&_ApproveAndFluidStakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.SetExecutionContext(&_ApproveAndFluidStakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.SetExecutionContext(&_ApproveAndFluidStakeHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.SetOutAmount(&_ApproveAndFluidStakeHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndFluidStakeHook *ApproveAndFluidStakeHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndFluidStakeHook.Contract.SetOutAmount(&_ApproveAndFluidStakeHook.TransactOpts, _outAmount, caller)
+}
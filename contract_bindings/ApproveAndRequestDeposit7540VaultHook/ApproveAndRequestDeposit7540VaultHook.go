@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ApproveAndRequestDeposit7540VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ApproveAndRequestDeposit7540VaultHookMetaData contains all meta data concerning the ApproveAndRequestDeposit7540VaultHook contract.
+var ApproveAndRequestDeposit7540VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260078152660455243373534360cc1b6020909101525f805460ff191690557fc518a1d423df5aa415a8180f6384c181bd18f2bc1b734ba3dd52cd98b9b5fb7760805260805161142761007a5f395f81816101dd015261025301526114275ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c8063685a943c1161009e578063984d6e7a1161006e578063984d6e7a1461024e578063ac440f7214610275578063d06a1e8914610288578063e445e7dd1461029b578063e7745517146102b4575f5ffd5b8063685a943c1461020157806381cbe691146102095780638c4313c11461021c5780638e1487761461023c575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d1461019657806338d52e0f146101a95780633b5896bc146101bb5780635492e677146101db575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f366004611097565b6102d7565b005b6101446101543660046110f8565b610351565b610144610167366004611113565b610372565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a4366004611097565b6103cb565b61017e6001600160a01b0360025c1681565b6101ce6101c9366004611097565b61043e565b604051610128919061116f565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61011e6102173660046110f8565b610657565b61022f61022a3660046111fc565b61066f565b604051610128919061123b565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b61011e610283366004611261565b610731565b6101446102963660046110f8565b61073b565b5f546102a79060ff1681565b6040516101289190611314565b6102c76102c2366004611261565b6107b8565b6040519015158152602001610128565b336001600160a01b038416146103005760405163e15e56c960e01b815260040160405180910390fd5b5f61030a846107c4565b9050610315816107d7565b156103335760405163945b63f560e01b815260040160405180910390fd5b61033e8160016107e4565b61034a858585856107fa565b5050505050565b61035a8161085b565b50336004805c6001600160a01b0319168217905d5050565b5f61037c826107c4565b90506103878161088d565b806103965750610396816107d7565b156103b45760405163441e4c7360e11b815260040160405180910390fd5b5f6103c0826001610896565b905083815d50505050565b336001600160a01b038416146103f45760405163e15e56c960e01b815260040160405180910390fd5b5f6103fe846107c4565b90506104098161088d565b1561042757604051630bbb04d960e11b815260040160405180910390fd5b6104328160016108eb565b61034a858585856108f7565b60605f61044d86868686610939565b90508051600261045d919061134e565b67ffffffffffffffff8111156104755761047561124d565b6040519080825280602002602001820160405280156104c157816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104935790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161050a9493929190611361565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061054c5761054c6113a9565b60209081029190910101525f5b81518110156105ad57818181518110610574576105746113a9565b60200260200101518382600161058a919061134e565b8151811061059a5761059a6113a9565b6020908102919091010152600101610559565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105f49493929190611361565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161063391906113bd565b81518110610643576106436113a9565b602002602001018190525050949350505050565b5f610669610664836107c4565b610db6565b92915050565b60606106af83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610dc392505050565b6106f084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610dcb915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b5f61066982610e34565b336001600160a01b0360045c16146107665760405163e15e56c960e01b815260040160405180910390fd5b5f610770826107c4565b905061077b8161088d565b158061078d575061078b816107d7565b155b156107ab57604051634bd439b560e11b815260040160405180910390fd5b6107b481610e40565b5050565b5f610669826068610e57565b5f5f6107cf83610e83565b5c9392505050565b5f5f6107cf836003610896565b5f6107f0836003610896565b905081815d505050565b61085561083c8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610eef92505050565b61084585610657565b61084f91906113bd565b84610fc9565b50505050565b5f6003805c908261086b836113d0565b9190505d505f61087a83610e83565b905060035c80825d505060035c92915050565b5f5f6107cf8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107f0836002610896565b61085561084f8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610eef92505050565b60605f61097a84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610dc392505050565b90505f6109be85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610dcb915050565b90505f6109ff86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e3492505050565b90505f610a4387878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060689250610e57915050565b90508015610ab6576040516381cbe69160e01b81526001600160a01b0389811660048301528a16906381cbe69190602401602060405180830381865afa158015610a8f573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610ab391906113e8565b91505b815f03610ad6576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0384161580610af357506001600160a01b038816155b80610b0557506001600160a01b038316155b15610b2357604051630f58648f60e01b815260040160405180910390fd5b60408051600480825260a0820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b3957905050604080516060810182526001600160a01b0380871682525f60208301819052835191891660248301526044820152929750919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186905f90610bde57610bde6113a9565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f81526020018584604051602401610c309291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906001908110610c7157610c716113a9565b60200260200101819052506040518060600160405280856001600160a01b031681526020015f8152602001838a8b604051602401610ccb939291909283526001600160a01b03918216602084015216604082015260600190565b60408051601f198184030181529190526020810180516001600160e01b03166385b77f4560e01b1790529052855186906002908110610d0c57610d0c6113a9565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f8152602001855f604051602401610d5e9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906003908110610d9f57610d9f6113a9565b602002602001018190525050505050949350505050565b5f5f6107cf836001610896565b5f6106698260205b5f610dd782601461134e565b83511015610e245760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610669826048610fe1565b610e4a815f6108eb565b610e54815f6107e4565b50565b5f828281518110610e6a57610e6a6113a9565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610ed292919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610ef982610dc3565b6001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610f34573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610f5891906113ff565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610f9e573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610fc291906113e8565b9392505050565b5f610fd3826107c4565b90505f6103c0826001610896565b5f610fed82602061134e565b835110156110355760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610e1b565b50016020015190565b6001600160a01b0381168114610e54575f5ffd5b5f5f83601f840112611062575f5ffd5b50813567ffffffffffffffff811115611079575f5ffd5b602083019150836020828501011115611090575f5ffd5b9250929050565b5f5f5f5f606085870312156110aa575f5ffd5b84356110b58161103e565b935060208501356110c58161103e565b9250604085013567ffffffffffffffff8111156110e0575f5ffd5b6110ec87828801611052565b95989497509550505050565b5f60208284031215611108575f5ffd5b8135610fc28161103e565b5f5f60408385031215611124575f5ffd5b8235915060208301356111368161103e565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b828110156111f057868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906111da90870182611141565b9550506020938401939190910190600101611195565b50929695505050505050565b5f5f6020838503121561120d575f5ffd5b823567ffffffffffffffff811115611223575f5ffd5b61122f85828601611052565b90969095509350505050565b602081525f610fc26020830184611141565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611271575f5ffd5b813567ffffffffffffffff811115611287575f5ffd5b8201601f81018413611297575f5ffd5b803567ffffffffffffffff8111156112b1576112b161124d565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156112e0576112e061124d565b6040528181528282016020018610156112f7575f5ffd5b816020840160208301375f91810160200191909152949350505050565b602081016003831061133457634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106695761066961133a565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106695761066961133a565b5f600182016113e1576113e161133a565b5060010190565b5f602082840312156113f8575f5ffd5b5051919050565b5f6020828403121561140f575f5ffd5b8151610fc28161103e56fea164736f6c634300081e000a",
+}
+
+// ApproveAndRequestDeposit7540VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ApproveAndRequestDeposit7540VaultHookMetaData.ABI instead.
+var ApproveAndRequestDeposit7540VaultHookABI = ApproveAndRequestDeposit7540VaultHookMetaData.ABI
+
+// ApproveAndRequestDeposit7540VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ApproveAndRequestDeposit7540VaultHookMetaData.Bin instead.
+var ApproveAndRequestDeposit7540VaultHookBin = ApproveAndRequestDeposit7540VaultHookMetaData.Bin
+
+// DeployApproveAndRequestDeposit7540VaultHook deploys a new Ethereum contract, binding an instance of ApproveAndRequestDeposit7540VaultHook to it.
+func DeployApproveAndRequestDeposit7540VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ApproveAndRequestDeposit7540VaultHook, error) {
+	parsed, err := ApproveAndRequestDeposit7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ApproveAndRequestDeposit7540VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ApproveAndRequestDeposit7540VaultHook{ApproveAndRequestDeposit7540VaultHookCaller: ApproveAndRequestDeposit7540VaultHookCaller{contract: contract}, ApproveAndRequestDeposit7540VaultHookTransactor: ApproveAndRequestDeposit7540VaultHookTransactor{contract: contract}, ApproveAndRequestDeposit7540VaultHookFilterer: ApproveAndRequestDeposit7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// ApproveAndRequestDeposit7540VaultHook is an auto generated Go binding around an Ethereum contract.
+type ApproveAndRequestDeposit7540VaultHook struct {
+	ApproveAndRequestDeposit7540VaultHookCaller     // Read-only binding to the contract
+	ApproveAndRequestDeposit7540VaultHookTransactor // Write-only binding to the contract
+	ApproveAndRequestDeposit7540VaultHookFilterer   // Log filterer for contract events
+}
+
+// ApproveAndRequestDeposit7540VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ApproveAndRequestDeposit7540VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndRequestDeposit7540VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ApproveAndRequestDeposit7540VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndRequestDeposit7540VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ApproveAndRequestDeposit7540VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndRequestDeposit7540VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ApproveAndRequestDeposit7540VaultHookSession struct {
+	Contract     *ApproveAndRequestDeposit7540VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// ApproveAndRequestDeposit7540VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ApproveAndRequestDeposit7540VaultHookCallerSession struct {
+	Contract *ApproveAndRequestDeposit7540VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                                // Call options to use throughout this session
+}
+
+// ApproveAndRequestDeposit7540VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ApproveAndRequestDeposit7540VaultHookTransactorSession struct {
+	Contract     *ApproveAndRequestDeposit7540VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                                // Transaction auth options to use throughout this session
+}
+
+// ApproveAndRequestDeposit7540VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ApproveAndRequestDeposit7540VaultHookRaw struct {
+	Contract *ApproveAndRequestDeposit7540VaultHook // Generic contract binding to access the raw methods on
+}
+
+// ApproveAndRequestDeposit7540VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ApproveAndRequestDeposit7540VaultHookCallerRaw struct {
+	Contract *ApproveAndRequestDeposit7540VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ApproveAndRequestDeposit7540VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ApproveAndRequestDeposit7540VaultHookTransactorRaw struct {
+	Contract *ApproveAndRequestDeposit7540VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewApproveAndRequestDeposit7540VaultHook creates a new instance of ApproveAndRequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewApproveAndRequestDeposit7540VaultHook(address common.Address, backend bind.ContractBackend) (*ApproveAndRequestDeposit7540VaultHook, error) {
+	contract, err := bindApproveAndRequestDeposit7540VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndRequestDeposit7540VaultHook{ApproveAndRequestDeposit7540VaultHookCaller: ApproveAndRequestDeposit7540VaultHookCaller{contract: contract}, ApproveAndRequestDeposit7540VaultHookTransactor: ApproveAndRequestDeposit7540VaultHookTransactor{contract: contract}, ApproveAndRequestDeposit7540VaultHookFilterer: ApproveAndRequestDeposit7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewApproveAndRequestDeposit7540VaultHookCaller creates a new read-only instance of ApproveAndRequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewApproveAndRequestDeposit7540VaultHookCaller(address common.Address, caller bind.ContractCaller) (*ApproveAndRequestDeposit7540VaultHookCaller, error) {
+	contract, err := bindApproveAndRequestDeposit7540VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndRequestDeposit7540VaultHookCaller{contract: contract}, nil
+}
+
+// NewApproveAndRequestDeposit7540VaultHookTransactor creates a new write-only instance of ApproveAndRequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewApproveAndRequestDeposit7540VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*ApproveAndRequestDeposit7540VaultHookTransactor, error) {
+	contract, err := bindApproveAndRequestDeposit7540VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndRequestDeposit7540VaultHookTransactor{contract: contract}, nil
+}
+
+// NewApproveAndRequestDeposit7540VaultHookFilterer creates a new log filterer instance of ApproveAndRequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewApproveAndRequestDeposit7540VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*ApproveAndRequestDeposit7540VaultHookFilterer, error) {
+	contract, err := bindApproveAndRequestDeposit7540VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndRequestDeposit7540VaultHookFilterer{contract: contract}, nil
+}
+
+// bindApproveAndRequestDeposit7540VaultHook binds a generic wrapper to an already deployed contract.
+func bindApproveAndRequestDeposit7540VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ApproveAndRequestDeposit7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ApproveAndRequestDeposit7540VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ApproveAndRequestDeposit7540VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ApproveAndRequestDeposit7540VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SUBTYPE(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SUBTYPE(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) Asset() (common.Address, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Asset(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) Asset() (common.Address, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Asset(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Build(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Build(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.DecodeAmount(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.DecodeAmount(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.DecodeUsePrevHookAmount(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ExecutionNonce(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ExecutionNonce(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.GetOutAmount(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.GetOutAmount(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) HookType() (uint8, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.HookType(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) HookType() (uint8, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.HookType(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Inspect(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Inspect(&_ApproveAndRequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) LastCaller() (common.Address, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.LastCaller(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.LastCaller(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) SpToken() (common.Address, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SpToken(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SpToken(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) Subtype() ([32]byte, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Subtype(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.Subtype(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndRequestDeposit7540VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.UsedShares(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.UsedShares(&_ApproveAndRequestDeposit7540VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.PostExecute(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.PostExecute(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.PreExecute(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.PreExecute(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ResetExecutionState(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.ResetExecutionState(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SetExecutionContext(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SetExecutionContext(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SetOutAmount(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndRequestDeposit7540VaultHook *ApproveAndRequestDeposit7540VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndRequestDeposit7540VaultHook.Contract.SetOutAmount(&_ApproveAndRequestDeposit7540VaultHook.TransactOpts, _outAmount, caller)
+}
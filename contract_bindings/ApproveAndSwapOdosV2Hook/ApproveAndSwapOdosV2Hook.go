@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ApproveAndSwapOdosV2Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ApproveAndSwapOdosV2HookMetaData contains all meta data concerning the ApproveAndSwapOdosV2Hook contract.
+var ApproveAndSwapOdosV2HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_routerV2\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ODOS_ROUTER_V2\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIOdosRouterV2\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b50604051611c2a380380611c2a83398101604081905261002e916100af565b6040805180820190915260048152630537761760e41b6020909101525f805460ff191690557fbce316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab6080526001600160a01b03811661009e57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a051611b116101195f395f81816102a60152818161080a01528181610d080152610ead01525f81816101dd01526102530152611b115ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c8063685a943c1161009e578063984d6e7a1161006e578063984d6e7a1461024e578063d06a1e8914610275578063e445e7dd14610288578063e50c1f66146102a1578063e7745517146102c8575f5ffd5b8063685a943c1461020157806381cbe691146102095780638c4313c11461021c5780638e1487761461023c575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d1461019657806338d52e0f146101a95780633b5896bc146101bb5780635492e677146101db575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f366004611700565b6102eb565b005b61014461015436600461175d565b610365565b610144610167366004611776565b610386565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a4366004611700565b6103df565b61017e6001600160a01b0360025c1681565b6101ce6101c9366004611700565b610452565b60405161012891906117ce565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61011e61021736600461175d565b61066b565b61022f61022a36600461185b565b610683565b604051610128919061189a565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b61014461028336600461175d565b610858565b5f546102949060ff1681565b60405161012891906118ac565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b6102db6102d63660046118e6565b6108d5565b6040519015158152602001610128565b336001600160a01b038416146103145760405163e15e56c960e01b815260040160405180910390fd5b5f61031e846108e1565b9050610329816108f4565b156103475760405163945b63f560e01b815260040160405180910390fd5b610352816001610901565b61035e85858585610917565b5050505050565b61036e81610978565b50336004805c6001600160a01b0319168217905d5050565b5f610390826108e1565b905061039b816109aa565b806103aa57506103aa816108f4565b156103c85760405163441e4c7360e11b815260040160405180910390fd5b5f6103d48260016109b3565b905083815d50505050565b336001600160a01b038416146104085760405163e15e56c960e01b815260040160405180910390fd5b5f610412846108e1565b905061041d816109aa565b1561043b57604051630bbb04d960e11b815260040160405180910390fd5b610446816001610a08565b61035e85858585610a14565b60605f61046186868686610a56565b90508051600261047191906119ad565b67ffffffffffffffff811115610489576104896118d2565b6040519080825280602002602001820160405280156104d557816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104a75790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161051e94939291906119c0565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061056057610560611a08565b60209081029190910101525f5b81518110156105c15781818151811061058857610588611a08565b60200260200101518382600161059e91906119ad565b815181106105ae576105ae611a08565b602090810291909101015260010161056d565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161060894939291906119c0565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106479190611a1c565b8151811061065757610657611a08565b602002602001018190525050949350505050565b5f61067d610678836108e1565b611033565b92915050565b60605f6106c784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609d9250611040915050565b90505f61071585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610710925086915060bd90506119ad565b6110a2565b905061075585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92018290525092506110a2915050565b61079686868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250603492506110a2915050565b6107d787878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250604892506110a2915050565b6040516bffffffffffffffffffffffff19606094851b8116602083015292841b8316603482015290831b821660488201527f0000000000000000000000000000000000000000000000000000000000000000831b8216605c8201529183901b1660708201526084016040516020818303038152906040529250505092915050565b336001600160a01b0360045c16146108835760405163e15e56c960e01b815260040160405180910390fd5b5f61088d826108e1565b9050610898816109aa565b15806108aa57506108a8816108f4565b155b156108c857604051634bd439b560e11b815260040160405180910390fd5b6108d181611106565b5050565b5f61067d82609c61111d565b5f5f6108ec83611149565b5c9392505050565b5f5f6108ec8360036109b3565b5f61090d8360036109b3565b905081815d505050565b6109726109238461066b565b6109628585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506111b592505050565b61096c9190611a1c565b84611254565b50505050565b5f6003805c908261098883611a2f565b9190505d505f61099783611149565b905060035c80825d505060035c92915050565b5f5f6108ec8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61090d8360026109b3565b61097261096c8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506111b592505050565b6040805160c0810182525f808252602080830182905282840182905260608084018190526080840183905260a084018390528451601f870183900483028101830190955285855293610ac49187908790819084018382808284375f92019190915250609d9250611040915050565b9050610b0985858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060bd925085915061126c9050565b6060830152604080516020601f8701819004810282018101909252858152610b529187908790819084018382808284375f92019190915250610710925085915060bd90506119ad565b6001600160a01b03166080830152604080516020601f8701819004810282018101909252858152610bb49187908790819084018382808284375f92019190915250610ba4925085915060bd90506119ad565b610baf9060146119ad565b611373565b63ffffffff1660a0830152604080516020601f8701819004810282018101909252858152610bfb9187908790819084018382808284375f92018290525092506110a2915050565b6001600160a01b03168252604080516020601f8701819004810282018101909252858152610c459187908790819084018382808284375f9201919091525060149250611040915050565b8260200181815250505f610c9086868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609c925061111d915050565b90508015610d06576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610cdc573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d009190611a47565b60208401525b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166040848101919091528051600480825260a0820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610d4d579050506040805160608101825285516001600160a01b0390811682525f60208301819052878401518451921660248301526044820152929650919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185905f90610df757610df7611a08565b60200260200101819052506040518060600160405280845f01516001600160a01b031681526020015f815260200184604001518560200151604051602401610e549291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185906001908110610e9557610e95611a08565b602002602001018190525060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f8152602001610f20898b8a8a8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506113cf92505050565b856060015186608001518760a00151604051602401610f429493929190611a5e565b60408051601f198184030181529190526020810180516001600160e01b0316630ed8d73960e21b1790529052845185906002908110610f8357610f83611a08565b60200260200101819052506040518060600160405280845f01516001600160a01b031681526020015f815260200184604001515f604051602401610fdc9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b179052905284518590600390811061101d5761101d611a08565b6020026020010181905250505050949350505050565b5f5f6108ec8360016109b3565b5f61104c8260206119ad565b835110156110995760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b5f6110ae8260146119ad565b835110156110f65760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401611090565b500160200151600160601b900490565b611110815f610a08565b61111a815f610901565b50565b5f82828151811061113057611130611a08565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161119892919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f5f6111c28360486110a2565b90506001600160a01b0381166111e45750506001600160a01b0382163161067d565b6040516370a0823160e01b81526001600160a01b0385811660048301528216906370a0823190602401602060405180830381865afa158015611228573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061124c9190611a47565b949350505050565b5f61125e826108e1565b90505f6103d48260016109b3565b60608182601f0110156112b25760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401611090565b6112bc82846119ad565b845110156113005760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401611090565b60608215801561131e5760405191505f825260208201604052611368565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101561135757805183526020928301920161133f565b5050858452601f01601f1916604052505b5090505b9392505050565b5f61137f8260046119ad565b835110156113c65760405162461bcd60e51b8152602060048201526014602482015273746f55696e7433325f6f75744f66426f756e647360601b6044820152606401611090565b50016004015190565b6040805160e0810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c081018290529061141183826110a2565b90505f61141f846014611040565b90505f61142d8560346110a2565b90505f61143b8660486110a2565b90505f61144987605c611040565b90505f61145788607c611040565b90505f61146589609c61111d565b905080156114e9576040516381cbe69160e01b81526001600160a01b038c811660048301528791908c16906381cbe69190602401602060405180830381865afa1580156114b4573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906114d89190611a47565b96506114e5878285611534565b9250505b506040805160e0810182526001600160a01b0397881681526020810196909652938616938501939093529084166060840152608083015260a0820152941660c0850152509192915050565b5f825f0361154357508061136c565b8284146115bc578284111561158c575f61156a6115608587611a1c565b620186a0866115c3565b905061157a8382620186a06115c3565b61158490846119ad565b9250506115bc565b5f61159a6115608686611a1c565b90505f6115ab8483620186a06115c3565b90506115b78185611a1c565b935050505b5092915050565b5f5f5f6115d08686611673565b91509150815f036115f4578381816115ea576115ea611af0565b049250505061136c565b81841161160b5761160b600385150260111861168f565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b80356001600160a01b03811681146116b6575f5ffd5b919050565b5f5f83601f8401126116cb575f5ffd5b50813567ffffffffffffffff8111156116e2575f5ffd5b6020830191508360208285010111156116f9575f5ffd5b9250929050565b5f5f5f5f60608587031215611713575f5ffd5b61171c856116a0565b935061172a602086016116a0565b9250604085013567ffffffffffffffff811115611745575f5ffd5b611751878288016116bb565b95989497509550505050565b5f6020828403121561176d575f5ffd5b61136c826116a0565b5f5f60408385031215611787575f5ffd5b82359150611797602084016116a0565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561184f57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611839908701826117a0565b95505060209384019391909101906001016117f4565b50929695505050505050565b5f5f6020838503121561186c575f5ffd5b823567ffffffffffffffff811115611882575f5ffd5b61188e858286016116bb565b90969095509350505050565b602081525f61136c60208301846117a0565b60208101600383106118cc57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f602082840312156118f6575f5ffd5b813567ffffffffffffffff81111561190c575f5ffd5b8201601f8101841361191c575f5ffd5b803567ffffffffffffffff811115611936576119366118d2565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611965576119656118d2565b60405281815282820160200186101561197c575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561067d5761067d611999565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561067d5761067d611999565b5f60018201611a4057611a40611999565b5060010190565b5f60208284031215611a57575f5ffd5b5051919050565b84516001600160a01b039081168252602080870151908301526040808701518216908301526060808701518216908301526080808701519083015260a0868101519083015260c0808701519091169082015261014060e082018190525f90611ac8908301866117a0565b6001600160a01b038516610100840152905063ffffffff831661012083015295945050505050565b634e487b7160e01b5f52601260045260245ffdfea164736f6c634300081e000a",
+}
+
+// ApproveAndSwapOdosV2HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ApproveAndSwapOdosV2HookMetaData.ABI instead.
+var ApproveAndSwapOdosV2HookABI = ApproveAndSwapOdosV2HookMetaData.ABI
+
+// ApproveAndSwapOdosV2HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ApproveAndSwapOdosV2HookMetaData.Bin instead.
+var ApproveAndSwapOdosV2HookBin = ApproveAndSwapOdosV2HookMetaData.Bin
+
+// DeployApproveAndSwapOdosV2Hook deploys a new Ethereum contract, binding an instance of ApproveAndSwapOdosV2Hook to it.
+func DeployApproveAndSwapOdosV2Hook(auth *bind.TransactOpts, backend bind.ContractBackend, _routerV2 common.Address) (common.Address, *types.Transaction, *ApproveAndSwapOdosV2Hook, error) {
+	parsed, err := ApproveAndSwapOdosV2HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ApproveAndSwapOdosV2HookBin), backend, _routerV2)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ApproveAndSwapOdosV2Hook{ApproveAndSwapOdosV2HookCaller: ApproveAndSwapOdosV2HookCaller{contract: contract}, ApproveAndSwapOdosV2HookTransactor: ApproveAndSwapOdosV2HookTransactor{contract: contract}, ApproveAndSwapOdosV2HookFilterer: ApproveAndSwapOdosV2HookFilterer{contract: contract}}, nil
+}
+
+// ApproveAndSwapOdosV2Hook is an auto generated Go binding around an Ethereum contract.
+type ApproveAndSwapOdosV2Hook struct {
+	ApproveAndSwapOdosV2HookCaller     // Read-only binding to the contract
+	ApproveAndSwapOdosV2HookTransactor // Write-only binding to the contract
+	ApproveAndSwapOdosV2HookFilterer   // Log filterer for contract events
+}
+
+// ApproveAndSwapOdosV2HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ApproveAndSwapOdosV2HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndSwapOdosV2HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ApproveAndSwapOdosV2HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndSwapOdosV2HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ApproveAndSwapOdosV2HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveAndSwapOdosV2HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ApproveAndSwapOdosV2HookSession struct {
+	Contract     *ApproveAndSwapOdosV2Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// ApproveAndSwapOdosV2HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ApproveAndSwapOdosV2HookCallerSession struct {
+	Contract *ApproveAndSwapOdosV2HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// ApproveAndSwapOdosV2HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ApproveAndSwapOdosV2HookTransactorSession struct {
+	Contract     *ApproveAndSwapOdosV2HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// ApproveAndSwapOdosV2HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ApproveAndSwapOdosV2HookRaw struct {
+	Contract *ApproveAndSwapOdosV2Hook // Generic contract binding to access the raw methods on
+}
+
+// ApproveAndSwapOdosV2HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ApproveAndSwapOdosV2HookCallerRaw struct {
+	Contract *ApproveAndSwapOdosV2HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ApproveAndSwapOdosV2HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ApproveAndSwapOdosV2HookTransactorRaw struct {
+	Contract *ApproveAndSwapOdosV2HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewApproveAndSwapOdosV2Hook creates a new instance of ApproveAndSwapOdosV2Hook, bound to a specific deployed contract.
+func NewApproveAndSwapOdosV2Hook(address common.Address, backend bind.ContractBackend) (*ApproveAndSwapOdosV2Hook, error) {
+	contract, err := bindApproveAndSwapOdosV2Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndSwapOdosV2Hook{ApproveAndSwapOdosV2HookCaller: ApproveAndSwapOdosV2HookCaller{contract: contract}, ApproveAndSwapOdosV2HookTransactor: ApproveAndSwapOdosV2HookTransactor{contract: contract}, ApproveAndSwapOdosV2HookFilterer: ApproveAndSwapOdosV2HookFilterer{contract: contract}}, nil
+}
+
+// NewApproveAndSwapOdosV2HookCaller creates a new read-only instance of ApproveAndSwapOdosV2Hook, bound to a specific deployed contract.
+func NewApproveAndSwapOdosV2HookCaller(address common.Address, caller bind.ContractCaller) (*ApproveAndSwapOdosV2HookCaller, error) {
+	contract, err := bindApproveAndSwapOdosV2Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndSwapOdosV2HookCaller{contract: contract}, nil
+}
+
+// NewApproveAndSwapOdosV2HookTransactor creates a new write-only instance of ApproveAndSwapOdosV2Hook, bound to a specific deployed contract.
+func NewApproveAndSwapOdosV2HookTransactor(address common.Address, transactor bind.ContractTransactor) (*ApproveAndSwapOdosV2HookTransactor, error) {
+	contract, err := bindApproveAndSwapOdosV2Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndSwapOdosV2HookTransactor{contract: contract}, nil
+}
+
+// NewApproveAndSwapOdosV2HookFilterer creates a new log filterer instance of ApproveAndSwapOdosV2Hook, bound to a specific deployed contract.
+func NewApproveAndSwapOdosV2HookFilterer(address common.Address, filterer bind.ContractFilterer) (*ApproveAndSwapOdosV2HookFilterer, error) {
+	contract, err := bindApproveAndSwapOdosV2Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveAndSwapOdosV2HookFilterer{contract: contract}, nil
+}
+
+// bindApproveAndSwapOdosV2Hook binds a generic wrapper to an already deployed contract.
+func bindApproveAndSwapOdosV2Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ApproveAndSwapOdosV2HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndSwapOdosV2Hook.Contract.ApproveAndSwapOdosV2HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ApproveAndSwapOdosV2HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ApproveAndSwapOdosV2HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveAndSwapOdosV2Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// ODOSROUTERV2 is a free data retrieval call binding the contract method 0xe50c1f66.
+//
+// Solidity: function ODOS_ROUTER_V2() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) ODOSROUTERV2(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "ODOS_ROUTER_V2")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ODOSROUTERV2 is a free data retrieval call binding the contract method 0xe50c1f66.
+//
+// Solidity: function ODOS_ROUTER_V2() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) ODOSROUTERV2() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ODOSROUTERV2(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// ODOSROUTERV2 is a free data retrieval call binding the contract method 0xe50c1f66.
+//
+// Solidity: function ODOS_ROUTER_V2() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) ODOSROUTERV2() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ODOSROUTERV2(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SUBTYPE(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SUBTYPE(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) Asset() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Asset(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) Asset() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Asset(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Build(&_ApproveAndSwapOdosV2Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Build(&_ApproveAndSwapOdosV2Hook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.DecodeUsePrevHookAmount(&_ApproveAndSwapOdosV2Hook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.DecodeUsePrevHookAmount(&_ApproveAndSwapOdosV2Hook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ExecutionNonce(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ExecutionNonce(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.GetOutAmount(&_ApproveAndSwapOdosV2Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.GetOutAmount(&_ApproveAndSwapOdosV2Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) HookType() (uint8, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.HookType(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) HookType() (uint8, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.HookType(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) view returns(bytes)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) view returns(bytes)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Inspect(&_ApproveAndSwapOdosV2Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) view returns(bytes)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Inspect(&_ApproveAndSwapOdosV2Hook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) LastCaller() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.LastCaller(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) LastCaller() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.LastCaller(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) SpToken() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SpToken(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) SpToken() (common.Address, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SpToken(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) Subtype() ([32]byte, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Subtype(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) Subtype() ([32]byte, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.Subtype(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveAndSwapOdosV2Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.UsedShares(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookCallerSession) UsedShares() (*big.Int, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.UsedShares(&_ApproveAndSwapOdosV2Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.PostExecute(&_ApproveAndSwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.PostExecute(&_ApproveAndSwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.PreExecute(&_ApproveAndSwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.PreExecute(&_ApproveAndSwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ResetExecutionState(&_ApproveAndSwapOdosV2Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.ResetExecutionState(&_ApproveAndSwapOdosV2Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SetExecutionContext(&_ApproveAndSwapOdosV2Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SetExecutionContext(&_ApproveAndSwapOdosV2Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SetOutAmount(&_ApproveAndSwapOdosV2Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveAndSwapOdosV2Hook *ApproveAndSwapOdosV2HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveAndSwapOdosV2Hook.Contract.SetOutAmount(&_ApproveAndSwapOdosV2Hook.TransactOpts, _outAmount, caller)
+}
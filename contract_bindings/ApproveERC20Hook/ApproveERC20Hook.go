@@ -0,0 +1,687 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ApproveERC20Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ApproveERC20HookMetaData contains all meta data concerning the ApproveERC20Hook contract.
+var ApproveERC20HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260058152642a37b5b2b760d91b6020909101525f805460ff191690557f1317f51c845ce3bfb7c268e5337a825f12f3d0af9584c2bbfbf4e64e314eaf736080526080516111fb6100785f395f81816101d2015261024801526111fb5ff3fe608060405234801561000f575f5ffd5b5060043610610106575f3560e01c80635492e6771161009e5780638e1487761161006e5780638e14877614610231578063984d6e7a14610243578063d06a1e891461026a578063e445e7dd1461027d578063e774551714610296575f5ffd5b80635492e677146101d0578063685a943c146101f657806381cbe691146101fe5780638c4313c114610211575f5ffd5b80632113522a116100d95780632113522a146101615780632ae2fe3d1461018b57806338d52e0f1461019e5780633b5896bc146101b0575f5ffd5b806301bd43ef1461010a57806305b4fe911461012657806314cb37cf1461013b5780631f2646191461014e575b5f5ffd5b61011360035c81565b6040519081526020015b60405180910390f35b610139610134366004610e89565b6102b9565b005b610139610149366004610ee6565b610333565b61013961015c366004610f06565b610354565b6101736001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161011d565b610139610199366004610e89565b6103ad565b6101736001600160a01b0360025c1681565b6101c36101be366004610e89565b610419565b60405161011d9190610f5e565b7f0000000000000000000000000000000000000000000000000000000000000000610113565b6101135f5c81565b61011361020c366004610ee6565b610632565b61022461021f366004610feb565b61064a565b60405161011d919061102a565b6101736001600160a01b0360015c1681565b6101137f000000000000000000000000000000000000000000000000000000000000000081565b610139610278366004610ee6565b61070c565b5f546102899060ff1681565b60405161011d919061103c565b6102a96102a4366004611076565b610789565b604051901515815260200161011d565b336001600160a01b038416146102e25760405163e15e56c960e01b815260040160405180910390fd5b5f6102ec84610795565b90506102f7816107a8565b156103155760405163945b63f560e01b815260040160405180910390fd5b6103208160016107b5565b61032c858585856107cb565b5050505050565b61033c816108d3565b50336004805c6001600160a01b0319168217905d5050565b5f61035e82610795565b905061036981610905565b806103785750610378816107a8565b156103965760405163441e4c7360e11b815260040160405180910390fd5b5f6103a282600161090e565b905083815d50505050565b336001600160a01b038416146103d65760405163e15e56c960e01b815260040160405180910390fd5b5f6103e084610795565b90506103eb81610905565b1561040957604051630bbb04d960e11b815260040160405180910390fd5b610414816001610963565b61032c565b60605f6104288686868661096f565b905080516002610438919061113d565b67ffffffffffffffff81111561045057610450611062565b60405190808252806020026020018201604052801561049c57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161046e5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104e59493929190611150565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061052757610527611198565b60209081029190910101525f5b81518110156105885781818151811061054f5761054f611198565b602002602001015183826001610565919061113d565b8151811061057557610575611198565b6020908102919091010152600101610534565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105cf9493929190611150565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161060e91906111ac565b8151811061061e5761061e611198565b602002602001018190525050949350505050565b5f61064461063f83610795565b610c8f565b92915050565b606061068a83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610c9c915050565b6106cb84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610c9c915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b336001600160a01b0360045c16146107375760405163e15e56c960e01b815260040160405180910390fd5b5f61074182610795565b905061074c81610905565b158061075e575061075c816107a8565b155b1561077c57604051634bd439b560e11b815260040160405180910390fd5b61078581610d05565b5050565b5f610644826048610d1c565b5f5f6107a083610d48565b5c9392505050565b5f5f6107a083600361090e565b5f6107c183600361090e565b905081815d505050565b5f61080a83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610c9c915050565b90505f61084e84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610c9c915050565b604051636eb1769f60e11b81526001600160a01b03878116600483015280831660248301529192506108cb9184169063dd62ed3e90604401602060405180830381865afa1580156108a1573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108c591906111bf565b86610db4565b505050505050565b5f6003805c90826108e3836111d6565b9190505d505f6108f283610d48565b905060035c80825d505060035c92915050565b5f5f6107a08360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107c183600261090e565b60605f6109b084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610c9c915050565b90505f6109f485858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610c9c915050565b90505f610a3886868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060289250610dcc915050565b90505f610a7c87878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060489250610d1c915050565b90508015610aef576040516381cbe69160e01b81526001600160a01b0389811660048301528a16906381cbe69190602401602060405180830381865afa158015610ac8573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610aec91906111bf565b91505b6001600160a01b0384161580610b0c57506001600160a01b038316155b15610b2a57604051630f58648f60e01b815260040160405180910390fd5b6040805160028082526060820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b4057905050604080516060810182526001600160a01b0380881682525f60208301819052835191881660248301526044820152929750919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186905f90610be557610be5611198565b60200260200101819052506040518060600160405280856001600160a01b031681526020015f81526020018484604051602401610c379291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052855186906001908110610c7857610c78611198565b602002602001018190525050505050949350505050565b5f5f6107a083600161090e565b5f610ca882601461113d565b83511015610cf55760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b610d0f815f610963565b610d19815f6107b5565b50565b5f828281518110610d2f57610d2f611198565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610d9792919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610dbe82610795565b90505f6103a282600161090e565b5f610dd882602061113d565b83511015610e205760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610cec565b50016020015190565b80356001600160a01b0381168114610e3f575f5ffd5b919050565b5f5f83601f840112610e54575f5ffd5b50813567ffffffffffffffff811115610e6b575f5ffd5b602083019150836020828501011115610e82575f5ffd5b9250929050565b5f5f5f5f60608587031215610e9c575f5ffd5b610ea585610e29565b9350610eb360208601610e29565b9250604085013567ffffffffffffffff811115610ece575f5ffd5b610eda87828801610e44565b95989497509550505050565b5f60208284031215610ef6575f5ffd5b610eff82610e29565b9392505050565b5f5f60408385031215610f17575f5ffd5b82359150610f2760208401610e29565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610fdf57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610fc990870182610f30565b9550506020938401939190910190600101610f84565b50929695505050505050565b5f5f60208385031215610ffc575f5ffd5b823567ffffffffffffffff811115611012575f5ffd5b61101e85828601610e44565b90969095509350505050565b602081525f610eff6020830184610f30565b602081016003831061105c57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611086575f5ffd5b813567ffffffffffffffff81111561109c575f5ffd5b8201601f810184136110ac575f5ffd5b803567ffffffffffffffff8111156110c6576110c6611062565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156110f5576110f5611062565b60405281815282820160200186101561110c575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561064457610644611129565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561064457610644611129565b5f602082840312156111cf575f5ffd5b5051919050565b5f600182016111e7576111e7611129565b506001019056fea164736f6c634300081e000a",
+}
+
+// ApproveERC20HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ApproveERC20HookMetaData.ABI instead.
+var ApproveERC20HookABI = ApproveERC20HookMetaData.ABI
+
+// ApproveERC20HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ApproveERC20HookMetaData.Bin instead.
+var ApproveERC20HookBin = ApproveERC20HookMetaData.Bin
+
+// DeployApproveERC20Hook deploys a new Ethereum contract, binding an instance of ApproveERC20Hook to it.
+func DeployApproveERC20Hook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ApproveERC20Hook, error) {
+	parsed, err := ApproveERC20HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ApproveERC20HookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ApproveERC20Hook{ApproveERC20HookCaller: ApproveERC20HookCaller{contract: contract}, ApproveERC20HookTransactor: ApproveERC20HookTransactor{contract: contract}, ApproveERC20HookFilterer: ApproveERC20HookFilterer{contract: contract}}, nil
+}
+
+// ApproveERC20Hook is an auto generated Go binding around an Ethereum contract.
+type ApproveERC20Hook struct {
+	ApproveERC20HookCaller     // Read-only binding to the contract
+	ApproveERC20HookTransactor // Write-only binding to the contract
+	ApproveERC20HookFilterer   // Log filterer for contract events
+}
+
+// ApproveERC20HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ApproveERC20HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveERC20HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ApproveERC20HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveERC20HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ApproveERC20HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ApproveERC20HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ApproveERC20HookSession struct {
+	Contract     *ApproveERC20Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// ApproveERC20HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ApproveERC20HookCallerSession struct {
+	Contract *ApproveERC20HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// ApproveERC20HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ApproveERC20HookTransactorSession struct {
+	Contract     *ApproveERC20HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// ApproveERC20HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ApproveERC20HookRaw struct {
+	Contract *ApproveERC20Hook // Generic contract binding to access the raw methods on
+}
+
+// ApproveERC20HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ApproveERC20HookCallerRaw struct {
+	Contract *ApproveERC20HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ApproveERC20HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ApproveERC20HookTransactorRaw struct {
+	Contract *ApproveERC20HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewApproveERC20Hook creates a new instance of ApproveERC20Hook, bound to a specific deployed contract.
+func NewApproveERC20Hook(address common.Address, backend bind.ContractBackend) (*ApproveERC20Hook, error) {
+	contract, err := bindApproveERC20Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveERC20Hook{ApproveERC20HookCaller: ApproveERC20HookCaller{contract: contract}, ApproveERC20HookTransactor: ApproveERC20HookTransactor{contract: contract}, ApproveERC20HookFilterer: ApproveERC20HookFilterer{contract: contract}}, nil
+}
+
+// NewApproveERC20HookCaller creates a new read-only instance of ApproveERC20Hook, bound to a specific deployed contract.
+func NewApproveERC20HookCaller(address common.Address, caller bind.ContractCaller) (*ApproveERC20HookCaller, error) {
+	contract, err := bindApproveERC20Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveERC20HookCaller{contract: contract}, nil
+}
+
+// NewApproveERC20HookTransactor creates a new write-only instance of ApproveERC20Hook, bound to a specific deployed contract.
+func NewApproveERC20HookTransactor(address common.Address, transactor bind.ContractTransactor) (*ApproveERC20HookTransactor, error) {
+	contract, err := bindApproveERC20Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveERC20HookTransactor{contract: contract}, nil
+}
+
+// NewApproveERC20HookFilterer creates a new log filterer instance of ApproveERC20Hook, bound to a specific deployed contract.
+func NewApproveERC20HookFilterer(address common.Address, filterer bind.ContractFilterer) (*ApproveERC20HookFilterer, error) {
+	contract, err := bindApproveERC20Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ApproveERC20HookFilterer{contract: contract}, nil
+}
+
+// bindApproveERC20Hook binds a generic wrapper to an already deployed contract.
+func bindApproveERC20Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ApproveERC20HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveERC20Hook *ApproveERC20HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveERC20Hook.Contract.ApproveERC20HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveERC20Hook *ApproveERC20HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.ApproveERC20HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveERC20Hook *ApproveERC20HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.ApproveERC20HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ApproveERC20Hook *ApproveERC20HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ApproveERC20Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ApproveERC20Hook *ApproveERC20HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ApproveERC20Hook *ApproveERC20HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveERC20Hook *ApproveERC20HookSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveERC20Hook.Contract.SUBTYPE(&_ApproveERC20Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ApproveERC20Hook.Contract.SUBTYPE(&_ApproveERC20Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookSession) Asset() (common.Address, error) {
+	return _ApproveERC20Hook.Contract.Asset(&_ApproveERC20Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) Asset() (common.Address, error) {
+	return _ApproveERC20Hook.Contract.Asset(&_ApproveERC20Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveERC20Hook *ApproveERC20HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveERC20Hook.Contract.Build(&_ApproveERC20Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ApproveERC20Hook.Contract.Build(&_ApproveERC20Hook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveERC20Hook *ApproveERC20HookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveERC20Hook.Contract.DecodeUsePrevHookAmount(&_ApproveERC20Hook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _ApproveERC20Hook.Contract.DecodeUsePrevHookAmount(&_ApproveERC20Hook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveERC20Hook.Contract.ExecutionNonce(&_ApproveERC20Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ApproveERC20Hook.Contract.ExecutionNonce(&_ApproveERC20Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveERC20Hook.Contract.GetOutAmount(&_ApproveERC20Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ApproveERC20Hook.Contract.GetOutAmount(&_ApproveERC20Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveERC20Hook *ApproveERC20HookSession) HookType() (uint8, error) {
+	return _ApproveERC20Hook.Contract.HookType(&_ApproveERC20Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) HookType() (uint8, error) {
+	return _ApproveERC20Hook.Contract.HookType(&_ApproveERC20Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveERC20Hook *ApproveERC20HookSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveERC20Hook.Contract.Inspect(&_ApproveERC20Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ApproveERC20Hook.Contract.Inspect(&_ApproveERC20Hook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookSession) LastCaller() (common.Address, error) {
+	return _ApproveERC20Hook.Contract.LastCaller(&_ApproveERC20Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) LastCaller() (common.Address, error) {
+	return _ApproveERC20Hook.Contract.LastCaller(&_ApproveERC20Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookSession) SpToken() (common.Address, error) {
+	return _ApproveERC20Hook.Contract.SpToken(&_ApproveERC20Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) SpToken() (common.Address, error) {
+	return _ApproveERC20Hook.Contract.SpToken(&_ApproveERC20Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveERC20Hook *ApproveERC20HookSession) Subtype() ([32]byte, error) {
+	return _ApproveERC20Hook.Contract.Subtype(&_ApproveERC20Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) Subtype() ([32]byte, error) {
+	return _ApproveERC20Hook.Contract.Subtype(&_ApproveERC20Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ApproveERC20Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookSession) UsedShares() (*big.Int, error) {
+	return _ApproveERC20Hook.Contract.UsedShares(&_ApproveERC20Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ApproveERC20Hook *ApproveERC20HookCallerSession) UsedShares() (*big.Int, error) {
+	return _ApproveERC20Hook.Contract.UsedShares(&_ApproveERC20Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveERC20Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveERC20Hook *ApproveERC20HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.PostExecute(&_ApproveERC20Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.PostExecute(&_ApproveERC20Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveERC20Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveERC20Hook *ApproveERC20HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.PreExecute(&_ApproveERC20Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.PreExecute(&_ApproveERC20Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.ResetExecutionState(&_ApproveERC20Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.ResetExecutionState(&_ApproveERC20Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.SetExecutionContext(&_ApproveERC20Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.SetExecutionContext(&_ApproveERC20Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.SetOutAmount(&_ApproveERC20Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ApproveERC20Hook *ApproveERC20HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ApproveERC20Hook.Contract.SetOutAmount(&_ApproveERC20Hook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package BatchTransferFromHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// BatchTransferFromHookMetaData contains all meta data concerning the BatchTransferFromHook contract.
+var BatchTransferFromHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"permit2_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"PERMIT_2\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"PERMIT_2_INTERFACE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIPermit2\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"packed\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INSUFFICIENT_BALANCE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ARRAY_LENGTH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeCastOverflowedUintDowncast\",\"inputs\":[{\"name\":\"bits\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60e060405234801561000f575f5ffd5b50604051611a3a380380611a3a83398101604081905261002e916100b5565b6040805180820190915260058152642a37b5b2b760d91b6020909101525f805460ff191690557f1317f51c845ce3bfb7c268e5337a825f12f3d0af9584c2bbfbf4e64e314eaf736080526001600160a01b03811661009f57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a081905260c0526100e2565b5f602082840312156100c5575f5ffd5b81516001600160a01b03811681146100db575f5ffd5b9392505050565b60805160a05160c0516119196101215f395f6102a101525f818161020e01528181610ede0152610fa001525f81816101dd015261027a01526119195ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c8063685a943c1161009e5780638e1487761161006e5780638e14877614610263578063984d6e7a146102755780639d0102171461029c578063d06a1e89146102c3578063e445e7dd146102d6575f5ffd5b8063685a943c14610201578063789d72fa1461020957806381cbe691146102305780638c4313c114610243575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d1461019657806338d52e0f146101a95780633b5896bc146101bb5780635492e677146101db575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f3660046114ba565b6102ef565b005b610144610154366004611517565b61035d565b610144610167366004611537565b61037e565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a43660046114ba565b6103d7565b61017e6001600160a01b0360025c1681565b6101ce6101c93660046114ba565b61043e565b604051610128919061158f565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b61011e61023e366004611517565b610657565b61025661025136600461161c565b61066f565b604051610128919061165b565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b6101446102d1366004611517565b610868565b5f546102e29060ff1681565b604051610128919061166d565b336001600160a01b038416146103185760405163e15e56c960e01b815260040160405180910390fd5b5f610322846108e5565b905061032d816108f8565b1561034b5760405163945b63f560e01b815260040160405180910390fd5b610356816001610905565b5050505050565b6103668161091b565b50336004805c6001600160a01b0319168217905d5050565b5f610388826108e5565b90506103938161094d565b806103a257506103a2816108f8565b156103c05760405163441e4c7360e11b815260040160405180910390fd5b5f6103cc826001610956565b905083815d50505050565b336001600160a01b038416146104005760405163e15e56c960e01b815260040160405180910390fd5b5f61040a846108e5565b90506104158161094d565b1561043357604051630bbb04d960e11b815260040160405180910390fd5b6103568160016109ab565b60605f61044d868686866109b7565b90508051600261045d91906116a7565b67ffffffffffffffff811115610475576104756116ba565b6040519080825280602002602001820160405280156104c157816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104935790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161050a94939291906116ce565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061054c5761054c611716565b60209081029190910101525f5b81518110156105ad5781818151811061057457610574611716565b60200260200101518382600161058a91906116a7565b8151811061059a5761059a611716565b6020908102919091010152600101610559565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105f494939291906116ce565b604051602081830303815290604052915060e01b6020820180516001600160e01b0383818316178352505050508152508260018451610633919061172a565b8151811061064357610643611716565b602002602001018190525050949350505050565b5f610669610664836108e5565b610fff565b92915050565b60605f6106b384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506014925061100c915050565b90505f61070385858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250605492506106fe9150869050601461173d565b61106e565b90505f8267ffffffffffffffff81111561071f5761071f6116ba565b604051908082528060200260200182016040528015610748578160200160208202803683370190505b5090505f5b8381101561079b576107698361076483601461173d565b611173565b82828151811061077b5761077b611716565b6001600160a01b039092166020928302919091019091015260010161074d565b506107da86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250611173915050565b6040516020016107fd919060609190911b6001600160601b031916815260140190565b60405160208183030381529060405293505f5b8381101561085e578482828151811061082b5761082b611716565b6020026020010151604051602001610844929190611754565b60408051601f198184030181529190529450600101610810565b5050505092915050565b336001600160a01b0360045c16146108935760405163e15e56c960e01b815260040160405180910390fd5b5f61089d826108e5565b90506108a88161094d565b15806108ba57506108b8816108f8565b155b156108d857604051634bd439b560e11b815260040160405180910390fd5b6108e1816111d7565b5050565b5f5f6108f0836111ee565b5c9392505050565b5f5f6108f0836003610956565b5f610911836003610956565b905081815d505050565b5f6003805c908261092b8361177e565b9190505d505f61093a836111ee565b905060035c80825d505060035c92915050565b5f5f6108f08360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610911836002610956565b60606109c16113d8565b6109ff84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250611173915050565b6001600160a01b0316808252610a2857604051630f58648f60e01b815260040160405180910390fd5b610a6984848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506014925061100c915050565b602082018190525f03610a8f5760405163c6a335ff60e01b815260040160405180910390fd5b610ad084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506034925061100c915050565b816040018181525050610b2484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525050506020840151605491506106fe90601461173d565b6060820152604080516020601f8601819004810282018101909252848152610b899186908690819084018382808284375f9201919091525050506020840151610b6f9150601461173d565b610b7a9060546116a7565b6020808501516106fe9161173d565b6080820152604080516020601f8601819004810282018101909252848152610c089186908690819084018382808284375f92019190915250505050602083810151610bd39161173d565b6020840151610be390601461173d565b610bee9060546116a7565b610bf891906116a7565b60208401516106fe90600661173d565b60a0820152604080516020601f8601819004810282018101909252848152610c589186908690819084018382808284375f92019190915250610c5192506041915087905061172a565b604161106e565b60c08201526040805160028082526060820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610c73579050509150806020015167ffffffffffffffff811115610cc057610cc06116ba565b604051908082528060200260200182016040528015610d1057816020015b604080516080810182525f8082526020808301829052928201819052606082015282525f19909201910181610cde5790505b5060e08201525f5b8160200151811015610e58575f610d3a8360600151836014610764919061173d565b90505f610d578460800151846020610d52919061173d565b61100c565b90505f610d768560a00151856006610d6f919061173d565b600661106e565b90505f60d0610d8483611796565b901c90506001600160a01b038416610daf57604051630f58648f60e01b815260040160405180910390fd5b825f03610dcf576040516305112ab160e41b815260040160405180910390fd5b6040518060800160405280856001600160a01b03168152602001610df285611255565b6001600160a01b03168152602001610e0d886040015161128c565b65ffffffffffff1681526020018265ffffffffffff168152508660e001518681518110610e3c57610e3c611716565b6020026020010181905250505050508080600101915050610d18565b506040805160608101825260e083015181526001600160a01b038716602082015282820151818301526101008301819052825160c084015192515f93610ea193916024016117bc565b60408051601f19818403018152918152602080830180516001600160e01b0316632a2d80d160e01b17905281516060810183526001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001681525f91810182905291820183905285519293509091859190610f2357610f23611716565b6020026020010181905250610f4a825f0151878460600151856080015186602001516112be565b61012083018190526040515f91610f6391602401611895565b60408051601f19818403018152918152602080830180516001600160e01b0316630d58b1db60e01b17905281516060810183526001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001681525f9181019190915290810182905285519192509085906001908110610fe957610fe9611716565b6020026020010181905250505050949350505050565b5f5f6108f0836001610956565b5f6110188260206116a7565b835110156110655760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b60608182601f0110156110b45760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b604482015260640161105c565b6110be82846116a7565b845110156111025760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b604482015260640161105c565b6060821580156111205760405191505f82526020820160405261116a565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015611159578051835260209283019201611141565b5050858452601f01601f1916604052505b50949350505050565b5f61117f8260146116a7565b835110156111c75760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640161105c565b500160200151600160601b900490565b6111e1815f6109ab565b6111eb815f610905565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161123892919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f6001600160a01b03821115611288576040516306dfcc6560e41b815260a060048201526024810183905260440161105c565b5090565b5f65ffffffffffff821115611288576040516306dfcc6560e41b8152603060048201526024810183905260440161105c565b60608167ffffffffffffffff8111156112d9576112d96116ba565b60405190808252806020026020018201604052801561132957816020015b604080516080810182525f8082526020808301829052928201819052606082015282525f199092019101816112f75790505b5090505f5b828110156113ce575f6113468661076484601461173d565b90505f61135886610d5285602061173d565b905060405180608001604052808a6001600160a01b03168152602001896001600160a01b0316815260200161138c83611255565b6001600160a01b03168152602001836001600160a01b03168152508484815181106113b9576113b9611716565b6020908102919091010152505060010161132e565b5095945050505050565b6040518061014001604052805f6001600160a01b031681526020015f81526020015f8152602001606081526020016060815260200160608152602001606081526020016060815260200161144d6040518060600160405280606081526020015f6001600160a01b031681526020015f81525090565b8152602001606081525090565b80356001600160a01b0381168114611470575f5ffd5b919050565b5f5f83601f840112611485575f5ffd5b50813567ffffffffffffffff81111561149c575f5ffd5b6020830191508360208285010111156114b3575f5ffd5b9250929050565b5f5f5f5f606085870312156114cd575f5ffd5b6114d68561145a565b93506114e46020860161145a565b9250604085013567ffffffffffffffff8111156114ff575f5ffd5b61150b87828801611475565b95989497509550505050565b5f60208284031215611527575f5ffd5b6115308261145a565b9392505050565b5f5f60408385031215611548575f5ffd5b823591506115586020840161145a565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561161057868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906115fa90870182611561565b95505060209384019391909101906001016115b5565b50929695505050505050565b5f5f6020838503121561162d575f5ffd5b823567ffffffffffffffff811115611643575f5ffd5b61164f85828601611475565b90969095509350505050565b602081525f6115306020830184611561565b602081016003831061168d57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561066957610669611693565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561066957610669611693565b808202811582820484141761066957610669611693565b5f83518060208601845e60609390931b6001600160601b0319169190920190815260140192915050565b5f6001820161178f5761178f611693565b5060010190565b805160208083015191908110156117b6575f198160200360031b1b821691505b50919050565b6001600160a01b038416815260606020808301829052845182840192909252815160c084018190525f9290910190829060e08501905b8083101561185657835160018060a01b03815116835260018060a01b03602082015116602084015265ffffffffffff604082015116604084015265ffffffffffff6060820151166060840152506080820191506020840193506001830192506117f2565b5060208701516001600160a01b03811660808701529250604087015160a086015284810360408601526118898187611561565b98975050505050505050565b602080825282518282018190525f918401906040840190835b8181101561190157835180516001600160a01b03908116855260208083015182168187015260408084015183169087015260609283015190911691850191909152909301926080909201916001016118ae565b50909594505050505056fea164736f6c634300081e000a",
+}
+
+// BatchTransferFromHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use BatchTransferFromHookMetaData.ABI instead.
+var BatchTransferFromHookABI = BatchTransferFromHookMetaData.ABI
+
+// BatchTransferFromHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use BatchTransferFromHookMetaData.Bin instead.
+var BatchTransferFromHookBin = BatchTransferFromHookMetaData.Bin
+
+// DeployBatchTransferFromHook deploys a new Ethereum contract, binding an instance of BatchTransferFromHook to it.
+func DeployBatchTransferFromHook(auth *bind.TransactOpts, backend bind.ContractBackend, permit2_ common.Address) (common.Address, *types.Transaction, *BatchTransferFromHook, error) {
+	parsed, err := BatchTransferFromHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BatchTransferFromHookBin), backend, permit2_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &BatchTransferFromHook{BatchTransferFromHookCaller: BatchTransferFromHookCaller{contract: contract}, BatchTransferFromHookTransactor: BatchTransferFromHookTransactor{contract: contract}, BatchTransferFromHookFilterer: BatchTransferFromHookFilterer{contract: contract}}, nil
+}
+
+// BatchTransferFromHook is an auto generated Go binding around an Ethereum contract.
+type BatchTransferFromHook struct {
+	BatchTransferFromHookCaller     // Read-only binding to the contract
+	BatchTransferFromHookTransactor // Write-only binding to the contract
+	BatchTransferFromHookFilterer   // Log filterer for contract events
+}
+
+// BatchTransferFromHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BatchTransferFromHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BatchTransferFromHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BatchTransferFromHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BatchTransferFromHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BatchTransferFromHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BatchTransferFromHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BatchTransferFromHookSession struct {
+	Contract     *BatchTransferFromHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts          // Call options to use throughout this session
+	TransactOpts bind.TransactOpts      // Transaction auth options to use throughout this session
+}
+
+// BatchTransferFromHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BatchTransferFromHookCallerSession struct {
+	Contract *BatchTransferFromHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                // Call options to use throughout this session
+}
+
+// BatchTransferFromHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BatchTransferFromHookTransactorSession struct {
+	Contract     *BatchTransferFromHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                // Transaction auth options to use throughout this session
+}
+
+// BatchTransferFromHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BatchTransferFromHookRaw struct {
+	Contract *BatchTransferFromHook // Generic contract binding to access the raw methods on
+}
+
+// BatchTransferFromHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BatchTransferFromHookCallerRaw struct {
+	Contract *BatchTransferFromHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BatchTransferFromHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BatchTransferFromHookTransactorRaw struct {
+	Contract *BatchTransferFromHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBatchTransferFromHook creates a new instance of BatchTransferFromHook, bound to a specific deployed contract.
+func NewBatchTransferFromHook(address common.Address, backend bind.ContractBackend) (*BatchTransferFromHook, error) {
+	contract, err := bindBatchTransferFromHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferFromHook{BatchTransferFromHookCaller: BatchTransferFromHookCaller{contract: contract}, BatchTransferFromHookTransactor: BatchTransferFromHookTransactor{contract: contract}, BatchTransferFromHookFilterer: BatchTransferFromHookFilterer{contract: contract}}, nil
+}
+
+// NewBatchTransferFromHookCaller creates a new read-only instance of BatchTransferFromHook, bound to a specific deployed contract.
+func NewBatchTransferFromHookCaller(address common.Address, caller bind.ContractCaller) (*BatchTransferFromHookCaller, error) {
+	contract, err := bindBatchTransferFromHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferFromHookCaller{contract: contract}, nil
+}
+
+// NewBatchTransferFromHookTransactor creates a new write-only instance of BatchTransferFromHook, bound to a specific deployed contract.
+func NewBatchTransferFromHookTransactor(address common.Address, transactor bind.ContractTransactor) (*BatchTransferFromHookTransactor, error) {
+	contract, err := bindBatchTransferFromHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferFromHookTransactor{contract: contract}, nil
+}
+
+// NewBatchTransferFromHookFilterer creates a new log filterer instance of BatchTransferFromHook, bound to a specific deployed contract.
+func NewBatchTransferFromHookFilterer(address common.Address, filterer bind.ContractFilterer) (*BatchTransferFromHookFilterer, error) {
+	contract, err := bindBatchTransferFromHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferFromHookFilterer{contract: contract}, nil
+}
+
+// bindBatchTransferFromHook binds a generic wrapper to an already deployed contract.
+func bindBatchTransferFromHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BatchTransferFromHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BatchTransferFromHook *BatchTransferFromHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BatchTransferFromHook.Contract.BatchTransferFromHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BatchTransferFromHook *BatchTransferFromHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.BatchTransferFromHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BatchTransferFromHook *BatchTransferFromHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.BatchTransferFromHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BatchTransferFromHook *BatchTransferFromHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BatchTransferFromHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// PERMIT2 is a free data retrieval call binding the contract method 0x789d72fa.
+//
+// Solidity: function PERMIT_2() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) PERMIT2(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "PERMIT_2")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PERMIT2 is a free data retrieval call binding the contract method 0x789d72fa.
+//
+// Solidity: function PERMIT_2() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) PERMIT2() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.PERMIT2(&_BatchTransferFromHook.CallOpts)
+}
+
+// PERMIT2 is a free data retrieval call binding the contract method 0x789d72fa.
+//
+// Solidity: function PERMIT_2() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) PERMIT2() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.PERMIT2(&_BatchTransferFromHook.CallOpts)
+}
+
+// PERMIT2INTERFACE is a free data retrieval call binding the contract method 0x9d010217.
+//
+// Solidity: function PERMIT_2_INTERFACE() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) PERMIT2INTERFACE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "PERMIT_2_INTERFACE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PERMIT2INTERFACE is a free data retrieval call binding the contract method 0x9d010217.
+//
+// Solidity: function PERMIT_2_INTERFACE() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) PERMIT2INTERFACE() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.PERMIT2INTERFACE(&_BatchTransferFromHook.CallOpts)
+}
+
+// PERMIT2INTERFACE is a free data retrieval call binding the contract method 0x9d010217.
+//
+// Solidity: function PERMIT_2_INTERFACE() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) PERMIT2INTERFACE() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.PERMIT2INTERFACE(&_BatchTransferFromHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) SUBTYPE() ([32]byte, error) {
+	return _BatchTransferFromHook.Contract.SUBTYPE(&_BatchTransferFromHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _BatchTransferFromHook.Contract.SUBTYPE(&_BatchTransferFromHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) Asset() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.Asset(&_BatchTransferFromHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) Asset() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.Asset(&_BatchTransferFromHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _BatchTransferFromHook.Contract.Build(&_BatchTransferFromHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _BatchTransferFromHook.Contract.Build(&_BatchTransferFromHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) ExecutionNonce() (*big.Int, error) {
+	return _BatchTransferFromHook.Contract.ExecutionNonce(&_BatchTransferFromHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _BatchTransferFromHook.Contract.ExecutionNonce(&_BatchTransferFromHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _BatchTransferFromHook.Contract.GetOutAmount(&_BatchTransferFromHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _BatchTransferFromHook.Contract.GetOutAmount(&_BatchTransferFromHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) HookType() (uint8, error) {
+	return _BatchTransferFromHook.Contract.HookType(&_BatchTransferFromHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) HookType() (uint8, error) {
+	return _BatchTransferFromHook.Contract.HookType(&_BatchTransferFromHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes packed)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes packed)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) Inspect(data []byte) ([]byte, error) {
+	return _BatchTransferFromHook.Contract.Inspect(&_BatchTransferFromHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes packed)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _BatchTransferFromHook.Contract.Inspect(&_BatchTransferFromHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) LastCaller() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.LastCaller(&_BatchTransferFromHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) LastCaller() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.LastCaller(&_BatchTransferFromHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) SpToken() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.SpToken(&_BatchTransferFromHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) SpToken() (common.Address, error) {
+	return _BatchTransferFromHook.Contract.SpToken(&_BatchTransferFromHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) Subtype() ([32]byte, error) {
+	return _BatchTransferFromHook.Contract.Subtype(&_BatchTransferFromHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) Subtype() ([32]byte, error) {
+	return _BatchTransferFromHook.Contract.Subtype(&_BatchTransferFromHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BatchTransferFromHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookSession) UsedShares() (*big.Int, error) {
+	return _BatchTransferFromHook.Contract.UsedShares(&_BatchTransferFromHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BatchTransferFromHook *BatchTransferFromHookCallerSession) UsedShares() (*big.Int, error) {
+	return _BatchTransferFromHook.Contract.UsedShares(&_BatchTransferFromHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferFromHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.PostExecute(&_BatchTransferFromHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.PostExecute(&_BatchTransferFromHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferFromHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.PreExecute(&_BatchTransferFromHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.PreExecute(&_BatchTransferFromHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.ResetExecutionState(&_BatchTransferFromHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.ResetExecutionState(&_BatchTransferFromHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.SetExecutionContext(&_BatchTransferFromHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.SetExecutionContext(&_BatchTransferFromHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.SetOutAmount(&_BatchTransferFromHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BatchTransferFromHook *BatchTransferFromHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferFromHook.Contract.SetOutAmount(&_BatchTransferFromHook.TransactOpts, _outAmount, caller)
+}
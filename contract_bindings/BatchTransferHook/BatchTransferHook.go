@@ -0,0 +1,687 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package BatchTransferHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// BatchTransferHookMetaData contains all meta data concerning the BatchTransferHook contract.
+var BatchTransferHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_nativeToken\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"NATIVE_TOKEN\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"result\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161132b38038061132b83398101604081905261002e91610089565b6040805180820190915260058152642a37b5b2b760d91b6020909101525f805460ff191690557f1317f51c845ce3bfb7c268e5337a825f12f3d0af9584c2bbfbf4e64e314eaf736080526001600160a01b031660a0526100b6565b5f60208284031215610099575f5ffd5b81516001600160a01b03811681146100af575f5ffd5b9392505050565b60805160a0516112466100e55f395f81816101a30152610a0201525f81816101f9015261026f01526112465ff3fe608060405234801561000f575f5ffd5b5060043610610106575f3560e01c80633b5896bc1161009e5780638c4313c11161006e5780638c4313c1146102385780638e14877614610258578063984d6e7a1461026a578063d06a1e8914610291578063e445e7dd146102a4575f5ffd5b80633b5896bc146101d75780635492e677146101f7578063685a943c1461021d57806381cbe69114610225575f5ffd5b80632113522a116100d95780632113522a146101615780632ae2fe3d1461018b57806331f7d9641461019e57806338d52e0f146101c5575f5ffd5b806301bd43ef1461010a57806305b4fe911461012657806314cb37cf1461013b5780631f2646191461014e575b5f5ffd5b61011360035c81565b6040519081526020015b60405180910390f35b610139610134366004610de5565b6102bd565b005b610139610149366004610e46565b61032b565b61013961015c366004610e68565b61034c565b6101736001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161011d565b610139610199366004610de5565b6103a5565b6101737f000000000000000000000000000000000000000000000000000000000000000081565b6101736001600160a01b0360025c1681565b6101ea6101e5366004610de5565b61040c565b60405161011d9190610ec4565b7f0000000000000000000000000000000000000000000000000000000000000000610113565b6101135f5c81565b610113610233366004610e46565b610625565b61024b610246366004610f51565b61063d565b60405161011d9190610f90565b6101736001600160a01b0360015c1681565b6101137f000000000000000000000000000000000000000000000000000000000000000081565b61013961029f366004610e46565b610772565b5f546102b09060ff1681565b60405161011d9190610fa2565b336001600160a01b038416146102e65760405163e15e56c960e01b815260040160405180910390fd5b5f6102f0846107ef565b90506102fb81610802565b156103195760405163945b63f560e01b815260040160405180910390fd5b61032481600161080f565b5050505050565b61033481610825565b50336004805c6001600160a01b0319168217905d5050565b5f610356826107ef565b905061036181610857565b80610370575061037081610802565b1561038e5760405163441e4c7360e11b815260040160405180910390fd5b5f61039a826001610860565b905083815d50505050565b336001600160a01b038416146103ce5760405163e15e56c960e01b815260040160405180910390fd5b5f6103d8846107ef565b90506103e381610857565b1561040157604051630bbb04d960e11b815260040160405180910390fd5b6103248160016108b5565b60605f61041b868686866108c1565b90508051600261042b9190610fdc565b67ffffffffffffffff81111561044357610443610fef565b60405190808252806020026020018201604052801561048f57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104615790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104d89493929190611003565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061051a5761051a61104b565b60209081029190910101525f5b815181101561057b578181815181106105425761054261104b565b6020026020010151838260016105589190610fdc565b815181106105685761056861104b565b6020908102919091010152600101610527565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105c29493929190611003565b604051602081830303815290604052915060e01b6020820180516001600160e01b0383818316178352505050508152508260018451610601919061105f565b815181106106115761061161104b565b602002602001018190525050949350505050565b5f610637610632836107ef565b610b93565b92915050565b60605f61067e84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610ba0915050565b90505f6106cd85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250601492506106c891508290508861105f565b610c09565b90505f818060200190518101906106e49190611130565b506040516001600160601b0319606086901b16602082015290915060340160408051601f1981840301815291905281519094505f5b8181101561076757858382815181106107345761073461104b565b602002602001015160405160200161074d9291906111f7565b60408051601f198184030181529190529550600101610719565b505050505092915050565b336001600160a01b0360045c161461079d5760405163e15e56c960e01b815260040160405180910390fd5b5f6107a7826107ef565b90506107b281610857565b15806107c457506107c281610802565b155b156107e257604051634bd439b560e11b815260040160405180910390fd5b6107eb81610d0e565b5050565b5f5f6107fa83610d25565b5c9392505050565b5f5f6107fa836003610860565b5f61081b836003610860565b905081815d505050565b5f6003805c908261083583611221565b9190505d505f61084483610d25565b905060035c80825d505060035c92915050565b5f5f6107fa8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61081b836002610860565b60605f61090284848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610ba0915050565b90505f61094c85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250601492506106c891508290508861105f565b90505f5f828060200190518101906109649190611130565b915091505f825190508151811461098e5760405163899ef10d60e01b815260040160405180910390fd5b8067ffffffffffffffff8111156109a7576109a7610fef565b6040519080825280602002602001820160405280156109f357816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816109c55790505b5095505f5b81811015610b85577f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316848281518110610a3c57610a3c61104b565b60200260200101516001600160a01b031603610abf576040518060600160405280876001600160a01b03168152602001848381518110610a7e57610a7e61104b565b6020026020010151815260200160405180602001604052805f815250815250878281518110610aaf57610aaf61104b565b6020026020010181905250610b7d565b6040518060600160405280858381518110610adc57610adc61104b565b60200260200101516001600160a01b031681526020015f815260200187858481518110610b0b57610b0b61104b565b60209081029190910101516040516001600160a01b039092166024830152604482015260640160408051601f198184030181529190526020810180516001600160e01b031663a9059cbb60e01b17905290528751889083908110610b7157610b7161104b565b60200260200101819052505b6001016109f8565b505050505050949350505050565b5f5f6107fa836001610860565b5f610bac826014610fdc565b83511015610bf95760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b60608182601f011015610c4f5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610bf0565b610c598284610fdc565b84511015610c9d5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610bf0565b606082158015610cbb5760405191505f825260208201604052610d05565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610cf4578051835260209283019201610cdc565b5050858452601f01601f1916604052505b50949350505050565b610d18815f6108b5565b610d22815f61080f565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610d6f92919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6001600160a01b0381168114610d22575f5ffd5b5f5f83601f840112610db0575f5ffd5b50813567ffffffffffffffff811115610dc7575f5ffd5b602083019150836020828501011115610dde575f5ffd5b9250929050565b5f5f5f5f60608587031215610df8575f5ffd5b8435610e0381610d8c565b93506020850135610e1381610d8c565b9250604085013567ffffffffffffffff811115610e2e575f5ffd5b610e3a87828801610da0565b95989497509550505050565b5f60208284031215610e56575f5ffd5b8135610e6181610d8c565b9392505050565b5f5f60408385031215610e79575f5ffd5b823591506020830135610e8b81610d8c565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610f4557868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610f2f90870182610e96565b9550506020938401939190910190600101610eea565b50929695505050505050565b5f5f60208385031215610f62575f5ffd5b823567ffffffffffffffff811115610f78575f5ffd5b610f8485828601610da0565b90969095509350505050565b602081525f610e616020830184610e96565b6020810160038310610fc257634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561063757610637610fc8565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561063757610637610fc8565b604051601f8201601f1916810167ffffffffffffffff8111828210171561109b5761109b610fef565b604052919050565b5f67ffffffffffffffff8211156110bc576110bc610fef565b5060051b60200190565b5f82601f8301126110d5575f5ffd5b81516110e86110e3826110a3565b611072565b8082825260208201915060208360051b860101925085831115611109575f5ffd5b602085015b8381101561112657805183526020928301920161110e565b5095945050505050565b5f5f60408385031215611141575f5ffd5b825167ffffffffffffffff811115611157575f5ffd5b8301601f81018513611167575f5ffd5b80516111756110e3826110a3565b8082825260208201915060208360051b850101925087831115611196575f5ffd5b6020840193505b828410156111c15783516111b081610d8c565b82526020938401939091019061119d565b80955050505050602083015167ffffffffffffffff8111156111e1575f5ffd5b6111ed858286016110c6565b9150509250929050565b5f83518060208601845e60609390931b6001600160601b0319169190920190815260140192915050565b5f6001820161123257611232610fc8565b506001019056fea164736f6c634300081e000a",
+}
+
+// BatchTransferHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use BatchTransferHookMetaData.ABI instead.
+var BatchTransferHookABI = BatchTransferHookMetaData.ABI
+
+// BatchTransferHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use BatchTransferHookMetaData.Bin instead.
+var BatchTransferHookBin = BatchTransferHookMetaData.Bin
+
+// DeployBatchTransferHook deploys a new Ethereum contract, binding an instance of BatchTransferHook to it.
+func DeployBatchTransferHook(auth *bind.TransactOpts, backend bind.ContractBackend, _nativeToken common.Address) (common.Address, *types.Transaction, *BatchTransferHook, error) {
+	parsed, err := BatchTransferHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(BatchTransferHookBin), backend, _nativeToken)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &BatchTransferHook{BatchTransferHookCaller: BatchTransferHookCaller{contract: contract}, BatchTransferHookTransactor: BatchTransferHookTransactor{contract: contract}, BatchTransferHookFilterer: BatchTransferHookFilterer{contract: contract}}, nil
+}
+
+// BatchTransferHook is an auto generated Go binding around an Ethereum contract.
+type BatchTransferHook struct {
+	BatchTransferHookCaller     // Read-only binding to the contract
+	BatchTransferHookTransactor // Write-only binding to the contract
+	BatchTransferHookFilterer   // Log filterer for contract events
+}
+
+// BatchTransferHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BatchTransferHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BatchTransferHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BatchTransferHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BatchTransferHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BatchTransferHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BatchTransferHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BatchTransferHookSession struct {
+	Contract     *BatchTransferHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// BatchTransferHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BatchTransferHookCallerSession struct {
+	Contract *BatchTransferHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// BatchTransferHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BatchTransferHookTransactorSession struct {
+	Contract     *BatchTransferHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// BatchTransferHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BatchTransferHookRaw struct {
+	Contract *BatchTransferHook // Generic contract binding to access the raw methods on
+}
+
+// BatchTransferHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BatchTransferHookCallerRaw struct {
+	Contract *BatchTransferHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BatchTransferHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BatchTransferHookTransactorRaw struct {
+	Contract *BatchTransferHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBatchTransferHook creates a new instance of BatchTransferHook, bound to a specific deployed contract.
+func NewBatchTransferHook(address common.Address, backend bind.ContractBackend) (*BatchTransferHook, error) {
+	contract, err := bindBatchTransferHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferHook{BatchTransferHookCaller: BatchTransferHookCaller{contract: contract}, BatchTransferHookTransactor: BatchTransferHookTransactor{contract: contract}, BatchTransferHookFilterer: BatchTransferHookFilterer{contract: contract}}, nil
+}
+
+// NewBatchTransferHookCaller creates a new read-only instance of BatchTransferHook, bound to a specific deployed contract.
+func NewBatchTransferHookCaller(address common.Address, caller bind.ContractCaller) (*BatchTransferHookCaller, error) {
+	contract, err := bindBatchTransferHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferHookCaller{contract: contract}, nil
+}
+
+// NewBatchTransferHookTransactor creates a new write-only instance of BatchTransferHook, bound to a specific deployed contract.
+func NewBatchTransferHookTransactor(address common.Address, transactor bind.ContractTransactor) (*BatchTransferHookTransactor, error) {
+	contract, err := bindBatchTransferHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferHookTransactor{contract: contract}, nil
+}
+
+// NewBatchTransferHookFilterer creates a new log filterer instance of BatchTransferHook, bound to a specific deployed contract.
+func NewBatchTransferHookFilterer(address common.Address, filterer bind.ContractFilterer) (*BatchTransferHookFilterer, error) {
+	contract, err := bindBatchTransferHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BatchTransferHookFilterer{contract: contract}, nil
+}
+
+// bindBatchTransferHook binds a generic wrapper to an already deployed contract.
+func bindBatchTransferHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BatchTransferHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BatchTransferHook *BatchTransferHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BatchTransferHook.Contract.BatchTransferHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BatchTransferHook *BatchTransferHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.BatchTransferHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BatchTransferHook *BatchTransferHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.BatchTransferHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BatchTransferHook *BatchTransferHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BatchTransferHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BatchTransferHook *BatchTransferHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BatchTransferHook *BatchTransferHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// NATIVETOKEN is a free data retrieval call binding the contract method 0x31f7d964.
+//
+// Solidity: function NATIVE_TOKEN() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCaller) NATIVETOKEN(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "NATIVE_TOKEN")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NATIVETOKEN is a free data retrieval call binding the contract method 0x31f7d964.
+//
+// Solidity: function NATIVE_TOKEN() view returns(address)
+func (_BatchTransferHook *BatchTransferHookSession) NATIVETOKEN() (common.Address, error) {
+	return _BatchTransferHook.Contract.NATIVETOKEN(&_BatchTransferHook.CallOpts)
+}
+
+// NATIVETOKEN is a free data retrieval call binding the contract method 0x31f7d964.
+//
+// Solidity: function NATIVE_TOKEN() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCallerSession) NATIVETOKEN() (common.Address, error) {
+	return _BatchTransferHook.Contract.NATIVETOKEN(&_BatchTransferHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BatchTransferHook *BatchTransferHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BatchTransferHook *BatchTransferHookSession) SUBTYPE() ([32]byte, error) {
+	return _BatchTransferHook.Contract.SUBTYPE(&_BatchTransferHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BatchTransferHook *BatchTransferHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _BatchTransferHook.Contract.SUBTYPE(&_BatchTransferHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BatchTransferHook *BatchTransferHookSession) Asset() (common.Address, error) {
+	return _BatchTransferHook.Contract.Asset(&_BatchTransferHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCallerSession) Asset() (common.Address, error) {
+	return _BatchTransferHook.Contract.Asset(&_BatchTransferHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BatchTransferHook *BatchTransferHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BatchTransferHook *BatchTransferHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _BatchTransferHook.Contract.Build(&_BatchTransferHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BatchTransferHook *BatchTransferHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _BatchTransferHook.Contract.Build(&_BatchTransferHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookSession) ExecutionNonce() (*big.Int, error) {
+	return _BatchTransferHook.Contract.ExecutionNonce(&_BatchTransferHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _BatchTransferHook.Contract.ExecutionNonce(&_BatchTransferHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _BatchTransferHook.Contract.GetOutAmount(&_BatchTransferHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _BatchTransferHook.Contract.GetOutAmount(&_BatchTransferHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BatchTransferHook *BatchTransferHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BatchTransferHook *BatchTransferHookSession) HookType() (uint8, error) {
+	return _BatchTransferHook.Contract.HookType(&_BatchTransferHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BatchTransferHook *BatchTransferHookCallerSession) HookType() (uint8, error) {
+	return _BatchTransferHook.Contract.HookType(&_BatchTransferHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes result)
+func (_BatchTransferHook *BatchTransferHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes result)
+func (_BatchTransferHook *BatchTransferHookSession) Inspect(data []byte) ([]byte, error) {
+	return _BatchTransferHook.Contract.Inspect(&_BatchTransferHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes result)
+func (_BatchTransferHook *BatchTransferHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _BatchTransferHook.Contract.Inspect(&_BatchTransferHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BatchTransferHook *BatchTransferHookSession) LastCaller() (common.Address, error) {
+	return _BatchTransferHook.Contract.LastCaller(&_BatchTransferHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCallerSession) LastCaller() (common.Address, error) {
+	return _BatchTransferHook.Contract.LastCaller(&_BatchTransferHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BatchTransferHook *BatchTransferHookSession) SpToken() (common.Address, error) {
+	return _BatchTransferHook.Contract.SpToken(&_BatchTransferHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BatchTransferHook *BatchTransferHookCallerSession) SpToken() (common.Address, error) {
+	return _BatchTransferHook.Contract.SpToken(&_BatchTransferHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BatchTransferHook *BatchTransferHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BatchTransferHook *BatchTransferHookSession) Subtype() ([32]byte, error) {
+	return _BatchTransferHook.Contract.Subtype(&_BatchTransferHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BatchTransferHook *BatchTransferHookCallerSession) Subtype() ([32]byte, error) {
+	return _BatchTransferHook.Contract.Subtype(&_BatchTransferHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BatchTransferHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookSession) UsedShares() (*big.Int, error) {
+	return _BatchTransferHook.Contract.UsedShares(&_BatchTransferHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BatchTransferHook *BatchTransferHookCallerSession) UsedShares() (*big.Int, error) {
+	return _BatchTransferHook.Contract.UsedShares(&_BatchTransferHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferHook *BatchTransferHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferHook *BatchTransferHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.PostExecute(&_BatchTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferHook *BatchTransferHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.PostExecute(&_BatchTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferHook *BatchTransferHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferHook *BatchTransferHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.PreExecute(&_BatchTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BatchTransferHook *BatchTransferHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.PreExecute(&_BatchTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BatchTransferHook *BatchTransferHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BatchTransferHook *BatchTransferHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.ResetExecutionState(&_BatchTransferHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BatchTransferHook *BatchTransferHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.ResetExecutionState(&_BatchTransferHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BatchTransferHook *BatchTransferHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BatchTransferHook *BatchTransferHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.SetExecutionContext(&_BatchTransferHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BatchTransferHook *BatchTransferHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.SetExecutionContext(&_BatchTransferHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BatchTransferHook *BatchTransferHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BatchTransferHook *BatchTransferHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.SetOutAmount(&_BatchTransferHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BatchTransferHook *BatchTransferHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BatchTransferHook.Contract.SetOutAmount(&_BatchTransferHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,758 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package BurnSuperPositionsHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// BurnSuperPositionsHookMetaData contains all meta data concerning the BurnSuperPositionsHook contract.
+var BurnSuperPositionsHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"dstChainId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vaultBank\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ID_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+}
+
+// BurnSuperPositionsHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use BurnSuperPositionsHookMetaData.ABI instead.
+var BurnSuperPositionsHookABI = BurnSuperPositionsHookMetaData.ABI
+
+// BurnSuperPositionsHook is an auto generated Go binding around an Ethereum contract.
+type BurnSuperPositionsHook struct {
+	BurnSuperPositionsHookCaller     // Read-only binding to the contract
+	BurnSuperPositionsHookTransactor // Write-only binding to the contract
+	BurnSuperPositionsHookFilterer   // Log filterer for contract events
+}
+
+// BurnSuperPositionsHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type BurnSuperPositionsHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BurnSuperPositionsHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type BurnSuperPositionsHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BurnSuperPositionsHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type BurnSuperPositionsHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// BurnSuperPositionsHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type BurnSuperPositionsHookSession struct {
+	Contract     *BurnSuperPositionsHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// BurnSuperPositionsHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type BurnSuperPositionsHookCallerSession struct {
+	Contract *BurnSuperPositionsHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// BurnSuperPositionsHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type BurnSuperPositionsHookTransactorSession struct {
+	Contract     *BurnSuperPositionsHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// BurnSuperPositionsHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type BurnSuperPositionsHookRaw struct {
+	Contract *BurnSuperPositionsHook // Generic contract binding to access the raw methods on
+}
+
+// BurnSuperPositionsHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type BurnSuperPositionsHookCallerRaw struct {
+	Contract *BurnSuperPositionsHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// BurnSuperPositionsHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type BurnSuperPositionsHookTransactorRaw struct {
+	Contract *BurnSuperPositionsHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewBurnSuperPositionsHook creates a new instance of BurnSuperPositionsHook, bound to a specific deployed contract.
+func NewBurnSuperPositionsHook(address common.Address, backend bind.ContractBackend) (*BurnSuperPositionsHook, error) {
+	contract, err := bindBurnSuperPositionsHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &BurnSuperPositionsHook{BurnSuperPositionsHookCaller: BurnSuperPositionsHookCaller{contract: contract}, BurnSuperPositionsHookTransactor: BurnSuperPositionsHookTransactor{contract: contract}, BurnSuperPositionsHookFilterer: BurnSuperPositionsHookFilterer{contract: contract}}, nil
+}
+
+// NewBurnSuperPositionsHookCaller creates a new read-only instance of BurnSuperPositionsHook, bound to a specific deployed contract.
+func NewBurnSuperPositionsHookCaller(address common.Address, caller bind.ContractCaller) (*BurnSuperPositionsHookCaller, error) {
+	contract, err := bindBurnSuperPositionsHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BurnSuperPositionsHookCaller{contract: contract}, nil
+}
+
+// NewBurnSuperPositionsHookTransactor creates a new write-only instance of BurnSuperPositionsHook, bound to a specific deployed contract.
+func NewBurnSuperPositionsHookTransactor(address common.Address, transactor bind.ContractTransactor) (*BurnSuperPositionsHookTransactor, error) {
+	contract, err := bindBurnSuperPositionsHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &BurnSuperPositionsHookTransactor{contract: contract}, nil
+}
+
+// NewBurnSuperPositionsHookFilterer creates a new log filterer instance of BurnSuperPositionsHook, bound to a specific deployed contract.
+func NewBurnSuperPositionsHookFilterer(address common.Address, filterer bind.ContractFilterer) (*BurnSuperPositionsHookFilterer, error) {
+	contract, err := bindBurnSuperPositionsHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &BurnSuperPositionsHookFilterer{contract: contract}, nil
+}
+
+// bindBurnSuperPositionsHook binds a generic wrapper to an already deployed contract.
+func bindBurnSuperPositionsHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := BurnSuperPositionsHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BurnSuperPositionsHook.Contract.BurnSuperPositionsHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.BurnSuperPositionsHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.BurnSuperPositionsHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _BurnSuperPositionsHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) SUBTYPE() ([32]byte, error) {
+	return _BurnSuperPositionsHook.Contract.SUBTYPE(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _BurnSuperPositionsHook.Contract.SUBTYPE(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) Asset() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.Asset(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) Asset() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.Asset(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _BurnSuperPositionsHook.Contract.Build(&_BurnSuperPositionsHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _BurnSuperPositionsHook.Contract.Build(&_BurnSuperPositionsHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.DecodeAmount(&_BurnSuperPositionsHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.DecodeAmount(&_BurnSuperPositionsHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _BurnSuperPositionsHook.Contract.DecodeUsePrevHookAmount(&_BurnSuperPositionsHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _BurnSuperPositionsHook.Contract.DecodeUsePrevHookAmount(&_BurnSuperPositionsHook.CallOpts, data)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) DstChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "dstChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) DstChainId() (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.DstChainId(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) DstChainId() (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.DstChainId(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) ExecutionNonce() (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.ExecutionNonce(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.ExecutionNonce(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.GetOutAmount(&_BurnSuperPositionsHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.GetOutAmount(&_BurnSuperPositionsHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) HookType() (uint8, error) {
+	return _BurnSuperPositionsHook.Contract.HookType(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) HookType() (uint8, error) {
+	return _BurnSuperPositionsHook.Contract.HookType(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) Inspect(data []byte) ([]byte, error) {
+	return _BurnSuperPositionsHook.Contract.Inspect(&_BurnSuperPositionsHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _BurnSuperPositionsHook.Contract.Inspect(&_BurnSuperPositionsHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) LastCaller() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.LastCaller(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) LastCaller() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.LastCaller(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) SpToken() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.SpToken(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) SpToken() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.SpToken(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) Subtype() ([32]byte, error) {
+	return _BurnSuperPositionsHook.Contract.Subtype(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) Subtype() ([32]byte, error) {
+	return _BurnSuperPositionsHook.Contract.Subtype(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) UsedShares() (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.UsedShares(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) UsedShares() (*big.Int, error) {
+	return _BurnSuperPositionsHook.Contract.UsedShares(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCaller) VaultBank(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _BurnSuperPositionsHook.contract.Call(opts, &out, "vaultBank")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) VaultBank() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.VaultBank(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookCallerSession) VaultBank() (common.Address, error) {
+	return _BurnSuperPositionsHook.Contract.VaultBank(&_BurnSuperPositionsHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.PostExecute(&_BurnSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.PostExecute(&_BurnSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.PreExecute(&_BurnSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.PreExecute(&_BurnSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.ResetExecutionState(&_BurnSuperPositionsHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.ResetExecutionState(&_BurnSuperPositionsHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.SetExecutionContext(&_BurnSuperPositionsHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.SetExecutionContext(&_BurnSuperPositionsHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.SetOutAmount(&_BurnSuperPositionsHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_BurnSuperPositionsHook *BurnSuperPositionsHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _BurnSuperPositionsHook.Contract.SetOutAmount(&_BurnSuperPositionsHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,656 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package CancelDepositRequest7540Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// CancelDepositRequest7540HookMetaData contains all meta data concerning the CancelDepositRequest7540Hook contract.
+var CancelDepositRequest7540HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152601481527f43616e63656c4465706f736974526571756573740000000000000000000000006020909101525f805460ff191690557fa80ed216577410a8484eae9cf1d0238bb7de7cc1458c698177d5b2278624de85608052608051610d176100905f395f81816101c7015261023d0152610d175ff3fe608060405234801561000f575f5ffd5b50600436106100fb575f3560e01c80635492e677116100935780638e148776116100635780638e14877614610226578063984d6e7a14610238578063d06a1e891461025f578063e445e7dd14610272575f5ffd5b80635492e677146101c5578063685a943c146101eb57806381cbe691146101f35780638c4313c114610206575f5ffd5b80632113522a116100ce5780632113522a146101565780632ae2fe3d1461018057806338d52e0f146101935780633b5896bc146101a5575f5ffd5b806301bd43ef146100ff57806305b4fe911461011b57806314cb37cf146101305780631f26461914610143575b5f5ffd5b61010860035c81565b6040519081526020015b60405180910390f35b61012e610129366004610a6f565b61028b565b005b61012e61013e366004610acc565b6102f9565b61012e610151366004610aec565b61031a565b6101686001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610112565b61012e61018e366004610a6f565b610373565b6101686001600160a01b0360025c1681565b6101b86101b3366004610a6f565b6103da565b6040516101129190610b44565b7f0000000000000000000000000000000000000000000000000000000000000000610108565b6101085f5c81565b610108610201366004610acc565b6105f3565b610219610214366004610bd1565b61060b565b6040516101129190610c10565b6101686001600160a01b0360015c1681565b6101087f000000000000000000000000000000000000000000000000000000000000000081565b61012e61026d366004610acc565b61068a565b5f5461027e9060ff1681565b6040516101129190610c22565b336001600160a01b038416146102b45760405163e15e56c960e01b815260040160405180910390fd5b5f6102be84610707565b90506102c98161071a565b156102e75760405163945b63f560e01b815260040160405180910390fd5b6102f2816001610727565b5050505050565b6103028161073d565b50336004805c6001600160a01b0319168217905d5050565b5f61032482610707565b905061032f8161076f565b8061033e575061033e8161071a565b1561035c5760405163441e4c7360e11b815260040160405180910390fd5b5f610368826001610778565b905083815d50505050565b336001600160a01b0384161461039c5760405163e15e56c960e01b815260040160405180910390fd5b5f6103a684610707565b90506103b18161076f565b156103cf57604051630bbb04d960e11b815260040160405180910390fd5b6102f28160016107cd565b60605f6103e9868686866107d9565b9050805160026103f99190610c5c565b67ffffffffffffffff81111561041157610411610c6f565b60405190808252806020026020018201604052801561045d57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161042f5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104a69493929190610c83565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106104e8576104e8610ccb565b60209081029190910101525f5b81518110156105495781818151811061051057610510610ccb565b6020026020010151838260016105269190610c5c565b8151811061053657610536610ccb565b60209081029190910101526001016104f5565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105909493929190610c83565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516105cf9190610cdf565b815181106105df576105df610ccb565b602002602001018190525050949350505050565b5f61060561060083610707565b61090b565b92915050565b606061064b83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061091892505050565b604051602001610673919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b336001600160a01b0360045c16146106b55760405163e15e56c960e01b815260040160405180910390fd5b5f6106bf82610707565b90506106ca8161076f565b15806106dc57506106da8161071a565b155b156106fa57604051634bd439b560e11b815260040160405180910390fd5b61070381610924565b5050565b5f5f6107128361093b565b5c9392505050565b5f5f610712836003610778565b5f610733836003610778565b905081815d505050565b5f6003805c908261074d83610cf2565b9190505d505f61075c8361093b565b905060035c80825d505060035c92915050565b5f5f6107128360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610733836002610778565b60605f61081a84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061091892505050565b90506001600160a01b03811661084357604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816108585790505091506040518060600160405280826001600160a01b031681526020015f81526020015f876040516024016108cd9291909182526001600160a01b0316602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b0316632e73c18d60e21b1790529052825183905f906105df576105df610ccb565b5f5f610712836001610778565b5f6106058260206109a7565b61092e815f6107cd565b610938815f610727565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161098a92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f6109b3826014610c5c565b835110156109ff5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b80356001600160a01b0381168114610a25575f5ffd5b919050565b5f5f83601f840112610a3a575f5ffd5b50813567ffffffffffffffff811115610a51575f5ffd5b602083019150836020828501011115610a68575f5ffd5b9250929050565b5f5f5f5f60608587031215610a82575f5ffd5b610a8b85610a0f565b9350610a9960208601610a0f565b9250604085013567ffffffffffffffff811115610ab4575f5ffd5b610ac087828801610a2a565b95989497509550505050565b5f60208284031215610adc575f5ffd5b610ae582610a0f565b9392505050565b5f5f60408385031215610afd575f5ffd5b82359150610b0d60208401610a0f565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610bc557868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610baf90870182610b16565b9550506020938401939190910190600101610b6a565b50929695505050505050565b5f5f60208385031215610be2575f5ffd5b823567ffffffffffffffff811115610bf8575f5ffd5b610c0485828601610a2a565b90969095509350505050565b602081525f610ae56020830184610b16565b6020810160038310610c4257634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561060557610605610c48565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561060557610605610c48565b5f60018201610d0357610d03610c48565b506001019056fea164736f6c634300081e000a",
+}
+
+// CancelDepositRequest7540HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use CancelDepositRequest7540HookMetaData.ABI instead.
+var CancelDepositRequest7540HookABI = CancelDepositRequest7540HookMetaData.ABI
+
+// CancelDepositRequest7540HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CancelDepositRequest7540HookMetaData.Bin instead.
+var CancelDepositRequest7540HookBin = CancelDepositRequest7540HookMetaData.Bin
+
+// DeployCancelDepositRequest7540Hook deploys a new Ethereum contract, binding an instance of CancelDepositRequest7540Hook to it.
+func DeployCancelDepositRequest7540Hook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *CancelDepositRequest7540Hook, error) {
+	parsed, err := CancelDepositRequest7540HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CancelDepositRequest7540HookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CancelDepositRequest7540Hook{CancelDepositRequest7540HookCaller: CancelDepositRequest7540HookCaller{contract: contract}, CancelDepositRequest7540HookTransactor: CancelDepositRequest7540HookTransactor{contract: contract}, CancelDepositRequest7540HookFilterer: CancelDepositRequest7540HookFilterer{contract: contract}}, nil
+}
+
+// CancelDepositRequest7540Hook is an auto generated Go binding around an Ethereum contract.
+type CancelDepositRequest7540Hook struct {
+	CancelDepositRequest7540HookCaller     // Read-only binding to the contract
+	CancelDepositRequest7540HookTransactor // Write-only binding to the contract
+	CancelDepositRequest7540HookFilterer   // Log filterer for contract events
+}
+
+// CancelDepositRequest7540HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CancelDepositRequest7540HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CancelDepositRequest7540HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CancelDepositRequest7540HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CancelDepositRequest7540HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CancelDepositRequest7540HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CancelDepositRequest7540HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CancelDepositRequest7540HookSession struct {
+	Contract     *CancelDepositRequest7540Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                 // Call options to use throughout this session
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// CancelDepositRequest7540HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CancelDepositRequest7540HookCallerSession struct {
+	Contract *CancelDepositRequest7540HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                       // Call options to use throughout this session
+}
+
+// CancelDepositRequest7540HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CancelDepositRequest7540HookTransactorSession struct {
+	Contract     *CancelDepositRequest7540HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                       // Transaction auth options to use throughout this session
+}
+
+// CancelDepositRequest7540HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CancelDepositRequest7540HookRaw struct {
+	Contract *CancelDepositRequest7540Hook // Generic contract binding to access the raw methods on
+}
+
+// CancelDepositRequest7540HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CancelDepositRequest7540HookCallerRaw struct {
+	Contract *CancelDepositRequest7540HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CancelDepositRequest7540HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CancelDepositRequest7540HookTransactorRaw struct {
+	Contract *CancelDepositRequest7540HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCancelDepositRequest7540Hook creates a new instance of CancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewCancelDepositRequest7540Hook(address common.Address, backend bind.ContractBackend) (*CancelDepositRequest7540Hook, error) {
+	contract, err := bindCancelDepositRequest7540Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CancelDepositRequest7540Hook{CancelDepositRequest7540HookCaller: CancelDepositRequest7540HookCaller{contract: contract}, CancelDepositRequest7540HookTransactor: CancelDepositRequest7540HookTransactor{contract: contract}, CancelDepositRequest7540HookFilterer: CancelDepositRequest7540HookFilterer{contract: contract}}, nil
+}
+
+// NewCancelDepositRequest7540HookCaller creates a new read-only instance of CancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewCancelDepositRequest7540HookCaller(address common.Address, caller bind.ContractCaller) (*CancelDepositRequest7540HookCaller, error) {
+	contract, err := bindCancelDepositRequest7540Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CancelDepositRequest7540HookCaller{contract: contract}, nil
+}
+
+// NewCancelDepositRequest7540HookTransactor creates a new write-only instance of CancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewCancelDepositRequest7540HookTransactor(address common.Address, transactor bind.ContractTransactor) (*CancelDepositRequest7540HookTransactor, error) {
+	contract, err := bindCancelDepositRequest7540Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CancelDepositRequest7540HookTransactor{contract: contract}, nil
+}
+
+// NewCancelDepositRequest7540HookFilterer creates a new log filterer instance of CancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewCancelDepositRequest7540HookFilterer(address common.Address, filterer bind.ContractFilterer) (*CancelDepositRequest7540HookFilterer, error) {
+	contract, err := bindCancelDepositRequest7540Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CancelDepositRequest7540HookFilterer{contract: contract}, nil
+}
+
+// bindCancelDepositRequest7540Hook binds a generic wrapper to an already deployed contract.
+func bindCancelDepositRequest7540Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CancelDepositRequest7540HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CancelDepositRequest7540Hook.Contract.CancelDepositRequest7540HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.CancelDepositRequest7540HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.CancelDepositRequest7540HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CancelDepositRequest7540Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) SUBTYPE() ([32]byte, error) {
+	return _CancelDepositRequest7540Hook.Contract.SUBTYPE(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _CancelDepositRequest7540Hook.Contract.SUBTYPE(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) Asset() (common.Address, error) {
+	return _CancelDepositRequest7540Hook.Contract.Asset(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) Asset() (common.Address, error) {
+	return _CancelDepositRequest7540Hook.Contract.Asset(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CancelDepositRequest7540Hook.Contract.Build(&_CancelDepositRequest7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CancelDepositRequest7540Hook.Contract.Build(&_CancelDepositRequest7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) ExecutionNonce() (*big.Int, error) {
+	return _CancelDepositRequest7540Hook.Contract.ExecutionNonce(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _CancelDepositRequest7540Hook.Contract.ExecutionNonce(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CancelDepositRequest7540Hook.Contract.GetOutAmount(&_CancelDepositRequest7540Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CancelDepositRequest7540Hook.Contract.GetOutAmount(&_CancelDepositRequest7540Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) HookType() (uint8, error) {
+	return _CancelDepositRequest7540Hook.Contract.HookType(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) HookType() (uint8, error) {
+	return _CancelDepositRequest7540Hook.Contract.HookType(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) Inspect(data []byte) ([]byte, error) {
+	return _CancelDepositRequest7540Hook.Contract.Inspect(&_CancelDepositRequest7540Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _CancelDepositRequest7540Hook.Contract.Inspect(&_CancelDepositRequest7540Hook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) LastCaller() (common.Address, error) {
+	return _CancelDepositRequest7540Hook.Contract.LastCaller(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) LastCaller() (common.Address, error) {
+	return _CancelDepositRequest7540Hook.Contract.LastCaller(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) SpToken() (common.Address, error) {
+	return _CancelDepositRequest7540Hook.Contract.SpToken(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) SpToken() (common.Address, error) {
+	return _CancelDepositRequest7540Hook.Contract.SpToken(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) Subtype() ([32]byte, error) {
+	return _CancelDepositRequest7540Hook.Contract.Subtype(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) Subtype() ([32]byte, error) {
+	return _CancelDepositRequest7540Hook.Contract.Subtype(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CancelDepositRequest7540Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) UsedShares() (*big.Int, error) {
+	return _CancelDepositRequest7540Hook.Contract.UsedShares(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookCallerSession) UsedShares() (*big.Int, error) {
+	return _CancelDepositRequest7540Hook.Contract.UsedShares(&_CancelDepositRequest7540Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.PostExecute(&_CancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.PostExecute(&_CancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.PreExecute(&_CancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.PreExecute(&_CancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.ResetExecutionState(&_CancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.ResetExecutionState(&_CancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.SetExecutionContext(&_CancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.SetExecutionContext(&_CancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.SetOutAmount(&_CancelDepositRequest7540Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CancelDepositRequest7540Hook *CancelDepositRequest7540HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CancelDepositRequest7540Hook.Contract.SetOutAmount(&_CancelDepositRequest7540Hook.TransactOpts, _outAmount, caller)
+}
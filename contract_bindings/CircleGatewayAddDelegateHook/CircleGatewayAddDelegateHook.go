@@ -0,0 +1,687 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package CircleGatewayAddDelegateHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// CircleGatewayAddDelegateHookMetaData contains all meta data concerning the CircleGatewayAddDelegateHook contract.
+var CircleGatewayAddDelegateHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"gatewayWalletAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"GATEWAY_WALLET\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b50604051610e3a380380610e3a83398101604081905261002e916100b1565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b0381166100a057604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100de565b5f602082840312156100c1575f5ffd5b81516001600160a01b03811681146100d7575f5ffd5b9392505050565b60805160a051610d2d61010d5f395f8181610272015261089401525f81816101d2015261024b0152610d2d5ff3fe608060405234801561000f575f5ffd5b5060043610610106575f3560e01c80635492e6771161009e5780638e1487761161006e5780638e14877614610234578063984d6e7a14610246578063a68736f81461026d578063d06a1e8914610294578063e445e7dd146102a7575f5ffd5b80635492e677146101d0578063685a943c146101f657806381cbe691146101fe5780638c4313c114610211575f5ffd5b80632113522a116100d95780632113522a146101615780632ae2fe3d1461018b57806338d52e0f1461019e5780633b5896bc146101b0575f5ffd5b806301bd43ef1461010a57806305b4fe911461012657806314cb37cf1461013b5780631f2646191461014e575b5f5ffd5b61011360035c81565b6040519081526020015b60405180910390f35b610139610134366004610a85565b6102c0565b005b610139610149366004610ae2565b61032e565b61013961015c366004610b02565b61034f565b6101736001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161011d565b610139610199366004610a85565b6103a8565b6101736001600160a01b0360025c1681565b6101c36101be366004610a85565b61040f565b60405161011d9190610b5a565b7f0000000000000000000000000000000000000000000000000000000000000000610113565b6101135f5c81565b61011361020c366004610ae2565b610628565b61022761021f366004610be7565b606092915050565b60405161011d9190610c26565b6101736001600160a01b0360015c1681565b6101137f000000000000000000000000000000000000000000000000000000000000000081565b6101737f000000000000000000000000000000000000000000000000000000000000000081565b6101396102a2366004610ae2565b610640565b5f546102b39060ff1681565b60405161011d9190610c38565b336001600160a01b038416146102e95760405163e15e56c960e01b815260040160405180910390fd5b5f6102f3846106bd565b90506102fe816106d0565b1561031c5760405163945b63f560e01b815260040160405180910390fd5b6103278160016106dd565b5050505050565b610337816106f3565b50336004805c6001600160a01b0319168217905d5050565b5f610359826106bd565b905061036481610725565b806103735750610373816106d0565b156103915760405163441e4c7360e11b815260040160405180910390fd5b5f61039d82600161072e565b905083815d50505050565b336001600160a01b038416146103d15760405163e15e56c960e01b815260040160405180910390fd5b5f6103db846106bd565b90506103e681610725565b1561040457604051630bbb04d960e11b815260040160405180910390fd5b610327816001610783565b60605f61041e8686868661078f565b90508051600261042e9190610c72565b67ffffffffffffffff81111561044657610446610c85565b60405190808252806020026020018201604052801561049257816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104645790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104db9493929190610c99565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061051d5761051d610ce1565b60209081029190910101525f5b815181101561057e5781818151811061054557610545610ce1565b60200260200101518382600161055b9190610c72565b8151811061056b5761056b610ce1565b602090810291909101015260010161052a565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105c59493929190610c99565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106049190610cf5565b8151811061061457610614610ce1565b602002602001018190525050949350505050565b5f61063a610635836106bd565b61092d565b92915050565b336001600160a01b0360045c161461066b5760405163e15e56c960e01b815260040160405180910390fd5b5f610675826106bd565b905061068081610725565b15806106925750610690816106d0565b155b156106b057604051634bd439b560e11b815260040160405180910390fd5b6106b98161093a565b5050565b5f5f6106c883610951565b5c9392505050565b5f5f6106c883600361072e565b5f6106e983600361072e565b905081815d505050565b5f6003805c908261070383610d08565b9190505d505f61071283610951565b905060035c80825d505060035c92915050565b5f5f6106c88360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6106e983600261072e565b60605f6107d084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92018290525092506109bd915050565b90505f61081485858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250601492506109bd915050565b90506001600160a01b03811661083d57604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161085257905050604080516060810182526001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000811682525f6020830152825186821660248201529085166044820152929550919082019060640160408051601f198184030181529190526020810180516001600160e01b0316637484f5fd60e11b1790529052835184905f9061091857610918610ce1565b60200260200101819052505050949350505050565b5f5f6106c883600161072e565b610944815f610783565b61094e815f6106dd565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b826040516020016109a092919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f6109c9826014610c72565b83511015610a155760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b80356001600160a01b0381168114610a3b575f5ffd5b919050565b5f5f83601f840112610a50575f5ffd5b50813567ffffffffffffffff811115610a67575f5ffd5b602083019150836020828501011115610a7e575f5ffd5b9250929050565b5f5f5f5f60608587031215610a98575f5ffd5b610aa185610a25565b9350610aaf60208601610a25565b9250604085013567ffffffffffffffff811115610aca575f5ffd5b610ad687828801610a40565b95989497509550505050565b5f60208284031215610af2575f5ffd5b610afb82610a25565b9392505050565b5f5f60408385031215610b13575f5ffd5b82359150610b2360208401610a25565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610bdb57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610bc590870182610b2c565b9550506020938401939190910190600101610b80565b50929695505050505050565b5f5f60208385031215610bf8575f5ffd5b823567ffffffffffffffff811115610c0e575f5ffd5b610c1a85828601610a40565b90969095509350505050565b602081525f610afb6020830184610b2c565b6020810160038310610c5857634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561063a5761063a610c5e565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561063a5761063a610c5e565b5f60018201610d1957610d19610c5e565b506001019056fea164736f6c634300081e000a",
+}
+
+// CircleGatewayAddDelegateHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use CircleGatewayAddDelegateHookMetaData.ABI instead.
+var CircleGatewayAddDelegateHookABI = CircleGatewayAddDelegateHookMetaData.ABI
+
+// CircleGatewayAddDelegateHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CircleGatewayAddDelegateHookMetaData.Bin instead.
+var CircleGatewayAddDelegateHookBin = CircleGatewayAddDelegateHookMetaData.Bin
+
+// DeployCircleGatewayAddDelegateHook deploys a new Ethereum contract, binding an instance of CircleGatewayAddDelegateHook to it.
+func DeployCircleGatewayAddDelegateHook(auth *bind.TransactOpts, backend bind.ContractBackend, gatewayWalletAddress common.Address) (common.Address, *types.Transaction, *CircleGatewayAddDelegateHook, error) {
+	parsed, err := CircleGatewayAddDelegateHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CircleGatewayAddDelegateHookBin), backend, gatewayWalletAddress)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CircleGatewayAddDelegateHook{CircleGatewayAddDelegateHookCaller: CircleGatewayAddDelegateHookCaller{contract: contract}, CircleGatewayAddDelegateHookTransactor: CircleGatewayAddDelegateHookTransactor{contract: contract}, CircleGatewayAddDelegateHookFilterer: CircleGatewayAddDelegateHookFilterer{contract: contract}}, nil
+}
+
+// CircleGatewayAddDelegateHook is an auto generated Go binding around an Ethereum contract.
+type CircleGatewayAddDelegateHook struct {
+	CircleGatewayAddDelegateHookCaller     // Read-only binding to the contract
+	CircleGatewayAddDelegateHookTransactor // Write-only binding to the contract
+	CircleGatewayAddDelegateHookFilterer   // Log filterer for contract events
+}
+
+// CircleGatewayAddDelegateHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CircleGatewayAddDelegateHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayAddDelegateHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CircleGatewayAddDelegateHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayAddDelegateHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CircleGatewayAddDelegateHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayAddDelegateHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CircleGatewayAddDelegateHookSession struct {
+	Contract     *CircleGatewayAddDelegateHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                 // Call options to use throughout this session
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// CircleGatewayAddDelegateHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CircleGatewayAddDelegateHookCallerSession struct {
+	Contract *CircleGatewayAddDelegateHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                       // Call options to use throughout this session
+}
+
+// CircleGatewayAddDelegateHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CircleGatewayAddDelegateHookTransactorSession struct {
+	Contract     *CircleGatewayAddDelegateHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                       // Transaction auth options to use throughout this session
+}
+
+// CircleGatewayAddDelegateHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CircleGatewayAddDelegateHookRaw struct {
+	Contract *CircleGatewayAddDelegateHook // Generic contract binding to access the raw methods on
+}
+
+// CircleGatewayAddDelegateHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CircleGatewayAddDelegateHookCallerRaw struct {
+	Contract *CircleGatewayAddDelegateHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CircleGatewayAddDelegateHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CircleGatewayAddDelegateHookTransactorRaw struct {
+	Contract *CircleGatewayAddDelegateHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCircleGatewayAddDelegateHook creates a new instance of CircleGatewayAddDelegateHook, bound to a specific deployed contract.
+func NewCircleGatewayAddDelegateHook(address common.Address, backend bind.ContractBackend) (*CircleGatewayAddDelegateHook, error) {
+	contract, err := bindCircleGatewayAddDelegateHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayAddDelegateHook{CircleGatewayAddDelegateHookCaller: CircleGatewayAddDelegateHookCaller{contract: contract}, CircleGatewayAddDelegateHookTransactor: CircleGatewayAddDelegateHookTransactor{contract: contract}, CircleGatewayAddDelegateHookFilterer: CircleGatewayAddDelegateHookFilterer{contract: contract}}, nil
+}
+
+// NewCircleGatewayAddDelegateHookCaller creates a new read-only instance of CircleGatewayAddDelegateHook, bound to a specific deployed contract.
+func NewCircleGatewayAddDelegateHookCaller(address common.Address, caller bind.ContractCaller) (*CircleGatewayAddDelegateHookCaller, error) {
+	contract, err := bindCircleGatewayAddDelegateHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayAddDelegateHookCaller{contract: contract}, nil
+}
+
+// NewCircleGatewayAddDelegateHookTransactor creates a new write-only instance of CircleGatewayAddDelegateHook, bound to a specific deployed contract.
+func NewCircleGatewayAddDelegateHookTransactor(address common.Address, transactor bind.ContractTransactor) (*CircleGatewayAddDelegateHookTransactor, error) {
+	contract, err := bindCircleGatewayAddDelegateHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayAddDelegateHookTransactor{contract: contract}, nil
+}
+
+// NewCircleGatewayAddDelegateHookFilterer creates a new log filterer instance of CircleGatewayAddDelegateHook, bound to a specific deployed contract.
+func NewCircleGatewayAddDelegateHookFilterer(address common.Address, filterer bind.ContractFilterer) (*CircleGatewayAddDelegateHookFilterer, error) {
+	contract, err := bindCircleGatewayAddDelegateHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayAddDelegateHookFilterer{contract: contract}, nil
+}
+
+// bindCircleGatewayAddDelegateHook binds a generic wrapper to an already deployed contract.
+func bindCircleGatewayAddDelegateHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CircleGatewayAddDelegateHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CircleGatewayAddDelegateHook.Contract.CircleGatewayAddDelegateHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.CircleGatewayAddDelegateHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.CircleGatewayAddDelegateHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CircleGatewayAddDelegateHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// GATEWAYWALLET is a free data retrieval call binding the contract method 0xa68736f8.
+//
+// Solidity: function GATEWAY_WALLET() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) GATEWAYWALLET(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "GATEWAY_WALLET")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GATEWAYWALLET is a free data retrieval call binding the contract method 0xa68736f8.
+//
+// Solidity: function GATEWAY_WALLET() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) GATEWAYWALLET() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.GATEWAYWALLET(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// GATEWAYWALLET is a free data retrieval call binding the contract method 0xa68736f8.
+//
+// Solidity: function GATEWAY_WALLET() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) GATEWAYWALLET() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.GATEWAYWALLET(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) SUBTYPE() ([32]byte, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SUBTYPE(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SUBTYPE(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) Asset() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Asset(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) Asset() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Asset(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Build(&_CircleGatewayAddDelegateHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Build(&_CircleGatewayAddDelegateHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) ExecutionNonce() (*big.Int, error) {
+	return _CircleGatewayAddDelegateHook.Contract.ExecutionNonce(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _CircleGatewayAddDelegateHook.Contract.ExecutionNonce(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CircleGatewayAddDelegateHook.Contract.GetOutAmount(&_CircleGatewayAddDelegateHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CircleGatewayAddDelegateHook.Contract.GetOutAmount(&_CircleGatewayAddDelegateHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) HookType() (uint8, error) {
+	return _CircleGatewayAddDelegateHook.Contract.HookType(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) HookType() (uint8, error) {
+	return _CircleGatewayAddDelegateHook.Contract.HookType(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) Inspect(opts *bind.CallOpts, arg0 []byte) ([]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "inspect", arg0)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Inspect(&_CircleGatewayAddDelegateHook.CallOpts, arg0)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Inspect(&_CircleGatewayAddDelegateHook.CallOpts, arg0)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) LastCaller() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.LastCaller(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) LastCaller() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.LastCaller(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) SpToken() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SpToken(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) SpToken() (common.Address, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SpToken(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) Subtype() ([32]byte, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Subtype(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) Subtype() ([32]byte, error) {
+	return _CircleGatewayAddDelegateHook.Contract.Subtype(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayAddDelegateHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) UsedShares() (*big.Int, error) {
+	return _CircleGatewayAddDelegateHook.Contract.UsedShares(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookCallerSession) UsedShares() (*big.Int, error) {
+	return _CircleGatewayAddDelegateHook.Contract.UsedShares(&_CircleGatewayAddDelegateHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.PostExecute(&_CircleGatewayAddDelegateHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.PostExecute(&_CircleGatewayAddDelegateHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.PreExecute(&_CircleGatewayAddDelegateHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.PreExecute(&_CircleGatewayAddDelegateHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.ResetExecutionState(&_CircleGatewayAddDelegateHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.ResetExecutionState(&_CircleGatewayAddDelegateHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SetExecutionContext(&_CircleGatewayAddDelegateHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SetExecutionContext(&_CircleGatewayAddDelegateHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SetOutAmount(&_CircleGatewayAddDelegateHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayAddDelegateHook *CircleGatewayAddDelegateHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayAddDelegateHook.Contract.SetOutAmount(&_CircleGatewayAddDelegateHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,732 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package CircleGatewayMinterHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// CircleGatewayMinterHookMetaData contains all meta data concerning the CircleGatewayMinterHook contract.
+var CircleGatewayMinterHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"gatewayMinterAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"GATEWAY_MINTER\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAttestationData\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"attestationPayload\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CursorOutOfBounds\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"DESTINATION_TOKENS_DIFFER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DATA_LENGTH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DESTINATION_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"InvalidTransferPayloadMagic\",\"inputs\":[{\"name\":\"actualMagic\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}]},{\"type\":\"error\",\"name\":\"InvalidTransferSpecMagic\",\"inputs\":[{\"name\":\"actualMagic\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}]},{\"type\":\"error\",\"name\":\"InvalidTransferSpecVersion\",\"inputs\":[{\"name\":\"actualVersion\",\"type\":\"uint32\",\"internalType\":\"uint32\"}]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TOKEN_ADDRESS_INVALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TransferPayloadDataTooShort\",\"inputs\":[{\"name\":\"expectedMinimumLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadHeaderTooShort\",\"inputs\":[{\"name\":\"expectedMinimumLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadOverallLengthMismatch\",\"inputs\":[{\"name\":\"expectedTotalLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualTotalLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadSetElementHeaderTooShort\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"actualSetLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"requiredOffset\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadSetElementTooShort\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"actualSetLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"requiredOffset\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadSetHeaderTooShort\",\"inputs\":[{\"name\":\"expectedMinimumLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadSetInvalidElementMagic\",\"inputs\":[{\"name\":\"index\",\"type\":\"uint32\",\"internalType\":\"uint32\"},{\"name\":\"actualMagic\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}]},{\"type\":\"error\",\"name\":\"TransferPayloadSetOverallLengthMismatch\",\"inputs\":[{\"name\":\"expectedTotalLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualTotalLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferSpecHeaderTooShort\",\"inputs\":[{\"name\":\"expectedMinimumLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"TransferSpecOverallLengthMismatch\",\"inputs\":[{\"name\":\"expectedTotalLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualTotalLength\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161225538038061225583398101604081905261002e916100b1565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b0381166100a057604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100de565b5f602082840312156100c1575f5ffd5b81516001600160a01b03811681146100d7575f5ffd5b9392505050565b60805160a05161214861010d5f395f81816102270152610bdb01525f81816101fe015261029b01526121485ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c80635492e6771161009e5780638c4313c11161006e5780638c4313c1146102645780638e14877614610284578063984d6e7a14610296578063d06a1e89146102bd578063e445e7dd146102d0575f5ffd5b80635492e677146101fc5780636558b98114610222578063685a943c1461024957806381cbe69114610251575f5ffd5b80632113522a116100e45780632113522a1461016c578063285d9e9d146101965780632ae2fe3d146101b757806338d52e0f146101ca5780633b5896bc146101dc575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f366004611c3a565b6102e9565b005b610144610154366004611c97565b610363565b610144610167366004611cb0565b610384565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101a96101a4366004611cee565b6103dd565b604051610128929190611dcf565b6101446101c5366004611c3a565b6103f2565b61017e6001600160a01b0360025c1681565b6101ef6101ea366004611c3a565b610465565b6040516101289190611df3565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b61011e5f5c81565b61011e61025f366004611c97565b61067e565b610277610272366004611e80565b610696565b6040516101289190611ebf565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b6101446102cb366004611c97565b61070e565b5f546102dc9060ff1681565b6040516101289190611ed1565b336001600160a01b038416146103125760405163e15e56c960e01b815260040160405180910390fd5b5f61031c8461078b565b90506103278161079e565b156103455760405163945b63f560e01b815260040160405180910390fd5b6103508160016107ab565b61035c858585856107c1565b5050505050565b61036c8161085d565b50336004805c6001600160a01b0319168217905d5050565b5f61038e8261078b565b90506103998161088f565b806103a857506103a88161079e565b156103c65760405163441e4c7360e11b815260040160405180910390fd5b5f6103d2826001610898565b905083815d50505050565b6060806103e9836108ed565b91509150915091565b336001600160a01b0384161461041b5760405163e15e56c960e01b815260040160405180910390fd5b5f6104258461078b565b90506104308161088f565b1561044e57604051630bbb04d960e11b815260040160405180910390fd5b6104598160016109f9565b61035c85858585610a05565b60605f61047486868686610ae2565b9050805160026104849190611f0b565b67ffffffffffffffff81111561049c5761049c611cda565b6040519080825280602002602001820160405280156104e857816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104ba5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105319493929190611f1e565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061057357610573611f66565b60209081029190910101525f5b81518110156105d45781818151811061059b5761059b611f66565b6020026020010151838260016105b19190611f0b565b815181106105c1576105c1611f66565b6020908102919091010152600101610580565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161061b9493929190611f1e565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161065a9190611f7a565b8151811061066a5761066a611f66565b602002602001018190525050949350505050565b5f61069061068b8361078b565b610c74565b92915050565b60605f6106d784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c8192505050565b6040805160609290921b6bffffffffffffffffffffffff191660208301528051601481840301815260349092019052949350505050565b336001600160a01b0360045c16146107395760405163e15e56c960e01b815260040160405180910390fd5b5f6107438261078b565b905061074e8161088f565b1580610760575061075e8161079e565b155b1561077e57604051634bd439b560e11b815260040160405180910390fd5b61078781610d55565b5050565b5f5f61079683610d6c565b5c9392505050565b5f5f610796836003610898565b5f6107b7836003610898565b905081815d505050565b6040516370a0823160e01b81526001600160a01b0384811660048301525f9160025c909116906370a0823190602401602060405180830381865afa15801561080b573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061082f9190611f8d565b90505f61083b8561067e565b90505f6108488284611f7a565b90506108548187610dd8565b50505050505050565b5f6003805c908261086d83611fa4565b9190505d505f61087c83610d6c565b905060035c80825d505060035c92915050565b5f5f6107968360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b606080604083511015610913576040516358f385d760e01b815260040160405180910390fd5b5f8061091f8582610df0565b905061092c602083611f0b565b91506109388183611f0b565b610943906020611f0b565b85511015610964576040516358f385d760e01b815260040160405180910390fd5b61096f858383610e52565b935061097b8183611f0b565b91505f6109888684610df0565b9050610995602084611f0b565b92506109a18184611f0b565b865110156109c2576040516358f385d760e01b815260040160405180910390fd5b60418110156109e4576040516358f385d760e01b815260040160405180910390fd5b6109ef868483610e52565b9350505050915091565b5f6107b7836002610898565b5f610a4483838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c8192505050565b9050806002805c6001600160a01b0319166001600160a01b03831617905d506040516370a0823160e01b81526001600160a01b0385811660048301525f91908316906370a0823190602401602060405180830381865afa158015610aaa573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610ace9190611f8d565b9050610ada8186610dd8565b505050505050565b60605f5f610b2485858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506108ed92505050565b9150915081515f03610b49576040516358f385d760e01b815260040160405180910390fd5b610b8985858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152508a9250610f59915050565b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b9e57905050925060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f81526020018383604051602401610c21929190611dcf565b60408051601f198184030181529190526020810180516001600160e01b0316639fb01cc560e01b1790529052835184905f90610c5f57610c5f611f66565b60200260200101819052505050949350505050565b5f5f610796836001610898565b5f5f610c8c836108ed565b5090505f610c9982611028565b90505f5f5b8260800151610d4c57610cb0836110bf565b91505f610cc262ffffff1984166111a3565b9050610cd9610cd662ffffff19831661122a565b90565b91506001600160a01b038216610d0257604051631ccc615360e31b815260040160405180910390fd5b6001600160a01b03861615610d4357816001600160a01b0316866001600160a01b031614610d435760405163755c8de560e11b815260040160405180910390fd5b81955050610c9e565b50505050919050565b610d5f815f6109f9565b610d69815f6107ab565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610dbb92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610de28261078b565b90505f6103d2826001610898565b5f610dfc826020611f0b565b83511015610e495760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b60608182601f011015610e985760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610e40565b610ea28284611f0b565b84511015610ee65760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610e40565b606082158015610f045760405191505f825260208201604052610f4e565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610f3d578051835260209283019201610f25565b5050858452601f01601f1916604052505b5090505b9392505050565b5f610f63836108ed565b5090505f610f7082611028565b9050806040015163ffffffff165f03610f9c576040516358f385d760e01b815260040160405180910390fd5b5f5b816080015161035c57610fb0826110bf565b90505f610fc262ffffff1983166111a3565b90505f610fd7610cd662ffffff19841661123e565b9050856001600160a01b0316816001600160a01b03161415801561100357506001600160a01b03811615155b15611021576040516308df7cdb60e11b815260040160405180910390fd5b5050610f9e565b6040805160a0810182525f8082526020820181905291810182905260608101829052608081018290529061105b83611252565b62ffffff19811683525f6060840152905061107581611283565b61109357505f60208201819052600160408301526080820152919050565b5f61109d826112a5565b6008602085015263ffffffff1660408401819052156080840152509092915050565b5f8160800151156110e357604051631365229760e31b815260040160405180910390fd5b5f61110c602461ffff1684602001516110fc9190611f0b565b845162ffffff19169060046112b4565b90505f61111a826028611fbc565b6020850151855163ffffffff9290921692506111429162ffffff1916908363ff6fb3346112e3565b925080846020018181516111569190611f0b565b9052506060840180519061116982611fd8565b63ffffffff1663ffffffff1681525050836040015163ffffffff16846060015163ffffffff161061119c57600160808501525b5050919050565b5f5f6111ae8361135e565b90505f6111ce62ffffff198516602863ffffffff851663ca85def76112e3565b90505f6111e362ffffff198316826004611372565b90506001600160e01b0319811663ca85def760e01b1461122257604051633c2c863160e11b81526001600160e01b031982166004820152602401610e40565b509392505050565b5f61069062ffffff19831660706020611372565b5f61069062ffffff19831660f06020611372565b5f61125c826114c8565b905061126781611283565b1561127a57611275816115a2565b919050565b611275816117ee565b5f631e12db7160e01b61129e62ffffff198416836004611372565b1492915050565b5f61069062ffffff1983166004805b5f6112c0826020611ffc565b6112cb906008612015565b60ff166112d9858585611372565b901c949350505050565b5f5f6112f88660781c6001600160601b031690565b6001600160601b0316905061130c8661180c565b846113178784611f0b565b6113219190611f0b565b11156113345762ffffff19915050611356565b61133e8582611f0b565b90506113528364ffffffffff168286611844565b9150505b949350505050565b5f61069062ffffff198316602460046112b4565b5f8160ff165f0361138457505f610f52565b6113978460181c6001600160601b031690565b6001600160601b03166113ad60ff841685611f0b565b1115611411576113f86113c98560781c6001600160601b031690565b6001600160601b03166113e58660181c6001600160601b031690565b6001600160601b0316858560ff1661188b565b60405162461bcd60e51b8152600401610e409190611ebf565b60208260ff16111561148b5760405162461bcd60e51b815260206004820152603a60248201527f54797065644d656d566965772f696e646578202d20417474656d70746564207460448201527f6f20696e646578206d6f7265207468616e2033322062797465730000000000006064820152608401610e40565b600882025f6114a38660781c6001600160601b031690565b6001600160601b031690505f600160ff1b5f1984011d91909501511695945050505050565b5f600460ff16825110156114fb578151604051631743c8a560e31b81526004808201526024810191909152604401610e40565b5f61150683826118f5565b90505f61151b62ffffff198316826004611372565b90506224133360e21b6001600160e01b03198216016115585761155163ff6fb3345b60d81b6301000000600160d81b0384161790565b925061119c565b63e1ed248f60e01b6001600160e01b031982160161157d57611551631e12db7161153d565b60405163319b52eb60e11b81526001600160e01b031982166004820152602401610e40565b6008601882901c6001600160601b031610156115e757604051637c087ac560e11b815260086004820152601882901c6001600160601b03166024820152604401610e40565b5f6115f1826112a5565b905060085f5b8263ffffffff168163ffffffff1610156117a5575f611617602884611f0b565b9050601885901c6001600160601b031681111561166957604051631f7a270f60e31b815263ffffffff83166004820152601886901c6001600160601b0316602482015260448101829052606401610e40565b5f611687611678602486611f0b565b62ffffff1988169060046112b4565b90505f611695826028611fbc565b63ffffffff1690505f6116a88287611f0b565b9050601888901c6001600160601b03168111156116fa57604051631014f32560e31b815263ffffffff86166004820152601889901c6001600160601b0316602482015260448101829052606401610e40565b5f6117176117088289611f0b565b62ffffff198b16906004611372565b90506001600160e01b0319811660016224133360e21b031914611765576040516312b22cbd60e01b815263ffffffff871660048201526001600160e01b031982166024820152604401610e40565b5f61177c62ffffff198b16898663ff6fb3346112e3565b9050611787816117ee565b6117918489611f0b565b975050600190950194506115f79350505050565b50601883901c6001600160601b031681146117e957604051633c99ab2560e21b815260048101829052601884901c6001600160601b03166024820152604401610e40565b505050565b6117f78161190f565b5f611801826111a3565b9050610787816119b7565b5f6118208260181c6001600160601b031690565b6118338360781c6001600160601b031690565b016001600160601b03169050919050565b5f806118508385611f0b565b905060405181111561185f57505f5b805f036118735762ffffff19915050610f52565b606085811b8517901b831760181b5b95945050505050565b60605f61189786611aa3565b9150505f6118a486611aa3565b9150505f6118b186611aa3565b9150505f6118be86611aa3565b915050838383836040516020016118d89493929190612038565b604051602081830303815290604052945050505050949350505050565b81515f906020840161188264ffffffffff85168284611844565b6028601882901c6001600160601b031610156119545760405163161e118160e31b815260286004820152601882901c6001600160601b03166024820152604401610e40565b5f61195e8261135e565b90505f61196c826028611fbc565b63ffffffff169050601883901c6001600160601b031681146117e9576040516316279d8b60e21b815260048101829052601884901c6001600160601b03166024820152604401610e40565b610154601882901c6001600160601b031610156119fe57604051631cd8e33f60e11b81526101546004820152601882901c6001600160601b03166024820152604401610e40565b5f611a08826112a5565b905063ffffffff8116600114611a3957604051633665681760e11b815263ffffffff82166004820152602401610e40565b5f611a4383611b4b565b90505f611a5282610154611fbc565b63ffffffff169050601884901c6001600160601b03168114611a9d57604051633df4634560e01b815260048101829052601885901c6001600160601b03166024820152604401610e40565b50505050565b5f80601f5b600f8160ff161115611af5575f611ac0826008612015565b60ff1685901c9050611ad181611b60565b61ffff16841793508160ff16601014611aec57601084901b93505b505f1901611aa8565b50600f5b60ff8160ff161015611b45575f611b11826008612015565b60ff1685901c9050611b2281611b60565b61ffff16831792508160ff165f14611b3c57601083901b92505b505f1901611af9565b50915091565b5f61069062ffffff19831661015060046112b4565b5f611b7160048360ff16901c611b91565b60ff1661ffff919091161760081b611b8882611b91565b60ff1617919050565b6040805180820190915260108082526f181899199a1a9b1b9c1cb0b131b232b360811b60208301525f91600f84169182908110611bd057611bd0611f66565b016020015160f81c9392505050565b80356001600160a01b0381168114611275575f5ffd5b5f5f83601f840112611c05575f5ffd5b50813567ffffffffffffffff811115611c1c575f5ffd5b602083019150836020828501011115611c33575f5ffd5b9250929050565b5f5f5f5f60608587031215611c4d575f5ffd5b611c5685611bdf565b9350611c6460208601611bdf565b9250604085013567ffffffffffffffff811115611c7f575f5ffd5b611c8b87828801611bf5565b95989497509550505050565b5f60208284031215611ca7575f5ffd5b610f5282611bdf565b5f5f60408385031215611cc1575f5ffd5b82359150611cd160208401611bdf565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611cfe575f5ffd5b813567ffffffffffffffff811115611d14575f5ffd5b8201601f81018413611d24575f5ffd5b803567ffffffffffffffff811115611d3e57611d3e611cda565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611d6d57611d6d611cda565b604052818152828201602001861015611d84575f5ffd5b816020840160208301375f91810160200191909152949350505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b604081525f611de16040830185611da1565b82810360208401526118828185611da1565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611e7457868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611e5e90870182611da1565b9550506020938401939190910190600101611e19565b50929695505050505050565b5f5f60208385031215611e91575f5ffd5b823567ffffffffffffffff811115611ea7575f5ffd5b611eb385828601611bf5565b90969095509350505050565b602081525f610f526020830184611da1565b6020810160038310611ef157634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561069057610690611ef7565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561069057610690611ef7565b5f60208284031215611f9d575f5ffd5b5051919050565b5f60018201611fb557611fb5611ef7565b5060010190565b63ffffffff818116838216019081111561069057610690611ef7565b5f63ffffffff821663ffffffff8103611ff357611ff3611ef7565b60010192915050565b60ff828116828216039081111561069057610690611ef7565b60ff818116838216029081169081811461203157612031611ef7565b5092915050565b7f54797065644d656d566965772f696e646578202d204f76657272616e20746865815274040ecd2caee5c40a6d8d2c6ca40d2e640c2e84060f605b1b602082015260d085811b6001600160d01b031990811660358401526e040eed2e8d040d8cadccee8d04060f608b1b603b84015285821b8116604a8401527f2e20417474656d7074656420746f20696e646578206174206f666673657420306050840152600f60fb1b60708401529084901b1660718201525f61210e607783016e040eed2e8d040d8cadccee8d04060f608b1b8152600f0190565b612124818560d01b6001600160d01b0319169052565b601760f91b6006820152600701969550505050505056fea164736f6c634300081e000a",
+}
+
+// CircleGatewayMinterHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use CircleGatewayMinterHookMetaData.ABI instead.
+var CircleGatewayMinterHookABI = CircleGatewayMinterHookMetaData.ABI
+
+// CircleGatewayMinterHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CircleGatewayMinterHookMetaData.Bin instead.
+var CircleGatewayMinterHookBin = CircleGatewayMinterHookMetaData.Bin
+
+// DeployCircleGatewayMinterHook deploys a new Ethereum contract, binding an instance of CircleGatewayMinterHook to it.
+func DeployCircleGatewayMinterHook(auth *bind.TransactOpts, backend bind.ContractBackend, gatewayMinterAddress common.Address) (common.Address, *types.Transaction, *CircleGatewayMinterHook, error) {
+	parsed, err := CircleGatewayMinterHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CircleGatewayMinterHookBin), backend, gatewayMinterAddress)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CircleGatewayMinterHook{CircleGatewayMinterHookCaller: CircleGatewayMinterHookCaller{contract: contract}, CircleGatewayMinterHookTransactor: CircleGatewayMinterHookTransactor{contract: contract}, CircleGatewayMinterHookFilterer: CircleGatewayMinterHookFilterer{contract: contract}}, nil
+}
+
+// CircleGatewayMinterHook is an auto generated Go binding around an Ethereum contract.
+type CircleGatewayMinterHook struct {
+	CircleGatewayMinterHookCaller     // Read-only binding to the contract
+	CircleGatewayMinterHookTransactor // Write-only binding to the contract
+	CircleGatewayMinterHookFilterer   // Log filterer for contract events
+}
+
+// CircleGatewayMinterHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CircleGatewayMinterHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayMinterHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CircleGatewayMinterHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayMinterHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CircleGatewayMinterHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayMinterHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CircleGatewayMinterHookSession struct {
+	Contract     *CircleGatewayMinterHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts            // Call options to use throughout this session
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// CircleGatewayMinterHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CircleGatewayMinterHookCallerSession struct {
+	Contract *CircleGatewayMinterHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                  // Call options to use throughout this session
+}
+
+// CircleGatewayMinterHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CircleGatewayMinterHookTransactorSession struct {
+	Contract     *CircleGatewayMinterHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                  // Transaction auth options to use throughout this session
+}
+
+// CircleGatewayMinterHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CircleGatewayMinterHookRaw struct {
+	Contract *CircleGatewayMinterHook // Generic contract binding to access the raw methods on
+}
+
+// CircleGatewayMinterHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CircleGatewayMinterHookCallerRaw struct {
+	Contract *CircleGatewayMinterHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CircleGatewayMinterHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CircleGatewayMinterHookTransactorRaw struct {
+	Contract *CircleGatewayMinterHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCircleGatewayMinterHook creates a new instance of CircleGatewayMinterHook, bound to a specific deployed contract.
+func NewCircleGatewayMinterHook(address common.Address, backend bind.ContractBackend) (*CircleGatewayMinterHook, error) {
+	contract, err := bindCircleGatewayMinterHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayMinterHook{CircleGatewayMinterHookCaller: CircleGatewayMinterHookCaller{contract: contract}, CircleGatewayMinterHookTransactor: CircleGatewayMinterHookTransactor{contract: contract}, CircleGatewayMinterHookFilterer: CircleGatewayMinterHookFilterer{contract: contract}}, nil
+}
+
+// NewCircleGatewayMinterHookCaller creates a new read-only instance of CircleGatewayMinterHook, bound to a specific deployed contract.
+func NewCircleGatewayMinterHookCaller(address common.Address, caller bind.ContractCaller) (*CircleGatewayMinterHookCaller, error) {
+	contract, err := bindCircleGatewayMinterHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayMinterHookCaller{contract: contract}, nil
+}
+
+// NewCircleGatewayMinterHookTransactor creates a new write-only instance of CircleGatewayMinterHook, bound to a specific deployed contract.
+func NewCircleGatewayMinterHookTransactor(address common.Address, transactor bind.ContractTransactor) (*CircleGatewayMinterHookTransactor, error) {
+	contract, err := bindCircleGatewayMinterHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayMinterHookTransactor{contract: contract}, nil
+}
+
+// NewCircleGatewayMinterHookFilterer creates a new log filterer instance of CircleGatewayMinterHook, bound to a specific deployed contract.
+func NewCircleGatewayMinterHookFilterer(address common.Address, filterer bind.ContractFilterer) (*CircleGatewayMinterHookFilterer, error) {
+	contract, err := bindCircleGatewayMinterHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayMinterHookFilterer{contract: contract}, nil
+}
+
+// bindCircleGatewayMinterHook binds a generic wrapper to an already deployed contract.
+func bindCircleGatewayMinterHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CircleGatewayMinterHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CircleGatewayMinterHook.Contract.CircleGatewayMinterHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.CircleGatewayMinterHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.CircleGatewayMinterHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CircleGatewayMinterHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// GATEWAYMINTER is a free data retrieval call binding the contract method 0x6558b981.
+//
+// Solidity: function GATEWAY_MINTER() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) GATEWAYMINTER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "GATEWAY_MINTER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GATEWAYMINTER is a free data retrieval call binding the contract method 0x6558b981.
+//
+// Solidity: function GATEWAY_MINTER() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) GATEWAYMINTER() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.GATEWAYMINTER(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// GATEWAYMINTER is a free data retrieval call binding the contract method 0x6558b981.
+//
+// Solidity: function GATEWAY_MINTER() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) GATEWAYMINTER() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.GATEWAYMINTER(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) SUBTYPE() ([32]byte, error) {
+	return _CircleGatewayMinterHook.Contract.SUBTYPE(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _CircleGatewayMinterHook.Contract.SUBTYPE(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) Asset() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.Asset(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) Asset() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.Asset(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CircleGatewayMinterHook.Contract.Build(&_CircleGatewayMinterHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CircleGatewayMinterHook.Contract.Build(&_CircleGatewayMinterHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAttestationData is a free data retrieval call binding the contract method 0x285d9e9d.
+//
+// Solidity: function decodeAttestationData(bytes data) pure returns(bytes attestationPayload, bytes signature)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) DecodeAttestationData(opts *bind.CallOpts, data []byte) (struct {
+	AttestationPayload []byte
+	Signature          []byte
+}, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "decodeAttestationData", data)
+
+	outstruct := new(struct {
+		AttestationPayload []byte
+		Signature          []byte
+	})
+	if err != nil {
+		return *outstruct, err
+	}
+
+	outstruct.AttestationPayload = *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+	outstruct.Signature = *abi.ConvertType(out[1], new([]byte)).(*[]byte)
+
+	return *outstruct, err
+
+}
+
+// DecodeAttestationData is a free data retrieval call binding the contract method 0x285d9e9d.
+//
+// Solidity: function decodeAttestationData(bytes data) pure returns(bytes attestationPayload, bytes signature)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) DecodeAttestationData(data []byte) (struct {
+	AttestationPayload []byte
+	Signature          []byte
+}, error) {
+	return _CircleGatewayMinterHook.Contract.DecodeAttestationData(&_CircleGatewayMinterHook.CallOpts, data)
+}
+
+// DecodeAttestationData is a free data retrieval call binding the contract method 0x285d9e9d.
+//
+// Solidity: function decodeAttestationData(bytes data) pure returns(bytes attestationPayload, bytes signature)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) DecodeAttestationData(data []byte) (struct {
+	AttestationPayload []byte
+	Signature          []byte
+}, error) {
+	return _CircleGatewayMinterHook.Contract.DecodeAttestationData(&_CircleGatewayMinterHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) ExecutionNonce() (*big.Int, error) {
+	return _CircleGatewayMinterHook.Contract.ExecutionNonce(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _CircleGatewayMinterHook.Contract.ExecutionNonce(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CircleGatewayMinterHook.Contract.GetOutAmount(&_CircleGatewayMinterHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CircleGatewayMinterHook.Contract.GetOutAmount(&_CircleGatewayMinterHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) HookType() (uint8, error) {
+	return _CircleGatewayMinterHook.Contract.HookType(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) HookType() (uint8, error) {
+	return _CircleGatewayMinterHook.Contract.HookType(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) Inspect(data []byte) ([]byte, error) {
+	return _CircleGatewayMinterHook.Contract.Inspect(&_CircleGatewayMinterHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _CircleGatewayMinterHook.Contract.Inspect(&_CircleGatewayMinterHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) LastCaller() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.LastCaller(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) LastCaller() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.LastCaller(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) SpToken() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.SpToken(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) SpToken() (common.Address, error) {
+	return _CircleGatewayMinterHook.Contract.SpToken(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) Subtype() ([32]byte, error) {
+	return _CircleGatewayMinterHook.Contract.Subtype(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) Subtype() ([32]byte, error) {
+	return _CircleGatewayMinterHook.Contract.Subtype(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayMinterHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) UsedShares() (*big.Int, error) {
+	return _CircleGatewayMinterHook.Contract.UsedShares(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookCallerSession) UsedShares() (*big.Int, error) {
+	return _CircleGatewayMinterHook.Contract.UsedShares(&_CircleGatewayMinterHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.PostExecute(&_CircleGatewayMinterHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.PostExecute(&_CircleGatewayMinterHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.PreExecute(&_CircleGatewayMinterHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.PreExecute(&_CircleGatewayMinterHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.ResetExecutionState(&_CircleGatewayMinterHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.ResetExecutionState(&_CircleGatewayMinterHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.SetExecutionContext(&_CircleGatewayMinterHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.SetExecutionContext(&_CircleGatewayMinterHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.SetOutAmount(&_CircleGatewayMinterHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayMinterHook *CircleGatewayMinterHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayMinterHook.Contract.SetOutAmount(&_CircleGatewayMinterHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package CircleGatewayWalletHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// CircleGatewayWalletHookMetaData contains all meta data concerning the CircleGatewayWalletHook contract.
+var CircleGatewayWalletHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"gatewayWalletAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"GATEWAY_WALLET\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeToken\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161139638038061139683398101604081905261002e916100b1565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b0381166100a057604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100de565b5f602082840312156100c1575f5ffd5b81516001600160a01b03811681146100d7575f5ffd5b9392505050565b60805160a0516112746101225f395f81816102a601528181610a6401528181610b0601528181610ba40152610c6c01525f8181610206015261027f01526112745ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063a68736f81161006e578063a68736f8146102a1578063ac440f72146102c8578063d06a1e89146102db578063e445e7dd146102ee578063e774551714610307575f5ffd5b8063685a943c1461022a57806381cbe691146102325780638c4313c1146102455780638e14877614610268578063984d6e7a1461027a575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806338d52e0f146101bf5780633b5896bc146101d15780634c07aefa146101f15780635492e67714610204575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a610155366004610f02565b61032a565b005b61015a61016a366004610f5f565b6103a4565b61015a61017d366004610f7f565b6103c5565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba366004610f02565b61041e565b6101946001600160a01b0360025c1681565b6101e46101df366004610f02565b61048a565b60405161013e9190610fd7565b6101946101ff366004611078565b6106a3565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b6101345f5c81565b610134610240366004610f5f565b6106b4565b61025b61025336600461112b565b606092915050565b60405161013e919061116a565b6101946001600160a01b0360015c1681565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b6101947f000000000000000000000000000000000000000000000000000000000000000081565b6101346102d6366004611078565b6106c6565b61015a6102e9366004610f5f565b6106d2565b5f546102fa9060ff1681565b60405161013e919061117c565b61031a610315366004611078565b61074f565b604051901515815260200161013e565b336001600160a01b038416146103535760405163e15e56c960e01b815260040160405180910390fd5b5f61035d8461075b565b90506103688161076e565b156103865760405163945b63f560e01b815260040160405180910390fd5b61039181600161077b565b61039d85858585610791565b5050505050565b6103ad816107df565b50336004805c6001600160a01b0319168217905d5050565b5f6103cf8261075b565b90506103da81610811565b806103e957506103e98161076e565b156104075760405163441e4c7360e11b815260040160405180910390fd5b5f61041382600161081a565b905083815d50505050565b336001600160a01b038416146104475760405163e15e56c960e01b815260040160405180910390fd5b5f6104518461075b565b905061045c81610811565b1561047a57604051630bbb04d960e11b815260040160405180910390fd5b61048581600161086f565b61039d565b60605f6104998686868661087b565b9050805160026104a991906111b6565b67ffffffffffffffff8111156104c1576104c1611064565b60405190808252806020026020018201604052801561050d57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104df5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161055694939291906111c9565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061059857610598611211565b60209081029190910101525f5b81518110156105f9578181815181106105c0576105c0611211565b6020026020010151838260016105d691906111b6565b815181106105e6576105e6611211565b60209081029190910101526001016105a5565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161064094939291906111c9565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161067f9190611225565b8151811061068f5761068f611211565b602002602001018190525050949350505050565b5f6106ae825f610d08565b92915050565b5f6106ae6106c18361075b565b610d71565b5f6106ae826014610d7e565b336001600160a01b0360045c16146106fd5760405163e15e56c960e01b815260040160405180910390fd5b5f6107078261075b565b905061071281610811565b158061072457506107228161076e565b155b1561074257604051634bd439b560e11b815260040160405180910390fd5b61074b81610ddb565b5050565b5f6106ae826034610df2565b5f5f61076683610e1e565b5c9392505050565b5f5f61076683600361081a565b5f61078783600361081a565b905081815d505050565b5f6107d383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610d7e915050565b905061039d8185610e8a565b5f6003805c90826107ef83611238565b9190505d505f6107fe83610e1e565b905060035c80825d505060035c92915050565b5f5f6107668360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61078783600261081a565b60605f6108bc84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610d08915050565b90505f61090085858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610d7e915050565b90505f61094486868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610df2915050565b905080156109b7576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610990573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109b49190611250565b91505b6001600160a01b0383166109de57604051630f58648f60e01b815260040160405180910390fd5b815f036109fe576040516305112ab160e41b815260040160405180910390fd5b60408051600480825260a0820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610a14575050604080516060810182526001600160a01b0380871682525f6020830181905283517f000000000000000000000000000000000000000000000000000000000000000090921660248301526044820152929650919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185905f90610ad957610ad9611211565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f81526020017f000000000000000000000000000000000000000000000000000000000000000084604051602401610b4b9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185906001908110610b8c57610b8c611211565b602002602001018190525060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f81526020018484604051602401610bfe9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b03166311f9fbc960e21b1790529052845185906002908110610c3f57610c3f611211565b60200260200101819052506040518060600160405280846001600160a01b031681526020015f81526020017f00000000000000000000000000000000000000000000000000000000000000005f604051602401610cb19291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185906003908110610cf257610cf2611211565b6020026020010181905250505050949350505050565b5f610d148260146111b6565b83511015610d615760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f5f61076683600161081a565b5f610d8a8260206111b6565b83511015610dd25760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610d58565b50016020015190565b610de5815f61086f565b610def815f61077b565b50565b5f828281518110610e0557610e05611211565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610e6d92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610e948261075b565b90505f61041382600161081a565b80356001600160a01b0381168114610eb8575f5ffd5b919050565b5f5f83601f840112610ecd575f5ffd5b50813567ffffffffffffffff811115610ee4575f5ffd5b602083019150836020828501011115610efb575f5ffd5b9250929050565b5f5f5f5f60608587031215610f15575f5ffd5b610f1e85610ea2565b9350610f2c60208601610ea2565b9250604085013567ffffffffffffffff811115610f47575f5ffd5b610f5387828801610ebd565b95989497509550505050565b5f60208284031215610f6f575f5ffd5b610f7882610ea2565b9392505050565b5f5f60408385031215610f90575f5ffd5b82359150610fa060208401610ea2565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561105857868503603f19018452815180516001600160a01b031686526020808201519087015260409081015160609187018290529061104290870182610fa9565b9550506020938401939190910190600101610ffd565b50929695505050505050565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611088575f5ffd5b813567ffffffffffffffff81111561109e575f5ffd5b8201601f810184136110ae575f5ffd5b803567ffffffffffffffff8111156110c8576110c8611064565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156110f7576110f7611064565b60405281815282820160200186101561110e575f5ffd5b816020840160208301375f91810160200191909152949350505050565b5f5f6020838503121561113c575f5ffd5b823567ffffffffffffffff811115611152575f5ffd5b61115e85828601610ebd565b90969095509350505050565b602081525f610f786020830184610fa9565b602081016003831061119c57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106ae576106ae6111a2565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106ae576106ae6111a2565b5f60018201611249576112496111a2565b5060010190565b5f60208284031215611260575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// CircleGatewayWalletHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use CircleGatewayWalletHookMetaData.ABI instead.
+var CircleGatewayWalletHookABI = CircleGatewayWalletHookMetaData.ABI
+
+// CircleGatewayWalletHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use CircleGatewayWalletHookMetaData.Bin instead.
+var CircleGatewayWalletHookBin = CircleGatewayWalletHookMetaData.Bin
+
+// DeployCircleGatewayWalletHook deploys a new Ethereum contract, binding an instance of CircleGatewayWalletHook to it.
+func DeployCircleGatewayWalletHook(auth *bind.TransactOpts, backend bind.ContractBackend, gatewayWalletAddress common.Address) (common.Address, *types.Transaction, *CircleGatewayWalletHook, error) {
+	parsed, err := CircleGatewayWalletHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(CircleGatewayWalletHookBin), backend, gatewayWalletAddress)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &CircleGatewayWalletHook{CircleGatewayWalletHookCaller: CircleGatewayWalletHookCaller{contract: contract}, CircleGatewayWalletHookTransactor: CircleGatewayWalletHookTransactor{contract: contract}, CircleGatewayWalletHookFilterer: CircleGatewayWalletHookFilterer{contract: contract}}, nil
+}
+
+// CircleGatewayWalletHook is an auto generated Go binding around an Ethereum contract.
+type CircleGatewayWalletHook struct {
+	CircleGatewayWalletHookCaller     // Read-only binding to the contract
+	CircleGatewayWalletHookTransactor // Write-only binding to the contract
+	CircleGatewayWalletHookFilterer   // Log filterer for contract events
+}
+
+// CircleGatewayWalletHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type CircleGatewayWalletHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayWalletHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type CircleGatewayWalletHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayWalletHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type CircleGatewayWalletHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// CircleGatewayWalletHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type CircleGatewayWalletHookSession struct {
+	Contract     *CircleGatewayWalletHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts            // Call options to use throughout this session
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// CircleGatewayWalletHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type CircleGatewayWalletHookCallerSession struct {
+	Contract *CircleGatewayWalletHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                  // Call options to use throughout this session
+}
+
+// CircleGatewayWalletHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type CircleGatewayWalletHookTransactorSession struct {
+	Contract     *CircleGatewayWalletHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                  // Transaction auth options to use throughout this session
+}
+
+// CircleGatewayWalletHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type CircleGatewayWalletHookRaw struct {
+	Contract *CircleGatewayWalletHook // Generic contract binding to access the raw methods on
+}
+
+// CircleGatewayWalletHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type CircleGatewayWalletHookCallerRaw struct {
+	Contract *CircleGatewayWalletHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// CircleGatewayWalletHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type CircleGatewayWalletHookTransactorRaw struct {
+	Contract *CircleGatewayWalletHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewCircleGatewayWalletHook creates a new instance of CircleGatewayWalletHook, bound to a specific deployed contract.
+func NewCircleGatewayWalletHook(address common.Address, backend bind.ContractBackend) (*CircleGatewayWalletHook, error) {
+	contract, err := bindCircleGatewayWalletHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayWalletHook{CircleGatewayWalletHookCaller: CircleGatewayWalletHookCaller{contract: contract}, CircleGatewayWalletHookTransactor: CircleGatewayWalletHookTransactor{contract: contract}, CircleGatewayWalletHookFilterer: CircleGatewayWalletHookFilterer{contract: contract}}, nil
+}
+
+// NewCircleGatewayWalletHookCaller creates a new read-only instance of CircleGatewayWalletHook, bound to a specific deployed contract.
+func NewCircleGatewayWalletHookCaller(address common.Address, caller bind.ContractCaller) (*CircleGatewayWalletHookCaller, error) {
+	contract, err := bindCircleGatewayWalletHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayWalletHookCaller{contract: contract}, nil
+}
+
+// NewCircleGatewayWalletHookTransactor creates a new write-only instance of CircleGatewayWalletHook, bound to a specific deployed contract.
+func NewCircleGatewayWalletHookTransactor(address common.Address, transactor bind.ContractTransactor) (*CircleGatewayWalletHookTransactor, error) {
+	contract, err := bindCircleGatewayWalletHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayWalletHookTransactor{contract: contract}, nil
+}
+
+// NewCircleGatewayWalletHookFilterer creates a new log filterer instance of CircleGatewayWalletHook, bound to a specific deployed contract.
+func NewCircleGatewayWalletHookFilterer(address common.Address, filterer bind.ContractFilterer) (*CircleGatewayWalletHookFilterer, error) {
+	contract, err := bindCircleGatewayWalletHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &CircleGatewayWalletHookFilterer{contract: contract}, nil
+}
+
+// bindCircleGatewayWalletHook binds a generic wrapper to an already deployed contract.
+func bindCircleGatewayWalletHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := CircleGatewayWalletHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CircleGatewayWalletHook.Contract.CircleGatewayWalletHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.CircleGatewayWalletHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.CircleGatewayWalletHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _CircleGatewayWalletHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// GATEWAYWALLET is a free data retrieval call binding the contract method 0xa68736f8.
+//
+// Solidity: function GATEWAY_WALLET() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) GATEWAYWALLET(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "GATEWAY_WALLET")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GATEWAYWALLET is a free data retrieval call binding the contract method 0xa68736f8.
+//
+// Solidity: function GATEWAY_WALLET() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) GATEWAYWALLET() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.GATEWAYWALLET(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// GATEWAYWALLET is a free data retrieval call binding the contract method 0xa68736f8.
+//
+// Solidity: function GATEWAY_WALLET() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) GATEWAYWALLET() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.GATEWAYWALLET(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) SUBTYPE() ([32]byte, error) {
+	return _CircleGatewayWalletHook.Contract.SUBTYPE(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _CircleGatewayWalletHook.Contract.SUBTYPE(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) Asset() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.Asset(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) Asset() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.Asset(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CircleGatewayWalletHook.Contract.Build(&_CircleGatewayWalletHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _CircleGatewayWalletHook.Contract.Build(&_CircleGatewayWalletHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.DecodeAmount(&_CircleGatewayWalletHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.DecodeAmount(&_CircleGatewayWalletHook.CallOpts, data)
+}
+
+// DecodeToken is a free data retrieval call binding the contract method 0x4c07aefa.
+//
+// Solidity: function decodeToken(bytes data) pure returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) DecodeToken(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "decodeToken", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DecodeToken is a free data retrieval call binding the contract method 0x4c07aefa.
+//
+// Solidity: function decodeToken(bytes data) pure returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) DecodeToken(data []byte) (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.DecodeToken(&_CircleGatewayWalletHook.CallOpts, data)
+}
+
+// DecodeToken is a free data retrieval call binding the contract method 0x4c07aefa.
+//
+// Solidity: function decodeToken(bytes data) pure returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) DecodeToken(data []byte) (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.DecodeToken(&_CircleGatewayWalletHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _CircleGatewayWalletHook.Contract.DecodeUsePrevHookAmount(&_CircleGatewayWalletHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _CircleGatewayWalletHook.Contract.DecodeUsePrevHookAmount(&_CircleGatewayWalletHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) ExecutionNonce() (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.ExecutionNonce(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.ExecutionNonce(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.GetOutAmount(&_CircleGatewayWalletHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.GetOutAmount(&_CircleGatewayWalletHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) HookType() (uint8, error) {
+	return _CircleGatewayWalletHook.Contract.HookType(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) HookType() (uint8, error) {
+	return _CircleGatewayWalletHook.Contract.HookType(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) Inspect(opts *bind.CallOpts, arg0 []byte) ([]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "inspect", arg0)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _CircleGatewayWalletHook.Contract.Inspect(&_CircleGatewayWalletHook.CallOpts, arg0)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _CircleGatewayWalletHook.Contract.Inspect(&_CircleGatewayWalletHook.CallOpts, arg0)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) LastCaller() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.LastCaller(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) LastCaller() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.LastCaller(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) SpToken() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.SpToken(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) SpToken() (common.Address, error) {
+	return _CircleGatewayWalletHook.Contract.SpToken(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) Subtype() ([32]byte, error) {
+	return _CircleGatewayWalletHook.Contract.Subtype(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) Subtype() ([32]byte, error) {
+	return _CircleGatewayWalletHook.Contract.Subtype(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _CircleGatewayWalletHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) UsedShares() (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.UsedShares(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookCallerSession) UsedShares() (*big.Int, error) {
+	return _CircleGatewayWalletHook.Contract.UsedShares(&_CircleGatewayWalletHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.PostExecute(&_CircleGatewayWalletHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.PostExecute(&_CircleGatewayWalletHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.PreExecute(&_CircleGatewayWalletHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.PreExecute(&_CircleGatewayWalletHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.ResetExecutionState(&_CircleGatewayWalletHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.ResetExecutionState(&_CircleGatewayWalletHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.SetExecutionContext(&_CircleGatewayWalletHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.SetExecutionContext(&_CircleGatewayWalletHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.SetOutAmount(&_CircleGatewayWalletHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_CircleGatewayWalletHook *CircleGatewayWalletHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _CircleGatewayWalletHook.Contract.SetOutAmount(&_CircleGatewayWalletHook.TransactOpts, _outAmount, caller)
+}
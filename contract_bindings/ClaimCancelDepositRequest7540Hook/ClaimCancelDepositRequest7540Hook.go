@@ -0,0 +1,687 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ClaimCancelDepositRequest7540Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ClaimCancelDepositRequest7540HookMetaData contains all meta data concerning the ClaimCancelDepositRequest7540Hook contract.
+var ClaimCancelDepositRequest7540HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"isAsyncCancelHook\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHookAsyncCancelations.CancelationType\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152601981527f436c61696d43616e63656c4465706f73697452657175657374000000000000006020909101525f805460ff191690557f1c2fb4885af674cedd52ef1d0798d4ea9a7f488becb481a135e68deb9e8f51f46080526080516110a76100905f395f81816101e2015261025801526110a75ff3fe608060405234801561000f575f5ffd5b5060043610610106575f3560e01c80633b5896bc1161009e5780638c4313c11161006e5780638c4313c1146102215780638e14877614610241578063984d6e7a14610253578063d06a1e891461027a578063e445e7dd1461028d575f5ffd5b80633b5896bc146101c05780635492e677146101e0578063685a943c1461020657806381cbe6911461020e575f5ffd5b80631f264619116100d95780631f2646191461015e5780632113522a146101715780632ae2fe3d1461019b57806338d52e0f146101ae575f5ffd5b806301bd43ef1461010a57806305b4fe911461012657806308c189f91461013b57806314cb37cf1461014b575b5f5ffd5b61011360035c81565b6040519081526020015b60405180910390f35b610139610134366004610dc1565b610299565b005b60015b60405161011d9190610e3e565b610139610159366004610e51565b610313565b61013961016c366004610e6c565b610334565b6101836001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161011d565b6101396101a9366004610dc1565b61038d565b6101836001600160a01b0360025c1681565b6101d36101ce366004610dc1565b610400565b60405161011d9190610ec8565b7f0000000000000000000000000000000000000000000000000000000000000000610113565b6101135f5c81565b61011361021c366004610e51565b610619565b61023461022f366004610f55565b610631565b60405161011d9190610f94565b6101836001600160a01b0360015c1681565b6101137f000000000000000000000000000000000000000000000000000000000000000081565b610139610288366004610e51565b6106f3565b5f5461013e9060ff1681565b336001600160a01b038416146102c25760405163e15e56c960e01b815260040160405180910390fd5b5f6102cc84610770565b90506102d781610783565b156102f55760405163945b63f560e01b815260040160405180910390fd5b610300816001610790565b61030c858585856107a6565b5050505050565b61031c81610845565b50336004805c6001600160a01b0319168217905d5050565b5f61033e82610770565b905061034981610877565b80610358575061035881610783565b156103765760405163441e4c7360e11b815260040160405180910390fd5b5f610382826001610880565b905083815d50505050565b336001600160a01b038416146103b65760405163e15e56c960e01b815260040160405180910390fd5b5f6103c084610770565b90506103cb81610877565b156103e957604051630bbb04d960e11b815260040160405180910390fd5b6103f48160016108d5565b61030c858585856108e1565b60605f61040f86868686610a32565b90508051600261041f9190610fba565b67ffffffffffffffff81111561043757610437610fcd565b60405190808252806020026020018201604052801561048357816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104555790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104cc9493929190610fe1565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061050e5761050e611029565b60209081029190910101525f5b815181101561056f5781818151811061053657610536611029565b60200260200101518382600161054c9190610fba565b8151811061055c5761055c611029565b602090810291909101015260010161051b565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105b69493929190610fe1565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516105f5919061103d565b8151811061060557610605611029565b602002602001018190525050949350505050565b5f61062b61062683610770565b610bdb565b92915050565b606061067183838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610be892505050565b6106b284848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bf0915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b336001600160a01b0360045c161461071e5760405163e15e56c960e01b815260040160405180910390fd5b5f61072882610770565b905061073381610877565b1580610745575061074381610783565b155b1561076357604051634bd439b560e11b815260040160405180910390fd5b61076c81610c58565b5050565b5f5f61077b83610c6f565b5c9392505050565b5f5f61077b836003610880565b5f61079c836003610880565b905081815d505050565b5f6107e883838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bf0915050565b905061030c6107f685610619565b6108358386868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cdb92505050565b61083f919061103d565b85610d50565b5f6003805c908261085583611050565b9190505d505f61086483610c6f565b905060035c80825d505060035c92915050565b5f5f61077b8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61079c836002610880565b5f61092083838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610be892505050565b90505f61096484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bf0915050565b9050816001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109a2573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109c69190611068565b6002805c6001600160a01b0319166001600160a01b03831617905d50610a2a610a248286868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cdb92505050565b86610d50565b505050505050565b60605f610a7384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610be892505050565b90505f610ab785858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bf0915050565b90506001600160a01b0382161580610ad657506001600160a01b038116155b15610af457604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b095790505092506040518060600160405280836001600160a01b031681526020015f81526020015f8389604051602401610b88939291909283526001600160a01b03918216602084015216604082015260600190565b60408051601f198184030181529190526020810180516001600160e01b0316631a75de9160e21b1790529052835184905f90610bc657610bc6611029565b60200260200101819052505050949350505050565b5f5f61077b836001610880565b5f61062b8260205b5f610bfc826014610fba565b83511015610c485760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b610c62815f6108d5565b610c6c815f610790565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610cbe92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610d25573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d499190611083565b9392505050565b5f610d5a82610770565b90505f610382826001610880565b6001600160a01b0381168114610c6c575f5ffd5b5f5f83601f840112610d8c575f5ffd5b50813567ffffffffffffffff811115610da3575f5ffd5b602083019150836020828501011115610dba575f5ffd5b9250929050565b5f5f5f5f60608587031215610dd4575f5ffd5b8435610ddf81610d68565b93506020850135610def81610d68565b9250604085013567ffffffffffffffff811115610e0a575f5ffd5b610e1687828801610d7c565b95989497509550505050565b60038110610c6c57634e487b7160e01b5f52602160045260245ffd5b60208101610e4b83610e22565b91905290565b5f60208284031215610e61575f5ffd5b8135610d4981610d68565b5f5f60408385031215610e7d575f5ffd5b823591506020830135610e8f81610d68565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610f4957868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610f3390870182610e9a565b9550506020938401939190910190600101610eee565b50929695505050505050565b5f5f60208385031215610f66575f5ffd5b823567ffffffffffffffff811115610f7c575f5ffd5b610f8885828601610d7c565b90969095509350505050565b602081525f610d496020830184610e9a565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561062b5761062b610fa6565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561062b5761062b610fa6565b5f6001820161106157611061610fa6565b5060010190565b5f60208284031215611078575f5ffd5b8151610d4981610d68565b5f60208284031215611093575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// ClaimCancelDepositRequest7540HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ClaimCancelDepositRequest7540HookMetaData.ABI instead.
+var ClaimCancelDepositRequest7540HookABI = ClaimCancelDepositRequest7540HookMetaData.ABI
+
+// ClaimCancelDepositRequest7540HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ClaimCancelDepositRequest7540HookMetaData.Bin instead.
+var ClaimCancelDepositRequest7540HookBin = ClaimCancelDepositRequest7540HookMetaData.Bin
+
+// DeployClaimCancelDepositRequest7540Hook deploys a new Ethereum contract, binding an instance of ClaimCancelDepositRequest7540Hook to it.
+func DeployClaimCancelDepositRequest7540Hook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ClaimCancelDepositRequest7540Hook, error) {
+	parsed, err := ClaimCancelDepositRequest7540HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ClaimCancelDepositRequest7540HookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ClaimCancelDepositRequest7540Hook{ClaimCancelDepositRequest7540HookCaller: ClaimCancelDepositRequest7540HookCaller{contract: contract}, ClaimCancelDepositRequest7540HookTransactor: ClaimCancelDepositRequest7540HookTransactor{contract: contract}, ClaimCancelDepositRequest7540HookFilterer: ClaimCancelDepositRequest7540HookFilterer{contract: contract}}, nil
+}
+
+// ClaimCancelDepositRequest7540Hook is an auto generated Go binding around an Ethereum contract.
+type ClaimCancelDepositRequest7540Hook struct {
+	ClaimCancelDepositRequest7540HookCaller     // Read-only binding to the contract
+	ClaimCancelDepositRequest7540HookTransactor // Write-only binding to the contract
+	ClaimCancelDepositRequest7540HookFilterer   // Log filterer for contract events
+}
+
+// ClaimCancelDepositRequest7540HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ClaimCancelDepositRequest7540HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ClaimCancelDepositRequest7540HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ClaimCancelDepositRequest7540HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ClaimCancelDepositRequest7540HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ClaimCancelDepositRequest7540HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ClaimCancelDepositRequest7540HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ClaimCancelDepositRequest7540HookSession struct {
+	Contract     *ClaimCancelDepositRequest7540Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                  // Transaction auth options to use throughout this session
+}
+
+// ClaimCancelDepositRequest7540HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ClaimCancelDepositRequest7540HookCallerSession struct {
+	Contract *ClaimCancelDepositRequest7540HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                            // Call options to use throughout this session
+}
+
+// ClaimCancelDepositRequest7540HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ClaimCancelDepositRequest7540HookTransactorSession struct {
+	Contract     *ClaimCancelDepositRequest7540HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                            // Transaction auth options to use throughout this session
+}
+
+// ClaimCancelDepositRequest7540HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ClaimCancelDepositRequest7540HookRaw struct {
+	Contract *ClaimCancelDepositRequest7540Hook // Generic contract binding to access the raw methods on
+}
+
+// ClaimCancelDepositRequest7540HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ClaimCancelDepositRequest7540HookCallerRaw struct {
+	Contract *ClaimCancelDepositRequest7540HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ClaimCancelDepositRequest7540HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ClaimCancelDepositRequest7540HookTransactorRaw struct {
+	Contract *ClaimCancelDepositRequest7540HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewClaimCancelDepositRequest7540Hook creates a new instance of ClaimCancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelDepositRequest7540Hook(address common.Address, backend bind.ContractBackend) (*ClaimCancelDepositRequest7540Hook, error) {
+	contract, err := bindClaimCancelDepositRequest7540Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelDepositRequest7540Hook{ClaimCancelDepositRequest7540HookCaller: ClaimCancelDepositRequest7540HookCaller{contract: contract}, ClaimCancelDepositRequest7540HookTransactor: ClaimCancelDepositRequest7540HookTransactor{contract: contract}, ClaimCancelDepositRequest7540HookFilterer: ClaimCancelDepositRequest7540HookFilterer{contract: contract}}, nil
+}
+
+// NewClaimCancelDepositRequest7540HookCaller creates a new read-only instance of ClaimCancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelDepositRequest7540HookCaller(address common.Address, caller bind.ContractCaller) (*ClaimCancelDepositRequest7540HookCaller, error) {
+	contract, err := bindClaimCancelDepositRequest7540Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelDepositRequest7540HookCaller{contract: contract}, nil
+}
+
+// NewClaimCancelDepositRequest7540HookTransactor creates a new write-only instance of ClaimCancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelDepositRequest7540HookTransactor(address common.Address, transactor bind.ContractTransactor) (*ClaimCancelDepositRequest7540HookTransactor, error) {
+	contract, err := bindClaimCancelDepositRequest7540Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelDepositRequest7540HookTransactor{contract: contract}, nil
+}
+
+// NewClaimCancelDepositRequest7540HookFilterer creates a new log filterer instance of ClaimCancelDepositRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelDepositRequest7540HookFilterer(address common.Address, filterer bind.ContractFilterer) (*ClaimCancelDepositRequest7540HookFilterer, error) {
+	contract, err := bindClaimCancelDepositRequest7540Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelDepositRequest7540HookFilterer{contract: contract}, nil
+}
+
+// bindClaimCancelDepositRequest7540Hook binds a generic wrapper to an already deployed contract.
+func bindClaimCancelDepositRequest7540Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ClaimCancelDepositRequest7540HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ClaimCancelDepositRequest7540HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ClaimCancelDepositRequest7540HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ClaimCancelDepositRequest7540HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ClaimCancelDepositRequest7540Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) SUBTYPE() ([32]byte, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SUBTYPE(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SUBTYPE(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) Asset() (common.Address, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Asset(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) Asset() (common.Address, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Asset(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Build(&_ClaimCancelDepositRequest7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Build(&_ClaimCancelDepositRequest7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) ExecutionNonce() (*big.Int, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ExecutionNonce(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ExecutionNonce(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.GetOutAmount(&_ClaimCancelDepositRequest7540Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.GetOutAmount(&_ClaimCancelDepositRequest7540Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) HookType() (uint8, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.HookType(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) HookType() (uint8, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.HookType(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) Inspect(data []byte) ([]byte, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Inspect(&_ClaimCancelDepositRequest7540Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Inspect(&_ClaimCancelDepositRequest7540Hook.CallOpts, data)
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) IsAsyncCancelHook(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "isAsyncCancelHook")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) IsAsyncCancelHook() (uint8, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.IsAsyncCancelHook(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) IsAsyncCancelHook() (uint8, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.IsAsyncCancelHook(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) LastCaller() (common.Address, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.LastCaller(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) LastCaller() (common.Address, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.LastCaller(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) SpToken() (common.Address, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SpToken(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) SpToken() (common.Address, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SpToken(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) Subtype() ([32]byte, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Subtype(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) Subtype() ([32]byte, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.Subtype(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelDepositRequest7540Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) UsedShares() (*big.Int, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.UsedShares(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookCallerSession) UsedShares() (*big.Int, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.UsedShares(&_ClaimCancelDepositRequest7540Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.PostExecute(&_ClaimCancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.PostExecute(&_ClaimCancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.PreExecute(&_ClaimCancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.PreExecute(&_ClaimCancelDepositRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ResetExecutionState(&_ClaimCancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.ResetExecutionState(&_ClaimCancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SetExecutionContext(&_ClaimCancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SetExecutionContext(&_ClaimCancelDepositRequest7540Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SetOutAmount(&_ClaimCancelDepositRequest7540Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ClaimCancelDepositRequest7540Hook *ClaimCancelDepositRequest7540HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelDepositRequest7540Hook.Contract.SetOutAmount(&_ClaimCancelDepositRequest7540Hook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package ClaimCancelRedeemRequest7540Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ClaimCancelRedeemRequest7540HookMetaData contains all meta data concerning the ClaimCancelRedeemRequest7540Hook contract.
+var ClaimCancelRedeemRequest7540HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"dstChainId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"isAsyncCancelHook\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHookAsyncCancelations.CancelationType\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vaultBank\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152601881527f436c61696d43616e63656c52656465656d5265717565737400000000000000006020909101525f805460ff191690557fa96126c9c00e455bebc980e686ab0ae5d752d56bd4dce8172fe193b82e6917b86080526080516110f26100905f395f8181610201015261028901526110f25ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80633b5896bc116100a95780638c4313c11161006e5780638c4313c1146102525780638e14877614610272578063984d6e7a14610284578063d06a1e89146102ab578063e445e7dd146102be575f5ffd5b80633b5896bc146101df5780635492e677146101ff578063685a943c14610225578063701ffc8b1461022d57806381cbe6911461023f575f5ffd5b80631f264619116100ef5780631f264619146101745780632113522a146101875780632ae2fe3d146101b157806330c593f7146101c457806338d52e0f146101cd575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806308c189f91461015157806314cb37cf14610161575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610e0c565b6102ca565b005b60025b6040516101339190610e89565b61014f61016f366004610e9c565b610344565b61014f610182366004610eb7565b610365565b6101996001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101bf366004610e0c565b6103be565b61012960065c81565b6101996001600160a01b0360025c1681565b6101f26101ed366004610e0c565b610431565b6040516101339190610f13565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b6101295f5c81565b6101996001600160a01b0360055c1681565b61012961024d366004610e9c565b61064a565b610265610260366004610fa0565b610662565b6040516101339190610fdf565b6101996001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102b9366004610e9c565b610724565b5f546101549060ff1681565b336001600160a01b038416146102f35760405163e15e56c960e01b815260040160405180910390fd5b5f6102fd846107a1565b9050610308816107b4565b156103265760405163945b63f560e01b815260040160405180910390fd5b6103318160016107c1565b61033d858585856107d7565b5050505050565b61034d81610876565b50336004805c6001600160a01b0319168217905d5050565b5f61036f826107a1565b905061037a816108a8565b806103895750610389816107b4565b156103a75760405163441e4c7360e11b815260040160405180910390fd5b5f6103b38260016108b1565b905083815d50505050565b336001600160a01b038416146103e75760405163e15e56c960e01b815260040160405180910390fd5b5f6103f1846107a1565b90506103fc816108a8565b1561041a57604051630bbb04d960e11b815260040160405180910390fd5b610425816001610906565b61033d85858585610912565b60605f61044086868686610a19565b9050805160026104509190611005565b67ffffffffffffffff81111561046857610468611018565b6040519080825280602002602001820160405280156104b457816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104865790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104fd949392919061102c565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061053f5761053f611074565b60209081029190910101525f5b81518110156105a05781818151811061056757610567611074565b60200260200101518382600161057d9190611005565b8151811061058d5761058d611074565b602090810291909101015260010161054c565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105e7949392919061102c565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106269190611088565b8151811061063657610636611074565b602002602001018190525050949350505050565b5f61065c610657836107a1565b610bc1565b92915050565b60606106a283838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bce92505050565b6106e384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bd6915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b336001600160a01b0360045c161461074f5760405163e15e56c960e01b815260040160405180910390fd5b5f610759826107a1565b9050610764816108a8565b15806107765750610774816107b4565b155b1561079457604051634bd439b560e11b815260040160405180910390fd5b61079d81610c3e565b5050565b5f5f6107ac83610c55565b5c9392505050565b5f5f6107ac8360036108b1565b5f6107cd8360036108b1565b905081815d505050565b5f61081983838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bd6915050565b905061033d6108278561064a565b6108668386868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cc192505050565b6108709190611088565b85610d9b565b5f6003805c90826108868361109b565b9190505d505f61089583610c55565b905060035c80825d505060035c92915050565b5f5f6107ac8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107cd8360026108b1565b61095a6109548484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cc192505050565b84610d9b565b61099882828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bce92505050565b6001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109d3573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109f791906110b3565b6001805c6001600160a01b0319166001600160a01b03831617905d5050505050565b60605f610a5a84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bce92505050565b90505f610a9e85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610bd6915050565b90506001600160a01b0382161580610abd57506001600160a01b038116155b15610adb57604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610af05790505092506040518060600160405280836001600160a01b031681526020015f81526020015f8389604051602401610b6f939291909283526001600160a01b03918216602084015216604082015260600190565b60408051601f198184030181529190526020810180516001600160e01b031662a06d1960e01b1790529052835184905f90610bac57610bac611074565b60200260200101819052505050949350505050565b5f5f6107ac8360016108b1565b5f61065c8260205b5f610be2826014611005565b83511015610c2e5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b610c48815f610906565b610c52815f6107c1565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610ca492919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610ccb82610bce565b6001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610d06573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d2a91906110b3565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610d70573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d9491906110ce565b9392505050565b5f610da5826107a1565b90505f6103b38260016108b1565b6001600160a01b0381168114610c52575f5ffd5b5f5f83601f840112610dd7575f5ffd5b50813567ffffffffffffffff811115610dee575f5ffd5b602083019150836020828501011115610e05575f5ffd5b9250929050565b5f5f5f5f60608587031215610e1f575f5ffd5b8435610e2a81610db3565b93506020850135610e3a81610db3565b9250604085013567ffffffffffffffff811115610e55575f5ffd5b610e6187828801610dc7565b95989497509550505050565b60038110610c5257634e487b7160e01b5f52602160045260245ffd5b60208101610e9683610e6d565b91905290565b5f60208284031215610eac575f5ffd5b8135610d9481610db3565b5f5f60408385031215610ec8575f5ffd5b823591506020830135610eda81610db3565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610f9457868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610f7e90870182610ee5565b9550506020938401939190910190600101610f39565b50929695505050505050565b5f5f60208385031215610fb1575f5ffd5b823567ffffffffffffffff811115610fc7575f5ffd5b610fd385828601610dc7565b90969095509350505050565b602081525f610d946020830184610ee5565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561065c5761065c610ff1565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561065c5761065c610ff1565b5f600182016110ac576110ac610ff1565b5060010190565b5f602082840312156110c3575f5ffd5b8151610d9481610db3565b5f602082840312156110de575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// ClaimCancelRedeemRequest7540HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use ClaimCancelRedeemRequest7540HookMetaData.ABI instead.
+var ClaimCancelRedeemRequest7540HookABI = ClaimCancelRedeemRequest7540HookMetaData.ABI
+
+// ClaimCancelRedeemRequest7540HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ClaimCancelRedeemRequest7540HookMetaData.Bin instead.
+var ClaimCancelRedeemRequest7540HookBin = ClaimCancelRedeemRequest7540HookMetaData.Bin
+
+// DeployClaimCancelRedeemRequest7540Hook deploys a new Ethereum contract, binding an instance of ClaimCancelRedeemRequest7540Hook to it.
+func DeployClaimCancelRedeemRequest7540Hook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *ClaimCancelRedeemRequest7540Hook, error) {
+	parsed, err := ClaimCancelRedeemRequest7540HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ClaimCancelRedeemRequest7540HookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ClaimCancelRedeemRequest7540Hook{ClaimCancelRedeemRequest7540HookCaller: ClaimCancelRedeemRequest7540HookCaller{contract: contract}, ClaimCancelRedeemRequest7540HookTransactor: ClaimCancelRedeemRequest7540HookTransactor{contract: contract}, ClaimCancelRedeemRequest7540HookFilterer: ClaimCancelRedeemRequest7540HookFilterer{contract: contract}}, nil
+}
+
+// ClaimCancelRedeemRequest7540Hook is an auto generated Go binding around an Ethereum contract.
+type ClaimCancelRedeemRequest7540Hook struct {
+	ClaimCancelRedeemRequest7540HookCaller     // Read-only binding to the contract
+	ClaimCancelRedeemRequest7540HookTransactor // Write-only binding to the contract
+	ClaimCancelRedeemRequest7540HookFilterer   // Log filterer for contract events
+}
+
+// ClaimCancelRedeemRequest7540HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type ClaimCancelRedeemRequest7540HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ClaimCancelRedeemRequest7540HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type ClaimCancelRedeemRequest7540HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ClaimCancelRedeemRequest7540HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type ClaimCancelRedeemRequest7540HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// ClaimCancelRedeemRequest7540HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type ClaimCancelRedeemRequest7540HookSession struct {
+	Contract     *ClaimCancelRedeemRequest7540Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// ClaimCancelRedeemRequest7540HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type ClaimCancelRedeemRequest7540HookCallerSession struct {
+	Contract *ClaimCancelRedeemRequest7540HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                           // Call options to use throughout this session
+}
+
+// ClaimCancelRedeemRequest7540HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type ClaimCancelRedeemRequest7540HookTransactorSession struct {
+	Contract     *ClaimCancelRedeemRequest7540HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                           // Transaction auth options to use throughout this session
+}
+
+// ClaimCancelRedeemRequest7540HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type ClaimCancelRedeemRequest7540HookRaw struct {
+	Contract *ClaimCancelRedeemRequest7540Hook // Generic contract binding to access the raw methods on
+}
+
+// ClaimCancelRedeemRequest7540HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type ClaimCancelRedeemRequest7540HookCallerRaw struct {
+	Contract *ClaimCancelRedeemRequest7540HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// ClaimCancelRedeemRequest7540HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type ClaimCancelRedeemRequest7540HookTransactorRaw struct {
+	Contract *ClaimCancelRedeemRequest7540HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewClaimCancelRedeemRequest7540Hook creates a new instance of ClaimCancelRedeemRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelRedeemRequest7540Hook(address common.Address, backend bind.ContractBackend) (*ClaimCancelRedeemRequest7540Hook, error) {
+	contract, err := bindClaimCancelRedeemRequest7540Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelRedeemRequest7540Hook{ClaimCancelRedeemRequest7540HookCaller: ClaimCancelRedeemRequest7540HookCaller{contract: contract}, ClaimCancelRedeemRequest7540HookTransactor: ClaimCancelRedeemRequest7540HookTransactor{contract: contract}, ClaimCancelRedeemRequest7540HookFilterer: ClaimCancelRedeemRequest7540HookFilterer{contract: contract}}, nil
+}
+
+// NewClaimCancelRedeemRequest7540HookCaller creates a new read-only instance of ClaimCancelRedeemRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelRedeemRequest7540HookCaller(address common.Address, caller bind.ContractCaller) (*ClaimCancelRedeemRequest7540HookCaller, error) {
+	contract, err := bindClaimCancelRedeemRequest7540Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelRedeemRequest7540HookCaller{contract: contract}, nil
+}
+
+// NewClaimCancelRedeemRequest7540HookTransactor creates a new write-only instance of ClaimCancelRedeemRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelRedeemRequest7540HookTransactor(address common.Address, transactor bind.ContractTransactor) (*ClaimCancelRedeemRequest7540HookTransactor, error) {
+	contract, err := bindClaimCancelRedeemRequest7540Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelRedeemRequest7540HookTransactor{contract: contract}, nil
+}
+
+// NewClaimCancelRedeemRequest7540HookFilterer creates a new log filterer instance of ClaimCancelRedeemRequest7540Hook, bound to a specific deployed contract.
+func NewClaimCancelRedeemRequest7540HookFilterer(address common.Address, filterer bind.ContractFilterer) (*ClaimCancelRedeemRequest7540HookFilterer, error) {
+	contract, err := bindClaimCancelRedeemRequest7540Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &ClaimCancelRedeemRequest7540HookFilterer{contract: contract}, nil
+}
+
+// bindClaimCancelRedeemRequest7540Hook binds a generic wrapper to an already deployed contract.
+func bindClaimCancelRedeemRequest7540Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := ClaimCancelRedeemRequest7540HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ClaimCancelRedeemRequest7540HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ClaimCancelRedeemRequest7540HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ClaimCancelRedeemRequest7540HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) SUBTYPE() ([32]byte, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SUBTYPE(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SUBTYPE(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) Asset() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Asset(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) Asset() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Asset(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Build(&_ClaimCancelRedeemRequest7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Build(&_ClaimCancelRedeemRequest7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) DstChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "dstChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) DstChainId() (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.DstChainId(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) DstChainId() (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.DstChainId(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) ExecutionNonce() (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ExecutionNonce(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ExecutionNonce(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.GetOutAmount(&_ClaimCancelRedeemRequest7540Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.GetOutAmount(&_ClaimCancelRedeemRequest7540Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) HookType() (uint8, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.HookType(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) HookType() (uint8, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.HookType(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) Inspect(data []byte) ([]byte, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Inspect(&_ClaimCancelRedeemRequest7540Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Inspect(&_ClaimCancelRedeemRequest7540Hook.CallOpts, data)
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) IsAsyncCancelHook(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "isAsyncCancelHook")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) IsAsyncCancelHook() (uint8, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.IsAsyncCancelHook(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) IsAsyncCancelHook() (uint8, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.IsAsyncCancelHook(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) LastCaller() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.LastCaller(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) LastCaller() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.LastCaller(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) SpToken() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SpToken(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) SpToken() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SpToken(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) Subtype() ([32]byte, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Subtype(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) Subtype() ([32]byte, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.Subtype(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) UsedShares() (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.UsedShares(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) UsedShares() (*big.Int, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.UsedShares(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCaller) VaultBank(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _ClaimCancelRedeemRequest7540Hook.contract.Call(opts, &out, "vaultBank")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) VaultBank() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.VaultBank(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookCallerSession) VaultBank() (common.Address, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.VaultBank(&_ClaimCancelRedeemRequest7540Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.PostExecute(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.PostExecute(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.PreExecute(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.PreExecute(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ResetExecutionState(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.ResetExecutionState(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SetExecutionContext(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SetExecutionContext(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SetOutAmount(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_ClaimCancelRedeemRequest7540Hook *ClaimCancelRedeemRequest7540HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _ClaimCancelRedeemRequest7540Hook.Contract.SetOutAmount(&_ClaimCancelRedeemRequest7540Hook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,687 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package DeBridgeCancelOrderHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// DeBridgeCancelOrderHookMetaData contains all meta data concerning the DeBridgeCancelOrderHook contract.
+var DeBridgeCancelOrderHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"dlnDestination_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DLN_DESTINATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161152538038061152583398101604081905261002e916100b1565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b0381166100a057604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100de565b5f602082840312156100c1575f5ffd5b81516001600160a01b03811681146100d7575f5ffd5b9392505050565b60805160a05161141861010d5f395f818161026f01526108f801525f81816101d2015261024801526114185ff3fe608060405234801561000f575f5ffd5b5060043610610106575f3560e01c80635492e6771161009e5780638e1487761161006e5780638e14877614610231578063984d6e7a14610243578063b17081d71461026a578063d06a1e8914610291578063e445e7dd146102a4575f5ffd5b80635492e677146101d0578063685a943c146101f657806381cbe691146101fe5780638c4313c114610211575f5ffd5b80632113522a116100d95780632113522a146101615780632ae2fe3d1461018b57806338d52e0f1461019e5780633b5896bc146101b0575f5ffd5b806301bd43ef1461010a57806305b4fe911461012657806314cb37cf1461013b5780631f2646191461014e575b5f5ffd5b61011360035c81565b6040519081526020015b60405180910390f35b610139610134366004610f4c565b6102bd565b005b610139610149366004610fa9565b61032b565b61013961015c366004610fc9565b61034c565b6101736001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161011d565b610139610199366004610f4c565b6103a5565b6101736001600160a01b0360025c1681565b6101c36101be366004610f4c565b61040c565b60405161011d9190611021565b7f0000000000000000000000000000000000000000000000000000000000000000610113565b6101135f5c81565b61011361020c366004610fa9565b610625565b61022461021f3660046110ae565b61063d565b60405161011d91906110ed565b6101736001600160a01b0360015c1681565b6101137f000000000000000000000000000000000000000000000000000000000000000081565b6101737f000000000000000000000000000000000000000000000000000000000000000081565b61013961029f366004610fa9565b61070d565b5f546102b09060ff1681565b60405161011d91906110ff565b336001600160a01b038416146102e65760405163e15e56c960e01b815260040160405180910390fd5b5f6102f08461078a565b90506102fb8161079d565b156103195760405163945b63f560e01b815260040160405180910390fd5b6103248160016107aa565b5050505050565b610334816107c0565b50336004805c6001600160a01b0319168217905d5050565b5f6103568261078a565b9050610361816107f2565b8061037057506103708161079d565b1561038e5760405163441e4c7360e11b815260040160405180910390fd5b5f61039a8260016107fb565b905083815d50505050565b336001600160a01b038416146103ce5760405163e15e56c960e01b815260040160405180910390fd5b5f6103d88461078a565b90506103e3816107f2565b1561040157604051630bbb04d960e11b815260040160405180910390fd5b610324816001610850565b60605f61041b8686868661085c565b90508051600261042b9190611139565b67ffffffffffffffff8111156104435761044361114c565b60405190808252806020026020018201604052801561048f57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104615790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104d89493929190611160565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061051a5761051a6111a8565b60209081029190910101525f5b815181101561057b57818181518110610542576105426111a8565b6020026020010151838260016105589190611139565b81518110610568576105686111a8565b6020908102919091010152600101610527565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105c29493929190611160565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161060191906111bc565b81518110610611576106116111a8565b602002602001018190525050949350505050565b5f6106376106328361078a565b610994565b92915050565b60605f61067e84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506109a192505050565b5050905080606001518160c00151610695906111cf565b60601c8261010001516106a7906111cf565b60601c8361012001516106b9906111cf565b60601c8461014001516106cb906111cf565b60601c8561018001516106dd906111cf565b60601c6040516020016106f59695949392919061121d565b60405160208183030381529060405291505092915050565b336001600160a01b0360045c16146107385760405163e15e56c960e01b815260040160405180910390fd5b5f6107428261078a565b905061074d816107f2565b158061075f575061075d8161079d565b155b1561077d57604051634bd439b560e11b815260040160405180910390fd5b61078681610cab565b5050565b5f5f61079583610cc2565b5c9392505050565b5f5f6107958360036107fb565b5f6107b68360036107fb565b905081815d505050565b5f6003805c90826107d08361126f565b9190505d505f6107df83610cc2565b905060035c80825d505060035c92915050565b5f5f6107958360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107b68360026107fb565b60605f5f5f61089f86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506109a192505050565b6040805160018082528183019092529396509194509250816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816108bb57905050935060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316815260200183815260200184898460405160240161094093929190611287565b60408051601f198184030181529190526020810180516001600160e01b03166369c6cb1360e11b1790529052845185905f9061097e5761097e6111a8565b6020026020010181905250505050949350505050565b5f5f6107958360016107fb565b610a18604051806101c001604052805f67ffffffffffffffff168152602001606081526020015f8152602001606081526020015f81526020015f8152602001606081526020015f81526020016060815260200160608152602001606081526020016060815260200160608152602001606081525090565b5f8080610a258582610d29565b9250610a32602082611139565b9050610a3e8582610d8b565b67ffffffffffffffff168452610a55600882611139565b90505f610a628683610d29565b9050610a6f602083611139565b9150610a7c868383610de7565b6020860152610a8b8183611139565b91505f610a988784610d29565b9050610aa5602084611139565b9250610ab2878483610de7565b6060870152610ac18184611139565b9250610acd8784610d29565b6080870152610add602084611139565b9250610ae98784610d29565b6040870152610af9602084611139565b9250610b058784610d29565b60a0870152610b15602084611139565b92505f610b228885610d29565b9050610b2f602085611139565b9350610b3c888583610de7565b60c0880152610b4b8185611139565b9350610b578885610d29565b60e0880152610b67602085611139565b93505f610b748986610d29565b9050610b81602086611139565b9450610b8e898683610de7565b610100890152610b9e8186611139565b94505f610bab8a87610d29565b9050610bb8602087611139565b9550610bc58a8783610de7565b6101208a0152610bd58187611139565b95505f610be28b88610d29565b9050610bef602088611139565b9650610bfc8b8883610de7565b6101408b0152610c0c8188611139565b96505f610c198c89610d29565b9050610c26602089611139565b9750610c338c8983610de7565b6101608c0152610c438189611139565b97505f610c508d8a610d29565b9050610c5d60208a611139565b9850610c6a8d8a83610de7565b6101808d0152610c7a818a611139565b60408051602081019091525f81526101a08e01529850610c9a8d8a610d29565b9b9d9a9c5050505050505050505050565b610cb5815f610850565b610cbf815f6107aa565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610d0c92919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610d35826020611139565b83511015610d825760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b5f610d97826008611139565b83511015610dde5760405162461bcd60e51b8152602060048201526014602482015273746f55696e7436345f6f75744f66426f756e647360601b6044820152606401610d79565b50016008015190565b60608182601f011015610e2d5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610d79565b610e378284611139565b84511015610e7b5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610d79565b606082158015610e995760405191505f825260208201604052610ee3565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610ed2578051835260209283019201610eba565b5050858452601f01601f1916604052505b50949350505050565b80356001600160a01b0381168114610f02575f5ffd5b919050565b5f5f83601f840112610f17575f5ffd5b50813567ffffffffffffffff811115610f2e575f5ffd5b602083019150836020828501011115610f45575f5ffd5b9250929050565b5f5f5f5f60608587031215610f5f575f5ffd5b610f6885610eec565b9350610f7660208601610eec565b9250604085013567ffffffffffffffff811115610f91575f5ffd5b610f9d87828801610f07565b95989497509550505050565b5f60208284031215610fb9575f5ffd5b610fc282610eec565b9392505050565b5f5f60408385031215610fda575f5ffd5b82359150610fea60208401610eec565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b828110156110a257868503603f19018452815180516001600160a01b031686526020808201519087015260409081015160609187018290529061108c90870182610ff3565b9550506020938401939190910190600101611047565b50929695505050505050565b5f5f602083850312156110bf575f5ffd5b823567ffffffffffffffff8111156110d5575f5ffd5b6110e185828601610f07565b90969095509350505050565b602081525f610fc26020830184610ff3565b602081016003831061111f57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561063757610637611125565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561063757610637611125565b805160208201516001600160601b0319811691906014821015611216576bffffffffffffffffffffffff196bffffffffffffffffffffffff198360140360031b1b82161692505b5050919050565b5f87518060208a01845e606097881b6001600160601b03199081169390910192835295871b861660148301525092851b8416602884015290841b8316603c83015290921b166050820152606401919050565b5f6001820161128057611280611125565b5060010190565b606081526112a260608201855167ffffffffffffffff169052565b5f60208501516101c060808401526112be610220840182610ff3565b9050604086015160a08401526060860151605f198483030160c08501526112e58282610ff3565b915050608086015160e084015260a086015161010084015260c0860151605f19848303016101208501526113198282610ff3565b91505060e0860151610140840152610100860151605f19848303016101608501526113448282610ff3565b915050610120860151605f19848303016101808501526113648282610ff3565b915050610140860151605f19848303016101a08501526113848282610ff3565b915050610160860151605f19848303016101c08501526113a48282610ff3565b915050610180860151605f19848303016101e08501526113c48282610ff3565b9150506101a0860151605f19848303016102008501526113e48282610ff3565b925050506113fd60208301856001600160a01b03169052565b82604083015294935050505056fea164736f6c634300081e000a",
+}
+
+// DeBridgeCancelOrderHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use DeBridgeCancelOrderHookMetaData.ABI instead.
+var DeBridgeCancelOrderHookABI = DeBridgeCancelOrderHookMetaData.ABI
+
+// DeBridgeCancelOrderHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use DeBridgeCancelOrderHookMetaData.Bin instead.
+var DeBridgeCancelOrderHookBin = DeBridgeCancelOrderHookMetaData.Bin
+
+// DeployDeBridgeCancelOrderHook deploys a new Ethereum contract, binding an instance of DeBridgeCancelOrderHook to it.
+func DeployDeBridgeCancelOrderHook(auth *bind.TransactOpts, backend bind.ContractBackend, dlnDestination_ common.Address) (common.Address, *types.Transaction, *DeBridgeCancelOrderHook, error) {
+	parsed, err := DeBridgeCancelOrderHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(DeBridgeCancelOrderHookBin), backend, dlnDestination_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &DeBridgeCancelOrderHook{DeBridgeCancelOrderHookCaller: DeBridgeCancelOrderHookCaller{contract: contract}, DeBridgeCancelOrderHookTransactor: DeBridgeCancelOrderHookTransactor{contract: contract}, DeBridgeCancelOrderHookFilterer: DeBridgeCancelOrderHookFilterer{contract: contract}}, nil
+}
+
+// DeBridgeCancelOrderHook is an auto generated Go binding around an Ethereum contract.
+type DeBridgeCancelOrderHook struct {
+	DeBridgeCancelOrderHookCaller     // Read-only binding to the contract
+	DeBridgeCancelOrderHookTransactor // Write-only binding to the contract
+	DeBridgeCancelOrderHookFilterer   // Log filterer for contract events
+}
+
+// DeBridgeCancelOrderHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DeBridgeCancelOrderHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeBridgeCancelOrderHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DeBridgeCancelOrderHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeBridgeCancelOrderHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DeBridgeCancelOrderHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeBridgeCancelOrderHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DeBridgeCancelOrderHookSession struct {
+	Contract     *DeBridgeCancelOrderHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts            // Call options to use throughout this session
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// DeBridgeCancelOrderHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DeBridgeCancelOrderHookCallerSession struct {
+	Contract *DeBridgeCancelOrderHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                  // Call options to use throughout this session
+}
+
+// DeBridgeCancelOrderHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DeBridgeCancelOrderHookTransactorSession struct {
+	Contract     *DeBridgeCancelOrderHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                  // Transaction auth options to use throughout this session
+}
+
+// DeBridgeCancelOrderHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DeBridgeCancelOrderHookRaw struct {
+	Contract *DeBridgeCancelOrderHook // Generic contract binding to access the raw methods on
+}
+
+// DeBridgeCancelOrderHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DeBridgeCancelOrderHookCallerRaw struct {
+	Contract *DeBridgeCancelOrderHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DeBridgeCancelOrderHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DeBridgeCancelOrderHookTransactorRaw struct {
+	Contract *DeBridgeCancelOrderHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDeBridgeCancelOrderHook creates a new instance of DeBridgeCancelOrderHook, bound to a specific deployed contract.
+func NewDeBridgeCancelOrderHook(address common.Address, backend bind.ContractBackend) (*DeBridgeCancelOrderHook, error) {
+	contract, err := bindDeBridgeCancelOrderHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeCancelOrderHook{DeBridgeCancelOrderHookCaller: DeBridgeCancelOrderHookCaller{contract: contract}, DeBridgeCancelOrderHookTransactor: DeBridgeCancelOrderHookTransactor{contract: contract}, DeBridgeCancelOrderHookFilterer: DeBridgeCancelOrderHookFilterer{contract: contract}}, nil
+}
+
+// NewDeBridgeCancelOrderHookCaller creates a new read-only instance of DeBridgeCancelOrderHook, bound to a specific deployed contract.
+func NewDeBridgeCancelOrderHookCaller(address common.Address, caller bind.ContractCaller) (*DeBridgeCancelOrderHookCaller, error) {
+	contract, err := bindDeBridgeCancelOrderHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeCancelOrderHookCaller{contract: contract}, nil
+}
+
+// NewDeBridgeCancelOrderHookTransactor creates a new write-only instance of DeBridgeCancelOrderHook, bound to a specific deployed contract.
+func NewDeBridgeCancelOrderHookTransactor(address common.Address, transactor bind.ContractTransactor) (*DeBridgeCancelOrderHookTransactor, error) {
+	contract, err := bindDeBridgeCancelOrderHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeCancelOrderHookTransactor{contract: contract}, nil
+}
+
+// NewDeBridgeCancelOrderHookFilterer creates a new log filterer instance of DeBridgeCancelOrderHook, bound to a specific deployed contract.
+func NewDeBridgeCancelOrderHookFilterer(address common.Address, filterer bind.ContractFilterer) (*DeBridgeCancelOrderHookFilterer, error) {
+	contract, err := bindDeBridgeCancelOrderHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeCancelOrderHookFilterer{contract: contract}, nil
+}
+
+// bindDeBridgeCancelOrderHook binds a generic wrapper to an already deployed contract.
+func bindDeBridgeCancelOrderHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := DeBridgeCancelOrderHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DeBridgeCancelOrderHook.Contract.DeBridgeCancelOrderHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.DeBridgeCancelOrderHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.DeBridgeCancelOrderHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DeBridgeCancelOrderHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// DLNDESTINATION is a free data retrieval call binding the contract method 0xb17081d7.
+//
+// Solidity: function DLN_DESTINATION() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) DLNDESTINATION(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "DLN_DESTINATION")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DLNDESTINATION is a free data retrieval call binding the contract method 0xb17081d7.
+//
+// Solidity: function DLN_DESTINATION() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) DLNDESTINATION() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.DLNDESTINATION(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// DLNDESTINATION is a free data retrieval call binding the contract method 0xb17081d7.
+//
+// Solidity: function DLN_DESTINATION() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) DLNDESTINATION() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.DLNDESTINATION(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) SUBTYPE() ([32]byte, error) {
+	return _DeBridgeCancelOrderHook.Contract.SUBTYPE(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _DeBridgeCancelOrderHook.Contract.SUBTYPE(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) Asset() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.Asset(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) Asset() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.Asset(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _DeBridgeCancelOrderHook.Contract.Build(&_DeBridgeCancelOrderHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _DeBridgeCancelOrderHook.Contract.Build(&_DeBridgeCancelOrderHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) ExecutionNonce() (*big.Int, error) {
+	return _DeBridgeCancelOrderHook.Contract.ExecutionNonce(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _DeBridgeCancelOrderHook.Contract.ExecutionNonce(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _DeBridgeCancelOrderHook.Contract.GetOutAmount(&_DeBridgeCancelOrderHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _DeBridgeCancelOrderHook.Contract.GetOutAmount(&_DeBridgeCancelOrderHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) HookType() (uint8, error) {
+	return _DeBridgeCancelOrderHook.Contract.HookType(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) HookType() (uint8, error) {
+	return _DeBridgeCancelOrderHook.Contract.HookType(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) Inspect(data []byte) ([]byte, error) {
+	return _DeBridgeCancelOrderHook.Contract.Inspect(&_DeBridgeCancelOrderHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _DeBridgeCancelOrderHook.Contract.Inspect(&_DeBridgeCancelOrderHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) LastCaller() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.LastCaller(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) LastCaller() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.LastCaller(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) SpToken() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.SpToken(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) SpToken() (common.Address, error) {
+	return _DeBridgeCancelOrderHook.Contract.SpToken(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) Subtype() ([32]byte, error) {
+	return _DeBridgeCancelOrderHook.Contract.Subtype(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) Subtype() ([32]byte, error) {
+	return _DeBridgeCancelOrderHook.Contract.Subtype(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DeBridgeCancelOrderHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) UsedShares() (*big.Int, error) {
+	return _DeBridgeCancelOrderHook.Contract.UsedShares(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookCallerSession) UsedShares() (*big.Int, error) {
+	return _DeBridgeCancelOrderHook.Contract.UsedShares(&_DeBridgeCancelOrderHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.PostExecute(&_DeBridgeCancelOrderHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.PostExecute(&_DeBridgeCancelOrderHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.PreExecute(&_DeBridgeCancelOrderHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.PreExecute(&_DeBridgeCancelOrderHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.ResetExecutionState(&_DeBridgeCancelOrderHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.ResetExecutionState(&_DeBridgeCancelOrderHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.SetExecutionContext(&_DeBridgeCancelOrderHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.SetExecutionContext(&_DeBridgeCancelOrderHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.SetOutAmount(&_DeBridgeCancelOrderHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DeBridgeCancelOrderHook *DeBridgeCancelOrderHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeCancelOrderHook.Contract.SetOutAmount(&_DeBridgeCancelOrderHook.TransactOpts, _outAmount, caller)
+}
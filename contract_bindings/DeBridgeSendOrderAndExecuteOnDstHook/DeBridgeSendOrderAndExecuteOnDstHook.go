@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package DeBridgeSendOrderAndExecuteOnDstHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookMetaData contains all meta data concerning the DeBridgeSendOrderAndExecuteOnDstHook contract.
+var DeBridgeSendOrderAndExecuteOnDstHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"dlnSource_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"validator_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DLN_SOURCE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_UNDERFLOW\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60e060405234801561000f575f5ffd5b5060405161227a38038061227a83398101604081905261002e916100e6565b60408051808201909152600681526542726964676560d01b6020909101525f805460ff191690557f7aa5ae620294318af92bf4e2b2a729646c932a80312a5fa630da993a2ef5cc106080526001600160a01b038216158061009657506001600160a01b038116155b156100b457604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b0391821660a0521660c052610117565b80516001600160a01b03811681146100e1575f5ffd5b919050565b5f5f604083850312156100f7575f5ffd5b610100836100cb565b915061010e602084016100cb565b90509250929050565b60805160a05160c05161212b61014f5f395f6108ef01525f818161027a0152610b5c01525f81816101dd0152610253015261212b5ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c8063685a943c1161009e578063984d6e7a1161006e578063984d6e7a1461024e5780639eaeb24f14610275578063d06a1e891461029c578063e445e7dd146102af578063e7745517146102c8575f5ffd5b8063685a943c1461020157806381cbe691146102095780638c4313c11461021c5780638e1487761461023c575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d1461019657806338d52e0f146101a95780633b5896bc146101bb5780635492e677146101db575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f366004611844565b6102eb565b005b6101446101543660046118a4565b610359565b6101446101673660046118bf565b61037a565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a4366004611844565b6103d3565b61017e6001600160a01b0360025c1681565b6101ce6101c9366004611844565b61043a565b604051610128919061191b565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61011e6102173660046118a4565b610652565b61022f61022a3660046119a8565b61066a565b60405161012891906119e6565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b6101446102aa3660046118a4565b610771565b5f546102bb9060ff1681565b60405161012891906119f8565b6102db6102d6366004611a88565b6107ee565b6040519015158152602001610128565b336001600160a01b038416146103145760405163e15e56c960e01b815260040160405180910390fd5b5f61031e846107f9565b90506103298161080c565b156103475760405163945b63f560e01b815260040160405180910390fd5b610352816001610819565b5050505050565b6103628161082f565b50336004805c6001600160a01b0319168217905d5050565b5f610384826107f9565b905061038f81610861565b8061039e575061039e8161080c565b156103bc5760405163441e4c7360e11b815260040160405180910390fd5b5f6103c882600161086a565b905083815d50505050565b336001600160a01b038416146103fc5760405163e15e56c960e01b815260040160405180910390fd5b5f610406846107f9565b905061041181610861565b1561042f57604051630bbb04d960e11b815260040160405180910390fd5b6103528160016108bf565b60605f610449868686866108cb565b9050805160026104599190611b15565b6001600160401b0381111561047057610470611a1e565b6040519080825280602002602001820160405280156104bc57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161048e5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105059493929190611b28565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061054757610547611b70565b60209081029190910101525f5b81518110156105a85781818151811061056f5761056f611b70565b6020026020010151838260016105859190611b15565b8151811061059557610595611b70565b6020908102919091010152600101610554565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105ef9493929190611b28565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161062e9190611b84565b8151811061063e5761063e611b70565b602002602001018190525050949350505050565b5f61066461065f836107f9565b610bfb565b92915050565b60605f6106b984848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92018290525060408051602081019091529081529250610c08915050565b5050509050805f015181604001516106d090611b97565b60601c8260a001516106e190611b97565b60601c8360c0015160601b60601c8460e001516106fd90611b97565b60601c85610140015161070f90611b97565b6040516001600160601b0319606097881b8116602083015295871b8616603482015293861b8516604885015291851b8416605c84015290931b821660708201529116608482015260980160405160208183030381529060405291505092915050565b336001600160a01b0360045c161461079c5760405163e15e56c960e01b815260040160405180910390fd5b5f6107a6826107f9565b90506107b181610861565b15806107c357506107c18161080c565b155b156107e157604051634bd439b560e11b815260040160405180910390fd5b6107ea816112bd565b5050565b5f610664825f6112d4565b5f5f61080483611300565b5c9392505050565b5f5f61080483600361086a565b5f61082583600361086a565b905081815d505050565b5f6003805c908261083f83611be5565b9190505d505f61084e83611300565b905060035c80825d505060035c92915050565b5f5f6108048360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61082583600261086a565b604051630f65bac560e01b81526001600160a01b0384811660048301526060915f917f00000000000000000000000000000000000000000000000000000000000000001690630f65bac5906024015f60405180830381865afa158015610933573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f1916820160405261095a9190810190611c4a565b90505f5f5f5f6109a088888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250899250610c08915050565b93509350935093505f6109e789898080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92018290525092506112d4915050565b90508015610ae6576040516381cbe69160e01b81526001600160a01b038b811660048301525f91908d16906381cbe69190602401602060405180830381865afa158015610a36573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a5a9190611c83565b90505f8660200151118015610a7257505f8660600151115b15610a9057610a8a8660600151828860200151611367565b60608701525b6020860180519082905286516001600160a01b0316610ae35780861015610aca5760405163a11995cd60e01b815260040160405180910390fd5b610ad48187611b84565b9550610ae08287611b15565b95505b50505b84602001515f03610b0a576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b1f57905050965060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001858152602001868585604051602401610ba493929190611c9a565b60408051601f198184030181529190526020810180516001600160e01b031663fbe16ca760e01b1790529052875188905f90610be257610be2611b70565b6020026020010181905250505050505050949350505050565b5f5f61080483600161086a565b610c726040518061016001604052805f6001600160a01b031681526020015f8152602001606081526020015f81526020015f8152602001606081526020015f6001600160a01b03168152602001606081526020016060815260200160608152602001606081525090565b5f60605f610d296040518061026001604052805f81526020015f81526020015f60ff1681526020015f6001600160a01b031681526020015f81526020015f6001600160a01b031681526020015f6001600160a01b031681526020015f81526020015f151581526020015f1515815260200160608152602001606081526020015f81526020015f8152602001606081526020015f6001600160a01b031681526020016060815260200160608152602001606081525090565b6001808252610d39908890611418565b93506020815f01818151610d4d9190611b15565b9052508051610d5d90889061147a565b6001600160a01b0316606082015280516014908290610d7d908390611b15565b9052508051610d8d908890611418565b608082015280516020908290610da4908390611b15565b9052508051610db49088906114de565b60ff16604082015280516001908290610dce908390611b15565b9052508051610dde90889061147a565b6001600160a01b031660a082015280516014908290610dfe908390611b15565b9052508051610e0e90889061147a565b6001600160a01b031660c082015280516014908290610e2e908390611b15565b9052508051610e3e908890611418565b60e082015280516020908290610e55908390611b15565b9052508051610e659088906112d4565b151561010082015280516001908290610e7f908390611b15565b9052508051610e8f9088906112d4565b151561012082015280516001908290610ea9908390611b15565b9052508051610eb9908890611418565b60208083019190915281518290610ed1908390611b15565b90525080516020820151610ee6918991611539565b610140820152602081015181518290610f00908390611b15565b9052508051610f10908890611418565b60208083019190915281518290610f28908390611b15565b90525080516020820151610f3d918991611539565b610160820152602081015181518290610f57908390611b15565b9052508051610f67908890611418565b61018082015280516020908290610f7f908390611b15565b9052508051610f8f908890611418565b6101a082015280516020908290610fa7908390611b15565b9052508051610fb7908890611418565b60208083019190915281518290610fcf908390611b15565b90525080516020820151610fe4918991611539565b6101c0820152602081015181518290610ffe908390611b15565b905250805161100e90889061147a565b6001600160a01b03166101e08201528051601490829061102f908390611b15565b905250805161103f908890611418565b60208083019190915281518290611057908390611b15565b9052508051602082015161106c918991611539565b610200820152602081015181518290611086908390611b15565b9052508051611096908890611418565b602080830191909152815182906110ae908390611b15565b905250805160208201516110c3918991611539565b6102208201526020810151815182906110dd908390611b15565b90525080516110ed908890611418565b60208083019190915281518290611105908390611b15565b9052508051602082015161111a918991611539565b610240820152602081015181518290611134908390611b15565b90525080515f90611146908990611418565b90506020825f0181815161115a9190611b15565b905250815161116b90899083611539565b935080825f0181815161117e9190611b15565b905250815161118e90899061163e565b92506004825f018181516111a29190611b15565b9150818152505060405180610160016040528083606001516001600160a01b031681526020018360800151815260200183610160015181526020018361018001518152602001836101a001518152602001836101c001518152602001836101e001516001600160a01b03168152602001836102000151815260200183610220015181526020016112a260405180610100016040528086610140015181526020018b81526020018660a001516001600160a01b031681526020018660c001516001600160a01b031681526020018660e0015181526020018661010001511515815260200186610120015115158152602001866040015160ff1681525061169a565b81526020018361024001518152509550505092959194509250565b6112c7815f6108bf565b6112d1815f610819565b50565b5f8282815181106112e7576112e7611b70565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161134a92919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f5f5f61137486866117bf565b91509150815f036113985783818161138e5761138e611de2565b0492505050611411565b8184116113af576113af60038515026011186117db565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150505b9392505050565b5f611424826020611b15565b835110156114715760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b5f611486826014611b15565b835110156114ce5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401611468565b500160200151600160601b900490565b5f6114ea826001611b15565b835110156115305760405162461bcd60e51b8152602060048201526013602482015272746f55696e74385f6f75744f66426f756e647360681b6044820152606401611468565b50016001015190565b60608182601f01101561157f5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401611468565b6115898284611b15565b845110156115cd5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401611468565b6060821580156115eb5760405191505f825260208201604052611635565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101561162457805183526020928301920161160c565b5050858452601f01601f1916604052505b50949350505050565b5f61164a826004611b15565b835110156116915760405162461bcd60e51b8152602060048201526014602482015273746f55696e7433325f6f75744f66426f756e647360601b6044820152606401611468565b50016004015190565b8051516060905f036116b957505060408051602081019091525f815290565b5f5f5f5f5f865f01518060200190518101906116d59190611ee1565b945094509450945094505f6040518060c0016040528089604001516001600160a01b0316815260200189606001516001600160a01b0316815260200189608001516001600160a01b031681526020018960a00151151581526020018960c001511515815260200187878787878e6020015160405160200161175b96959493929190611fe3565b60405160208183030381529060405281525090508760e0015181604051602001611785919061208d565b60408051601f19818403018152908290526117a392916020016120f5565b6040516020818303038152906040529650505050505050919050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b03811681146112d1575f5ffd5b5f5f83601f840112611810575f5ffd5b5081356001600160401b03811115611826575f5ffd5b60208301915083602082850101111561183d575f5ffd5b9250929050565b5f5f5f5f60608587031215611857575f5ffd5b8435611862816117ec565b93506020850135611872816117ec565b925060408501356001600160401b0381111561188c575f5ffd5b61189887828801611800565b95989497509550505050565b5f602082840312156118b4575f5ffd5b8135611411816117ec565b5f5f604083850312156118d0575f5ffd5b8235915060208301356118e2816117ec565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561199c57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611986908701826118ed565b9550506020938401939190910190600101611941565b50929695505050505050565b5f5f602083850312156119b9575f5ffd5b82356001600160401b038111156119ce575f5ffd5b6119da85828601611800565b90969095509350505050565b602081525f61141160208301846118ed565b6020810160038310611a1857634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f191681016001600160401b0381118282101715611a5a57611a5a611a1e565b604052919050565b5f6001600160401b03821115611a7a57611a7a611a1e565b50601f01601f191660200190565b5f60208284031215611a98575f5ffd5b81356001600160401b03811115611aad575f5ffd5b8201601f81018413611abd575f5ffd5b8035611ad0611acb82611a62565b611a32565b818152856020838501011115611ae4575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561066457610664611b01565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561066457610664611b01565b805160208201516001600160601b0319811691906014821015611bde576bffffffffffffffffffffffff196bffffffffffffffffffffffff198360140360031b1b82161692505b5050919050565b5f60018201611bf657611bf6611b01565b5060010190565b5f82601f830112611c0c575f5ffd5b8151611c1a611acb82611a62565b818152846020838601011115611c2e575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f60208284031215611c5a575f5ffd5b81516001600160401b03811115611c6f575f5ffd5b611c7b84828501611bfd565b949350505050565b5f60208284031215611c93575f5ffd5b5051919050565b60808152611cb46080820185516001600160a01b03169052565b602084015160a08201525f604085015161016060c0840152611cda6101e08401826118ed565b9050606086015160e0840152608086015161010084015260a0860151607f1984830301610120850152611d0d82826118ed565b91505060c0860151611d2b6101408501826001600160a01b03169052565b5060e0860151838203607f1901610160850152611d4882826118ed565b915050610100860151607f1984830301610180850152611d6882826118ed565b915050610120860151607f19848303016101a0850152611d8882826118ed565b915050610140860151607f19848303016101c0850152611da882826118ed565b9150508281036020840152611dbd81866118ed565b63ffffffff94909416604084015250508082036060909101525f815260200192915050565b634e487b7160e01b5f52601260045260245ffd5b5f6001600160401b03821115611e0e57611e0e611a1e565b5060051b60200190565b5f82601f830112611e27575f5ffd5b8151611e35611acb82611df6565b8082825260208201915060208360051b860101925085831115611e56575f5ffd5b602085015b83811015611e7c578051611e6e816117ec565b835260209283019201611e5b565b5095945050505050565b5f82601f830112611e95575f5ffd5b8151611ea3611acb82611df6565b8082825260208201915060208360051b860101925085831115611ec4575f5ffd5b602085015b83811015611e7c578051835260209283019201611ec9565b5f5f5f5f5f60a08688031215611ef5575f5ffd5b85516001600160401b03811115611f0a575f5ffd5b611f1688828901611bfd565b95505060208601516001600160401b03811115611f31575f5ffd5b611f3d88828901611bfd565b9450506040860151611f4e816117ec565b60608701519093506001600160401b03811115611f69575f5ffd5b611f7588828901611e18565b92505060808601516001600160401b03811115611f90575f5ffd5b611f9c88828901611e86565b9150509295509295909350565b5f8151808452602084019350602083015f5b82811015611fd9578151865260209586019590910190600101611fbb565b5093949350505050565b60c081525f611ff560c08301896118ed565b828103602084015261200781896118ed565b6001600160a01b03881660408501528381036060850152865180825260208089019350909101905f5b818110156120575783516001600160a01b0316835260209384019390920191600101612030565b5050838103608085015261206b8187611fa9565b91505082810360a084015261208081856118ed565b9998505050505050505050565b6020815260018060a01b03825116602082015260018060a01b03602083015116604082015260018060a01b0360408301511660608201526060820151151560808201526080820151151560a08201525f60a083015160c080840152611c7b60e08401826118ed565b60ff60f81b8360f81b1681525f82518060208501600185015e5f9201600101918252509291505056fea164736f6c634300081e000a",
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use DeBridgeSendOrderAndExecuteOnDstHookMetaData.ABI instead.
+var DeBridgeSendOrderAndExecuteOnDstHookABI = DeBridgeSendOrderAndExecuteOnDstHookMetaData.ABI
+
+// DeBridgeSendOrderAndExecuteOnDstHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use DeBridgeSendOrderAndExecuteOnDstHookMetaData.Bin instead.
+var DeBridgeSendOrderAndExecuteOnDstHookBin = DeBridgeSendOrderAndExecuteOnDstHookMetaData.Bin
+
+// DeployDeBridgeSendOrderAndExecuteOnDstHook deploys a new Ethereum contract, binding an instance of DeBridgeSendOrderAndExecuteOnDstHook to it.
+func DeployDeBridgeSendOrderAndExecuteOnDstHook(auth *bind.TransactOpts, backend bind.ContractBackend, dlnSource_ common.Address, validator_ common.Address) (common.Address, *types.Transaction, *DeBridgeSendOrderAndExecuteOnDstHook, error) {
+	parsed, err := DeBridgeSendOrderAndExecuteOnDstHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(DeBridgeSendOrderAndExecuteOnDstHookBin), backend, dlnSource_, validator_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &DeBridgeSendOrderAndExecuteOnDstHook{DeBridgeSendOrderAndExecuteOnDstHookCaller: DeBridgeSendOrderAndExecuteOnDstHookCaller{contract: contract}, DeBridgeSendOrderAndExecuteOnDstHookTransactor: DeBridgeSendOrderAndExecuteOnDstHookTransactor{contract: contract}, DeBridgeSendOrderAndExecuteOnDstHookFilterer: DeBridgeSendOrderAndExecuteOnDstHookFilterer{contract: contract}}, nil
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHook is an auto generated Go binding around an Ethereum contract.
+type DeBridgeSendOrderAndExecuteOnDstHook struct {
+	DeBridgeSendOrderAndExecuteOnDstHookCaller     // Read-only binding to the contract
+	DeBridgeSendOrderAndExecuteOnDstHookTransactor // Write-only binding to the contract
+	DeBridgeSendOrderAndExecuteOnDstHookFilterer   // Log filterer for contract events
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DeBridgeSendOrderAndExecuteOnDstHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DeBridgeSendOrderAndExecuteOnDstHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DeBridgeSendOrderAndExecuteOnDstHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DeBridgeSendOrderAndExecuteOnDstHookSession struct {
+	Contract     *DeBridgeSendOrderAndExecuteOnDstHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts                     // Transaction auth options to use throughout this session
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DeBridgeSendOrderAndExecuteOnDstHookCallerSession struct {
+	Contract *DeBridgeSendOrderAndExecuteOnDstHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                               // Call options to use throughout this session
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DeBridgeSendOrderAndExecuteOnDstHookTransactorSession struct {
+	Contract     *DeBridgeSendOrderAndExecuteOnDstHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                               // Transaction auth options to use throughout this session
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DeBridgeSendOrderAndExecuteOnDstHookRaw struct {
+	Contract *DeBridgeSendOrderAndExecuteOnDstHook // Generic contract binding to access the raw methods on
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DeBridgeSendOrderAndExecuteOnDstHookCallerRaw struct {
+	Contract *DeBridgeSendOrderAndExecuteOnDstHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DeBridgeSendOrderAndExecuteOnDstHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DeBridgeSendOrderAndExecuteOnDstHookTransactorRaw struct {
+	Contract *DeBridgeSendOrderAndExecuteOnDstHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDeBridgeSendOrderAndExecuteOnDstHook creates a new instance of DeBridgeSendOrderAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewDeBridgeSendOrderAndExecuteOnDstHook(address common.Address, backend bind.ContractBackend) (*DeBridgeSendOrderAndExecuteOnDstHook, error) {
+	contract, err := bindDeBridgeSendOrderAndExecuteOnDstHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeSendOrderAndExecuteOnDstHook{DeBridgeSendOrderAndExecuteOnDstHookCaller: DeBridgeSendOrderAndExecuteOnDstHookCaller{contract: contract}, DeBridgeSendOrderAndExecuteOnDstHookTransactor: DeBridgeSendOrderAndExecuteOnDstHookTransactor{contract: contract}, DeBridgeSendOrderAndExecuteOnDstHookFilterer: DeBridgeSendOrderAndExecuteOnDstHookFilterer{contract: contract}}, nil
+}
+
+// NewDeBridgeSendOrderAndExecuteOnDstHookCaller creates a new read-only instance of DeBridgeSendOrderAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewDeBridgeSendOrderAndExecuteOnDstHookCaller(address common.Address, caller bind.ContractCaller) (*DeBridgeSendOrderAndExecuteOnDstHookCaller, error) {
+	contract, err := bindDeBridgeSendOrderAndExecuteOnDstHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeSendOrderAndExecuteOnDstHookCaller{contract: contract}, nil
+}
+
+// NewDeBridgeSendOrderAndExecuteOnDstHookTransactor creates a new write-only instance of DeBridgeSendOrderAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewDeBridgeSendOrderAndExecuteOnDstHookTransactor(address common.Address, transactor bind.ContractTransactor) (*DeBridgeSendOrderAndExecuteOnDstHookTransactor, error) {
+	contract, err := bindDeBridgeSendOrderAndExecuteOnDstHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeSendOrderAndExecuteOnDstHookTransactor{contract: contract}, nil
+}
+
+// NewDeBridgeSendOrderAndExecuteOnDstHookFilterer creates a new log filterer instance of DeBridgeSendOrderAndExecuteOnDstHook, bound to a specific deployed contract.
+func NewDeBridgeSendOrderAndExecuteOnDstHookFilterer(address common.Address, filterer bind.ContractFilterer) (*DeBridgeSendOrderAndExecuteOnDstHookFilterer, error) {
+	contract, err := bindDeBridgeSendOrderAndExecuteOnDstHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DeBridgeSendOrderAndExecuteOnDstHookFilterer{contract: contract}, nil
+}
+
+// bindDeBridgeSendOrderAndExecuteOnDstHook binds a generic wrapper to an already deployed contract.
+func bindDeBridgeSendOrderAndExecuteOnDstHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := DeBridgeSendOrderAndExecuteOnDstHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DeBridgeSendOrderAndExecuteOnDstHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DeBridgeSendOrderAndExecuteOnDstHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DeBridgeSendOrderAndExecuteOnDstHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// DLNSOURCE is a free data retrieval call binding the contract method 0x9eaeb24f.
+//
+// Solidity: function DLN_SOURCE() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) DLNSOURCE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "DLN_SOURCE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DLNSOURCE is a free data retrieval call binding the contract method 0x9eaeb24f.
+//
+// Solidity: function DLN_SOURCE() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) DLNSOURCE() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DLNSOURCE(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// DLNSOURCE is a free data retrieval call binding the contract method 0x9eaeb24f.
+//
+// Solidity: function DLN_SOURCE() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) DLNSOURCE() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DLNSOURCE(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) SUBTYPE() ([32]byte, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SUBTYPE(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SUBTYPE(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) Asset() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Asset(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) Asset() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Asset(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Build(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Build(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DecodeUsePrevHookAmount(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.DecodeUsePrevHookAmount(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) ExecutionNonce() (*big.Int, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.ExecutionNonce(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.ExecutionNonce(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.GetOutAmount(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.GetOutAmount(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) HookType() (uint8, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.HookType(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) HookType() (uint8, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.HookType(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) Inspect(data []byte) ([]byte, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Inspect(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Inspect(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) LastCaller() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.LastCaller(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) LastCaller() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.LastCaller(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) SpToken() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SpToken(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) SpToken() (common.Address, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SpToken(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) Subtype() ([32]byte, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Subtype(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) Subtype() ([32]byte, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.Subtype(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DeBridgeSendOrderAndExecuteOnDstHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) UsedShares() (*big.Int, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.UsedShares(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookCallerSession) UsedShares() (*big.Int, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.UsedShares(&_DeBridgeSendOrderAndExecuteOnDstHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.PostExecute(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.PostExecute(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.PreExecute(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.PreExecute(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.ResetExecutionState(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.ResetExecutionState(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SetExecutionContext(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SetExecutionContext(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SetOutAmount(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DeBridgeSendOrderAndExecuteOnDstHook *DeBridgeSendOrderAndExecuteOnDstHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DeBridgeSendOrderAndExecuteOnDstHook.Contract.SetOutAmount(&_DeBridgeSendOrderAndExecuteOnDstHook.TransactOpts, _outAmount, caller)
+}
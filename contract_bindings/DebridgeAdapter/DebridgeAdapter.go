@@ -32,12 +32,34 @@ var (
 // DebridgeAdapterMetaData contains all meta data concerning the DebridgeAdapter contract.
 var DebridgeAdapterMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"dlnDestination\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"superDestinationExecutor_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"DLN_DESTINATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUPER_DESTINATION_EXECUTOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISuperDestinationExecutor\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"onERC20Received\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"_token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_transferredAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_payload\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"callSucceeded\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"callResult\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onEtherReceived\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"_payload\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"callSucceeded\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"callResult\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"payable\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ONLY_EXTERNAL_CALL_ADAPTER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ON_ETHER_RECEIVED_FAILED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeERC20FailedOperation\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"}]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b50604051610c27380380610c2783398101604081905261002e9161013b565b6001600160a01b038116158061004b57506001600160a01b038216155b1561006957604051630f58648f60e01b815260040160405180910390fd5b806001600160a01b03166080816001600160a01b0316815250505f826001600160a01b0316639aa69b846040518163ffffffff1660e01b8152600401602060405180830381865afa1580156100c0573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906100e4919061016c565b90506001600160a01b03811661010d57604051630f58648f60e01b815260040160405180910390fd5b50506001600160a01b031660a05261018c565b80516001600160a01b0381168114610136575f5ffd5b919050565b5f5f6040838503121561014c575f5ffd5b61015583610120565b915061016360208401610120565b90509250929050565b5f6020828403121561017c575f5ffd5b61018582610120565b9392505050565b60805160a051610a676101c05f395f8181609c0152818161010e015261027d01525f818160e701526103d80152610a675ff3fe60806040526004361061003e575f3560e01c80633d266812146100425780637cbf7a551461006c578063b17081d71461008b578063f2ad8247146100d6575b5f5ffd5b6100556100503660046105f1565b610109565b604051610063929190610674565b60405180910390f35b348015610077575f5ffd5b50610055610086366004610696565b610278565b348015610096575f5ffd5b506100be7f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b039091168152602001610063565b3480156100e1575f5ffd5b506100be7f000000000000000000000000000000000000000000000000000000000000000081565b5f60607f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316639aa69b846040518163ffffffff1660e01b8152600401602060405180830381865afa158015610168573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061018c9190610707565b6001600160a01b0316336001600160a01b0316146101bd57604051631f5d18a560e21b815260040160405180910390fd5b5f5f5f5f5f5f6101cc89610390565b9550955095509550955095505f846001600160a01b0316476040515f6040518083038185875af1925050503d805f8114610221576040519150601f19603f3d011682016040523d82523d5f602084013e610226565b606091505b50509050806102475760405162039ce360e61b815260040160405180910390fd5b6102565f8888888888886103c1565b505060408051602081019091525f815260019b909a5098505050505050505050565b5f60607f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316639aa69b846040518163ffffffff1660e01b8152600401602060405180830381865afa1580156102d7573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906102fb9190610707565b6001600160a01b0316336001600160a01b03161461032c57604051631f5d18a560e21b815260040160405180910390fd5b5f5f5f5f5f5f61033b89610390565b949a5092985090965094509250905061035e6001600160a01b038d16858d61044f565b61036d8c8787878787876103c1565b505060408051602081019091525f815260019c909b509950505050505050505050565b6060805f6060806060868060200190518101906103ad9190610872565b949c939b5091995097509550909350915050565b60405163ed71d9d160e01b81526001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000169063ed71d9d190610419908a908890889088908d908d908a9060040161099e565b5f604051808303815f87803b158015610430575f5ffd5b505af1158015610442573d5f5f3e3d5ffd5b5050505050505050505050565b604080516001600160a01b038416602482015260448082018490528251808303909101815260649091019091526020810180516001600160e01b031663a9059cbb60e01b1790526104a19084906104a6565b505050565b5f5f60205f8451602086015f885af1806104c5576040513d5f823e3d81fd5b50505f513d915081156104dc5780600114156104e9565b6001600160a01b0384163b155b1561051657604051635274afe760e01b81526001600160a01b038516600482015260240160405180910390fd5b50505050565b6001600160a01b0381168114610530575f5ffd5b50565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff8111828210171561057057610570610533565b604052919050565b5f67ffffffffffffffff82111561059157610591610533565b50601f01601f191660200190565b5f82601f8301126105ae575f5ffd5b81356105c16105bc82610578565b610547565b8181528460208386010111156105d5575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f5f60608486031215610603575f5ffd5b8335925060208401356106158161051c565b9150604084013567ffffffffffffffff811115610630575f5ffd5b61063c8682870161059f565b9150509250925092565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b8215158152604060208201525f61068e6040830184610646565b949350505050565b5f5f5f5f5f60a086880312156106aa575f5ffd5b8535945060208601356106bc8161051c565b93506040860135925060608601356106d38161051c565b9150608086013567ffffffffffffffff8111156106ee575f5ffd5b6106fa8882890161059f565b9150509295509295909350565b5f60208284031215610717575f5ffd5b81516107228161051c565b9392505050565b5f82601f830112610738575f5ffd5b81516107466105bc82610578565b81815284602083860101111561075a575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b80516107818161051c565b919050565b5f67ffffffffffffffff82111561079f5761079f610533565b5060051b60200190565b5f82601f8301126107b8575f5ffd5b81516107c66105bc82610786565b8082825260208201915060208360051b8601019250858311156107e7575f5ffd5b602085015b8381101561080d5780516107ff8161051c565b8352602092830192016107ec565b5095945050505050565b5f82601f830112610826575f5ffd5b81516108346105bc82610786565b8082825260208201915060208360051b860101925085831115610855575f5ffd5b602085015b8381101561080d57805183526020928301920161085a565b5f5f5f5f5f5f60c08789031215610887575f5ffd5b865167ffffffffffffffff81111561089d575f5ffd5b6108a989828a01610729565b965050602087015167ffffffffffffffff8111156108c5575f5ffd5b6108d189828a01610729565b9550506108e060408801610776565b9350606087015167ffffffffffffffff8111156108fb575f5ffd5b61090789828a016107a9565b935050608087015167ffffffffffffffff811115610923575f5ffd5b61092f89828a01610817565b92505060a087015167ffffffffffffffff81111561094b575f5ffd5b61095789828a01610729565b9150509295509295509295565b5f8151808452602084019350602083015f5b82811015610994578151865260209586019590910190600101610976565b5093949350505050565b6001600160a01b038881168252871660208083019190915260e06040830181905287519083018190525f91880190610100840190835b818110156109fb5783516001600160a01b03168352602093840193909201916001016109d4565b50508381036060850152610a0f8189610964565b9150508281036080840152610a248187610646565b905082810360a0840152610a388186610646565b905082810360c0840152610a4c8185610646565b9a995050505050505050505056fea164736f6c634300081e000a",
 }
 
 // DebridgeAdapterABI is the input ABI used to generate the binding from.
 // Deprecated: Use DebridgeAdapterMetaData.ABI instead.
 var DebridgeAdapterABI = DebridgeAdapterMetaData.ABI
 
+// DebridgeAdapterBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use DebridgeAdapterMetaData.Bin instead.
+var DebridgeAdapterBin = DebridgeAdapterMetaData.Bin
+
+// DeployDebridgeAdapter deploys a new Ethereum contract, binding an instance of DebridgeAdapter to it.
+func DeployDebridgeAdapter(auth *bind.TransactOpts, backend bind.ContractBackend, dlnDestination common.Address, superDestinationExecutor_ common.Address) (common.Address, *types.Transaction, *DebridgeAdapter, error) {
+	parsed, err := DebridgeAdapterMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(DebridgeAdapterBin), backend, dlnDestination, superDestinationExecutor_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &DebridgeAdapter{DebridgeAdapterCaller: DebridgeAdapterCaller{contract: contract}, DebridgeAdapterTransactor: DebridgeAdapterTransactor{contract: contract}, DebridgeAdapterFilterer: DebridgeAdapterFilterer{contract: contract}}, nil
+}
+
 // DebridgeAdapter is an auto generated Go binding around an Ethereum contract.
 type DebridgeAdapter struct {
 	DebridgeAdapterCaller     // Read-only binding to the contract
@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Deposit4626VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Deposit4626VaultHookMetaData contains all meta data concerning the Deposit4626VaultHook contract.
+var Deposit4626VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"dstChainId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vaultBank\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152600781526622a9219a1b191b60c91b6020909101525f805460ff191660011790557f6bd137f80bcc1600ee75e85074135d267cba030d0cda1f5fdfe642ca33d3d7bd6080526080516111dd61007d5f395f81816101fc015261028401526111dd5ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063984d6e7a1161006e578063984d6e7a1461027f578063ac440f72146102a6578063d06a1e89146102b9578063e445e7dd146102cc578063e7745517146102e5575f5ffd5b8063685a943c14610220578063701ffc8b1461022857806381cbe6911461023a5780638c4313c11461024d5780638e1487761461026d575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806330c593f7146101bf57806338d52e0f146101c85780633b5896bc146101da5780635492e677146101fa575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a610155366004610e72565b610308565b005b61015a61016a366004610ecf565b610382565b61015a61017d366004610ee8565b6103a3565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba366004610e72565b6103fc565b61013460065c81565b6101946001600160a01b0360025c1681565b6101ed6101e8366004610e72565b61046f565b60405161013e9190610f40565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b6101345f5c81565b6101946001600160a01b0360055c1681565b610134610248366004610ecf565b610688565b61026061025b366004610fcd565b6106a0565b60405161013e919061100c565b6101946001600160a01b0360015c1681565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b6101346102b4366004611032565b61071f565b61015a6102c7366004610ecf565b610729565b5f546102d89060ff1681565b60405161013e91906110e5565b6102f86102f3366004611032565b6107a6565b604051901515815260200161013e565b336001600160a01b038416146103315760405163e15e56c960e01b815260040160405180910390fd5b5f61033b846107b2565b9050610346816107c5565b156103645760405163945b63f560e01b815260040160405180910390fd5b61036f8160016107d2565b61037b858585856107e8565b5050505050565b61038b81610849565b50336004805c6001600160a01b0319168217905d5050565b5f6103ad826107b2565b90506103b88161087b565b806103c757506103c7816107c5565b156103e55760405163441e4c7360e11b815260040160405180910390fd5b5f6103f1826001610884565b905083815d50505050565b336001600160a01b038416146104255760405163e15e56c960e01b815260040160405180910390fd5b5f61042f846107b2565b905061043a8161087b565b1561045857604051630bbb04d960e11b815260040160405180910390fd5b6104638160016108d9565b61037b858585856108e5565b60605f61047e86868686610987565b90508051600261048e919061111f565b67ffffffffffffffff8111156104a6576104a661101e565b6040519080825280602002602001820160405280156104f257816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104c45790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161053b9493929190611132565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061057d5761057d61117a565b60209081029190910101525f5b81518110156105de578181815181106105a5576105a561117a565b6020026020010151838260016105bb919061111f565b815181106105cb576105cb61117a565b602090810291909101015260010161058a565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106259493929190611132565b604051602081830303815290604052915060e01b6020820180516001600160e01b0383818316178352505050508152508260018451610664919061118e565b815181106106745761067461117a565b602002602001018190525050949350505050565b5f61069a610695836107b2565b610be5565b92915050565b60606106e083838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf292505050565b604051602001610708919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61069a82610bfe565b336001600160a01b0360045c16146107545760405163e15e56c960e01b815260040160405180910390fd5b5f61075e826107b2565b90506107698161087b565b158061077b5750610779816107c5565b155b1561079957604051634bd439b560e11b815260040160405180910390fd5b6107a281610c0a565b5050565b5f61069a826054610c21565b5f5f6107bd83610c4d565b5c9392505050565b5f5f6107bd836003610884565b5f6107de836003610884565b905081815d505050565b6108436107f484610688565b6108338585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cb992505050565b61083d919061118e565b84610d34565b50505050565b5f6003805c9082610859836111a1565b9190505d505f61086883610c4d565b905060035c80825d505060035c92915050565b5f5f6107bd8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107de836002610884565b61092761083d8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cb992505050565b61096582828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf292505050565b6001805c6001600160a01b0319166001600160a01b03831617905d5050505050565b60605f6109c884848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf292505050565b90505f610a0985858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bfe92505050565b90505f610a4d86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610c21915050565b90508015610ac0576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610a99573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610abd91906111b9565b91505b815f03610ae0576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b038316610b0757604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b1c5790505093506040518060600160405280846001600160a01b031681526020015f81526020018389604051602401610b919291909182526001600160a01b0316602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b0316636e553f6560e01b1790529052845185905f90610bcf57610bcf61117a565b6020026020010181905250505050949350505050565b5f5f6107bd836001610884565b5f61069a826020610d4c565b5f61069a826034610db5565b610c14815f6108d9565b610c1e815f6107d2565b50565b5f828281518110610c3457610c3461117a565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610c9c92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610cc382610bf2565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610d09573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d2d91906111b9565b9392505050565b5f610d3e826107b2565b90505f6103f1826001610884565b5f610d5882601461111f565b83511015610da55760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610dc182602061111f565b83511015610e095760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610d9c565b50016020015190565b80356001600160a01b0381168114610e28575f5ffd5b919050565b5f5f83601f840112610e3d575f5ffd5b50813567ffffffffffffffff811115610e54575f5ffd5b602083019150836020828501011115610e6b575f5ffd5b9250929050565b5f5f5f5f60608587031215610e85575f5ffd5b610e8e85610e12565b9350610e9c60208601610e12565b9250604085013567ffffffffffffffff811115610eb7575f5ffd5b610ec387828801610e2d565b95989497509550505050565b5f60208284031215610edf575f5ffd5b610d2d82610e12565b5f5f60408385031215610ef9575f5ffd5b82359150610f0960208401610e12565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610fc157868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610fab90870182610f12565b9550506020938401939190910190600101610f66565b50929695505050505050565b5f5f60208385031215610fde575f5ffd5b823567ffffffffffffffff811115610ff4575f5ffd5b61100085828601610e2d565b90969095509350505050565b602081525f610d2d6020830184610f12565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611042575f5ffd5b813567ffffffffffffffff811115611058575f5ffd5b8201601f81018413611068575f5ffd5b803567ffffffffffffffff8111156110825761108261101e565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156110b1576110b161101e565b6040528181528282016020018610156110c8575f5ffd5b816020840160208301375f91810160200191909152949350505050565b602081016003831061110557634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561069a5761069a61110b565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561069a5761069a61110b565b5f600182016111b2576111b261110b565b5060010190565b5f602082840312156111c9575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// Deposit4626VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use Deposit4626VaultHookMetaData.ABI instead.
+var Deposit4626VaultHookABI = Deposit4626VaultHookMetaData.ABI
+
+// Deposit4626VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Deposit4626VaultHookMetaData.Bin instead.
+var Deposit4626VaultHookBin = Deposit4626VaultHookMetaData.Bin
+
+// DeployDeposit4626VaultHook deploys a new Ethereum contract, binding an instance of Deposit4626VaultHook to it.
+func DeployDeposit4626VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Deposit4626VaultHook, error) {
+	parsed, err := Deposit4626VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Deposit4626VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Deposit4626VaultHook{Deposit4626VaultHookCaller: Deposit4626VaultHookCaller{contract: contract}, Deposit4626VaultHookTransactor: Deposit4626VaultHookTransactor{contract: contract}, Deposit4626VaultHookFilterer: Deposit4626VaultHookFilterer{contract: contract}}, nil
+}
+
+// Deposit4626VaultHook is an auto generated Go binding around an Ethereum contract.
+type Deposit4626VaultHook struct {
+	Deposit4626VaultHookCaller     // Read-only binding to the contract
+	Deposit4626VaultHookTransactor // Write-only binding to the contract
+	Deposit4626VaultHookFilterer   // Log filterer for contract events
+}
+
+// Deposit4626VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Deposit4626VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Deposit4626VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Deposit4626VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Deposit4626VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Deposit4626VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Deposit4626VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Deposit4626VaultHookSession struct {
+	Contract     *Deposit4626VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// Deposit4626VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Deposit4626VaultHookCallerSession struct {
+	Contract *Deposit4626VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// Deposit4626VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Deposit4626VaultHookTransactorSession struct {
+	Contract     *Deposit4626VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// Deposit4626VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Deposit4626VaultHookRaw struct {
+	Contract *Deposit4626VaultHook // Generic contract binding to access the raw methods on
+}
+
+// Deposit4626VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Deposit4626VaultHookCallerRaw struct {
+	Contract *Deposit4626VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Deposit4626VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Deposit4626VaultHookTransactorRaw struct {
+	Contract *Deposit4626VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDeposit4626VaultHook creates a new instance of Deposit4626VaultHook, bound to a specific deployed contract.
+func NewDeposit4626VaultHook(address common.Address, backend bind.ContractBackend) (*Deposit4626VaultHook, error) {
+	contract, err := bindDeposit4626VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit4626VaultHook{Deposit4626VaultHookCaller: Deposit4626VaultHookCaller{contract: contract}, Deposit4626VaultHookTransactor: Deposit4626VaultHookTransactor{contract: contract}, Deposit4626VaultHookFilterer: Deposit4626VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewDeposit4626VaultHookCaller creates a new read-only instance of Deposit4626VaultHook, bound to a specific deployed contract.
+func NewDeposit4626VaultHookCaller(address common.Address, caller bind.ContractCaller) (*Deposit4626VaultHookCaller, error) {
+	contract, err := bindDeposit4626VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit4626VaultHookCaller{contract: contract}, nil
+}
+
+// NewDeposit4626VaultHookTransactor creates a new write-only instance of Deposit4626VaultHook, bound to a specific deployed contract.
+func NewDeposit4626VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*Deposit4626VaultHookTransactor, error) {
+	contract, err := bindDeposit4626VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit4626VaultHookTransactor{contract: contract}, nil
+}
+
+// NewDeposit4626VaultHookFilterer creates a new log filterer instance of Deposit4626VaultHook, bound to a specific deployed contract.
+func NewDeposit4626VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*Deposit4626VaultHookFilterer, error) {
+	contract, err := bindDeposit4626VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit4626VaultHookFilterer{contract: contract}, nil
+}
+
+// bindDeposit4626VaultHook binds a generic wrapper to an already deployed contract.
+func bindDeposit4626VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Deposit4626VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Deposit4626VaultHook *Deposit4626VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Deposit4626VaultHook.Contract.Deposit4626VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Deposit4626VaultHook *Deposit4626VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.Deposit4626VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Deposit4626VaultHook *Deposit4626VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.Deposit4626VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Deposit4626VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _Deposit4626VaultHook.Contract.SUBTYPE(&_Deposit4626VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _Deposit4626VaultHook.Contract.SUBTYPE(&_Deposit4626VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) Asset() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.Asset(&_Deposit4626VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) Asset() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.Asset(&_Deposit4626VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Deposit4626VaultHook.Contract.Build(&_Deposit4626VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Deposit4626VaultHook.Contract.Build(&_Deposit4626VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.DecodeAmount(&_Deposit4626VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.DecodeAmount(&_Deposit4626VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Deposit4626VaultHook.Contract.DecodeUsePrevHookAmount(&_Deposit4626VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Deposit4626VaultHook.Contract.DecodeUsePrevHookAmount(&_Deposit4626VaultHook.CallOpts, data)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) DstChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "dstChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) DstChainId() (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.DstChainId(&_Deposit4626VaultHook.CallOpts)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) DstChainId() (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.DstChainId(&_Deposit4626VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.ExecutionNonce(&_Deposit4626VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.ExecutionNonce(&_Deposit4626VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.GetOutAmount(&_Deposit4626VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.GetOutAmount(&_Deposit4626VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) HookType() (uint8, error) {
+	return _Deposit4626VaultHook.Contract.HookType(&_Deposit4626VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) HookType() (uint8, error) {
+	return _Deposit4626VaultHook.Contract.HookType(&_Deposit4626VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _Deposit4626VaultHook.Contract.Inspect(&_Deposit4626VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _Deposit4626VaultHook.Contract.Inspect(&_Deposit4626VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) LastCaller() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.LastCaller(&_Deposit4626VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.LastCaller(&_Deposit4626VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) SpToken() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.SpToken(&_Deposit4626VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.SpToken(&_Deposit4626VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) Subtype() ([32]byte, error) {
+	return _Deposit4626VaultHook.Contract.Subtype(&_Deposit4626VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _Deposit4626VaultHook.Contract.Subtype(&_Deposit4626VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) UsedShares() (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.UsedShares(&_Deposit4626VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _Deposit4626VaultHook.Contract.UsedShares(&_Deposit4626VaultHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCaller) VaultBank(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit4626VaultHook.contract.Call(opts, &out, "vaultBank")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) VaultBank() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.VaultBank(&_Deposit4626VaultHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_Deposit4626VaultHook *Deposit4626VaultHookCallerSession) VaultBank() (common.Address, error) {
+	return _Deposit4626VaultHook.Contract.VaultBank(&_Deposit4626VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.PostExecute(&_Deposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.PostExecute(&_Deposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.PreExecute(&_Deposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.PreExecute(&_Deposit4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.ResetExecutionState(&_Deposit4626VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.ResetExecutionState(&_Deposit4626VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.SetExecutionContext(&_Deposit4626VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.SetExecutionContext(&_Deposit4626VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.SetOutAmount(&_Deposit4626VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Deposit4626VaultHook *Deposit4626VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Deposit4626VaultHook.Contract.SetOutAmount(&_Deposit4626VaultHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Deposit7540VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Deposit7540VaultHookMetaData contains all meta data concerning the Deposit7540VaultHook contract.
+var Deposit7540VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"dstChainId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vaultBank\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260078152660455243373534360cc1b6020909101525f805460ff191660011790557fc518a1d423df5aa415a8180f6384c181bd18f2bc1b734ba3dd52cd98b9b5fb776080526080516112c661007d5f395f81816101fc015261028401526112c65ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063984d6e7a1161006e578063984d6e7a1461027f578063ac440f72146102a6578063d06a1e89146102b9578063e445e7dd146102cc578063e7745517146102e5575f5ffd5b8063685a943c14610220578063701ffc8b1461022857806381cbe6911461023a5780638c4313c11461024d5780638e1487761461026d575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806330c593f7146101bf57806338d52e0f146101c85780633b5896bc146101da5780635492e677146101fa575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a610155366004610f36565b610308565b005b61015a61016a366004610f97565b610382565b61015a61017d366004610fb2565b6103a3565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba366004610f36565b6103fc565b61013460065c81565b6101946001600160a01b0360025c1681565b6101ed6101e8366004610f36565b61046f565b60405161013e919061100e565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b6101345f5c81565b6101946001600160a01b0360055c1681565b610134610248366004610f97565b610688565b61026061025b36600461109b565b6106a0565b60405161013e91906110da565b6101946001600160a01b0360015c1681565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b6101346102b4366004611100565b61071f565b61015a6102c7366004610f97565b610729565b5f546102d89060ff1681565b60405161013e91906111b3565b6102f86102f3366004611100565b6107a6565b604051901515815260200161013e565b336001600160a01b038416146103315760405163e15e56c960e01b815260040160405180910390fd5b5f61033b846107b2565b9050610346816107c5565b156103645760405163945b63f560e01b815260040160405180910390fd5b61036f8160016107d2565b61037b858585856107e8565b5050505050565b61038b81610849565b50336004805c6001600160a01b0319168217905d5050565b5f6103ad826107b2565b90506103b88161087b565b806103c757506103c7816107c5565b156103e55760405163441e4c7360e11b815260040160405180910390fd5b5f6103f1826001610884565b905083815d50505050565b336001600160a01b038416146104255760405163e15e56c960e01b815260040160405180910390fd5b5f61042f846107b2565b905061043a8161087b565b1561045857604051630bbb04d960e11b815260040160405180910390fd5b6104638160016108d9565b61037b858585856108e5565b60605f61047e868686866109e6565b90508051600261048e91906111ed565b67ffffffffffffffff8111156104a6576104a66110ec565b6040519080825280602002602001820160405280156104f257816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104c45790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161053b9493929190611200565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061057d5761057d611248565b60209081029190910101525f5b81518110156105de578181815181106105a5576105a5611248565b6020026020010151838260016105bb91906111ed565b815181106105cb576105cb611248565b602090810291909101015260010161058a565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106259493929190611200565b604051602081830303815290604052915060e01b6020820180516001600160e01b0383818316178352505050508152508260018451610664919061125c565b8151811061067457610674611248565b602002602001018190525050949350505050565b5f61069a610695836107b2565b610c51565b92915050565b60606106e083838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c5e92505050565b604051602001610708919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61069a82610c6a565b336001600160a01b0360045c16146107545760405163e15e56c960e01b815260040160405180910390fd5b5f61075e826107b2565b90506107698161087b565b158061077b5750610779816107c5565b155b1561079957604051634bd439b560e11b815260040160405180910390fd5b6107a281610c76565b5050565b5f61069a826054610c8d565b5f5f6107bd83610cb9565b5c9392505050565b5f5f6107bd836003610884565b5f6107de836003610884565b905081815d505050565b6108436107f484610688565b6108338585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610d2592505050565b61083d919061125c565b84610dff565b50505050565b5f6003805c90826108598361126f565b9190505d505f61086883610cb9565b905060035c80825d505060035c92915050565b5f5f6107bd8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107de836002610884565b61092761083d8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610d2592505050565b61096582828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c5e92505050565b6001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109a0573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109c49190611287565b6001805c6001600160a01b0319166001600160a01b03831617905d5050505050565b60605f610a2784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c5e92505050565b90505f610a6885858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c6a92505050565b90505f610aac86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610c8d915050565b90508015610b1f576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610af8573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b1c91906112a2565b91505b815f03610b3f576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0383161580610b5c57506001600160a01b038716155b15610b7a57604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b8f57905050604080516060810182526001600160a01b0380871682525f6020830152825160248101879052908b16604482018190526064820152929650919082019060840160408051601f198184030181529190526020810180516001600160e01b0316630b8b4a6160e21b1790529052845185905f90610c3b57610c3b611248565b6020026020010181905250505050949350505050565b5f5f6107bd836001610884565b5f61069a826020610e17565b5f61069a826034610e80565b610c80815f6108d9565b610c8a815f6107d2565b50565b5f828281518110610ca057610ca0611248565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610d0892919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610d2f82610c5e565b6001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610d6a573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d8e9190611287565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610dd4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610df891906112a2565b9392505050565b5f610e09826107b2565b90505f6103f1826001610884565b5f610e238260146111ed565b83511015610e705760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610e8c8260206111ed565b83511015610ed45760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610e67565b50016020015190565b6001600160a01b0381168114610c8a575f5ffd5b5f5f83601f840112610f01575f5ffd5b50813567ffffffffffffffff811115610f18575f5ffd5b602083019150836020828501011115610f2f575f5ffd5b9250929050565b5f5f5f5f60608587031215610f49575f5ffd5b8435610f5481610edd565b93506020850135610f6481610edd565b9250604085013567ffffffffffffffff811115610f7f575f5ffd5b610f8b87828801610ef1565b95989497509550505050565b5f60208284031215610fa7575f5ffd5b8135610df881610edd565b5f5f60408385031215610fc3575f5ffd5b823591506020830135610fd581610edd565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561108f57868503603f19018452815180516001600160a01b031686526020808201519087015260409081015160609187018290529061107990870182610fe0565b9550506020938401939190910190600101611034565b50929695505050505050565b5f5f602083850312156110ac575f5ffd5b823567ffffffffffffffff8111156110c2575f5ffd5b6110ce85828601610ef1565b90969095509350505050565b602081525f610df86020830184610fe0565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611110575f5ffd5b813567ffffffffffffffff811115611126575f5ffd5b8201601f81018413611136575f5ffd5b803567ffffffffffffffff811115611150576111506110ec565b604051601f8201601f19908116603f0116810167ffffffffffffffff8111828210171561117f5761117f6110ec565b604052818152828201602001861015611196575f5ffd5b816020840160208301375f91810160200191909152949350505050565b60208101600383106111d357634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561069a5761069a6111d9565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561069a5761069a6111d9565b5f60018201611280576112806111d9565b5060010190565b5f60208284031215611297575f5ffd5b8151610df881610edd565b5f602082840312156112b2575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// Deposit7540VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use Deposit7540VaultHookMetaData.ABI instead.
+var Deposit7540VaultHookABI = Deposit7540VaultHookMetaData.ABI
+
+// Deposit7540VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Deposit7540VaultHookMetaData.Bin instead.
+var Deposit7540VaultHookBin = Deposit7540VaultHookMetaData.Bin
+
+// DeployDeposit7540VaultHook deploys a new Ethereum contract, binding an instance of Deposit7540VaultHook to it.
+func DeployDeposit7540VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Deposit7540VaultHook, error) {
+	parsed, err := Deposit7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Deposit7540VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Deposit7540VaultHook{Deposit7540VaultHookCaller: Deposit7540VaultHookCaller{contract: contract}, Deposit7540VaultHookTransactor: Deposit7540VaultHookTransactor{contract: contract}, Deposit7540VaultHookFilterer: Deposit7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// Deposit7540VaultHook is an auto generated Go binding around an Ethereum contract.
+type Deposit7540VaultHook struct {
+	Deposit7540VaultHookCaller     // Read-only binding to the contract
+	Deposit7540VaultHookTransactor // Write-only binding to the contract
+	Deposit7540VaultHookFilterer   // Log filterer for contract events
+}
+
+// Deposit7540VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Deposit7540VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Deposit7540VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Deposit7540VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Deposit7540VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Deposit7540VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Deposit7540VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Deposit7540VaultHookSession struct {
+	Contract     *Deposit7540VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// Deposit7540VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Deposit7540VaultHookCallerSession struct {
+	Contract *Deposit7540VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// Deposit7540VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Deposit7540VaultHookTransactorSession struct {
+	Contract     *Deposit7540VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// Deposit7540VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Deposit7540VaultHookRaw struct {
+	Contract *Deposit7540VaultHook // Generic contract binding to access the raw methods on
+}
+
+// Deposit7540VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Deposit7540VaultHookCallerRaw struct {
+	Contract *Deposit7540VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Deposit7540VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Deposit7540VaultHookTransactorRaw struct {
+	Contract *Deposit7540VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDeposit7540VaultHook creates a new instance of Deposit7540VaultHook, bound to a specific deployed contract.
+func NewDeposit7540VaultHook(address common.Address, backend bind.ContractBackend) (*Deposit7540VaultHook, error) {
+	contract, err := bindDeposit7540VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit7540VaultHook{Deposit7540VaultHookCaller: Deposit7540VaultHookCaller{contract: contract}, Deposit7540VaultHookTransactor: Deposit7540VaultHookTransactor{contract: contract}, Deposit7540VaultHookFilterer: Deposit7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewDeposit7540VaultHookCaller creates a new read-only instance of Deposit7540VaultHook, bound to a specific deployed contract.
+func NewDeposit7540VaultHookCaller(address common.Address, caller bind.ContractCaller) (*Deposit7540VaultHookCaller, error) {
+	contract, err := bindDeposit7540VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit7540VaultHookCaller{contract: contract}, nil
+}
+
+// NewDeposit7540VaultHookTransactor creates a new write-only instance of Deposit7540VaultHook, bound to a specific deployed contract.
+func NewDeposit7540VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*Deposit7540VaultHookTransactor, error) {
+	contract, err := bindDeposit7540VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit7540VaultHookTransactor{contract: contract}, nil
+}
+
+// NewDeposit7540VaultHookFilterer creates a new log filterer instance of Deposit7540VaultHook, bound to a specific deployed contract.
+func NewDeposit7540VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*Deposit7540VaultHookFilterer, error) {
+	contract, err := bindDeposit7540VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Deposit7540VaultHookFilterer{contract: contract}, nil
+}
+
+// bindDeposit7540VaultHook binds a generic wrapper to an already deployed contract.
+func bindDeposit7540VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Deposit7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Deposit7540VaultHook *Deposit7540VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Deposit7540VaultHook.Contract.Deposit7540VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Deposit7540VaultHook *Deposit7540VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.Deposit7540VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Deposit7540VaultHook *Deposit7540VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.Deposit7540VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Deposit7540VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _Deposit7540VaultHook.Contract.SUBTYPE(&_Deposit7540VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _Deposit7540VaultHook.Contract.SUBTYPE(&_Deposit7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) Asset() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.Asset(&_Deposit7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) Asset() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.Asset(&_Deposit7540VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Deposit7540VaultHook.Contract.Build(&_Deposit7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Deposit7540VaultHook.Contract.Build(&_Deposit7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.DecodeAmount(&_Deposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.DecodeAmount(&_Deposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Deposit7540VaultHook.Contract.DecodeUsePrevHookAmount(&_Deposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Deposit7540VaultHook.Contract.DecodeUsePrevHookAmount(&_Deposit7540VaultHook.CallOpts, data)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) DstChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "dstChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) DstChainId() (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.DstChainId(&_Deposit7540VaultHook.CallOpts)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) DstChainId() (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.DstChainId(&_Deposit7540VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.ExecutionNonce(&_Deposit7540VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.ExecutionNonce(&_Deposit7540VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.GetOutAmount(&_Deposit7540VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.GetOutAmount(&_Deposit7540VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) HookType() (uint8, error) {
+	return _Deposit7540VaultHook.Contract.HookType(&_Deposit7540VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) HookType() (uint8, error) {
+	return _Deposit7540VaultHook.Contract.HookType(&_Deposit7540VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _Deposit7540VaultHook.Contract.Inspect(&_Deposit7540VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _Deposit7540VaultHook.Contract.Inspect(&_Deposit7540VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) LastCaller() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.LastCaller(&_Deposit7540VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.LastCaller(&_Deposit7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) SpToken() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.SpToken(&_Deposit7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.SpToken(&_Deposit7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) Subtype() ([32]byte, error) {
+	return _Deposit7540VaultHook.Contract.Subtype(&_Deposit7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _Deposit7540VaultHook.Contract.Subtype(&_Deposit7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) UsedShares() (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.UsedShares(&_Deposit7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _Deposit7540VaultHook.Contract.UsedShares(&_Deposit7540VaultHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCaller) VaultBank(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Deposit7540VaultHook.contract.Call(opts, &out, "vaultBank")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) VaultBank() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.VaultBank(&_Deposit7540VaultHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_Deposit7540VaultHook *Deposit7540VaultHookCallerSession) VaultBank() (common.Address, error) {
+	return _Deposit7540VaultHook.Contract.VaultBank(&_Deposit7540VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.PostExecute(&_Deposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.PostExecute(&_Deposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.PreExecute(&_Deposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.PreExecute(&_Deposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.ResetExecutionState(&_Deposit7540VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.ResetExecutionState(&_Deposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.SetExecutionContext(&_Deposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.SetExecutionContext(&_Deposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.SetOutAmount(&_Deposit7540VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Deposit7540VaultHook *Deposit7540VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Deposit7540VaultHook.Contract.SetOutAmount(&_Deposit7540VaultHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,696 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package DepositWETHHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// DepositWETHHookMetaData contains all meta data concerning the DepositWETHHook contract.
+var DepositWETHHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"weth\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"WETH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ETH_AMOUNT\",\"inputs\":[]}]",
+}
+
+// DepositWETHHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use DepositWETHHookMetaData.ABI instead.
+var DepositWETHHookABI = DepositWETHHookMetaData.ABI
+
+// DepositWETHHook is an auto generated Go binding around an Ethereum contract.
+type DepositWETHHook struct {
+	DepositWETHHookCaller     // Read-only binding to the contract
+	DepositWETHHookTransactor // Write-only binding to the contract
+	DepositWETHHookFilterer   // Log filterer for contract events
+}
+
+// DepositWETHHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type DepositWETHHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DepositWETHHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type DepositWETHHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DepositWETHHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type DepositWETHHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// DepositWETHHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type DepositWETHHookSession struct {
+	Contract     *DepositWETHHook  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// DepositWETHHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type DepositWETHHookCallerSession struct {
+	Contract *DepositWETHHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// DepositWETHHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type DepositWETHHookTransactorSession struct {
+	Contract     *DepositWETHHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// DepositWETHHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type DepositWETHHookRaw struct {
+	Contract *DepositWETHHook // Generic contract binding to access the raw methods on
+}
+
+// DepositWETHHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type DepositWETHHookCallerRaw struct {
+	Contract *DepositWETHHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// DepositWETHHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type DepositWETHHookTransactorRaw struct {
+	Contract *DepositWETHHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewDepositWETHHook creates a new instance of DepositWETHHook, bound to a specific deployed contract.
+func NewDepositWETHHook(address common.Address, backend bind.ContractBackend) (*DepositWETHHook, error) {
+	contract, err := bindDepositWETHHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositWETHHook{DepositWETHHookCaller: DepositWETHHookCaller{contract: contract}, DepositWETHHookTransactor: DepositWETHHookTransactor{contract: contract}, DepositWETHHookFilterer: DepositWETHHookFilterer{contract: contract}}, nil
+}
+
+// NewDepositWETHHookCaller creates a new read-only instance of DepositWETHHook, bound to a specific deployed contract.
+func NewDepositWETHHookCaller(address common.Address, caller bind.ContractCaller) (*DepositWETHHookCaller, error) {
+	contract, err := bindDepositWETHHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositWETHHookCaller{contract: contract}, nil
+}
+
+// NewDepositWETHHookTransactor creates a new write-only instance of DepositWETHHook, bound to a specific deployed contract.
+func NewDepositWETHHookTransactor(address common.Address, transactor bind.ContractTransactor) (*DepositWETHHookTransactor, error) {
+	contract, err := bindDepositWETHHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositWETHHookTransactor{contract: contract}, nil
+}
+
+// NewDepositWETHHookFilterer creates a new log filterer instance of DepositWETHHook, bound to a specific deployed contract.
+func NewDepositWETHHookFilterer(address common.Address, filterer bind.ContractFilterer) (*DepositWETHHookFilterer, error) {
+	contract, err := bindDepositWETHHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &DepositWETHHookFilterer{contract: contract}, nil
+}
+
+// bindDepositWETHHook binds a generic wrapper to an already deployed contract.
+func bindDepositWETHHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := DepositWETHHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DepositWETHHook *DepositWETHHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DepositWETHHook.Contract.DepositWETHHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DepositWETHHook *DepositWETHHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.DepositWETHHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DepositWETHHook *DepositWETHHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.DepositWETHHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_DepositWETHHook *DepositWETHHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _DepositWETHHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_DepositWETHHook *DepositWETHHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_DepositWETHHook *DepositWETHHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DepositWETHHook *DepositWETHHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DepositWETHHook *DepositWETHHookSession) SUBTYPE() ([32]byte, error) {
+	return _DepositWETHHook.Contract.SUBTYPE(&_DepositWETHHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_DepositWETHHook *DepositWETHHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _DepositWETHHook.Contract.SUBTYPE(&_DepositWETHHook.CallOpts)
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCaller) WETH(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "WETH")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_DepositWETHHook *DepositWETHHookSession) WETH() (common.Address, error) {
+	return _DepositWETHHook.Contract.WETH(&_DepositWETHHook.CallOpts)
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCallerSession) WETH() (common.Address, error) {
+	return _DepositWETHHook.Contract.WETH(&_DepositWETHHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DepositWETHHook *DepositWETHHookSession) Asset() (common.Address, error) {
+	return _DepositWETHHook.Contract.Asset(&_DepositWETHHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCallerSession) Asset() (common.Address, error) {
+	return _DepositWETHHook.Contract.Asset(&_DepositWETHHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DepositWETHHook *DepositWETHHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DepositWETHHook *DepositWETHHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _DepositWETHHook.Contract.Build(&_DepositWETHHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_DepositWETHHook *DepositWETHHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _DepositWETHHook.Contract.Build(&_DepositWETHHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_DepositWETHHook *DepositWETHHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_DepositWETHHook *DepositWETHHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _DepositWETHHook.Contract.DecodeUsePrevHookAmount(&_DepositWETHHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_DepositWETHHook *DepositWETHHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _DepositWETHHook.Contract.DecodeUsePrevHookAmount(&_DepositWETHHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookSession) ExecutionNonce() (*big.Int, error) {
+	return _DepositWETHHook.Contract.ExecutionNonce(&_DepositWETHHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _DepositWETHHook.Contract.ExecutionNonce(&_DepositWETHHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _DepositWETHHook.Contract.GetOutAmount(&_DepositWETHHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _DepositWETHHook.Contract.GetOutAmount(&_DepositWETHHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DepositWETHHook *DepositWETHHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DepositWETHHook *DepositWETHHookSession) HookType() (uint8, error) {
+	return _DepositWETHHook.Contract.HookType(&_DepositWETHHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_DepositWETHHook *DepositWETHHookCallerSession) HookType() (uint8, error) {
+	return _DepositWETHHook.Contract.HookType(&_DepositWETHHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_DepositWETHHook *DepositWETHHookCaller) Inspect(opts *bind.CallOpts, arg0 []byte) ([]byte, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "inspect", arg0)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_DepositWETHHook *DepositWETHHookSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _DepositWETHHook.Contract.Inspect(&_DepositWETHHook.CallOpts, arg0)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_DepositWETHHook *DepositWETHHookCallerSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _DepositWETHHook.Contract.Inspect(&_DepositWETHHook.CallOpts, arg0)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DepositWETHHook *DepositWETHHookSession) LastCaller() (common.Address, error) {
+	return _DepositWETHHook.Contract.LastCaller(&_DepositWETHHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCallerSession) LastCaller() (common.Address, error) {
+	return _DepositWETHHook.Contract.LastCaller(&_DepositWETHHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DepositWETHHook *DepositWETHHookSession) SpToken() (common.Address, error) {
+	return _DepositWETHHook.Contract.SpToken(&_DepositWETHHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_DepositWETHHook *DepositWETHHookCallerSession) SpToken() (common.Address, error) {
+	return _DepositWETHHook.Contract.SpToken(&_DepositWETHHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DepositWETHHook *DepositWETHHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DepositWETHHook *DepositWETHHookSession) Subtype() ([32]byte, error) {
+	return _DepositWETHHook.Contract.Subtype(&_DepositWETHHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_DepositWETHHook *DepositWETHHookCallerSession) Subtype() ([32]byte, error) {
+	return _DepositWETHHook.Contract.Subtype(&_DepositWETHHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _DepositWETHHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookSession) UsedShares() (*big.Int, error) {
+	return _DepositWETHHook.Contract.UsedShares(&_DepositWETHHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_DepositWETHHook *DepositWETHHookCallerSession) UsedShares() (*big.Int, error) {
+	return _DepositWETHHook.Contract.UsedShares(&_DepositWETHHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DepositWETHHook *DepositWETHHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DepositWETHHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DepositWETHHook *DepositWETHHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.PostExecute(&_DepositWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_DepositWETHHook *DepositWETHHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.PostExecute(&_DepositWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DepositWETHHook *DepositWETHHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DepositWETHHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DepositWETHHook *DepositWETHHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.PreExecute(&_DepositWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_DepositWETHHook *DepositWETHHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.PreExecute(&_DepositWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DepositWETHHook *DepositWETHHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DepositWETHHook *DepositWETHHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.ResetExecutionState(&_DepositWETHHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_DepositWETHHook *DepositWETHHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.ResetExecutionState(&_DepositWETHHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DepositWETHHook *DepositWETHHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DepositWETHHook *DepositWETHHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.SetExecutionContext(&_DepositWETHHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_DepositWETHHook *DepositWETHHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.SetExecutionContext(&_DepositWETHHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DepositWETHHook *DepositWETHHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DepositWETHHook *DepositWETHHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.SetOutAmount(&_DepositWETHHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_DepositWETHHook *DepositWETHHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _DepositWETHHook.Contract.SetOutAmount(&_DepositWETHHook.TransactOpts, _outAmount, caller)
+}
@@ -31,13 +31,35 @@ var (
 
 // ERC4626YieldSourceOracleMetaData contains all meta data concerning the ERC4626YieldSourceOracle contract.
 var ERC4626YieldSourceOracleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604051611147380380611147833981016040819052602b91603b565b6001600160a01b03166080526066565b5f60208284031215604a575f5ffd5b81516001600160a01b0381168114605f575f5ffd5b9392505050565b6080516110c26100855f395f8181610166015261033001526110c25ff3fe608060405234801561000f575f5ffd5b50600436106100cb575f3560e01c80638717164a11610088578063cacc7b0e11610063578063cacc7b0e146101d3578063d449a832146101e6578063ec422afd1461020b578063fea8af5f1461021e575f5ffd5b80638717164a14610161578063a7a128b4146101a0578063aa5815fd146101c0575f5ffd5b8063056f143c146100cf5780630f40517a146100f55780632f112c461461010857806334f99b481461011b5780634fecb2661461013b5780637eeb81071461014e575b5f5ffd5b6100e26100dd366004610b26565b610231565b6040519081526020015b60405180910390f35b6100e2610103366004610b64565b6102a3565b6100e2610116366004610b7f565b61030a565b61012e610129366004610cb1565b61056f565b6040516100ec9190610d8f565b6100e2610149366004610e1b565b610715565b6100e261015c366004610b26565b6107fe565b6101887f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100ec565b6101b36101ae366004610e52565b61082d565b6040516100ec9190610e84565b6100e26101ce366004610b26565b6108cf565b6101b36101e1366004610e52565b6108fe565b6101f96101f4366004610b64565b610999565b60405160ff90911681526020016100ec565b6100e2610219366004610b64565b6109fa565b6100e261022c366004610e1b565b610a9d565b60405163ef8b30f760e01b8152600481018290525f906001600160a01b0385169063ef8b30f7906024015b602060405180830381865afa158015610277573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029b9190610ec6565b949350505050565b5f816001600160a01b03166301e1d1146040518163ffffffff1660e01b8152600401602060405180830381865afa1580156102e0573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103049190610ec6565b92915050565b5f5f6103178686856108cf565b604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa92505050801561039b575060408051601f3d908101601f1916820190925261039891810190610edd565b60015b6103a6579050610566565b5f81602001511180156103c5575060808101516001600160a01b031615155b1561056257805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa158015610411573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104359190610ec6565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa158015610481573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104a59190610f6c565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa158015610526573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061054a9190610ec6565b90506105568186610fa0565b95505050505050610566565b5090505b95945050505050565b8151815160609190811461059657604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff8111156105af576105af610bd6565b6040519080825280602002602001820160405280156105e257816020015b60608152602001906001900390816105cd5790505b5091505f5b8181101561070d575f85828151811061060257610602610fb3565b602002602001015190505f85838151811061061f5761061f610fb3565b602002602001015190505f815190508067ffffffffffffffff81111561064757610647610bd6565b604051908082528060200260200182016040528015610670578160200160208202803683370190505b5086858151811061068357610683610fb3565b60200260200101819052505f5b818110156106fe575f6106bc858584815181106106af576106af610fb3565b6020026020010151610715565b9050808887815181106106d1576106d1610fb3565b602002602001015183815181106106ea576106ea610fb3565b602090810291909101015250600101610690565b505050508060010190506105e7565b505092915050565b6040516370a0823160e01b81526001600160a01b0382811660048301525f9184918391908316906370a0823190602401602060405180830381865afa158015610760573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906107849190610ec6565b9050805f03610797575f92505050610304565b6040516303d1689d60e11b8152600481018290526001600160a01b038316906307a2d13a90602401602060405180830381865afa1580156107da573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105669190610ec6565b604051630a28a47760e01b8152600481018290525f906001600160a01b03851690630a28a4779060240161025c565b80516060908067ffffffffffffffff81111561084b5761084b610bd6565b604051908082528060200260200182016040528015610874578160200160208202803683370190505b5091505f5b818110156108c8576108a384828151811061089657610896610fb3565b60200260200101516109fa565b8382815181106108b5576108b5610fb3565b6020908102919091010152600101610879565b5050919050565b60405163266d6a8360e11b8152600481018290525f906001600160a01b03851690634cdad5069060240161025c565b80516060908067ffffffffffffffff81111561091c5761091c610bd6565b604051908082528060200260200182016040528015610945578160200160208202803683370190505b5091505f5b818110156108c85761097484828151811061096757610967610fb3565b60200260200101516102a3565b83828151811061098657610986610fb3565b602090810291909101015260010161094a565b5f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109d6573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103049190610f6c565b5f5f8290505f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610a3c573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a609190610f6c565b60ff1690506001600160a01b0382166307a2d13a610a7f83600a6110aa565b6040518263ffffffff1660e01b815260040161025c91815260200190565b6040516370a0823160e01b81526001600160a01b0382811660048301525f91908416906370a0823190602401602060405180830381865afa158015610ae4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b089190610ec6565b9392505050565b6001600160a01b0381168114610b23575f5ffd5b50565b5f5f5f60608486031215610b38575f5ffd5b8335610b4381610b0f565b92506020840135610b5381610b0f565b929592945050506040919091013590565b5f60208284031215610b74575f5ffd5b8135610b0881610b0f565b5f5f5f5f5f60a08688031215610b93575f5ffd5b853594506020860135610ba581610b0f565b93506040860135610bb581610b0f565b92506060860135610bc581610b0f565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610c1357610c13610bd6565b604052919050565b5f67ffffffffffffffff821115610c3457610c34610bd6565b5060051b60200190565b5f82601f830112610c4d575f5ffd5b8135610c60610c5b82610c1b565b610bea565b8082825260208201915060208360051b860101925085831115610c81575f5ffd5b602085015b83811015610ca7578035610c9981610b0f565b835260209283019201610c86565b5095945050505050565b5f5f60408385031215610cc2575f5ffd5b823567ffffffffffffffff811115610cd8575f5ffd5b610ce485828601610c3e565b925050602083013567ffffffffffffffff811115610d00575f5ffd5b8301601f81018513610d10575f5ffd5b8035610d1e610c5b82610c1b565b8082825260208201915060208360051b850101925087831115610d3f575f5ffd5b602084015b83811015610d8057803567ffffffffffffffff811115610d62575f5ffd5b610d718a602083890101610c3e565b84525060209283019201610d44565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610e0f57868503603f19018452815180518087526020918201918701905f5b81811015610df6578351835260209384019390920191600101610dd8565b5090965050506020938401939190910190600101610db5565b50929695505050505050565b5f5f60408385031215610e2c575f5ffd5b8235610e3781610b0f565b91506020830135610e4781610b0f565b809150509250929050565b5f60208284031215610e62575f5ffd5b813567ffffffffffffffff811115610e78575f5ffd5b61029b84828501610c3e565b602080825282518282018190525f918401906040840190835b81811015610ebb578351835260209384019390920191600101610e9d565b509095945050505050565b5f60208284031215610ed6575f5ffd5b5051919050565b5f60a0828403128015610eee575f5ffd5b5060405160a0810167ffffffffffffffff81118282101715610f1257610f12610bd6565b6040528251610f2081610b0f565b8152602083810151908201526040830151610f3a81610b0f565b60408201526060830151610f4d81610b0f565b60608201526080830151610f6081610b0f565b60808201529392505050565b5f60208284031215610f7c575f5ffd5b815160ff81168114610b08575f5ffd5b634e487b7160e01b5f52601160045260245ffd5b8082018082111561030457610304610f8c565b634e487b7160e01b5f52603260045260245ffd5b6001815b600184111561100257808504811115610fe657610fe6610f8c565b6001841615610ff457908102905b60019390931c928002610fcb565b935093915050565b5f8261101857506001610304565b8161102457505f610304565b816001811461103a576002811461104457611060565b6001915050610304565b60ff84111561105557611055610f8c565b50506001821b610304565b5060208310610133831016604e8410600b8410161715611083575081810a610304565b61108f5f198484610fc7565b805f19048211156110a2576110a2610f8c565b029392505050565b5f610b08838361100a56fea164736f6c634300081e000a",
 }
 
 // ERC4626YieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use ERC4626YieldSourceOracleMetaData.ABI instead.
 var ERC4626YieldSourceOracleABI = ERC4626YieldSourceOracleMetaData.ABI
 
+// ERC4626YieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC4626YieldSourceOracleMetaData.Bin instead.
+var ERC4626YieldSourceOracleBin = ERC4626YieldSourceOracleMetaData.Bin
+
+// DeployERC4626YieldSourceOracle deploys a new Ethereum contract, binding an instance of ERC4626YieldSourceOracle to it.
+func DeployERC4626YieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *ERC4626YieldSourceOracle, error) {
+	parsed, err := ERC4626YieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC4626YieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC4626YieldSourceOracle{ERC4626YieldSourceOracleCaller: ERC4626YieldSourceOracleCaller{contract: contract}, ERC4626YieldSourceOracleTransactor: ERC4626YieldSourceOracleTransactor{contract: contract}, ERC4626YieldSourceOracleFilterer: ERC4626YieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // ERC4626YieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type ERC4626YieldSourceOracle struct {
 	ERC4626YieldSourceOracleCaller     // Read-only binding to the contract
@@ -551,3 +573,34 @@ func (_ERC4626YieldSourceOracle *ERC4626YieldSourceOracleSession) GetTVLMultiple
 func (_ERC4626YieldSourceOracle *ERC4626YieldSourceOracleCallerSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
 	return _ERC4626YieldSourceOracle.Contract.GetTVLMultiple(&_ERC4626YieldSourceOracle.CallOpts, yieldSourceAddresses)
 }
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_ERC4626YieldSourceOracle *ERC4626YieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC4626YieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", yieldSourceAddress, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_ERC4626YieldSourceOracle *ERC4626YieldSourceOracleSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _ERC4626YieldSourceOracle.Contract.GetWithdrawalShareOutput(&_ERC4626YieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_ERC4626YieldSourceOracle *ERC4626YieldSourceOracleCallerSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _ERC4626YieldSourceOracle.Contract.GetWithdrawalShareOutput(&_ERC4626YieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
@@ -31,13 +31,35 @@ var (
 
 // ERC5115YieldSourceOracleMetaData contains all meta data concerning the ERC5115YieldSourceOracle contract.
 var ERC5115YieldSourceOracleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetIn\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060405161123d38038061123d833981016040819052602b91603b565b6001600160a01b03166080526066565b5f60208284031215604a575f5ffd5b81516001600160a01b0381168114605f575f5ffd5b9392505050565b6080516111b86100855f395f818161016601526103c701526111b85ff3fe608060405234801561000f575f5ffd5b50600436106100cb575f3560e01c80638717164a11610088578063cacc7b0e11610063578063cacc7b0e146101d3578063d449a832146101e6578063ec422afd1461020c578063fea8af5f1461021f575f5ffd5b80638717164a14610161578063a7a128b4146101a0578063aa5815fd146101c0575f5ffd5b8063056f143c146100cf5780630f40517a146100f55780632f112c461461010857806334f99b481461011b5780634fecb2661461013b5780637eeb81071461014e575b5f5ffd5b6100e26100dd366004610cbd565b610232565b6040519081526020015b60405180910390f35b6100e2610103366004610cfb565b6102af565b6100e2610116366004610d16565b6103a1565b61012e610129366004610e48565b610606565b6040516100ec9190610f26565b6100e2610149366004610fb2565b6107ac565b6100e261015c366004610cbd565b610879565b6101887f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100ec565b6101b36101ae366004610fe9565b61091a565b6040516100ec919061101b565b6100e26101ce366004610cbd565b6109bc565b6101b36101e1366004610fe9565b6109f4565b6101fa6101f4366004610cfb565b50601290565b60405160ff90911681526020016100ec565b6100e261021a366004610cfb565b610a8f565b6100e261022d366004610fb2565b610af0565b604051635c7c159360e11b81526001600160a01b038381166004830152602482018390525f919085169063b8f82b26906044015b602060405180830381865afa158015610281573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906102a5919061105d565b90505b9392505050565b5f5f8290505f816001600160a01b03166318160ddd6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156102f1573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610315919061105d565b9050805f0361032757505f9392505050565b61039981836001600160a01b0316633ba0b9a96040518163ffffffff1660e01b8152600401602060405180830381865afa158015610367573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061038b919061105d565b670de0b6b3a7640000610b5b565b949350505050565b5f5f6103ae8686856109bc565b604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa925050508015610432575060408051601f3d908101601f1916820190925261042f91810190611074565b60015b61043d5790506105fd565b5f816020015111801561045c575060808101516001600160a01b031615155b156105f957805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa1580156104a8573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104cc919061105d565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa158015610518573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061053c9190611103565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa1580156105bd573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105e1919061105d565b90506105ed8186611123565b955050505050506105fd565b5090505b95945050505050565b8151815160609190811461062d57604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff81111561064657610646610d6d565b60405190808252806020026020018201604052801561067957816020015b60608152602001906001900390816106645790505b5091505f5b818110156107a4575f85828151811061069957610699611142565b602002602001015190505f8583815181106106b6576106b6611142565b602002602001015190505f815190508067ffffffffffffffff8111156106de576106de610d6d565b604051908082528060200260200182016040528015610707578160200160208202803683370190505b5086858151811061071a5761071a611142565b60200260200101819052505f5b81811015610795575f6107538585848151811061074657610746611142565b60200260200101516107ac565b90508088878151811061076857610768611142565b6020026020010151838151811061078157610781611142565b602090810291909101015250600101610727565b5050505080600101905061067e565b505092915050565b6040516370a0823160e01b81526001600160a01b0382811660048301525f9184918391908316906370a0823190602401602060405180830381865afa1580156107f7573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061081b919061105d565b9050805f0361082e575f92505050610873565b61086e81836001600160a01b0316633ba0b9a96040518163ffffffff1660e01b8152600401602060405180830381865afa158015610367573d5f5f3e3d5ffd5b925050505b92915050565b60405163cbe52ae360e01b81526001600160a01b038381166004830152670de0b6b3a764000060248301525f91829186169063cbe52ae390604401602060405180830381865afa1580156108cf573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108f3919061105d565b9050805f03610905575f9150506102a8565b6105fd83670de0b6b3a7640000836001610c0b565b80516060908067ffffffffffffffff81111561093857610938610d6d565b604051908082528060200260200182016040528015610961578160200160208202803683370190505b5091505f5b818110156109b55761099084828151811061098357610983611142565b6020026020010151610a8f565b8382815181106109a2576109a2611142565b6020908102919091010152600101610966565b5050919050565b60405163cbe52ae360e01b81526001600160a01b038381166004830152602482018390525f919085169063cbe52ae390604401610266565b80516060908067ffffffffffffffff811115610a1257610a12610d6d565b604051908082528060200260200182016040528015610a3b578160200160208202803683370190505b5091505f5b818110156109b557610a6a848281518110610a5d57610a5d611142565b60200260200101516102af565b838281518110610a7c57610a7c611142565b6020908102919091010152600101610a40565b5f816001600160a01b0316633ba0b9a96040518163ffffffff1660e01b8152600401602060405180830381865afa158015610acc573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610873919061105d565b6040516370a0823160e01b81526001600160a01b0382811660048301525f91908416906370a0823190602401602060405180830381865afa158015610b37573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906102a8919061105d565b5f5f5f610b688686610c4d565b91509150815f03610b8c57838181610b8257610b82611156565b04925050506102a8565b818411610ba357610ba36003851502601118610c69565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f610c38610c1883610c7a565b8015610c3357505f8480610c2e57610c2e611156565b868809115b151590565b610c43868686610b5b565b6105fd9190611123565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b5f6002826003811115610c8f57610c8f61116a565b610c99919061117e565b60ff166001149050919050565b6001600160a01b0381168114610cba575f5ffd5b50565b5f5f5f60608486031215610ccf575f5ffd5b8335610cda81610ca6565b92506020840135610cea81610ca6565b929592945050506040919091013590565b5f60208284031215610d0b575f5ffd5b81356102a881610ca6565b5f5f5f5f5f60a08688031215610d2a575f5ffd5b853594506020860135610d3c81610ca6565b93506040860135610d4c81610ca6565b92506060860135610d5c81610ca6565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610daa57610daa610d6d565b604052919050565b5f67ffffffffffffffff821115610dcb57610dcb610d6d565b5060051b60200190565b5f82601f830112610de4575f5ffd5b8135610df7610df282610db2565b610d81565b8082825260208201915060208360051b860101925085831115610e18575f5ffd5b602085015b83811015610e3e578035610e3081610ca6565b835260209283019201610e1d565b5095945050505050565b5f5f60408385031215610e59575f5ffd5b823567ffffffffffffffff811115610e6f575f5ffd5b610e7b85828601610dd5565b925050602083013567ffffffffffffffff811115610e97575f5ffd5b8301601f81018513610ea7575f5ffd5b8035610eb5610df282610db2565b8082825260208201915060208360051b850101925087831115610ed6575f5ffd5b602084015b83811015610f1757803567ffffffffffffffff811115610ef9575f5ffd5b610f088a602083890101610dd5565b84525060209283019201610edb565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610fa657868503603f19018452815180518087526020918201918701905f5b81811015610f8d578351835260209384019390920191600101610f6f565b5090965050506020938401939190910190600101610f4c565b50929695505050505050565b5f5f60408385031215610fc3575f5ffd5b8235610fce81610ca6565b91506020830135610fde81610ca6565b809150509250929050565b5f60208284031215610ff9575f5ffd5b813567ffffffffffffffff81111561100f575f5ffd5b61039984828501610dd5565b602080825282518282018190525f918401906040840190835b81811015611052578351835260209384019390920191600101611034565b509095945050505050565b5f6020828403121561106d575f5ffd5b5051919050565b5f60a0828403128015611085575f5ffd5b5060405160a0810167ffffffffffffffff811182821017156110a9576110a9610d6d565b60405282516110b781610ca6565b81526020838101519082015260408301516110d181610ca6565b604082015260608301516110e481610ca6565b606082015260808301516110f781610ca6565b60808201529392505050565b5f60208284031215611113575f5ffd5b815160ff811681146102a8575f5ffd5b8082018082111561087357634e487b7160e01b5f52601160045260245ffd5b634e487b7160e01b5f52603260045260245ffd5b634e487b7160e01b5f52601260045260245ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60ff83168061119c57634e487b7160e01b5f52601260045260245ffd5b8060ff8416069150509291505056fea164736f6c634300081e000a",
 }
 
 // ERC5115YieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use ERC5115YieldSourceOracleMetaData.ABI instead.
 var ERC5115YieldSourceOracleABI = ERC5115YieldSourceOracleMetaData.ABI
 
+// ERC5115YieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC5115YieldSourceOracleMetaData.Bin instead.
+var ERC5115YieldSourceOracleBin = ERC5115YieldSourceOracleMetaData.Bin
+
+// DeployERC5115YieldSourceOracle deploys a new Ethereum contract, binding an instance of ERC5115YieldSourceOracle to it.
+func DeployERC5115YieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *ERC5115YieldSourceOracle, error) {
+	parsed, err := ERC5115YieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC5115YieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC5115YieldSourceOracle{ERC5115YieldSourceOracleCaller: ERC5115YieldSourceOracleCaller{contract: contract}, ERC5115YieldSourceOracleTransactor: ERC5115YieldSourceOracleTransactor{contract: contract}, ERC5115YieldSourceOracleFilterer: ERC5115YieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // ERC5115YieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type ERC5115YieldSourceOracle struct {
 	ERC5115YieldSourceOracleCaller     // Read-only binding to the contract
@@ -551,3 +573,34 @@ func (_ERC5115YieldSourceOracle *ERC5115YieldSourceOracleSession) GetTVLMultiple
 func (_ERC5115YieldSourceOracle *ERC5115YieldSourceOracleCallerSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
 	return _ERC5115YieldSourceOracle.Contract.GetTVLMultiple(&_ERC5115YieldSourceOracle.CallOpts, yieldSourceAddresses)
 }
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address assetIn, uint256 assetsIn) view returns(uint256)
+func (_ERC5115YieldSourceOracle *ERC5115YieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, assetIn common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC5115YieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", yieldSourceAddress, assetIn, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address assetIn, uint256 assetsIn) view returns(uint256)
+func (_ERC5115YieldSourceOracle *ERC5115YieldSourceOracleSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, assetIn common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _ERC5115YieldSourceOracle.Contract.GetWithdrawalShareOutput(&_ERC5115YieldSourceOracle.CallOpts, yieldSourceAddress, assetIn, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address assetIn, uint256 assetsIn) view returns(uint256)
+func (_ERC5115YieldSourceOracle *ERC5115YieldSourceOracleCallerSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, assetIn common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _ERC5115YieldSourceOracle.Contract.GetWithdrawalShareOutput(&_ERC5115YieldSourceOracle.CallOpts, yieldSourceAddress, assetIn, assetsIn)
+}
@@ -31,13 +31,35 @@ var (
 
 // ERC7540YieldSourceOracleMetaData contains all meta data concerning the ERC7540YieldSourceOracle contract.
 var ERC7540YieldSourceOracleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604051611508380380611508833981016040819052602b91603b565b6001600160a01b03166080526066565b5f60208284031215604a575f5ffd5b81516001600160a01b0381168114605f575f5ffd5b9392505050565b6080516114836100855f395f8181610166015261033201526114835ff3fe608060405234801561000f575f5ffd5b50600436106100cb575f3560e01c80638717164a11610088578063cacc7b0e11610063578063cacc7b0e146101d3578063d449a832146101e6578063ec422afd1461020b578063fea8af5f1461021e575f5ffd5b80638717164a14610161578063a7a128b4146101a0578063aa5815fd146101c0575f5ffd5b8063056f143c146100cf5780630f40517a146100f55780632f112c461461010857806334f99b481461011b5780634fecb2661461013b5780637eeb81071461014e575b5f5ffd5b6100e26100dd366004610e77565b610231565b6040519081526020015b60405180910390f35b6100e2610103366004610eb5565b6102a5565b6100e2610116366004610ed0565b61030c565b61012e610129366004611002565b610571565b6040516100ec91906110e0565b6100e261014936600461116c565b610717565b6100e261015c366004610e77565b610864565b6101887f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100ec565b6101b36101ae3660046111a3565b6108fd565b6040516100ec91906111d5565b6100e26101ce366004610e77565b61099f565b6101b36101e13660046111a3565b6109ce565b6101f96101f4366004610eb5565b610a69565b60405160ff90911681526020016100ec565b6100e2610219366004610eb5565b610b2d565b6100e261022c36600461116c565b610c4a565b6040516363737ac960e11b8152600481018290525f906001600160a01b0385169063c6e6f592906024015b602060405180830381865afa158015610277573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029b9190611217565b90505b9392505050565b5f816001600160a01b03166301e1d1146040518163ffffffff1660e01b8152600401602060405180830381865afa1580156102e2573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103069190611217565b92915050565b5f5f61031986868561099f565b604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa92505050801561039d575060408051601f3d908101601f1916820190925261039a9181019061122e565b60015b6103a8579050610568565b5f81602001511180156103c7575060808101516001600160a01b031615155b1561056457805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa158015610413573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104379190611217565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa158015610483573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104a791906112bd565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa158015610528573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061054c9190611217565b905061055881866112f1565b95505050505050610568565b5090505b95945050505050565b8151815160609190811461059857604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff8111156105b1576105b1610f27565b6040519080825280602002602001820160405280156105e457816020015b60608152602001906001900390816105cf5790505b5091505f5b8181101561070f575f85828151811061060457610604611304565b602002602001015190505f85838151811061062157610621611304565b602002602001015190505f815190508067ffffffffffffffff81111561064957610649610f27565b604051908082528060200260200182016040528015610672578160200160208202803683370190505b5086858151811061068557610685611304565b60200260200101819052505f5b81811015610700575f6106be858584815181106106b1576106b1611304565b6020026020010151610717565b9050808887815181106106d3576106d3611304565b602002602001015183815181106106ec576106ec611304565b602090810291909101015250600101610692565b505050508060010190506105e9565b505092915050565b5f5f836001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610755573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906107799190611318565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa1580156107bf573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906107e39190611217565b9050805f036107f5575f915050610306565b6040516303d1689d60e11b8152600481018290526001600160a01b038516906307a2d13a90602401602060405180830381865afa158015610838573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061085c9190611217565b949350505050565b6040516303d1689d60e11b8152670de0b6b3a764000060048201525f9081906001600160a01b038616906307a2d13a90602401602060405180830381865afa1580156108b2573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108d69190611217565b9050805f036108e8575f91505061029e565b61056883670de0b6b3a7640000836001610d15565b80516060908067ffffffffffffffff81111561091b5761091b610f27565b604051908082528060200260200182016040528015610944578160200160208202803683370190505b5091505f5b818110156109985761097384828151811061096657610966611304565b6020026020010151610b2d565b83828151811061098557610985611304565b6020908102919091010152600101610949565b5050919050565b6040516303d1689d60e11b8152600481018290525f906001600160a01b038516906307a2d13a9060240161025c565b80516060908067ffffffffffffffff8111156109ec576109ec610f27565b604051908082528060200260200182016040528015610a15578160200160208202803683370190505b5091505f5b8181101561099857610a44848281518110610a3757610a37611304565b60200260200101516102a5565b838281518110610a5657610a56611304565b6020908102919091010152600101610a1a565b5f5f826001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610aa7573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610acb9190611318565b9050806001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610b09573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029e91906112bd565b5f5f826001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610b6b573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b8f9190611318565b90505f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610bce573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610bf291906112bd565b60ff1690506001600160a01b0384166307a2d13a610c1183600a611416565b6040518263ffffffff1660e01b8152600401610c2f91815260200190565b602060405180830381865afa158015610838573d5f5f3e3d5ffd5b5f826001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610c87573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610cab9190611318565b6040516370a0823160e01b81526001600160a01b03848116600483015291909116906370a0823190602401602060405180830381865afa158015610cf1573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029e9190611217565b5f610d42610d2283610d57565b8015610d3d57505f8480610d3857610d38611421565b868809115b151590565b610d4d868686610d83565b61056891906112f1565b5f6002826003811115610d6c57610d6c611435565b610d769190611449565b60ff166001149050919050565b5f5f5f610d908686610e33565b91509150815f03610db457838181610daa57610daa611421565b049250505061029e565b818411610dcb57610dcb6003851502601118610e4f565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b0381168114610e74575f5ffd5b50565b5f5f5f60608486031215610e89575f5ffd5b8335610e9481610e60565b92506020840135610ea481610e60565b929592945050506040919091013590565b5f60208284031215610ec5575f5ffd5b813561029e81610e60565b5f5f5f5f5f60a08688031215610ee4575f5ffd5b853594506020860135610ef681610e60565b93506040860135610f0681610e60565b92506060860135610f1681610e60565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610f6457610f64610f27565b604052919050565b5f67ffffffffffffffff821115610f8557610f85610f27565b5060051b60200190565b5f82601f830112610f9e575f5ffd5b8135610fb1610fac82610f6c565b610f3b565b8082825260208201915060208360051b860101925085831115610fd2575f5ffd5b602085015b83811015610ff8578035610fea81610e60565b835260209283019201610fd7565b5095945050505050565b5f5f60408385031215611013575f5ffd5b823567ffffffffffffffff811115611029575f5ffd5b61103585828601610f8f565b925050602083013567ffffffffffffffff811115611051575f5ffd5b8301601f81018513611061575f5ffd5b803561106f610fac82610f6c565b8082825260208201915060208360051b850101925087831115611090575f5ffd5b602084015b838110156110d157803567ffffffffffffffff8111156110b3575f5ffd5b6110c28a602083890101610f8f565b84525060209283019201611095565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561116057868503603f19018452815180518087526020918201918701905f5b81811015611147578351835260209384019390920191600101611129565b5090965050506020938401939190910190600101611106565b50929695505050505050565b5f5f6040838503121561117d575f5ffd5b823561118881610e60565b9150602083013561119881610e60565b809150509250929050565b5f602082840312156111b3575f5ffd5b813567ffffffffffffffff8111156111c9575f5ffd5b61085c84828501610f8f565b602080825282518282018190525f918401906040840190835b8181101561120c5783518352602093840193909201916001016111ee565b509095945050505050565b5f60208284031215611227575f5ffd5b5051919050565b5f60a082840312801561123f575f5ffd5b5060405160a0810167ffffffffffffffff8111828210171561126357611263610f27565b604052825161127181610e60565b815260208381015190820152604083015161128b81610e60565b6040820152606083015161129e81610e60565b606082015260808301516112b181610e60565b60808201529392505050565b5f602082840312156112cd575f5ffd5b815160ff8116811461029e575f5ffd5b634e487b7160e01b5f52601160045260245ffd5b80820180821115610306576103066112dd565b634e487b7160e01b5f52603260045260245ffd5b5f60208284031215611328575f5ffd5b815161029e81610e60565b6001815b600184111561136e57808504811115611352576113526112dd565b600184161561136057908102905b60019390931c928002611337565b935093915050565b5f8261138457506001610306565b8161139057505f610306565b81600181146113a657600281146113b0576113cc565b6001915050610306565b60ff8411156113c1576113c16112dd565b50506001821b610306565b5060208310610133831016604e8410600b84101617156113ef575081810a610306565b6113fb5f198484611333565b805f190482111561140e5761140e6112dd565b029392505050565b5f61029e8383611376565b634e487b7160e01b5f52601260045260245ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60ff83168061146757634e487b7160e01b5f52601260045260245ffd5b8060ff8416069150509291505056fea164736f6c634300081e000a",
 }
 
 // ERC7540YieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use ERC7540YieldSourceOracleMetaData.ABI instead.
 var ERC7540YieldSourceOracleABI = ERC7540YieldSourceOracleMetaData.ABI
 
+// ERC7540YieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use ERC7540YieldSourceOracleMetaData.Bin instead.
+var ERC7540YieldSourceOracleBin = ERC7540YieldSourceOracleMetaData.Bin
+
+// DeployERC7540YieldSourceOracle deploys a new Ethereum contract, binding an instance of ERC7540YieldSourceOracle to it.
+func DeployERC7540YieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *ERC7540YieldSourceOracle, error) {
+	parsed, err := ERC7540YieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(ERC7540YieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &ERC7540YieldSourceOracle{ERC7540YieldSourceOracleCaller: ERC7540YieldSourceOracleCaller{contract: contract}, ERC7540YieldSourceOracleTransactor: ERC7540YieldSourceOracleTransactor{contract: contract}, ERC7540YieldSourceOracleFilterer: ERC7540YieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // ERC7540YieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type ERC7540YieldSourceOracle struct {
 	ERC7540YieldSourceOracleCaller     // Read-only binding to the contract
@@ -551,3 +573,34 @@ func (_ERC7540YieldSourceOracle *ERC7540YieldSourceOracleSession) GetTVLMultiple
 func (_ERC7540YieldSourceOracle *ERC7540YieldSourceOracleCallerSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
 	return _ERC7540YieldSourceOracle.Contract.GetTVLMultiple(&_ERC7540YieldSourceOracle.CallOpts, yieldSourceAddresses)
 }
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_ERC7540YieldSourceOracle *ERC7540YieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _ERC7540YieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", yieldSourceAddress, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_ERC7540YieldSourceOracle *ERC7540YieldSourceOracleSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _ERC7540YieldSourceOracle.Contract.GetWithdrawalShareOutput(&_ERC7540YieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_ERC7540YieldSourceOracle *ERC7540YieldSourceOracleCallerSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _ERC7540YieldSourceOracle.Contract.GetWithdrawalShareOutput(&_ERC7540YieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
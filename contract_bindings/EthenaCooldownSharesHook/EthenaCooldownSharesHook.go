@@ -0,0 +1,687 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package EthenaCooldownSharesHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// EthenaCooldownSharesHookMetaData contains all meta data concerning the EthenaCooldownSharesHook contract.
+var EthenaCooldownSharesHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152600881526721b7b7b63237bbb760c11b6020909101525f805460ff191690557f70fbe9d5927235f8c6014e8efeb60b8ac37eb0fca78742c3a38f4cc6d89da3a96080526080516110ec61007b5f395f81816101d2015261024801526110ec5ff3fe608060405234801561000f575f5ffd5b5060043610610106575f3560e01c80635492e6771161009e5780638e1487761161006e5780638e14877614610231578063984d6e7a14610243578063ac440f721461026a578063d06a1e891461027d578063e445e7dd14610290575f5ffd5b80635492e677146101d0578063685a943c146101f657806381cbe691146101fe5780638c4313c114610211575f5ffd5b80632113522a116100d95780632113522a146101615780632ae2fe3d1461018b57806338d52e0f1461019e5780633b5896bc146101b0575f5ffd5b806301bd43ef1461010a57806305b4fe911461012657806314cb37cf1461013b5780631f2646191461014e575b5f5ffd5b61011360035c81565b6040519081526020015b60405180910390f35b610139610134366004610d81565b6102a9565b005b610139610149366004610dde565b610323565b61013961015c366004610df7565b610344565b6101736001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161011d565b610139610199366004610d81565b61039d565b6101736001600160a01b0360025c1681565b6101c36101be366004610d81565b610410565b60405161011d9190610e4f565b7f0000000000000000000000000000000000000000000000000000000000000000610113565b6101135f5c81565b61011361020c366004610dde565b610629565b61022461021f366004610edc565b610641565b60405161011d9190610f1b565b6101736001600160a01b0360015c1681565b6101137f000000000000000000000000000000000000000000000000000000000000000081565b610113610278366004610f41565b6106c0565b61013961028b366004610dde565b6106ca565b5f5461029c9060ff1681565b60405161011d9190610ff4565b336001600160a01b038416146102d25760405163e15e56c960e01b815260040160405180910390fd5b5f6102dc84610747565b90506102e78161075a565b156103055760405163945b63f560e01b815260040160405180910390fd5b610310816001610767565b61031c8585858561077d565b5050505050565b61032c816107d1565b50336004805c6001600160a01b0319168217905d5050565b5f61034e82610747565b905061035981610803565b8061036857506103688161075a565b156103865760405163441e4c7360e11b815260040160405180910390fd5b5f61039282600161080c565b905083815d50505050565b336001600160a01b038416146103c65760405163e15e56c960e01b815260040160405180910390fd5b5f6103d084610747565b90506103db81610803565b156103f957604051630bbb04d960e11b815260040160405180910390fd5b610404816001610861565b61031c8585858561086d565b60605f61041f868686866108ac565b90508051600261042f919061102e565b67ffffffffffffffff81111561044757610447610f2d565b60405190808252806020026020018201604052801561049357816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104655790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104dc9493929190611041565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061051e5761051e611089565b60209081029190910101525f5b815181101561057f5781818151811061054657610546611089565b60200260200101518382600161055c919061102e565b8151811061056c5761056c611089565b602090810291909101015260010161052b565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105c69493929190611041565b604051602081830303815290604052915060e01b6020820180516001600160e01b0383818316178352505050508152508260018451610605919061109d565b8151811061061557610615611089565b602002602001018190525050949350505050565b5f61063b61063683610747565b610b0c565b92915050565b606061068183838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b1992505050565b6040516020016106a9919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61063b82610b25565b336001600160a01b0360045c16146106f55760405163e15e56c960e01b815260040160405180910390fd5b5f6106ff82610747565b905061070a81610803565b158061071c575061071a8161075a565b155b1561073a57604051634bd439b560e11b815260040160405180910390fd5b61074381610b31565b5050565b5f5f61075283610b48565b5c9392505050565b5f5f61075283600361080c565b5f61077383600361080c565b905081815d505050565b6107bc8383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bb492505050565b6107c7905f5c61109d565b805f5d5050505050565b5f6003805c90826107e1836110b0565b9190505d505f6107f083610b48565b905060035c80825d505060035c92915050565b5f5f6107528360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61077383600261080c565b6107c78383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bb492505050565b60605f6108ed84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b1992505050565b90505f61092e85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b2592505050565b90505f61097286868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610c2f915050565b905080156109e5576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa1580156109be573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109e291906110c8565b91505b815f03610a05576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0383161580610a2257506001600160a01b038716155b15610a4057604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610a555790505093506040518060600160405280846001600160a01b031681526020015f815260200183604051602401610ab891815260200190565b60408051601f198184030181529190526020810180516001600160e01b0316639343d9e160e01b1790529052845185905f90610af657610af6611089565b6020026020010181905250505050949350505050565b5f5f61075283600161080c565b5f61063b826020610c5b565b5f61063b826034610cc4565b610b3b815f610861565b610b45815f610767565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610b9792919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610bbe82610b19565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610c04573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c2891906110c8565b9392505050565b5f828281518110610c4257610c42611089565b01602001516001600160f81b0319161515905092915050565b5f610c6782601461102e565b83511015610cb45760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610cd082602061102e565b83511015610d185760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610cab565b50016020015190565b80356001600160a01b0381168114610d37575f5ffd5b919050565b5f5f83601f840112610d4c575f5ffd5b50813567ffffffffffffffff811115610d63575f5ffd5b602083019150836020828501011115610d7a575f5ffd5b9250929050565b5f5f5f5f60608587031215610d94575f5ffd5b610d9d85610d21565b9350610dab60208601610d21565b9250604085013567ffffffffffffffff811115610dc6575f5ffd5b610dd287828801610d3c565b95989497509550505050565b5f60208284031215610dee575f5ffd5b610c2882610d21565b5f5f60408385031215610e08575f5ffd5b82359150610e1860208401610d21565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610ed057868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610eba90870182610e21565b9550506020938401939190910190600101610e75565b50929695505050505050565b5f5f60208385031215610eed575f5ffd5b823567ffffffffffffffff811115610f03575f5ffd5b610f0f85828601610d3c565b90969095509350505050565b602081525f610c286020830184610e21565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215610f51575f5ffd5b813567ffffffffffffffff811115610f67575f5ffd5b8201601f81018413610f77575f5ffd5b803567ffffffffffffffff811115610f9157610f91610f2d565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715610fc057610fc0610f2d565b604052818152828201602001861015610fd7575f5ffd5b816020840160208301375f91810160200191909152949350505050565b602081016003831061101457634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561063b5761063b61101a565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561063b5761063b61101a565b5f600182016110c1576110c161101a565b5060010190565b5f602082840312156110d8575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// EthenaCooldownSharesHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use EthenaCooldownSharesHookMetaData.ABI instead.
+var EthenaCooldownSharesHookABI = EthenaCooldownSharesHookMetaData.ABI
+
+// EthenaCooldownSharesHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use EthenaCooldownSharesHookMetaData.Bin instead.
+var EthenaCooldownSharesHookBin = EthenaCooldownSharesHookMetaData.Bin
+
+// DeployEthenaCooldownSharesHook deploys a new Ethereum contract, binding an instance of EthenaCooldownSharesHook to it.
+func DeployEthenaCooldownSharesHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *EthenaCooldownSharesHook, error) {
+	parsed, err := EthenaCooldownSharesHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(EthenaCooldownSharesHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &EthenaCooldownSharesHook{EthenaCooldownSharesHookCaller: EthenaCooldownSharesHookCaller{contract: contract}, EthenaCooldownSharesHookTransactor: EthenaCooldownSharesHookTransactor{contract: contract}, EthenaCooldownSharesHookFilterer: EthenaCooldownSharesHookFilterer{contract: contract}}, nil
+}
+
+// EthenaCooldownSharesHook is an auto generated Go binding around an Ethereum contract.
+type EthenaCooldownSharesHook struct {
+	EthenaCooldownSharesHookCaller     // Read-only binding to the contract
+	EthenaCooldownSharesHookTransactor // Write-only binding to the contract
+	EthenaCooldownSharesHookFilterer   // Log filterer for contract events
+}
+
+// EthenaCooldownSharesHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EthenaCooldownSharesHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EthenaCooldownSharesHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EthenaCooldownSharesHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EthenaCooldownSharesHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EthenaCooldownSharesHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EthenaCooldownSharesHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EthenaCooldownSharesHookSession struct {
+	Contract     *EthenaCooldownSharesHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts             // Call options to use throughout this session
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// EthenaCooldownSharesHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EthenaCooldownSharesHookCallerSession struct {
+	Contract *EthenaCooldownSharesHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                   // Call options to use throughout this session
+}
+
+// EthenaCooldownSharesHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EthenaCooldownSharesHookTransactorSession struct {
+	Contract     *EthenaCooldownSharesHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                   // Transaction auth options to use throughout this session
+}
+
+// EthenaCooldownSharesHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EthenaCooldownSharesHookRaw struct {
+	Contract *EthenaCooldownSharesHook // Generic contract binding to access the raw methods on
+}
+
+// EthenaCooldownSharesHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EthenaCooldownSharesHookCallerRaw struct {
+	Contract *EthenaCooldownSharesHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EthenaCooldownSharesHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EthenaCooldownSharesHookTransactorRaw struct {
+	Contract *EthenaCooldownSharesHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEthenaCooldownSharesHook creates a new instance of EthenaCooldownSharesHook, bound to a specific deployed contract.
+func NewEthenaCooldownSharesHook(address common.Address, backend bind.ContractBackend) (*EthenaCooldownSharesHook, error) {
+	contract, err := bindEthenaCooldownSharesHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaCooldownSharesHook{EthenaCooldownSharesHookCaller: EthenaCooldownSharesHookCaller{contract: contract}, EthenaCooldownSharesHookTransactor: EthenaCooldownSharesHookTransactor{contract: contract}, EthenaCooldownSharesHookFilterer: EthenaCooldownSharesHookFilterer{contract: contract}}, nil
+}
+
+// NewEthenaCooldownSharesHookCaller creates a new read-only instance of EthenaCooldownSharesHook, bound to a specific deployed contract.
+func NewEthenaCooldownSharesHookCaller(address common.Address, caller bind.ContractCaller) (*EthenaCooldownSharesHookCaller, error) {
+	contract, err := bindEthenaCooldownSharesHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaCooldownSharesHookCaller{contract: contract}, nil
+}
+
+// NewEthenaCooldownSharesHookTransactor creates a new write-only instance of EthenaCooldownSharesHook, bound to a specific deployed contract.
+func NewEthenaCooldownSharesHookTransactor(address common.Address, transactor bind.ContractTransactor) (*EthenaCooldownSharesHookTransactor, error) {
+	contract, err := bindEthenaCooldownSharesHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaCooldownSharesHookTransactor{contract: contract}, nil
+}
+
+// NewEthenaCooldownSharesHookFilterer creates a new log filterer instance of EthenaCooldownSharesHook, bound to a specific deployed contract.
+func NewEthenaCooldownSharesHookFilterer(address common.Address, filterer bind.ContractFilterer) (*EthenaCooldownSharesHookFilterer, error) {
+	contract, err := bindEthenaCooldownSharesHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaCooldownSharesHookFilterer{contract: contract}, nil
+}
+
+// bindEthenaCooldownSharesHook binds a generic wrapper to an already deployed contract.
+func bindEthenaCooldownSharesHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EthenaCooldownSharesHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EthenaCooldownSharesHook.Contract.EthenaCooldownSharesHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.EthenaCooldownSharesHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.EthenaCooldownSharesHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EthenaCooldownSharesHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) SUBTYPE() ([32]byte, error) {
+	return _EthenaCooldownSharesHook.Contract.SUBTYPE(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _EthenaCooldownSharesHook.Contract.SUBTYPE(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) Asset() (common.Address, error) {
+	return _EthenaCooldownSharesHook.Contract.Asset(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) Asset() (common.Address, error) {
+	return _EthenaCooldownSharesHook.Contract.Asset(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _EthenaCooldownSharesHook.Contract.Build(&_EthenaCooldownSharesHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _EthenaCooldownSharesHook.Contract.Build(&_EthenaCooldownSharesHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.DecodeAmount(&_EthenaCooldownSharesHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.DecodeAmount(&_EthenaCooldownSharesHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) ExecutionNonce() (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.ExecutionNonce(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.ExecutionNonce(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.GetOutAmount(&_EthenaCooldownSharesHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.GetOutAmount(&_EthenaCooldownSharesHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) HookType() (uint8, error) {
+	return _EthenaCooldownSharesHook.Contract.HookType(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) HookType() (uint8, error) {
+	return _EthenaCooldownSharesHook.Contract.HookType(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) Inspect(data []byte) ([]byte, error) {
+	return _EthenaCooldownSharesHook.Contract.Inspect(&_EthenaCooldownSharesHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _EthenaCooldownSharesHook.Contract.Inspect(&_EthenaCooldownSharesHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) LastCaller() (common.Address, error) {
+	return _EthenaCooldownSharesHook.Contract.LastCaller(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) LastCaller() (common.Address, error) {
+	return _EthenaCooldownSharesHook.Contract.LastCaller(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) SpToken() (common.Address, error) {
+	return _EthenaCooldownSharesHook.Contract.SpToken(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) SpToken() (common.Address, error) {
+	return _EthenaCooldownSharesHook.Contract.SpToken(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) Subtype() ([32]byte, error) {
+	return _EthenaCooldownSharesHook.Contract.Subtype(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) Subtype() ([32]byte, error) {
+	return _EthenaCooldownSharesHook.Contract.Subtype(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaCooldownSharesHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) UsedShares() (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.UsedShares(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookCallerSession) UsedShares() (*big.Int, error) {
+	return _EthenaCooldownSharesHook.Contract.UsedShares(&_EthenaCooldownSharesHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.PostExecute(&_EthenaCooldownSharesHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.PostExecute(&_EthenaCooldownSharesHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.PreExecute(&_EthenaCooldownSharesHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.PreExecute(&_EthenaCooldownSharesHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.ResetExecutionState(&_EthenaCooldownSharesHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.ResetExecutionState(&_EthenaCooldownSharesHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.SetExecutionContext(&_EthenaCooldownSharesHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.SetExecutionContext(&_EthenaCooldownSharesHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.SetOutAmount(&_EthenaCooldownSharesHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_EthenaCooldownSharesHook *EthenaCooldownSharesHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _EthenaCooldownSharesHook.Contract.SetOutAmount(&_EthenaCooldownSharesHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,656 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package EthenaUnstakeHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// EthenaUnstakeHookMetaData contains all meta data concerning the EthenaUnstakeHook contract.
+var EthenaUnstakeHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604080518082019091526006815265457468656e6160d01b6020909101525f805460ff191660021790557f6b3e49837ea4354d423ec538ceb9b24edffccb1dfce5535d0d6fdbc5741d31ed6080526080516110ca61007c5f395f81816101c7015261023d01526110ca5ff3fe608060405234801561000f575f5ffd5b50600436106100fb575f3560e01c80635492e677116100935780638e148776116100635780638e14877614610226578063984d6e7a14610238578063d06a1e891461025f578063e445e7dd14610272575f5ffd5b80635492e677146101c5578063685a943c146101eb57806381cbe691146101f35780638c4313c114610206575f5ffd5b80632113522a116100ce5780632113522a146101565780632ae2fe3d1461018057806338d52e0f146101935780633b5896bc146101a5575f5ffd5b806301bd43ef146100ff57806305b4fe911461011b57806314cb37cf146101305780631f26461914610143575b5f5ffd5b61010860035c81565b6040519081526020015b60405180910390f35b61012e610129366004610ded565b61028b565b005b61012e61013e366004610e4e565b610305565b61012e610151366004610e69565b610326565b6101686001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610112565b61012e61018e366004610ded565b61037f565b6101686001600160a01b0360025c1681565b6101b86101b3366004610ded565b6103f2565b6040516101129190610ec5565b7f0000000000000000000000000000000000000000000000000000000000000000610108565b6101085f5c81565b610108610201366004610e4e565b61060b565b610219610214366004610f52565b610623565b6040516101129190610f91565b6101686001600160a01b0360015c1681565b6101087f000000000000000000000000000000000000000000000000000000000000000081565b61012e61026d366004610e4e565b6106a2565b5f5461027e9060ff1681565b6040516101129190610fa3565b336001600160a01b038416146102b45760405163e15e56c960e01b815260040160405180910390fd5b5f6102be8461071f565b90506102c981610732565b156102e75760405163945b63f560e01b815260040160405180910390fd5b6102f281600161073f565b6102fe85858585610755565b5050505050565b61030e81610868565b50336004805c6001600160a01b0319168217905d5050565b5f6103308261071f565b905061033b8161089a565b8061034a575061034a81610732565b156103685760405163441e4c7360e11b815260040160405180910390fd5b5f6103748260016108a3565b905083815d50505050565b336001600160a01b038416146103a85760405163e15e56c960e01b815260040160405180910390fd5b5f6103b28461071f565b90506103bd8161089a565b156103db57604051630bbb04d960e11b815260040160405180910390fd5b6103e68160016108f8565b6102fe85858585610904565b60605f61040186868686610a53565b9050805160026104119190610fdd565b67ffffffffffffffff81111561042957610429610ff0565b60405190808252806020026020018201604052801561047557816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104475790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104be9493929190611004565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106105005761050061104c565b60209081029190910101525f5b8151811015610561578181815181106105285761052861104c565b60200260200101518382600161053e9190610fdd565b8151811061054e5761054e61104c565b602090810291909101015260010161050d565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105a89493929190611004565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516105e79190611060565b815181106105f7576105f761104c565b602002602001018190525050949350505050565b5f61061d6106188361071f565b610b84565b92915050565b606061066383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b9192505050565b60405160200161068b919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b336001600160a01b0360045c16146106cd5760405163e15e56c960e01b815260040160405180910390fd5b5f6106d78261071f565b90506106e28161089a565b15806106f457506106f281610732565b155b1561071257604051634bd439b560e11b815260040160405180910390fd5b61071b81610b9d565b5050565b5f5f61072a83610bb4565b5c9392505050565b5f5f61072a8360036108a3565b5f61074b8360036108a3565b905081815d505050565b5f61079483838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b9192505050565b90505f6107a08561060b565b90506107f5816107e58787878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c2092505050565b6107ef9190611060565b86610c95565b604051630a28a47760e01b8152600481018290526001600160a01b03831690630a28a47790602401602060405180830381865afa158015610838573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061085c9190611073565b805f5d50505050505050565b5f6003805c90826108788361108a565b9190505d505f61088783610bb4565b905060035c80825d505060035c92915050565b5f5f61072a8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61074b8360026108a3565b5f61094383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b9192505050565b9050806001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610981573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109a591906110a2565b6002805c6001600160a01b0319166001600160a01b03831617905d50610a09610a038585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c2092505050565b85610c95565b610a488484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cad92505050565b805f5d505050505050565b60605f610a9484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b9192505050565b90506001600160a01b0381161580610ab357506001600160a01b038516155b15610ad157604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610ae657905050604080516060810182526001600160a01b0380851682525f602083015282519089166024820152929450919082019060440160408051601f198184030181529190526020810180516001600160e01b031663f2888dbb60e01b1790529052825183905f906105f7576105f761104c565b5f5f61072a8360016108a3565b5f61061d826020610d2c565b610ba7815f6108f8565b610bb1815f61073f565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610c0392919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610c6a573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c8e9190611073565b9392505050565b5f610c9f8261071f565b90505f6103748260016108a3565b5f5f610cb883610b91565b6040516370a0823160e01b81526001600160a01b038681166004830152919250908216906370a0823190602401602060405180830381865afa158015610d00573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d249190611073565b949350505050565b5f610d38826014610fdd565b83511015610d845760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b6001600160a01b0381168114610bb1575f5ffd5b5f5f83601f840112610db8575f5ffd5b50813567ffffffffffffffff811115610dcf575f5ffd5b602083019150836020828501011115610de6575f5ffd5b9250929050565b5f5f5f5f60608587031215610e00575f5ffd5b8435610e0b81610d94565b93506020850135610e1b81610d94565b9250604085013567ffffffffffffffff811115610e36575f5ffd5b610e4287828801610da8565b95989497509550505050565b5f60208284031215610e5e575f5ffd5b8135610c8e81610d94565b5f5f60408385031215610e7a575f5ffd5b823591506020830135610e8c81610d94565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610f4657868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610f3090870182610e97565b9550506020938401939190910190600101610eeb565b50929695505050505050565b5f5f60208385031215610f63575f5ffd5b823567ffffffffffffffff811115610f79575f5ffd5b610f8585828601610da8565b90969095509350505050565b602081525f610c8e6020830184610e97565b6020810160038310610fc357634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561061d5761061d610fc9565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561061d5761061d610fc9565b5f60208284031215611083575f5ffd5b5051919050565b5f6001820161109b5761109b610fc9565b5060010190565b5f602082840312156110b2575f5ffd5b8151610c8e81610d9456fea164736f6c634300081e000a",
+}
+
+// EthenaUnstakeHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use EthenaUnstakeHookMetaData.ABI instead.
+var EthenaUnstakeHookABI = EthenaUnstakeHookMetaData.ABI
+
+// EthenaUnstakeHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use EthenaUnstakeHookMetaData.Bin instead.
+var EthenaUnstakeHookBin = EthenaUnstakeHookMetaData.Bin
+
+// DeployEthenaUnstakeHook deploys a new Ethereum contract, binding an instance of EthenaUnstakeHook to it.
+func DeployEthenaUnstakeHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *EthenaUnstakeHook, error) {
+	parsed, err := EthenaUnstakeHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(EthenaUnstakeHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &EthenaUnstakeHook{EthenaUnstakeHookCaller: EthenaUnstakeHookCaller{contract: contract}, EthenaUnstakeHookTransactor: EthenaUnstakeHookTransactor{contract: contract}, EthenaUnstakeHookFilterer: EthenaUnstakeHookFilterer{contract: contract}}, nil
+}
+
+// EthenaUnstakeHook is an auto generated Go binding around an Ethereum contract.
+type EthenaUnstakeHook struct {
+	EthenaUnstakeHookCaller     // Read-only binding to the contract
+	EthenaUnstakeHookTransactor // Write-only binding to the contract
+	EthenaUnstakeHookFilterer   // Log filterer for contract events
+}
+
+// EthenaUnstakeHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type EthenaUnstakeHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EthenaUnstakeHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type EthenaUnstakeHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EthenaUnstakeHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type EthenaUnstakeHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// EthenaUnstakeHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type EthenaUnstakeHookSession struct {
+	Contract     *EthenaUnstakeHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// EthenaUnstakeHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type EthenaUnstakeHookCallerSession struct {
+	Contract *EthenaUnstakeHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// EthenaUnstakeHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type EthenaUnstakeHookTransactorSession struct {
+	Contract     *EthenaUnstakeHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// EthenaUnstakeHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type EthenaUnstakeHookRaw struct {
+	Contract *EthenaUnstakeHook // Generic contract binding to access the raw methods on
+}
+
+// EthenaUnstakeHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type EthenaUnstakeHookCallerRaw struct {
+	Contract *EthenaUnstakeHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// EthenaUnstakeHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type EthenaUnstakeHookTransactorRaw struct {
+	Contract *EthenaUnstakeHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewEthenaUnstakeHook creates a new instance of EthenaUnstakeHook, bound to a specific deployed contract.
+func NewEthenaUnstakeHook(address common.Address, backend bind.ContractBackend) (*EthenaUnstakeHook, error) {
+	contract, err := bindEthenaUnstakeHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaUnstakeHook{EthenaUnstakeHookCaller: EthenaUnstakeHookCaller{contract: contract}, EthenaUnstakeHookTransactor: EthenaUnstakeHookTransactor{contract: contract}, EthenaUnstakeHookFilterer: EthenaUnstakeHookFilterer{contract: contract}}, nil
+}
+
+// NewEthenaUnstakeHookCaller creates a new read-only instance of EthenaUnstakeHook, bound to a specific deployed contract.
+func NewEthenaUnstakeHookCaller(address common.Address, caller bind.ContractCaller) (*EthenaUnstakeHookCaller, error) {
+	contract, err := bindEthenaUnstakeHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaUnstakeHookCaller{contract: contract}, nil
+}
+
+// NewEthenaUnstakeHookTransactor creates a new write-only instance of EthenaUnstakeHook, bound to a specific deployed contract.
+func NewEthenaUnstakeHookTransactor(address common.Address, transactor bind.ContractTransactor) (*EthenaUnstakeHookTransactor, error) {
+	contract, err := bindEthenaUnstakeHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaUnstakeHookTransactor{contract: contract}, nil
+}
+
+// NewEthenaUnstakeHookFilterer creates a new log filterer instance of EthenaUnstakeHook, bound to a specific deployed contract.
+func NewEthenaUnstakeHookFilterer(address common.Address, filterer bind.ContractFilterer) (*EthenaUnstakeHookFilterer, error) {
+	contract, err := bindEthenaUnstakeHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &EthenaUnstakeHookFilterer{contract: contract}, nil
+}
+
+// bindEthenaUnstakeHook binds a generic wrapper to an already deployed contract.
+func bindEthenaUnstakeHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := EthenaUnstakeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EthenaUnstakeHook *EthenaUnstakeHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EthenaUnstakeHook.Contract.EthenaUnstakeHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EthenaUnstakeHook *EthenaUnstakeHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.EthenaUnstakeHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EthenaUnstakeHook *EthenaUnstakeHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.EthenaUnstakeHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _EthenaUnstakeHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) SUBTYPE() ([32]byte, error) {
+	return _EthenaUnstakeHook.Contract.SUBTYPE(&_EthenaUnstakeHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _EthenaUnstakeHook.Contract.SUBTYPE(&_EthenaUnstakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) Asset() (common.Address, error) {
+	return _EthenaUnstakeHook.Contract.Asset(&_EthenaUnstakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) Asset() (common.Address, error) {
+	return _EthenaUnstakeHook.Contract.Asset(&_EthenaUnstakeHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _EthenaUnstakeHook.Contract.Build(&_EthenaUnstakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _EthenaUnstakeHook.Contract.Build(&_EthenaUnstakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) ExecutionNonce() (*big.Int, error) {
+	return _EthenaUnstakeHook.Contract.ExecutionNonce(&_EthenaUnstakeHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _EthenaUnstakeHook.Contract.ExecutionNonce(&_EthenaUnstakeHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _EthenaUnstakeHook.Contract.GetOutAmount(&_EthenaUnstakeHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _EthenaUnstakeHook.Contract.GetOutAmount(&_EthenaUnstakeHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) HookType() (uint8, error) {
+	return _EthenaUnstakeHook.Contract.HookType(&_EthenaUnstakeHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) HookType() (uint8, error) {
+	return _EthenaUnstakeHook.Contract.HookType(&_EthenaUnstakeHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) Inspect(data []byte) ([]byte, error) {
+	return _EthenaUnstakeHook.Contract.Inspect(&_EthenaUnstakeHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _EthenaUnstakeHook.Contract.Inspect(&_EthenaUnstakeHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) LastCaller() (common.Address, error) {
+	return _EthenaUnstakeHook.Contract.LastCaller(&_EthenaUnstakeHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) LastCaller() (common.Address, error) {
+	return _EthenaUnstakeHook.Contract.LastCaller(&_EthenaUnstakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) SpToken() (common.Address, error) {
+	return _EthenaUnstakeHook.Contract.SpToken(&_EthenaUnstakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) SpToken() (common.Address, error) {
+	return _EthenaUnstakeHook.Contract.SpToken(&_EthenaUnstakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) Subtype() ([32]byte, error) {
+	return _EthenaUnstakeHook.Contract.Subtype(&_EthenaUnstakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) Subtype() ([32]byte, error) {
+	return _EthenaUnstakeHook.Contract.Subtype(&_EthenaUnstakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _EthenaUnstakeHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) UsedShares() (*big.Int, error) {
+	return _EthenaUnstakeHook.Contract.UsedShares(&_EthenaUnstakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_EthenaUnstakeHook *EthenaUnstakeHookCallerSession) UsedShares() (*big.Int, error) {
+	return _EthenaUnstakeHook.Contract.UsedShares(&_EthenaUnstakeHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.PostExecute(&_EthenaUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.PostExecute(&_EthenaUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.PreExecute(&_EthenaUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.PreExecute(&_EthenaUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.ResetExecutionState(&_EthenaUnstakeHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.ResetExecutionState(&_EthenaUnstakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.SetExecutionContext(&_EthenaUnstakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.SetExecutionContext(&_EthenaUnstakeHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.SetOutAmount(&_EthenaUnstakeHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_EthenaUnstakeHook *EthenaUnstakeHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _EthenaUnstakeHook.Contract.SetOutAmount(&_EthenaUnstakeHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package FluidStakeHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// FluidStakeHookMetaData contains all meta data concerning the FluidStakeHook contract.
+var FluidStakeHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260058152645374616b6560d81b6020909101525f805460ff191690557f46e0152631724883515adf5183d0ad68b0f3fdb4ea59b5bb258492ab0edd5ec76080526080516111216100785f395f81816101e8015261029201526111215ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c806360b5be29116100a95780638e1487761161006e5780638e1487761461027b578063cf0a61811461028d578063d06a1e89146102b4578063e445e7dd146102c7578063e7745517146102e0575f5ffd5b806360b5be291461020c578063685a943c1461022e57806381cbe6911461023657806384542a24146102495780638c4313c11461025b575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633b5896bc146101c65780635492e677146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610db6565b6102f3565b005b61014f61015f366004610e13565b61036d565b61014f610172366004610e2c565b61038e565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af366004610db6565b6103e7565b6101896001600160a01b0360025c1681565b6101d96101d4366004610db6565b61045a565b6040516101339190610e84565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b61021e60ff600160a81b60025c041681565b6040519015158152602001610133565b6101295f5c81565b610129610244366004610e13565b610673565b61021e60ff600160a01b60025c041681565b61026e610269366004610f11565b61068b565b6040516101339190610f50565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102c2366004610e13565b61070a565b5f546102d39060ff1681565b6040516101339190610f62565b61021e6102ee366004610f9c565b61075c565b336001600160a01b0384161461031c5760405163e15e56c960e01b815260040160405180910390fd5b5f61032684610768565b90506103318161077b565b1561034f5760405163945b63f560e01b815260040160405180910390fd5b61035a816001610788565b6103668585858561079e565b5050505050565b610376816107ff565b50336004805c6001600160a01b0319168217905d5050565b5f61039882610768565b90506103a381610831565b806103b257506103b28161077b565b156103d05760405163441e4c7360e11b815260040160405180910390fd5b5f6103dc82600161083a565b905083815d50505050565b336001600160a01b038416146104105760405163e15e56c960e01b815260040160405180910390fd5b5f61041a84610768565b905061042581610831565b1561044357604051630bbb04d960e11b815260040160405180910390fd5b61044e81600161088f565b6103668585858561089b565b60605f610469868686866108dd565b9050805160026104799190611063565b67ffffffffffffffff81111561049157610491610f88565b6040519080825280602002602001820160405280156104dd57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104af5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105269493929190611076565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610568576105686110be565b60209081029190910101525f5b81518110156105c957818181518110610590576105906110be565b6020026020010151838260016105a69190611063565b815181106105b6576105b66110be565b6020908102919091010152600101610575565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106109493929190611076565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161064f91906110d2565b8151811061065f5761065f6110be565b602002602001018190525050949350505050565b5f61068561068083610768565b610b29565b92915050565b60606106cb83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b3692505050565b6040516020016106f3919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61071482610768565b905061071f81610831565b1580610731575061072f8161077b565b155b1561074f57604051634bd439b560e11b815260040160405180910390fd5b61075881610b42565b5050565b5f610685826054610b59565b5f5f61077383610b85565b5c9392505050565b5f5f61077383600361083a565b5f61079483600361083a565b905081815d505050565b6107f96107aa84610673565b6107e98585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf192505050565b6107f391906110d2565b84610c6c565b50505050565b5f6003805c908261080f836110e5565b9190505d505f61081e83610b85565b905060035c80825d505060035c92915050565b5f5f6107738360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61079483600261083a565b6107f96107f38484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf192505050565b60605f61091e84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b3692505050565b90505f61095f85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c8492505050565b90505f6109a386868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610b59915050565b90506001600160a01b0383166109cc57604051630f58648f60e01b815260040160405180910390fd5b8015610a3d576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610a16573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a3a91906110fd565b91505b815f03610a5d576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610a725790505093506040518060600160405280846001600160a01b031681526020015f815260200183604051602401610ad591815260200190565b60408051601f198184030181529190526020810180516001600160e01b031663534a7e1d60e11b1790529052845185905f90610b1357610b136110be565b6020026020010181905250505050949350505050565b5f5f61077383600161083a565b5f610685826020610c90565b610b4c815f61088f565b610b56815f610788565b50565b5f828281518110610b6c57610b6c6110be565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610bd492919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610bfb82610b36565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610c41573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c6591906110fd565b9392505050565b5f610c7682610768565b90505f6103dc82600161083a565b5f610685826034610cf9565b5f610c9c826014611063565b83511015610ce95760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610d05826020611063565b83511015610d4d5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610ce0565b50016020015190565b80356001600160a01b0381168114610d6c575f5ffd5b919050565b5f5f83601f840112610d81575f5ffd5b50813567ffffffffffffffff811115610d98575f5ffd5b602083019150836020828501011115610daf575f5ffd5b9250929050565b5f5f5f5f60608587031215610dc9575f5ffd5b610dd285610d56565b9350610de060208601610d56565b9250604085013567ffffffffffffffff811115610dfb575f5ffd5b610e0787828801610d71565b95989497509550505050565b5f60208284031215610e23575f5ffd5b610c6582610d56565b5f5f60408385031215610e3d575f5ffd5b82359150610e4d60208401610d56565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610f0557868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610eef90870182610e56565b9550506020938401939190910190600101610eaa565b50929695505050505050565b5f5f60208385031215610f22575f5ffd5b823567ffffffffffffffff811115610f38575f5ffd5b610f4485828601610d71565b90969095509350505050565b602081525f610c656020830184610e56565b6020810160038310610f8257634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215610fac575f5ffd5b813567ffffffffffffffff811115610fc2575f5ffd5b8201601f81018413610fd2575f5ffd5b803567ffffffffffffffff811115610fec57610fec610f88565b604051601f8201601f19908116603f0116810167ffffffffffffffff8111828210171561101b5761101b610f88565b604052818152828201602001861015611032575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106855761068561104f565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106855761068561104f565b5f600182016110f6576110f661104f565b5060010190565b5f6020828403121561110d575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// FluidStakeHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use FluidStakeHookMetaData.ABI instead.
+var FluidStakeHookABI = FluidStakeHookMetaData.ABI
+
+// FluidStakeHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use FluidStakeHookMetaData.Bin instead.
+var FluidStakeHookBin = FluidStakeHookMetaData.Bin
+
+// DeployFluidStakeHook deploys a new Ethereum contract, binding an instance of FluidStakeHook to it.
+func DeployFluidStakeHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *FluidStakeHook, error) {
+	parsed, err := FluidStakeHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(FluidStakeHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &FluidStakeHook{FluidStakeHookCaller: FluidStakeHookCaller{contract: contract}, FluidStakeHookTransactor: FluidStakeHookTransactor{contract: contract}, FluidStakeHookFilterer: FluidStakeHookFilterer{contract: contract}}, nil
+}
+
+// FluidStakeHook is an auto generated Go binding around an Ethereum contract.
+type FluidStakeHook struct {
+	FluidStakeHookCaller     // Read-only binding to the contract
+	FluidStakeHookTransactor // Write-only binding to the contract
+	FluidStakeHookFilterer   // Log filterer for contract events
+}
+
+// FluidStakeHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FluidStakeHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FluidStakeHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FluidStakeHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FluidStakeHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FluidStakeHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FluidStakeHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FluidStakeHookSession struct {
+	Contract     *FluidStakeHook   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// FluidStakeHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FluidStakeHookCallerSession struct {
+	Contract *FluidStakeHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// FluidStakeHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FluidStakeHookTransactorSession struct {
+	Contract     *FluidStakeHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// FluidStakeHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FluidStakeHookRaw struct {
+	Contract *FluidStakeHook // Generic contract binding to access the raw methods on
+}
+
+// FluidStakeHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FluidStakeHookCallerRaw struct {
+	Contract *FluidStakeHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FluidStakeHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FluidStakeHookTransactorRaw struct {
+	Contract *FluidStakeHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFluidStakeHook creates a new instance of FluidStakeHook, bound to a specific deployed contract.
+func NewFluidStakeHook(address common.Address, backend bind.ContractBackend) (*FluidStakeHook, error) {
+	contract, err := bindFluidStakeHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidStakeHook{FluidStakeHookCaller: FluidStakeHookCaller{contract: contract}, FluidStakeHookTransactor: FluidStakeHookTransactor{contract: contract}, FluidStakeHookFilterer: FluidStakeHookFilterer{contract: contract}}, nil
+}
+
+// NewFluidStakeHookCaller creates a new read-only instance of FluidStakeHook, bound to a specific deployed contract.
+func NewFluidStakeHookCaller(address common.Address, caller bind.ContractCaller) (*FluidStakeHookCaller, error) {
+	contract, err := bindFluidStakeHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidStakeHookCaller{contract: contract}, nil
+}
+
+// NewFluidStakeHookTransactor creates a new write-only instance of FluidStakeHook, bound to a specific deployed contract.
+func NewFluidStakeHookTransactor(address common.Address, transactor bind.ContractTransactor) (*FluidStakeHookTransactor, error) {
+	contract, err := bindFluidStakeHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidStakeHookTransactor{contract: contract}, nil
+}
+
+// NewFluidStakeHookFilterer creates a new log filterer instance of FluidStakeHook, bound to a specific deployed contract.
+func NewFluidStakeHookFilterer(address common.Address, filterer bind.ContractFilterer) (*FluidStakeHookFilterer, error) {
+	contract, err := bindFluidStakeHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidStakeHookFilterer{contract: contract}, nil
+}
+
+// bindFluidStakeHook binds a generic wrapper to an already deployed contract.
+func bindFluidStakeHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := FluidStakeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FluidStakeHook *FluidStakeHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FluidStakeHook.Contract.FluidStakeHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FluidStakeHook *FluidStakeHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.FluidStakeHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FluidStakeHook *FluidStakeHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.FluidStakeHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FluidStakeHook *FluidStakeHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FluidStakeHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FluidStakeHook *FluidStakeHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FluidStakeHook *FluidStakeHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_FluidStakeHook *FluidStakeHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_FluidStakeHook *FluidStakeHookSession) Asset() (common.Address, error) {
+	return _FluidStakeHook.Contract.Asset(&_FluidStakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_FluidStakeHook *FluidStakeHookCallerSession) Asset() (common.Address, error) {
+	return _FluidStakeHook.Contract.Asset(&_FluidStakeHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_FluidStakeHook *FluidStakeHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_FluidStakeHook *FluidStakeHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _FluidStakeHook.Contract.Build(&_FluidStakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_FluidStakeHook *FluidStakeHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _FluidStakeHook.Contract.Build(&_FluidStakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_FluidStakeHook *FluidStakeHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_FluidStakeHook *FluidStakeHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _FluidStakeHook.Contract.DecodeUsePrevHookAmount(&_FluidStakeHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_FluidStakeHook *FluidStakeHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _FluidStakeHook.Contract.DecodeUsePrevHookAmount(&_FluidStakeHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookSession) ExecutionNonce() (*big.Int, error) {
+	return _FluidStakeHook.Contract.ExecutionNonce(&_FluidStakeHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _FluidStakeHook.Contract.ExecutionNonce(&_FluidStakeHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _FluidStakeHook.Contract.GetOutAmount(&_FluidStakeHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _FluidStakeHook.Contract.GetOutAmount(&_FluidStakeHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_FluidStakeHook *FluidStakeHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_FluidStakeHook *FluidStakeHookSession) HookType() (uint8, error) {
+	return _FluidStakeHook.Contract.HookType(&_FluidStakeHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_FluidStakeHook *FluidStakeHookCallerSession) HookType() (uint8, error) {
+	return _FluidStakeHook.Contract.HookType(&_FluidStakeHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_FluidStakeHook *FluidStakeHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_FluidStakeHook *FluidStakeHookSession) Inspect(data []byte) ([]byte, error) {
+	return _FluidStakeHook.Contract.Inspect(&_FluidStakeHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_FluidStakeHook *FluidStakeHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _FluidStakeHook.Contract.Inspect(&_FluidStakeHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_FluidStakeHook *FluidStakeHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_FluidStakeHook *FluidStakeHookSession) LastCaller() (common.Address, error) {
+	return _FluidStakeHook.Contract.LastCaller(&_FluidStakeHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_FluidStakeHook *FluidStakeHookCallerSession) LastCaller() (common.Address, error) {
+	return _FluidStakeHook.Contract.LastCaller(&_FluidStakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_FluidStakeHook *FluidStakeHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_FluidStakeHook *FluidStakeHookSession) PostExecuteMutex() (bool, error) {
+	return _FluidStakeHook.Contract.PostExecuteMutex(&_FluidStakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_FluidStakeHook *FluidStakeHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _FluidStakeHook.Contract.PostExecuteMutex(&_FluidStakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_FluidStakeHook *FluidStakeHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_FluidStakeHook *FluidStakeHookSession) PreExecuteMutex() (bool, error) {
+	return _FluidStakeHook.Contract.PreExecuteMutex(&_FluidStakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_FluidStakeHook *FluidStakeHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _FluidStakeHook.Contract.PreExecuteMutex(&_FluidStakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_FluidStakeHook *FluidStakeHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_FluidStakeHook *FluidStakeHookSession) SpToken() (common.Address, error) {
+	return _FluidStakeHook.Contract.SpToken(&_FluidStakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_FluidStakeHook *FluidStakeHookCallerSession) SpToken() (common.Address, error) {
+	return _FluidStakeHook.Contract.SpToken(&_FluidStakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_FluidStakeHook *FluidStakeHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_FluidStakeHook *FluidStakeHookSession) SubType() ([32]byte, error) {
+	return _FluidStakeHook.Contract.SubType(&_FluidStakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_FluidStakeHook *FluidStakeHookCallerSession) SubType() ([32]byte, error) {
+	return _FluidStakeHook.Contract.SubType(&_FluidStakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_FluidStakeHook *FluidStakeHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_FluidStakeHook *FluidStakeHookSession) Subtype() ([32]byte, error) {
+	return _FluidStakeHook.Contract.Subtype(&_FluidStakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_FluidStakeHook *FluidStakeHookCallerSession) Subtype() ([32]byte, error) {
+	return _FluidStakeHook.Contract.Subtype(&_FluidStakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FluidStakeHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookSession) UsedShares() (*big.Int, error) {
+	return _FluidStakeHook.Contract.UsedShares(&_FluidStakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_FluidStakeHook *FluidStakeHookCallerSession) UsedShares() (*big.Int, error) {
+	return _FluidStakeHook.Contract.UsedShares(&_FluidStakeHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_FluidStakeHook *FluidStakeHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidStakeHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_FluidStakeHook *FluidStakeHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.PostExecute(&_FluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_FluidStakeHook *FluidStakeHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.PostExecute(&_FluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_FluidStakeHook *FluidStakeHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidStakeHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_FluidStakeHook *FluidStakeHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.PreExecute(&_FluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_FluidStakeHook *FluidStakeHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.PreExecute(&_FluidStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_FluidStakeHook *FluidStakeHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_FluidStakeHook *FluidStakeHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.ResetExecutionState(&_FluidStakeHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_FluidStakeHook *FluidStakeHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.ResetExecutionState(&_FluidStakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_FluidStakeHook *FluidStakeHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_FluidStakeHook *FluidStakeHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.SetExecutionContext(&_FluidStakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_FluidStakeHook *FluidStakeHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.SetExecutionContext(&_FluidStakeHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_FluidStakeHook *FluidStakeHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_FluidStakeHook *FluidStakeHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.SetOutAmount(&_FluidStakeHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_FluidStakeHook *FluidStakeHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _FluidStakeHook.Contract.SetOutAmount(&_FluidStakeHook.TransactOpts, _outAmount, caller)
+}
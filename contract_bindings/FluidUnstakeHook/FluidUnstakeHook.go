@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package FluidUnstakeHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// FluidUnstakeHookMetaData contains all meta data concerning the FluidUnstakeHook contract.
+var FluidUnstakeHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604080518082019091526007815266556e7374616b6560c81b6020909101525f805460ff191690557ff0a03d7ca84198f4fdc5c655bb99909990e63c4795273b4a9c54893dd062220c6080526080516111f261007a5f395f81816101e8015261029201526111f25ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c806360b5be29116100a95780638e1487761161006e5780638e1487761461027b578063cf0a61811461028d578063d06a1e89146102b4578063e445e7dd146102c7578063e7745517146102e0575f5ffd5b806360b5be291461020c578063685a943c1461022e57806381cbe6911461023657806384542a24146102495780638c4313c11461025b575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633b5896bc146101c65780635492e677146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610e62565b6102f3565b005b61014f61015f366004610ec3565b61036d565b61014f610172366004610ede565b61038e565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af366004610e62565b6103e7565b6101896001600160a01b0360025c1681565b6101d96101d4366004610e62565b61045a565b6040516101339190610f3a565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b61021e60ff600160a81b60025c041681565b6040519015158152602001610133565b6101295f5c81565b610129610244366004610ec3565b610673565b61021e60ff600160a01b60025c041681565b61026e610269366004610fc7565b61068b565b6040516101339190611006565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102c2366004610ec3565b61070a565b5f546102d39060ff1681565b6040516101339190611018565b61021e6102ee366004611052565b61075c565b336001600160a01b0384161461031c5760405163e15e56c960e01b815260040160405180910390fd5b5f61032684610768565b90506103318161077b565b1561034f5760405163945b63f560e01b815260040160405180910390fd5b61035a816001610788565b6103668585858561079e565b5050505050565b610376816107ff565b50336004805c6001600160a01b0319168217905d5050565b5f61039882610768565b90506103a381610831565b806103b257506103b28161077b565b156103d05760405163441e4c7360e11b815260040160405180910390fd5b5f6103dc82600161083a565b905083815d50505050565b336001600160a01b038416146104105760405163e15e56c960e01b815260040160405180910390fd5b5f61041a84610768565b905061042581610831565b1561044357604051630bbb04d960e11b815260040160405180910390fd5b61044e81600161088f565b6103668585858561089b565b60605f61046986868686610996565b9050805160026104799190611119565b67ffffffffffffffff8111156104915761049161103e565b6040519080825280602002602001820160405280156104dd57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104af5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d89898989604051602401610526949392919061112c565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061056857610568611174565b60209081029190910101525f5b81518110156105c95781818151811061059057610590611174565b6020026020010151838260016105a69190611119565b815181106105b6576105b6611174565b6020908102919091010152600101610575565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe9189898989604051602401610610949392919061112c565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161064f9190611188565b8151811061065f5761065f611174565b602002602001018190525050949350505050565b5f61068561068083610768565b610be2565b92915050565b60606106cb83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bef92505050565b6040516020016106f3919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61071482610768565b905061071f81610831565b1580610731575061072f8161077b565b155b1561074f57604051634bd439b560e11b815260040160405180910390fd5b61075881610bfb565b5050565b5f610685826054610c12565b5f5f61077383610c3e565b5c9392505050565b5f5f61077383600361083a565b5f61079483600361083a565b905081815d505050565b6107f96107aa84610673565b6107e98585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610caa92505050565b6107f39190611188565b84610d1f565b50505050565b5f6003805c908261080f8361119b565b9190505d505f61081e83610c3e565b905060035c80825d505060035c92915050565b5f5f6107738360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61079483600261083a565b6108d982828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bef92505050565b6001600160a01b03166372f702f36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610914573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061093891906111b3565b6002805c6001600160a01b0319166001600160a01b03831617905d506107f96107f38484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610caa92505050565b60605f6109d784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bef92505050565b90505f610a1885858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610d3792505050565b90505f610a5c86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610c12915050565b90506001600160a01b038316610a8557604051630f58648f60e01b815260040160405180910390fd5b8015610af6576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610acf573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610af391906111ce565b91505b815f03610b16576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b2b5790505093506040518060600160405280846001600160a01b031681526020015f815260200183604051602401610b8e91815260200190565b60408051601f198184030181529190526020810180516001600160e01b0316632e1a7d4d60e01b1790529052845185905f90610bcc57610bcc611174565b6020026020010181905250505050949350505050565b5f5f61077383600161083a565b5f610685826020610d43565b610c05815f61088f565b610c0f815f610788565b50565b5f828281518110610c2557610c25611174565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610c8d92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610cf4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d1891906111ce565b9392505050565b5f610d2982610768565b90505f6103dc82600161083a565b5f610685826034610dac565b5f610d4f826014611119565b83511015610d9c5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610db8826020611119565b83511015610e005760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610d93565b50016020015190565b6001600160a01b0381168114610c0f575f5ffd5b5f5f83601f840112610e2d575f5ffd5b50813567ffffffffffffffff811115610e44575f5ffd5b602083019150836020828501011115610e5b575f5ffd5b9250929050565b5f5f5f5f60608587031215610e75575f5ffd5b8435610e8081610e09565b93506020850135610e9081610e09565b9250604085013567ffffffffffffffff811115610eab575f5ffd5b610eb787828801610e1d565b95989497509550505050565b5f60208284031215610ed3575f5ffd5b8135610d1881610e09565b5f5f60408385031215610eef575f5ffd5b823591506020830135610f0181610e09565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610fbb57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610fa590870182610f0c565b9550506020938401939190910190600101610f60565b50929695505050505050565b5f5f60208385031215610fd8575f5ffd5b823567ffffffffffffffff811115610fee575f5ffd5b610ffa85828601610e1d565b90969095509350505050565b602081525f610d186020830184610f0c565b602081016003831061103857634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f60208284031215611062575f5ffd5b813567ffffffffffffffff811115611078575f5ffd5b8201601f81018413611088575f5ffd5b803567ffffffffffffffff8111156110a2576110a261103e565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156110d1576110d161103e565b6040528181528282016020018610156110e8575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561068557610685611105565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561068557610685611105565b5f600182016111ac576111ac611105565b5060010190565b5f602082840312156111c3575f5ffd5b8151610d1881610e09565b5f602082840312156111de575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// FluidUnstakeHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use FluidUnstakeHookMetaData.ABI instead.
+var FluidUnstakeHookABI = FluidUnstakeHookMetaData.ABI
+
+// FluidUnstakeHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use FluidUnstakeHookMetaData.Bin instead.
+var FluidUnstakeHookBin = FluidUnstakeHookMetaData.Bin
+
+// DeployFluidUnstakeHook deploys a new Ethereum contract, binding an instance of FluidUnstakeHook to it.
+func DeployFluidUnstakeHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *FluidUnstakeHook, error) {
+	parsed, err := FluidUnstakeHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(FluidUnstakeHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &FluidUnstakeHook{FluidUnstakeHookCaller: FluidUnstakeHookCaller{contract: contract}, FluidUnstakeHookTransactor: FluidUnstakeHookTransactor{contract: contract}, FluidUnstakeHookFilterer: FluidUnstakeHookFilterer{contract: contract}}, nil
+}
+
+// FluidUnstakeHook is an auto generated Go binding around an Ethereum contract.
+type FluidUnstakeHook struct {
+	FluidUnstakeHookCaller     // Read-only binding to the contract
+	FluidUnstakeHookTransactor // Write-only binding to the contract
+	FluidUnstakeHookFilterer   // Log filterer for contract events
+}
+
+// FluidUnstakeHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type FluidUnstakeHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FluidUnstakeHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type FluidUnstakeHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FluidUnstakeHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type FluidUnstakeHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// FluidUnstakeHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type FluidUnstakeHookSession struct {
+	Contract     *FluidUnstakeHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// FluidUnstakeHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type FluidUnstakeHookCallerSession struct {
+	Contract *FluidUnstakeHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// FluidUnstakeHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type FluidUnstakeHookTransactorSession struct {
+	Contract     *FluidUnstakeHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// FluidUnstakeHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type FluidUnstakeHookRaw struct {
+	Contract *FluidUnstakeHook // Generic contract binding to access the raw methods on
+}
+
+// FluidUnstakeHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type FluidUnstakeHookCallerRaw struct {
+	Contract *FluidUnstakeHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// FluidUnstakeHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type FluidUnstakeHookTransactorRaw struct {
+	Contract *FluidUnstakeHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewFluidUnstakeHook creates a new instance of FluidUnstakeHook, bound to a specific deployed contract.
+func NewFluidUnstakeHook(address common.Address, backend bind.ContractBackend) (*FluidUnstakeHook, error) {
+	contract, err := bindFluidUnstakeHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidUnstakeHook{FluidUnstakeHookCaller: FluidUnstakeHookCaller{contract: contract}, FluidUnstakeHookTransactor: FluidUnstakeHookTransactor{contract: contract}, FluidUnstakeHookFilterer: FluidUnstakeHookFilterer{contract: contract}}, nil
+}
+
+// NewFluidUnstakeHookCaller creates a new read-only instance of FluidUnstakeHook, bound to a specific deployed contract.
+func NewFluidUnstakeHookCaller(address common.Address, caller bind.ContractCaller) (*FluidUnstakeHookCaller, error) {
+	contract, err := bindFluidUnstakeHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidUnstakeHookCaller{contract: contract}, nil
+}
+
+// NewFluidUnstakeHookTransactor creates a new write-only instance of FluidUnstakeHook, bound to a specific deployed contract.
+func NewFluidUnstakeHookTransactor(address common.Address, transactor bind.ContractTransactor) (*FluidUnstakeHookTransactor, error) {
+	contract, err := bindFluidUnstakeHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidUnstakeHookTransactor{contract: contract}, nil
+}
+
+// NewFluidUnstakeHookFilterer creates a new log filterer instance of FluidUnstakeHook, bound to a specific deployed contract.
+func NewFluidUnstakeHookFilterer(address common.Address, filterer bind.ContractFilterer) (*FluidUnstakeHookFilterer, error) {
+	contract, err := bindFluidUnstakeHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &FluidUnstakeHookFilterer{contract: contract}, nil
+}
+
+// bindFluidUnstakeHook binds a generic wrapper to an already deployed contract.
+func bindFluidUnstakeHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := FluidUnstakeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FluidUnstakeHook *FluidUnstakeHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FluidUnstakeHook.Contract.FluidUnstakeHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FluidUnstakeHook *FluidUnstakeHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.FluidUnstakeHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FluidUnstakeHook *FluidUnstakeHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.FluidUnstakeHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_FluidUnstakeHook *FluidUnstakeHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _FluidUnstakeHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) Asset() (common.Address, error) {
+	return _FluidUnstakeHook.Contract.Asset(&_FluidUnstakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) Asset() (common.Address, error) {
+	return _FluidUnstakeHook.Contract.Asset(&_FluidUnstakeHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _FluidUnstakeHook.Contract.Build(&_FluidUnstakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _FluidUnstakeHook.Contract.Build(&_FluidUnstakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _FluidUnstakeHook.Contract.DecodeUsePrevHookAmount(&_FluidUnstakeHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _FluidUnstakeHook.Contract.DecodeUsePrevHookAmount(&_FluidUnstakeHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) ExecutionNonce() (*big.Int, error) {
+	return _FluidUnstakeHook.Contract.ExecutionNonce(&_FluidUnstakeHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _FluidUnstakeHook.Contract.ExecutionNonce(&_FluidUnstakeHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _FluidUnstakeHook.Contract.GetOutAmount(&_FluidUnstakeHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _FluidUnstakeHook.Contract.GetOutAmount(&_FluidUnstakeHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) HookType() (uint8, error) {
+	return _FluidUnstakeHook.Contract.HookType(&_FluidUnstakeHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) HookType() (uint8, error) {
+	return _FluidUnstakeHook.Contract.HookType(&_FluidUnstakeHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) Inspect(data []byte) ([]byte, error) {
+	return _FluidUnstakeHook.Contract.Inspect(&_FluidUnstakeHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _FluidUnstakeHook.Contract.Inspect(&_FluidUnstakeHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) LastCaller() (common.Address, error) {
+	return _FluidUnstakeHook.Contract.LastCaller(&_FluidUnstakeHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) LastCaller() (common.Address, error) {
+	return _FluidUnstakeHook.Contract.LastCaller(&_FluidUnstakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) PostExecuteMutex() (bool, error) {
+	return _FluidUnstakeHook.Contract.PostExecuteMutex(&_FluidUnstakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _FluidUnstakeHook.Contract.PostExecuteMutex(&_FluidUnstakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) PreExecuteMutex() (bool, error) {
+	return _FluidUnstakeHook.Contract.PreExecuteMutex(&_FluidUnstakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _FluidUnstakeHook.Contract.PreExecuteMutex(&_FluidUnstakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) SpToken() (common.Address, error) {
+	return _FluidUnstakeHook.Contract.SpToken(&_FluidUnstakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) SpToken() (common.Address, error) {
+	return _FluidUnstakeHook.Contract.SpToken(&_FluidUnstakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) SubType() ([32]byte, error) {
+	return _FluidUnstakeHook.Contract.SubType(&_FluidUnstakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) SubType() ([32]byte, error) {
+	return _FluidUnstakeHook.Contract.SubType(&_FluidUnstakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) Subtype() ([32]byte, error) {
+	return _FluidUnstakeHook.Contract.Subtype(&_FluidUnstakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) Subtype() ([32]byte, error) {
+	return _FluidUnstakeHook.Contract.Subtype(&_FluidUnstakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _FluidUnstakeHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookSession) UsedShares() (*big.Int, error) {
+	return _FluidUnstakeHook.Contract.UsedShares(&_FluidUnstakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_FluidUnstakeHook *FluidUnstakeHookCallerSession) UsedShares() (*big.Int, error) {
+	return _FluidUnstakeHook.Contract.UsedShares(&_FluidUnstakeHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidUnstakeHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.PostExecute(&_FluidUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.PostExecute(&_FluidUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidUnstakeHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.PreExecute(&_FluidUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.PreExecute(&_FluidUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.ResetExecutionState(&_FluidUnstakeHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.ResetExecutionState(&_FluidUnstakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.SetExecutionContext(&_FluidUnstakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.SetExecutionContext(&_FluidUnstakeHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.SetOutAmount(&_FluidUnstakeHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_FluidUnstakeHook *FluidUnstakeHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _FluidUnstakeHook.Contract.SetOutAmount(&_FluidUnstakeHook.TransactOpts, _outAmount, caller)
+}
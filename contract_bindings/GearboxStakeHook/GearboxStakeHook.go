@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package GearboxStakeHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// GearboxStakeHookMetaData contains all meta data concerning the GearboxStakeHook contract.
+var GearboxStakeHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260058152645374616b6560d81b6020909101525f805460ff191690557f46e0152631724883515adf5183d0ad68b0f3fdb4ea59b5bb258492ab0edd5ec76080526080516112316100785f395f81816101e8015261029201526112315ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c806360b5be29116100a95780638e1487761161006e5780638e1487761461027b578063cf0a61811461028d578063d06a1e89146102b4578063e445e7dd146102c7578063e7745517146102e0575f5ffd5b806360b5be291461020c578063685a943c1461022e57806381cbe6911461023657806384542a24146102495780638c4313c11461025b575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633b5896bc146101c65780635492e677146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610ec6565b6102f3565b005b61014f61015f366004610f23565b61036d565b61014f610172366004610f3c565b61038e565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af366004610ec6565b6103e7565b6101896001600160a01b0360025c1681565b6101d96101d4366004610ec6565b61045a565b6040516101339190610f94565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b61021e60ff600160a81b60025c041681565b6040519015158152602001610133565b6101295f5c81565b610129610244366004610f23565b610673565b61021e60ff600160a01b60025c041681565b61026e610269366004611021565b61068b565b6040516101339190611060565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102c2366004610f23565b61070a565b5f546102d39060ff1681565b6040516101339190611072565b61021e6102ee3660046110ac565b61075c565b336001600160a01b0384161461031c5760405163e15e56c960e01b815260040160405180910390fd5b5f61032684610768565b90506103318161077b565b1561034f5760405163945b63f560e01b815260040160405180910390fd5b61035a816001610788565b6103668585858561079e565b5050505050565b610376816107ff565b50336004805c6001600160a01b0319168217905d5050565b5f61039882610768565b90506103a381610831565b806103b257506103b28161077b565b156103d05760405163441e4c7360e11b815260040160405180910390fd5b5f6103dc82600161083a565b905083815d50505050565b336001600160a01b038416146104105760405163e15e56c960e01b815260040160405180910390fd5b5f61041a84610768565b905061042581610831565b1561044357604051630bbb04d960e11b815260040160405180910390fd5b61044e81600161088f565b6103668585858561089b565b60605f610469868686866108dd565b9050805160026104799190611173565b67ffffffffffffffff81111561049157610491611098565b6040519080825280602002602001820160405280156104dd57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104af5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105269493929190611186565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610568576105686111ce565b60209081029190910101525f5b81518110156105c957818181518110610590576105906111ce565b6020026020010151838260016105a69190611173565b815181106105b6576105b66111ce565b6020908102919091010152600101610575565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106109493929190611186565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161064f91906111e2565b8151811061065f5761065f6111ce565b602002602001018190525050949350505050565b5f61068561068083610768565b610b29565b92915050565b60606106cb83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b3692505050565b6040516020016106f3919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61071482610768565b905061071f81610831565b1580610731575061072f8161077b565b155b1561074f57604051634bd439b560e11b815260040160405180910390fd5b61075881610b42565b5050565b5f610685826054610b59565b5f5f61077383610b85565b5c9392505050565b5f5f61077383600361083a565b5f61079483600361083a565b905081815d505050565b6107f96107aa84610673565b6107e98585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf192505050565b6107f391906111e2565b84610c6c565b50505050565b5f6003805c908261080f836111f5565b9190505d505f61081e83610b85565b905060035c80825d505060035c92915050565b5f5f6107738360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61079483600261083a565b6107f96107f38484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf192505050565b60605f61091e84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b3692505050565b90505f61095f85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c8492505050565b90505f6109a386868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610b59915050565b90506001600160a01b0383166109cc57604051630f58648f60e01b815260040160405180910390fd5b8015610a3d576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610a16573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a3a919061120d565b91505b815f03610a5d576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610a725790505093506040518060600160405280846001600160a01b031681526020015f815260200183604051602401610ad591815260200190565b60408051601f198184030181529190526020810180516001600160e01b031663b6b55f2560e01b1790529052845185905f90610b1357610b136111ce565b6020026020010181905250505050949350505050565b5f5f61077383600161083a565b5f610685826020610c9b565b610b4c815f61088f565b610b56815f610788565b50565b5f828281518110610b6c57610b6c6111ce565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610bd492919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610bfb82610b36565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610c41573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c65919061120d565b9392505050565b5f610c7682610768565b90505f6103dc82600161083a565b5f610685610c958360346020610d04565b5f610e09565b5f610ca7826014611173565b83511015610cf45760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b60608182601f011015610d4a5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610ceb565b610d548284611173565b84511015610d985760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610ceb565b606082158015610db65760405191505f825260208201604052610e00565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610def578051835260209283019201610dd7565b5050858452601f01601f1916604052505b50949350505050565b5f610e15826020611173565b83511015610e5d5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610ceb565b50016020015190565b80356001600160a01b0381168114610e7c575f5ffd5b919050565b5f5f83601f840112610e91575f5ffd5b50813567ffffffffffffffff811115610ea8575f5ffd5b602083019150836020828501011115610ebf575f5ffd5b9250929050565b5f5f5f5f60608587031215610ed9575f5ffd5b610ee285610e66565b9350610ef060208601610e66565b9250604085013567ffffffffffffffff811115610f0b575f5ffd5b610f1787828801610e81565b95989497509550505050565b5f60208284031215610f33575f5ffd5b610c6582610e66565b5f5f60408385031215610f4d575f5ffd5b82359150610f5d60208401610e66565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561101557868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610fff90870182610f66565b9550506020938401939190910190600101610fba565b50929695505050505050565b5f5f60208385031215611032575f5ffd5b823567ffffffffffffffff811115611048575f5ffd5b61105485828601610e81565b90969095509350505050565b602081525f610c656020830184610f66565b602081016003831061109257634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f602082840312156110bc575f5ffd5b813567ffffffffffffffff8111156110d2575f5ffd5b8201601f810184136110e2575f5ffd5b803567ffffffffffffffff8111156110fc576110fc611098565b604051601f8201601f19908116603f0116810167ffffffffffffffff8111828210171561112b5761112b611098565b604052818152828201602001861015611142575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106855761068561115f565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106855761068561115f565b5f600182016112065761120661115f565b5060010190565b5f6020828403121561121d575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// GearboxStakeHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use GearboxStakeHookMetaData.ABI instead.
+var GearboxStakeHookABI = GearboxStakeHookMetaData.ABI
+
+// GearboxStakeHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use GearboxStakeHookMetaData.Bin instead.
+var GearboxStakeHookBin = GearboxStakeHookMetaData.Bin
+
+// DeployGearboxStakeHook deploys a new Ethereum contract, binding an instance of GearboxStakeHook to it.
+func DeployGearboxStakeHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *GearboxStakeHook, error) {
+	parsed, err := GearboxStakeHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GearboxStakeHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &GearboxStakeHook{GearboxStakeHookCaller: GearboxStakeHookCaller{contract: contract}, GearboxStakeHookTransactor: GearboxStakeHookTransactor{contract: contract}, GearboxStakeHookFilterer: GearboxStakeHookFilterer{contract: contract}}, nil
+}
+
+// GearboxStakeHook is an auto generated Go binding around an Ethereum contract.
+type GearboxStakeHook struct {
+	GearboxStakeHookCaller     // Read-only binding to the contract
+	GearboxStakeHookTransactor // Write-only binding to the contract
+	GearboxStakeHookFilterer   // Log filterer for contract events
+}
+
+// GearboxStakeHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GearboxStakeHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GearboxStakeHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GearboxStakeHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GearboxStakeHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GearboxStakeHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GearboxStakeHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GearboxStakeHookSession struct {
+	Contract     *GearboxStakeHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// GearboxStakeHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GearboxStakeHookCallerSession struct {
+	Contract *GearboxStakeHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// GearboxStakeHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GearboxStakeHookTransactorSession struct {
+	Contract     *GearboxStakeHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// GearboxStakeHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GearboxStakeHookRaw struct {
+	Contract *GearboxStakeHook // Generic contract binding to access the raw methods on
+}
+
+// GearboxStakeHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GearboxStakeHookCallerRaw struct {
+	Contract *GearboxStakeHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GearboxStakeHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GearboxStakeHookTransactorRaw struct {
+	Contract *GearboxStakeHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGearboxStakeHook creates a new instance of GearboxStakeHook, bound to a specific deployed contract.
+func NewGearboxStakeHook(address common.Address, backend bind.ContractBackend) (*GearboxStakeHook, error) {
+	contract, err := bindGearboxStakeHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxStakeHook{GearboxStakeHookCaller: GearboxStakeHookCaller{contract: contract}, GearboxStakeHookTransactor: GearboxStakeHookTransactor{contract: contract}, GearboxStakeHookFilterer: GearboxStakeHookFilterer{contract: contract}}, nil
+}
+
+// NewGearboxStakeHookCaller creates a new read-only instance of GearboxStakeHook, bound to a specific deployed contract.
+func NewGearboxStakeHookCaller(address common.Address, caller bind.ContractCaller) (*GearboxStakeHookCaller, error) {
+	contract, err := bindGearboxStakeHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxStakeHookCaller{contract: contract}, nil
+}
+
+// NewGearboxStakeHookTransactor creates a new write-only instance of GearboxStakeHook, bound to a specific deployed contract.
+func NewGearboxStakeHookTransactor(address common.Address, transactor bind.ContractTransactor) (*GearboxStakeHookTransactor, error) {
+	contract, err := bindGearboxStakeHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxStakeHookTransactor{contract: contract}, nil
+}
+
+// NewGearboxStakeHookFilterer creates a new log filterer instance of GearboxStakeHook, bound to a specific deployed contract.
+func NewGearboxStakeHookFilterer(address common.Address, filterer bind.ContractFilterer) (*GearboxStakeHookFilterer, error) {
+	contract, err := bindGearboxStakeHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxStakeHookFilterer{contract: contract}, nil
+}
+
+// bindGearboxStakeHook binds a generic wrapper to an already deployed contract.
+func bindGearboxStakeHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := GearboxStakeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GearboxStakeHook *GearboxStakeHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GearboxStakeHook.Contract.GearboxStakeHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GearboxStakeHook *GearboxStakeHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.GearboxStakeHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GearboxStakeHook *GearboxStakeHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.GearboxStakeHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GearboxStakeHook *GearboxStakeHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GearboxStakeHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GearboxStakeHook *GearboxStakeHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GearboxStakeHook *GearboxStakeHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookSession) Asset() (common.Address, error) {
+	return _GearboxStakeHook.Contract.Asset(&_GearboxStakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) Asset() (common.Address, error) {
+	return _GearboxStakeHook.Contract.Asset(&_GearboxStakeHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_GearboxStakeHook *GearboxStakeHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_GearboxStakeHook *GearboxStakeHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _GearboxStakeHook.Contract.Build(&_GearboxStakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _GearboxStakeHook.Contract.Build(&_GearboxStakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _GearboxStakeHook.Contract.DecodeUsePrevHookAmount(&_GearboxStakeHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _GearboxStakeHook.Contract.DecodeUsePrevHookAmount(&_GearboxStakeHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookSession) ExecutionNonce() (*big.Int, error) {
+	return _GearboxStakeHook.Contract.ExecutionNonce(&_GearboxStakeHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _GearboxStakeHook.Contract.ExecutionNonce(&_GearboxStakeHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _GearboxStakeHook.Contract.GetOutAmount(&_GearboxStakeHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _GearboxStakeHook.Contract.GetOutAmount(&_GearboxStakeHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_GearboxStakeHook *GearboxStakeHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_GearboxStakeHook *GearboxStakeHookSession) HookType() (uint8, error) {
+	return _GearboxStakeHook.Contract.HookType(&_GearboxStakeHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) HookType() (uint8, error) {
+	return _GearboxStakeHook.Contract.HookType(&_GearboxStakeHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_GearboxStakeHook *GearboxStakeHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_GearboxStakeHook *GearboxStakeHookSession) Inspect(data []byte) ([]byte, error) {
+	return _GearboxStakeHook.Contract.Inspect(&_GearboxStakeHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _GearboxStakeHook.Contract.Inspect(&_GearboxStakeHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookSession) LastCaller() (common.Address, error) {
+	return _GearboxStakeHook.Contract.LastCaller(&_GearboxStakeHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) LastCaller() (common.Address, error) {
+	return _GearboxStakeHook.Contract.LastCaller(&_GearboxStakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookSession) PostExecuteMutex() (bool, error) {
+	return _GearboxStakeHook.Contract.PostExecuteMutex(&_GearboxStakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _GearboxStakeHook.Contract.PostExecuteMutex(&_GearboxStakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookSession) PreExecuteMutex() (bool, error) {
+	return _GearboxStakeHook.Contract.PreExecuteMutex(&_GearboxStakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _GearboxStakeHook.Contract.PreExecuteMutex(&_GearboxStakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookSession) SpToken() (common.Address, error) {
+	return _GearboxStakeHook.Contract.SpToken(&_GearboxStakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) SpToken() (common.Address, error) {
+	return _GearboxStakeHook.Contract.SpToken(&_GearboxStakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_GearboxStakeHook *GearboxStakeHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_GearboxStakeHook *GearboxStakeHookSession) SubType() ([32]byte, error) {
+	return _GearboxStakeHook.Contract.SubType(&_GearboxStakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) SubType() ([32]byte, error) {
+	return _GearboxStakeHook.Contract.SubType(&_GearboxStakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_GearboxStakeHook *GearboxStakeHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_GearboxStakeHook *GearboxStakeHookSession) Subtype() ([32]byte, error) {
+	return _GearboxStakeHook.Contract.Subtype(&_GearboxStakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) Subtype() ([32]byte, error) {
+	return _GearboxStakeHook.Contract.Subtype(&_GearboxStakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GearboxStakeHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookSession) UsedShares() (*big.Int, error) {
+	return _GearboxStakeHook.Contract.UsedShares(&_GearboxStakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_GearboxStakeHook *GearboxStakeHookCallerSession) UsedShares() (*big.Int, error) {
+	return _GearboxStakeHook.Contract.UsedShares(&_GearboxStakeHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxStakeHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxStakeHook *GearboxStakeHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.PostExecute(&_GearboxStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.PostExecute(&_GearboxStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxStakeHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxStakeHook *GearboxStakeHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.PreExecute(&_GearboxStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.PreExecute(&_GearboxStakeHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.ResetExecutionState(&_GearboxStakeHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.ResetExecutionState(&_GearboxStakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.SetExecutionContext(&_GearboxStakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.SetExecutionContext(&_GearboxStakeHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.SetOutAmount(&_GearboxStakeHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_GearboxStakeHook *GearboxStakeHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _GearboxStakeHook.Contract.SetOutAmount(&_GearboxStakeHook.TransactOpts, _outAmount, caller)
+}
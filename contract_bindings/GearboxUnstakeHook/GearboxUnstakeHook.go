@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package GearboxUnstakeHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// GearboxUnstakeHookMetaData contains all meta data concerning the GearboxUnstakeHook contract.
+var GearboxUnstakeHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604080518082019091526007815266556e7374616b6560c81b6020909101525f805460ff191690557ff0a03d7ca84198f4fdc5c655bb99909990e63c4795273b4a9c54893dd062220c60805260805161130c61007a5f395f81816101e80152610292015261130c5ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c806360b5be29116100a95780638e1487761161006e5780638e1487761461027b578063cf0a61811461028d578063d06a1e89146102b4578063e445e7dd146102c7578063e7745517146102e0575f5ffd5b806360b5be291461020c578063685a943c1461022e57806381cbe6911461023657806384542a24146102495780638c4313c11461025b575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633b5896bc146101c65780635492e677146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610f7c565b6102f3565b005b61014f61015f366004610fdd565b61036d565b61014f610172366004610ff8565b61038e565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af366004610f7c565b6103e7565b6101896001600160a01b0360025c1681565b6101d96101d4366004610f7c565b61045a565b6040516101339190611054565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b61021e60ff600160a81b60025c041681565b6040519015158152602001610133565b6101295f5c81565b610129610244366004610fdd565b610673565b61021e60ff600160a01b60025c041681565b61026e6102693660046110e1565b61068b565b6040516101339190611120565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102c2366004610fdd565b61070a565b5f546102d39060ff1681565b6040516101339190611132565b61021e6102ee36600461116c565b61075c565b336001600160a01b0384161461031c5760405163e15e56c960e01b815260040160405180910390fd5b5f61032684610768565b90506103318161077b565b1561034f5760405163945b63f560e01b815260040160405180910390fd5b61035a816001610788565b6103668585858561079e565b5050505050565b610376816107ff565b50336004805c6001600160a01b0319168217905d5050565b5f61039882610768565b90506103a381610831565b806103b257506103b28161077b565b156103d05760405163441e4c7360e11b815260040160405180910390fd5b5f6103dc82600161083a565b905083815d50505050565b336001600160a01b038416146104105760405163e15e56c960e01b815260040160405180910390fd5b5f61041a84610768565b905061042581610831565b1561044357604051630bbb04d960e11b815260040160405180910390fd5b61044e81600161088f565b6103668585858561089b565b60605f610469868686866109a0565b9050805160026104799190611233565b67ffffffffffffffff81111561049157610491611158565b6040519080825280602002602001820160405280156104dd57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104af5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105269493929190611246565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106105685761056861128e565b60209081029190910101525f5b81518110156105c9578181815181106105905761059061128e565b6020026020010151838260016105a69190611233565b815181106105b6576105b661128e565b6020908102919091010152600101610575565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106109493929190611246565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161064f91906112a2565b8151811061065f5761065f61128e565b602002602001018190525050949350505050565b5f61068561068083610768565b610bec565b92915050565b60606106cb83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf992505050565b6040516020016106f3919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61071482610768565b905061071f81610831565b1580610731575061072f8161077b565b155b1561074f57604051634bd439b560e11b815260040160405180910390fd5b61075881610c05565b5050565b5f610685826054610c1c565b5f5f61077383610c48565b5c9392505050565b5f5f61077383600361083a565b5f61079483600361083a565b905081815d505050565b6107f96107aa84610673565b6107e98585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cb492505050565b6107f391906112a2565b84610d29565b50505050565b5f6003805c908261080f836112b5565b9190505d505f61081e83610c48565b905060035c80825d505060035c92915050565b5f5f6107738360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61079483600261083a565b5f6108da83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf992505050565b9050806001600160a01b03166372f702f36040518163ffffffff1660e01b8152600401602060405180830381865afa158015610918573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061093c91906112cd565b6002805c6001600160a01b0319166001600160a01b03831617905d5061036661099a8585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610cb492505050565b85610d29565b60605f6109e184848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610bf992505050565b90505f610a2285858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610d4192505050565b90505f610a6686868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610c1c915050565b90506001600160a01b038316610a8f57604051630f58648f60e01b815260040160405180910390fd5b8015610b00576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610ad9573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610afd91906112e8565b91505b815f03610b20576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b355790505093506040518060600160405280846001600160a01b031681526020015f815260200183604051602401610b9891815260200190565b60408051601f198184030181529190526020810180516001600160e01b0316632e1a7d4d60e01b1790529052845185905f90610bd657610bd661128e565b6020026020010181905250505050949350505050565b5f5f61077383600161083a565b5f610685826020610d58565b610c0f815f61088f565b610c19815f610788565b50565b5f828281518110610c2f57610c2f61128e565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610c9792919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610cfe573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d2291906112e8565b9392505050565b5f610d3382610768565b90505f6103dc82600161083a565b5f610685610d528360346020610dc1565b5f610ec6565b5f610d64826014611233565b83511015610db15760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b60608182601f011015610e075760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610da8565b610e118284611233565b84511015610e555760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610da8565b606082158015610e735760405191505f825260208201604052610ebd565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610eac578051835260209283019201610e94565b5050858452601f01601f1916604052505b50949350505050565b5f610ed2826020611233565b83511015610f1a5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610da8565b50016020015190565b6001600160a01b0381168114610c19575f5ffd5b5f5f83601f840112610f47575f5ffd5b50813567ffffffffffffffff811115610f5e575f5ffd5b602083019150836020828501011115610f75575f5ffd5b9250929050565b5f5f5f5f60608587031215610f8f575f5ffd5b8435610f9a81610f23565b93506020850135610faa81610f23565b9250604085013567ffffffffffffffff811115610fc5575f5ffd5b610fd187828801610f37565b95989497509550505050565b5f60208284031215610fed575f5ffd5b8135610d2281610f23565b5f5f60408385031215611009575f5ffd5b82359150602083013561101b81610f23565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b828110156110d557868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906110bf90870182611026565b955050602093840193919091019060010161107a565b50929695505050505050565b5f5f602083850312156110f2575f5ffd5b823567ffffffffffffffff811115611108575f5ffd5b61111485828601610f37565b90969095509350505050565b602081525f610d226020830184611026565b602081016003831061115257634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f6020828403121561117c575f5ffd5b813567ffffffffffffffff811115611192575f5ffd5b8201601f810184136111a2575f5ffd5b803567ffffffffffffffff8111156111bc576111bc611158565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156111eb576111eb611158565b604052818152828201602001861015611202575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106855761068561121f565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106855761068561121f565b5f600182016112c6576112c661121f565b5060010190565b5f602082840312156112dd575f5ffd5b8151610d2281610f23565b5f602082840312156112f8575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// GearboxUnstakeHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use GearboxUnstakeHookMetaData.ABI instead.
+var GearboxUnstakeHookABI = GearboxUnstakeHookMetaData.ABI
+
+// GearboxUnstakeHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use GearboxUnstakeHookMetaData.Bin instead.
+var GearboxUnstakeHookBin = GearboxUnstakeHookMetaData.Bin
+
+// DeployGearboxUnstakeHook deploys a new Ethereum contract, binding an instance of GearboxUnstakeHook to it.
+func DeployGearboxUnstakeHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *GearboxUnstakeHook, error) {
+	parsed, err := GearboxUnstakeHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(GearboxUnstakeHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &GearboxUnstakeHook{GearboxUnstakeHookCaller: GearboxUnstakeHookCaller{contract: contract}, GearboxUnstakeHookTransactor: GearboxUnstakeHookTransactor{contract: contract}, GearboxUnstakeHookFilterer: GearboxUnstakeHookFilterer{contract: contract}}, nil
+}
+
+// GearboxUnstakeHook is an auto generated Go binding around an Ethereum contract.
+type GearboxUnstakeHook struct {
+	GearboxUnstakeHookCaller     // Read-only binding to the contract
+	GearboxUnstakeHookTransactor // Write-only binding to the contract
+	GearboxUnstakeHookFilterer   // Log filterer for contract events
+}
+
+// GearboxUnstakeHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type GearboxUnstakeHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GearboxUnstakeHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type GearboxUnstakeHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GearboxUnstakeHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type GearboxUnstakeHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// GearboxUnstakeHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type GearboxUnstakeHookSession struct {
+	Contract     *GearboxUnstakeHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// GearboxUnstakeHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type GearboxUnstakeHookCallerSession struct {
+	Contract *GearboxUnstakeHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// GearboxUnstakeHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type GearboxUnstakeHookTransactorSession struct {
+	Contract     *GearboxUnstakeHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// GearboxUnstakeHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type GearboxUnstakeHookRaw struct {
+	Contract *GearboxUnstakeHook // Generic contract binding to access the raw methods on
+}
+
+// GearboxUnstakeHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type GearboxUnstakeHookCallerRaw struct {
+	Contract *GearboxUnstakeHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// GearboxUnstakeHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type GearboxUnstakeHookTransactorRaw struct {
+	Contract *GearboxUnstakeHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewGearboxUnstakeHook creates a new instance of GearboxUnstakeHook, bound to a specific deployed contract.
+func NewGearboxUnstakeHook(address common.Address, backend bind.ContractBackend) (*GearboxUnstakeHook, error) {
+	contract, err := bindGearboxUnstakeHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxUnstakeHook{GearboxUnstakeHookCaller: GearboxUnstakeHookCaller{contract: contract}, GearboxUnstakeHookTransactor: GearboxUnstakeHookTransactor{contract: contract}, GearboxUnstakeHookFilterer: GearboxUnstakeHookFilterer{contract: contract}}, nil
+}
+
+// NewGearboxUnstakeHookCaller creates a new read-only instance of GearboxUnstakeHook, bound to a specific deployed contract.
+func NewGearboxUnstakeHookCaller(address common.Address, caller bind.ContractCaller) (*GearboxUnstakeHookCaller, error) {
+	contract, err := bindGearboxUnstakeHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxUnstakeHookCaller{contract: contract}, nil
+}
+
+// NewGearboxUnstakeHookTransactor creates a new write-only instance of GearboxUnstakeHook, bound to a specific deployed contract.
+func NewGearboxUnstakeHookTransactor(address common.Address, transactor bind.ContractTransactor) (*GearboxUnstakeHookTransactor, error) {
+	contract, err := bindGearboxUnstakeHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxUnstakeHookTransactor{contract: contract}, nil
+}
+
+// NewGearboxUnstakeHookFilterer creates a new log filterer instance of GearboxUnstakeHook, bound to a specific deployed contract.
+func NewGearboxUnstakeHookFilterer(address common.Address, filterer bind.ContractFilterer) (*GearboxUnstakeHookFilterer, error) {
+	contract, err := bindGearboxUnstakeHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &GearboxUnstakeHookFilterer{contract: contract}, nil
+}
+
+// bindGearboxUnstakeHook binds a generic wrapper to an already deployed contract.
+func bindGearboxUnstakeHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := GearboxUnstakeHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GearboxUnstakeHook *GearboxUnstakeHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GearboxUnstakeHook.Contract.GearboxUnstakeHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GearboxUnstakeHook *GearboxUnstakeHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.GearboxUnstakeHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GearboxUnstakeHook *GearboxUnstakeHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.GearboxUnstakeHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _GearboxUnstakeHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) Asset() (common.Address, error) {
+	return _GearboxUnstakeHook.Contract.Asset(&_GearboxUnstakeHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) Asset() (common.Address, error) {
+	return _GearboxUnstakeHook.Contract.Asset(&_GearboxUnstakeHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _GearboxUnstakeHook.Contract.Build(&_GearboxUnstakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _GearboxUnstakeHook.Contract.Build(&_GearboxUnstakeHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _GearboxUnstakeHook.Contract.DecodeUsePrevHookAmount(&_GearboxUnstakeHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _GearboxUnstakeHook.Contract.DecodeUsePrevHookAmount(&_GearboxUnstakeHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) ExecutionNonce() (*big.Int, error) {
+	return _GearboxUnstakeHook.Contract.ExecutionNonce(&_GearboxUnstakeHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _GearboxUnstakeHook.Contract.ExecutionNonce(&_GearboxUnstakeHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _GearboxUnstakeHook.Contract.GetOutAmount(&_GearboxUnstakeHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _GearboxUnstakeHook.Contract.GetOutAmount(&_GearboxUnstakeHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) HookType() (uint8, error) {
+	return _GearboxUnstakeHook.Contract.HookType(&_GearboxUnstakeHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) HookType() (uint8, error) {
+	return _GearboxUnstakeHook.Contract.HookType(&_GearboxUnstakeHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) Inspect(data []byte) ([]byte, error) {
+	return _GearboxUnstakeHook.Contract.Inspect(&_GearboxUnstakeHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _GearboxUnstakeHook.Contract.Inspect(&_GearboxUnstakeHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) LastCaller() (common.Address, error) {
+	return _GearboxUnstakeHook.Contract.LastCaller(&_GearboxUnstakeHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) LastCaller() (common.Address, error) {
+	return _GearboxUnstakeHook.Contract.LastCaller(&_GearboxUnstakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) PostExecuteMutex() (bool, error) {
+	return _GearboxUnstakeHook.Contract.PostExecuteMutex(&_GearboxUnstakeHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _GearboxUnstakeHook.Contract.PostExecuteMutex(&_GearboxUnstakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) PreExecuteMutex() (bool, error) {
+	return _GearboxUnstakeHook.Contract.PreExecuteMutex(&_GearboxUnstakeHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _GearboxUnstakeHook.Contract.PreExecuteMutex(&_GearboxUnstakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) SpToken() (common.Address, error) {
+	return _GearboxUnstakeHook.Contract.SpToken(&_GearboxUnstakeHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) SpToken() (common.Address, error) {
+	return _GearboxUnstakeHook.Contract.SpToken(&_GearboxUnstakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) SubType() ([32]byte, error) {
+	return _GearboxUnstakeHook.Contract.SubType(&_GearboxUnstakeHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) SubType() ([32]byte, error) {
+	return _GearboxUnstakeHook.Contract.SubType(&_GearboxUnstakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) Subtype() ([32]byte, error) {
+	return _GearboxUnstakeHook.Contract.Subtype(&_GearboxUnstakeHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) Subtype() ([32]byte, error) {
+	return _GearboxUnstakeHook.Contract.Subtype(&_GearboxUnstakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _GearboxUnstakeHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) UsedShares() (*big.Int, error) {
+	return _GearboxUnstakeHook.Contract.UsedShares(&_GearboxUnstakeHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_GearboxUnstakeHook *GearboxUnstakeHookCallerSession) UsedShares() (*big.Int, error) {
+	return _GearboxUnstakeHook.Contract.UsedShares(&_GearboxUnstakeHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.PostExecute(&_GearboxUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.PostExecute(&_GearboxUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.PreExecute(&_GearboxUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.PreExecute(&_GearboxUnstakeHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.ResetExecutionState(&_GearboxUnstakeHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.ResetExecutionState(&_GearboxUnstakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.SetExecutionContext(&_GearboxUnstakeHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.SetExecutionContext(&_GearboxUnstakeHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.SetOutAmount(&_GearboxUnstakeHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_GearboxUnstakeHook *GearboxUnstakeHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _GearboxUnstakeHook.Contract.SetOutAmount(&_GearboxUnstakeHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,656 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MarkRootAsUsedHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MarkRootAsUsedHookMetaData contains all meta data concerning the MarkRootAsUsedHook contract.
+var MarkRootAsUsedHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260048152634d69736360e01b6020909101525f805460ff191690557ff315ec026a281fddb35f8b0d6a6103444774da4370a6c647a0f1510d600eb75b608052608051610f896100775f395f81816101c7015261023d0152610f895ff3fe608060405234801561000f575f5ffd5b50600436106100fb575f3560e01c80635492e677116100935780638e148776116100635780638e14877614610226578063984d6e7a14610238578063d06a1e891461025f578063e445e7dd14610272575f5ffd5b80635492e677146101c5578063685a943c146101eb57806381cbe691146101f35780638c4313c114610206575f5ffd5b80632113522a116100ce5780632113522a146101565780632ae2fe3d1461018057806338d52e0f146101935780633b5896bc146101a5575f5ffd5b806301bd43ef146100ff57806305b4fe911461011b57806314cb37cf146101305780631f26461914610143575b5f5ffd5b61010860035c81565b6040519081526020015b60405180910390f35b61012e610129366004610bd7565b61028b565b005b61012e61013e366004610c34565b6102f9565b61012e610151366004610c54565b61031a565b6101686001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610112565b61012e61018e366004610bd7565b610373565b6101686001600160a01b0360025c1681565b6101b86101b3366004610bd7565b6103da565b6040516101129190610cac565b7f0000000000000000000000000000000000000000000000000000000000000000610108565b6101085f5c81565b610108610201366004610c34565b6105f3565b610219610214366004610d39565b61060b565b6040516101129190610d78565b6101686001600160a01b0360015c1681565b6101087f000000000000000000000000000000000000000000000000000000000000000081565b61012e61026d366004610c34565b61068a565b5f5461027e9060ff1681565b6040516101129190610d8a565b336001600160a01b038416146102b45760405163e15e56c960e01b815260040160405180910390fd5b5f6102be84610707565b90506102c98161071a565b156102e75760405163945b63f560e01b815260040160405180910390fd5b6102f2816001610727565b5050505050565b6103028161073d565b50336004805c6001600160a01b0319168217905d5050565b5f61032482610707565b905061032f8161076f565b8061033e575061033e8161071a565b1561035c5760405163441e4c7360e11b815260040160405180910390fd5b5f610368826001610778565b905083815d50505050565b336001600160a01b0384161461039c5760405163e15e56c960e01b815260040160405180910390fd5b5f6103a684610707565b90506103b18161076f565b156103cf57604051630bbb04d960e11b815260040160405180910390fd5b6102f28160016107cd565b60605f6103e9868686866107d9565b9050805160026103f99190610dc4565b67ffffffffffffffff81111561041157610411610dd7565b60405190808252806020026020018201604052801561045d57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161042f5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104a69493929190610deb565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106104e8576104e8610e33565b60209081029190910101525f5b81518110156105495781818151811061051057610510610e33565b6020026020010151838260016105269190610dc4565b8151811061053657610536610e33565b60209081029190910101526001016104f5565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105909493929190610deb565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516105cf9190610e47565b815181106105df576105df610e33565b602002602001018190525050949350505050565b5f61060561060083610707565b61096d565b92915050565b606061064b83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061097a92505050565b604051602001610673919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b336001600160a01b0360045c16146106b55760405163e15e56c960e01b815260040160405180910390fd5b5f6106bf82610707565b90506106ca8161076f565b15806106dc57506106da8161071a565b155b156106fa57604051634bd439b560e11b815260040160405180910390fd5b61070381610986565b5050565b5f5f6107128361099d565b5c9392505050565b5f5f610712836003610778565b5f610733836003610778565b905081815d505050565b5f6003805c908261074d83610e5a565b9190505d505f61075c8361099d565b905060035c80825d505060035c92915050565b5f5f6107128360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610733836002610778565b60605f61081a84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061097a92505050565b90505f61086985858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610864915082905088610e47565b610a09565b90505f818060200190518101906108809190610e72565b905080515f036108a3576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816108b85790505093506040518060600160405280846001600160a01b031681526020015f8152602001826040516024016109199190610f3a565b60408051601f198184030181529190526020810180516001600160e01b031663a5c08d3d60e01b1790529052845185905f9061095757610957610e33565b6020026020010181905250505050949350505050565b5f5f610712836001610778565b5f610605826020610b13565b610990815f6107cd565b61099a815f610727565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b826040516020016109ec92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b60608182601f011015610a545760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b60448201526064015b60405180910390fd5b610a5e8284610dc4565b84511015610aa25760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610a4b565b606082158015610ac05760405191505f825260208201604052610b0a565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610af9578051835260209283019201610ae1565b5050858452601f01601f1916604052505b50949350505050565b5f610b1f826014610dc4565b83511015610b675760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401610a4b565b500160200151600160601b900490565b80356001600160a01b0381168114610b8d575f5ffd5b919050565b5f5f83601f840112610ba2575f5ffd5b50813567ffffffffffffffff811115610bb9575f5ffd5b602083019150836020828501011115610bd0575f5ffd5b9250929050565b5f5f5f5f60608587031215610bea575f5ffd5b610bf385610b77565b9350610c0160208601610b77565b9250604085013567ffffffffffffffff811115610c1c575f5ffd5b610c2887828801610b92565b95989497509550505050565b5f60208284031215610c44575f5ffd5b610c4d82610b77565b9392505050565b5f5f60408385031215610c65575f5ffd5b82359150610c7560208401610b77565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610d2d57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610d1790870182610c7e565b9550506020938401939190910190600101610cd2565b50929695505050505050565b5f5f60208385031215610d4a575f5ffd5b823567ffffffffffffffff811115610d60575f5ffd5b610d6c85828601610b92565b90969095509350505050565b602081525f610c4d6020830184610c7e565b6020810160038310610daa57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561060557610605610db0565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561060557610605610db0565b5f60018201610e6b57610e6b610db0565b5060010190565b5f60208284031215610e82575f5ffd5b815167ffffffffffffffff811115610e98575f5ffd5b8201601f81018413610ea8575f5ffd5b805167ffffffffffffffff811115610ec257610ec2610dd7565b8060051b604051601f19603f830116810181811067ffffffffffffffff82111715610eef57610eef610dd7565b604052918252602081840181019290810187841115610f0c575f5ffd5b6020850194505b83851015610f2f57845180825260209586019590935001610f13565b509695505050505050565b602080825282518282018190525f918401906040840190835b81811015610f71578351835260209384019390920191600101610f53565b50909594505050505056fea164736f6c634300081e000a",
+}
+
+// MarkRootAsUsedHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MarkRootAsUsedHookMetaData.ABI instead.
+var MarkRootAsUsedHookABI = MarkRootAsUsedHookMetaData.ABI
+
+// MarkRootAsUsedHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MarkRootAsUsedHookMetaData.Bin instead.
+var MarkRootAsUsedHookBin = MarkRootAsUsedHookMetaData.Bin
+
+// DeployMarkRootAsUsedHook deploys a new Ethereum contract, binding an instance of MarkRootAsUsedHook to it.
+func DeployMarkRootAsUsedHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *MarkRootAsUsedHook, error) {
+	parsed, err := MarkRootAsUsedHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MarkRootAsUsedHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MarkRootAsUsedHook{MarkRootAsUsedHookCaller: MarkRootAsUsedHookCaller{contract: contract}, MarkRootAsUsedHookTransactor: MarkRootAsUsedHookTransactor{contract: contract}, MarkRootAsUsedHookFilterer: MarkRootAsUsedHookFilterer{contract: contract}}, nil
+}
+
+// MarkRootAsUsedHook is an auto generated Go binding around an Ethereum contract.
+type MarkRootAsUsedHook struct {
+	MarkRootAsUsedHookCaller     // Read-only binding to the contract
+	MarkRootAsUsedHookTransactor // Write-only binding to the contract
+	MarkRootAsUsedHookFilterer   // Log filterer for contract events
+}
+
+// MarkRootAsUsedHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MarkRootAsUsedHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MarkRootAsUsedHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MarkRootAsUsedHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MarkRootAsUsedHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MarkRootAsUsedHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MarkRootAsUsedHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MarkRootAsUsedHookSession struct {
+	Contract     *MarkRootAsUsedHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// MarkRootAsUsedHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MarkRootAsUsedHookCallerSession struct {
+	Contract *MarkRootAsUsedHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// MarkRootAsUsedHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MarkRootAsUsedHookTransactorSession struct {
+	Contract     *MarkRootAsUsedHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// MarkRootAsUsedHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MarkRootAsUsedHookRaw struct {
+	Contract *MarkRootAsUsedHook // Generic contract binding to access the raw methods on
+}
+
+// MarkRootAsUsedHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MarkRootAsUsedHookCallerRaw struct {
+	Contract *MarkRootAsUsedHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MarkRootAsUsedHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MarkRootAsUsedHookTransactorRaw struct {
+	Contract *MarkRootAsUsedHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMarkRootAsUsedHook creates a new instance of MarkRootAsUsedHook, bound to a specific deployed contract.
+func NewMarkRootAsUsedHook(address common.Address, backend bind.ContractBackend) (*MarkRootAsUsedHook, error) {
+	contract, err := bindMarkRootAsUsedHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MarkRootAsUsedHook{MarkRootAsUsedHookCaller: MarkRootAsUsedHookCaller{contract: contract}, MarkRootAsUsedHookTransactor: MarkRootAsUsedHookTransactor{contract: contract}, MarkRootAsUsedHookFilterer: MarkRootAsUsedHookFilterer{contract: contract}}, nil
+}
+
+// NewMarkRootAsUsedHookCaller creates a new read-only instance of MarkRootAsUsedHook, bound to a specific deployed contract.
+func NewMarkRootAsUsedHookCaller(address common.Address, caller bind.ContractCaller) (*MarkRootAsUsedHookCaller, error) {
+	contract, err := bindMarkRootAsUsedHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MarkRootAsUsedHookCaller{contract: contract}, nil
+}
+
+// NewMarkRootAsUsedHookTransactor creates a new write-only instance of MarkRootAsUsedHook, bound to a specific deployed contract.
+func NewMarkRootAsUsedHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MarkRootAsUsedHookTransactor, error) {
+	contract, err := bindMarkRootAsUsedHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MarkRootAsUsedHookTransactor{contract: contract}, nil
+}
+
+// NewMarkRootAsUsedHookFilterer creates a new log filterer instance of MarkRootAsUsedHook, bound to a specific deployed contract.
+func NewMarkRootAsUsedHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MarkRootAsUsedHookFilterer, error) {
+	contract, err := bindMarkRootAsUsedHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MarkRootAsUsedHookFilterer{contract: contract}, nil
+}
+
+// bindMarkRootAsUsedHook binds a generic wrapper to an already deployed contract.
+func bindMarkRootAsUsedHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MarkRootAsUsedHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MarkRootAsUsedHook.Contract.MarkRootAsUsedHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.MarkRootAsUsedHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.MarkRootAsUsedHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MarkRootAsUsedHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) SUBTYPE() ([32]byte, error) {
+	return _MarkRootAsUsedHook.Contract.SUBTYPE(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _MarkRootAsUsedHook.Contract.SUBTYPE(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) Asset() (common.Address, error) {
+	return _MarkRootAsUsedHook.Contract.Asset(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) Asset() (common.Address, error) {
+	return _MarkRootAsUsedHook.Contract.Asset(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MarkRootAsUsedHook.Contract.Build(&_MarkRootAsUsedHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MarkRootAsUsedHook.Contract.Build(&_MarkRootAsUsedHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MarkRootAsUsedHook.Contract.ExecutionNonce(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MarkRootAsUsedHook.Contract.ExecutionNonce(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MarkRootAsUsedHook.Contract.GetOutAmount(&_MarkRootAsUsedHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MarkRootAsUsedHook.Contract.GetOutAmount(&_MarkRootAsUsedHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) HookType() (uint8, error) {
+	return _MarkRootAsUsedHook.Contract.HookType(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) HookType() (uint8, error) {
+	return _MarkRootAsUsedHook.Contract.HookType(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MarkRootAsUsedHook.Contract.Inspect(&_MarkRootAsUsedHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MarkRootAsUsedHook.Contract.Inspect(&_MarkRootAsUsedHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) LastCaller() (common.Address, error) {
+	return _MarkRootAsUsedHook.Contract.LastCaller(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) LastCaller() (common.Address, error) {
+	return _MarkRootAsUsedHook.Contract.LastCaller(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) SpToken() (common.Address, error) {
+	return _MarkRootAsUsedHook.Contract.SpToken(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) SpToken() (common.Address, error) {
+	return _MarkRootAsUsedHook.Contract.SpToken(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) Subtype() ([32]byte, error) {
+	return _MarkRootAsUsedHook.Contract.Subtype(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) Subtype() ([32]byte, error) {
+	return _MarkRootAsUsedHook.Contract.Subtype(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MarkRootAsUsedHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) UsedShares() (*big.Int, error) {
+	return _MarkRootAsUsedHook.Contract.UsedShares(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MarkRootAsUsedHook.Contract.UsedShares(&_MarkRootAsUsedHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.PostExecute(&_MarkRootAsUsedHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.PostExecute(&_MarkRootAsUsedHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.PreExecute(&_MarkRootAsUsedHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.PreExecute(&_MarkRootAsUsedHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.ResetExecutionState(&_MarkRootAsUsedHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.ResetExecutionState(&_MarkRootAsUsedHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.SetExecutionContext(&_MarkRootAsUsedHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.SetExecutionContext(&_MarkRootAsUsedHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.SetOutAmount(&_MarkRootAsUsedHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MarkRootAsUsedHook *MarkRootAsUsedHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MarkRootAsUsedHook.Contract.SetOutAmount(&_MarkRootAsUsedHook.TransactOpts, _outAmount, caller)
+}
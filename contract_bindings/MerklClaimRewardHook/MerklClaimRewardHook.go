@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MerklClaimRewardHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MerklClaimRewardHookMetaData contains all meta data concerning the MerklClaimRewardHook contract.
+var MerklClaimRewardHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"distributor_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"BPS\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"DISTRIBUTOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"MAX_FEE_PERCENT\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"addressData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"FEE_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ENCODING\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b50604051611a2a380380611a2a83398101604081905261002e916100b0565b604080518082019091526005815264436c61696d60d81b6020909101525f805460ff191690557f5c2db6855baf6c374b9d0065771a33d4c31a8627d7b9d40f3fc6aa5dbd7b8a486080526001600160a01b03811661009f57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dd565b5f602082840312156100c0575f5ffd5b81516001600160a01b03811681146100d6575f5ffd5b9392505050565b60805160a0516119176101135f395f81816102970152818161099a0152610bce01525f81816101f1015261027001526119175ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80635492e677116100a95780638e1487761161006e5780638e14877614610259578063984d6e7a1461026b5780639c26149f14610292578063d06a1e89146102b9578063e445e7dd146102cc575f5ffd5b80635492e677146101ef57806367d8174014610215578063685a943c1461021e57806381cbe691146102265780638c4313c114610239575f5ffd5b80632113522a116100ef5780632113522a14610177578063249d39e9146101a15780632ae2fe3d146101aa57806338d52e0f146101bd5780633b5896bc146101cf575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004611494565b6102e5565b005b61014f61015f3660046114f1565b61035f565b61014f610172366004611511565b610380565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61012961271081565b61014f6101b8366004611494565b6103d9565b6101896001600160a01b0360025c1681565b6101e26101dd366004611494565b610440565b6040516101339190611560565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b61012961138881565b6101295f5c81565b6101296102343660046114f1565b610646565b61024c6102473660046115ed565b61065e565b604051610133919061162c565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b6101897f000000000000000000000000000000000000000000000000000000000000000081565b61014f6102c73660046114f1565b610704565b5f546102d89060ff1681565b604051610133919061163e565b336001600160a01b0384161461030e5760405163e15e56c960e01b815260040160405180910390fd5b5f61031884610781565b905061032381610794565b156103415760405163945b63f560e01b815260040160405180910390fd5b61034c8160016107a1565b610358858585856107b7565b5050505050565b610368816107c7565b50336004805c6001600160a01b0319168217905d5050565b5f61038a82610781565b9050610395816107f9565b806103a457506103a481610794565b156103c25760405163441e4c7360e11b815260040160405180910390fd5b5f6103ce826001610802565b905083815d50505050565b336001600160a01b038416146104025760405163e15e56c960e01b815260040160405180910390fd5b5f61040c84610781565b9050610417816107f9565b1561043557604051630bbb04d960e11b815260040160405180910390fd5b61034c816001610857565b60605f61044f86868686610863565b90508051600261045f9190611678565b67ffffffffffffffff8111156104775761047761168b565b6040519080825280602002602001820160405280156104b057816020015b61049d61140c565b8152602001906001900390816104955790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104f9949392919061169f565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061053b5761053b6116e7565b60209081029190910101525f5b815181101561059c57818181518110610563576105636116e7565b6020026020010151838260016105799190611678565b81518110610589576105896116e7565b6020908102919091010152600101610548565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016105e3949392919061169f565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161062291906116fb565b81518110610632576106326116e7565b602002602001018190525050949350505050565b5f61065861065383610781565b610c7c565b92915050565b60605f61066b8484610c89565b509050818160601b60405160200161068492919061170e565b60405160208183030381529060405291505f6106a08585610d15565b505080519091505f5b818110156106fa57848382815181106106c4576106c46116e7565b602002602001015160601b6040516020016106e092919061170e565b60408051601f1981840301815291905294506001016106a9565b5050505092915050565b336001600160a01b0360045c161461072f5760405163e15e56c960e01b815260040160405180910390fd5b5f61073982610781565b9050610744816107f9565b1580610756575061075481610794565b155b1561077457604051634bd439b560e11b815260040160405180910390fd5b61077d81610d4a565b5050565b5f5f61078c83610d61565b5c9392505050565b5f5f61078c836003610802565b5f6107ad836003610802565b905081815d505050565b6107c15f84610dcd565b50505050565b5f6003805c90826107d78361173a565b9190505d505f6107e683610d61565b905060035c80825d505060035c92915050565b5f5f61078c8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107ad836002610802565b60606108906040518060800160405280606081526020016060815260200160608152602001606081525090565b5f5f61089c8686610c89565b915091506113888111156108c35760405163adafbb0b60e01b815260040160405180910390fd5b5f811180156108d957506001600160a01b038216155b156108f757604051630f58648f60e01b815260040160405180910390fd5b5f610903888888610de5565b80855290506109128787610d15565b6060870152604086015260208501528115610b8e57602084015151610938816001611678565b67ffffffffffffffff8111156109505761095061168b565b60405190808252806020026020018201604052801561098957816020015b61097661140c565b81526020019060019003908161096e5790505b5095505f5b81811015610b87575f5f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316630c9cbf0e895f015185815181106109dc576109dc6116e7565b60200260200101518a6020015186815181106109fa576109fa6116e7565b60200260200101516040518363ffffffff1660e01b8152600401610a349291906001600160a01b0392831681529116602082015260400190565b606060405180830381865afa158015610a4f573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a739190611752565b9050508091505061271086826001600160d01b03168a604001518681518110610a9e57610a9e6116e7565b6020026020010151610ab091906116fb565b610aba91906117a7565b610ac491906117be565b9150604051806060016040528089602001518581518110610ae757610ae76116e7565b60200260200101516001600160a01b031681526020015f81526020018884604051602401610b2a9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663a9059cbb60e01b179052905289610b62856001611678565b81518110610b7257610b726116e7565b6020908102919091010152505060010161098e565b5050610bc1565b60408051600180825281830190925290816020015b610bab61140c565b815260200190600190039081610ba35790505094505b60405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f8152602001855f0151866020015187604001518860600151604051602401610c279493929190611820565b60408051601f198184030181529190526020810180516001600160e01b03166301c7ba5760e61b1790529052855186905f90610c6557610c656116e7565b602002602001018190525050505050949350505050565b5f5f61078c836001610802565b5f5f610cc984848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610eb6915050565b9150610d0c84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610f1f915050565b90509250929050565b60608060605f5f5f610d278888610f7c565b925092509250819550809450610d3e8888856111b2565b93505050509250925092565b610d54815f610857565b610d5e815f6107a1565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610db092919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610dd782610781565b90505f6103ce826001610802565b60605f610e2984848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610f1f915050565b90508067ffffffffffffffff811115610e4457610e4461168b565b604051908082528060200260200182016040528015610e6d578160200160208202803683370190505b5091505f5b81811015610ead5785838281518110610e8d57610e8d6116e7565b6001600160a01b0390921660209283029190910190910152600101610e72565b50509392505050565b5f610ec2826014611678565b83511015610f0f5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610f2b826020611678565b83511015610f735760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610f06565b50016020015190565b5f6060805f610fc286868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610f1f915050565b9050605493508067ffffffffffffffff811115610fe157610fe161168b565b60405190808252806020026020018201604052801561100a578160200160208202803683370190505b5092505f5b818110156110c2575f61105888888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152508a9250610eb6915050565b9050611065601487611678565b95506001600160a01b03811661108e57604051630f58648f60e01b815260040160405180910390fd5b808583815181106110a1576110a16116e7565b6001600160a01b03909216602092830291909101909101525060010161100f565b508067ffffffffffffffff8111156110dc576110dc61168b565b604051908082528060200260200182016040528015611105578160200160208202803683370190505b5091505f5b818110156111a9575f61115388888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152508a9250610f1f915050565b9050611160602087611678565b9550805f03611182576040516305112ab160e41b815260040160405180910390fd5b80848381518110611195576111956116e7565b60209081029190910101525060010161110a565b50509250925092565b60605f6111f685858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610f1f915050565b90508067ffffffffffffffff8111156112115761121161168b565b60405190808252806020026020018201604052801561124457816020015b606081526020019060019003908161122f5790505b5091505f5b8181101561138f575f61129287878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250899250610f1f915050565b905061129f602086611678565b94505f8167ffffffffffffffff8111156112bb576112bb61168b565b6040519080825280602002602001820160405280156112e4578160200160208202803683370190505b5090505f5b828110156113665761133189898080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152508b92506113b8915050565b828281518110611343576113436116e7565b60200260200101818152505060208761135c9190611678565b96506001016112e9565b508085848151811061137a5761137a6116e7565b60209081029190910101525050600101611249565b508284146113b057604051637277ba1b60e01b815260040160405180910390fd5b509392505050565b5f6113c4826020611678565b83511015610f735760405162461bcd60e51b8152602060048201526015602482015274746f427974657333325f6f75744f66426f756e647360581b6044820152606401610f06565b60405180606001604052805f6001600160a01b031681526020015f8152602001606081525090565b80356001600160a01b038116811461144a575f5ffd5b919050565b5f5f83601f84011261145f575f5ffd5b50813567ffffffffffffffff811115611476575f5ffd5b60208301915083602082850101111561148d575f5ffd5b9250929050565b5f5f5f5f606085870312156114a7575f5ffd5b6114b085611434565b93506114be60208601611434565b9250604085013567ffffffffffffffff8111156114d9575f5ffd5b6114e58782880161144f565b95989497509550505050565b5f60208284031215611501575f5ffd5b61150a82611434565b9392505050565b5f5f60408385031215611522575f5ffd5b82359150610d0c60208401611434565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b828110156115e157868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906115cb90870182611532565b9550506020938401939190910190600101611586565b50929695505050505050565b5f5f602083850312156115fe575f5ffd5b823567ffffffffffffffff811115611614575f5ffd5b6116208582860161144f565b90969095509350505050565b602081525f61150a6020830184611532565b602081016003831061165e57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561065857610658611664565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561065857610658611664565b5f83518060208601845e6bffffffffffffffffffffffff19939093169190920190815260140192915050565b5f6001820161174b5761174b611664565b5060010190565b5f5f5f60608486031215611764575f5ffd5b83516001600160d01b038116811461177a575f5ffd5b602085015190935065ffffffffffff81168114611795575f5ffd5b80925050604084015190509250925092565b808202811582820484141761065857610658611664565b5f826117d857634e487b7160e01b5f52601260045260245ffd5b500490565b5f8151808452602084019350602083015f5b828110156118165781516001600160a01b03168652602095860195909101906001016117ef565b5093949350505050565b608081525f61183260808301876117dd565b828103602084015261184481876117dd565b8381036040850152855180825260208088019350909101905f5b8181101561187c57835183526020938401939092019160010161185e565b50508381036060850152845180825260208083019350600582901b830181019087015f5b838110156118fa57848303601f19018652815180518085526020918201918501905f5b818110156118e15783518352602093840193909201916001016118c3565b50506020978801979094509290920191506001016118a0565b50909a995050505050505050505056fea164736f6c634300081e000a",
+}
+
+// MerklClaimRewardHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MerklClaimRewardHookMetaData.ABI instead.
+var MerklClaimRewardHookABI = MerklClaimRewardHookMetaData.ABI
+
+// MerklClaimRewardHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MerklClaimRewardHookMetaData.Bin instead.
+var MerklClaimRewardHookBin = MerklClaimRewardHookMetaData.Bin
+
+// DeployMerklClaimRewardHook deploys a new Ethereum contract, binding an instance of MerklClaimRewardHook to it.
+func DeployMerklClaimRewardHook(auth *bind.TransactOpts, backend bind.ContractBackend, distributor_ common.Address) (common.Address, *types.Transaction, *MerklClaimRewardHook, error) {
+	parsed, err := MerklClaimRewardHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MerklClaimRewardHookBin), backend, distributor_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MerklClaimRewardHook{MerklClaimRewardHookCaller: MerklClaimRewardHookCaller{contract: contract}, MerklClaimRewardHookTransactor: MerklClaimRewardHookTransactor{contract: contract}, MerklClaimRewardHookFilterer: MerklClaimRewardHookFilterer{contract: contract}}, nil
+}
+
+// MerklClaimRewardHook is an auto generated Go binding around an Ethereum contract.
+type MerklClaimRewardHook struct {
+	MerklClaimRewardHookCaller     // Read-only binding to the contract
+	MerklClaimRewardHookTransactor // Write-only binding to the contract
+	MerklClaimRewardHookFilterer   // Log filterer for contract events
+}
+
+// MerklClaimRewardHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MerklClaimRewardHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MerklClaimRewardHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MerklClaimRewardHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MerklClaimRewardHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MerklClaimRewardHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MerklClaimRewardHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MerklClaimRewardHookSession struct {
+	Contract     *MerklClaimRewardHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// MerklClaimRewardHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MerklClaimRewardHookCallerSession struct {
+	Contract *MerklClaimRewardHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// MerklClaimRewardHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MerklClaimRewardHookTransactorSession struct {
+	Contract     *MerklClaimRewardHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// MerklClaimRewardHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MerklClaimRewardHookRaw struct {
+	Contract *MerklClaimRewardHook // Generic contract binding to access the raw methods on
+}
+
+// MerklClaimRewardHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MerklClaimRewardHookCallerRaw struct {
+	Contract *MerklClaimRewardHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MerklClaimRewardHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MerklClaimRewardHookTransactorRaw struct {
+	Contract *MerklClaimRewardHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMerklClaimRewardHook creates a new instance of MerklClaimRewardHook, bound to a specific deployed contract.
+func NewMerklClaimRewardHook(address common.Address, backend bind.ContractBackend) (*MerklClaimRewardHook, error) {
+	contract, err := bindMerklClaimRewardHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MerklClaimRewardHook{MerklClaimRewardHookCaller: MerklClaimRewardHookCaller{contract: contract}, MerklClaimRewardHookTransactor: MerklClaimRewardHookTransactor{contract: contract}, MerklClaimRewardHookFilterer: MerklClaimRewardHookFilterer{contract: contract}}, nil
+}
+
+// NewMerklClaimRewardHookCaller creates a new read-only instance of MerklClaimRewardHook, bound to a specific deployed contract.
+func NewMerklClaimRewardHookCaller(address common.Address, caller bind.ContractCaller) (*MerklClaimRewardHookCaller, error) {
+	contract, err := bindMerklClaimRewardHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MerklClaimRewardHookCaller{contract: contract}, nil
+}
+
+// NewMerklClaimRewardHookTransactor creates a new write-only instance of MerklClaimRewardHook, bound to a specific deployed contract.
+func NewMerklClaimRewardHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MerklClaimRewardHookTransactor, error) {
+	contract, err := bindMerklClaimRewardHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MerklClaimRewardHookTransactor{contract: contract}, nil
+}
+
+// NewMerklClaimRewardHookFilterer creates a new log filterer instance of MerklClaimRewardHook, bound to a specific deployed contract.
+func NewMerklClaimRewardHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MerklClaimRewardHookFilterer, error) {
+	contract, err := bindMerklClaimRewardHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MerklClaimRewardHookFilterer{contract: contract}, nil
+}
+
+// bindMerklClaimRewardHook binds a generic wrapper to an already deployed contract.
+func bindMerklClaimRewardHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MerklClaimRewardHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MerklClaimRewardHook *MerklClaimRewardHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MerklClaimRewardHook.Contract.MerklClaimRewardHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MerklClaimRewardHook *MerklClaimRewardHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.MerklClaimRewardHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MerklClaimRewardHook *MerklClaimRewardHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.MerklClaimRewardHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MerklClaimRewardHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// BPS is a free data retrieval call binding the contract method 0x249d39e9.
+//
+// Solidity: function BPS() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) BPS(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "BPS")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// BPS is a free data retrieval call binding the contract method 0x249d39e9.
+//
+// Solidity: function BPS() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) BPS() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.BPS(&_MerklClaimRewardHook.CallOpts)
+}
+
+// BPS is a free data retrieval call binding the contract method 0x249d39e9.
+//
+// Solidity: function BPS() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) BPS() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.BPS(&_MerklClaimRewardHook.CallOpts)
+}
+
+// DISTRIBUTOR is a free data retrieval call binding the contract method 0x9c26149f.
+//
+// Solidity: function DISTRIBUTOR() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) DISTRIBUTOR(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "DISTRIBUTOR")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// DISTRIBUTOR is a free data retrieval call binding the contract method 0x9c26149f.
+//
+// Solidity: function DISTRIBUTOR() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) DISTRIBUTOR() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.DISTRIBUTOR(&_MerklClaimRewardHook.CallOpts)
+}
+
+// DISTRIBUTOR is a free data retrieval call binding the contract method 0x9c26149f.
+//
+// Solidity: function DISTRIBUTOR() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) DISTRIBUTOR() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.DISTRIBUTOR(&_MerklClaimRewardHook.CallOpts)
+}
+
+// MAXFEEPERCENT is a free data retrieval call binding the contract method 0x67d81740.
+//
+// Solidity: function MAX_FEE_PERCENT() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) MAXFEEPERCENT(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "MAX_FEE_PERCENT")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// MAXFEEPERCENT is a free data retrieval call binding the contract method 0x67d81740.
+//
+// Solidity: function MAX_FEE_PERCENT() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) MAXFEEPERCENT() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.MAXFEEPERCENT(&_MerklClaimRewardHook.CallOpts)
+}
+
+// MAXFEEPERCENT is a free data retrieval call binding the contract method 0x67d81740.
+//
+// Solidity: function MAX_FEE_PERCENT() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) MAXFEEPERCENT() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.MAXFEEPERCENT(&_MerklClaimRewardHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) SUBTYPE() ([32]byte, error) {
+	return _MerklClaimRewardHook.Contract.SUBTYPE(&_MerklClaimRewardHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _MerklClaimRewardHook.Contract.SUBTYPE(&_MerklClaimRewardHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) Asset() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.Asset(&_MerklClaimRewardHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) Asset() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.Asset(&_MerklClaimRewardHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MerklClaimRewardHook.Contract.Build(&_MerklClaimRewardHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MerklClaimRewardHook.Contract.Build(&_MerklClaimRewardHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.ExecutionNonce(&_MerklClaimRewardHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.ExecutionNonce(&_MerklClaimRewardHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.GetOutAmount(&_MerklClaimRewardHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.GetOutAmount(&_MerklClaimRewardHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) HookType() (uint8, error) {
+	return _MerklClaimRewardHook.Contract.HookType(&_MerklClaimRewardHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) HookType() (uint8, error) {
+	return _MerklClaimRewardHook.Contract.HookType(&_MerklClaimRewardHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes addressData)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes addressData)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MerklClaimRewardHook.Contract.Inspect(&_MerklClaimRewardHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes addressData)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MerklClaimRewardHook.Contract.Inspect(&_MerklClaimRewardHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) LastCaller() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.LastCaller(&_MerklClaimRewardHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) LastCaller() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.LastCaller(&_MerklClaimRewardHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) SpToken() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.SpToken(&_MerklClaimRewardHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) SpToken() (common.Address, error) {
+	return _MerklClaimRewardHook.Contract.SpToken(&_MerklClaimRewardHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) Subtype() ([32]byte, error) {
+	return _MerklClaimRewardHook.Contract.Subtype(&_MerklClaimRewardHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) Subtype() ([32]byte, error) {
+	return _MerklClaimRewardHook.Contract.Subtype(&_MerklClaimRewardHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MerklClaimRewardHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) UsedShares() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.UsedShares(&_MerklClaimRewardHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MerklClaimRewardHook *MerklClaimRewardHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MerklClaimRewardHook.Contract.UsedShares(&_MerklClaimRewardHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.PostExecute(&_MerklClaimRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.PostExecute(&_MerklClaimRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.PreExecute(&_MerklClaimRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.PreExecute(&_MerklClaimRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.ResetExecutionState(&_MerklClaimRewardHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.ResetExecutionState(&_MerklClaimRewardHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.SetExecutionContext(&_MerklClaimRewardHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.SetExecutionContext(&_MerklClaimRewardHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.SetOutAmount(&_MerklClaimRewardHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MerklClaimRewardHook *MerklClaimRewardHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MerklClaimRewardHook.Contract.SetOutAmount(&_MerklClaimRewardHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,758 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MintSuperPositionsHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MintSuperPositionsHookMetaData contains all meta data concerning the MintSuperPositionsHook contract.
+var MintSuperPositionsHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"dstChainId\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"vaultBank\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ID_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+}
+
+// MintSuperPositionsHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MintSuperPositionsHookMetaData.ABI instead.
+var MintSuperPositionsHookABI = MintSuperPositionsHookMetaData.ABI
+
+// MintSuperPositionsHook is an auto generated Go binding around an Ethereum contract.
+type MintSuperPositionsHook struct {
+	MintSuperPositionsHookCaller     // Read-only binding to the contract
+	MintSuperPositionsHookTransactor // Write-only binding to the contract
+	MintSuperPositionsHookFilterer   // Log filterer for contract events
+}
+
+// MintSuperPositionsHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MintSuperPositionsHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MintSuperPositionsHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MintSuperPositionsHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MintSuperPositionsHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MintSuperPositionsHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MintSuperPositionsHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MintSuperPositionsHookSession struct {
+	Contract     *MintSuperPositionsHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// MintSuperPositionsHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MintSuperPositionsHookCallerSession struct {
+	Contract *MintSuperPositionsHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// MintSuperPositionsHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MintSuperPositionsHookTransactorSession struct {
+	Contract     *MintSuperPositionsHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// MintSuperPositionsHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MintSuperPositionsHookRaw struct {
+	Contract *MintSuperPositionsHook // Generic contract binding to access the raw methods on
+}
+
+// MintSuperPositionsHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MintSuperPositionsHookCallerRaw struct {
+	Contract *MintSuperPositionsHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MintSuperPositionsHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MintSuperPositionsHookTransactorRaw struct {
+	Contract *MintSuperPositionsHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMintSuperPositionsHook creates a new instance of MintSuperPositionsHook, bound to a specific deployed contract.
+func NewMintSuperPositionsHook(address common.Address, backend bind.ContractBackend) (*MintSuperPositionsHook, error) {
+	contract, err := bindMintSuperPositionsHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MintSuperPositionsHook{MintSuperPositionsHookCaller: MintSuperPositionsHookCaller{contract: contract}, MintSuperPositionsHookTransactor: MintSuperPositionsHookTransactor{contract: contract}, MintSuperPositionsHookFilterer: MintSuperPositionsHookFilterer{contract: contract}}, nil
+}
+
+// NewMintSuperPositionsHookCaller creates a new read-only instance of MintSuperPositionsHook, bound to a specific deployed contract.
+func NewMintSuperPositionsHookCaller(address common.Address, caller bind.ContractCaller) (*MintSuperPositionsHookCaller, error) {
+	contract, err := bindMintSuperPositionsHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MintSuperPositionsHookCaller{contract: contract}, nil
+}
+
+// NewMintSuperPositionsHookTransactor creates a new write-only instance of MintSuperPositionsHook, bound to a specific deployed contract.
+func NewMintSuperPositionsHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MintSuperPositionsHookTransactor, error) {
+	contract, err := bindMintSuperPositionsHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MintSuperPositionsHookTransactor{contract: contract}, nil
+}
+
+// NewMintSuperPositionsHookFilterer creates a new log filterer instance of MintSuperPositionsHook, bound to a specific deployed contract.
+func NewMintSuperPositionsHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MintSuperPositionsHookFilterer, error) {
+	contract, err := bindMintSuperPositionsHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MintSuperPositionsHookFilterer{contract: contract}, nil
+}
+
+// bindMintSuperPositionsHook binds a generic wrapper to an already deployed contract.
+func bindMintSuperPositionsHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MintSuperPositionsHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MintSuperPositionsHook *MintSuperPositionsHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MintSuperPositionsHook.Contract.MintSuperPositionsHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MintSuperPositionsHook *MintSuperPositionsHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.MintSuperPositionsHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MintSuperPositionsHook *MintSuperPositionsHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.MintSuperPositionsHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MintSuperPositionsHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) SUBTYPE() ([32]byte, error) {
+	return _MintSuperPositionsHook.Contract.SUBTYPE(&_MintSuperPositionsHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _MintSuperPositionsHook.Contract.SUBTYPE(&_MintSuperPositionsHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) Asset() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.Asset(&_MintSuperPositionsHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) Asset() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.Asset(&_MintSuperPositionsHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MintSuperPositionsHook.Contract.Build(&_MintSuperPositionsHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MintSuperPositionsHook.Contract.Build(&_MintSuperPositionsHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.DecodeAmount(&_MintSuperPositionsHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.DecodeAmount(&_MintSuperPositionsHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MintSuperPositionsHook.Contract.DecodeUsePrevHookAmount(&_MintSuperPositionsHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MintSuperPositionsHook.Contract.DecodeUsePrevHookAmount(&_MintSuperPositionsHook.CallOpts, data)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) DstChainId(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "dstChainId")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) DstChainId() (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.DstChainId(&_MintSuperPositionsHook.CallOpts)
+}
+
+// DstChainId is a free data retrieval call binding the contract method 0x30c593f7.
+//
+// Solidity: function dstChainId() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) DstChainId() (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.DstChainId(&_MintSuperPositionsHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.ExecutionNonce(&_MintSuperPositionsHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.ExecutionNonce(&_MintSuperPositionsHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.GetOutAmount(&_MintSuperPositionsHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.GetOutAmount(&_MintSuperPositionsHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) HookType() (uint8, error) {
+	return _MintSuperPositionsHook.Contract.HookType(&_MintSuperPositionsHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) HookType() (uint8, error) {
+	return _MintSuperPositionsHook.Contract.HookType(&_MintSuperPositionsHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MintSuperPositionsHook.Contract.Inspect(&_MintSuperPositionsHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MintSuperPositionsHook.Contract.Inspect(&_MintSuperPositionsHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) LastCaller() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.LastCaller(&_MintSuperPositionsHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) LastCaller() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.LastCaller(&_MintSuperPositionsHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) SpToken() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.SpToken(&_MintSuperPositionsHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) SpToken() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.SpToken(&_MintSuperPositionsHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) Subtype() ([32]byte, error) {
+	return _MintSuperPositionsHook.Contract.Subtype(&_MintSuperPositionsHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) Subtype() ([32]byte, error) {
+	return _MintSuperPositionsHook.Contract.Subtype(&_MintSuperPositionsHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) UsedShares() (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.UsedShares(&_MintSuperPositionsHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MintSuperPositionsHook.Contract.UsedShares(&_MintSuperPositionsHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCaller) VaultBank(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MintSuperPositionsHook.contract.Call(opts, &out, "vaultBank")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) VaultBank() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.VaultBank(&_MintSuperPositionsHook.CallOpts)
+}
+
+// VaultBank is a free data retrieval call binding the contract method 0x701ffc8b.
+//
+// Solidity: function vaultBank() view returns(address)
+func (_MintSuperPositionsHook *MintSuperPositionsHookCallerSession) VaultBank() (common.Address, error) {
+	return _MintSuperPositionsHook.Contract.VaultBank(&_MintSuperPositionsHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.PostExecute(&_MintSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.PostExecute(&_MintSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.PreExecute(&_MintSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.PreExecute(&_MintSuperPositionsHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.ResetExecutionState(&_MintSuperPositionsHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.ResetExecutionState(&_MintSuperPositionsHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.SetExecutionContext(&_MintSuperPositionsHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.SetExecutionContext(&_MintSuperPositionsHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.SetOutAmount(&_MintSuperPositionsHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MintSuperPositionsHook *MintSuperPositionsHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MintSuperPositionsHook.Contract.SetOutAmount(&_MintSuperPositionsHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,966 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MorphoBorrowHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MorphoBorrowHookMetaData contains all meta data concerning the MorphoBorrowHook contract.
+var MorphoBorrowHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"morpho_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCollateralTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getCollateralTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getLoanTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getLoanTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morpho\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoBase\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorphoBase\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoInterface\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorpho\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LTV_RATIO_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a060405234801561000f575f5ffd5b506040516115b43803806115b483398101604081905261002e916100ed565b6040805180820190915260048152632637b0b760e11b6020909101525f805460ff191690557faf83e6e4bc80a23e2ed9719678c2dd27d06f4ea1f8280f57cf8a988618831b7d608081905281906001600160a01b0382166100a257604051630f58648f60e01b815260040160405180910390fd5b505f80546001600160a01b0392831661010002610100600160a81b031990911617905560018054929091166001600160a01b031992831681179091556002805490921617905561011a565b5f602082840312156100fd575f5ffd5b81516001600160a01b0381168114610113575f5ffd5b9392505050565b60805161147b6101395f395f81816102780152610348015261147b5ff3fe608060405234801561000f575f5ffd5b5060043610610186575f3560e01c806381cbe691116100d9578063cf0a618111610093578063e445e7dd1161006e578063e445e7dd14610390578063e512cf16146103a9578063e7745517146103bc578063f9837896146103cf575f5ffd5b8063cf0a618114610343578063d06a1e891461036a578063d8fbc8331461037d575f5ffd5b806381cbe691146102c657806384542a24146102d9578063867bb72c146102eb57806387ef841a146102fe5780638c4313c1146103115780638e14877614610331575f5ffd5b80632113522a116101445780633b5896bc1161011f5780633b5896bc146102565780635492e6771461027657806360b5be291461029c578063685a943c146102be575f5ffd5b80632113522a1461021f5780632ae2fe3d1461023157806338d52e0f14610244575f5ffd5b8062f5f8b41461018a57806301bd43ef146101ba57806303341d66146101d157806305b4fe91146101e457806314cb37cf146101f95780631f2646191461020c575b5f5ffd5b61019d6101983660046110f3565b6103e6565b6040516001600160a01b0390911681526020015b60405180910390f35b6101c360035c81565b6040519081526020016101b1565b6101c36101df366004611140565b6103f7565b6101f76101f23660046111d0565b610477565b005b6101f761020736600461122d565b6104f1565b6101f761021a36600461124d565b610512565b61019d6001600160a01b0360045c1681565b6101f761023f3660046111d0565b61056b565b61019d6001600160a01b0360025c1681565b6102696102643660046111d0565b6105de565b6040516101b191906112a5565b7f00000000000000000000000000000000000000000000000000000000000000006101c3565b6102ae60ff600160a81b60025c041681565b60405190151581526020016101b1565b6101c35f5c81565b6101c36102d436600461122d565b6107f7565b6102ae60ff600160a01b60025c041681565b6101c36102f9366004611140565b610809565b61019d61030c3660046110f3565b610816565b61032461031f366004611332565b610822565b6040516101b19190611371565b61019d6001600160a01b0360015c1681565b6101c37f000000000000000000000000000000000000000000000000000000000000000081565b6101f761037836600461122d565b6108ed565b60015461019d906001600160a01b031681565b5f5461039c9060ff1681565b6040516101b19190611383565b60025461019d906001600160a01b031681565b6102ae6103ca3660046110f3565b61093f565b5f5461019d9061010090046001600160a01b031681565b5f6103f1825f61094b565b92915050565b5f5f610403835f61094b565b6040516370a0823160e01b81526001600160a01b038681166004830152919250908216906370a0823190602401602060405180830381865afa15801561044b573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061046f91906113a9565b949350505050565b336001600160a01b038416146104a05760405163e15e56c960e01b815260040160405180910390fd5b5f6104aa846109b4565b90506104b5816109c7565b156104d35760405163945b63f560e01b815260040160405180910390fd5b6104de8160016109d4565b6104ea858585856109ea565b5050505050565b6104fa81610a4b565b50336004805c6001600160a01b0319168217905d5050565b5f61051c826109b4565b905061052781610a7d565b806105365750610536816109c7565b156105545760405163441e4c7360e11b815260040160405180910390fd5b5f610560826001610a86565b905083815d50505050565b336001600160a01b038416146105945760405163e15e56c960e01b815260040160405180910390fd5b5f61059e846109b4565b90506105a981610a7d565b156105c757604051630bbb04d960e11b815260040160405180910390fd5b6105d2816001610adb565b6104ea85858585610ae7565b60605f6105ed86868686610b29565b9050805160026105fd91906113d4565b67ffffffffffffffff81111561061557610615611054565b60405190808252806020026020018201604052801561066157816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816106335790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016106aa94939291906113e7565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106106ec576106ec61142f565b60209081029190910101525f5b815181101561074d578181815181106107145761071461142f565b60200260200101518382600161072a91906113d4565b8151811061073a5761073a61142f565b60209081029190910101526001016106f9565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161079494939291906113e7565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516107d39190611443565b815181106107e3576107e361142f565b602002602001018190525050949350505050565b5f6103f1610804836109b4565b610db3565b5f5f61040383601461094b565b5f6103f182601461094b565b60605f61086384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610dc092505050565b90505f610886825f01518360200151846040015185606001518660e00151610ec1565b8051602080830151604080850151606095860151825195871b6bffffffffffffffffffffffff199081169587019590955292861b84166034860152851b83166048850152931b16605c82015281518082036050018152607090910190915295945050505050565b5f6108f7826109b4565b905061090281610a7d565b15806109145750610912816109c7565b155b1561093257604051634bd439b560e11b815260040160405180910390fd5b61093b81610f28565b5050565b5f6103f1826090610f3f565b5f6109578260146113d4565b835110156109a45760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f5f6109bf83610f6b565b5c9392505050565b5f5f6109bf836003610a86565b5f6109e0836003610a86565b905081815d505050565b610a456109f6846107f7565b610a358585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506103f792505050565b610a3f9190611443565b84610fd7565b50505050565b5f6003805c9082610a5b83611456565b9190505d505f610a6a83610f6b565b905060035c80825d505060035c92915050565b5f5f6109bf8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6109e0836002610a86565b610a45610a3f8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506103f792505050565b60605f610b6a84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610dc092505050565b90508060c0015115610be4576040516381cbe69160e01b81526001600160a01b0386811660048301528716906381cbe69190602401602060405180830381865afa158015610bba573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610bde91906113a9565b60808201525b80608001515f03610c08576040516305112ab160e41b815260040160405180910390fd5b80516001600160a01b03161580610c2a575060208101516001600160a01b0316155b80610c40575060408101516001600160a01b0316155b80610c56575060608101516001600160a01b0316155b15610c7457604051630f58648f60e01b815260040160405180910390fd5b5f610c95825f01518360200151846040015185606001518660e00151610ec1565b604080516001808252818301909252919250816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610cac57505060408051606080820183526001546001600160a01b0390811683525f602080850182905260808981015187518a5186166024820152928a0151851660448401528988015185166064840152948901518416608483015288015160a482015260c481019390935260e48301528a16610104820181905261012482015292955091908201906101440160408051601f198184030181529190526020810180516001600160e01b03166350d8cd4b60e01b1790529052835184905f90610d9e57610d9e61142f565b60200260200101819052505050949350505050565b5f5f6109bf836001610a86565b60408051610100810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c0810182905260e0810182905290610e0a838261094b565b90505f610e1884601461094b565b90505f610e2685602861094b565b90505f610e3486603c61094b565b90505f610e4087610fef565b90505f610e4e886070610ff7565b90505f610e5c896090610f3f565b90505f610e6a8a6091610ff7565b60408051610100810182526001600160a01b039a8b168152988a1660208a01529689169688019690965250929095166060850152608084015260a083019390935291151560c082015260e081019190915292915050565b6040805160a0810182525f80825260208201819052918101829052606081018290526080810191909152506040805160a0810182526001600160a01b0396871681529486166020860152928516928401929092529092166060820152608081019190915290565b610f32815f610adb565b610f3c815f6109d4565b50565b5f828281518110610f5257610f5261142f565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610fba92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610fe1826109b4565b90505f610560826001610a86565b5f6103f18260505b5f6110038260206113d4565b8351101561104b5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b604482015260640161099b565b50016020015190565b634e487b7160e01b5f52604160045260245ffd5b5f82601f830112611077575f5ffd5b813567ffffffffffffffff81111561109157611091611054565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156110c0576110c0611054565b6040528181528382016020018510156110d7575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611103575f5ffd5b813567ffffffffffffffff811115611119575f5ffd5b61046f84828501611068565b80356001600160a01b038116811461113b575f5ffd5b919050565b5f5f60408385031215611151575f5ffd5b61115a83611125565b9150602083013567ffffffffffffffff811115611175575f5ffd5b61118185828601611068565b9150509250929050565b5f5f83601f84011261119b575f5ffd5b50813567ffffffffffffffff8111156111b2575f5ffd5b6020830191508360208285010111156111c9575f5ffd5b9250929050565b5f5f5f5f606085870312156111e3575f5ffd5b6111ec85611125565b93506111fa60208601611125565b9250604085013567ffffffffffffffff811115611215575f5ffd5b6112218782880161118b565b95989497509550505050565b5f6020828403121561123d575f5ffd5b61124682611125565b9392505050565b5f5f6040838503121561125e575f5ffd5b8235915061126e60208401611125565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561132657868503603f19018452815180516001600160a01b031686526020808201519087015260409081015160609187018290529061131090870182611277565b95505060209384019391909101906001016112cb565b50929695505050505050565b5f5f60208385031215611343575f5ffd5b823567ffffffffffffffff811115611359575f5ffd5b6113658582860161118b565b90969095509350505050565b602081525f6112466020830184611277565b60208101600383106113a357634e487b7160e01b5f52602160045260245ffd5b91905290565b5f602082840312156113b9575f5ffd5b5051919050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156103f1576103f16113c0565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156103f1576103f16113c0565b5f60018201611467576114676113c0565b506001019056fea164736f6c634300081e000a",
+}
+
+// MorphoBorrowHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MorphoBorrowHookMetaData.ABI instead.
+var MorphoBorrowHookABI = MorphoBorrowHookMetaData.ABI
+
+// MorphoBorrowHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MorphoBorrowHookMetaData.Bin instead.
+var MorphoBorrowHookBin = MorphoBorrowHookMetaData.Bin
+
+// DeployMorphoBorrowHook deploys a new Ethereum contract, binding an instance of MorphoBorrowHook to it.
+func DeployMorphoBorrowHook(auth *bind.TransactOpts, backend bind.ContractBackend, morpho_ common.Address) (common.Address, *types.Transaction, *MorphoBorrowHook, error) {
+	parsed, err := MorphoBorrowHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MorphoBorrowHookBin), backend, morpho_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MorphoBorrowHook{MorphoBorrowHookCaller: MorphoBorrowHookCaller{contract: contract}, MorphoBorrowHookTransactor: MorphoBorrowHookTransactor{contract: contract}, MorphoBorrowHookFilterer: MorphoBorrowHookFilterer{contract: contract}}, nil
+}
+
+// MorphoBorrowHook is an auto generated Go binding around an Ethereum contract.
+type MorphoBorrowHook struct {
+	MorphoBorrowHookCaller     // Read-only binding to the contract
+	MorphoBorrowHookTransactor // Write-only binding to the contract
+	MorphoBorrowHookFilterer   // Log filterer for contract events
+}
+
+// MorphoBorrowHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MorphoBorrowHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoBorrowHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MorphoBorrowHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoBorrowHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MorphoBorrowHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoBorrowHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MorphoBorrowHookSession struct {
+	Contract     *MorphoBorrowHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MorphoBorrowHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MorphoBorrowHookCallerSession struct {
+	Contract *MorphoBorrowHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// MorphoBorrowHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MorphoBorrowHookTransactorSession struct {
+	Contract     *MorphoBorrowHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// MorphoBorrowHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MorphoBorrowHookRaw struct {
+	Contract *MorphoBorrowHook // Generic contract binding to access the raw methods on
+}
+
+// MorphoBorrowHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MorphoBorrowHookCallerRaw struct {
+	Contract *MorphoBorrowHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MorphoBorrowHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MorphoBorrowHookTransactorRaw struct {
+	Contract *MorphoBorrowHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMorphoBorrowHook creates a new instance of MorphoBorrowHook, bound to a specific deployed contract.
+func NewMorphoBorrowHook(address common.Address, backend bind.ContractBackend) (*MorphoBorrowHook, error) {
+	contract, err := bindMorphoBorrowHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoBorrowHook{MorphoBorrowHookCaller: MorphoBorrowHookCaller{contract: contract}, MorphoBorrowHookTransactor: MorphoBorrowHookTransactor{contract: contract}, MorphoBorrowHookFilterer: MorphoBorrowHookFilterer{contract: contract}}, nil
+}
+
+// NewMorphoBorrowHookCaller creates a new read-only instance of MorphoBorrowHook, bound to a specific deployed contract.
+func NewMorphoBorrowHookCaller(address common.Address, caller bind.ContractCaller) (*MorphoBorrowHookCaller, error) {
+	contract, err := bindMorphoBorrowHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoBorrowHookCaller{contract: contract}, nil
+}
+
+// NewMorphoBorrowHookTransactor creates a new write-only instance of MorphoBorrowHook, bound to a specific deployed contract.
+func NewMorphoBorrowHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MorphoBorrowHookTransactor, error) {
+	contract, err := bindMorphoBorrowHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoBorrowHookTransactor{contract: contract}, nil
+}
+
+// NewMorphoBorrowHookFilterer creates a new log filterer instance of MorphoBorrowHook, bound to a specific deployed contract.
+func NewMorphoBorrowHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MorphoBorrowHookFilterer, error) {
+	contract, err := bindMorphoBorrowHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoBorrowHookFilterer{contract: contract}, nil
+}
+
+// bindMorphoBorrowHook binds a generic wrapper to an already deployed contract.
+func bindMorphoBorrowHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MorphoBorrowHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoBorrowHook *MorphoBorrowHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoBorrowHook.Contract.MorphoBorrowHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoBorrowHook *MorphoBorrowHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.MorphoBorrowHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoBorrowHook *MorphoBorrowHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.MorphoBorrowHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoBorrowHook *MorphoBorrowHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoBorrowHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) Asset() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.Asset(&_MorphoBorrowHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) Asset() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.Asset(&_MorphoBorrowHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoBorrowHook.Contract.Build(&_MorphoBorrowHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoBorrowHook.Contract.Build(&_MorphoBorrowHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoBorrowHook.Contract.DecodeUsePrevHookAmount(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoBorrowHook.Contract.DecodeUsePrevHookAmount(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.ExecutionNonce(&_MorphoBorrowHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.ExecutionNonce(&_MorphoBorrowHook.CallOpts)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) GetCollateralTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "getCollateralTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoBorrowHook.Contract.GetCollateralTokenAddress(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoBorrowHook.Contract.GetCollateralTokenAddress(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) GetCollateralTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "getCollateralTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.GetCollateralTokenBalance(&_MorphoBorrowHook.CallOpts, account, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.GetCollateralTokenBalance(&_MorphoBorrowHook.CallOpts, account, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) GetLoanTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "getLoanTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoBorrowHook.Contract.GetLoanTokenAddress(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoBorrowHook.Contract.GetLoanTokenAddress(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) GetLoanTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "getLoanTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.GetLoanTokenBalance(&_MorphoBorrowHook.CallOpts, account, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.GetLoanTokenBalance(&_MorphoBorrowHook.CallOpts, account, data)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.GetOutAmount(&_MorphoBorrowHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.GetOutAmount(&_MorphoBorrowHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) HookType() (uint8, error) {
+	return _MorphoBorrowHook.Contract.HookType(&_MorphoBorrowHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) HookType() (uint8, error) {
+	return _MorphoBorrowHook.Contract.HookType(&_MorphoBorrowHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoBorrowHook.Contract.Inspect(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoBorrowHook.Contract.Inspect(&_MorphoBorrowHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) LastCaller() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.LastCaller(&_MorphoBorrowHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) LastCaller() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.LastCaller(&_MorphoBorrowHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) Morpho(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "morpho")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) Morpho() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.Morpho(&_MorphoBorrowHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) Morpho() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.Morpho(&_MorphoBorrowHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) MorphoBase(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "morphoBase")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) MorphoBase() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.MorphoBase(&_MorphoBorrowHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) MorphoBase() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.MorphoBase(&_MorphoBorrowHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) MorphoInterface(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "morphoInterface")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) MorphoInterface() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.MorphoInterface(&_MorphoBorrowHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) MorphoInterface() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.MorphoInterface(&_MorphoBorrowHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) PostExecuteMutex() (bool, error) {
+	return _MorphoBorrowHook.Contract.PostExecuteMutex(&_MorphoBorrowHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _MorphoBorrowHook.Contract.PostExecuteMutex(&_MorphoBorrowHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) PreExecuteMutex() (bool, error) {
+	return _MorphoBorrowHook.Contract.PreExecuteMutex(&_MorphoBorrowHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _MorphoBorrowHook.Contract.PreExecuteMutex(&_MorphoBorrowHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) SpToken() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.SpToken(&_MorphoBorrowHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) SpToken() (common.Address, error) {
+	return _MorphoBorrowHook.Contract.SpToken(&_MorphoBorrowHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) SubType() ([32]byte, error) {
+	return _MorphoBorrowHook.Contract.SubType(&_MorphoBorrowHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) SubType() ([32]byte, error) {
+	return _MorphoBorrowHook.Contract.SubType(&_MorphoBorrowHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) Subtype() ([32]byte, error) {
+	return _MorphoBorrowHook.Contract.Subtype(&_MorphoBorrowHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) Subtype() ([32]byte, error) {
+	return _MorphoBorrowHook.Contract.Subtype(&_MorphoBorrowHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoBorrowHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookSession) UsedShares() (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.UsedShares(&_MorphoBorrowHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoBorrowHook *MorphoBorrowHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MorphoBorrowHook.Contract.UsedShares(&_MorphoBorrowHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoBorrowHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.PostExecute(&_MorphoBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.PostExecute(&_MorphoBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoBorrowHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.PreExecute(&_MorphoBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.PreExecute(&_MorphoBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.ResetExecutionState(&_MorphoBorrowHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.ResetExecutionState(&_MorphoBorrowHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.SetExecutionContext(&_MorphoBorrowHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.SetExecutionContext(&_MorphoBorrowHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.SetOutAmount(&_MorphoBorrowHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoBorrowHook *MorphoBorrowHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoBorrowHook.Contract.SetOutAmount(&_MorphoBorrowHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,1192 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MorphoRepayAndWithdrawHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MarketParams is an auto generated low-level Go binding around an user-defined struct.
+type MarketParams struct {
+	LoanToken       common.Address
+	CollateralToken common.Address
+	Oracle          common.Address
+	Irm             common.Address
+	Lltv            *big.Int
+}
+
+// MorphoRepayAndWithdrawHookMetaData contains all meta data concerning the MorphoRepayAndWithdrawHook contract.
+var MorphoRepayAndWithdrawHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"morpho_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"assetsToShares\",\"inputs\":[{\"name\":\"marketParams\",\"type\":\"tuple\",\"internalType\":\"structMarketParams\",\"components\":[{\"name\":\"loanToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"collateralToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"irm\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"lltv\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"deriveCollateralForFullRepayment\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"Id\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"collateralAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deriveCollateralForPartialRepayment\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"Id\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"fullCollateral\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"withdrawableCollateral\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deriveLoanAmount\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"Id\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"loanAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"deriveShareBalance\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"Id\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"borrowShares\",\"type\":\"uint128\",\"internalType\":\"uint128\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCollateralTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getCollateralTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getLoanTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getLoanTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morpho\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoBase\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorphoBase\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoInterface\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorpho\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoStaticTyping\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorphoStaticTyping\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"sharesToAssets\",\"inputs\":[{\"name\":\"marketParams\",\"type\":\"tuple\",\"internalType\":\"structMarketParams\",\"components\":[{\"name\":\"loanToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"collateralToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"irm\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"lltv\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a060405234801561000f575f5ffd5b506040516123de3803806123de83398101604081905261002e9161010a565b6040805180820190915260098152684c6f616e526570617960b81b6020909101525f805460ff191690557fc4ca98b212a28e3a28db4ed5cc1e6eefa183a4af47da3bb56451d704736462d0608081905281906001600160a01b0382166100a757604051630f58648f60e01b815260040160405180910390fd5b505f8054600180546001600160a01b03199081166001600160a01b0396871690811790925560028054821683179055610100600160a81b031992831694909516610100908102929092169390931790830217905560038054909216179055610137565b5f6020828403121561011a575f5ffd5b81516001600160a01b0381168114610130575f5ffd5b9392505050565b6080516122886101565f395f8181610309015261041201526122885ff3fe608060405234801561000f575f5ffd5b50600436106101f1575f3560e01c806384542a2411610114578063cf0a6181116100a9578063e512cf1611610079578063e512cf1614610473578063e774551714610486578063eb55e8cc14610499578063f9837896146104ac578063faf33140146104c3575f5ffd5b8063cf0a61811461040d578063d06a1e8914610434578063d8fbc83314610447578063e445e7dd1461045a575f5ffd5b80638e148776116100e45780638e148776146103c2578063930c819e146103d4578063b263ee3e146103e7578063b2ee3a19146103fa575f5ffd5b806384542a241461036a578063867bb72c1461037c57806387ef841a1461038f5780638c4313c1146103a2575f5ffd5b80632ae2fe3d1161018a5780635492e6771161015a5780635492e6771461030757806360b5be291461032d578063685a943c1461034f57806381cbe69114610357575f5ffd5b80632ae2fe3d146102af57806338d52e0f146102c25780633b5896bc146102d45780634f475eae146102f4575f5ffd5b8063131ebef3116101c5578063131ebef31461026457806314cb37cf146102775780631f2646191461028a5780632113522a1461029d575f5ffd5b8062f5f8b4146101f557806301bd43ef1461022557806303341d661461023c57806305b4fe911461024f575b5f5ffd5b610208610203366004611bfb565b6104ee565b6040516001600160a01b0390911681526020015b60405180910390f35b61022e60035c81565b60405190815260200161021c565b61022e61024a366004611c48565b6104ff565b61026261025d366004611cd8565b61057f565b005b61022e610272366004611d35565b6105f9565b610262610285366004611d5f565b610682565b610262610298366004611d35565b6106a3565b6102086001600160a01b0360045c1681565b6102626102bd366004611cd8565b6106fc565b6102086001600160a01b0360025c1681565b6102e76102e2366004611cd8565b61076f565b60405161021c9190611da6565b61022e610302366004611ebb565b610988565b7f000000000000000000000000000000000000000000000000000000000000000061022e565b61033f60ff600160a81b60025c041681565b604051901515815260200161021c565b61022e5f5c81565b61022e610365366004611d5f565b610a43565b61033f60ff600160a01b60025c041681565b61022e61038a366004611c48565b610a55565b61020861039d366004611bfb565b610a62565b6103b56103b0366004611ee4565b610a6e565b60405161021c9190611f23565b6102086001600160a01b0360015c1681565b61022e6103e2366004611f35565b610b39565b600354610208906001600160a01b031681565b61022e610408366004611d35565b610b5c565b61022e7f000000000000000000000000000000000000000000000000000000000000000081565b610262610442366004611d5f565b610c85565b600154610208906001600160a01b031681565b5f546104669060ff1681565b60405161021c9190611f6d565b600254610208906001600160a01b031681565b61033f610494366004611bfb565b610cd7565b61022e6104a7366004611f93565b610ce3565b5f546102089061010090046001600160a01b031681565b6104d66104d1366004611d35565b610d4b565b6040516001600160801b03909116815260200161021c565b5f6104f9825f610dc7565b92915050565b5f5f61050b835f610dc7565b6040516370a0823160e01b81526001600160a01b038681166004830152919250908216906370a0823190602401602060405180830381865afa158015610553573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105779190611fbc565b949350505050565b336001600160a01b038416146105a85760405163e15e56c960e01b815260040160405180910390fd5b5f6105b284610e30565b90506105bd81610e43565b156105db5760405163945b63f560e01b815260040160405180910390fd5b6105e6816001610e50565b6105f285858585610e66565b5050505050565b6003546040516349e2903160e11b8152600481018490526001600160a01b0383811660248301525f9283929116906393c5206290604401606060405180830381865afa15801561064b573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061066f9190611fe9565b6001600160801b03169695505050505050565b61068b81610ec7565b50336004805c6001600160a01b0319168217905d5050565b5f6106ad82610e30565b90506106b881610ef9565b806106c757506106c781610e43565b156106e55760405163441e4c7360e11b815260040160405180910390fd5b5f6106f1826001610f02565b905083815d50505050565b336001600160a01b038416146107255760405163e15e56c960e01b815260040160405180910390fd5b5f61072f84610e30565b905061073a81610ef9565b1561075857604051630bbb04d960e11b815260040160405180910390fd5b610763816001610f57565b6105f285858585610f63565b60605f61077e86868686611077565b90508051600261078e9190612036565b67ffffffffffffffff8111156107a6576107a6611b5c565b6040519080825280602002602001820160405280156107f257816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816107c45790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161083b9493929190612049565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061087d5761087d612091565b60209081029190910101525f5b81518110156108de578181815181106108a5576108a5612091565b6020026020010151838260016108bb9190612036565b815181106108cb576108cb612091565b602090810291909101015260010161088a565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016109259493929190612049565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161096491906120a5565b8151811061097457610974612091565b602002602001018190525050949350505050565b5f5f6109958460a0902090565b5f8054604051632e3071cd60e11b81526004810184905292935090916101009091046001600160a01b031690635c60e39a9060240160c060405180830381865afa1580156109e5573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a0991906120b8565b9050610a3a81604001516001600160801b031682606001516001600160801b0316866117699092919063ffffffff16565b95945050505050565b5f6104f9610a5083610e30565b611797565b5f5f61050b836014610dc7565b5f6104f9826014610dc7565b60605f610aaf84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506117a492505050565b90505f610ad2825f01518360200151846040015185606001518660a001516118a5565b8051602080830151604080850151606095860151825195871b6bffffffffffffffffffffffff199081169587019590955292861b84166034860152851b83166048850152931b16605c82015281518082036050018152607090910190915295945050505050565b5f5f610b458686610b5c565b9050610b5283858361190c565b9695505050505050565b6003546040516349e2903160e11b8152600481018490526001600160a01b0383811660248301525f9283929116906393c5206290604401606060405180830381865afa158015610bae573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610bd29190611fe9565b505f8054604051632e3071cd60e11b8152600481018990529294506001600160801b038516935090916101009091046001600160a01b031690635c60e39a906024015b60c060405180830381865afa158015610c30573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c5491906120b8565b9050610b5281604001516001600160801b031682606001516001600160801b0316846119bc9092919063ffffffff16565b5f610c8f82610e30565b9050610c9a81610ef9565b1580610cac5750610caa81610e43565b155b15610cca57604051634bd439b560e11b815260040160405180910390fd5b610cd3816119d8565b5050565b5f6104f98260906119ef565b5f5f610cf08460a0902090565b90505f610cfd8285610d4b565b6001600160801b031690505f5f60019054906101000a90046001600160a01b03166001600160a01b0316635c60e39a846040518263ffffffff1660e01b8152600401610c1591815260200190565b6003546040516349e2903160e11b8152600481018490526001600160a01b0383811660248301525f9216906393c5206290604401606060405180830381865afa158015610d9a573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610dbe9190611fe9565b50949350505050565b5f610dd3826014612036565b83511015610e205760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f5f610e3b83611a1b565b5c9392505050565b5f5f610e3b836003610f02565b5f610e5c836003610f02565b905081815d505050565b610ec1610e7284610a43565b610eb18585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610a5592505050565b610ebb91906120a5565b84611a87565b50505050565b5f6003805c9082610ed783612157565b9190505d505f610ee683611a1b565b905060035c80825d505060035c92915050565b5f5f610e3b8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610e5c836002610f02565b5f610fa283838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506117a492505050565b90505f610fc5825f01518360200151846040015185606001518660a001516118a5565b5f54604051630a8e0d6f60e11b815291925061010090046001600160a01b03169063151c1ade90610ffa9084906004016121ae565b5f604051808303815f87803b158015611011575f5ffd5b505af1158015611023573d5f5f3e3d5ffd5b5050505061106f6110698686868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610a5592505050565b86611a87565b505050505050565b60605f6110b884848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506117a492505050565b80519091506001600160a01b031615806110dd575060208101516001600160a01b0316155b156110fb57604051630f58648f60e01b815260040160405180910390fd5b60408051610160810182525f60c0820181815260e08301829052610100830182905261012083018290526101408301829052825260208201819052918101829052606081018290526080810182905260a0810191909152611172825f01518360200151846040015185606001518660a001516118a5565b80825260a09020602082015260408051600580825260c0820190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081611194579050506040805160608101825284516001600160a01b0390811682525f602083018190526001548451921660248301526044820152929550919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052835184905f9061123d5761123d612091565b6020908102919091018101919091526040805160608101825284516001600160a01b0390811682525f9382018490526001548351911660248201526044810193909352919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b17905290528351849060039081106112c9576112c9612091565b60200260200101819052508160e00151156114e8576112ec816020015187610d4b565b6001600160801b03166080820181905260a0820152602081015161131090876105f9565b8160400181815250506040518060600160405280835f01516001600160a01b031681526020015f815260200160015f9054906101000a90046001600160a01b031661135f84602001518a610b5c565b6040516001600160a01b039092166024830152604482015260640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b17905290528351849060019081106113bb576113bb612091565b602090810291909101810191909152604080516060810182526001546001600160a01b031681525f928101839052835160a08501518351929493850193611409939091908c906024016121bc565b60408051601f198184030181529190526020810180516001600160e01b03166320b76e8160e01b179052905283518490600290811061144a5761144a612091565b602090810291909101810191909152604080516060810182526001546001600160a01b031681525f928101929092528251838201518251928401926114979291908b9081906024016121fd565b60408051601f198184030181529190526020810180516001600160e01b0316638720316d60e01b17905290528351849060049081106114d8576114d8612091565b602002602001018190525061175f565b8160c0015115611560576040516381cbe69160e01b81526001600160a01b0387811660048301528816906381cbe69190602401602060405180830381865afa158015611536573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061155a9190611fbc565b60808301525b81608001515f03611584576040516305112ab160e41b815260040160405180910390fd5b6115928160200151876105f9565b60608201819052602082015160808401516115af92899190610b39565b604082810191909152805160608101825283516001600160a01b0390811682525f6020830152600154608086015184519190921660248201526044810191909152909182019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b179052905283518490600190811061163957611639612091565b602090810291909101810191909152604080516060810182526001546001600160a01b031681525f928101839052835160808601518351929493850193611684938c906024016121bc565b60408051601f198184030181529190526020810180516001600160e01b03166320b76e8160e01b17905290528351849060029081106116c5576116c5612091565b602090810291909101810191909152604080516060810182526001546001600160a01b031681525f928101929092528251838201518251928401926117129291908b9081906024016121fd565b60408051601f198184030181529190526020810180516001600160e01b0316638720316d60e01b179052905283518490600490811061175357611753612091565b60200260200101819052505b5050949350505050565b5f61178d61177a620f424084612036565b611785600186612036565b869190611a9f565b90505b9392505050565b5f5f610e3b836001610f02565b60408051610100810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c0810182905260e08101829052906117ee8382610dc7565b90505f6117fc846014610dc7565b90505f61180a856028610dc7565b90505f61181886603c610dc7565b90505f61182487611aca565b90505f611832886070611ad2565b90505f6118408960906119ef565b90505f61184e8a60916119ef565b60408051610100810182526001600160a01b039a8b168152988a1660208a01529689169688019690965250929095166060850152608084015260a083019390935291151560c082015290151560e082015292915050565b6040805160a0810182525f80825260208201819052918101829052606081018290526080810191909152506040805160a0810182526001600160a01b0396871681529486166020860152928516928401929092529092166060820152608081019190915290565b5f5f5f6119198686611b2f565b91509150815f0361193d5783818161193357611933612231565b0492505050611790565b818411611954576119546003851502601118611b4b565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f61178d6119cb600185612036565b611785620f424085612036565b6119e2815f610f57565b6119ec815f610e50565b50565b5f828281518110611a0257611a02612091565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001611a6a92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f611a9182610e30565b90505f6106f1826001610f02565b5f81611aac6001826120a5565b611ab68587612245565b611ac09190612036565b61178d919061225c565b5f6104f98260505b5f611ade826020612036565b83511015611b265760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610e17565b50016020015190565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b634e487b7160e01b5f52604160045260245ffd5b5f82601f830112611b7f575f5ffd5b813567ffffffffffffffff811115611b9957611b99611b5c565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611bc857611bc8611b5c565b604052818152838201602001851015611bdf575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611c0b575f5ffd5b813567ffffffffffffffff811115611c21575f5ffd5b61057784828501611b70565b80356001600160a01b0381168114611c43575f5ffd5b919050565b5f5f60408385031215611c59575f5ffd5b611c6283611c2d565b9150602083013567ffffffffffffffff811115611c7d575f5ffd5b611c8985828601611b70565b9150509250929050565b5f5f83601f840112611ca3575f5ffd5b50813567ffffffffffffffff811115611cba575f5ffd5b602083019150836020828501011115611cd1575f5ffd5b9250929050565b5f5f5f5f60608587031215611ceb575f5ffd5b611cf485611c2d565b9350611d0260208601611c2d565b9250604085013567ffffffffffffffff811115611d1d575f5ffd5b611d2987828801611c93565b95989497509550505050565b5f5f60408385031215611d46575f5ffd5b82359150611d5660208401611c2d565b90509250929050565b5f60208284031215611d6f575f5ffd5b61179082611c2d565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611e2757868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611e1190870182611d78565b9550506020938401939190910190600101611dcc565b50929695505050505050565b5f60a08284031215611e43575f5ffd5b60405160a0810167ffffffffffffffff81118282101715611e6657611e66611b5c565b604052905080611e7583611c2d565b8152611e8360208401611c2d565b6020820152611e9460408401611c2d565b6040820152611ea560608401611c2d565b6060820152608092830135920191909152919050565b5f5f60c08385031215611ecc575f5ffd5b611ed68484611e33565b9460a0939093013593505050565b5f5f60208385031215611ef5575f5ffd5b823567ffffffffffffffff811115611f0b575f5ffd5b611f1785828601611c93565b90969095509350505050565b602081525f6117906020830184611d78565b5f5f5f5f60808587031215611f48575f5ffd5b84359350611f5860208601611c2d565b93969395505050506040820135916060013590565b6020810160038310611f8d57634e487b7160e01b5f52602160045260245ffd5b91905290565b5f5f60c08385031215611fa4575f5ffd5b611fae8484611e33565b9150611d5660a08401611c2d565b5f60208284031215611fcc575f5ffd5b5051919050565b80516001600160801b0381168114611c43575f5ffd5b5f5f5f60608486031215611ffb575f5ffd5b8351925061200b60208501611fd3565b915061201960408501611fd3565b90509250925092565b634e487b7160e01b5f52601160045260245ffd5b808201808211156104f9576104f9612022565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156104f9576104f9612022565b5f60c08284031280156120c9575f5ffd5b5060405160c0810167ffffffffffffffff811182821017156120ed576120ed611b5c565b6040526120f983611fd3565b815261210760208401611fd3565b602082015261211860408401611fd3565b604082015261212960608401611fd3565b606082015261213a60808401611fd3565b608082015261214b60a08401611fd3565b60a08201529392505050565b5f6001820161216857612168612022565b5060010190565b80516001600160a01b03908116835260208083015182169084015260408083015182169084015260608083015190911690830152608090810151910152565b60a081016104f9828461216f565b6121c6818661216f565b60a081019390935260c08301919091526001600160a01b031660e082015261012061010082018190525f9082015261014001919050565b610100810161220c828761216f565b60a08201949094526001600160a01b0392831660c0820152911660e090910152919050565b634e487b7160e01b5f52601260045260245ffd5b80820281158282048414176104f9576104f9612022565b5f8261227657634e487b7160e01b5f52601260045260245ffd5b50049056fea164736f6c634300081e000a",
+}
+
+// MorphoRepayAndWithdrawHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MorphoRepayAndWithdrawHookMetaData.ABI instead.
+var MorphoRepayAndWithdrawHookABI = MorphoRepayAndWithdrawHookMetaData.ABI
+
+// MorphoRepayAndWithdrawHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MorphoRepayAndWithdrawHookMetaData.Bin instead.
+var MorphoRepayAndWithdrawHookBin = MorphoRepayAndWithdrawHookMetaData.Bin
+
+// DeployMorphoRepayAndWithdrawHook deploys a new Ethereum contract, binding an instance of MorphoRepayAndWithdrawHook to it.
+func DeployMorphoRepayAndWithdrawHook(auth *bind.TransactOpts, backend bind.ContractBackend, morpho_ common.Address) (common.Address, *types.Transaction, *MorphoRepayAndWithdrawHook, error) {
+	parsed, err := MorphoRepayAndWithdrawHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MorphoRepayAndWithdrawHookBin), backend, morpho_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MorphoRepayAndWithdrawHook{MorphoRepayAndWithdrawHookCaller: MorphoRepayAndWithdrawHookCaller{contract: contract}, MorphoRepayAndWithdrawHookTransactor: MorphoRepayAndWithdrawHookTransactor{contract: contract}, MorphoRepayAndWithdrawHookFilterer: MorphoRepayAndWithdrawHookFilterer{contract: contract}}, nil
+}
+
+// MorphoRepayAndWithdrawHook is an auto generated Go binding around an Ethereum contract.
+type MorphoRepayAndWithdrawHook struct {
+	MorphoRepayAndWithdrawHookCaller     // Read-only binding to the contract
+	MorphoRepayAndWithdrawHookTransactor // Write-only binding to the contract
+	MorphoRepayAndWithdrawHookFilterer   // Log filterer for contract events
+}
+
+// MorphoRepayAndWithdrawHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MorphoRepayAndWithdrawHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoRepayAndWithdrawHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MorphoRepayAndWithdrawHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoRepayAndWithdrawHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MorphoRepayAndWithdrawHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoRepayAndWithdrawHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MorphoRepayAndWithdrawHookSession struct {
+	Contract     *MorphoRepayAndWithdrawHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts               // Call options to use throughout this session
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// MorphoRepayAndWithdrawHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MorphoRepayAndWithdrawHookCallerSession struct {
+	Contract *MorphoRepayAndWithdrawHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                     // Call options to use throughout this session
+}
+
+// MorphoRepayAndWithdrawHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MorphoRepayAndWithdrawHookTransactorSession struct {
+	Contract     *MorphoRepayAndWithdrawHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                     // Transaction auth options to use throughout this session
+}
+
+// MorphoRepayAndWithdrawHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MorphoRepayAndWithdrawHookRaw struct {
+	Contract *MorphoRepayAndWithdrawHook // Generic contract binding to access the raw methods on
+}
+
+// MorphoRepayAndWithdrawHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MorphoRepayAndWithdrawHookCallerRaw struct {
+	Contract *MorphoRepayAndWithdrawHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MorphoRepayAndWithdrawHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MorphoRepayAndWithdrawHookTransactorRaw struct {
+	Contract *MorphoRepayAndWithdrawHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMorphoRepayAndWithdrawHook creates a new instance of MorphoRepayAndWithdrawHook, bound to a specific deployed contract.
+func NewMorphoRepayAndWithdrawHook(address common.Address, backend bind.ContractBackend) (*MorphoRepayAndWithdrawHook, error) {
+	contract, err := bindMorphoRepayAndWithdrawHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayAndWithdrawHook{MorphoRepayAndWithdrawHookCaller: MorphoRepayAndWithdrawHookCaller{contract: contract}, MorphoRepayAndWithdrawHookTransactor: MorphoRepayAndWithdrawHookTransactor{contract: contract}, MorphoRepayAndWithdrawHookFilterer: MorphoRepayAndWithdrawHookFilterer{contract: contract}}, nil
+}
+
+// NewMorphoRepayAndWithdrawHookCaller creates a new read-only instance of MorphoRepayAndWithdrawHook, bound to a specific deployed contract.
+func NewMorphoRepayAndWithdrawHookCaller(address common.Address, caller bind.ContractCaller) (*MorphoRepayAndWithdrawHookCaller, error) {
+	contract, err := bindMorphoRepayAndWithdrawHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayAndWithdrawHookCaller{contract: contract}, nil
+}
+
+// NewMorphoRepayAndWithdrawHookTransactor creates a new write-only instance of MorphoRepayAndWithdrawHook, bound to a specific deployed contract.
+func NewMorphoRepayAndWithdrawHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MorphoRepayAndWithdrawHookTransactor, error) {
+	contract, err := bindMorphoRepayAndWithdrawHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayAndWithdrawHookTransactor{contract: contract}, nil
+}
+
+// NewMorphoRepayAndWithdrawHookFilterer creates a new log filterer instance of MorphoRepayAndWithdrawHook, bound to a specific deployed contract.
+func NewMorphoRepayAndWithdrawHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MorphoRepayAndWithdrawHookFilterer, error) {
+	contract, err := bindMorphoRepayAndWithdrawHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayAndWithdrawHookFilterer{contract: contract}, nil
+}
+
+// bindMorphoRepayAndWithdrawHook binds a generic wrapper to an already deployed contract.
+func bindMorphoRepayAndWithdrawHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MorphoRepayAndWithdrawHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoRepayAndWithdrawHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoRepayAndWithdrawHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoRepayAndWithdrawHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoRepayAndWithdrawHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) Asset() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Asset(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) Asset() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Asset(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// AssetsToShares is a free data retrieval call binding the contract method 0x4f475eae.
+//
+// Solidity: function assetsToShares((address,address,address,address,uint256) marketParams, uint256 assets) view returns(uint256 shares)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) AssetsToShares(opts *bind.CallOpts, marketParams MarketParams, assets *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "assetsToShares", marketParams, assets)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// AssetsToShares is a free data retrieval call binding the contract method 0x4f475eae.
+//
+// Solidity: function assetsToShares((address,address,address,address,uint256) marketParams, uint256 assets) view returns(uint256 shares)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) AssetsToShares(marketParams MarketParams, assets *big.Int) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.AssetsToShares(&_MorphoRepayAndWithdrawHook.CallOpts, marketParams, assets)
+}
+
+// AssetsToShares is a free data retrieval call binding the contract method 0x4f475eae.
+//
+// Solidity: function assetsToShares((address,address,address,address,uint256) marketParams, uint256 assets) view returns(uint256 shares)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) AssetsToShares(marketParams MarketParams, assets *big.Int) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.AssetsToShares(&_MorphoRepayAndWithdrawHook.CallOpts, marketParams, assets)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Build(&_MorphoRepayAndWithdrawHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Build(&_MorphoRepayAndWithdrawHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DecodeUsePrevHookAmount(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DecodeUsePrevHookAmount(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// DeriveCollateralForFullRepayment is a free data retrieval call binding the contract method 0x131ebef3.
+//
+// Solidity: function deriveCollateralForFullRepayment(bytes32 id, address account) view returns(uint256 collateralAmount)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) DeriveCollateralForFullRepayment(opts *bind.CallOpts, id [32]byte, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "deriveCollateralForFullRepayment", id, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DeriveCollateralForFullRepayment is a free data retrieval call binding the contract method 0x131ebef3.
+//
+// Solidity: function deriveCollateralForFullRepayment(bytes32 id, address account) view returns(uint256 collateralAmount)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) DeriveCollateralForFullRepayment(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveCollateralForFullRepayment(&_MorphoRepayAndWithdrawHook.CallOpts, id, account)
+}
+
+// DeriveCollateralForFullRepayment is a free data retrieval call binding the contract method 0x131ebef3.
+//
+// Solidity: function deriveCollateralForFullRepayment(bytes32 id, address account) view returns(uint256 collateralAmount)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) DeriveCollateralForFullRepayment(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveCollateralForFullRepayment(&_MorphoRepayAndWithdrawHook.CallOpts, id, account)
+}
+
+// DeriveCollateralForPartialRepayment is a free data retrieval call binding the contract method 0x930c819e.
+//
+// Solidity: function deriveCollateralForPartialRepayment(bytes32 id, address account, uint256 amount, uint256 fullCollateral) view returns(uint256 withdrawableCollateral)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) DeriveCollateralForPartialRepayment(opts *bind.CallOpts, id [32]byte, account common.Address, amount *big.Int, fullCollateral *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "deriveCollateralForPartialRepayment", id, account, amount, fullCollateral)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DeriveCollateralForPartialRepayment is a free data retrieval call binding the contract method 0x930c819e.
+//
+// Solidity: function deriveCollateralForPartialRepayment(bytes32 id, address account, uint256 amount, uint256 fullCollateral) view returns(uint256 withdrawableCollateral)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) DeriveCollateralForPartialRepayment(id [32]byte, account common.Address, amount *big.Int, fullCollateral *big.Int) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveCollateralForPartialRepayment(&_MorphoRepayAndWithdrawHook.CallOpts, id, account, amount, fullCollateral)
+}
+
+// DeriveCollateralForPartialRepayment is a free data retrieval call binding the contract method 0x930c819e.
+//
+// Solidity: function deriveCollateralForPartialRepayment(bytes32 id, address account, uint256 amount, uint256 fullCollateral) view returns(uint256 withdrawableCollateral)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) DeriveCollateralForPartialRepayment(id [32]byte, account common.Address, amount *big.Int, fullCollateral *big.Int) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveCollateralForPartialRepayment(&_MorphoRepayAndWithdrawHook.CallOpts, id, account, amount, fullCollateral)
+}
+
+// DeriveLoanAmount is a free data retrieval call binding the contract method 0xb2ee3a19.
+//
+// Solidity: function deriveLoanAmount(bytes32 id, address account) view returns(uint256 loanAmount)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) DeriveLoanAmount(opts *bind.CallOpts, id [32]byte, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "deriveLoanAmount", id, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DeriveLoanAmount is a free data retrieval call binding the contract method 0xb2ee3a19.
+//
+// Solidity: function deriveLoanAmount(bytes32 id, address account) view returns(uint256 loanAmount)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) DeriveLoanAmount(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveLoanAmount(&_MorphoRepayAndWithdrawHook.CallOpts, id, account)
+}
+
+// DeriveLoanAmount is a free data retrieval call binding the contract method 0xb2ee3a19.
+//
+// Solidity: function deriveLoanAmount(bytes32 id, address account) view returns(uint256 loanAmount)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) DeriveLoanAmount(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveLoanAmount(&_MorphoRepayAndWithdrawHook.CallOpts, id, account)
+}
+
+// DeriveShareBalance is a free data retrieval call binding the contract method 0xfaf33140.
+//
+// Solidity: function deriveShareBalance(bytes32 id, address account) view returns(uint128 borrowShares)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) DeriveShareBalance(opts *bind.CallOpts, id [32]byte, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "deriveShareBalance", id, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DeriveShareBalance is a free data retrieval call binding the contract method 0xfaf33140.
+//
+// Solidity: function deriveShareBalance(bytes32 id, address account) view returns(uint128 borrowShares)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) DeriveShareBalance(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveShareBalance(&_MorphoRepayAndWithdrawHook.CallOpts, id, account)
+}
+
+// DeriveShareBalance is a free data retrieval call binding the contract method 0xfaf33140.
+//
+// Solidity: function deriveShareBalance(bytes32 id, address account) view returns(uint128 borrowShares)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) DeriveShareBalance(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.DeriveShareBalance(&_MorphoRepayAndWithdrawHook.CallOpts, id, account)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.ExecutionNonce(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.ExecutionNonce(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) GetCollateralTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "getCollateralTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetCollateralTokenAddress(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetCollateralTokenAddress(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) GetCollateralTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "getCollateralTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetCollateralTokenBalance(&_MorphoRepayAndWithdrawHook.CallOpts, account, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetCollateralTokenBalance(&_MorphoRepayAndWithdrawHook.CallOpts, account, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) GetLoanTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "getLoanTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetLoanTokenAddress(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetLoanTokenAddress(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) GetLoanTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "getLoanTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetLoanTokenBalance(&_MorphoRepayAndWithdrawHook.CallOpts, account, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetLoanTokenBalance(&_MorphoRepayAndWithdrawHook.CallOpts, account, data)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetOutAmount(&_MorphoRepayAndWithdrawHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.GetOutAmount(&_MorphoRepayAndWithdrawHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) HookType() (uint8, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.HookType(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) HookType() (uint8, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.HookType(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Inspect(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Inspect(&_MorphoRepayAndWithdrawHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) LastCaller() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.LastCaller(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) LastCaller() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.LastCaller(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) Morpho(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "morpho")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) Morpho() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Morpho(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) Morpho() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Morpho(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) MorphoBase(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "morphoBase")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) MorphoBase() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoBase(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) MorphoBase() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoBase(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) MorphoInterface(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "morphoInterface")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) MorphoInterface() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoInterface(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) MorphoInterface() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoInterface(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// MorphoStaticTyping is a free data retrieval call binding the contract method 0xb263ee3e.
+//
+// Solidity: function morphoStaticTyping() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) MorphoStaticTyping(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "morphoStaticTyping")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoStaticTyping is a free data retrieval call binding the contract method 0xb263ee3e.
+//
+// Solidity: function morphoStaticTyping() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) MorphoStaticTyping() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoStaticTyping(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// MorphoStaticTyping is a free data retrieval call binding the contract method 0xb263ee3e.
+//
+// Solidity: function morphoStaticTyping() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) MorphoStaticTyping() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.MorphoStaticTyping(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) PostExecuteMutex() (bool, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PostExecuteMutex(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PostExecuteMutex(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) PreExecuteMutex() (bool, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PreExecuteMutex(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PreExecuteMutex(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// SharesToAssets is a free data retrieval call binding the contract method 0xeb55e8cc.
+//
+// Solidity: function sharesToAssets((address,address,address,address,uint256) marketParams, address account) view returns(uint256 assets)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) SharesToAssets(opts *bind.CallOpts, marketParams MarketParams, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "sharesToAssets", marketParams, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SharesToAssets is a free data retrieval call binding the contract method 0xeb55e8cc.
+//
+// Solidity: function sharesToAssets((address,address,address,address,uint256) marketParams, address account) view returns(uint256 assets)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) SharesToAssets(marketParams MarketParams, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SharesToAssets(&_MorphoRepayAndWithdrawHook.CallOpts, marketParams, account)
+}
+
+// SharesToAssets is a free data retrieval call binding the contract method 0xeb55e8cc.
+//
+// Solidity: function sharesToAssets((address,address,address,address,uint256) marketParams, address account) view returns(uint256 assets)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) SharesToAssets(marketParams MarketParams, account common.Address) (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SharesToAssets(&_MorphoRepayAndWithdrawHook.CallOpts, marketParams, account)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) SpToken() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SpToken(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) SpToken() (common.Address, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SpToken(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) SubType() ([32]byte, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SubType(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) SubType() ([32]byte, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SubType(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) Subtype() ([32]byte, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Subtype(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) Subtype() ([32]byte, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.Subtype(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayAndWithdrawHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) UsedShares() (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.UsedShares(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.UsedShares(&_MorphoRepayAndWithdrawHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PostExecute(&_MorphoRepayAndWithdrawHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PostExecute(&_MorphoRepayAndWithdrawHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PreExecute(&_MorphoRepayAndWithdrawHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.PreExecute(&_MorphoRepayAndWithdrawHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.ResetExecutionState(&_MorphoRepayAndWithdrawHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.ResetExecutionState(&_MorphoRepayAndWithdrawHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SetExecutionContext(&_MorphoRepayAndWithdrawHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SetExecutionContext(&_MorphoRepayAndWithdrawHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SetOutAmount(&_MorphoRepayAndWithdrawHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoRepayAndWithdrawHook *MorphoRepayAndWithdrawHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayAndWithdrawHook.Contract.SetOutAmount(&_MorphoRepayAndWithdrawHook.TransactOpts, _outAmount, caller)
+}
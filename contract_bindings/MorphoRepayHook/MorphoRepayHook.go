@@ -0,0 +1,1068 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MorphoRepayHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MarketParams is an auto generated low-level Go binding around an user-defined struct.
+type MarketParams struct {
+	LoanToken       common.Address
+	CollateralToken common.Address
+	Oracle          common.Address
+	Irm             common.Address
+	Lltv            *big.Int
+}
+
+// MorphoRepayHookMetaData contains all meta data concerning the MorphoRepayHook contract.
+var MorphoRepayHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"morpho_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"deriveShareBalance\",\"inputs\":[{\"name\":\"id\",\"type\":\"bytes32\",\"internalType\":\"Id\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"borrowShares\",\"type\":\"uint128\",\"internalType\":\"uint128\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCollateralTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getCollateralTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getLoanTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getLoanTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morpho\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoBase\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorphoBase\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoInterface\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorpho\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoStaticTyping\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorphoStaticTyping\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"sharesToAssets\",\"inputs\":[{\"name\":\"marketParams\",\"type\":\"tuple\",\"internalType\":\"structMarketParams\",\"components\":[{\"name\":\"loanToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"collateralToken\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"irm\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"lltv\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"assets\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a060405234801561000f575f5ffd5b50604051611d01380380611d0183398101604081905261002e9161010a565b6040805180820190915260098152684c6f616e526570617960b81b6020909101525f805460ff191690557fc4ca98b212a28e3a28db4ed5cc1e6eefa183a4af47da3bb56451d704736462d0608081905281906001600160a01b0382166100a757604051630f58648f60e01b815260040160405180910390fd5b505f8054600180546001600160a01b03199081166001600160a01b0396871690811790925560028054821683179055610100600160a81b031992831694909516610100908102929092169390931790830217905560038054909216179055610137565b5f6020828403121561011a575f5ffd5b81516001600160a01b0381168114610130575f5ffd5b9392505050565b608051611bab6101565f395f81816102b7015261039a0152611bab5ff3fe608060405234801561000f575f5ffd5b50600436106101c5575f3560e01c806384542a24116100fe578063d06a1e891161009e578063e77455171161006e578063e77455171461040e578063eb55e8cc14610421578063f983789614610434578063faf331401461044b575f5ffd5b8063d06a1e89146103bc578063d8fbc833146103cf578063e445e7dd146103e2578063e512cf16146103fb575f5ffd5b80638c4313c1116100d95780638c4313c1146103505780638e14877614610370578063b263ee3e14610382578063cf0a618114610395575f5ffd5b806384542a2414610318578063867bb72c1461032a57806387ef841a1461033d575f5ffd5b80632ae2fe3d116101695780635492e677116101445780635492e677146102b557806360b5be29146102db578063685a943c146102fd57806381cbe69114610305575f5ffd5b80632ae2fe3d1461027057806338d52e0f146102835780633b5896bc14610295575f5ffd5b806305b4fe91116101a457806305b4fe911461022357806314cb37cf146102385780631f2646191461024b5780632113522a1461025e575f5ffd5b8062f5f8b4146101c957806301bd43ef146101f957806303341d6614610210575b5f5ffd5b6101dc6101d73660046115d5565b610476565b6040516001600160a01b0390911681526020015b60405180910390f35b61020260035c81565b6040519081526020016101f0565b61020261021e366004611622565b610487565b6102366102313660046116b2565b610507565b005b61023661024636600461170f565b610575565b61023661025936600461172f565b610596565b6101dc6001600160a01b0360045c1681565b61023661027e3660046116b2565b6105ef565b6101dc6001600160a01b0360025c1681565b6102a86102a33660046116b2565b610662565b6040516101f09190611787565b7f0000000000000000000000000000000000000000000000000000000000000000610202565b6102ed60ff600160a81b60025c041681565b60405190151581526020016101f0565b6102025f5c81565b61020261031336600461170f565b61087b565b6102ed60ff600160a01b60025c041681565b610202610338366004611622565b61088d565b6101dc61034b3660046115d5565b61089a565b61036361035e366004611814565b6108a6565b6040516101f09190611853565b6101dc6001600160a01b0360015c1681565b6003546101dc906001600160a01b031681565b6102027f000000000000000000000000000000000000000000000000000000000000000081565b6102366103ca36600461170f565b610971565b6001546101dc906001600160a01b031681565b5f546103ee9060ff1681565b6040516101f09190611865565b6002546101dc906001600160a01b031681565b6102ed61041c3660046115d5565b6109c3565b61020261042f36600461188b565b6109cf565b5f546101dc9061010090046001600160a01b031681565b61045e61045936600461172f565b610ab1565b6040516001600160801b0390911681526020016101f0565b5f610481825f610b2d565b92915050565b5f5f610493835f610b2d565b6040516370a0823160e01b81526001600160a01b038681166004830152919250908216906370a0823190602401602060405180830381865afa1580156104db573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104ff9190611927565b949350505050565b336001600160a01b038416146105305760405163e15e56c960e01b815260040160405180910390fd5b5f61053a84610b96565b905061054581610ba9565b156105635760405163945b63f560e01b815260040160405180910390fd5b61056e816001610bb6565b5050505050565b61057e81610bcc565b50336004805c6001600160a01b0319168217905d5050565b5f6105a082610b96565b90506105ab81610bfe565b806105ba57506105ba81610ba9565b156105d85760405163441e4c7360e11b815260040160405180910390fd5b5f6105e4826001610c07565b905083815d50505050565b336001600160a01b038416146106185760405163e15e56c960e01b815260040160405180910390fd5b5f61062284610b96565b905061062d81610bfe565b1561064b57604051630bbb04d960e11b815260040160405180910390fd5b610656816001610c5c565b61056e85858585610c68565b60605f61067186868686610d34565b9050805160026106819190611952565b67ffffffffffffffff81111561069957610699611536565b6040519080825280602002602001820160405280156106e557816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816106b75790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161072e9493929190611965565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610770576107706119ad565b60209081029190910101525f5b81518110156107d157818181518110610798576107986119ad565b6020026020010151838260016107ae9190611952565b815181106107be576107be6119ad565b602090810291909101015260010161077d565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016108189493929190611965565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161085791906119c1565b81518110610867576108676119ad565b602002602001018190525050949350505050565b5f61048161088883610b96565b61125e565b5f5f610493836014610b2d565b5f610481826014610b2d565b60605f6108e784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061126b92505050565b90505f61090a825f01518360200151846040015185606001518660a0015161136c565b8051602080830151604080850151606095860151825195871b6bffffffffffffffffffffffff199081169587019590955292861b84166034860152851b83166048850152931b16605c82015281518082036050018152607090910190915295945050505050565b5f61097b82610b96565b905061098681610bfe565b1580610998575061099681610ba9565b155b156109b657604051634bd439b560e11b815260040160405180910390fd5b6109bf816113d3565b5050565b5f6104818260906113ea565b5f5f6109dc8460a0902090565b90505f6109e98285610ab1565b6001600160801b031690505f5f60019054906101000a90046001600160a01b03166001600160a01b0316635c60e39a846040518263ffffffff1660e01b8152600401610a3791815260200190565b60c060405180830381865afa158015610a52573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a7691906119ea565b9050610aa781604001516001600160801b031682606001516001600160801b0316846114169092919063ffffffff16565b9695505050505050565b6003546040516349e2903160e11b8152600481018490526001600160a01b0383811660248301525f9216906393c5206290604401606060405180830381865afa158015610b00573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b249190611a89565b50949350505050565b5f610b39826014611952565b83511015610b865760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f5f610ba18361143a565b5c9392505050565b5f5f610ba1836003610c07565b5f610bc2836003610c07565b905081815d505050565b5f6003805c9082610bdc83611ac2565b9190505d505f610beb8361143a565b905060035c80825d505060035c92915050565b5f5f610ba18360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610bc2836002610c07565b5f610ca783838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061126b92505050565b90505f610cca825f01518360200151846040015185606001518660a0015161136c565b5f54604051630a8e0d6f60e11b815291925061010090046001600160a01b03169063151c1ade90610cff908490600401611b19565b5f604051808303815f87803b158015610d16575f5ffd5b505af1158015610d28573d5f5f3e3d5ffd5b50505050505050505050565b60605f610d7584848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061126b92505050565b80519091506001600160a01b03161580610d9a575060208101516001600160a01b0316155b15610db857604051630f58648f60e01b815260040160405180910390fd5b5f610dd9825f01518360200151846040015185606001518660a0015161136c565b90505f610de78260a0902090565b60408051600480825260a08201909252919250816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610dff579050506040805160608101825285516001600160a01b0390811682525f602083018190526001548451921660248301526044820152929650919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185905f90610ea857610ea86119ad565b60200260200101819052508260e0015115611007575f610ec88289610ab1565b90506001600160801b0381165f610edf858b6109cf565b6040805160608101825288516001600160a01b0390811682525f602083015260015483519116602482015260448101849052929350919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052875188906001908110610f5d57610f5d6119ad565b6020026020010181905250604051806060016040528060015f9054906101000a90046001600160a01b03166001600160a01b031681526020015f8152602001865f858e604051602401610fb39493929190611b27565b60408051601f198184030181529190526020810180516001600160e01b03166320b76e8160e01b1790529052875188906002908110610ff457610ff46119ad565b60200260200101819052505050506111cd565b8260c001511561107f576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015611055573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906110799190611927565b60808401525b82608001515f036110a3576040516305112ab160e41b815260040160405180910390fd5b6040805160608101825284516001600160a01b0390811682525f6020830152600154608087015184519190921660248201526044810191909152909182019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185906001908110611126576111266119ad565b6020026020010181905250604051806060016040528060015f9054906101000a90046001600160a01b03166001600160a01b031681526020015f81526020018385608001515f8b6040516024016111809493929190611b27565b60408051601f198184030181529190526020810180516001600160e01b03166320b76e8160e01b17905290528451859060029081106111c1576111c16119ad565b60200260200101819052505b6040805160608101825284516001600160a01b0390811682525f602083018190526001548451921660248301526044820152909182019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185906003908110611248576112486119ad565b6020026020010181905250505050949350505050565b5f5f610ba1836001610c07565b60408051610100810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c0810182905260e08101829052906112b58382610b2d565b90505f6112c3846014610b2d565b90505f6112d1856028610b2d565b90505f6112df86603c610b2d565b90505f6112eb876114a6565b90505f6112f98860706114ae565b90505f6113078960906113ea565b90505f6113158a60916113ea565b60408051610100810182526001600160a01b039a8b168152988a1660208a01529689169688019690965250929095166060850152608084015260a083019390935291151560c082015290151560e082015292915050565b6040805160a0810182525f80825260208201819052918101829052606081018290526080810191909152506040805160a0810182526001600160a01b0396871681529486166020860152928516928401929092529092166060820152608081019190915290565b6113dd815f610c5c565b6113e7815f610bb6565b50565b5f8282815181106113fd576113fd6119ad565b01602001516001600160f81b0319161515905092915050565b5f6104ff611425600185611952565b611432620f424085611952565b86919061150b565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161148992919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f6104818260505b5f6114ba826020611952565b835110156115025760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610b7d565b50016020015190565b5f816115186001826119c1565b6115228587611b68565b61152c9190611952565b6104ff9190611b7f565b634e487b7160e01b5f52604160045260245ffd5b5f82601f830112611559575f5ffd5b813567ffffffffffffffff81111561157357611573611536565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156115a2576115a2611536565b6040528181528382016020018510156115b9575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f602082840312156115e5575f5ffd5b813567ffffffffffffffff8111156115fb575f5ffd5b6104ff8482850161154a565b80356001600160a01b038116811461161d575f5ffd5b919050565b5f5f60408385031215611633575f5ffd5b61163c83611607565b9150602083013567ffffffffffffffff811115611657575f5ffd5b6116638582860161154a565b9150509250929050565b5f5f83601f84011261167d575f5ffd5b50813567ffffffffffffffff811115611694575f5ffd5b6020830191508360208285010111156116ab575f5ffd5b9250929050565b5f5f5f5f606085870312156116c5575f5ffd5b6116ce85611607565b93506116dc60208601611607565b9250604085013567ffffffffffffffff8111156116f7575f5ffd5b6117038782880161166d565b95989497509550505050565b5f6020828403121561171f575f5ffd5b61172882611607565b9392505050565b5f5f60408385031215611740575f5ffd5b8235915061175060208401611607565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561180857868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906117f290870182611759565b95505060209384019391909101906001016117ad565b50929695505050505050565b5f5f60208385031215611825575f5ffd5b823567ffffffffffffffff81111561183b575f5ffd5b6118478582860161166d565b90969095509350505050565b602081525f6117286020830184611759565b602081016003831061188557634e487b7160e01b5f52602160045260245ffd5b91905290565b5f5f82840360c081121561189d575f5ffd5b60a08112156118aa575f5ffd5b5060405160a0810167ffffffffffffffff811182821017156118ce576118ce611536565b6040526118da84611607565b81526118e860208501611607565b60208201526118f960408501611607565b604082015261190a60608501611607565b606082015260808481013590820152915061175060a08401611607565b5f60208284031215611937575f5ffd5b5051919050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156104815761048161193e565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156104815761048161193e565b80516001600160801b038116811461161d575f5ffd5b5f60c08284031280156119fb575f5ffd5b5060405160c0810167ffffffffffffffff81118282101715611a1f57611a1f611536565b604052611a2b836119d4565b8152611a39602084016119d4565b6020820152611a4a604084016119d4565b6040820152611a5b606084016119d4565b6060820152611a6c608084016119d4565b6080820152611a7d60a084016119d4565b60a08201529392505050565b5f5f5f60608486031215611a9b575f5ffd5b83519250611aab602085016119d4565b9150611ab9604085016119d4565b90509250925092565b5f60018201611ad357611ad361193e565b5060010190565b80516001600160a01b03908116835260208083015182169084015260408083015182169084015260608083015190911690830152608090810151910152565b60a081016104818284611ada565b611b318186611ada565b60a081019390935260c08301919091526001600160a01b031660e082015261012061010082018190525f9082015261014001919050565b80820281158282048414176104815761048161193e565b5f82611b9957634e487b7160e01b5f52601260045260245ffd5b50049056fea164736f6c634300081e000a",
+}
+
+// MorphoRepayHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MorphoRepayHookMetaData.ABI instead.
+var MorphoRepayHookABI = MorphoRepayHookMetaData.ABI
+
+// MorphoRepayHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MorphoRepayHookMetaData.Bin instead.
+var MorphoRepayHookBin = MorphoRepayHookMetaData.Bin
+
+// DeployMorphoRepayHook deploys a new Ethereum contract, binding an instance of MorphoRepayHook to it.
+func DeployMorphoRepayHook(auth *bind.TransactOpts, backend bind.ContractBackend, morpho_ common.Address) (common.Address, *types.Transaction, *MorphoRepayHook, error) {
+	parsed, err := MorphoRepayHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MorphoRepayHookBin), backend, morpho_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MorphoRepayHook{MorphoRepayHookCaller: MorphoRepayHookCaller{contract: contract}, MorphoRepayHookTransactor: MorphoRepayHookTransactor{contract: contract}, MorphoRepayHookFilterer: MorphoRepayHookFilterer{contract: contract}}, nil
+}
+
+// MorphoRepayHook is an auto generated Go binding around an Ethereum contract.
+type MorphoRepayHook struct {
+	MorphoRepayHookCaller     // Read-only binding to the contract
+	MorphoRepayHookTransactor // Write-only binding to the contract
+	MorphoRepayHookFilterer   // Log filterer for contract events
+}
+
+// MorphoRepayHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MorphoRepayHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoRepayHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MorphoRepayHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoRepayHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MorphoRepayHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoRepayHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MorphoRepayHookSession struct {
+	Contract     *MorphoRepayHook  // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MorphoRepayHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MorphoRepayHookCallerSession struct {
+	Contract *MorphoRepayHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts          // Call options to use throughout this session
+}
+
+// MorphoRepayHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MorphoRepayHookTransactorSession struct {
+	Contract     *MorphoRepayHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// MorphoRepayHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MorphoRepayHookRaw struct {
+	Contract *MorphoRepayHook // Generic contract binding to access the raw methods on
+}
+
+// MorphoRepayHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MorphoRepayHookCallerRaw struct {
+	Contract *MorphoRepayHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MorphoRepayHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MorphoRepayHookTransactorRaw struct {
+	Contract *MorphoRepayHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMorphoRepayHook creates a new instance of MorphoRepayHook, bound to a specific deployed contract.
+func NewMorphoRepayHook(address common.Address, backend bind.ContractBackend) (*MorphoRepayHook, error) {
+	contract, err := bindMorphoRepayHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayHook{MorphoRepayHookCaller: MorphoRepayHookCaller{contract: contract}, MorphoRepayHookTransactor: MorphoRepayHookTransactor{contract: contract}, MorphoRepayHookFilterer: MorphoRepayHookFilterer{contract: contract}}, nil
+}
+
+// NewMorphoRepayHookCaller creates a new read-only instance of MorphoRepayHook, bound to a specific deployed contract.
+func NewMorphoRepayHookCaller(address common.Address, caller bind.ContractCaller) (*MorphoRepayHookCaller, error) {
+	contract, err := bindMorphoRepayHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayHookCaller{contract: contract}, nil
+}
+
+// NewMorphoRepayHookTransactor creates a new write-only instance of MorphoRepayHook, bound to a specific deployed contract.
+func NewMorphoRepayHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MorphoRepayHookTransactor, error) {
+	contract, err := bindMorphoRepayHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayHookTransactor{contract: contract}, nil
+}
+
+// NewMorphoRepayHookFilterer creates a new log filterer instance of MorphoRepayHook, bound to a specific deployed contract.
+func NewMorphoRepayHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MorphoRepayHookFilterer, error) {
+	contract, err := bindMorphoRepayHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoRepayHookFilterer{contract: contract}, nil
+}
+
+// bindMorphoRepayHook binds a generic wrapper to an already deployed contract.
+func bindMorphoRepayHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MorphoRepayHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoRepayHook *MorphoRepayHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoRepayHook.Contract.MorphoRepayHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoRepayHook *MorphoRepayHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.MorphoRepayHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoRepayHook *MorphoRepayHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.MorphoRepayHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoRepayHook *MorphoRepayHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoRepayHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoRepayHook *MorphoRepayHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoRepayHook *MorphoRepayHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) Asset() (common.Address, error) {
+	return _MorphoRepayHook.Contract.Asset(&_MorphoRepayHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) Asset() (common.Address, error) {
+	return _MorphoRepayHook.Contract.Asset(&_MorphoRepayHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoRepayHook *MorphoRepayHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoRepayHook *MorphoRepayHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoRepayHook.Contract.Build(&_MorphoRepayHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoRepayHook.Contract.Build(&_MorphoRepayHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoRepayHook.Contract.DecodeUsePrevHookAmount(&_MorphoRepayHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoRepayHook.Contract.DecodeUsePrevHookAmount(&_MorphoRepayHook.CallOpts, data)
+}
+
+// DeriveShareBalance is a free data retrieval call binding the contract method 0xfaf33140.
+//
+// Solidity: function deriveShareBalance(bytes32 id, address account) view returns(uint128 borrowShares)
+func (_MorphoRepayHook *MorphoRepayHookCaller) DeriveShareBalance(opts *bind.CallOpts, id [32]byte, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "deriveShareBalance", id, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DeriveShareBalance is a free data retrieval call binding the contract method 0xfaf33140.
+//
+// Solidity: function deriveShareBalance(bytes32 id, address account) view returns(uint128 borrowShares)
+func (_MorphoRepayHook *MorphoRepayHookSession) DeriveShareBalance(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.DeriveShareBalance(&_MorphoRepayHook.CallOpts, id, account)
+}
+
+// DeriveShareBalance is a free data retrieval call binding the contract method 0xfaf33140.
+//
+// Solidity: function deriveShareBalance(bytes32 id, address account) view returns(uint128 borrowShares)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) DeriveShareBalance(id [32]byte, account common.Address) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.DeriveShareBalance(&_MorphoRepayHook.CallOpts, id, account)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoRepayHook.Contract.ExecutionNonce(&_MorphoRepayHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoRepayHook.Contract.ExecutionNonce(&_MorphoRepayHook.CallOpts)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) GetCollateralTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "getCollateralTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayHook.Contract.GetCollateralTokenAddress(&_MorphoRepayHook.CallOpts, data)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayHook.Contract.GetCollateralTokenAddress(&_MorphoRepayHook.CallOpts, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCaller) GetCollateralTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "getCollateralTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.GetCollateralTokenBalance(&_MorphoRepayHook.CallOpts, account, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.GetCollateralTokenBalance(&_MorphoRepayHook.CallOpts, account, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) GetLoanTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "getLoanTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayHook.Contract.GetLoanTokenAddress(&_MorphoRepayHook.CallOpts, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoRepayHook.Contract.GetLoanTokenAddress(&_MorphoRepayHook.CallOpts, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCaller) GetLoanTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "getLoanTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.GetLoanTokenBalance(&_MorphoRepayHook.CallOpts, account, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.GetLoanTokenBalance(&_MorphoRepayHook.CallOpts, account, data)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.GetOutAmount(&_MorphoRepayHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.GetOutAmount(&_MorphoRepayHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoRepayHook *MorphoRepayHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoRepayHook *MorphoRepayHookSession) HookType() (uint8, error) {
+	return _MorphoRepayHook.Contract.HookType(&_MorphoRepayHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) HookType() (uint8, error) {
+	return _MorphoRepayHook.Contract.HookType(&_MorphoRepayHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoRepayHook *MorphoRepayHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoRepayHook *MorphoRepayHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoRepayHook.Contract.Inspect(&_MorphoRepayHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoRepayHook.Contract.Inspect(&_MorphoRepayHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) LastCaller() (common.Address, error) {
+	return _MorphoRepayHook.Contract.LastCaller(&_MorphoRepayHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) LastCaller() (common.Address, error) {
+	return _MorphoRepayHook.Contract.LastCaller(&_MorphoRepayHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) Morpho(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "morpho")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) Morpho() (common.Address, error) {
+	return _MorphoRepayHook.Contract.Morpho(&_MorphoRepayHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) Morpho() (common.Address, error) {
+	return _MorphoRepayHook.Contract.Morpho(&_MorphoRepayHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) MorphoBase(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "morphoBase")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) MorphoBase() (common.Address, error) {
+	return _MorphoRepayHook.Contract.MorphoBase(&_MorphoRepayHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) MorphoBase() (common.Address, error) {
+	return _MorphoRepayHook.Contract.MorphoBase(&_MorphoRepayHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) MorphoInterface(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "morphoInterface")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) MorphoInterface() (common.Address, error) {
+	return _MorphoRepayHook.Contract.MorphoInterface(&_MorphoRepayHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) MorphoInterface() (common.Address, error) {
+	return _MorphoRepayHook.Contract.MorphoInterface(&_MorphoRepayHook.CallOpts)
+}
+
+// MorphoStaticTyping is a free data retrieval call binding the contract method 0xb263ee3e.
+//
+// Solidity: function morphoStaticTyping() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) MorphoStaticTyping(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "morphoStaticTyping")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoStaticTyping is a free data retrieval call binding the contract method 0xb263ee3e.
+//
+// Solidity: function morphoStaticTyping() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) MorphoStaticTyping() (common.Address, error) {
+	return _MorphoRepayHook.Contract.MorphoStaticTyping(&_MorphoRepayHook.CallOpts)
+}
+
+// MorphoStaticTyping is a free data retrieval call binding the contract method 0xb263ee3e.
+//
+// Solidity: function morphoStaticTyping() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) MorphoStaticTyping() (common.Address, error) {
+	return _MorphoRepayHook.Contract.MorphoStaticTyping(&_MorphoRepayHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookSession) PostExecuteMutex() (bool, error) {
+	return _MorphoRepayHook.Contract.PostExecuteMutex(&_MorphoRepayHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _MorphoRepayHook.Contract.PostExecuteMutex(&_MorphoRepayHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookSession) PreExecuteMutex() (bool, error) {
+	return _MorphoRepayHook.Contract.PreExecuteMutex(&_MorphoRepayHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _MorphoRepayHook.Contract.PreExecuteMutex(&_MorphoRepayHook.CallOpts)
+}
+
+// SharesToAssets is a free data retrieval call binding the contract method 0xeb55e8cc.
+//
+// Solidity: function sharesToAssets((address,address,address,address,uint256) marketParams, address account) view returns(uint256 assets)
+func (_MorphoRepayHook *MorphoRepayHookCaller) SharesToAssets(opts *bind.CallOpts, marketParams MarketParams, account common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "sharesToAssets", marketParams, account)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// SharesToAssets is a free data retrieval call binding the contract method 0xeb55e8cc.
+//
+// Solidity: function sharesToAssets((address,address,address,address,uint256) marketParams, address account) view returns(uint256 assets)
+func (_MorphoRepayHook *MorphoRepayHookSession) SharesToAssets(marketParams MarketParams, account common.Address) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.SharesToAssets(&_MorphoRepayHook.CallOpts, marketParams, account)
+}
+
+// SharesToAssets is a free data retrieval call binding the contract method 0xeb55e8cc.
+//
+// Solidity: function sharesToAssets((address,address,address,address,uint256) marketParams, address account) view returns(uint256 assets)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) SharesToAssets(marketParams MarketParams, account common.Address) (*big.Int, error) {
+	return _MorphoRepayHook.Contract.SharesToAssets(&_MorphoRepayHook.CallOpts, marketParams, account)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookSession) SpToken() (common.Address, error) {
+	return _MorphoRepayHook.Contract.SpToken(&_MorphoRepayHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) SpToken() (common.Address, error) {
+	return _MorphoRepayHook.Contract.SpToken(&_MorphoRepayHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoRepayHook *MorphoRepayHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoRepayHook *MorphoRepayHookSession) SubType() ([32]byte, error) {
+	return _MorphoRepayHook.Contract.SubType(&_MorphoRepayHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) SubType() ([32]byte, error) {
+	return _MorphoRepayHook.Contract.SubType(&_MorphoRepayHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoRepayHook *MorphoRepayHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoRepayHook *MorphoRepayHookSession) Subtype() ([32]byte, error) {
+	return _MorphoRepayHook.Contract.Subtype(&_MorphoRepayHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) Subtype() ([32]byte, error) {
+	return _MorphoRepayHook.Contract.Subtype(&_MorphoRepayHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoRepayHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookSession) UsedShares() (*big.Int, error) {
+	return _MorphoRepayHook.Contract.UsedShares(&_MorphoRepayHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoRepayHook *MorphoRepayHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MorphoRepayHook.Contract.UsedShares(&_MorphoRepayHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayHook *MorphoRepayHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.PostExecute(&_MorphoRepayHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.PostExecute(&_MorphoRepayHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayHook *MorphoRepayHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.PreExecute(&_MorphoRepayHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.PreExecute(&_MorphoRepayHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.ResetExecutionState(&_MorphoRepayHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.ResetExecutionState(&_MorphoRepayHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.SetExecutionContext(&_MorphoRepayHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.SetExecutionContext(&_MorphoRepayHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.SetOutAmount(&_MorphoRepayHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoRepayHook *MorphoRepayHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoRepayHook.Contract.SetOutAmount(&_MorphoRepayHook.TransactOpts, _outAmount, caller)
+}
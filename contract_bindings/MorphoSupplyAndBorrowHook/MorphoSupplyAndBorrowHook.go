@@ -0,0 +1,997 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MorphoSupplyAndBorrowHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MorphoSupplyAndBorrowHookMetaData contains all meta data concerning the MorphoSupplyAndBorrowHook contract.
+var MorphoSupplyAndBorrowHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"morpho_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"deriveLoanAmount\",\"inputs\":[{\"name\":\"collateralAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"ltvRatio\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"lltv\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"loanAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCollateralTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getCollateralTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getLoanTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getLoanTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morpho\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoBase\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorphoBase\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoInterface\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorpho\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LTV_RATIO_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a060405234801561000f575f5ffd5b50604051611a12380380611a1283398101604081905261002e916100ed565b6040805180820190915260048152632637b0b760e11b6020909101525f805460ff191690557faf83e6e4bc80a23e2ed9719678c2dd27d06f4ea1f8280f57cf8a988618831b7d608081905281906001600160a01b0382166100a257604051630f58648f60e01b815260040160405180910390fd5b505f80546001600160a01b0392831661010002610100600160a81b031990911617905560018054929091166001600160a01b031992831681179091556002805490921617905561011a565b5f602082840312156100fd575f5ffd5b81516001600160a01b0381168114610113575f5ffd5b9392505050565b6080516118d96101395f395f8181610292015261037501526118d95ff3fe608060405234801561000f575f5ffd5b50600436106101a0575f3560e01c806381cbe691116100f3578063cf0a618111610093578063e445e7dd1161006e578063e445e7dd146103bd578063e512cf16146103d6578063e7745517146103e9578063f9837896146103fc575f5ffd5b8063cf0a618114610370578063d06a1e8914610397578063d8fbc833146103aa575f5ffd5b806387ef841a116100ce57806387ef841a146103185780638c4313c11461032b5780638e1487761461034b578063bacc47d21461035d575f5ffd5b806381cbe691146102e057806384542a24146102f3578063867bb72c14610305575f5ffd5b80632113522a1161015e5780633b5896bc116101395780633b5896bc146102705780635492e6771461029057806360b5be29146102b6578063685a943c146102d8575f5ffd5b80632113522a146102395780632ae2fe3d1461024b57806338d52e0f1461025e575f5ffd5b8062f5f8b4146101a457806301bd43ef146101d457806303341d66146101eb57806305b4fe91146101fe57806314cb37cf146102135780631f26461914610226575b5f5ffd5b6101b76101b2366004611455565b610413565b6040516001600160a01b0390911681526020015b60405180910390f35b6101dd60035c81565b6040519081526020016101cb565b6101dd6101f93660046114a2565b610424565b61021161020c366004611532565b6104a4565b005b61021161022136600461158f565b61051e565b6102116102343660046115a8565b61053f565b6101b76001600160a01b0360045c1681565b610211610259366004611532565b610598565b6101b76001600160a01b0360025c1681565b61028361027e366004611532565b61060b565b6040516101cb9190611600565b7f00000000000000000000000000000000000000000000000000000000000000006101dd565b6102c860ff600160a81b60025c041681565b60405190151581526020016101cb565b6101dd5f5c81565b6101dd6102ee36600461158f565b610824565b6102c860ff600160a01b60025c041681565b6101dd6103133660046114a2565b610836565b6101b7610326366004611455565b610843565b61033e61033936600461168d565b61084f565b6040516101cb91906116cc565b6101b76001600160a01b0360015c1681565b6101dd61036b3660046116de565b61091a565b6101dd7f000000000000000000000000000000000000000000000000000000000000000081565b6102116103a536600461158f565b6109de565b6001546101b7906001600160a01b031681565b5f546103c99060ff1681565b6040516101cb919061171a565b6002546101b7906001600160a01b031681565b6102c86103f7366004611455565b610a30565b5f546101b79061010090046001600160a01b031681565b5f61041e825f610a3c565b92915050565b5f5f610430835f610a3c565b6040516370a0823160e01b81526001600160a01b038681166004830152919250908216906370a0823190602401602060405180830381865afa158015610478573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061049c9190611740565b949350505050565b336001600160a01b038416146104cd5760405163e15e56c960e01b815260040160405180910390fd5b5f6104d784610aa5565b90506104e281610ab8565b156105005760405163945b63f560e01b815260040160405180910390fd5b61050b816001610ac5565b61051785858585610adb565b5050505050565b61052781610b3c565b50336004805c6001600160a01b0319168217905d5050565b5f61054982610aa5565b905061055481610b6e565b80610563575061056381610ab8565b156105815760405163441e4c7360e11b815260040160405180910390fd5b5f61058d826001610b77565b905083815d50505050565b336001600160a01b038416146105c15760405163e15e56c960e01b815260040160405180910390fd5b5f6105cb84610aa5565b90506105d681610b6e565b156105f457604051630bbb04d960e11b815260040160405180910390fd5b6105ff816001610bcc565b61051785858585610bd8565b60605f61061a86868686610c1a565b90508051600261062a919061176b565b67ffffffffffffffff811115610642576106426113b6565b60405190808252806020026020018201604052801561068e57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816106605790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016106d7949392919061177e565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610719576107196117c6565b60209081029190910101525f5b815181101561077a57818181518110610741576107416117c6565b602002602001015183826001610757919061176b565b81518110610767576107676117c6565b6020908102919091010152600101610726565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016107c1949392919061177e565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161080091906117da565b81518110610810576108106117c6565b602002602001018190525050949350505050565b5f61041e61083183610aa5565b611037565b5f5f610430836014610a3c565b5f61041e826014610a3c565b60605f61089084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061104492505050565b90505f6108b3825f01518360200151846040015185606001518660e00151611145565b8051602080830151604080850151606095860151825195871b6bffffffffffffffffffffffff199081169587019590955292861b84166034860152851b83166048850152931b16605c82015281518082036050018152607090910190915295945050505050565b5f5f8290505f816001600160a01b031663a035b1fe6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561095c573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109809190611740565b90508486106109a25760405163048bb5df60e01b815260040160405180910390fd5b5f6109bd88836ec097ce7bc90715b34b9f10000000006111ac565b90506109d28188670de0b6b3a76400006111ac565b98975050505050505050565b5f6109e882610aa5565b90506109f381610b6e565b1580610a055750610a0381610ab8565b155b15610a2357604051634bd439b560e11b815260040160405180910390fd5b610a2c8161125d565b5050565b5f61041e826090611274565b5f610a4882601461176b565b83511015610a955760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f5f610ab0836112a0565b5c9392505050565b5f5f610ab0836003610b77565b5f610ad1836003610b77565b905081815d505050565b610b36610b1d8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061083692505050565b610b2685610824565b610b3091906117da565b8461130c565b50505050565b5f6003805c9082610b4c836117ed565b9190505d505f610b5b836112a0565b905060035c80825d505060035c92915050565b5f5f610ab08360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610ad1836002610b77565b610b36610b308484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061083692505050565b60605f610c5b84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061104492505050565b90508060c0015115610cd5576040516381cbe69160e01b81526001600160a01b0386811660048301528716906381cbe69190602401602060405180830381865afa158015610cab573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610ccf9190611740565b60808201525b80608001515f03610cf9576040516305112ab160e41b815260040160405180910390fd5b80516001600160a01b03161580610d1b575060208101516001600160a01b0316155b15610d3957604051630f58648f60e01b815260040160405180910390fd5b5f610d5a825f01518360200151846040015185606001518660e00151611145565b90505f610d7983608001518460a001518560e00151866040015161091a565b60408051600480825260a08201909252919250816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610d9157905050604080516060810182526020808701516001600160a01b0390811683525f9183018290526001548451911660248201526044810191909152929650919082019060640160408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185905f90610e4057610e406117c6565b6020026020010181905250604051806060016040528084602001516001600160a01b031681526020015f815260200160015f9054906101000a90046001600160a01b03168560800151604051602401610eae9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052845185906001908110610eef57610eef6117c6565b6020026020010181905250604051806060016040528060015f9054906101000a90046001600160a01b03166001600160a01b031681526020015f81526020018385608001518a604051602401610f4793929190611844565b60408051601f198184030181529190526020810180516001600160e01b031663238d657960e01b1790529052845185906002908110610f8857610f886117c6565b6020026020010181905250604051806060016040528060015f9054906101000a90046001600160a01b03166001600160a01b031681526020015f815260200183835f8b8c604051602401610fe095949392919061187c565b60408051601f198184030181529190526020810180516001600160e01b03166350d8cd4b60e01b1790529052845185906003908110611021576110216117c6565b6020026020010181905250505050949350505050565b5f5f610ab0836001610b77565b60408051610100810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c0810182905260e081018290529061108e8382610a3c565b90505f61109c846014610a3c565b90505f6110aa856028610a3c565b90505f6110b886603c610a3c565b90505f6110c487611324565b90505f6110d288607061132c565b90505f6110e0896090611274565b90505f6110ee8a609161132c565b60408051610100810182526001600160a01b039a8b168152988a1660208a01529689169688019690965250929095166060850152608084015260a083019390935291151560c082015260e081019190915292915050565b6040805160a0810182525f80825260208201819052918101829052606081018290526080810191909152506040805160a0810182526001600160a01b0396871681529486166020860152928516928401929092529092166060820152608081019190915290565b5f5f5f6111b98686611389565b91509150815f036111dd578381816111d3576111d36118b8565b0492505050611256565b8184116111f4576111f460038515026011186113a5565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150505b9392505050565b611267815f610bcc565b611271815f610ac5565b50565b5f828281518110611287576112876117c6565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b826040516020016112ef92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f61131682610aa5565b90505f61058d826001610b77565b5f61041e8260505b5f61133882602061176b565b835110156113805760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610a8c565b50016020015190565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b634e487b7160e01b5f52604160045260245ffd5b5f82601f8301126113d9575f5ffd5b813567ffffffffffffffff8111156113f3576113f36113b6565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611422576114226113b6565b604052818152838201602001851015611439575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611465575f5ffd5b813567ffffffffffffffff81111561147b575f5ffd5b61049c848285016113ca565b80356001600160a01b038116811461149d575f5ffd5b919050565b5f5f604083850312156114b3575f5ffd5b6114bc83611487565b9150602083013567ffffffffffffffff8111156114d7575f5ffd5b6114e3858286016113ca565b9150509250929050565b5f5f83601f8401126114fd575f5ffd5b50813567ffffffffffffffff811115611514575f5ffd5b60208301915083602082850101111561152b575f5ffd5b9250929050565b5f5f5f5f60608587031215611545575f5ffd5b61154e85611487565b935061155c60208601611487565b9250604085013567ffffffffffffffff811115611577575f5ffd5b611583878288016114ed565b95989497509550505050565b5f6020828403121561159f575f5ffd5b61125682611487565b5f5f604083850312156115b9575f5ffd5b823591506115c960208401611487565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561168157868503603f19018452815180516001600160a01b031686526020808201519087015260409081015160609187018290529061166b908701826115d2565b9550506020938401939190910190600101611626565b50929695505050505050565b5f5f6020838503121561169e575f5ffd5b823567ffffffffffffffff8111156116b4575f5ffd5b6116c0858286016114ed565b90969095509350505050565b602081525f61125660208301846115d2565b5f5f5f5f608085870312156116f1575f5ffd5b84359350602085013592506040850135915061170f60608601611487565b905092959194509250565b602081016003831061173a57634e487b7160e01b5f52602160045260245ffd5b91905290565b5f60208284031215611750575f5ffd5b5051919050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561041e5761041e611757565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561041e5761041e611757565b5f600182016117fe576117fe611757565b5060010190565b80516001600160a01b03908116835260208083015182169084015260408083015182169084015260608083015190911690830152608090810151910152565b61184e8185611805565b60a08101929092526001600160a01b031660c082015261010060e082018190525f9082015261012001919050565b610120810161188b8288611805565b60a082019590955260c08101939093526001600160a01b0391821660e08401521661010090910152919050565b634e487b7160e01b5f52601260045260245ffdfea164736f6c634300081e000a",
+}
+
+// MorphoSupplyAndBorrowHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MorphoSupplyAndBorrowHookMetaData.ABI instead.
+var MorphoSupplyAndBorrowHookABI = MorphoSupplyAndBorrowHookMetaData.ABI
+
+// MorphoSupplyAndBorrowHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use MorphoSupplyAndBorrowHookMetaData.Bin instead.
+var MorphoSupplyAndBorrowHookBin = MorphoSupplyAndBorrowHookMetaData.Bin
+
+// DeployMorphoSupplyAndBorrowHook deploys a new Ethereum contract, binding an instance of MorphoSupplyAndBorrowHook to it.
+func DeployMorphoSupplyAndBorrowHook(auth *bind.TransactOpts, backend bind.ContractBackend, morpho_ common.Address) (common.Address, *types.Transaction, *MorphoSupplyAndBorrowHook, error) {
+	parsed, err := MorphoSupplyAndBorrowHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(MorphoSupplyAndBorrowHookBin), backend, morpho_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &MorphoSupplyAndBorrowHook{MorphoSupplyAndBorrowHookCaller: MorphoSupplyAndBorrowHookCaller{contract: contract}, MorphoSupplyAndBorrowHookTransactor: MorphoSupplyAndBorrowHookTransactor{contract: contract}, MorphoSupplyAndBorrowHookFilterer: MorphoSupplyAndBorrowHookFilterer{contract: contract}}, nil
+}
+
+// MorphoSupplyAndBorrowHook is an auto generated Go binding around an Ethereum contract.
+type MorphoSupplyAndBorrowHook struct {
+	MorphoSupplyAndBorrowHookCaller     // Read-only binding to the contract
+	MorphoSupplyAndBorrowHookTransactor // Write-only binding to the contract
+	MorphoSupplyAndBorrowHookFilterer   // Log filterer for contract events
+}
+
+// MorphoSupplyAndBorrowHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MorphoSupplyAndBorrowHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoSupplyAndBorrowHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MorphoSupplyAndBorrowHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoSupplyAndBorrowHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MorphoSupplyAndBorrowHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoSupplyAndBorrowHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MorphoSupplyAndBorrowHookSession struct {
+	Contract     *MorphoSupplyAndBorrowHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// MorphoSupplyAndBorrowHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MorphoSupplyAndBorrowHookCallerSession struct {
+	Contract *MorphoSupplyAndBorrowHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// MorphoSupplyAndBorrowHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MorphoSupplyAndBorrowHookTransactorSession struct {
+	Contract     *MorphoSupplyAndBorrowHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// MorphoSupplyAndBorrowHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MorphoSupplyAndBorrowHookRaw struct {
+	Contract *MorphoSupplyAndBorrowHook // Generic contract binding to access the raw methods on
+}
+
+// MorphoSupplyAndBorrowHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MorphoSupplyAndBorrowHookCallerRaw struct {
+	Contract *MorphoSupplyAndBorrowHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MorphoSupplyAndBorrowHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MorphoSupplyAndBorrowHookTransactorRaw struct {
+	Contract *MorphoSupplyAndBorrowHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMorphoSupplyAndBorrowHook creates a new instance of MorphoSupplyAndBorrowHook, bound to a specific deployed contract.
+func NewMorphoSupplyAndBorrowHook(address common.Address, backend bind.ContractBackend) (*MorphoSupplyAndBorrowHook, error) {
+	contract, err := bindMorphoSupplyAndBorrowHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyAndBorrowHook{MorphoSupplyAndBorrowHookCaller: MorphoSupplyAndBorrowHookCaller{contract: contract}, MorphoSupplyAndBorrowHookTransactor: MorphoSupplyAndBorrowHookTransactor{contract: contract}, MorphoSupplyAndBorrowHookFilterer: MorphoSupplyAndBorrowHookFilterer{contract: contract}}, nil
+}
+
+// NewMorphoSupplyAndBorrowHookCaller creates a new read-only instance of MorphoSupplyAndBorrowHook, bound to a specific deployed contract.
+func NewMorphoSupplyAndBorrowHookCaller(address common.Address, caller bind.ContractCaller) (*MorphoSupplyAndBorrowHookCaller, error) {
+	contract, err := bindMorphoSupplyAndBorrowHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyAndBorrowHookCaller{contract: contract}, nil
+}
+
+// NewMorphoSupplyAndBorrowHookTransactor creates a new write-only instance of MorphoSupplyAndBorrowHook, bound to a specific deployed contract.
+func NewMorphoSupplyAndBorrowHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MorphoSupplyAndBorrowHookTransactor, error) {
+	contract, err := bindMorphoSupplyAndBorrowHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyAndBorrowHookTransactor{contract: contract}, nil
+}
+
+// NewMorphoSupplyAndBorrowHookFilterer creates a new log filterer instance of MorphoSupplyAndBorrowHook, bound to a specific deployed contract.
+func NewMorphoSupplyAndBorrowHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MorphoSupplyAndBorrowHookFilterer, error) {
+	contract, err := bindMorphoSupplyAndBorrowHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyAndBorrowHookFilterer{contract: contract}, nil
+}
+
+// bindMorphoSupplyAndBorrowHook binds a generic wrapper to an already deployed contract.
+func bindMorphoSupplyAndBorrowHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MorphoSupplyAndBorrowHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoSupplyAndBorrowHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoSupplyAndBorrowHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoSupplyAndBorrowHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoSupplyAndBorrowHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) Asset() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Asset(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) Asset() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Asset(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Build(&_MorphoSupplyAndBorrowHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Build(&_MorphoSupplyAndBorrowHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.DecodeUsePrevHookAmount(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.DecodeUsePrevHookAmount(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// DeriveLoanAmount is a free data retrieval call binding the contract method 0xbacc47d2.
+//
+// Solidity: function deriveLoanAmount(uint256 collateralAmount, uint256 ltvRatio, uint256 lltv, address oracle) view returns(uint256 loanAmount)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) DeriveLoanAmount(opts *bind.CallOpts, collateralAmount *big.Int, ltvRatio *big.Int, lltv *big.Int, oracle common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "deriveLoanAmount", collateralAmount, ltvRatio, lltv, oracle)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DeriveLoanAmount is a free data retrieval call binding the contract method 0xbacc47d2.
+//
+// Solidity: function deriveLoanAmount(uint256 collateralAmount, uint256 ltvRatio, uint256 lltv, address oracle) view returns(uint256 loanAmount)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) DeriveLoanAmount(collateralAmount *big.Int, ltvRatio *big.Int, lltv *big.Int, oracle common.Address) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.DeriveLoanAmount(&_MorphoSupplyAndBorrowHook.CallOpts, collateralAmount, ltvRatio, lltv, oracle)
+}
+
+// DeriveLoanAmount is a free data retrieval call binding the contract method 0xbacc47d2.
+//
+// Solidity: function deriveLoanAmount(uint256 collateralAmount, uint256 ltvRatio, uint256 lltv, address oracle) view returns(uint256 loanAmount)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) DeriveLoanAmount(collateralAmount *big.Int, ltvRatio *big.Int, lltv *big.Int, oracle common.Address) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.DeriveLoanAmount(&_MorphoSupplyAndBorrowHook.CallOpts, collateralAmount, ltvRatio, lltv, oracle)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.ExecutionNonce(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.ExecutionNonce(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) GetCollateralTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "getCollateralTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetCollateralTokenAddress(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetCollateralTokenAddress(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) GetCollateralTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "getCollateralTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetCollateralTokenBalance(&_MorphoSupplyAndBorrowHook.CallOpts, account, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetCollateralTokenBalance(&_MorphoSupplyAndBorrowHook.CallOpts, account, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) GetLoanTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "getLoanTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetLoanTokenAddress(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetLoanTokenAddress(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) GetLoanTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "getLoanTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetLoanTokenBalance(&_MorphoSupplyAndBorrowHook.CallOpts, account, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetLoanTokenBalance(&_MorphoSupplyAndBorrowHook.CallOpts, account, data)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetOutAmount(&_MorphoSupplyAndBorrowHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.GetOutAmount(&_MorphoSupplyAndBorrowHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) HookType() (uint8, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.HookType(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) HookType() (uint8, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.HookType(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Inspect(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Inspect(&_MorphoSupplyAndBorrowHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) LastCaller() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.LastCaller(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) LastCaller() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.LastCaller(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) Morpho(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "morpho")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) Morpho() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Morpho(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Morpho is a free data retrieval call binding the contract method 0xd8fbc833.
+//
+// Solidity: function morpho() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) Morpho() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Morpho(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) MorphoBase(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "morphoBase")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) MorphoBase() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoBase(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// MorphoBase is a free data retrieval call binding the contract method 0xe512cf16.
+//
+// Solidity: function morphoBase() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) MorphoBase() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoBase(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) MorphoInterface(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "morphoInterface")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) MorphoInterface() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoInterface(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) MorphoInterface() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.MorphoInterface(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) PostExecuteMutex() (bool, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PostExecuteMutex(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PostExecuteMutex(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) PreExecuteMutex() (bool, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PreExecuteMutex(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PreExecuteMutex(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) SpToken() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SpToken(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) SpToken() (common.Address, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SpToken(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) SubType() ([32]byte, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SubType(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) SubType() ([32]byte, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SubType(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) Subtype() ([32]byte, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Subtype(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) Subtype() ([32]byte, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.Subtype(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyAndBorrowHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) UsedShares() (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.UsedShares(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.UsedShares(&_MorphoSupplyAndBorrowHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PostExecute(&_MorphoSupplyAndBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PostExecute(&_MorphoSupplyAndBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PreExecute(&_MorphoSupplyAndBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.PreExecute(&_MorphoSupplyAndBorrowHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.ResetExecutionState(&_MorphoSupplyAndBorrowHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.ResetExecutionState(&_MorphoSupplyAndBorrowHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SetExecutionContext(&_MorphoSupplyAndBorrowHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SetExecutionContext(&_MorphoSupplyAndBorrowHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SetOutAmount(&_MorphoSupplyAndBorrowHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoSupplyAndBorrowHook *MorphoSupplyAndBorrowHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyAndBorrowHook.Contract.SetOutAmount(&_MorphoSupplyAndBorrowHook.TransactOpts, _outAmount, caller)
+}
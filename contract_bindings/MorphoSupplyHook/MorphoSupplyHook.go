@@ -0,0 +1,820 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package MorphoSupplyHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// MorphoSupplyHookMetaData contains all meta data concerning the MorphoSupplyHook contract.
+var MorphoSupplyHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"morpho_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getCollateralTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getCollateralTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getLoanTokenAddress\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getLoanTokenBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"morphoInterface\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIMorpho\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+}
+
+// MorphoSupplyHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use MorphoSupplyHookMetaData.ABI instead.
+var MorphoSupplyHookABI = MorphoSupplyHookMetaData.ABI
+
+// MorphoSupplyHook is an auto generated Go binding around an Ethereum contract.
+type MorphoSupplyHook struct {
+	MorphoSupplyHookCaller     // Read-only binding to the contract
+	MorphoSupplyHookTransactor // Write-only binding to the contract
+	MorphoSupplyHookFilterer   // Log filterer for contract events
+}
+
+// MorphoSupplyHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type MorphoSupplyHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoSupplyHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type MorphoSupplyHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoSupplyHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type MorphoSupplyHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// MorphoSupplyHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type MorphoSupplyHookSession struct {
+	Contract     *MorphoSupplyHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// MorphoSupplyHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type MorphoSupplyHookCallerSession struct {
+	Contract *MorphoSupplyHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// MorphoSupplyHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type MorphoSupplyHookTransactorSession struct {
+	Contract     *MorphoSupplyHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// MorphoSupplyHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type MorphoSupplyHookRaw struct {
+	Contract *MorphoSupplyHook // Generic contract binding to access the raw methods on
+}
+
+// MorphoSupplyHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type MorphoSupplyHookCallerRaw struct {
+	Contract *MorphoSupplyHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// MorphoSupplyHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type MorphoSupplyHookTransactorRaw struct {
+	Contract *MorphoSupplyHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewMorphoSupplyHook creates a new instance of MorphoSupplyHook, bound to a specific deployed contract.
+func NewMorphoSupplyHook(address common.Address, backend bind.ContractBackend) (*MorphoSupplyHook, error) {
+	contract, err := bindMorphoSupplyHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyHook{MorphoSupplyHookCaller: MorphoSupplyHookCaller{contract: contract}, MorphoSupplyHookTransactor: MorphoSupplyHookTransactor{contract: contract}, MorphoSupplyHookFilterer: MorphoSupplyHookFilterer{contract: contract}}, nil
+}
+
+// NewMorphoSupplyHookCaller creates a new read-only instance of MorphoSupplyHook, bound to a specific deployed contract.
+func NewMorphoSupplyHookCaller(address common.Address, caller bind.ContractCaller) (*MorphoSupplyHookCaller, error) {
+	contract, err := bindMorphoSupplyHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyHookCaller{contract: contract}, nil
+}
+
+// NewMorphoSupplyHookTransactor creates a new write-only instance of MorphoSupplyHook, bound to a specific deployed contract.
+func NewMorphoSupplyHookTransactor(address common.Address, transactor bind.ContractTransactor) (*MorphoSupplyHookTransactor, error) {
+	contract, err := bindMorphoSupplyHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyHookTransactor{contract: contract}, nil
+}
+
+// NewMorphoSupplyHookFilterer creates a new log filterer instance of MorphoSupplyHook, bound to a specific deployed contract.
+func NewMorphoSupplyHookFilterer(address common.Address, filterer bind.ContractFilterer) (*MorphoSupplyHookFilterer, error) {
+	contract, err := bindMorphoSupplyHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &MorphoSupplyHookFilterer{contract: contract}, nil
+}
+
+// bindMorphoSupplyHook binds a generic wrapper to an already deployed contract.
+func bindMorphoSupplyHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := MorphoSupplyHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoSupplyHook *MorphoSupplyHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoSupplyHook.Contract.MorphoSupplyHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoSupplyHook *MorphoSupplyHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.MorphoSupplyHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoSupplyHook *MorphoSupplyHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.MorphoSupplyHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_MorphoSupplyHook *MorphoSupplyHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _MorphoSupplyHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) SUBTYPE() ([32]byte, error) {
+	return _MorphoSupplyHook.Contract.SUBTYPE(&_MorphoSupplyHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _MorphoSupplyHook.Contract.SUBTYPE(&_MorphoSupplyHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) Asset() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.Asset(&_MorphoSupplyHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) Asset() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.Asset(&_MorphoSupplyHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoSupplyHook.Contract.Build(&_MorphoSupplyHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _MorphoSupplyHook.Contract.Build(&_MorphoSupplyHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoSupplyHook.Contract.DecodeUsePrevHookAmount(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _MorphoSupplyHook.Contract.DecodeUsePrevHookAmount(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.ExecutionNonce(&_MorphoSupplyHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.ExecutionNonce(&_MorphoSupplyHook.CallOpts)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) GetCollateralTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "getCollateralTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyHook.Contract.GetCollateralTokenAddress(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// GetCollateralTokenAddress is a free data retrieval call binding the contract method 0x87ef841a.
+//
+// Solidity: function getCollateralTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) GetCollateralTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyHook.Contract.GetCollateralTokenAddress(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) GetCollateralTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "getCollateralTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.GetCollateralTokenBalance(&_MorphoSupplyHook.CallOpts, account, data)
+}
+
+// GetCollateralTokenBalance is a free data retrieval call binding the contract method 0x867bb72c.
+//
+// Solidity: function getCollateralTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) GetCollateralTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.GetCollateralTokenBalance(&_MorphoSupplyHook.CallOpts, account, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) GetLoanTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "getLoanTokenAddress", data)
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyHook.Contract.GetLoanTokenAddress(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// GetLoanTokenAddress is a free data retrieval call binding the contract method 0x00f5f8b4.
+//
+// Solidity: function getLoanTokenAddress(bytes data) pure returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) GetLoanTokenAddress(data []byte) (common.Address, error) {
+	return _MorphoSupplyHook.Contract.GetLoanTokenAddress(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) GetLoanTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "getLoanTokenBalance", account, data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.GetLoanTokenBalance(&_MorphoSupplyHook.CallOpts, account, data)
+}
+
+// GetLoanTokenBalance is a free data retrieval call binding the contract method 0x03341d66.
+//
+// Solidity: function getLoanTokenBalance(address account, bytes data) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) GetLoanTokenBalance(account common.Address, data []byte) (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.GetLoanTokenBalance(&_MorphoSupplyHook.CallOpts, account, data)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.GetOutAmount(&_MorphoSupplyHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.GetOutAmount(&_MorphoSupplyHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) HookType() (uint8, error) {
+	return _MorphoSupplyHook.Contract.HookType(&_MorphoSupplyHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) HookType() (uint8, error) {
+	return _MorphoSupplyHook.Contract.HookType(&_MorphoSupplyHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoSupplyHook.Contract.Inspect(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _MorphoSupplyHook.Contract.Inspect(&_MorphoSupplyHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) LastCaller() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.LastCaller(&_MorphoSupplyHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) LastCaller() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.LastCaller(&_MorphoSupplyHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) MorphoInterface(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "morphoInterface")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) MorphoInterface() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.MorphoInterface(&_MorphoSupplyHook.CallOpts)
+}
+
+// MorphoInterface is a free data retrieval call binding the contract method 0xf9837896.
+//
+// Solidity: function morphoInterface() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) MorphoInterface() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.MorphoInterface(&_MorphoSupplyHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) SpToken() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.SpToken(&_MorphoSupplyHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) SpToken() (common.Address, error) {
+	return _MorphoSupplyHook.Contract.SpToken(&_MorphoSupplyHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) Subtype() ([32]byte, error) {
+	return _MorphoSupplyHook.Contract.Subtype(&_MorphoSupplyHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) Subtype() ([32]byte, error) {
+	return _MorphoSupplyHook.Contract.Subtype(&_MorphoSupplyHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _MorphoSupplyHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookSession) UsedShares() (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.UsedShares(&_MorphoSupplyHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_MorphoSupplyHook *MorphoSupplyHookCallerSession) UsedShares() (*big.Int, error) {
+	return _MorphoSupplyHook.Contract.UsedShares(&_MorphoSupplyHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.PostExecute(&_MorphoSupplyHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.PostExecute(&_MorphoSupplyHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.PreExecute(&_MorphoSupplyHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.PreExecute(&_MorphoSupplyHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.ResetExecutionState(&_MorphoSupplyHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.ResetExecutionState(&_MorphoSupplyHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.SetExecutionContext(&_MorphoSupplyHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.SetExecutionContext(&_MorphoSupplyHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.SetOutAmount(&_MorphoSupplyHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_MorphoSupplyHook *MorphoSupplyHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _MorphoSupplyHook.Contract.SetOutAmount(&_MorphoSupplyHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,634 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package NativeTransferHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// NativeTransferHookMetaData contains all meta data concerning the NativeTransferHook contract.
+var NativeTransferHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+}
+
+// NativeTransferHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use NativeTransferHookMetaData.ABI instead.
+var NativeTransferHookABI = NativeTransferHookMetaData.ABI
+
+// NativeTransferHook is an auto generated Go binding around an Ethereum contract.
+type NativeTransferHook struct {
+	NativeTransferHookCaller     // Read-only binding to the contract
+	NativeTransferHookTransactor // Write-only binding to the contract
+	NativeTransferHookFilterer   // Log filterer for contract events
+}
+
+// NativeTransferHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type NativeTransferHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeTransferHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type NativeTransferHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeTransferHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type NativeTransferHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// NativeTransferHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type NativeTransferHookSession struct {
+	Contract     *NativeTransferHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts       // Call options to use throughout this session
+	TransactOpts bind.TransactOpts   // Transaction auth options to use throughout this session
+}
+
+// NativeTransferHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type NativeTransferHookCallerSession struct {
+	Contract *NativeTransferHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts             // Call options to use throughout this session
+}
+
+// NativeTransferHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type NativeTransferHookTransactorSession struct {
+	Contract     *NativeTransferHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts             // Transaction auth options to use throughout this session
+}
+
+// NativeTransferHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type NativeTransferHookRaw struct {
+	Contract *NativeTransferHook // Generic contract binding to access the raw methods on
+}
+
+// NativeTransferHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type NativeTransferHookCallerRaw struct {
+	Contract *NativeTransferHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// NativeTransferHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type NativeTransferHookTransactorRaw struct {
+	Contract *NativeTransferHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewNativeTransferHook creates a new instance of NativeTransferHook, bound to a specific deployed contract.
+func NewNativeTransferHook(address common.Address, backend bind.ContractBackend) (*NativeTransferHook, error) {
+	contract, err := bindNativeTransferHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTransferHook{NativeTransferHookCaller: NativeTransferHookCaller{contract: contract}, NativeTransferHookTransactor: NativeTransferHookTransactor{contract: contract}, NativeTransferHookFilterer: NativeTransferHookFilterer{contract: contract}}, nil
+}
+
+// NewNativeTransferHookCaller creates a new read-only instance of NativeTransferHook, bound to a specific deployed contract.
+func NewNativeTransferHookCaller(address common.Address, caller bind.ContractCaller) (*NativeTransferHookCaller, error) {
+	contract, err := bindNativeTransferHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTransferHookCaller{contract: contract}, nil
+}
+
+// NewNativeTransferHookTransactor creates a new write-only instance of NativeTransferHook, bound to a specific deployed contract.
+func NewNativeTransferHookTransactor(address common.Address, transactor bind.ContractTransactor) (*NativeTransferHookTransactor, error) {
+	contract, err := bindNativeTransferHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTransferHookTransactor{contract: contract}, nil
+}
+
+// NewNativeTransferHookFilterer creates a new log filterer instance of NativeTransferHook, bound to a specific deployed contract.
+func NewNativeTransferHookFilterer(address common.Address, filterer bind.ContractFilterer) (*NativeTransferHookFilterer, error) {
+	contract, err := bindNativeTransferHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &NativeTransferHookFilterer{contract: contract}, nil
+}
+
+// bindNativeTransferHook binds a generic wrapper to an already deployed contract.
+func bindNativeTransferHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := NativeTransferHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NativeTransferHook *NativeTransferHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NativeTransferHook.Contract.NativeTransferHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NativeTransferHook *NativeTransferHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.NativeTransferHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NativeTransferHook *NativeTransferHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.NativeTransferHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_NativeTransferHook *NativeTransferHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _NativeTransferHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_NativeTransferHook *NativeTransferHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_NativeTransferHook *NativeTransferHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_NativeTransferHook *NativeTransferHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_NativeTransferHook *NativeTransferHookSession) SUBTYPE() ([32]byte, error) {
+	return _NativeTransferHook.Contract.SUBTYPE(&_NativeTransferHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_NativeTransferHook *NativeTransferHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _NativeTransferHook.Contract.SUBTYPE(&_NativeTransferHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_NativeTransferHook *NativeTransferHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_NativeTransferHook *NativeTransferHookSession) Asset() (common.Address, error) {
+	return _NativeTransferHook.Contract.Asset(&_NativeTransferHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_NativeTransferHook *NativeTransferHookCallerSession) Asset() (common.Address, error) {
+	return _NativeTransferHook.Contract.Asset(&_NativeTransferHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_NativeTransferHook *NativeTransferHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_NativeTransferHook *NativeTransferHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _NativeTransferHook.Contract.Build(&_NativeTransferHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_NativeTransferHook *NativeTransferHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _NativeTransferHook.Contract.Build(&_NativeTransferHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookSession) ExecutionNonce() (*big.Int, error) {
+	return _NativeTransferHook.Contract.ExecutionNonce(&_NativeTransferHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _NativeTransferHook.Contract.ExecutionNonce(&_NativeTransferHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _NativeTransferHook.Contract.GetOutAmount(&_NativeTransferHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _NativeTransferHook.Contract.GetOutAmount(&_NativeTransferHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_NativeTransferHook *NativeTransferHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_NativeTransferHook *NativeTransferHookSession) HookType() (uint8, error) {
+	return _NativeTransferHook.Contract.HookType(&_NativeTransferHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_NativeTransferHook *NativeTransferHookCallerSession) HookType() (uint8, error) {
+	return _NativeTransferHook.Contract.HookType(&_NativeTransferHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_NativeTransferHook *NativeTransferHookCaller) Inspect(opts *bind.CallOpts, arg0 []byte) ([]byte, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "inspect", arg0)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_NativeTransferHook *NativeTransferHookSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _NativeTransferHook.Contract.Inspect(&_NativeTransferHook.CallOpts, arg0)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_NativeTransferHook *NativeTransferHookCallerSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _NativeTransferHook.Contract.Inspect(&_NativeTransferHook.CallOpts, arg0)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_NativeTransferHook *NativeTransferHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_NativeTransferHook *NativeTransferHookSession) LastCaller() (common.Address, error) {
+	return _NativeTransferHook.Contract.LastCaller(&_NativeTransferHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_NativeTransferHook *NativeTransferHookCallerSession) LastCaller() (common.Address, error) {
+	return _NativeTransferHook.Contract.LastCaller(&_NativeTransferHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_NativeTransferHook *NativeTransferHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_NativeTransferHook *NativeTransferHookSession) SpToken() (common.Address, error) {
+	return _NativeTransferHook.Contract.SpToken(&_NativeTransferHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_NativeTransferHook *NativeTransferHookCallerSession) SpToken() (common.Address, error) {
+	return _NativeTransferHook.Contract.SpToken(&_NativeTransferHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_NativeTransferHook *NativeTransferHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_NativeTransferHook *NativeTransferHookSession) Subtype() ([32]byte, error) {
+	return _NativeTransferHook.Contract.Subtype(&_NativeTransferHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_NativeTransferHook *NativeTransferHookCallerSession) Subtype() ([32]byte, error) {
+	return _NativeTransferHook.Contract.Subtype(&_NativeTransferHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _NativeTransferHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookSession) UsedShares() (*big.Int, error) {
+	return _NativeTransferHook.Contract.UsedShares(&_NativeTransferHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_NativeTransferHook *NativeTransferHookCallerSession) UsedShares() (*big.Int, error) {
+	return _NativeTransferHook.Contract.UsedShares(&_NativeTransferHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_NativeTransferHook *NativeTransferHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _NativeTransferHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_NativeTransferHook *NativeTransferHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.PostExecute(&_NativeTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_NativeTransferHook *NativeTransferHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.PostExecute(&_NativeTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_NativeTransferHook *NativeTransferHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _NativeTransferHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_NativeTransferHook *NativeTransferHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.PreExecute(&_NativeTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_NativeTransferHook *NativeTransferHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.PreExecute(&_NativeTransferHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_NativeTransferHook *NativeTransferHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_NativeTransferHook *NativeTransferHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.ResetExecutionState(&_NativeTransferHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_NativeTransferHook *NativeTransferHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.ResetExecutionState(&_NativeTransferHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_NativeTransferHook *NativeTransferHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_NativeTransferHook *NativeTransferHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.SetExecutionContext(&_NativeTransferHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_NativeTransferHook *NativeTransferHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.SetExecutionContext(&_NativeTransferHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_NativeTransferHook *NativeTransferHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_NativeTransferHook *NativeTransferHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.SetOutAmount(&_NativeTransferHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_NativeTransferHook *NativeTransferHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _NativeTransferHook.Contract.SetOutAmount(&_NativeTransferHook.TransactOpts, _outAmount, caller)
+}
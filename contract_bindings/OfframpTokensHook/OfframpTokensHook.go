@@ -0,0 +1,656 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package OfframpTokensHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// OfframpTokensHookMetaData contains all meta data concerning the OfframpTokensHook contract.
+var OfframpTokensHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"result\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260058152642a37b5b2b760d91b6020909101525f805460ff191690557f1317f51c845ce3bfb7c268e5337a825f12f3d0af9584c2bbfbf4e64e314eaf736080526080516112806100785f395f81816101c7015261023d01526112805ff3fe608060405234801561000f575f5ffd5b50600436106100fb575f3560e01c80635492e677116100935780638e148776116100635780638e14877614610226578063984d6e7a14610238578063d06a1e891461025f578063e445e7dd14610272575f5ffd5b80635492e677146101c5578063685a943c146101eb57806381cbe691146101f35780638c4313c114610206575f5ffd5b80632113522a116100ce5780632113522a146101565780632ae2fe3d1461018057806338d52e0f146101935780633b5896bc146101a5575f5ffd5b806301bd43ef146100ff57806305b4fe911461011b57806314cb37cf146101305780631f26461914610143575b5f5ffd5b61010860035c81565b6040519081526020015b60405180910390f35b61012e610129366004610eb2565b61028b565b005b61012e61013e366004610f13565b6102f9565b61012e610151366004610f35565b61031a565b6101686001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610112565b61012e61018e366004610eb2565b610373565b6101686001600160a01b0360025c1681565b6101b86101b3366004610eb2565b6103da565b6040516101129190610f91565b7f0000000000000000000000000000000000000000000000000000000000000000610108565b6101085f5c81565b610108610201366004610f13565b6105f3565b61021961021436600461101e565b61060b565b604051610112919061105d565b6101686001600160a01b0360015c1681565b6101087f000000000000000000000000000000000000000000000000000000000000000081565b61012e61026d366004610f13565b61073f565b5f5461027e9060ff1681565b604051610112919061106f565b336001600160a01b038416146102b45760405163e15e56c960e01b815260040160405180910390fd5b5f6102be846107bc565b90506102c9816107cf565b156102e75760405163945b63f560e01b815260040160405180910390fd5b6102f28160016107dc565b5050505050565b610302816107f2565b50336004805c6001600160a01b0319168217905d5050565b5f610324826107bc565b905061032f81610824565b8061033e575061033e816107cf565b1561035c5760405163441e4c7360e11b815260040160405180910390fd5b5f61036882600161082d565b905083815d50505050565b336001600160a01b0384161461039c5760405163e15e56c960e01b815260040160405180910390fd5b5f6103a6846107bc565b90506103b181610824565b156103cf57604051630bbb04d960e11b815260040160405180910390fd5b6102f2816001610882565b60605f6103e98686868661088e565b9050805160026103f991906110a9565b67ffffffffffffffff811115610411576104116110bc565b60405190808252806020026020018201604052801561045d57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161042f5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016104a694939291906110d0565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106104e8576104e8611118565b60209081029190910101525f5b81518110156105495781818151811061051057610510611118565b60200260200101518382600161052691906110a9565b8151811061053657610536611118565b60209081029190910101526001016104f5565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161059094939291906110d0565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516105cf919061112c565b815181106105df576105df611118565b602002602001018190525050949350505050565b5f610605610600836107bc565b610bb0565b92915050565b60605f61064c84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610bbd915050565b90505f61069b85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506014925061069691508290508861112c565b610c26565b90505f818060200190518101906106b2919061114f565b6040516001600160601b0319606086901b16602082015290915060340160408051601f1981840301815291905281519094505f5b81811015610734578583828151811061070157610701611118565b602002602001015160405160200161071a92919061121a565b60408051601f1981840301815291905295506001016106e6565b505050505092915050565b336001600160a01b0360045c161461076a5760405163e15e56c960e01b815260040160405180910390fd5b5f610774826107bc565b905061077f81610824565b1580610791575061078f816107cf565b155b156107af57604051634bd439b560e11b815260040160405180910390fd5b6107b881610d2b565b5050565b5f5f6107c783610d42565b5c9392505050565b5f5f6107c783600361082d565b5f6107e883600361082d565b905081815d505050565b5f6003805c908261080283611244565b9190505d505f61081183610d42565b905060035c80825d505060035c92915050565b5f5f6107c78360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107e883600261082d565b60605f6108cf84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610bbd915050565b90505f61091985858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506014925061069691508290508861112c565b90505f81806020019051810190610930919061114f565b905061093b81610da9565b61094481610db2565b80515f8167ffffffffffffffff811115610960576109606110bc565b6040519080825280602002602001820160405280156109ac57816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161097e5790505b5095505f5b82811015610ba0575f8482815181106109cc576109cc611118565b602002602001015190505f73eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee6001600160a01b0316826001600160a01b031614610a71576040516370a0823160e01b81526001600160a01b038d811660048301528316906370a0823190602401602060405180830381865afa158015610a48573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a6c919061125c565b610a7d565b8b6001600160a01b0316315b9050805f03610a8d575050610b98565b73eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeed196001600160a01b03831601610b06576040518060600160405280896001600160a01b0316815260200182815260200160405180602001604052805f815250815250898581518110610af657610af6611118565b6020026020010181905250610b88565b604080516060810182526001600160a01b0380851682525f60208301528251908b16602482015260448101849052909182019060640160408051601f198184030181529190526020810180516001600160e01b031663a9059cbb60e01b179052905289518a9086908110610b7c57610b7c611118565b60200260200101819052505b83610b9281611244565b94505050505b6001016109b1565b5085525092979650505050505050565b5f5f6107c783600161082d565b5f610bc98260146110a9565b83511015610c165760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b60608182601f011015610c6c5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610c0d565b610c7682846110a9565b84511015610cba5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610c0d565b606082158015610cd85760405191505f825260208201604052610d22565b6040519150601f8416801560200281840101858101878315602002848b0101015b81831015610d11578051835260209283019201610cf9565b5050858452601f01601f1916604052505b50949350505050565b610d35815f610882565b610d3f815f6107dc565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610d8c92919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b610d3f81610dbb565b610d3f81610e10565b80515f82528060051b8201601f19602084015b602001828111610e095780518282018051828111610dee57505050610dce565b5b602082015283018051828111610def575060200152610dce565b5050509052565b6002815110610d3f576020810160408201600183510160051b83015b8151835114610e4057602083019250815183525b602082019150808203610e2c57505081900360051c9052565b6001600160a01b0381168114610d3f575f5ffd5b5f5f83601f840112610e7d575f5ffd5b50813567ffffffffffffffff811115610e94575f5ffd5b602083019150836020828501011115610eab575f5ffd5b9250929050565b5f5f5f5f60608587031215610ec5575f5ffd5b8435610ed081610e59565b93506020850135610ee081610e59565b9250604085013567ffffffffffffffff811115610efb575f5ffd5b610f0787828801610e6d565b95989497509550505050565b5f60208284031215610f23575f5ffd5b8135610f2e81610e59565b9392505050565b5f5f60408385031215610f46575f5ffd5b823591506020830135610f5881610e59565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561101257868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610ffc90870182610f63565b9550506020938401939190910190600101610fb7565b50929695505050505050565b5f5f6020838503121561102f575f5ffd5b823567ffffffffffffffff811115611045575f5ffd5b61105185828601610e6d565b90969095509350505050565b602081525f610f2e6020830184610f63565b602081016003831061108f57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561060557610605611095565b634e487b7160e01b5f52604160045260245ffd5b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561060557610605611095565b805161114a81610e59565b919050565b5f6020828403121561115f575f5ffd5b815167ffffffffffffffff811115611175575f5ffd5b8201601f81018413611185575f5ffd5b805167ffffffffffffffff81111561119f5761119f6110bc565b8060051b604051601f19603f830116810181811067ffffffffffffffff821117156111cc576111cc6110bc565b6040529182526020818401810192908101878411156111e9575f5ffd5b6020850194505b8385101561120f576112018561113f565b8152602094850194016111f0565b509695505050505050565b5f83518060208601845e60609390931b6001600160601b0319169190920190815260140192915050565b5f6001820161125557611255611095565b5060010190565b5f6020828403121561126c575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// OfframpTokensHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use OfframpTokensHookMetaData.ABI instead.
+var OfframpTokensHookABI = OfframpTokensHookMetaData.ABI
+
+// OfframpTokensHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use OfframpTokensHookMetaData.Bin instead.
+var OfframpTokensHookBin = OfframpTokensHookMetaData.Bin
+
+// DeployOfframpTokensHook deploys a new Ethereum contract, binding an instance of OfframpTokensHook to it.
+func DeployOfframpTokensHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *OfframpTokensHook, error) {
+	parsed, err := OfframpTokensHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(OfframpTokensHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &OfframpTokensHook{OfframpTokensHookCaller: OfframpTokensHookCaller{contract: contract}, OfframpTokensHookTransactor: OfframpTokensHookTransactor{contract: contract}, OfframpTokensHookFilterer: OfframpTokensHookFilterer{contract: contract}}, nil
+}
+
+// OfframpTokensHook is an auto generated Go binding around an Ethereum contract.
+type OfframpTokensHook struct {
+	OfframpTokensHookCaller     // Read-only binding to the contract
+	OfframpTokensHookTransactor // Write-only binding to the contract
+	OfframpTokensHookFilterer   // Log filterer for contract events
+}
+
+// OfframpTokensHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type OfframpTokensHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OfframpTokensHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type OfframpTokensHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OfframpTokensHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type OfframpTokensHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// OfframpTokensHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type OfframpTokensHookSession struct {
+	Contract     *OfframpTokensHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// OfframpTokensHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type OfframpTokensHookCallerSession struct {
+	Contract *OfframpTokensHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// OfframpTokensHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type OfframpTokensHookTransactorSession struct {
+	Contract     *OfframpTokensHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// OfframpTokensHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type OfframpTokensHookRaw struct {
+	Contract *OfframpTokensHook // Generic contract binding to access the raw methods on
+}
+
+// OfframpTokensHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type OfframpTokensHookCallerRaw struct {
+	Contract *OfframpTokensHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// OfframpTokensHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type OfframpTokensHookTransactorRaw struct {
+	Contract *OfframpTokensHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewOfframpTokensHook creates a new instance of OfframpTokensHook, bound to a specific deployed contract.
+func NewOfframpTokensHook(address common.Address, backend bind.ContractBackend) (*OfframpTokensHook, error) {
+	contract, err := bindOfframpTokensHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &OfframpTokensHook{OfframpTokensHookCaller: OfframpTokensHookCaller{contract: contract}, OfframpTokensHookTransactor: OfframpTokensHookTransactor{contract: contract}, OfframpTokensHookFilterer: OfframpTokensHookFilterer{contract: contract}}, nil
+}
+
+// NewOfframpTokensHookCaller creates a new read-only instance of OfframpTokensHook, bound to a specific deployed contract.
+func NewOfframpTokensHookCaller(address common.Address, caller bind.ContractCaller) (*OfframpTokensHookCaller, error) {
+	contract, err := bindOfframpTokensHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OfframpTokensHookCaller{contract: contract}, nil
+}
+
+// NewOfframpTokensHookTransactor creates a new write-only instance of OfframpTokensHook, bound to a specific deployed contract.
+func NewOfframpTokensHookTransactor(address common.Address, transactor bind.ContractTransactor) (*OfframpTokensHookTransactor, error) {
+	contract, err := bindOfframpTokensHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &OfframpTokensHookTransactor{contract: contract}, nil
+}
+
+// NewOfframpTokensHookFilterer creates a new log filterer instance of OfframpTokensHook, bound to a specific deployed contract.
+func NewOfframpTokensHookFilterer(address common.Address, filterer bind.ContractFilterer) (*OfframpTokensHookFilterer, error) {
+	contract, err := bindOfframpTokensHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &OfframpTokensHookFilterer{contract: contract}, nil
+}
+
+// bindOfframpTokensHook binds a generic wrapper to an already deployed contract.
+func bindOfframpTokensHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := OfframpTokensHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OfframpTokensHook *OfframpTokensHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OfframpTokensHook.Contract.OfframpTokensHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OfframpTokensHook *OfframpTokensHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.OfframpTokensHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OfframpTokensHook *OfframpTokensHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.OfframpTokensHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_OfframpTokensHook *OfframpTokensHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _OfframpTokensHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_OfframpTokensHook *OfframpTokensHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_OfframpTokensHook *OfframpTokensHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_OfframpTokensHook *OfframpTokensHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_OfframpTokensHook *OfframpTokensHookSession) SUBTYPE() ([32]byte, error) {
+	return _OfframpTokensHook.Contract.SUBTYPE(&_OfframpTokensHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _OfframpTokensHook.Contract.SUBTYPE(&_OfframpTokensHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookSession) Asset() (common.Address, error) {
+	return _OfframpTokensHook.Contract.Asset(&_OfframpTokensHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) Asset() (common.Address, error) {
+	return _OfframpTokensHook.Contract.Asset(&_OfframpTokensHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_OfframpTokensHook *OfframpTokensHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_OfframpTokensHook *OfframpTokensHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _OfframpTokensHook.Contract.Build(&_OfframpTokensHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _OfframpTokensHook.Contract.Build(&_OfframpTokensHook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookSession) ExecutionNonce() (*big.Int, error) {
+	return _OfframpTokensHook.Contract.ExecutionNonce(&_OfframpTokensHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _OfframpTokensHook.Contract.ExecutionNonce(&_OfframpTokensHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _OfframpTokensHook.Contract.GetOutAmount(&_OfframpTokensHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _OfframpTokensHook.Contract.GetOutAmount(&_OfframpTokensHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_OfframpTokensHook *OfframpTokensHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_OfframpTokensHook *OfframpTokensHookSession) HookType() (uint8, error) {
+	return _OfframpTokensHook.Contract.HookType(&_OfframpTokensHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) HookType() (uint8, error) {
+	return _OfframpTokensHook.Contract.HookType(&_OfframpTokensHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes result)
+func (_OfframpTokensHook *OfframpTokensHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes result)
+func (_OfframpTokensHook *OfframpTokensHookSession) Inspect(data []byte) ([]byte, error) {
+	return _OfframpTokensHook.Contract.Inspect(&_OfframpTokensHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes result)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _OfframpTokensHook.Contract.Inspect(&_OfframpTokensHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookSession) LastCaller() (common.Address, error) {
+	return _OfframpTokensHook.Contract.LastCaller(&_OfframpTokensHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) LastCaller() (common.Address, error) {
+	return _OfframpTokensHook.Contract.LastCaller(&_OfframpTokensHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookSession) SpToken() (common.Address, error) {
+	return _OfframpTokensHook.Contract.SpToken(&_OfframpTokensHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) SpToken() (common.Address, error) {
+	return _OfframpTokensHook.Contract.SpToken(&_OfframpTokensHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_OfframpTokensHook *OfframpTokensHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_OfframpTokensHook *OfframpTokensHookSession) Subtype() ([32]byte, error) {
+	return _OfframpTokensHook.Contract.Subtype(&_OfframpTokensHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) Subtype() ([32]byte, error) {
+	return _OfframpTokensHook.Contract.Subtype(&_OfframpTokensHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _OfframpTokensHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookSession) UsedShares() (*big.Int, error) {
+	return _OfframpTokensHook.Contract.UsedShares(&_OfframpTokensHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_OfframpTokensHook *OfframpTokensHookCallerSession) UsedShares() (*big.Int, error) {
+	return _OfframpTokensHook.Contract.UsedShares(&_OfframpTokensHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _OfframpTokensHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_OfframpTokensHook *OfframpTokensHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.PostExecute(&_OfframpTokensHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.PostExecute(&_OfframpTokensHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _OfframpTokensHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_OfframpTokensHook *OfframpTokensHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.PreExecute(&_OfframpTokensHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.PreExecute(&_OfframpTokensHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.ResetExecutionState(&_OfframpTokensHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.ResetExecutionState(&_OfframpTokensHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.SetExecutionContext(&_OfframpTokensHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.SetExecutionContext(&_OfframpTokensHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.SetOutAmount(&_OfframpTokensHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_OfframpTokensHook *OfframpTokensHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _OfframpTokensHook.Contract.SetOutAmount(&_OfframpTokensHook.TransactOpts, _outAmount, caller)
+}
@@ -31,13 +31,35 @@ var (
 
 // PendlePTYieldSourceOracleMetaData contains all meta data concerning the PendlePTYieldSourceOracle contract.
 var PendlePTYieldSourceOracleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TWAP_DURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"assetsOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"balance\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"price\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"sharesOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"tvl\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"tvl\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"TwapDurationSet\",\"inputs\":[{\"name\":\"newDuration\",\"type\":\"uint32\",\"indexed\":false,\"internalType\":\"uint32\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ASSET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AVAILABLE_ERC20_ON_CHAIN\",\"inputs\":[]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"TWAP_DURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint32\",\"internalType\":\"uint32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"assetsOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"balance\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"price\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"sharesOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"tvl\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"tvl\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"market\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"TwapDurationSet\",\"inputs\":[{\"name\":\"newDuration\",\"type\":\"uint32\",\"indexed\":false,\"internalType\":\"uint32\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ASSET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AVAILABLE_ERC20_ON_CHAIN\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b506040516122e73803806122e783398101604081905261002e9161007c565b6001600160a01b03811660805261038460a08190526040519081527fae45eae27fdd572bcc5daa11e5155fef7d0b5081d88a374f0580bf91bfdc29b99060200160405180910390a1506100a9565b5f6020828403121561008c575f5ffd5b81516001600160a01b03811681146100a2575f5ffd5b9392505050565b60805160a05161220f6100d85f395f81816101bf0152610c4201525f81816101800152610467015261220f5ff3fe608060405234801561000f575f5ffd5b50600436106100e5575f3560e01c8063879ac8f811610088578063cacc7b0e11610063578063cacc7b0e14610229578063d449a8321461023c578063ec422afd14610262578063fea8af5f14610275575f5ffd5b8063879ac8f8146101ba578063a7a128b4146101f6578063aa5815fd14610216575f5ffd5b806334f99b48116100c357806334f99b48146101355780634fecb266146101555780637eeb8107146101685780638717164a1461017b575f5ffd5b8063056f143c146100e95780630f40517a1461010f5780632f112c4614610122575b5f5ffd5b6100fc6100f7366004611935565b610288565b6040519081526020015b60405180910390f35b6100fc61011d366004611973565b6103ae565b6100fc61013036600461198e565b610441565b610148610143366004611ac0565b6106a6565b6040516101069190611b9e565b6100fc610163366004611c2a565b61084c565b6100fc610176366004611935565b6108ed565b6101a27f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b039091168152602001610106565b6101e17f000000000000000000000000000000000000000000000000000000000000000081565b60405163ffffffff9091168152602001610106565b610209610204366004611c61565b6109f6565b6040516101069190611c93565b6100fc610224366004611935565b610a98565b610209610237366004611c61565b610b97565b61025061024a366004611973565b50601290565b60405160ff9091168152602001610106565b6100fc610270366004611973565b610c32565b6100fc610283366004611c2a565b610c66565b5f5f61029385610c32565b9050805f036102a5575f9150506103a7565b5f6102af86610cdd565b90505f6102bb82610d49565b925050505f6102c988610dd3565b6001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610304573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103289190611cea565b90505f60128360ff16116103605761034460ff84166012611d17565b61034f90600a611e0d565b6103599088611e18565b9050610387565b610384876001610374601260ff8816611d17565b61037f90600a611e0d565b610e3e565b90505b61039f8161039960ff8516600a611e0d565b87610e3e565b955050505050505b9392505050565b5f5f6103b983610dd3565b90505f816001600160a01b03166318160ddd6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156103f8573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061041c9190611e2f565b9050805f0361042e57505f9392505050565b610439845f83610a98565b949350505050565b5f5f61044e868685610a98565b604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa9250505080156104d2575060408051601f3d908101601f191682019092526104cf91810190611e46565b60015b6104dd57905061069d565b5f81602001511180156104fc575060808101516001600160a01b031615155b1561069957805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa158015610548573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061056c9190611e2f565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa1580156105b8573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105dc9190611cea565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa15801561065d573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906106819190611e2f565b905061068d8186611ed5565b9550505050505061069d565b5090505b95945050505050565b815181516060919081146106cd57604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff8111156106e6576106e66119e5565b60405190808252806020026020018201604052801561071957816020015b60608152602001906001900390816107045790505b5091505f5b81811015610844575f85828151811061073957610739611ee8565b602002602001015190505f85838151811061075657610756611ee8565b602002602001015190505f815190508067ffffffffffffffff81111561077e5761077e6119e5565b6040519080825280602002602001820160405280156107a7578160200160208202803683370190505b508685815181106107ba576107ba611ee8565b60200260200101819052505f5b81811015610835575f6107f3858584815181106107e6576107e6611ee8565b602002602001015161084c565b90508088878151811061080857610808611ee8565b6020026020010151838151811061082157610821611ee8565b6020908102919091010152506001016107c7565b5050505080600101905061071e565b505092915050565b5f5f61085784610dd3565b6040516370a0823160e01b81526001600160a01b0385811660048301529192505f918316906370a0823190602401602060405180830381865afa1580156108a0573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108c49190611e2f565b9050805f036108d7575f925050506108e7565b6108e2855f83610a98565b925050505b92915050565b5f5f6108f885610c32565b9050805f0361090a575f9150506103a7565b5f61091486610cdd565b90505f61092082610d49565b925050505f61092e88610dd3565b6001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610969573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061098d9190611cea565b90505f60128360ff16116109c5576109a960ff84166012611d17565b6109b490600a611e0d565b6109be9088611e18565b90506109dc565b6109d9876001610374601260ff8816611d17565b90505b61039f816109ee60ff8516600a611e0d565b876001610eee565b80516060908067ffffffffffffffff811115610a1457610a146119e5565b604051908082528060200260200182016040528015610a3d578160200160208202803683370190505b5091505f5b81811015610a9157610a6c848281518110610a5f57610a5f611ee8565b6020026020010151610c32565b838281518110610a7e57610a7e611ee8565b6020908102919091010152600101610a42565b5050919050565b5f5f610aa385610c32565b90505f610aaf86610dd3565b6001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610aea573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b0e9190611cea565b90505f610b1a87610cdd565b90505f610b2682610d49565b925050505f610b4087868660ff16600a61037f9190611e0d565b905060128260ff1610610b7757610b5b601260ff8416611d17565b610b6690600a611e0d565b610b709082611e18565b9550610b8b565b61039f81600161037460ff86166012611d17565b50505050509392505050565b80516060908067ffffffffffffffff811115610bb557610bb56119e5565b604051908082528060200260200182016040528015610bde578160200160208202803683370190505b5091505f5b81811015610a9157610c0d848281518110610c0057610c00611ee8565b60200260200101516103ae565b838281518110610c1f57610c1f611ee8565b6020908102919091010152600101610be3565b5f6108e76001600160a01b0383167f0000000000000000000000000000000000000000000000000000000000000000610f30565b5f5f610c7184610dd3565b6040516370a0823160e01b81526001600160a01b038581166004830152919250908216906370a0823190602401602060405180830381865afa158015610cb9573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104399190611e2f565b5f5f826001600160a01b0316632c8ce6bc6040518163ffffffff1660e01b8152600401606060405180830381865afa158015610d1b573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d3f9190611efc565b5090949350505050565b5f5f5f5f5f5f866001600160a01b031663a40bee506040518163ffffffff1660e01b8152600401606060405180830381865afa158015610d8b573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610daf9190611f46565b925092509250826001811115610dc757610dc7611f8e565b97919650945092505050565b5f5f826001600160a01b0316632c8ce6bc6040518163ffffffff1660e01b8152600401606060405180830381865afa158015610e11573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610e359190611efc565b50949350505050565b5f5f5f610e4b8686610f7a565b91509150815f03610e6f57838181610e6557610e65611fa2565b04925050506103a7565b818411610e8657610e866003851502601118610f96565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f610f1b610efb83610fa7565b8015610f1657505f8480610f1157610f11611fa2565b868809115b151590565b610f26868686610e3e565b61069d9190611ed5565b5f5f5f610f3c85610fd3565b91509150808210610f5a57610f5185856111f6565b925050506108e7565b8082610f6687876111f6565b610f709190611e18565b610f519190611fb6565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b5f6002826003811115610fbc57610fbc611f8e565b610fc69190611fc9565b60ff166001149050919050565b5f5f5f5f846001600160a01b0316632c8ce6bc6040518163ffffffff1660e01b8152600401606060405180830381865afa158015611013573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906110379190611efc565b9250509150816001600160a01b0316633ba0b9a96040518163ffffffff1660e01b8152600401602060405180830381865afa158015611078573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061109c9190611e2f565b93505f816001600160a01b031663d2a3584e6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156110db573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906110ff9190611e2f565b9050816001600160a01b031663516399df6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561113d573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906111619190611fea565b80156111d4575043826001600160a01b03166360e0a9e16040518163ffffffff1660e01b8152600401602060405180830381865afa1580156111a5573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906111c99190612009565b6001600160801b0316145b156111e1578093506111ee565b6111eb85826112be565b93505b505050915091565b5f5f836001600160a01b031663e184c9be6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611234573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906112589190611e2f565b905042811161127257670de0b6b3a76400009150506108e7565b5f61127d85856112d3565b90505f61128a4284611d17565b90505f61129f61129a848461147b565b6114b3565b90506112b3670de0b6b3a7640000826114c4565b9450505050506108e7565b5f8183116112cc57816103a7565b5090919050565b5f8163ffffffff165f03611360575f836001600160a01b031663c3fb90d66040518163ffffffff1660e01b815260040160c060405180830381865afa15801561131e573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906113429190612051565b50505092505050806bffffffffffffffffffffffff169150506108e7565b6040805160028082526060820183525f9260208301908036833701905050905082815f8151811061139357611393611ee8565b63ffffffff9092166020928302919091019091015260405163883bdbfd60e01b81525f906001600160a01b0386169063883bdbfd906113d69085906004016120d3565b5f60405180830381865afa1580156113f0573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526114179190810190612110565b90508363ffffffff16815f8151811061143257611432611ee8565b60200260200101518260018151811061144d5761144d611ee8565b602002602001015161145f91906121b5565b61146991906121d4565b6001600160d81b031695945050505050565b5f8061148c6201518061016d611e18565b6114968486611e18565b6114a09190611fb6565b90506104396114ae826114f2565b611506565b5f5f8212156114c0575f5ffd5b5090565b5f806114d8670de0b6b3a764000085611e18565b90508281816114e9576114e9611fa2565b04949350505050565b5f6001600160ff1b038211156114c0575f5ffd5b5f680238fd42c5cf03ffff19821215801561152a575068070c1cc73b00c800008213155b61156d5760405162461bcd60e51b815260206004820152601060248201526f125b9d985b1a5908195e1c1bdb995b9d60821b604482015260640160405180910390fd5b5f8212156115a457611580825f03611506565b6ec097ce7bc90715b34b9f10000000008161159d5761159d611fa2565b0592915050565b5f6806f05b59d3b200000083126115e357506806f05b59d3b1ffffff1990910190770195e54c5dd42177f53a27172fa9ec630262827000000000611619565b6803782dace9d9000000831261161557506803782dace9d8ffffff19909101906b1425982cf597cd205cef7380611619565b5060015b6064929092029168056bc75e2d6310000068ad78ebc5ac6200000084126116695768ad78ebc5ac61ffffff199093019268056bc75e2d631000006e01855144814a7ff805980ff008400082020590505b6856bc75e2d63100000084126116a5576856bc75e2d630ffffff199093019268056bc75e2d631000006b02df0ab5a80a22c61ab5a70082020590505b682b5e3af16b1880000084126116df57682b5e3af16b187fffff199093019268056bc75e2d63100000693f1fce3da636ea5cf85082020590505b6815af1d78b58c4000008412611719576815af1d78b58c3fffff199093019268056bc75e2d63100000690127fa27722cc06cc5e282020590505b680ad78ebc5ac6200000841261175257680ad78ebc5ac61fffff199093019268056bc75e2d6310000068280e60114edb805d0382020590505b68056bc75e2d63100000841261178b5768056bc75e2d630fffff199093019268056bc75e2d63100000680ebc5fb4174612111082020590505b6802b5e3af16b188000084126117c4576802b5e3af16b187ffff199093019268056bc75e2d631000006808f00f760a4b2db55d82020590505b68015af1d78b58c4000084126117fd5768015af1d78b58c3ffff199093019268056bc75e2d631000006806f5f177578893793782020590505b68056bc75e2d631000008481019085906002908280020505918201919050600368056bc75e2d631000008783020505918201919050600468056bc75e2d631000008783020505918201919050600568056bc75e2d631000008783020505918201919050600668056bc75e2d631000008783020505918201919050600768056bc75e2d631000008783020505918201919050600868056bc75e2d631000008783020505918201919050600968056bc75e2d631000008783020505918201919050600a68056bc75e2d631000008783020505918201919050600b68056bc75e2d631000008783020505918201919050600c68056bc75e2d631000008783020505918201919050606468056bc75e2d63100000848402058502059695505050505050565b6001600160a01b0381168114611932575f5ffd5b50565b5f5f5f60608486031215611947575f5ffd5b83356119528161191e565b925060208401356119628161191e565b929592945050506040919091013590565b5f60208284031215611983575f5ffd5b81356103a78161191e565b5f5f5f5f5f60a086880312156119a2575f5ffd5b8535945060208601356119b48161191e565b935060408601356119c48161191e565b925060608601356119d48161191e565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715611a2257611a226119e5565b604052919050565b5f67ffffffffffffffff821115611a4357611a436119e5565b5060051b60200190565b5f82601f830112611a5c575f5ffd5b8135611a6f611a6a82611a2a565b6119f9565b8082825260208201915060208360051b860101925085831115611a90575f5ffd5b602085015b83811015611ab6578035611aa88161191e565b835260209283019201611a95565b5095945050505050565b5f5f60408385031215611ad1575f5ffd5b823567ffffffffffffffff811115611ae7575f5ffd5b611af385828601611a4d565b925050602083013567ffffffffffffffff811115611b0f575f5ffd5b8301601f81018513611b1f575f5ffd5b8035611b2d611a6a82611a2a565b8082825260208201915060208360051b850101925087831115611b4e575f5ffd5b602084015b83811015611b8f57803567ffffffffffffffff811115611b71575f5ffd5b611b808a602083890101611a4d565b84525060209283019201611b53565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611c1e57868503603f19018452815180518087526020918201918701905f5b81811015611c05578351835260209384019390920191600101611be7565b5090965050506020938401939190910190600101611bc4565b50929695505050505050565b5f5f60408385031215611c3b575f5ffd5b8235611c468161191e565b91506020830135611c568161191e565b809150509250929050565b5f60208284031215611c71575f5ffd5b813567ffffffffffffffff811115611c87575f5ffd5b61043984828501611a4d565b602080825282518282018190525f918401906040840190835b81811015611cca578351835260209384019390920191600101611cac565b509095945050505050565b805160ff81168114611ce5575f5ffd5b919050565b5f60208284031215611cfa575f5ffd5b6103a782611cd5565b634e487b7160e01b5f52601160045260245ffd5b818103818111156108e7576108e7611d03565b6001815b6001841115611d6557808504811115611d4957611d49611d03565b6001841615611d5757908102905b60019390931c928002611d2e565b935093915050565b5f82611d7b575060016108e7565b81611d8757505f6108e7565b8160018114611d9d5760028114611da757611dc3565b60019150506108e7565b60ff841115611db857611db8611d03565b50506001821b6108e7565b5060208310610133831016604e8410600b8410161715611de6575081810a6108e7565b611df25f198484611d2a565b805f1904821115611e0557611e05611d03565b029392505050565b5f6103a78383611d6d565b80820281158282048414176108e7576108e7611d03565b5f60208284031215611e3f575f5ffd5b5051919050565b5f60a0828403128015611e57575f5ffd5b5060405160a0810167ffffffffffffffff81118282101715611e7b57611e7b6119e5565b6040528251611e898161191e565b8152602083810151908201526040830151611ea38161191e565b60408201526060830151611eb68161191e565b60608201526080830151611ec98161191e565b60808201529392505050565b808201808211156108e7576108e7611d03565b634e487b7160e01b5f52603260045260245ffd5b5f5f5f60608486031215611f0e575f5ffd5b8351611f198161191e565b6020850151909350611f2a8161191e565b6040850151909250611f3b8161191e565b809150509250925092565b5f5f5f60608486031215611f58575f5ffd5b835160028110611f66575f5ffd5b6020850151909350611f778161191e565b9150611f8560408501611cd5565b90509250925092565b634e487b7160e01b5f52602160045260245ffd5b634e487b7160e01b5f52601260045260245ffd5b5f82611fc457611fc4611fa2565b500490565b5f60ff831680611fdb57611fdb611fa2565b8060ff84160691505092915050565b5f60208284031215611ffa575f5ffd5b815180151581146103a7575f5ffd5b5f60208284031215612019575f5ffd5b81516001600160801b03811681146103a7575f5ffd5b8051600f81900b8114611ce5575f5ffd5b805161ffff81168114611ce5575f5ffd5b5f5f5f5f5f5f60c08789031215612066575f5ffd5b61206f8761202f565b955061207d6020880161202f565b945060408701516bffffffffffffffffffffffff8116811461209d575f5ffd5b93506120ab60608801612040565b92506120b960808801612040565b91506120c760a08801612040565b90509295509295509295565b602080825282518282018190525f918401906040840190835b81811015611cca57835163ffffffff168352602093840193909201916001016120ec565b5f60208284031215612120575f5ffd5b815167ffffffffffffffff811115612136575f5ffd5b8201601f81018413612146575f5ffd5b8051612154611a6a82611a2a565b8082825260208201915060208360051b850101925086831115612175575f5ffd5b6020840193505b828410156121ab5783516001600160d81b038116811461219a575f5ffd5b82526020938401939091019061217c565b9695505050505050565b6001600160d81b0382811682821603908111156108e7576108e7611d03565b5f6001600160d81b038316806121ec576121ec611fa2565b6001600160d81b0392909216919091049291505056fea164736f6c634300081e000a",
 }
 
 // PendlePTYieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use PendlePTYieldSourceOracleMetaData.ABI instead.
 var PendlePTYieldSourceOracleABI = PendlePTYieldSourceOracleMetaData.ABI
 
+// PendlePTYieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use PendlePTYieldSourceOracleMetaData.Bin instead.
+var PendlePTYieldSourceOracleBin = PendlePTYieldSourceOracleMetaData.Bin
+
+// DeployPendlePTYieldSourceOracle deploys a new Ethereum contract, binding an instance of PendlePTYieldSourceOracle to it.
+func DeployPendlePTYieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *PendlePTYieldSourceOracle, error) {
+	parsed, err := PendlePTYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(PendlePTYieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &PendlePTYieldSourceOracle{PendlePTYieldSourceOracleCaller: PendlePTYieldSourceOracleCaller{contract: contract}, PendlePTYieldSourceOracleTransactor: PendlePTYieldSourceOracleTransactor{contract: contract}, PendlePTYieldSourceOracleFilterer: PendlePTYieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // PendlePTYieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type PendlePTYieldSourceOracle struct {
 	PendlePTYieldSourceOracleCaller     // Read-only binding to the contract
@@ -583,6 +605,37 @@ func (_PendlePTYieldSourceOracle *PendlePTYieldSourceOracleCallerSession) GetTVL
 	return _PendlePTYieldSourceOracle.Contract.GetTVLMultiple(&_PendlePTYieldSourceOracle.CallOpts, yieldSourceAddresses)
 }
 
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address market, address , uint256 assetsIn) view returns(uint256)
+func (_PendlePTYieldSourceOracle *PendlePTYieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, market common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _PendlePTYieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", market, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address market, address , uint256 assetsIn) view returns(uint256)
+func (_PendlePTYieldSourceOracle *PendlePTYieldSourceOracleSession) GetWithdrawalShareOutput(market common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _PendlePTYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_PendlePTYieldSourceOracle.CallOpts, market, arg1, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address market, address , uint256 assetsIn) view returns(uint256)
+func (_PendlePTYieldSourceOracle *PendlePTYieldSourceOracleCallerSession) GetWithdrawalShareOutput(market common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _PendlePTYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_PendlePTYieldSourceOracle.CallOpts, market, arg1, assetsIn)
+}
+
 // PendlePTYieldSourceOracleTwapDurationSetIterator is returned from FilterTwapDurationSet and is used to iterate over the raw logs and unpacked data for TwapDurationSet events raised by the PendlePTYieldSourceOracle contract.
 type PendlePTYieldSourceOracleTwapDurationSetIterator struct {
 	Event *PendlePTYieldSourceOracleTwapDurationSet // Event containing the contract specifics and raw log
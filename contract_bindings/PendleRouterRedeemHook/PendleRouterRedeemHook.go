@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package PendleRouterRedeemHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// PendleRouterRedeemHookMetaData contains all meta data concerning the PendleRouterRedeemHook contract.
+var PendleRouterRedeemHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"pendleRouterV4_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pendleRouterV4\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIPendleRouterV4\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DATA_LENGTH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MIN_TOKEN_OUT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"RECEIVER_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"TOKEN_OUT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"YT_NOT_VALID\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161198138038061198183398101604081905261002e916100af565b6040805180820190915260048152631415165560e21b6020909101525f805460ff191690557fb86a7e98c9c656516a060ac5bc14d0453b037386adfb5f9f2ae12ee7a543e1b46080526001600160a01b03811661009e57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a0516118686101195f395f818161029d01528181610946015281816109fa0152610a9801525f81816101f301526102c401526118685ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063a131db561161006e578063a131db5614610298578063cf0a6181146102bf578063d06a1e89146102e6578063e445e7dd146102f9578063e774551714610312575f5ffd5b8063685a943c1461023957806381cbe6911461024157806384542a24146102545780638c4313c1146102665780638e14877614610286575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806338d52e0f146101bf5780633b5896bc146101d15780635492e677146101f157806360b5be2914610217575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a61015536600461128c565b610325565b005b61015a61016a3660046112e9565b61039f565b61015a61017d366004611302565b6103c0565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba36600461128c565b610419565b6101946001600160a01b0360025c1681565b6101e46101df36600461128c565b61048c565b60405161013e919061135a565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b61022960ff600160a81b60025c041681565b604051901515815260200161013e565b6101345f5c81565b61013461024f3660046112e9565b6106a5565b61022960ff600160a01b60025c041681565b6102796102743660046113e7565b6106bd565b60405161013e9190611426565b6101946001600160a01b0360015c1681565b6101947f000000000000000000000000000000000000000000000000000000000000000081565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b61015a6102f43660046112e9565b610727565b5f546103059060ff1681565b60405161013e919061144c565b610229610320366004611551565b610779565b336001600160a01b0384161461034e5760405163e15e56c960e01b815260040160405180910390fd5b5f610358846107a8565b9050610363816107bb565b156103815760405163945b63f560e01b815260040160405180910390fd5b61038c8160016107c8565b610398858585856107de565b5050505050565b6103a88161080b565b50336004805c6001600160a01b0319168217905d5050565b5f6103ca826107a8565b90506103d58161083d565b806103e457506103e4816107bb565b156104025760405163441e4c7360e11b815260040160405180910390fd5b5f61040e826001610846565b905083815d50505050565b336001600160a01b038416146104425760405163e15e56c960e01b815260040160405180910390fd5b5f61044c846107a8565b90506104578161083d565b1561047557604051630bbb04d960e11b815260040160405180910390fd5b61048081600161089b565b610398858585856108a7565b60605f61049b868686866108b5565b9050805160026104ab9190611597565b67ffffffffffffffff8111156104c3576104c3611466565b60405190808252806020026020018201604052801561050f57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104e15790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161055894939291906115aa565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061059a5761059a6115f2565b60209081029190910101525f5b81518110156105fb578181815181106105c2576105c26115f2565b6020026020010151838260016105d89190611597565b815181106105e8576105e86115f2565b60209081029190910101526001016105a7565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161064294939291906115aa565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106819190611606565b81518110610691576106916115f2565b602002602001018190525050949350505050565b5f6106b76106b2836107a8565b610b40565b92915050565b60605f6106ca8484610b4d565b60208082015160408084015160608086015192516bffffffffffffffffffffffff1994821b85169581019590955290811b831660348501521b166048820152909150605c0160405160208183030381529060405291505092915050565b5f610731826107a8565b905061073c8161083d565b158061074e575061074c816107bb565b155b1561076c57604051634bd439b560e11b815260040160405180910390fd5b61077581610e25565b5050565b5f607d8251101561079d576040516358f385d760e01b815260040160405180910390fd5b6106b782607c610e3c565b5f5f6107b383610e68565b5c9392505050565b5f5f6107b3836003610846565b5f6107d4836003610846565b905081815d505050565b6108056107ea846106a5565b6107f5848487610ed4565b6107ff9190611606565b84610fd1565b50505050565b5f6003805c908261081b83611619565b9190505d505f61082a83610e68565b905060035c80825d505060035c92915050565b5f5f6107b38360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107d4836002610846565b6108056107ff838386610ed4565b60605f6108c28484610b4d565b90505f6108d8825f01518360a001518989610fe9565b60408051600380825260808201909252919250816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816108f0579050509250604051806060016040528083604001516001600160a01b031681526020015f81526020017f00000000000000000000000000000000000000000000000000000000000000008360405160240161098b9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052835184905f906109c9576109c96115f2565b6020026020010181905250604051806060016040528083602001516001600160a01b031681526020015f81526020017f000000000000000000000000000000000000000000000000000000000000000083604051602401610a3f9291906001600160a01b03929092168252602082015260400190565b60408051601f198184030181529190526020810180516001600160e01b031663095ea7b360e01b1790529052835184906001908110610a8057610a806115f2565b602002602001018190525060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f8152602001878460200151848660c00151604051602401610aea9493929190611631565b60408051601f198184030181529190526020810180516001600160e01b03166323f8ef1160e11b1790529052835184906002908110610b2b57610b2b6115f2565b60200260200101819052505050949350505050565b5f5f6107b3836001610846565b610b55611170565b607d821015610b77576040516358f385d760e01b815260040160405180910390fd5b610bb583838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92018290525092506110aa915050565b8152604080516020601f8501819004810282018101909252838152610bf69185908590819084018382808284375f920191909152506020925061110c915050565b6001600160a01b031660208083019190915260408051601f8501839004830281018301909152838152610c459185908590819084018382808284375f920191909152506034925061110c915050565b6001600160a01b031660408083019190915280516020601f8501819004810282018101909252838152610c949185908590819084018382808284375f920191909152506048925061110c915050565b6001600160a01b03166060820152604080516020601f8501819004810282018101909252838152610ce19185908590819084018382808284375f92019190915250605c92506110aa915050565b6080820152604080516020601f8501819004810282018101909252838152610d259185908590819084018382808284375f92019190915250607c9250610e3c915050565b151560a082015260208101516001600160a01b0316610d57576040516326d9999560e11b815260040160405180910390fd5b60608101516001600160a01b0316610d82576040516389efedaf60e01b815260040160405180910390fd5b80608001515f03610da65760405163617d18e360e01b815260040160405180910390fd5b610db382607d81866116fb565b810190610dc09190611722565b60c08201819052606082015190516001600160a01b03908116911614610df9576040516389efedaf60e01b815260040160405180910390fd5b80608001518160c0015160200151146106b75760405163617d18e360e01b815260040160405180910390fd5b610e2f815f61089b565b610e39815f6107c8565b50565b5f828281518110610e4f57610e4f6115f2565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610eb792919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f605c80841015610ef8576040516358f385d760e01b815260040160405180910390fd5b5f610f3a86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506048925061110c915050565b90506001600160a01b038116610f5d575050506001600160a01b03811631610fca565b6040516370a0823160e01b81526001600160a01b0385811660048301528216906370a0823190602401602060405180830381865afa158015610fa1573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610fc59190611844565b925050505b9392505050565b5f610fdb826107a8565b90505f61040e826001610846565b5f831561107f576040516381cbe69160e01b81526001600160a01b0383811660048301528416906381cbe69190602401602060405180830381865afa158015611034573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906110589190611844565b9050805f0361107a576040516305112ab160e41b815260040160405180910390fd5b6110a2565b845f0361109f576040516305112ab160e41b815260040160405180910390fd5b50835b949350505050565b5f6110b6826020611597565b835110156111035760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b5f611118826014611597565b835110156111605760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064016110fa565b500160200151600160601b900490565b6040518060e001604052805f81526020015f6001600160a01b031681526020015f6001600160a01b031681526020015f6001600160a01b031681526020015f81526020015f151581526020016112276040518060a001604052805f6001600160a01b031681526020015f81526020015f6001600160a01b031681526020015f6001600160a01b031681526020016112276040805160808101909152805f81525f602082018190526060604083018190529091015290565b905290565b80356001600160a01b0381168114611242575f5ffd5b919050565b5f5f83601f840112611257575f5ffd5b50813567ffffffffffffffff81111561126e575f5ffd5b602083019150836020828501011115611285575f5ffd5b9250929050565b5f5f5f5f6060858703121561129f575f5ffd5b6112a88561122c565b93506112b66020860161122c565b9250604085013567ffffffffffffffff8111156112d1575f5ffd5b6112dd87828801611247565b95989497509550505050565b5f602082840312156112f9575f5ffd5b610fca8261122c565b5f5f60408385031215611313575f5ffd5b823591506113236020840161122c565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b828110156113db57868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906113c59087018261132c565b9550506020938401939190910190600101611380565b50929695505050505050565b5f5f602083850312156113f8575f5ffd5b823567ffffffffffffffff81111561140e575f5ffd5b61141a85828601611247565b90969095509350505050565b602081525f610fca602083018461132c565b634e487b7160e01b5f52602160045260245ffd5b602081016003831061146057611460611438565b91905290565b634e487b7160e01b5f52604160045260245ffd5b60405160a0810167ffffffffffffffff8111828210171561149d5761149d611466565b60405290565b6040516080810167ffffffffffffffff8111828210171561149d5761149d611466565b5f82601f8301126114d5575f5ffd5b813567ffffffffffffffff8111156114ef576114ef611466565b604051601f8201601f19908116603f0116810167ffffffffffffffff8111828210171561151e5761151e611466565b604052818152838201602001851015611535575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611561575f5ffd5b813567ffffffffffffffff811115611577575f5ffd5b6110a2848285016114c6565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106b7576106b7611583565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106b7576106b7611583565b5f6001820161162a5761162a611583565b5060010190565b6001600160a01b03858116825284811660208084019190915260408084018690526080606080860182905286518516828701529286015160a08681019190915291860151841660c08601529185015190921660e084015283015161010083019190915280515f9190600b81106116a9576116a9611438565b61012084015260208101516001600160a01b0316610140840152604081015160806101608501526116de6101a085018261132c565b905060608201511515610180850152809250505095945050505050565b5f5f85851115611709575f5ffd5b83861115611715575f5ffd5b5050820193919092039150565b5f60208284031215611732575f5ffd5b813567ffffffffffffffff811115611748575f5ffd5b820160a08185031215611759575f5ffd5b61176161147a565b61176a8261122c565b8152602082810135908201526117826040830161122c565b60408201526117936060830161122c565b6060820152608082013567ffffffffffffffff8111156117b1575f5ffd5b9190910190608082860312156117c5575f5ffd5b6117cd6114a3565b8235600b81106117db575f5ffd5b81526117e96020840161122c565b6020820152604083013567ffffffffffffffff811115611807575f5ffd5b611813878286016114c6565b60408301525060608301359250821515831461182d575f5ffd5b606081019290925260808101919091529392505050565b5f60208284031215611854575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// PendleRouterRedeemHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use PendleRouterRedeemHookMetaData.ABI instead.
+var PendleRouterRedeemHookABI = PendleRouterRedeemHookMetaData.ABI
+
+// PendleRouterRedeemHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use PendleRouterRedeemHookMetaData.Bin instead.
+var PendleRouterRedeemHookBin = PendleRouterRedeemHookMetaData.Bin
+
+// DeployPendleRouterRedeemHook deploys a new Ethereum contract, binding an instance of PendleRouterRedeemHook to it.
+func DeployPendleRouterRedeemHook(auth *bind.TransactOpts, backend bind.ContractBackend, pendleRouterV4_ common.Address) (common.Address, *types.Transaction, *PendleRouterRedeemHook, error) {
+	parsed, err := PendleRouterRedeemHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(PendleRouterRedeemHookBin), backend, pendleRouterV4_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &PendleRouterRedeemHook{PendleRouterRedeemHookCaller: PendleRouterRedeemHookCaller{contract: contract}, PendleRouterRedeemHookTransactor: PendleRouterRedeemHookTransactor{contract: contract}, PendleRouterRedeemHookFilterer: PendleRouterRedeemHookFilterer{contract: contract}}, nil
+}
+
+// PendleRouterRedeemHook is an auto generated Go binding around an Ethereum contract.
+type PendleRouterRedeemHook struct {
+	PendleRouterRedeemHookCaller     // Read-only binding to the contract
+	PendleRouterRedeemHookTransactor // Write-only binding to the contract
+	PendleRouterRedeemHookFilterer   // Log filterer for contract events
+}
+
+// PendleRouterRedeemHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PendleRouterRedeemHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PendleRouterRedeemHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PendleRouterRedeemHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PendleRouterRedeemHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PendleRouterRedeemHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PendleRouterRedeemHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PendleRouterRedeemHookSession struct {
+	Contract     *PendleRouterRedeemHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts           // Call options to use throughout this session
+	TransactOpts bind.TransactOpts       // Transaction auth options to use throughout this session
+}
+
+// PendleRouterRedeemHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PendleRouterRedeemHookCallerSession struct {
+	Contract *PendleRouterRedeemHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                 // Call options to use throughout this session
+}
+
+// PendleRouterRedeemHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PendleRouterRedeemHookTransactorSession struct {
+	Contract     *PendleRouterRedeemHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                 // Transaction auth options to use throughout this session
+}
+
+// PendleRouterRedeemHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PendleRouterRedeemHookRaw struct {
+	Contract *PendleRouterRedeemHook // Generic contract binding to access the raw methods on
+}
+
+// PendleRouterRedeemHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PendleRouterRedeemHookCallerRaw struct {
+	Contract *PendleRouterRedeemHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PendleRouterRedeemHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PendleRouterRedeemHookTransactorRaw struct {
+	Contract *PendleRouterRedeemHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPendleRouterRedeemHook creates a new instance of PendleRouterRedeemHook, bound to a specific deployed contract.
+func NewPendleRouterRedeemHook(address common.Address, backend bind.ContractBackend) (*PendleRouterRedeemHook, error) {
+	contract, err := bindPendleRouterRedeemHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterRedeemHook{PendleRouterRedeemHookCaller: PendleRouterRedeemHookCaller{contract: contract}, PendleRouterRedeemHookTransactor: PendleRouterRedeemHookTransactor{contract: contract}, PendleRouterRedeemHookFilterer: PendleRouterRedeemHookFilterer{contract: contract}}, nil
+}
+
+// NewPendleRouterRedeemHookCaller creates a new read-only instance of PendleRouterRedeemHook, bound to a specific deployed contract.
+func NewPendleRouterRedeemHookCaller(address common.Address, caller bind.ContractCaller) (*PendleRouterRedeemHookCaller, error) {
+	contract, err := bindPendleRouterRedeemHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterRedeemHookCaller{contract: contract}, nil
+}
+
+// NewPendleRouterRedeemHookTransactor creates a new write-only instance of PendleRouterRedeemHook, bound to a specific deployed contract.
+func NewPendleRouterRedeemHookTransactor(address common.Address, transactor bind.ContractTransactor) (*PendleRouterRedeemHookTransactor, error) {
+	contract, err := bindPendleRouterRedeemHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterRedeemHookTransactor{contract: contract}, nil
+}
+
+// NewPendleRouterRedeemHookFilterer creates a new log filterer instance of PendleRouterRedeemHook, bound to a specific deployed contract.
+func NewPendleRouterRedeemHookFilterer(address common.Address, filterer bind.ContractFilterer) (*PendleRouterRedeemHookFilterer, error) {
+	contract, err := bindPendleRouterRedeemHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterRedeemHookFilterer{contract: contract}, nil
+}
+
+// bindPendleRouterRedeemHook binds a generic wrapper to an already deployed contract.
+func bindPendleRouterRedeemHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PendleRouterRedeemHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PendleRouterRedeemHook.Contract.PendleRouterRedeemHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.PendleRouterRedeemHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.PendleRouterRedeemHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PendleRouterRedeemHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) Asset() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.Asset(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) Asset() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.Asset(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _PendleRouterRedeemHook.Contract.Build(&_PendleRouterRedeemHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _PendleRouterRedeemHook.Contract.Build(&_PendleRouterRedeemHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _PendleRouterRedeemHook.Contract.DecodeUsePrevHookAmount(&_PendleRouterRedeemHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _PendleRouterRedeemHook.Contract.DecodeUsePrevHookAmount(&_PendleRouterRedeemHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) ExecutionNonce() (*big.Int, error) {
+	return _PendleRouterRedeemHook.Contract.ExecutionNonce(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _PendleRouterRedeemHook.Contract.ExecutionNonce(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _PendleRouterRedeemHook.Contract.GetOutAmount(&_PendleRouterRedeemHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _PendleRouterRedeemHook.Contract.GetOutAmount(&_PendleRouterRedeemHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) HookType() (uint8, error) {
+	return _PendleRouterRedeemHook.Contract.HookType(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) HookType() (uint8, error) {
+	return _PendleRouterRedeemHook.Contract.HookType(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) Inspect(data []byte) ([]byte, error) {
+	return _PendleRouterRedeemHook.Contract.Inspect(&_PendleRouterRedeemHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _PendleRouterRedeemHook.Contract.Inspect(&_PendleRouterRedeemHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) LastCaller() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.LastCaller(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) LastCaller() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.LastCaller(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PendleRouterV4 is a free data retrieval call binding the contract method 0xa131db56.
+//
+// Solidity: function pendleRouterV4() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) PendleRouterV4(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "pendleRouterV4")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PendleRouterV4 is a free data retrieval call binding the contract method 0xa131db56.
+//
+// Solidity: function pendleRouterV4() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) PendleRouterV4() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.PendleRouterV4(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PendleRouterV4 is a free data retrieval call binding the contract method 0xa131db56.
+//
+// Solidity: function pendleRouterV4() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) PendleRouterV4() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.PendleRouterV4(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) PostExecuteMutex() (bool, error) {
+	return _PendleRouterRedeemHook.Contract.PostExecuteMutex(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _PendleRouterRedeemHook.Contract.PostExecuteMutex(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) PreExecuteMutex() (bool, error) {
+	return _PendleRouterRedeemHook.Contract.PreExecuteMutex(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _PendleRouterRedeemHook.Contract.PreExecuteMutex(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) SpToken() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.SpToken(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) SpToken() (common.Address, error) {
+	return _PendleRouterRedeemHook.Contract.SpToken(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) SubType() ([32]byte, error) {
+	return _PendleRouterRedeemHook.Contract.SubType(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) SubType() ([32]byte, error) {
+	return _PendleRouterRedeemHook.Contract.SubType(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) Subtype() ([32]byte, error) {
+	return _PendleRouterRedeemHook.Contract.Subtype(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) Subtype() ([32]byte, error) {
+	return _PendleRouterRedeemHook.Contract.Subtype(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PendleRouterRedeemHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) UsedShares() (*big.Int, error) {
+	return _PendleRouterRedeemHook.Contract.UsedShares(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookCallerSession) UsedShares() (*big.Int, error) {
+	return _PendleRouterRedeemHook.Contract.UsedShares(&_PendleRouterRedeemHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.PostExecute(&_PendleRouterRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.PostExecute(&_PendleRouterRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.PreExecute(&_PendleRouterRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.PreExecute(&_PendleRouterRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.ResetExecutionState(&_PendleRouterRedeemHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.ResetExecutionState(&_PendleRouterRedeemHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.SetExecutionContext(&_PendleRouterRedeemHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.SetExecutionContext(&_PendleRouterRedeemHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.SetOutAmount(&_PendleRouterRedeemHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_PendleRouterRedeemHook *PendleRouterRedeemHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterRedeemHook.Contract.SetOutAmount(&_PendleRouterRedeemHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package PendleRouterSwapHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// PendleRouterSwapHookMetaData contains all meta data concerning the PendleRouterSwapHook contract.
+var PendleRouterSwapHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"pendleRouterV4_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"pendleRouterV4\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIPendleRouterV4\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_IN_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"EPS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_GUESS_PT_OUT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SWAP_TYPE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MAKING_AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MARKET_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MIN_OUT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ORDER_EXPIRED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"RECEIVER_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161281438038061281483398101604081905261002e916100af565b6040805180820190915260048152631415165560e21b6020909101525f805460ff191690557fb86a7e98c9c656516a060ac5bc14d0453b037386adfb5f9f2ae12ee7a543e1b46080526001600160a01b03811661009e57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a05161270961010b5f395f818161029d0152610efe01525f81816101f301526102c401526127095ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063a131db561161006e578063a131db5614610298578063cf0a6181146102bf578063d06a1e89146102e6578063e445e7dd146102f9578063e774551714610312575f5ffd5b8063685a943c1461023957806381cbe6911461024157806384542a24146102545780638c4313c1146102665780638e14877614610286575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806338d52e0f146101bf5780633b5896bc146101d15780635492e677146101f157806360b5be2914610217575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a610155366004611934565b610325565b005b61015a61016a366004611994565b61039f565b61015a61017d3660046119b6565b6103c0565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba366004611934565b610419565b6101946001600160a01b0360025c1681565b6101e46101df366004611934565b61048c565b60405161013e9190611a12565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b61022960ff600160a81b60025c041681565b604051901515815260200161013e565b6101345f5c81565b61013461024f366004611994565b6106a4565b61022960ff600160a01b60025c041681565b610279610274366004611a9f565b6106bc565b60405161013e9190611add565b6101946001600160a01b0360015c1681565b6101947f000000000000000000000000000000000000000000000000000000000000000081565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b61015a6102f4366004611994565b610c17565b5f546103059060ff1681565b60405161013e9190611b03565b610229610320366004611c5b565b610c69565b336001600160a01b0384161461034e5760405163e15e56c960e01b815260040160405180910390fd5b5f61035884610c75565b905061036381610c88565b156103815760405163945b63f560e01b815260040160405180910390fd5b61038c816001610c95565b61039885858585610cab565b5050505050565b6103a881610cd7565b50336004805c6001600160a01b0319168217905d5050565b5f6103ca82610c75565b90506103d581610d09565b806103e457506103e481610c88565b156104025760405163441e4c7360e11b815260040160405180910390fd5b5f61040e826001610d12565b905083815d50505050565b336001600160a01b038416146104425760405163e15e56c960e01b815260040160405180910390fd5b5f61044c84610c75565b905061045781610d09565b1561047557604051630bbb04d960e11b815260040160405180910390fd5b610480816001610d67565b61039885858585610d73565b60605f61049b86868686610d80565b9050805160026104ab9190611ca8565b6001600160401b038111156104c2576104c2611b1d565b60405190808252806020026020018201604052801561050e57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104e05790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105579493929190611cbb565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061059957610599611d03565b60209081029190910101525f5b81518110156105fa578181815181106105c1576105c1611d03565b6020026020010151838260016105d79190611ca8565b815181106105e7576105e7611d03565b60209081029190910101526001016105a6565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106419493929190611cbb565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106809190611d17565b8151811061069057610690611d03565b602002602001018190525050949350505050565b5f6106b66106b183610c75565b610fdd565b92915050565b6060365f6106cd8460558188611d2a565b90925090505f6106e06004828486611d2a565b6106e991611d51565b90506060631bf03dc360e11b6001600160e01b0319831601610963575f808080610716876004818b611d2a565b810190610723919061211c565b9550955050509350935061076b8b8b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610fea92505050565b8484845f015185604001518660600151876080015160200151875f015160405160200161079f9897969594939291906121ff565b60408051601f19818403018152918152820151519095505f5b818110156108835786836040015182815181106107d7576107d7611d03565b60200260200101515f015160800151846040015183815181106107fc576107fc611d03565b60200260200101515f015160a001518560400151848151811061082157610821611d03565b60200260200101515f015160c001518660400151858151811061084657610846611d03565b60200260200101515f015160e00151604051602001610869959493929190612274565b60408051601f1981840301815291905296506001016107b8565b506060820151515f5b818110156109575787846060015182815181106108ab576108ab611d03565b60200260200101515f015160800151856060015183815181106108d0576108d0611d03565b60200260200101515f015160a00151866060015184815181106108f5576108f5611d03565b60200260200101515f015160c001518760600151858151811061091a5761091a611d03565b60200260200101515f015160e0015160405160200161093d959493929190612274565b60408051601f19818403018152919052975060010161088c565b50505050505050610c0d565b6329ad5dcd60e21b6001600160e01b0319831601610c0d575f80808061098c876004818b611d2a565b81019061099991906122bc565b9450945050935093506109e08b8b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610fea92505050565b82516040808501516060808701516080880151602090810151945196831b6001600160601b0319908116918801919091528a831b8116603488015289831b8116604888015294821b8516605c87015291811b8416607086015290811b831660848501521b16609882015260ac0160408051601f19818403018152918152820151519095505f5b81811015610b31578683604001518281518110610a8557610a85611d03565b60200260200101515f01516080015184604001518381518110610aaa57610aaa611d03565b60200260200101515f015160a0015185604001518481518110610acf57610acf611d03565b60200260200101515f015160c0015186604001518581518110610af457610af4611d03565b60200260200101515f015160e00151604051602001610b17959493929190612274565b60408051601f198184030181529190529650600101610a66565b506060820151515f5b81811015610c05578784606001518281518110610b5957610b59611d03565b60200260200101515f01516080015185606001518381518110610b7e57610b7e611d03565b60200260200101515f015160a0015186606001518481518110610ba357610ba3611d03565b60200260200101515f015160c0015187606001518581518110610bc857610bc8611d03565b60200260200101515f015160e00151604051602001610beb959493929190612274565b60408051601f198184030181529190529750600101610b3a565b505050505050505b9695505050505050565b5f610c2182610c75565b9050610c2c81610d09565b1580610c3e5750610c3c81610c88565b155b15610c5c57604051634bd439b560e11b815260040160405180910390fd5b610c6581610ff6565b5050565b5f6106b682603461100d565b5f5f610c8083611039565b5c9392505050565b5f5f610c80836003610d12565b5f610ca1836003610d12565b905081815d505050565b610cd1610cb7846106a4565b610cc184846110a0565b610ccb9190611d17565b8461114f565b50505050565b5f6003805c9082610ce78361234c565b9190505d505f610cf683611039565b905060035c80825d505060035c92915050565b5f5f610c808360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610ca1836002610d12565b610cd1610ccb83836110a0565b60605f610dc184848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610fea92505050565b90505f610e0585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506034925061100d915050565b90505f610e4986868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060359250611167915050565b90505f610e59866055818a611d2a565b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920182905250939450610eaa9250610ea191508990506055818c611d2a565b8b878e8a6111c9565b604080516001808252818301909252919250816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610ec157905050955060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316815260200185610f365784610f9e565b6040516381cbe69160e01b81526001600160a01b038c811660048301528d16906381cbe69190602401602060405180830381865afa158015610f7a573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610f9e9190612364565b815260200185610fae5783610fb0565b825b815250865f81518110610fc557610fc5611d03565b60200260200101819052505050505050949350505050565b5f5f610c80836001610d12565b5f6106b6826020611679565b611000815f610d67565b61100a815f610c95565b50565b5f82828151811061102057611020611d03565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161108392919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f80806110b86110b38560558189611d2a565b6116dd565b90925090506001600160a01b0382166110de576001600160a01b03163191506106b69050565b6040516370a0823160e01b81526001600160a01b0382811660048301528316906370a0823190602401602060405180830381865afa158015611122573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906111469190612364565b95945050505050565b5f61115982610c75565b90505f61040e826001610d12565b5f611173826020611ca8565b835110156111c05760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b60605f6111d9600482898b611d2a565b6111e291611d51565b9050631bf03dc360e11b6001600160e01b031982160161146c575f5f5f5f5f5f8d8d600490809261121593929190611d2a565b810190611222919061211c565b9550955095509550955095508b6001600160a01b0316866001600160a01b03161461126057604051631af73f3b60e21b815260040160405180910390fd5b886001600160a01b0316856001600160a01b03161461129257604051636d88b14560e11b815260040160405180910390fd5b835f036112b257604051636b74117160e11b815260040160405180910390fd5b6020830151835111156112d85760405163f963d46960e01b815260040160405180910390fd5b670de0b6b3a764000083608001511115611305576040516343d8fec560e01b815260040160405180910390fd5b60408201516001600160a01b0316158061132a575060608201516001600160a01b0316155b1561134857604051630f58648f60e01b815260040160405180910390fd5b8a156113bc576040516381cbe69160e01b81526001600160a01b038d811660048301528b16906381cbe69190602401602060405180830381865afa158015611392573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906113b69190612364565b60208301525b81602001515f036113e05760405163648453d360e11b815260040160405180910390fd5b604081015151156113f8576113f881604001516117ef565b606081015151156114105761141081606001516117ef565b8686868686868660405160240161142c969594939291906125dc565b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b031990931692909217909152975061166e95505050505050565b6329ad5dcd60e21b6001600160e01b0319821601611655575f5f5f5f5f8c8c600490809261149c93929190611d2a565b8101906114a991906122bc565b945094509450945094508a6001600160a01b0316856001600160a01b0316146114e557604051631af73f3b60e21b815260040160405180910390fd5b876001600160a01b0316846001600160a01b03161461151757604051636d88b14560e11b815260040160405180910390fd5b8915611588576040516381cbe69160e01b81526001600160a01b038c811660048301528a16906381cbe69190602401602060405180830381865afa158015611561573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906115859190612364565b92505b825f036115a85760405163648453d360e11b815260040160405180910390fd5b81602001515f036115cc57604051636b74117160e11b815260040160405180910390fd5b604081015151156115e4576115e481604001516117ef565b606081015151156115fc576115fc81606001516117ef565b858585858585604051602401611616959493929190612662565b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b031990931692909217909152965061166e945050505050565b6040516312d9b5dd60e01b815260040160405180910390fd5b509695505050505050565b5f611685826014611ca8565b835110156116cd5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064016111b7565b500160200151600160601b900490565b5f80806116ed6004828688611d2a565b6116f691611d51565b9050631bf03dc360e11b6001600160e01b03198216016117a2575f8061171f866004818a611d2a565b81019061172c919061211c565b5050505091509150806001600160a01b0316632c8ce6bc6040518163ffffffff1660e01b8152600401606060405180830381865afa158015611770573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061179491906126b2565b5095509193506117e7915050565b6329ad5dcd60e21b6001600160e01b0319821601611655575f806117c9866004818a611d2a565b8101906117d691906122bc565b505197509195506117e79350505050565b509250929050565b5f5b8151811015610c655781818151811061180c5761180c611d03565b6020026020010151604001515f0361183757604051638b8565b960e01b815260040160405180910390fd5b61185c82828151811061184c5761184c611d03565b60200260200101515f0151611864565b6001016117f1565b428160200151101561188957604051637811aa5560e01b815260040160405180910390fd5b60c08101516001600160a01b031615806118ae575060e08101516001600160a01b0316155b1561100a57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b038116811461100a575f5ffd5b80356118eb816118cc565b919050565b5f5f83601f840112611900575f5ffd5b5081356001600160401b03811115611916575f5ffd5b60208301915083602082850101111561192d575f5ffd5b9250929050565b5f5f5f5f60608587031215611947575f5ffd5b8435611952816118cc565b93506020850135611962816118cc565b925060408501356001600160401b0381111561197c575f5ffd5b611988878288016118f0565b95989497509550505050565b5f602082840312156119a4575f5ffd5b81356119af816118cc565b9392505050565b5f5f604083850312156119c7575f5ffd5b8235915060208301356119d9816118cc565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611a9357868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611a7d908701826119e4565b9550506020938401939190910190600101611a38565b50929695505050505050565b5f5f60208385031215611ab0575f5ffd5b82356001600160401b03811115611ac5575f5ffd5b611ad1858286016118f0565b90969095509350505050565b602081525f6119af60208301846119e4565b634e487b7160e01b5f52602160045260245ffd5b6020810160038310611b1757611b17611aef565b91905290565b634e487b7160e01b5f52604160045260245ffd5b60405160a081016001600160401b0381118282101715611b5357611b53611b1d565b60405290565b604051608081016001600160401b0381118282101715611b5357611b53611b1d565b604051606081016001600160401b0381118282101715611b5357611b53611b1d565b60405161018081016001600160401b0381118282101715611b5357611b53611b1d565b604051601f8201601f191681016001600160401b0381118282101715611be857611be8611b1d565b604052919050565b5f82601f830112611bff575f5ffd5b81356001600160401b03811115611c1857611c18611b1d565b611c2b601f8201601f1916602001611bc0565b818152846020838601011115611c3f575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611c6b575f5ffd5b81356001600160401b03811115611c80575f5ffd5b611c8c84828501611bf0565b949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106b6576106b6611c94565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106b6576106b6611c94565b5f5f85851115611d38575f5ffd5b83861115611d44575f5ffd5b5050820193919092039150565b80356001600160e01b03198116906004841015611d82576001600160e01b0319600485900360031b81901b82161691505b5092915050565b5f60a08284031215611d99575f5ffd5b611da1611b31565b90508135611dae816118cc565b8152602082810135908201526040820135611dc8816118cc565b60408201526060820135611ddb816118cc565b606082015260808201356001600160401b03811115611df8575f5ffd5b820160808185031215611e09575f5ffd5b611e11611b59565b8135600b8110611e1f575f5ffd5b81526020820135611e2f816118cc565b602082015260408201356001600160401b03811115611e4c575f5ffd5b611e5886828501611bf0565b604083015250606082013591508115158214611e72575f5ffd5b6060810191909152608082015292915050565b8035600481106118eb575f5ffd5b5f82601f830112611ea2575f5ffd5b81356001600160401b03811115611ebb57611ebb611b1d565b8060051b611ecb60208201611bc0565b91825260208185018101929081019086841115611ee6575f5ffd5b6020860192505b83831015610c0d5782356001600160401b03811115611f0a575f5ffd5b86016060818903601f19011215611f1f575f5ffd5b611f27611b7b565b60208201356001600160401b03811115611f3f575f5ffd5b6020818401019050610180818b031215611f57575f5ffd5b611f5f611b9d565b813581526020808301359082015260408083013590820152611f8360608301611e85565b6060820152611f94608083016118e0565b6080820152611fa560a083016118e0565b60a0820152611fb660c083016118e0565b60c0820152611fc760e083016118e0565b60e08201526101008281013590820152610120808301359082015261014080830135908201526101608201356001600160401b03811115612006575f5ffd5b6120128c828501611bf0565b6101608301525082525060408201356001600160401b03811115612034575f5ffd5b6120438a602083860101611bf0565b602083810191909152606093909301356040830152508352928301929190910190611eed565b5f60a08284031215612079575f5ffd5b612081611b31565b905061208c826118e0565b81526020828101359082015260408201356001600160401b038111156120b0575f5ffd5b6120bc84828501611e93565b60408301525060608201356001600160401b038111156120da575f5ffd5b6120e684828501611e93565b60608301525060808201356001600160401b03811115612104575f5ffd5b61211084828501611bf0565b60808301525092915050565b5f5f5f5f5f5f868803610140811215612133575f5ffd5b873561213e816118cc565b9650602088013561214e816118cc565b95506040880135945060a0605f1982011215612168575f5ffd5b50612171611b31565b60608881013582526080808a0135602084015260a08a0135604084015260c08a01359183019190915260e08901359082015292506101008701356001600160401b038111156121be575f5ffd5b6121ca89828a01611d89565b9250506101208701356001600160401b038111156121e6575f5ffd5b6121f289828a01612069565b9150509295509295509295565b6001600160601b031960608a811b8216835289811b8216601484015288811b8216602884015287811b8216603c84015286811b8216605084015285811b8216606484015284901b166078820152612265608c82018360601b6001600160601b0319169052565b60a00198975050505050505050565b5f86518060208901845e606096871b6001600160601b03199081169390910192835294861b851660148301525091841b8316602883015290921b16603c820152605001919050565b5f5f5f5f5f60a086880312156122d0575f5ffd5b85356122db816118cc565b945060208601356122eb816118cc565b93506040860135925060608601356001600160401b0381111561230c575f5ffd5b61231888828901611d89565b92505060808601356001600160401b03811115612333575f5ffd5b61233f88828901612069565b9150509295509295909350565b5f6001820161235d5761235d611c94565b5060010190565b5f60208284031215612374575f5ffd5b5051919050565b60018060a01b0381511682526020810151602083015260018060a01b03604082015116604083015260018060a01b0360608201511660608301525f608082015160a060808501528051600b81106123d4576123d4611aef565b60a085015260208101516001600160a01b031660c08501526040810151608060e08601526124066101208601826119e4565b905060608201511515610100860152809250505092915050565b6004811061243057612430611aef565b9052565b5f82825180855260208501945060208160051b830101602085015f5b8381101561256d57848303601f1901885281518051606080865281518187015260208201516080870152604082015160a087015281015161249460c0870182612420565b5060808101516001600160a01b03811660e08701525060a08101516001600160a01b0381166101008701525060c08101516001600160a01b0381166101208701525060e08101516001600160a01b038116610140870152506101008101516101608601526101208101516101808601526101408101516101a086015261016081015190506101806101c086015261252f6101e08601826119e4565b90506020820151858203602087015261254882826119e4565b6040938401519690930195909552506020988901989093509190910190600101612450565b50909695505050505050565b60018060a01b038151168252602081015160208301525f604082015160a060408501526125a960a0850182612434565b9050606083015184820360608601526125c28282612434565b9150506080830151848203608086015261114682826119e4565b60018060a01b038716815260018060a01b03861660208201528460408201528351606082015260208401516080820152604084015160a0820152606084015160c0820152608084015160e08201526101406101008201525f61264261014083018561237b565b8281036101208401526126558185612579565b9998505050505050505050565b6001600160a01b038681168252851660208201526040810184905260a0606082018190525f906126949083018561237b565b82810360808401526126a68185612579565b98975050505050505050565b5f5f5f606084860312156126c4575f5ffd5b83516126cf816118cc565b60208501519093506126e0816118cc565b60408501519092506126f1816118cc565b80915050925092509256fea164736f6c634300081e000a",
+}
+
+// PendleRouterSwapHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use PendleRouterSwapHookMetaData.ABI instead.
+var PendleRouterSwapHookABI = PendleRouterSwapHookMetaData.ABI
+
+// PendleRouterSwapHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use PendleRouterSwapHookMetaData.Bin instead.
+var PendleRouterSwapHookBin = PendleRouterSwapHookMetaData.Bin
+
+// DeployPendleRouterSwapHook deploys a new Ethereum contract, binding an instance of PendleRouterSwapHook to it.
+func DeployPendleRouterSwapHook(auth *bind.TransactOpts, backend bind.ContractBackend, pendleRouterV4_ common.Address) (common.Address, *types.Transaction, *PendleRouterSwapHook, error) {
+	parsed, err := PendleRouterSwapHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(PendleRouterSwapHookBin), backend, pendleRouterV4_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &PendleRouterSwapHook{PendleRouterSwapHookCaller: PendleRouterSwapHookCaller{contract: contract}, PendleRouterSwapHookTransactor: PendleRouterSwapHookTransactor{contract: contract}, PendleRouterSwapHookFilterer: PendleRouterSwapHookFilterer{contract: contract}}, nil
+}
+
+// PendleRouterSwapHook is an auto generated Go binding around an Ethereum contract.
+type PendleRouterSwapHook struct {
+	PendleRouterSwapHookCaller     // Read-only binding to the contract
+	PendleRouterSwapHookTransactor // Write-only binding to the contract
+	PendleRouterSwapHookFilterer   // Log filterer for contract events
+}
+
+// PendleRouterSwapHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type PendleRouterSwapHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PendleRouterSwapHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type PendleRouterSwapHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PendleRouterSwapHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type PendleRouterSwapHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// PendleRouterSwapHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type PendleRouterSwapHookSession struct {
+	Contract     *PendleRouterSwapHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts         // Call options to use throughout this session
+	TransactOpts bind.TransactOpts     // Transaction auth options to use throughout this session
+}
+
+// PendleRouterSwapHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type PendleRouterSwapHookCallerSession struct {
+	Contract *PendleRouterSwapHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts               // Call options to use throughout this session
+}
+
+// PendleRouterSwapHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type PendleRouterSwapHookTransactorSession struct {
+	Contract     *PendleRouterSwapHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts               // Transaction auth options to use throughout this session
+}
+
+// PendleRouterSwapHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type PendleRouterSwapHookRaw struct {
+	Contract *PendleRouterSwapHook // Generic contract binding to access the raw methods on
+}
+
+// PendleRouterSwapHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type PendleRouterSwapHookCallerRaw struct {
+	Contract *PendleRouterSwapHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// PendleRouterSwapHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type PendleRouterSwapHookTransactorRaw struct {
+	Contract *PendleRouterSwapHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewPendleRouterSwapHook creates a new instance of PendleRouterSwapHook, bound to a specific deployed contract.
+func NewPendleRouterSwapHook(address common.Address, backend bind.ContractBackend) (*PendleRouterSwapHook, error) {
+	contract, err := bindPendleRouterSwapHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterSwapHook{PendleRouterSwapHookCaller: PendleRouterSwapHookCaller{contract: contract}, PendleRouterSwapHookTransactor: PendleRouterSwapHookTransactor{contract: contract}, PendleRouterSwapHookFilterer: PendleRouterSwapHookFilterer{contract: contract}}, nil
+}
+
+// NewPendleRouterSwapHookCaller creates a new read-only instance of PendleRouterSwapHook, bound to a specific deployed contract.
+func NewPendleRouterSwapHookCaller(address common.Address, caller bind.ContractCaller) (*PendleRouterSwapHookCaller, error) {
+	contract, err := bindPendleRouterSwapHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterSwapHookCaller{contract: contract}, nil
+}
+
+// NewPendleRouterSwapHookTransactor creates a new write-only instance of PendleRouterSwapHook, bound to a specific deployed contract.
+func NewPendleRouterSwapHookTransactor(address common.Address, transactor bind.ContractTransactor) (*PendleRouterSwapHookTransactor, error) {
+	contract, err := bindPendleRouterSwapHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterSwapHookTransactor{contract: contract}, nil
+}
+
+// NewPendleRouterSwapHookFilterer creates a new log filterer instance of PendleRouterSwapHook, bound to a specific deployed contract.
+func NewPendleRouterSwapHookFilterer(address common.Address, filterer bind.ContractFilterer) (*PendleRouterSwapHookFilterer, error) {
+	contract, err := bindPendleRouterSwapHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &PendleRouterSwapHookFilterer{contract: contract}, nil
+}
+
+// bindPendleRouterSwapHook binds a generic wrapper to an already deployed contract.
+func bindPendleRouterSwapHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := PendleRouterSwapHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PendleRouterSwapHook *PendleRouterSwapHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PendleRouterSwapHook.Contract.PendleRouterSwapHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PendleRouterSwapHook *PendleRouterSwapHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.PendleRouterSwapHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PendleRouterSwapHook *PendleRouterSwapHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.PendleRouterSwapHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _PendleRouterSwapHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) Asset() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.Asset(&_PendleRouterSwapHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) Asset() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.Asset(&_PendleRouterSwapHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _PendleRouterSwapHook.Contract.Build(&_PendleRouterSwapHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _PendleRouterSwapHook.Contract.Build(&_PendleRouterSwapHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _PendleRouterSwapHook.Contract.DecodeUsePrevHookAmount(&_PendleRouterSwapHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _PendleRouterSwapHook.Contract.DecodeUsePrevHookAmount(&_PendleRouterSwapHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) ExecutionNonce() (*big.Int, error) {
+	return _PendleRouterSwapHook.Contract.ExecutionNonce(&_PendleRouterSwapHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _PendleRouterSwapHook.Contract.ExecutionNonce(&_PendleRouterSwapHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _PendleRouterSwapHook.Contract.GetOutAmount(&_PendleRouterSwapHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _PendleRouterSwapHook.Contract.GetOutAmount(&_PendleRouterSwapHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) HookType() (uint8, error) {
+	return _PendleRouterSwapHook.Contract.HookType(&_PendleRouterSwapHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) HookType() (uint8, error) {
+	return _PendleRouterSwapHook.Contract.HookType(&_PendleRouterSwapHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) Inspect(data []byte) ([]byte, error) {
+	return _PendleRouterSwapHook.Contract.Inspect(&_PendleRouterSwapHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _PendleRouterSwapHook.Contract.Inspect(&_PendleRouterSwapHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) LastCaller() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.LastCaller(&_PendleRouterSwapHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) LastCaller() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.LastCaller(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PendleRouterV4 is a free data retrieval call binding the contract method 0xa131db56.
+//
+// Solidity: function pendleRouterV4() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) PendleRouterV4(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "pendleRouterV4")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// PendleRouterV4 is a free data retrieval call binding the contract method 0xa131db56.
+//
+// Solidity: function pendleRouterV4() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) PendleRouterV4() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.PendleRouterV4(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PendleRouterV4 is a free data retrieval call binding the contract method 0xa131db56.
+//
+// Solidity: function pendleRouterV4() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) PendleRouterV4() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.PendleRouterV4(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) PostExecuteMutex() (bool, error) {
+	return _PendleRouterSwapHook.Contract.PostExecuteMutex(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _PendleRouterSwapHook.Contract.PostExecuteMutex(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) PreExecuteMutex() (bool, error) {
+	return _PendleRouterSwapHook.Contract.PreExecuteMutex(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _PendleRouterSwapHook.Contract.PreExecuteMutex(&_PendleRouterSwapHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) SpToken() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.SpToken(&_PendleRouterSwapHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) SpToken() (common.Address, error) {
+	return _PendleRouterSwapHook.Contract.SpToken(&_PendleRouterSwapHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) SubType() ([32]byte, error) {
+	return _PendleRouterSwapHook.Contract.SubType(&_PendleRouterSwapHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) SubType() ([32]byte, error) {
+	return _PendleRouterSwapHook.Contract.SubType(&_PendleRouterSwapHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) Subtype() ([32]byte, error) {
+	return _PendleRouterSwapHook.Contract.Subtype(&_PendleRouterSwapHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) Subtype() ([32]byte, error) {
+	return _PendleRouterSwapHook.Contract.Subtype(&_PendleRouterSwapHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _PendleRouterSwapHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) UsedShares() (*big.Int, error) {
+	return _PendleRouterSwapHook.Contract.UsedShares(&_PendleRouterSwapHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_PendleRouterSwapHook *PendleRouterSwapHookCallerSession) UsedShares() (*big.Int, error) {
+	return _PendleRouterSwapHook.Contract.UsedShares(&_PendleRouterSwapHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.PostExecute(&_PendleRouterSwapHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.PostExecute(&_PendleRouterSwapHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.PreExecute(&_PendleRouterSwapHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.PreExecute(&_PendleRouterSwapHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.ResetExecutionState(&_PendleRouterSwapHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.ResetExecutionState(&_PendleRouterSwapHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.SetExecutionContext(&_PendleRouterSwapHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.SetExecutionContext(&_PendleRouterSwapHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.SetOutAmount(&_PendleRouterSwapHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_PendleRouterSwapHook *PendleRouterSwapHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _PendleRouterSwapHook.Contract.SetOutAmount(&_PendleRouterSwapHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Redeem4626VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Redeem4626VaultHookMetaData contains all meta data concerning the Redeem4626VaultHook contract.
+var Redeem4626VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"replaceCalldataAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060408051808201909152600781526622a9219a1b191b60c91b6020909101525f805460ff191660021790557f6bd137f80bcc1600ee75e85074135d267cba030d0cda1f5fdfe642ca33d3d7bd60805260805161151061007d5f395f8181610208015261027101526115105ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80635492e677116100a9578063984d6e7a1161006e578063984d6e7a1461026c578063ac440f7214610293578063d06a1e89146102a6578063e445e7dd146102b9578063e7745517146102d2575f5ffd5b80635492e67714610206578063685a943c1461022c57806381cbe691146102345780638c4313c1146102475780638e1487761461025a575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633a28b0ab146101c65780633b5896bc146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004611125565b6102f5565b005b61014f61015f366004611186565b61036f565b61014f6101723660046111a8565b610390565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af366004611125565b6103e9565b6101896001600160a01b0360025c1681565b6101d96101d4366004611275565b61045c565b60405161013391906112e5565b6101f96101f4366004611125565b610473565b60405161013391906112f7565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b6101295f5c81565b610129610242366004611186565b61068c565b6101d9610255366004611384565b61069e565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b6101296102a13660046113c3565b610760565b61014f6102b4366004611186565b61076a565b5f546102c59060ff1681565b60405161013391906113fd565b6102e56102e03660046113c3565b6107e7565b6040519015158152602001610133565b336001600160a01b0384161461031e5760405163e15e56c960e01b815260040160405180910390fd5b5f610328846107f3565b905061033381610806565b156103515760405163945b63f560e01b815260040160405180910390fd5b61035c816001610813565b61036885858585610829565b5050505050565b610378816108d8565b50336004805c6001600160a01b0319168217905d5050565b5f61039a826107f3565b90506103a58161090a565b806103b457506103b481610806565b156103d25760405163441e4c7360e11b815260040160405180910390fd5b5f6103de826001610913565b905083815d50505050565b336001600160a01b038416146104125760405163e15e56c960e01b815260040160405180910390fd5b5f61041c846107f3565b90506104278161090a565b1561044557604051630bbb04d960e11b815260040160405180910390fd5b610450816001610968565b61036885858585610974565b606061046a83836048610ae0565b90505b92915050565b60605f61048286868686610b76565b9050805160026104929190611437565b67ffffffffffffffff8111156104aa576104aa6111d6565b6040519080825280602002602001820160405280156104f657816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104c85790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161053f949392919061144a565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061058157610581611492565b60209081029190910101525f5b81518110156105e2578181815181106105a9576105a9611492565b6020026020010151838260016105bf9190611437565b815181106105cf576105cf611492565b602090810291909101015260010161058e565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe9189898989604051602401610629949392919061144a565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161066891906114a6565b8151811061067857610678611492565b602002602001018190525050949350505050565b5f61046d610699836107f3565b610e13565b60606106de83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e2092505050565b61071f84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e28915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b5f61046d82610e91565b336001600160a01b0360045c16146107955760405163e15e56c960e01b815260040160405180910390fd5b5f61079f826107f3565b90506107aa8161090a565b15806107bc57506107ba81610806565b155b156107da57604051634bd439b560e11b815260040160405180910390fd5b6107e381610e9d565b5050565b5f61046d826068610eb4565b5f5f6107fe83610ee0565b5c9392505050565b5f5f6107fe836003610913565b5f61081f836003610913565b905081815d505050565b6108846108358461068c565b6108748585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f4c92505050565b61087e91906114a6565b84610fba565b6108c38383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610fd292505050565b6108ce905f5c6114a6565b805f5d5050505050565b5f6003805c90826108e8836114b9565b9190505d505f6108f783610ee0565b905060035c80825d505060035c92915050565b5f5f6107fe8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61081f836002610913565b5f6109b383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e2092505050565b9050806001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109f1573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a1591906114d1565b6002805c6001600160a01b0319166001600160a01b03831617905d50610a79610a738585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f4c92505050565b85610fba565b610ab88484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610fd292505050565b805f5d50806001805c6001600160a01b0319166001600160a01b03831617905d505050505050565b60605f83604051602001610af691815260200190565b60405160208183030381529060405290505f5b6020811015610b6c57818181518110610b2457610b24611492565b01602001516001600160f81b03191686610b3e8387611437565b81518110610b4e57610b4e611492565b60200101906001600160f81b03191690815f1a905350600101610b09565b5093949350505050565b60605f610bb784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e2092505050565b90505f610bfb85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e28915050565b90505f610c3c86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e9192505050565b90505f610c8087878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060689250610eb4915050565b90508015610cf3576040516381cbe69160e01b81526001600160a01b0389811660048301528a16906381cbe69190602401602060405180830381865afa158015610ccc573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610cf091906114ec565b91505b815f03610d13576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b038416610d3a57604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610d4f57905050604080516060810182526001600160a01b0380881682525f60208301528251602481018790528c821660448201529087166064820152929750919082019060840160408051601f198184030181529190526020810180516001600160e01b0316635d043b2960e11b1790529052855186905f90610dfc57610dfc611492565b602002602001018190525050505050949350505050565b5f5f6107fe836001610913565b5f61046d8260205b5f610e34826014611437565b83511015610e815760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f61046d82604861106f565b610ea7815f610968565b610eb1815f610813565b50565b5f828281518110610ec757610ec7611492565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610f2f92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610f96573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061046a91906114ec565b5f610fc4826107f3565b90505f6103de826001610913565b5f5f610fdd83610e20565b90505f610feb846034610e28565b90506001600160a01b038116610ffe5750835b6040516370a0823160e01b81526001600160a01b0382811660048301528316906370a0823190602401602060405180830381865afa158015611042573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061106691906114ec565b95945050505050565b5f61107b826020611437565b835110156110c35760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610e78565b50016020015190565b6001600160a01b0381168114610eb1575f5ffd5b5f5f83601f8401126110f0575f5ffd5b50813567ffffffffffffffff811115611107575f5ffd5b60208301915083602082850101111561111e575f5ffd5b9250929050565b5f5f5f5f60608587031215611138575f5ffd5b8435611143816110cc565b93506020850135611153816110cc565b9250604085013567ffffffffffffffff81111561116e575f5ffd5b61117a878288016110e0565b95989497509550505050565b5f60208284031215611196575f5ffd5b81356111a1816110cc565b9392505050565b5f5f604083850312156111b9575f5ffd5b8235915060208301356111cb816110cc565b809150509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f82601f8301126111f9575f5ffd5b813567ffffffffffffffff811115611213576112136111d6565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611242576112426111d6565b604052818152838201602001851015611259575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f60408385031215611286575f5ffd5b823567ffffffffffffffff81111561129c575f5ffd5b6112a8858286016111ea565b95602094909401359450505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f61046a60208301846112b7565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561137857868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611362908701826112b7565b955050602093840193919091019060010161131d565b50929695505050505050565b5f5f60208385031215611395575f5ffd5b823567ffffffffffffffff8111156113ab575f5ffd5b6113b7858286016110e0565b90969095509350505050565b5f602082840312156113d3575f5ffd5b813567ffffffffffffffff8111156113e9575f5ffd5b6113f5848285016111ea565b949350505050565b602081016003831061141d57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561046d5761046d611423565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561046d5761046d611423565b5f600182016114ca576114ca611423565b5060010190565b5f602082840312156114e1575f5ffd5b81516111a1816110cc565b5f602082840312156114fc575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// Redeem4626VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use Redeem4626VaultHookMetaData.ABI instead.
+var Redeem4626VaultHookABI = Redeem4626VaultHookMetaData.ABI
+
+// Redeem4626VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Redeem4626VaultHookMetaData.Bin instead.
+var Redeem4626VaultHookBin = Redeem4626VaultHookMetaData.Bin
+
+// DeployRedeem4626VaultHook deploys a new Ethereum contract, binding an instance of Redeem4626VaultHook to it.
+func DeployRedeem4626VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Redeem4626VaultHook, error) {
+	parsed, err := Redeem4626VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Redeem4626VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Redeem4626VaultHook{Redeem4626VaultHookCaller: Redeem4626VaultHookCaller{contract: contract}, Redeem4626VaultHookTransactor: Redeem4626VaultHookTransactor{contract: contract}, Redeem4626VaultHookFilterer: Redeem4626VaultHookFilterer{contract: contract}}, nil
+}
+
+// Redeem4626VaultHook is an auto generated Go binding around an Ethereum contract.
+type Redeem4626VaultHook struct {
+	Redeem4626VaultHookCaller     // Read-only binding to the contract
+	Redeem4626VaultHookTransactor // Write-only binding to the contract
+	Redeem4626VaultHookFilterer   // Log filterer for contract events
+}
+
+// Redeem4626VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Redeem4626VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem4626VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Redeem4626VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem4626VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Redeem4626VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem4626VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Redeem4626VaultHookSession struct {
+	Contract     *Redeem4626VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// Redeem4626VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Redeem4626VaultHookCallerSession struct {
+	Contract *Redeem4626VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// Redeem4626VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Redeem4626VaultHookTransactorSession struct {
+	Contract     *Redeem4626VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// Redeem4626VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Redeem4626VaultHookRaw struct {
+	Contract *Redeem4626VaultHook // Generic contract binding to access the raw methods on
+}
+
+// Redeem4626VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Redeem4626VaultHookCallerRaw struct {
+	Contract *Redeem4626VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Redeem4626VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Redeem4626VaultHookTransactorRaw struct {
+	Contract *Redeem4626VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRedeem4626VaultHook creates a new instance of Redeem4626VaultHook, bound to a specific deployed contract.
+func NewRedeem4626VaultHook(address common.Address, backend bind.ContractBackend) (*Redeem4626VaultHook, error) {
+	contract, err := bindRedeem4626VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem4626VaultHook{Redeem4626VaultHookCaller: Redeem4626VaultHookCaller{contract: contract}, Redeem4626VaultHookTransactor: Redeem4626VaultHookTransactor{contract: contract}, Redeem4626VaultHookFilterer: Redeem4626VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewRedeem4626VaultHookCaller creates a new read-only instance of Redeem4626VaultHook, bound to a specific deployed contract.
+func NewRedeem4626VaultHookCaller(address common.Address, caller bind.ContractCaller) (*Redeem4626VaultHookCaller, error) {
+	contract, err := bindRedeem4626VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem4626VaultHookCaller{contract: contract}, nil
+}
+
+// NewRedeem4626VaultHookTransactor creates a new write-only instance of Redeem4626VaultHook, bound to a specific deployed contract.
+func NewRedeem4626VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*Redeem4626VaultHookTransactor, error) {
+	contract, err := bindRedeem4626VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem4626VaultHookTransactor{contract: contract}, nil
+}
+
+// NewRedeem4626VaultHookFilterer creates a new log filterer instance of Redeem4626VaultHook, bound to a specific deployed contract.
+func NewRedeem4626VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*Redeem4626VaultHookFilterer, error) {
+	contract, err := bindRedeem4626VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem4626VaultHookFilterer{contract: contract}, nil
+}
+
+// bindRedeem4626VaultHook binds a generic wrapper to an already deployed contract.
+func bindRedeem4626VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Redeem4626VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Redeem4626VaultHook *Redeem4626VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Redeem4626VaultHook.Contract.Redeem4626VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Redeem4626VaultHook *Redeem4626VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.Redeem4626VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Redeem4626VaultHook *Redeem4626VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.Redeem4626VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Redeem4626VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _Redeem4626VaultHook.Contract.SUBTYPE(&_Redeem4626VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _Redeem4626VaultHook.Contract.SUBTYPE(&_Redeem4626VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) Asset() (common.Address, error) {
+	return _Redeem4626VaultHook.Contract.Asset(&_Redeem4626VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) Asset() (common.Address, error) {
+	return _Redeem4626VaultHook.Contract.Asset(&_Redeem4626VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Redeem4626VaultHook.Contract.Build(&_Redeem4626VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Redeem4626VaultHook.Contract.Build(&_Redeem4626VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.DecodeAmount(&_Redeem4626VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.DecodeAmount(&_Redeem4626VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Redeem4626VaultHook.Contract.DecodeUsePrevHookAmount(&_Redeem4626VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Redeem4626VaultHook.Contract.DecodeUsePrevHookAmount(&_Redeem4626VaultHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.ExecutionNonce(&_Redeem4626VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.ExecutionNonce(&_Redeem4626VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.GetOutAmount(&_Redeem4626VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.GetOutAmount(&_Redeem4626VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) HookType() (uint8, error) {
+	return _Redeem4626VaultHook.Contract.HookType(&_Redeem4626VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) HookType() (uint8, error) {
+	return _Redeem4626VaultHook.Contract.HookType(&_Redeem4626VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _Redeem4626VaultHook.Contract.Inspect(&_Redeem4626VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _Redeem4626VaultHook.Contract.Inspect(&_Redeem4626VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) LastCaller() (common.Address, error) {
+	return _Redeem4626VaultHook.Contract.LastCaller(&_Redeem4626VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _Redeem4626VaultHook.Contract.LastCaller(&_Redeem4626VaultHook.CallOpts)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) ReplaceCalldataAmount(opts *bind.CallOpts, data []byte, amount *big.Int) ([]byte, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "replaceCalldataAmount", data, amount)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) ReplaceCalldataAmount(data []byte, amount *big.Int) ([]byte, error) {
+	return _Redeem4626VaultHook.Contract.ReplaceCalldataAmount(&_Redeem4626VaultHook.CallOpts, data, amount)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) ReplaceCalldataAmount(data []byte, amount *big.Int) ([]byte, error) {
+	return _Redeem4626VaultHook.Contract.ReplaceCalldataAmount(&_Redeem4626VaultHook.CallOpts, data, amount)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) SpToken() (common.Address, error) {
+	return _Redeem4626VaultHook.Contract.SpToken(&_Redeem4626VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _Redeem4626VaultHook.Contract.SpToken(&_Redeem4626VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) Subtype() ([32]byte, error) {
+	return _Redeem4626VaultHook.Contract.Subtype(&_Redeem4626VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _Redeem4626VaultHook.Contract.Subtype(&_Redeem4626VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem4626VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) UsedShares() (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.UsedShares(&_Redeem4626VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem4626VaultHook *Redeem4626VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _Redeem4626VaultHook.Contract.UsedShares(&_Redeem4626VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.PostExecute(&_Redeem4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.PostExecute(&_Redeem4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.PreExecute(&_Redeem4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.PreExecute(&_Redeem4626VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.ResetExecutionState(&_Redeem4626VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.ResetExecutionState(&_Redeem4626VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.SetExecutionContext(&_Redeem4626VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.SetExecutionContext(&_Redeem4626VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.SetOutAmount(&_Redeem4626VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem4626VaultHook *Redeem4626VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem4626VaultHook.Contract.SetOutAmount(&_Redeem4626VaultHook.TransactOpts, _outAmount, caller)
+}
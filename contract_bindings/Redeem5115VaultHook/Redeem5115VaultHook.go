@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Redeem5115VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Redeem5115VaultHookMetaData contains all meta data concerning the Redeem5115VaultHook contract.
+var Redeem5115VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"replaceCalldataAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260078152664552433531313560c81b6020909101525f805460ff191660021790557f16b0755429540630f2d4bfab5526bb485356bbabc307c7597c9d323a9f8780a760805260805161150261007d5f395f8181610208015261027101526115025ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80635492e677116100a9578063984d6e7a1161006e578063984d6e7a1461026c578063ac440f7214610293578063d06a1e89146102a6578063e445e7dd146102b9578063e7745517146102d2575f5ffd5b80635492e67714610206578063685a943c1461022c57806381cbe691146102345780638c4313c1146102475780638e1487761461025a575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633a28b0ab146101c65780633b5896bc146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a36600461114b565b6102f5565b005b61014f61015f3660046111a8565b61036f565b61014f6101723660046111c1565b610390565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af36600461114b565b6103e9565b6101896001600160a01b0360025c1681565b6101d96101d436600461128a565b61045c565b60405161013391906112fa565b6101f96101f436600461114b565b610473565b604051610133919061130c565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b6101295f5c81565b6101296102423660046111a8565b61068c565b6101d9610255366004611399565b61069e565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b6101296102a13660046113d8565b610760565b61014f6102b43660046111a8565b61076a565b5f546102c59060ff1681565b604051610133919061140a565b6102e56102e03660046113d8565b6107e7565b6040519015158152602001610133565b336001600160a01b0384161461031e5760405163e15e56c960e01b815260040160405180910390fd5b5f610328846107f3565b905061033381610806565b156103515760405163945b63f560e01b815260040160405180910390fd5b61035c816001610813565b61036885858585610829565b5050505050565b610378816108d8565b50336004805c6001600160a01b0319168217905d5050565b5f61039a826107f3565b90506103a58161090a565b806103b457506103b481610806565b156103d25760405163441e4c7360e11b815260040160405180910390fd5b5f6103de826001610913565b905083815d50505050565b336001600160a01b038416146104125760405163e15e56c960e01b815260040160405180910390fd5b5f61041c846107f3565b90506104278161090a565b1561044557604051630bbb04d960e11b815260040160405180910390fd5b610450816001610968565b61036885858585610974565b606061046a83836048610ab6565b90505b92915050565b60605f61048286868686610b4c565b9050805160026104929190611444565b67ffffffffffffffff8111156104aa576104aa6111eb565b6040519080825280602002602001820160405280156104f657816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104c85790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161053f9493929190611457565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106105815761058161149f565b60209081029190910101525f5b81518110156105e2578181815181106105a9576105a961149f565b6020026020010151838260016105bf9190611444565b815181106105cf576105cf61149f565b602090810291909101015260010161058e565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106299493929190611457565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161066891906114b3565b815181106106785761067861149f565b602002602001018190525050949350505050565b5f61046d610699836107f3565b610e50565b60606106de83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e5d92505050565b61071f84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e65915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b5f61046d82610ece565b336001600160a01b0360045c16146107955760405163e15e56c960e01b815260040160405180910390fd5b5f61079f826107f3565b90506107aa8161090a565b15806107bc57506107ba81610806565b155b156107da57604051634bd439b560e11b815260040160405180910390fd5b6107e381610eda565b5050565b5f61046d826088610ef1565b5f5f6107fe83610f1d565b5c9392505050565b5f5f6107fe836003610913565b5f61081f836003610913565b905081815d505050565b6108846108358461068c565b6108748585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f8992505050565b61087e91906114b3565b84610ff7565b6108c38383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061100f92505050565b6108ce905f5c6114b3565b805f5d5050505050565b5f6003805c90826108e8836114c6565b9190505d505f6108f783610f1d565b905060035c80825d505060035c92915050565b5f5f6107fe8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f61081f836002610913565b6109b582828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e65915050565b6002805c6001600160a01b0319166001600160a01b03831617905d50610a1361087e8484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f8992505050565b610a528383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061100f92505050565b805f5d50610a9482828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e5d92505050565b6001805c6001600160a01b0319166001600160a01b03831617905d5050505050565b60605f83604051602001610acc91815260200190565b60405160208183030381529060405290505f5b6020811015610b4257818181518110610afa57610afa61149f565b01602001516001600160f81b03191686610b148387611444565b81518110610b2457610b2461149f565b60200101906001600160f81b03191690815f1a905350600101610adf565b5093949350505050565b60605f610b8d84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e5d92505050565b90505f610bd185858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e65915050565b90505f610c1286868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610ece92505050565b90505f610c5687878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506068925061108e915050565b90505f610c9a88888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060889250610ef1915050565b90508015610d0d576040516381cbe69160e01b81526001600160a01b038a811660048301528b16906381cbe69190602401602060405180830381865afa158015610ce6573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d0a91906114de565b92505b825f03610d2d576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0385161580610d4a57506001600160a01b038416155b15610d6857604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610d7d57905050604080516060810182526001600160a01b0380891682525f6020830181905283518e831660248201526044810189905291891660648301526084820187905260a4820152929850919082019060c40160408051601f198184030181529190526020810180516001600160e01b031663769f8e5d60e01b1790529052865187905f90610e3857610e3861149f565b60200260200101819052505050505050949350505050565b5f5f6107fe836001610913565b5f61046d8260205b5f610e71826014611444565b83511015610ebe5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f61046d82604861108e565b610ee4815f610968565b610eee815f610813565b50565b5f828281518110610f0457610f0461149f565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610f6c92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610fd3573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061046a91906114de565b5f611001826107f3565b90505f6103de826001610913565b5f5f61101a83610e5d565b6040516370a0823160e01b81526001600160a01b038681166004830152919250908216906370a0823190602401602060405180830381865afa158015611062573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061108691906114de565b949350505050565b5f61109a826020611444565b835110156110e25760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610eb5565b50016020015190565b80356001600160a01b0381168114611101575f5ffd5b919050565b5f5f83601f840112611116575f5ffd5b50813567ffffffffffffffff81111561112d575f5ffd5b602083019150836020828501011115611144575f5ffd5b9250929050565b5f5f5f5f6060858703121561115e575f5ffd5b611167856110eb565b9350611175602086016110eb565b9250604085013567ffffffffffffffff811115611190575f5ffd5b61119c87828801611106565b95989497509550505050565b5f602082840312156111b8575f5ffd5b61046a826110eb565b5f5f604083850312156111d2575f5ffd5b823591506111e2602084016110eb565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f82601f83011261120e575f5ffd5b813567ffffffffffffffff811115611228576112286111eb565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611257576112576111eb565b60405281815283820160200185101561126e575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f6040838503121561129b575f5ffd5b823567ffffffffffffffff8111156112b1575f5ffd5b6112bd858286016111ff565b95602094909401359450505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f61046a60208301846112cc565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561138d57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611377908701826112cc565b9550506020938401939190910190600101611332565b50929695505050505050565b5f5f602083850312156113aa575f5ffd5b823567ffffffffffffffff8111156113c0575f5ffd5b6113cc85828601611106565b90969095509350505050565b5f602082840312156113e8575f5ffd5b813567ffffffffffffffff8111156113fe575f5ffd5b611086848285016111ff565b602081016003831061142a57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561046d5761046d611430565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561046d5761046d611430565b5f600182016114d7576114d7611430565b5060010190565b5f602082840312156114ee575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// Redeem5115VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use Redeem5115VaultHookMetaData.ABI instead.
+var Redeem5115VaultHookABI = Redeem5115VaultHookMetaData.ABI
+
+// Redeem5115VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Redeem5115VaultHookMetaData.Bin instead.
+var Redeem5115VaultHookBin = Redeem5115VaultHookMetaData.Bin
+
+// DeployRedeem5115VaultHook deploys a new Ethereum contract, binding an instance of Redeem5115VaultHook to it.
+func DeployRedeem5115VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Redeem5115VaultHook, error) {
+	parsed, err := Redeem5115VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Redeem5115VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Redeem5115VaultHook{Redeem5115VaultHookCaller: Redeem5115VaultHookCaller{contract: contract}, Redeem5115VaultHookTransactor: Redeem5115VaultHookTransactor{contract: contract}, Redeem5115VaultHookFilterer: Redeem5115VaultHookFilterer{contract: contract}}, nil
+}
+
+// Redeem5115VaultHook is an auto generated Go binding around an Ethereum contract.
+type Redeem5115VaultHook struct {
+	Redeem5115VaultHookCaller     // Read-only binding to the contract
+	Redeem5115VaultHookTransactor // Write-only binding to the contract
+	Redeem5115VaultHookFilterer   // Log filterer for contract events
+}
+
+// Redeem5115VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Redeem5115VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem5115VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Redeem5115VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem5115VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Redeem5115VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem5115VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Redeem5115VaultHookSession struct {
+	Contract     *Redeem5115VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// Redeem5115VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Redeem5115VaultHookCallerSession struct {
+	Contract *Redeem5115VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// Redeem5115VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Redeem5115VaultHookTransactorSession struct {
+	Contract     *Redeem5115VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// Redeem5115VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Redeem5115VaultHookRaw struct {
+	Contract *Redeem5115VaultHook // Generic contract binding to access the raw methods on
+}
+
+// Redeem5115VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Redeem5115VaultHookCallerRaw struct {
+	Contract *Redeem5115VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Redeem5115VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Redeem5115VaultHookTransactorRaw struct {
+	Contract *Redeem5115VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRedeem5115VaultHook creates a new instance of Redeem5115VaultHook, bound to a specific deployed contract.
+func NewRedeem5115VaultHook(address common.Address, backend bind.ContractBackend) (*Redeem5115VaultHook, error) {
+	contract, err := bindRedeem5115VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem5115VaultHook{Redeem5115VaultHookCaller: Redeem5115VaultHookCaller{contract: contract}, Redeem5115VaultHookTransactor: Redeem5115VaultHookTransactor{contract: contract}, Redeem5115VaultHookFilterer: Redeem5115VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewRedeem5115VaultHookCaller creates a new read-only instance of Redeem5115VaultHook, bound to a specific deployed contract.
+func NewRedeem5115VaultHookCaller(address common.Address, caller bind.ContractCaller) (*Redeem5115VaultHookCaller, error) {
+	contract, err := bindRedeem5115VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem5115VaultHookCaller{contract: contract}, nil
+}
+
+// NewRedeem5115VaultHookTransactor creates a new write-only instance of Redeem5115VaultHook, bound to a specific deployed contract.
+func NewRedeem5115VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*Redeem5115VaultHookTransactor, error) {
+	contract, err := bindRedeem5115VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem5115VaultHookTransactor{contract: contract}, nil
+}
+
+// NewRedeem5115VaultHookFilterer creates a new log filterer instance of Redeem5115VaultHook, bound to a specific deployed contract.
+func NewRedeem5115VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*Redeem5115VaultHookFilterer, error) {
+	contract, err := bindRedeem5115VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem5115VaultHookFilterer{contract: contract}, nil
+}
+
+// bindRedeem5115VaultHook binds a generic wrapper to an already deployed contract.
+func bindRedeem5115VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Redeem5115VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Redeem5115VaultHook *Redeem5115VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Redeem5115VaultHook.Contract.Redeem5115VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Redeem5115VaultHook *Redeem5115VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.Redeem5115VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Redeem5115VaultHook *Redeem5115VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.Redeem5115VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Redeem5115VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _Redeem5115VaultHook.Contract.SUBTYPE(&_Redeem5115VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _Redeem5115VaultHook.Contract.SUBTYPE(&_Redeem5115VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) Asset() (common.Address, error) {
+	return _Redeem5115VaultHook.Contract.Asset(&_Redeem5115VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) Asset() (common.Address, error) {
+	return _Redeem5115VaultHook.Contract.Asset(&_Redeem5115VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Redeem5115VaultHook.Contract.Build(&_Redeem5115VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Redeem5115VaultHook.Contract.Build(&_Redeem5115VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.DecodeAmount(&_Redeem5115VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.DecodeAmount(&_Redeem5115VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Redeem5115VaultHook.Contract.DecodeUsePrevHookAmount(&_Redeem5115VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Redeem5115VaultHook.Contract.DecodeUsePrevHookAmount(&_Redeem5115VaultHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.ExecutionNonce(&_Redeem5115VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.ExecutionNonce(&_Redeem5115VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.GetOutAmount(&_Redeem5115VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.GetOutAmount(&_Redeem5115VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) HookType() (uint8, error) {
+	return _Redeem5115VaultHook.Contract.HookType(&_Redeem5115VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) HookType() (uint8, error) {
+	return _Redeem5115VaultHook.Contract.HookType(&_Redeem5115VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _Redeem5115VaultHook.Contract.Inspect(&_Redeem5115VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _Redeem5115VaultHook.Contract.Inspect(&_Redeem5115VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) LastCaller() (common.Address, error) {
+	return _Redeem5115VaultHook.Contract.LastCaller(&_Redeem5115VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _Redeem5115VaultHook.Contract.LastCaller(&_Redeem5115VaultHook.CallOpts)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) ReplaceCalldataAmount(opts *bind.CallOpts, data []byte, amount *big.Int) ([]byte, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "replaceCalldataAmount", data, amount)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) ReplaceCalldataAmount(data []byte, amount *big.Int) ([]byte, error) {
+	return _Redeem5115VaultHook.Contract.ReplaceCalldataAmount(&_Redeem5115VaultHook.CallOpts, data, amount)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) ReplaceCalldataAmount(data []byte, amount *big.Int) ([]byte, error) {
+	return _Redeem5115VaultHook.Contract.ReplaceCalldataAmount(&_Redeem5115VaultHook.CallOpts, data, amount)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) SpToken() (common.Address, error) {
+	return _Redeem5115VaultHook.Contract.SpToken(&_Redeem5115VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _Redeem5115VaultHook.Contract.SpToken(&_Redeem5115VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) Subtype() ([32]byte, error) {
+	return _Redeem5115VaultHook.Contract.Subtype(&_Redeem5115VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _Redeem5115VaultHook.Contract.Subtype(&_Redeem5115VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem5115VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) UsedShares() (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.UsedShares(&_Redeem5115VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem5115VaultHook *Redeem5115VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _Redeem5115VaultHook.Contract.UsedShares(&_Redeem5115VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.PostExecute(&_Redeem5115VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.PostExecute(&_Redeem5115VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.PreExecute(&_Redeem5115VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.PreExecute(&_Redeem5115VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.ResetExecutionState(&_Redeem5115VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.ResetExecutionState(&_Redeem5115VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.SetExecutionContext(&_Redeem5115VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.SetExecutionContext(&_Redeem5115VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.SetOutAmount(&_Redeem5115VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem5115VaultHook *Redeem5115VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem5115VaultHook.Contract.SetOutAmount(&_Redeem5115VaultHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Redeem7540VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Redeem7540VaultHookMetaData contains all meta data concerning the Redeem7540VaultHook contract.
+var Redeem7540VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"replaceCalldataAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"amount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260078152660455243373534360cc1b6020909101525f805460ff191660021790557fc518a1d423df5aa415a8180f6384c181bd18f2bc1b734ba3dd52cd98b9b5fb776080526080516114de61007d5f395f8181610208015261027101526114de5ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80635492e677116100a9578063984d6e7a1161006e578063984d6e7a1461026c578063ac440f7214610293578063d06a1e89146102a6578063e445e7dd146102b9578063e7745517146102d2575f5ffd5b80635492e67714610206578063685a943c1461022c57806381cbe691146102345780638c4313c1146102475780638e1487761461025a575f5ffd5b80632113522a116100ef5780632113522a146101775780632ae2fe3d146101a157806338d52e0f146101b45780633a28b0ab146101c65780633b5896bc146101e6575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806314cb37cf146101515780631f26461914610164575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a3660046110fb565b6102f5565b005b61014f61015f36600461115c565b61036f565b61014f61017236600461117e565b610390565b6101896001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101af3660046110fb565b6103e9565b6101896001600160a01b0360025c1681565b6101d96101d436600461124b565b61045c565b60405161013391906112bb565b6101f96101f43660046110fb565b610473565b60405161013391906112cd565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b6101295f5c81565b61012961024236600461115c565b61068c565b6101d961025536600461135a565b61069e565b6101896001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b6101296102a1366004611399565b61071d565b61014f6102b436600461115c565b610727565b5f546102c59060ff1681565b60405161013391906113cb565b6102e56102e0366004611399565b6107a4565b6040519015158152602001610133565b336001600160a01b0384161461031e5760405163e15e56c960e01b815260040160405180910390fd5b5f610328846107b0565b9050610333816107c3565b156103515760405163945b63f560e01b815260040160405180910390fd5b61035c8160016107d0565b610368858585856107e6565b5050505050565b61037881610895565b50336004805c6001600160a01b0319168217905d5050565b5f61039a826107b0565b90506103a5816108c7565b806103b457506103b4816107c3565b156103d25760405163441e4c7360e11b815260040160405180910390fd5b5f6103de8260016108d0565b905083815d50505050565b336001600160a01b038416146104125760405163e15e56c960e01b815260040160405180910390fd5b5f61041c846107b0565b9050610427816108c7565b1561044557604051630bbb04d960e11b815260040160405180910390fd5b610450816001610925565b61036885858585610931565b606061046a83836034610afc565b90505b92915050565b60605f61048286868686610b92565b9050805160026104929190611405565b67ffffffffffffffff8111156104aa576104aa6111ac565b6040519080825280602002602001820160405280156104f657816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104c85790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161053f9493929190611418565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061058157610581611460565b60209081029190910101525f5b81518110156105e2578181815181106105a9576105a9611460565b6020026020010151838260016105bf9190611405565b815181106105cf576105cf611460565b602090810291909101015260010161058e565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106299493929190611418565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106689190611474565b8151811061067857610678611460565b602002602001018190525050949350505050565b5f61046d610699836107b0565b610dfd565b60606106de83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e0a92505050565b604051602001610706919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61046d82610e16565b336001600160a01b0360045c16146107525760405163e15e56c960e01b815260040160405180910390fd5b5f61075c826107b0565b9050610767816108c7565b15806107795750610777816107c3565b155b1561079757604051634bd439b560e11b815260040160405180910390fd5b6107a081610e22565b5050565b5f61046d826054610e39565b5f5f6107bb83610e65565b5c9392505050565b5f5f6107bb8360036108d0565b5f6107dc8360036108d0565b905081815d505050565b6108416107f28461068c565b6108318585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610ed192505050565b61083b9190611474565b84610f3f565b6108808383838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f5792505050565b61088b905f5c611474565b805f5d5050505050565b5f6003805c90826108a583611487565b9190505d505f6108b483610e65565b905060035c80825d505060035c92915050565b5f5f6107bb8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107dc8360026108d0565b5f61097083838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e0a92505050565b9050806001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156109ae573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109d2919061149f565b6002805c6001600160a01b0319166001600160a01b03831617905d50610a36610a308585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610ed192505050565b85610f3f565b610a758484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f5792505050565b805f5d50806001600160a01b031663a8d5fd656040518163ffffffff1660e01b8152600401602060405180830381865afa158015610ab5573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610ad9919061149f565b6001805c6001600160a01b0319166001600160a01b03831617905d505050505050565b60605f83604051602001610b1291815260200190565b60405160208183030381529060405290505f5b6020811015610b8857818181518110610b4057610b40611460565b01602001516001600160f81b03191686610b5a8387611405565b81518110610b6a57610b6a611460565b60200101906001600160f81b03191690815f1a905350600101610b25565b5093949350505050565b60605f610bd384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e0a92505050565b90505f610c1485858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610e1692505050565b90505f610c5886868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610e39915050565b90508015610ccb576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610ca4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610cc891906114ba565b91505b815f03610ceb576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0383161580610d0857506001600160a01b038716155b15610d2657604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610d3b57905050604080516060810182526001600160a01b0380871682525f6020830152825160248101879052908b16604482018190526064820152929650919082019060840160408051601f198184030181529190526020810180516001600160e01b0316635d043b2960e11b1790529052845185905f90610de757610de7611460565b6020026020010181905250505050949350505050565b5f5f6107bb8360016108d0565b5f61046d826020610fdc565b5f61046d826034611045565b610e2c815f610925565b610e36815f6107d0565b50565b5f828281518110610e4c57610e4c611460565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610eb492919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b6040516370a0823160e01b81526001600160a01b0383811660048301525f9160025c909116906370a0823190602401602060405180830381865afa158015610f1b573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061046a91906114ba565b5f610f49826107b0565b90505f6103de8260016108d0565b5f5f610f6283610e0a565b60405163eaed1d0760e01b81525f60048201526001600160a01b0386811660248301529192509082169063eaed1d0790604401602060405180830381865afa158015610fb0573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610fd491906114ba565b949350505050565b5f610fe8826014611405565b835110156110355760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f611051826020611405565b835110156110995760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b604482015260640161102c565b50016020015190565b6001600160a01b0381168114610e36575f5ffd5b5f5f83601f8401126110c6575f5ffd5b50813567ffffffffffffffff8111156110dd575f5ffd5b6020830191508360208285010111156110f4575f5ffd5b9250929050565b5f5f5f5f6060858703121561110e575f5ffd5b8435611119816110a2565b93506020850135611129816110a2565b9250604085013567ffffffffffffffff811115611144575f5ffd5b611150878288016110b6565b95989497509550505050565b5f6020828403121561116c575f5ffd5b8135611177816110a2565b9392505050565b5f5f6040838503121561118f575f5ffd5b8235915060208301356111a1816110a2565b809150509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f82601f8301126111cf575f5ffd5b813567ffffffffffffffff8111156111e9576111e96111ac565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611218576112186111ac565b60405281815283820160200185101561122f575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f6040838503121561125c575f5ffd5b823567ffffffffffffffff811115611272575f5ffd5b61127e858286016111c0565b95602094909401359450505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f61046a602083018461128d565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561134e57868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906113389087018261128d565b95505060209384019391909101906001016112f3565b50929695505050505050565b5f5f6020838503121561136b575f5ffd5b823567ffffffffffffffff811115611381575f5ffd5b61138d858286016110b6565b90969095509350505050565b5f602082840312156113a9575f5ffd5b813567ffffffffffffffff8111156113bf575f5ffd5b610fd4848285016111c0565b60208101600383106113eb57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561046d5761046d6113f1565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561046d5761046d6113f1565b5f60018201611498576114986113f1565b5060010190565b5f602082840312156114af575f5ffd5b8151611177816110a2565b5f602082840312156114ca575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// Redeem7540VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use Redeem7540VaultHookMetaData.ABI instead.
+var Redeem7540VaultHookABI = Redeem7540VaultHookMetaData.ABI
+
+// Redeem7540VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Redeem7540VaultHookMetaData.Bin instead.
+var Redeem7540VaultHookBin = Redeem7540VaultHookMetaData.Bin
+
+// DeployRedeem7540VaultHook deploys a new Ethereum contract, binding an instance of Redeem7540VaultHook to it.
+func DeployRedeem7540VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *Redeem7540VaultHook, error) {
+	parsed, err := Redeem7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Redeem7540VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Redeem7540VaultHook{Redeem7540VaultHookCaller: Redeem7540VaultHookCaller{contract: contract}, Redeem7540VaultHookTransactor: Redeem7540VaultHookTransactor{contract: contract}, Redeem7540VaultHookFilterer: Redeem7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// Redeem7540VaultHook is an auto generated Go binding around an Ethereum contract.
+type Redeem7540VaultHook struct {
+	Redeem7540VaultHookCaller     // Read-only binding to the contract
+	Redeem7540VaultHookTransactor // Write-only binding to the contract
+	Redeem7540VaultHookFilterer   // Log filterer for contract events
+}
+
+// Redeem7540VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Redeem7540VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem7540VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Redeem7540VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem7540VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Redeem7540VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Redeem7540VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Redeem7540VaultHookSession struct {
+	Contract     *Redeem7540VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// Redeem7540VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Redeem7540VaultHookCallerSession struct {
+	Contract *Redeem7540VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// Redeem7540VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Redeem7540VaultHookTransactorSession struct {
+	Contract     *Redeem7540VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// Redeem7540VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Redeem7540VaultHookRaw struct {
+	Contract *Redeem7540VaultHook // Generic contract binding to access the raw methods on
+}
+
+// Redeem7540VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Redeem7540VaultHookCallerRaw struct {
+	Contract *Redeem7540VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Redeem7540VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Redeem7540VaultHookTransactorRaw struct {
+	Contract *Redeem7540VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRedeem7540VaultHook creates a new instance of Redeem7540VaultHook, bound to a specific deployed contract.
+func NewRedeem7540VaultHook(address common.Address, backend bind.ContractBackend) (*Redeem7540VaultHook, error) {
+	contract, err := bindRedeem7540VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem7540VaultHook{Redeem7540VaultHookCaller: Redeem7540VaultHookCaller{contract: contract}, Redeem7540VaultHookTransactor: Redeem7540VaultHookTransactor{contract: contract}, Redeem7540VaultHookFilterer: Redeem7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewRedeem7540VaultHookCaller creates a new read-only instance of Redeem7540VaultHook, bound to a specific deployed contract.
+func NewRedeem7540VaultHookCaller(address common.Address, caller bind.ContractCaller) (*Redeem7540VaultHookCaller, error) {
+	contract, err := bindRedeem7540VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem7540VaultHookCaller{contract: contract}, nil
+}
+
+// NewRedeem7540VaultHookTransactor creates a new write-only instance of Redeem7540VaultHook, bound to a specific deployed contract.
+func NewRedeem7540VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*Redeem7540VaultHookTransactor, error) {
+	contract, err := bindRedeem7540VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem7540VaultHookTransactor{contract: contract}, nil
+}
+
+// NewRedeem7540VaultHookFilterer creates a new log filterer instance of Redeem7540VaultHook, bound to a specific deployed contract.
+func NewRedeem7540VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*Redeem7540VaultHookFilterer, error) {
+	contract, err := bindRedeem7540VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Redeem7540VaultHookFilterer{contract: contract}, nil
+}
+
+// bindRedeem7540VaultHook binds a generic wrapper to an already deployed contract.
+func bindRedeem7540VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Redeem7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Redeem7540VaultHook *Redeem7540VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Redeem7540VaultHook.Contract.Redeem7540VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Redeem7540VaultHook *Redeem7540VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.Redeem7540VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Redeem7540VaultHook *Redeem7540VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.Redeem7540VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Redeem7540VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _Redeem7540VaultHook.Contract.SUBTYPE(&_Redeem7540VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _Redeem7540VaultHook.Contract.SUBTYPE(&_Redeem7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) Asset() (common.Address, error) {
+	return _Redeem7540VaultHook.Contract.Asset(&_Redeem7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) Asset() (common.Address, error) {
+	return _Redeem7540VaultHook.Contract.Asset(&_Redeem7540VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Redeem7540VaultHook.Contract.Build(&_Redeem7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Redeem7540VaultHook.Contract.Build(&_Redeem7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.DecodeAmount(&_Redeem7540VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.DecodeAmount(&_Redeem7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Redeem7540VaultHook.Contract.DecodeUsePrevHookAmount(&_Redeem7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Redeem7540VaultHook.Contract.DecodeUsePrevHookAmount(&_Redeem7540VaultHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.ExecutionNonce(&_Redeem7540VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.ExecutionNonce(&_Redeem7540VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.GetOutAmount(&_Redeem7540VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.GetOutAmount(&_Redeem7540VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) HookType() (uint8, error) {
+	return _Redeem7540VaultHook.Contract.HookType(&_Redeem7540VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) HookType() (uint8, error) {
+	return _Redeem7540VaultHook.Contract.HookType(&_Redeem7540VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _Redeem7540VaultHook.Contract.Inspect(&_Redeem7540VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _Redeem7540VaultHook.Contract.Inspect(&_Redeem7540VaultHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) LastCaller() (common.Address, error) {
+	return _Redeem7540VaultHook.Contract.LastCaller(&_Redeem7540VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _Redeem7540VaultHook.Contract.LastCaller(&_Redeem7540VaultHook.CallOpts)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) ReplaceCalldataAmount(opts *bind.CallOpts, data []byte, amount *big.Int) ([]byte, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "replaceCalldataAmount", data, amount)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) ReplaceCalldataAmount(data []byte, amount *big.Int) ([]byte, error) {
+	return _Redeem7540VaultHook.Contract.ReplaceCalldataAmount(&_Redeem7540VaultHook.CallOpts, data, amount)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 amount) pure returns(bytes)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) ReplaceCalldataAmount(data []byte, amount *big.Int) ([]byte, error) {
+	return _Redeem7540VaultHook.Contract.ReplaceCalldataAmount(&_Redeem7540VaultHook.CallOpts, data, amount)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) SpToken() (common.Address, error) {
+	return _Redeem7540VaultHook.Contract.SpToken(&_Redeem7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _Redeem7540VaultHook.Contract.SpToken(&_Redeem7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) Subtype() ([32]byte, error) {
+	return _Redeem7540VaultHook.Contract.Subtype(&_Redeem7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _Redeem7540VaultHook.Contract.Subtype(&_Redeem7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Redeem7540VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) UsedShares() (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.UsedShares(&_Redeem7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Redeem7540VaultHook *Redeem7540VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _Redeem7540VaultHook.Contract.UsedShares(&_Redeem7540VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.PostExecute(&_Redeem7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.PostExecute(&_Redeem7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.PreExecute(&_Redeem7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.PreExecute(&_Redeem7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.ResetExecutionState(&_Redeem7540VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.ResetExecutionState(&_Redeem7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.SetExecutionContext(&_Redeem7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.SetExecutionContext(&_Redeem7540VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.SetOutAmount(&_Redeem7540VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Redeem7540VaultHook *Redeem7540VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Redeem7540VaultHook.Contract.SetOutAmount(&_Redeem7540VaultHook.TransactOpts, _outAmount, caller)
+}
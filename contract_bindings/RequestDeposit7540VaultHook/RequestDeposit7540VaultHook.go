@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package RequestDeposit7540VaultHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// RequestDeposit7540VaultHookMetaData contains all meta data concerning the RequestDeposit7540VaultHook contract.
+var RequestDeposit7540VaultHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"isAsyncCancelHook\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHookAsyncCancelations.CancelationType\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040805180820190915260078152660455243373534360cc1b6020909101525f805460ff191690557fc518a1d423df5aa415a8180f6384c181bd18f2bc1b734ba3dd52cd98b9b5fb776080526080516111ec61007a5f395f81816101f7015261026d01526111ec5ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80635492e677116100a9578063984d6e7a1161006e578063984d6e7a14610268578063ac440f721461028f578063d06a1e89146102a2578063e445e7dd146102b5578063e7745517146102c1575f5ffd5b80635492e677146101f5578063685a943c1461021b57806381cbe691146102235780638c4313c1146102365780638e14877614610256575f5ffd5b80631f264619116100ef5780631f264619146101735780632113522a146101865780632ae2fe3d146101b057806338d52e0f146101c35780633b5896bc146101d5575f5ffd5b806301bd43ef1461012057806305b4fe911461013c57806308c189f91461015157806314cb37cf14610160575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a366004610e53565b6102e4565b005b5f5b6040516101339190610ed0565b61014f61016e366004610ee3565b61035e565b61014f610181366004610efe565b61037f565b6101986001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610133565b61014f6101be366004610e53565b6103d8565b6101986001600160a01b0360025c1681565b6101e86101e3366004610e53565b61044b565b6040516101339190610f5a565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b6101295f5c81565b610129610231366004610ee3565b610664565b610249610244366004610fe7565b61067c565b6040516101339190611026565b6101986001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61012961029d36600461104c565b6106fb565b61014f6102b0366004610ee3565b610705565b5f546101539060ff1681565b6102d46102cf36600461104c565b610782565b6040519015158152602001610133565b336001600160a01b0384161461030d5760405163e15e56c960e01b815260040160405180910390fd5b5f6103178461078e565b9050610322816107a1565b156103405760405163945b63f560e01b815260040160405180910390fd5b61034b8160016107ae565b610357858585856107c4565b5050505050565b61036781610825565b50336004805c6001600160a01b0319168217905d5050565b5f6103898261078e565b905061039481610857565b806103a357506103a3816107a1565b156103c15760405163441e4c7360e11b815260040160405180910390fd5b5f6103cd826001610860565b905083815d50505050565b336001600160a01b038416146104015760405163e15e56c960e01b815260040160405180910390fd5b5f61040b8461078e565b905061041681610857565b1561043457604051630bbb04d960e11b815260040160405180910390fd5b61043f8160016108b5565b610357858585856108c1565b60605f61045a86868686610903565b90508051600261046a9190611113565b67ffffffffffffffff81111561048257610482611038565b6040519080825280602002602001820160405280156104ce57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104a05790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105179493929190611126565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106105595761055961116e565b60209081029190910101525f5b81518110156105ba578181815181106105815761058161116e565b6020026020010151838260016105979190611113565b815181106105a7576105a761116e565b6020908102919091010152600101610566565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106019493929190611126565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106409190611182565b815181106106505761065061116e565b602002602001018190525050949350505050565b5f6106766106718361078e565b610b6e565b92915050565b60606106bc83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b7b92505050565b6040516020016106e4919060609190911b6bffffffffffffffffffffffff1916815260140190565b604051602081830303815290604052905092915050565b5f61067682610b87565b336001600160a01b0360045c16146107305760405163e15e56c960e01b815260040160405180910390fd5b5f61073a8261078e565b905061074581610857565b15806107575750610755816107a1565b155b1561077557604051634bd439b560e11b815260040160405180910390fd5b61077e81610b93565b5050565b5f610676826054610baa565b5f5f61079983610bd6565b5c9392505050565b5f5f610799836003610860565b5f6107ba836003610860565b905081815d505050565b61081f6108068484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c4292505050565b61080f85610664565b6108199190611182565b84610d1c565b50505050565b5f6003805c908261083583611195565b9190505d505f61084483610bd6565b905060035c80825d505060035c92915050565b5f5f6107998360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6107ba836002610860565b61081f6108198484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c4292505050565b60605f61094484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b7b92505050565b90505f61098585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b8792505050565b90505f6109c986868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060549250610baa915050565b90508015610a3c576040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015610a15573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a3991906111ad565b91505b815f03610a5c576040516305112ab160e41b815260040160405180910390fd5b6001600160a01b0383161580610a7957506001600160a01b038716155b15610a9757604051630f58648f60e01b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610aac57905050604080516060810182526001600160a01b0380871682525f6020830152825160248101879052908b16604482018190526064820152929650919082019060840160408051601f198184030181529190526020810180516001600160e01b03166385b77f4560e01b1790529052845185905f90610b5857610b5861116e565b6020026020010181905250505050949350505050565b5f5f610799836001610860565b5f610676826020610d34565b5f610676826034610d9d565b610b9d815f6108b5565b610ba7815f6107ae565b50565b5f828281518110610bbd57610bbd61116e565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610c2592919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f610c4c82610b7b565b6001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610c87573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610cab91906111c4565b6040516370a0823160e01b81526001600160a01b03858116600483015291909116906370a0823190602401602060405180830381865afa158015610cf1573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d1591906111ad565b9392505050565b5f610d268261078e565b90505f6103cd826001610860565b5f610d40826014611113565b83511015610d8d5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f610da9826020611113565b83511015610df15760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610d84565b50016020015190565b6001600160a01b0381168114610ba7575f5ffd5b5f5f83601f840112610e1e575f5ffd5b50813567ffffffffffffffff811115610e35575f5ffd5b602083019150836020828501011115610e4c575f5ffd5b9250929050565b5f5f5f5f60608587031215610e66575f5ffd5b8435610e7181610dfa565b93506020850135610e8181610dfa565b9250604085013567ffffffffffffffff811115610e9c575f5ffd5b610ea887828801610e0e565b95989497509550505050565b60038110610ba757634e487b7160e01b5f52602160045260245ffd5b60208101610edd83610eb4565b91905290565b5f60208284031215610ef3575f5ffd5b8135610d1581610dfa565b5f5f60408385031215610f0f575f5ffd5b823591506020830135610f2181610dfa565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610fdb57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610fc590870182610f2c565b9550506020938401939190910190600101610f80565b50929695505050505050565b5f5f60208385031215610ff8575f5ffd5b823567ffffffffffffffff81111561100e575f5ffd5b61101a85828601610e0e565b90969095509350505050565b602081525f610d156020830184610f2c565b634e487b7160e01b5f52604160045260245ffd5b5f6020828403121561105c575f5ffd5b813567ffffffffffffffff811115611072575f5ffd5b8201601f81018413611082575f5ffd5b803567ffffffffffffffff81111561109c5761109c611038565b604051601f8201601f19908116603f0116810167ffffffffffffffff811182821017156110cb576110cb611038565b6040528181528282016020018610156110e2575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b80820180821115610676576106766110ff565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b81810381811115610676576106766110ff565b5f600182016111a6576111a66110ff565b5060010190565b5f602082840312156111bd575f5ffd5b5051919050565b5f602082840312156111d4575f5ffd5b8151610d1581610dfa56fea164736f6c634300081e000a",
+}
+
+// RequestDeposit7540VaultHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use RequestDeposit7540VaultHookMetaData.ABI instead.
+var RequestDeposit7540VaultHookABI = RequestDeposit7540VaultHookMetaData.ABI
+
+// RequestDeposit7540VaultHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use RequestDeposit7540VaultHookMetaData.Bin instead.
+var RequestDeposit7540VaultHookBin = RequestDeposit7540VaultHookMetaData.Bin
+
+// DeployRequestDeposit7540VaultHook deploys a new Ethereum contract, binding an instance of RequestDeposit7540VaultHook to it.
+func DeployRequestDeposit7540VaultHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *RequestDeposit7540VaultHook, error) {
+	parsed, err := RequestDeposit7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(RequestDeposit7540VaultHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &RequestDeposit7540VaultHook{RequestDeposit7540VaultHookCaller: RequestDeposit7540VaultHookCaller{contract: contract}, RequestDeposit7540VaultHookTransactor: RequestDeposit7540VaultHookTransactor{contract: contract}, RequestDeposit7540VaultHookFilterer: RequestDeposit7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// RequestDeposit7540VaultHook is an auto generated Go binding around an Ethereum contract.
+type RequestDeposit7540VaultHook struct {
+	RequestDeposit7540VaultHookCaller     // Read-only binding to the contract
+	RequestDeposit7540VaultHookTransactor // Write-only binding to the contract
+	RequestDeposit7540VaultHookFilterer   // Log filterer for contract events
+}
+
+// RequestDeposit7540VaultHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type RequestDeposit7540VaultHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RequestDeposit7540VaultHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type RequestDeposit7540VaultHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RequestDeposit7540VaultHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type RequestDeposit7540VaultHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// RequestDeposit7540VaultHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type RequestDeposit7540VaultHookSession struct {
+	Contract     *RequestDeposit7540VaultHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// RequestDeposit7540VaultHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type RequestDeposit7540VaultHookCallerSession struct {
+	Contract *RequestDeposit7540VaultHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// RequestDeposit7540VaultHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type RequestDeposit7540VaultHookTransactorSession struct {
+	Contract     *RequestDeposit7540VaultHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// RequestDeposit7540VaultHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type RequestDeposit7540VaultHookRaw struct {
+	Contract *RequestDeposit7540VaultHook // Generic contract binding to access the raw methods on
+}
+
+// RequestDeposit7540VaultHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type RequestDeposit7540VaultHookCallerRaw struct {
+	Contract *RequestDeposit7540VaultHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// RequestDeposit7540VaultHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type RequestDeposit7540VaultHookTransactorRaw struct {
+	Contract *RequestDeposit7540VaultHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewRequestDeposit7540VaultHook creates a new instance of RequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewRequestDeposit7540VaultHook(address common.Address, backend bind.ContractBackend) (*RequestDeposit7540VaultHook, error) {
+	contract, err := bindRequestDeposit7540VaultHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestDeposit7540VaultHook{RequestDeposit7540VaultHookCaller: RequestDeposit7540VaultHookCaller{contract: contract}, RequestDeposit7540VaultHookTransactor: RequestDeposit7540VaultHookTransactor{contract: contract}, RequestDeposit7540VaultHookFilterer: RequestDeposit7540VaultHookFilterer{contract: contract}}, nil
+}
+
+// NewRequestDeposit7540VaultHookCaller creates a new read-only instance of RequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewRequestDeposit7540VaultHookCaller(address common.Address, caller bind.ContractCaller) (*RequestDeposit7540VaultHookCaller, error) {
+	contract, err := bindRequestDeposit7540VaultHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestDeposit7540VaultHookCaller{contract: contract}, nil
+}
+
+// NewRequestDeposit7540VaultHookTransactor creates a new write-only instance of RequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewRequestDeposit7540VaultHookTransactor(address common.Address, transactor bind.ContractTransactor) (*RequestDeposit7540VaultHookTransactor, error) {
+	contract, err := bindRequestDeposit7540VaultHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestDeposit7540VaultHookTransactor{contract: contract}, nil
+}
+
+// NewRequestDeposit7540VaultHookFilterer creates a new log filterer instance of RequestDeposit7540VaultHook, bound to a specific deployed contract.
+func NewRequestDeposit7540VaultHookFilterer(address common.Address, filterer bind.ContractFilterer) (*RequestDeposit7540VaultHookFilterer, error) {
+	contract, err := bindRequestDeposit7540VaultHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &RequestDeposit7540VaultHookFilterer{contract: contract}, nil
+}
+
+// bindRequestDeposit7540VaultHook binds a generic wrapper to an already deployed contract.
+func bindRequestDeposit7540VaultHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := RequestDeposit7540VaultHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RequestDeposit7540VaultHook.Contract.RequestDeposit7540VaultHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.RequestDeposit7540VaultHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.RequestDeposit7540VaultHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _RequestDeposit7540VaultHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) SUBTYPE() ([32]byte, error) {
+	return _RequestDeposit7540VaultHook.Contract.SUBTYPE(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _RequestDeposit7540VaultHook.Contract.SUBTYPE(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) Asset() (common.Address, error) {
+	return _RequestDeposit7540VaultHook.Contract.Asset(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) Asset() (common.Address, error) {
+	return _RequestDeposit7540VaultHook.Contract.Asset(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _RequestDeposit7540VaultHook.Contract.Build(&_RequestDeposit7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _RequestDeposit7540VaultHook.Contract.Build(&_RequestDeposit7540VaultHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "decodeAmount", data)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.DecodeAmount(&_RequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes data) pure returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) DecodeAmount(data []byte) (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.DecodeAmount(&_RequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _RequestDeposit7540VaultHook.Contract.DecodeUsePrevHookAmount(&_RequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _RequestDeposit7540VaultHook.Contract.DecodeUsePrevHookAmount(&_RequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) ExecutionNonce() (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.ExecutionNonce(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.ExecutionNonce(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.GetOutAmount(&_RequestDeposit7540VaultHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.GetOutAmount(&_RequestDeposit7540VaultHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) HookType() (uint8, error) {
+	return _RequestDeposit7540VaultHook.Contract.HookType(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) HookType() (uint8, error) {
+	return _RequestDeposit7540VaultHook.Contract.HookType(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) Inspect(data []byte) ([]byte, error) {
+	return _RequestDeposit7540VaultHook.Contract.Inspect(&_RequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _RequestDeposit7540VaultHook.Contract.Inspect(&_RequestDeposit7540VaultHook.CallOpts, data)
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) IsAsyncCancelHook(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "isAsyncCancelHook")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) IsAsyncCancelHook() (uint8, error) {
+	return _RequestDeposit7540VaultHook.Contract.IsAsyncCancelHook(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// IsAsyncCancelHook is a free data retrieval call binding the contract method 0x08c189f9.
+//
+// Solidity: function isAsyncCancelHook() pure returns(uint8)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) IsAsyncCancelHook() (uint8, error) {
+	return _RequestDeposit7540VaultHook.Contract.IsAsyncCancelHook(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) LastCaller() (common.Address, error) {
+	return _RequestDeposit7540VaultHook.Contract.LastCaller(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) LastCaller() (common.Address, error) {
+	return _RequestDeposit7540VaultHook.Contract.LastCaller(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) SpToken() (common.Address, error) {
+	return _RequestDeposit7540VaultHook.Contract.SpToken(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) SpToken() (common.Address, error) {
+	return _RequestDeposit7540VaultHook.Contract.SpToken(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) Subtype() ([32]byte, error) {
+	return _RequestDeposit7540VaultHook.Contract.Subtype(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) Subtype() ([32]byte, error) {
+	return _RequestDeposit7540VaultHook.Contract.Subtype(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _RequestDeposit7540VaultHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) UsedShares() (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.UsedShares(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookCallerSession) UsedShares() (*big.Int, error) {
+	return _RequestDeposit7540VaultHook.Contract.UsedShares(&_RequestDeposit7540VaultHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.PostExecute(&_RequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.PostExecute(&_RequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.PreExecute(&_RequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.PreExecute(&_RequestDeposit7540VaultHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.ResetExecutionState(&_RequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.ResetExecutionState(&_RequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.SetExecutionContext(&_RequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.SetExecutionContext(&_RequestDeposit7540VaultHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.SetOutAmount(&_RequestDeposit7540VaultHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_RequestDeposit7540VaultHook *RequestDeposit7540VaultHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _RequestDeposit7540VaultHook.Contract.SetOutAmount(&_RequestDeposit7540VaultHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,634 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package SetOperator7540Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// SetOperator7540HookMetaData contains all meta data concerning the SetOperator7540Hook contract.
+var SetOperator7540HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+}
+
+// SetOperator7540HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use SetOperator7540HookMetaData.ABI instead.
+var SetOperator7540HookABI = SetOperator7540HookMetaData.ABI
+
+// SetOperator7540Hook is an auto generated Go binding around an Ethereum contract.
+type SetOperator7540Hook struct {
+	SetOperator7540HookCaller     // Read-only binding to the contract
+	SetOperator7540HookTransactor // Write-only binding to the contract
+	SetOperator7540HookFilterer   // Log filterer for contract events
+}
+
+// SetOperator7540HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SetOperator7540HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SetOperator7540HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SetOperator7540HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SetOperator7540HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SetOperator7540HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SetOperator7540HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SetOperator7540HookSession struct {
+	Contract     *SetOperator7540Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts        // Call options to use throughout this session
+	TransactOpts bind.TransactOpts    // Transaction auth options to use throughout this session
+}
+
+// SetOperator7540HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SetOperator7540HookCallerSession struct {
+	Contract *SetOperator7540HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts              // Call options to use throughout this session
+}
+
+// SetOperator7540HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SetOperator7540HookTransactorSession struct {
+	Contract     *SetOperator7540HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts              // Transaction auth options to use throughout this session
+}
+
+// SetOperator7540HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SetOperator7540HookRaw struct {
+	Contract *SetOperator7540Hook // Generic contract binding to access the raw methods on
+}
+
+// SetOperator7540HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SetOperator7540HookCallerRaw struct {
+	Contract *SetOperator7540HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SetOperator7540HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SetOperator7540HookTransactorRaw struct {
+	Contract *SetOperator7540HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSetOperator7540Hook creates a new instance of SetOperator7540Hook, bound to a specific deployed contract.
+func NewSetOperator7540Hook(address common.Address, backend bind.ContractBackend) (*SetOperator7540Hook, error) {
+	contract, err := bindSetOperator7540Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SetOperator7540Hook{SetOperator7540HookCaller: SetOperator7540HookCaller{contract: contract}, SetOperator7540HookTransactor: SetOperator7540HookTransactor{contract: contract}, SetOperator7540HookFilterer: SetOperator7540HookFilterer{contract: contract}}, nil
+}
+
+// NewSetOperator7540HookCaller creates a new read-only instance of SetOperator7540Hook, bound to a specific deployed contract.
+func NewSetOperator7540HookCaller(address common.Address, caller bind.ContractCaller) (*SetOperator7540HookCaller, error) {
+	contract, err := bindSetOperator7540Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SetOperator7540HookCaller{contract: contract}, nil
+}
+
+// NewSetOperator7540HookTransactor creates a new write-only instance of SetOperator7540Hook, bound to a specific deployed contract.
+func NewSetOperator7540HookTransactor(address common.Address, transactor bind.ContractTransactor) (*SetOperator7540HookTransactor, error) {
+	contract, err := bindSetOperator7540Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SetOperator7540HookTransactor{contract: contract}, nil
+}
+
+// NewSetOperator7540HookFilterer creates a new log filterer instance of SetOperator7540Hook, bound to a specific deployed contract.
+func NewSetOperator7540HookFilterer(address common.Address, filterer bind.ContractFilterer) (*SetOperator7540HookFilterer, error) {
+	contract, err := bindSetOperator7540Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SetOperator7540HookFilterer{contract: contract}, nil
+}
+
+// bindSetOperator7540Hook binds a generic wrapper to an already deployed contract.
+func bindSetOperator7540Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SetOperator7540HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SetOperator7540Hook *SetOperator7540HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SetOperator7540Hook.Contract.SetOperator7540HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SetOperator7540Hook *SetOperator7540HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.SetOperator7540HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SetOperator7540Hook *SetOperator7540HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.SetOperator7540HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SetOperator7540Hook *SetOperator7540HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SetOperator7540Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SetOperator7540Hook *SetOperator7540HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SetOperator7540Hook *SetOperator7540HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SetOperator7540Hook *SetOperator7540HookSession) SUBTYPE() ([32]byte, error) {
+	return _SetOperator7540Hook.Contract.SUBTYPE(&_SetOperator7540Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _SetOperator7540Hook.Contract.SUBTYPE(&_SetOperator7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookSession) Asset() (common.Address, error) {
+	return _SetOperator7540Hook.Contract.Asset(&_SetOperator7540Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) Asset() (common.Address, error) {
+	return _SetOperator7540Hook.Contract.Asset(&_SetOperator7540Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SetOperator7540Hook *SetOperator7540HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SetOperator7540Hook.Contract.Build(&_SetOperator7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SetOperator7540Hook.Contract.Build(&_SetOperator7540Hook.CallOpts, prevHook, account, hookData)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookSession) ExecutionNonce() (*big.Int, error) {
+	return _SetOperator7540Hook.Contract.ExecutionNonce(&_SetOperator7540Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _SetOperator7540Hook.Contract.ExecutionNonce(&_SetOperator7540Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SetOperator7540Hook.Contract.GetOutAmount(&_SetOperator7540Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SetOperator7540Hook.Contract.GetOutAmount(&_SetOperator7540Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SetOperator7540Hook *SetOperator7540HookSession) HookType() (uint8, error) {
+	return _SetOperator7540Hook.Contract.HookType(&_SetOperator7540Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) HookType() (uint8, error) {
+	return _SetOperator7540Hook.Contract.HookType(&_SetOperator7540Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SetOperator7540Hook *SetOperator7540HookSession) Inspect(data []byte) ([]byte, error) {
+	return _SetOperator7540Hook.Contract.Inspect(&_SetOperator7540Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _SetOperator7540Hook.Contract.Inspect(&_SetOperator7540Hook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookSession) LastCaller() (common.Address, error) {
+	return _SetOperator7540Hook.Contract.LastCaller(&_SetOperator7540Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) LastCaller() (common.Address, error) {
+	return _SetOperator7540Hook.Contract.LastCaller(&_SetOperator7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookSession) SpToken() (common.Address, error) {
+	return _SetOperator7540Hook.Contract.SpToken(&_SetOperator7540Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) SpToken() (common.Address, error) {
+	return _SetOperator7540Hook.Contract.SpToken(&_SetOperator7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SetOperator7540Hook *SetOperator7540HookSession) Subtype() ([32]byte, error) {
+	return _SetOperator7540Hook.Contract.Subtype(&_SetOperator7540Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) Subtype() ([32]byte, error) {
+	return _SetOperator7540Hook.Contract.Subtype(&_SetOperator7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SetOperator7540Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookSession) UsedShares() (*big.Int, error) {
+	return _SetOperator7540Hook.Contract.UsedShares(&_SetOperator7540Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SetOperator7540Hook *SetOperator7540HookCallerSession) UsedShares() (*big.Int, error) {
+	return _SetOperator7540Hook.Contract.UsedShares(&_SetOperator7540Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SetOperator7540Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SetOperator7540Hook *SetOperator7540HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.PostExecute(&_SetOperator7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.PostExecute(&_SetOperator7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SetOperator7540Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SetOperator7540Hook *SetOperator7540HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.PreExecute(&_SetOperator7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.PreExecute(&_SetOperator7540Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.ResetExecutionState(&_SetOperator7540Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.ResetExecutionState(&_SetOperator7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.SetExecutionContext(&_SetOperator7540Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.SetExecutionContext(&_SetOperator7540Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.SetOutAmount(&_SetOperator7540Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SetOperator7540Hook *SetOperator7540HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SetOperator7540Hook.Contract.SetOutAmount(&_SetOperator7540Hook.TransactOpts, _outAmount, caller)
+}
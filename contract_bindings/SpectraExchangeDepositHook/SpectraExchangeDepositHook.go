@@ -0,0 +1,780 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package SpectraExchangeDepositHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// SpectraExchangeDepositHookMetaData contains all meta data concerning the SpectraExchangeDepositHook contract.
+var SpectraExchangeDepositHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"router_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"router\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISpectraRouter\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_COMMAND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DEADLINE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_IBT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_LAST_COMMAND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_MIN_SHARES\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_RECIPIENT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SELECTOR\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_TRANSFER_TOKEN\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b5060405161243938038061243983398101604081905261002e916100af565b6040805180820190915260048152631415165560e21b6020909101525f805460ff191690557fb86a7e98c9c656516a060ac5bc14d0453b037386adfb5f9f2ae12ee7a543e1b46080526001600160a01b03811661009e57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a05161232e61010b5f395f81816103030152610d0901525f81816101f3015261029d015261232e5ff3fe608060405234801561000f575f5ffd5b5060043610610127575f3560e01c8063685a943c116100a9578063cf0a61811161006e578063cf0a618114610298578063d06a1e89146102bf578063e445e7dd146102d2578063e7745517146102eb578063f887ea40146102fe575f5ffd5b8063685a943c1461023957806381cbe6911461024157806384542a24146102545780638c4313c1146102665780638e14877614610286575f5ffd5b80632ae2fe3d116100ef5780632ae2fe3d146101ac57806338d52e0f146101bf5780633b5896bc146101d15780635492e677146101f157806360b5be2914610217575f5ffd5b806301bd43ef1461012b57806305b4fe911461014757806314cb37cf1461015c5780631f2646191461016f5780632113522a14610182575b5f5ffd5b61013460035c81565b6040519081526020015b60405180910390f35b61015a610155366004611b1c565b610325565b005b61015a61016a366004611b7c565b61039f565b61015a61017d366004611b97565b6103c0565b6101946001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161013e565b61015a6101ba366004611b1c565b610419565b6101946001600160a01b0360025c1681565b6101e46101df366004611b1c565b61048c565b60405161013e9190611bf3565b7f0000000000000000000000000000000000000000000000000000000000000000610134565b61022960ff600160a81b60025c041681565b604051901515815260200161013e565b6101345f5c81565b61013461024f366004611b7c565b6106a4565b61022960ff600160a01b60025c041681565b610279610274366004611c80565b6106bc565b60405161013e9190611cbe565b6101946001600160a01b0360015c1681565b6101347f000000000000000000000000000000000000000000000000000000000000000081565b61015a6102cd366004611b7c565b610a46565b5f546102de9060ff1681565b60405161013e9190611cd0565b6102296102f9366004611da5565b610a98565b6101947f000000000000000000000000000000000000000000000000000000000000000081565b336001600160a01b0384161461034e5760405163e15e56c960e01b815260040160405180910390fd5b5f61035884610aa4565b905061036381610ab7565b156103815760405163945b63f560e01b815260040160405180910390fd5b61038c816001610ac4565b61039885858585610ada565b5050505050565b6103a881610b07565b50336004805c6001600160a01b0319168217905d5050565b5f6103ca82610aa4565b90506103d581610b39565b806103e457506103e481610ab7565b156104025760405163441e4c7360e11b815260040160405180910390fd5b5f61040e826001610b42565b905083815d50505050565b336001600160a01b038416146104425760405163e15e56c960e01b815260040160405180910390fd5b5f61044c84610aa4565b905061045781610b39565b1561047557604051630bbb04d960e11b815260040160405180910390fd5b610480816001610b97565b61039885858585610ba3565b60605f61049b86868686610bb1565b9050805160026104ab9190611df2565b6001600160401b038111156104c2576104c2611cf6565b60405190808252806020026020018201604052801561050e57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104e05790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105579493929190611e05565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061059957610599611e4d565b60209081029190910101525f5b81518110156105fa578181815181106105c1576105c1611e4d565b6020026020010151838260016105d79190611df2565b815181106105e7576105e7611e4d565b60209081029190910101526001016105a6565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106419493929190611e05565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516106809190611e61565b8151811061069057610690611e4d565b602002602001018190525050949350505050565b5f6106b66106b183610aa4565b610df3565b92915050565b6060365f6106cd8460558188611e74565b915091506106d9611a13565b6106e660045f8486611e74565b6106ef91611e9b565b6001600160e01b03191680825263db7a943d60e01b0161078f576107168260048186611e74565b8101906107239190611f75565b60608301819052604083019190915251608082018190526001600160401b0381111561075157610751611cf6565b60405190808252806020026020018201604052801561078457816020015b606081526020019060019003908161076f5790505b50602082015261082f565b80516001600160e01b03191663329b2a6d60e21b0161082f576107b58260048186611e74565b8101906107c29190611fd8565b60a084015260608301819052604083019190915251608082018190526001600160401b038111156107f5576107f5611cf6565b60405190808252806020026020018201604052801561082857816020015b60608152602001906001900390816108135790505b5060208201525b61084181604001518260800151610e00565b60c082018190525160e0820152604080516020601f88018190048102820181019092528681525f9161088d919089908990819084018382808284375f92019190915250610f0a92505050565b6040516020016108b0919060609190911b6001600160601b031916815260140190565b60405160208183030381529060405290505f5b8260e00151811015610a3b575f8360c0015182815181106108e6576108e6611e4d565b602002602001015190505f8460600151838151811061090757610907611e4d565b602002602001015190506005820361098e578080602001905181019061092d9190612044565b6101c08a01526001600160a01b039081166101608a018190529181166101408a018190526101208a0193909352929092166101008801819052604051610978938893916020016120b8565b6040516020818303038152906040529350610a31565b600482036109e457808060200190518101906109aa91906120f5565b6001600160a01b039081166101a0890181905261012089019290925291909116610180870181905260405161097892879291602001612135565b81610a3157808060200190518101906109fd9190612168565b6001600160a01b03166101e08601819052604051610a1f918691602001612183565b60405160208183030381529060405293505b50506001016108c3565b509695505050505050565b5f610a5082610aa4565b9050610a5b81610b39565b1580610a6d5750610a6b81610ab7565b155b15610a8b57604051634bd439b560e11b815260040160405180910390fd5b610a9481610f16565b5050565b5f6106b6826034610f2d565b5f5f610aaf83610f59565b5c9392505050565b5f5f610aaf836003610b42565b5f610ad0836003610b42565b905081815d505050565b610b01610ae6846106a4565b610af1848487610fc0565b610afb9190611e61565b8461106c565b50505050565b5f6003805c9082610b17836121aa565b9190505d505f610b2683610f59565b905060035c80825d505060035c92915050565b5f5f610aaf8360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f610ad0836002610b42565b610b01610afb838386610fc0565b60605f610bf284848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f0a92505050565b90505f610c3685858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610f2d915050565b90505f610c47605560358789611e74565b810190610c5491906121c2565b90505f610c64866055818a611e74565b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920182905250939450610cb59250610cac91508990506055818c611e74565b8b878e8a611084565b604080516001808252818301909252919250816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610ccc57905050955060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001858015610d4257505f85115b610d4c5784610db4565b6040516381cbe69160e01b81526001600160a01b038c811660048301528d16906381cbe69190602401602060405180830381865afa158015610d90573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610db491906121d9565b815260200185610dc45783610dc6565b825b815250865f81518110610ddb57610ddb611e4d565b60200260200101819052505050505050949350505050565b5f5f610aaf836001610b42565b8151606090828114610e255760405163899ef10d60e01b815260040160405180910390fd5b806001600160401b03811115610e3d57610e3d611cf6565b604051908082528060200260200182016040528015610e66578160200160208202803683370190505b5091505f5b81811015610f02575f858281518110610e8657610e86611e4d565b6020910101516001600160f81b03198116915060f81c603f1660058114801590610eb1575060048114155b8015610ebc57508015155b15610eda57604051637ee9fb1960e01b815260040160405180910390fd5b80858481518110610eed57610eed611e4d565b60209081029190910101525050600101610e6b565b505092915050565b5f6106b6826020611862565b610f20815f610b97565b610f2a815f610ac4565b50565b5f828281518110610f4057610f40611e4d565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610fa392919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f80610fd7610fd28560558189611e74565b6118ca565b90506001600160a01b038116610ff95750506001600160a01b03811631611065565b6040516370a0823160e01b81526001600160a01b0384811660048301528216906370a0823190602401602060405180830381865afa15801561103d573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061106191906121d9565b9150505b9392505050565b5f61107682610aa4565b90505f61040e826001610b42565b606061108e611a13565b61109b60045f898b611e74565b6110a491611e9b565b6001600160e01b03191680825263db7a943d60e01b01611144576110cb876004818b611e74565b8101906110d89190611f75565b60608301819052604083019190915251608082018190526001600160401b0381111561110657611106611cf6565b60405190808252806020026020018201604052801561113957816020015b60608152602001906001900390816111245790505b5060208201526111eb565b80516001600160e01b03191663329b2a6d60e21b016111d25761116a876004818b611e74565b8101906111779190611fd8565b60a08401819052606084019190915260408301919091524211156111ae57604051632c5af88760e21b815260040160405180910390fd5b606081015151608082018190526001600160401b0381111561110657611106611cf6565b60405163d858d3d360e01b815260040160405180910390fd5b6111fd81604001518260800151610e00565b60c08201819052805160e083018190525f919061121c90600190611e61565b8151811061122c5761122c611e4d565b60200260200101510361125257604051632effda3760e01b815260040160405180910390fd5b5f5b8160e0015181101561177a575f8260c00151828151811061127757611277611e4d565b602002602001015190505f8360600151838151811061129857611298611e4d565b60200260200101519050600582036114c757808060200190518101906112be9190612044565b6101c089018190526001600160a01b039182166101608a015291811661014089015261012088019290925291166101008601525f0361131057604051630217861160e51b815260040160405180910390fd5b856001600160a01b03168461010001516001600160a01b0316146113475760405163569d85b160e11b815260040160405180910390fd5b886001600160a01b03168461014001516001600160a01b03161415806113845750886001600160a01b03168461016001516001600160a01b031614155b156113a25760405163290802d360e11b815260040160405180910390fd5b8715611417576040516381cbe69160e01b81526001600160a01b038a811660048301528816906381cbe69190602401602060405180830381865afa1580156113ec573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061141091906121d9565b6101208501525b8361012001515f0361143c576040516305112ab160e41b815260040160405180910390fd5b6101008401516101208501516101408601516101608701516101c0880151604080516001600160a01b03968716602082015290810194909452918416606084015292909216608082015260a081019190915260c0015b604051602081830303815290604052846020015184815181106114b7576114b7611e4d565b6020026020010181905250611770565b6004820361163257808060200190518101906114e391906120f5565b6001600160a01b039081166101a088015261012087019190915216610180850181905261152357604051631e6c645960e01b815260040160405180910390fd5b886001600160a01b0316846101a001516001600160a01b03161461155a5760405163290802d360e11b815260040160405180910390fd5b87156115cf576040516381cbe69160e01b81526001600160a01b038a811660048301528816906381cbe69190602401602060405180830381865afa1580156115a4573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906115c891906121d9565b6101208501525b8361012001515f036115f4576040516305112ab160e41b815260040160405180910390fd5b6101808401516101208501516101a0860151604080516001600160a01b03948516602082015290810192909252919091166060820152608001611492565b81611770578080602001905181019061164b91906121f0565b6101208601526001600160a01b03166101e0850181905261167f576040516357b707a960e01b815260040160405180910390fd5b87156116f4576040516381cbe69160e01b81526001600160a01b038a811660048301528816906381cbe69190602401602060405180830381865afa1580156116c9573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906116ed91906121d9565b6101208501525b8361012001515f03611719576040516305112ab160e41b815260040160405180910390fd5b6101e0840151610120850151604080516001600160a01b0390931660208401528201526060016040516020818303038152906040528460200151848151811061176457611764611e4d565b60200260200101819052505b5050600101611254565b5080516001600160e01b03191663db7a943d60e01b016117e9578051604080830151602084015191516117b09290602401612276565b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b0319909316929092179091529150610a3b565b80516001600160e01b03191663329b2a6d60e21b01610a3b578051604080830151602084015160a08501519251611823939060240161229a565b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b0319909316929092179091529150509695505050505050565b5f61186e826014611df2565b835110156118ba5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b5f806118d96004828587611e74565b6118e291611e9b565b905060608063db7a943d60e01b6001600160e01b03198416016119235761190c8560048189611e74565b8101906119199190611f75565b909250905061195c565b63329b2a6d60e21b6001600160e01b03198416016111d2576119488560048189611e74565b8101906119559190611fd8565b5090925090505b80515f6119698483610e00565b80519091505f5b81811015611a06575f83828151811061198b5761198b611e4d565b602002602001015190505f8683815181106119a8576119a8611e4d565b60200260200101519050600582036119da57808060200190518101906119ce91906122cf565b50919b506119fc915050565b600482036119fc57808060200190518101906119f691906120f5565b50909a50505b5050600101611970565b5050505050505092915050565b6040518061020001604052805f6001600160e01b03191681526020016060815260200160608152602001606081526020015f81526020015f8152602001606081526020015f81526020015f6001600160a01b031681526020015f81526020015f6001600160a01b031681526020015f6001600160a01b031681526020015f6001600160a01b031681526020015f6001600160a01b031681526020015f81526020015f6001600160a01b031681525090565b6001600160a01b0381168114610f2a575f5ffd5b5f5f83601f840112611ae8575f5ffd5b5081356001600160401b03811115611afe575f5ffd5b602083019150836020828501011115611b15575f5ffd5b9250929050565b5f5f5f5f60608587031215611b2f575f5ffd5b8435611b3a81611ac4565b93506020850135611b4a81611ac4565b925060408501356001600160401b03811115611b64575f5ffd5b611b7087828801611ad8565b95989497509550505050565b5f60208284031215611b8c575f5ffd5b813561106581611ac4565b5f5f60408385031215611ba8575f5ffd5b823591506020830135611bba81611ac4565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611c7457868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611c5e90870182611bc5565b9550506020938401939190910190600101611c19565b50929695505050505050565b5f5f60208385031215611c91575f5ffd5b82356001600160401b03811115611ca6575f5ffd5b611cb285828601611ad8565b90969095509350505050565b602081525f6110656020830184611bc5565b6020810160038310611cf057634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f191681016001600160401b0381118282101715611d3257611d32611cf6565b604052919050565b5f82601f830112611d49575f5ffd5b81356001600160401b03811115611d6257611d62611cf6565b611d75601f8201601f1916602001611d0a565b818152846020838601011115611d89575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611db5575f5ffd5b81356001600160401b03811115611dca575f5ffd5b611dd684828501611d3a565b949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106b6576106b6611dde565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106b6576106b6611dde565b5f5f85851115611e82575f5ffd5b83861115611e8e575f5ffd5b5050820193919092039150565b80356001600160e01b03198116906004841015611ecc576001600160e01b0319600485900360031b81901b82161691505b5092915050565b5f82601f830112611ee2575f5ffd5b81356001600160401b03811115611efb57611efb611cf6565b8060051b611f0b60208201611d0a565b91825260208185018101929081019086841115611f26575f5ffd5b6020860192505b83831015611f6b5782356001600160401b03811115611f4a575f5ffd5b611f59886020838a0101611d3a565b83525060209283019290910190611f2d565b9695505050505050565b5f5f60408385031215611f86575f5ffd5b82356001600160401b03811115611f9b575f5ffd5b611fa785828601611d3a565b92505060208301356001600160401b03811115611fc2575f5ffd5b611fce85828601611ed3565b9150509250929050565b5f5f5f60608486031215611fea575f5ffd5b83356001600160401b03811115611fff575f5ffd5b61200b86828701611d3a565b93505060208401356001600160401b03811115612026575f5ffd5b61203286828701611ed3565b93969395505050506040919091013590565b5f5f5f5f5f60a08688031215612058575f5ffd5b855161206381611ac4565b60208701516040880151919650945061207b81611ac4565b606087015190935061208c81611ac4565b60809690960151949793965091949392915050565b5f81518060208401855e5f93019283525090919050565b5f6120c382876120a1565b6001600160601b0319606096871b8116825294861b851660148201529290941b9092166028820152603c019392505050565b5f5f5f60608486031215612107575f5ffd5b835161211281611ac4565b60208501516040860151919450925061212a81611ac4565b809150509250925092565b5f61214082866120a1565b6001600160601b0319606095861b811682529390941b90921660148401525050602801919050565b5f60208284031215612178575f5ffd5b815161106581611ac4565b5f61218e82856120a1565b60609390931b6001600160601b03191683525050601401919050565b5f600182016121bb576121bb611dde565b5060010190565b5f602082840312156121d2575f5ffd5b5035919050565b5f602082840312156121e9575f5ffd5b5051919050565b5f5f60408385031215612201575f5ffd5b825161220c81611ac4565b6020939093015192949293505050565b5f82825180855260208501945060208160051b830101602085015f5b8381101561226a57601f19858403018852612254838351611bc5565b6020988901989093509190910190600101612238565b50909695505050505050565b604081525f6122886040830185611bc5565b8281036020840152611061818561221c565b606081525f6122ac6060830186611bc5565b82810360208401526122be818661221c565b915050826040830152949350505050565b5f5f5f5f608085870312156122e2575f5ffd5b84516122ed81611ac4565b60208601516040870151919550935061230581611ac4565b606086015190925061231681611ac4565b93969295509093505056fea164736f6c634300081e000a",
+}
+
+// SpectraExchangeDepositHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use SpectraExchangeDepositHookMetaData.ABI instead.
+var SpectraExchangeDepositHookABI = SpectraExchangeDepositHookMetaData.ABI
+
+// SpectraExchangeDepositHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SpectraExchangeDepositHookMetaData.Bin instead.
+var SpectraExchangeDepositHookBin = SpectraExchangeDepositHookMetaData.Bin
+
+// DeploySpectraExchangeDepositHook deploys a new Ethereum contract, binding an instance of SpectraExchangeDepositHook to it.
+func DeploySpectraExchangeDepositHook(auth *bind.TransactOpts, backend bind.ContractBackend, router_ common.Address) (common.Address, *types.Transaction, *SpectraExchangeDepositHook, error) {
+	parsed, err := SpectraExchangeDepositHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SpectraExchangeDepositHookBin), backend, router_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SpectraExchangeDepositHook{SpectraExchangeDepositHookCaller: SpectraExchangeDepositHookCaller{contract: contract}, SpectraExchangeDepositHookTransactor: SpectraExchangeDepositHookTransactor{contract: contract}, SpectraExchangeDepositHookFilterer: SpectraExchangeDepositHookFilterer{contract: contract}}, nil
+}
+
+// SpectraExchangeDepositHook is an auto generated Go binding around an Ethereum contract.
+type SpectraExchangeDepositHook struct {
+	SpectraExchangeDepositHookCaller     // Read-only binding to the contract
+	SpectraExchangeDepositHookTransactor // Write-only binding to the contract
+	SpectraExchangeDepositHookFilterer   // Log filterer for contract events
+}
+
+// SpectraExchangeDepositHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SpectraExchangeDepositHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpectraExchangeDepositHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SpectraExchangeDepositHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpectraExchangeDepositHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SpectraExchangeDepositHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpectraExchangeDepositHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SpectraExchangeDepositHookSession struct {
+	Contract     *SpectraExchangeDepositHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts               // Call options to use throughout this session
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// SpectraExchangeDepositHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SpectraExchangeDepositHookCallerSession struct {
+	Contract *SpectraExchangeDepositHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                     // Call options to use throughout this session
+}
+
+// SpectraExchangeDepositHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SpectraExchangeDepositHookTransactorSession struct {
+	Contract     *SpectraExchangeDepositHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                     // Transaction auth options to use throughout this session
+}
+
+// SpectraExchangeDepositHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SpectraExchangeDepositHookRaw struct {
+	Contract *SpectraExchangeDepositHook // Generic contract binding to access the raw methods on
+}
+
+// SpectraExchangeDepositHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SpectraExchangeDepositHookCallerRaw struct {
+	Contract *SpectraExchangeDepositHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SpectraExchangeDepositHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SpectraExchangeDepositHookTransactorRaw struct {
+	Contract *SpectraExchangeDepositHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSpectraExchangeDepositHook creates a new instance of SpectraExchangeDepositHook, bound to a specific deployed contract.
+func NewSpectraExchangeDepositHook(address common.Address, backend bind.ContractBackend) (*SpectraExchangeDepositHook, error) {
+	contract, err := bindSpectraExchangeDepositHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeDepositHook{SpectraExchangeDepositHookCaller: SpectraExchangeDepositHookCaller{contract: contract}, SpectraExchangeDepositHookTransactor: SpectraExchangeDepositHookTransactor{contract: contract}, SpectraExchangeDepositHookFilterer: SpectraExchangeDepositHookFilterer{contract: contract}}, nil
+}
+
+// NewSpectraExchangeDepositHookCaller creates a new read-only instance of SpectraExchangeDepositHook, bound to a specific deployed contract.
+func NewSpectraExchangeDepositHookCaller(address common.Address, caller bind.ContractCaller) (*SpectraExchangeDepositHookCaller, error) {
+	contract, err := bindSpectraExchangeDepositHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeDepositHookCaller{contract: contract}, nil
+}
+
+// NewSpectraExchangeDepositHookTransactor creates a new write-only instance of SpectraExchangeDepositHook, bound to a specific deployed contract.
+func NewSpectraExchangeDepositHookTransactor(address common.Address, transactor bind.ContractTransactor) (*SpectraExchangeDepositHookTransactor, error) {
+	contract, err := bindSpectraExchangeDepositHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeDepositHookTransactor{contract: contract}, nil
+}
+
+// NewSpectraExchangeDepositHookFilterer creates a new log filterer instance of SpectraExchangeDepositHook, bound to a specific deployed contract.
+func NewSpectraExchangeDepositHookFilterer(address common.Address, filterer bind.ContractFilterer) (*SpectraExchangeDepositHookFilterer, error) {
+	contract, err := bindSpectraExchangeDepositHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeDepositHookFilterer{contract: contract}, nil
+}
+
+// bindSpectraExchangeDepositHook binds a generic wrapper to an already deployed contract.
+func bindSpectraExchangeDepositHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SpectraExchangeDepositHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SpectraExchangeDepositHook.Contract.SpectraExchangeDepositHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.SpectraExchangeDepositHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.SpectraExchangeDepositHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SpectraExchangeDepositHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) Asset() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.Asset(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) Asset() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.Asset(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SpectraExchangeDepositHook.Contract.Build(&_SpectraExchangeDepositHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SpectraExchangeDepositHook.Contract.Build(&_SpectraExchangeDepositHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SpectraExchangeDepositHook.Contract.DecodeUsePrevHookAmount(&_SpectraExchangeDepositHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SpectraExchangeDepositHook.Contract.DecodeUsePrevHookAmount(&_SpectraExchangeDepositHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) ExecutionNonce() (*big.Int, error) {
+	return _SpectraExchangeDepositHook.Contract.ExecutionNonce(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _SpectraExchangeDepositHook.Contract.ExecutionNonce(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SpectraExchangeDepositHook.Contract.GetOutAmount(&_SpectraExchangeDepositHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SpectraExchangeDepositHook.Contract.GetOutAmount(&_SpectraExchangeDepositHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) HookType() (uint8, error) {
+	return _SpectraExchangeDepositHook.Contract.HookType(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) HookType() (uint8, error) {
+	return _SpectraExchangeDepositHook.Contract.HookType(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) Inspect(data []byte) ([]byte, error) {
+	return _SpectraExchangeDepositHook.Contract.Inspect(&_SpectraExchangeDepositHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _SpectraExchangeDepositHook.Contract.Inspect(&_SpectraExchangeDepositHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) LastCaller() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.LastCaller(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) LastCaller() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.LastCaller(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) PostExecuteMutex() (bool, error) {
+	return _SpectraExchangeDepositHook.Contract.PostExecuteMutex(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _SpectraExchangeDepositHook.Contract.PostExecuteMutex(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) PreExecuteMutex() (bool, error) {
+	return _SpectraExchangeDepositHook.Contract.PreExecuteMutex(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _SpectraExchangeDepositHook.Contract.PreExecuteMutex(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Router is a free data retrieval call binding the contract method 0xf887ea40.
+//
+// Solidity: function router() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) Router(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "router")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Router is a free data retrieval call binding the contract method 0xf887ea40.
+//
+// Solidity: function router() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) Router() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.Router(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Router is a free data retrieval call binding the contract method 0xf887ea40.
+//
+// Solidity: function router() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) Router() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.Router(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) SpToken() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.SpToken(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) SpToken() (common.Address, error) {
+	return _SpectraExchangeDepositHook.Contract.SpToken(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) SubType() ([32]byte, error) {
+	return _SpectraExchangeDepositHook.Contract.SubType(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) SubType() ([32]byte, error) {
+	return _SpectraExchangeDepositHook.Contract.SubType(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) Subtype() ([32]byte, error) {
+	return _SpectraExchangeDepositHook.Contract.Subtype(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) Subtype() ([32]byte, error) {
+	return _SpectraExchangeDepositHook.Contract.Subtype(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraExchangeDepositHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) UsedShares() (*big.Int, error) {
+	return _SpectraExchangeDepositHook.Contract.UsedShares(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookCallerSession) UsedShares() (*big.Int, error) {
+	return _SpectraExchangeDepositHook.Contract.UsedShares(&_SpectraExchangeDepositHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.PostExecute(&_SpectraExchangeDepositHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.PostExecute(&_SpectraExchangeDepositHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.PreExecute(&_SpectraExchangeDepositHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.PreExecute(&_SpectraExchangeDepositHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.ResetExecutionState(&_SpectraExchangeDepositHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.ResetExecutionState(&_SpectraExchangeDepositHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.SetExecutionContext(&_SpectraExchangeDepositHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.SetExecutionContext(&_SpectraExchangeDepositHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.SetOutAmount(&_SpectraExchangeDepositHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SpectraExchangeDepositHook *SpectraExchangeDepositHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeDepositHook.Contract.SetOutAmount(&_SpectraExchangeDepositHook.TransactOpts, _outAmount, caller)
+}
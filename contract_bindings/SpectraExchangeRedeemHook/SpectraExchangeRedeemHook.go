@@ -0,0 +1,873 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package SpectraExchangeRedeemHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// SpectraExchangeRedeemHookMetaData contains all meta data concerning the SpectraExchangeRedeemHook contract.
+var SpectraExchangeRedeemHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"router_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"REDEEM_IBT_FOR_ASSET\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"REDEEM_PT_FOR_ASSET\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes1\",\"internalType\":\"bytes1\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"ROUTER\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SELECTOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ASSET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_COMMAND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_MIN_ASSETS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_RECIPIENT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b506040516119eb3803806119eb83398101604081905261002e916100af565b6040805180820190915260048152631415165560e21b6020909101525f805460ff191690557fb86a7e98c9c656516a060ac5bc14d0453b037386adfb5f9f2ae12ee7a543e1b46080526001600160a01b03811661009e57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a0516118e061010b5f395f81816102250152610b8e01525f818161027b015261032501526118e05ff3fe608060405234801561000f575f5ffd5b5060043610610148575f3560e01c806360b5be29116100bf578063cf0a618111610079578063cf0a618114610320578063cf48b54214610347578063d06a1e891461036b578063e2302d141461037e578063e445e7dd14610389578063e7745517146103a2575f5ffd5b806360b5be291461029f578063685a943c146102c157806381cbe691146102c957806384542a24146102dc5780638c4313c1146102ee5780638e1487761461030e575f5ffd5b80632113522a116101105780632113522a146101e35780632ae2fe3d1461020d57806332fe7b261461022057806338d52e0f146102475780633b5896bc146102595780635492e67714610279575f5ffd5b806301bd43ef1461014c578063053c238d1461016857806305b4fe91146101a857806314cb37cf146101bd5780631f264619146101d0575b5f5ffd5b61015560035c81565b6040519081526020015b60405180910390f35b61018f7f24856bc3cddb0b75c4dd6e2501472e6df224cd4fbd15d402737681bfb656feaf81565b6040516001600160e01b0319909116815260200161015f565b6101bb6101b63660046114f9565b6103b5565b005b6101bb6101cb366004611556565b61042f565b6101bb6101de366004611576565b610450565b6101f56001600160a01b0360045c1681565b6040516001600160a01b03909116815260200161015f565b6101bb61021b3660046114f9565b6104a9565b6101f57f000000000000000000000000000000000000000000000000000000000000000081565b6101f56001600160a01b0360025c1681565b61026c6102673660046114f9565b61051c565b60405161015f91906115ce565b7f0000000000000000000000000000000000000000000000000000000000000000610155565b6102b160ff600160a81b60025c041681565b604051901515815260200161015f565b6101555f5c81565b6101556102d7366004611556565b610735565b6102b160ff600160a01b60025c041681565b6103016102fc36600461165b565b61074d565b60405161015f919061169a565b6101f56001600160a01b0360015c1681565b6101557f000000000000000000000000000000000000000000000000000000000000000081565b610352600760f81b81565b6040516001600160f81b0319909116815260200161015f565b6101bb610379366004611556565b6107bf565b610352600160fb1b81565b5f546103959060ff1681565b60405161015f91906116ac565b6102b16103b03660046116e6565b610811565b336001600160a01b038416146103de5760405163e15e56c960e01b815260040160405180910390fd5b5f6103e88461081d565b90506103f381610830565b156104115760405163945b63f560e01b815260040160405180910390fd5b61041c81600161083d565b61042885858585610853565b5050505050565b61043881610880565b50336004805c6001600160a01b0319168217905d5050565b5f61045a8261081d565b9050610465816108b2565b80610474575061047481610830565b156104925760405163441e4c7360e11b815260040160405180910390fd5b5f61049e8260016108bb565b905083815d50505050565b336001600160a01b038416146104d25760405163e15e56c960e01b815260040160405180910390fd5b5f6104dc8461081d565b90506104e7816108b2565b1561050557604051630bbb04d960e11b815260040160405180910390fd5b610510816001610910565b6104288585858561091c565b60605f61052b8686868661092a565b90508051600261053b91906117ad565b67ffffffffffffffff811115610553576105536116d2565b60405190808252806020026020018201604052801561059f57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816105715790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105e894939291906117c0565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061062a5761062a611808565b60209081029190910101525f5b815181101561068b5781818151811061065257610652611808565b60200260200101518382600161066891906117ad565b8151811061067857610678611808565b6020908102919091010152600101610637565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106d294939291906117c0565b604051602081830303815290604052915060e01b6020820180516001600160e01b0383818316178352505050508152508260018451610711919061181c565b8151811061072157610721611808565b602002602001018190525050949350505050565b5f6107476107428361081d565b610bec565b92915050565b60605f61075a8484610bf9565b90508060200151815f015182604001516040516020016107a793929190606093841b6bffffffffffffffffffffffff19908116825292841b83166014820152921b166028820152603c0190565b60405160208183030381529060405291505092915050565b5f6107c98261081d565b90506107d4816108b2565b15806107e657506107e481610830565b155b1561080457604051634bd439b560e11b815260040160405180910390fd5b61080d81610e82565b5050565b5f61074782609c610e99565b5f5f61082883610ec5565b5c9392505050565b5f5f6108288360036108bb565b5f6108498360036108bb565b905081815d505050565b61087a61085f84610735565b61086a848487610f31565b610874919061181c565b8461102e565b50505050565b5f6003805c90826108908361182f565b9190505d505f61089f83610ec5565b905060035c80825d505060035c92915050565b5f5f6108288360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6108498360026108bb565b61087a610874838386610f31565b60605f6109378484610bf9565b60408101519091506001600160a01b03166109655760405163290802d360e11b815260040160405180910390fd5b60c08101516001600160f81b031916600760f81b14801590610999575060c08101516001600160f81b031916600160fb1b14155b156109b757604051637ee9fb1960e01b815260040160405180910390fd5b8060a0015115610a2f576040516381cbe69160e01b81526001600160a01b0386811660048301528716906381cbe69190602401602060405180830381865afa158015610a05573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a299190611847565b60808201525b80608001515f03610a53576040516305112ab160e41b815260040160405180910390fd5b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610a685790505060c08201519092506060906001600160f81b03191660f960f81b01610afe5760208201516001600160a01b0316610ae0576040516333bb44cb60e21b815260040160405180910390fd5b610af7826020015183608001518460400151611046565b9050610b81565b60c08201516001600160f81b031916601f60fb1b01610b815781516001600160a01b0316610b3f5760405163569d85b160e11b815260040160405180910390fd5b81606001515f03610b6357604051636981bc8f60e01b815260040160405180910390fd5b610b7e825f0151836080015184604001518560600151611186565b90505b60405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f815260200182815250835f81518110610bd757610bd7611808565b60200260200101819052505050949350505050565b5f5f6108288360016108bb565b6040805160e0810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c08101919091525f610c7384848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250602092506112ce915050565b90505f610cb785858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250603492506112ce915050565b90505f610cfb86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250604892506112ce915050565b90505f610d3f87878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250605c9250611337915050565b90505f610d8388888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250607c9250611337915050565b90505f610dc789898080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609c9250610e99915050565b90505f610e0e8a8a8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609d9250600191506113949050565b90505f815f81518110610e2357610e23611808565b602091018101516040805160e0810182526001600160a01b039a8b1681529a8a16928b019290925296909716968801969096525060608601929092526080850152151560a08401526001600160f81b03191660c0830152509392505050565b610e8c815f610910565b610e96815f61083d565b50565b5f828281518110610eac57610eac611808565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610f1492919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f5f610f7485858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250602092506112ce915050565b90505f610fb886868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250604892506112ce915050565b6040516370a0823160e01b81526001600160a01b038083166004830152919250908316906370a0823190602401602060405180830381865afa158015611000573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906110249190611847565b9695505050505050565b5f6110388261081d565b90505f61049e8260016108bb565b6040805160018082528183019092526060915f9190602082018180368337019050509050600760f81b815f8151811061108157611081611808565b60200101906001600160f81b03191690815f1a9053506040805160018082528183019092525f91816020015b60608152602001906001900390816110ad57905050604080516001600160a01b03808a1660208301529181018890529086166060820152909150608001604051602081830303815290604052815f8151811061110b5761110b611808565b60200260200101819052507f24856bc3cddb0b75c4dd6e2501472e6df224cd4fbd15d402737681bfb656feaf828260405160240161114a92919061185e565b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b0319909316929092179091529695505050505050565b6040805160018082528183019092526060915f9190602082018180368337019050509050600860f81b815f815181106111c1576111c1611808565b60200101906001600160f81b03191690815f1a9053506040805160018082528183019092525f91816020015b60608152602001906001900390816111ed57905050604080516001600160a01b03808b16602083015291810189905290871660608201526080810186905290915060a001604051602081830303815290604052815f8151811061125257611252611808565b60200260200101819052507f24856bc3cddb0b75c4dd6e2501472e6df224cd4fbd15d402737681bfb656feaf828260405160240161129192919061185e565b60408051601f198184030181529190526020810180516001600160e01b03166001600160e01b031990931692909217909152979650505050505050565b5f6112da8260146117ad565b835110156113275760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b500160200151600160601b900490565b5f6113438260206117ad565b8351101561138b5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b604482015260640161131e565b50016020015190565b60608182601f0110156113da5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b604482015260640161131e565b6113e482846117ad565b845110156114285760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b604482015260640161131e565b6060821580156114465760405191505f825260208201604052611490565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101561147f578051835260209283019201611467565b5050858452601f01601f1916604052505b50949350505050565b80356001600160a01b03811681146114af575f5ffd5b919050565b5f5f83601f8401126114c4575f5ffd5b50813567ffffffffffffffff8111156114db575f5ffd5b6020830191508360208285010111156114f2575f5ffd5b9250929050565b5f5f5f5f6060858703121561150c575f5ffd5b61151585611499565b935061152360208601611499565b9250604085013567ffffffffffffffff81111561153e575f5ffd5b61154a878288016114b4565b95989497509550505050565b5f60208284031215611566575f5ffd5b61156f82611499565b9392505050565b5f5f60408385031215611587575f5ffd5b8235915061159760208401611499565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561164f57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611639908701826115a0565b95505060209384019391909101906001016115f4565b50929695505050505050565b5f5f6020838503121561166c575f5ffd5b823567ffffffffffffffff811115611682575f5ffd5b61168e858286016114b4565b90969095509350505050565b602081525f61156f60208301846115a0565b60208101600383106116cc57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f602082840312156116f6575f5ffd5b813567ffffffffffffffff81111561170c575f5ffd5b8201601f8101841361171c575f5ffd5b803567ffffffffffffffff811115611736576117366116d2565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611765576117656116d2565b60405281815282820160200186101561177c575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561074757610747611799565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561074757610747611799565b5f6001820161184057611840611799565b5060010190565b5f60208284031215611857575f5ffd5b5051919050565b604081525f61187060408301856115a0565b828103602084015280845180835260208301915060208160051b840101602087015f5b838110156118c557601f198684030185526118af8383516115a0565b6020958601959093509190910190600101611893565b50909897505050505050505056fea164736f6c634300081e000a",
+}
+
+// SpectraExchangeRedeemHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use SpectraExchangeRedeemHookMetaData.ABI instead.
+var SpectraExchangeRedeemHookABI = SpectraExchangeRedeemHookMetaData.ABI
+
+// SpectraExchangeRedeemHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SpectraExchangeRedeemHookMetaData.Bin instead.
+var SpectraExchangeRedeemHookBin = SpectraExchangeRedeemHookMetaData.Bin
+
+// DeploySpectraExchangeRedeemHook deploys a new Ethereum contract, binding an instance of SpectraExchangeRedeemHook to it.
+func DeploySpectraExchangeRedeemHook(auth *bind.TransactOpts, backend bind.ContractBackend, router_ common.Address) (common.Address, *types.Transaction, *SpectraExchangeRedeemHook, error) {
+	parsed, err := SpectraExchangeRedeemHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SpectraExchangeRedeemHookBin), backend, router_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SpectraExchangeRedeemHook{SpectraExchangeRedeemHookCaller: SpectraExchangeRedeemHookCaller{contract: contract}, SpectraExchangeRedeemHookTransactor: SpectraExchangeRedeemHookTransactor{contract: contract}, SpectraExchangeRedeemHookFilterer: SpectraExchangeRedeemHookFilterer{contract: contract}}, nil
+}
+
+// SpectraExchangeRedeemHook is an auto generated Go binding around an Ethereum contract.
+type SpectraExchangeRedeemHook struct {
+	SpectraExchangeRedeemHookCaller     // Read-only binding to the contract
+	SpectraExchangeRedeemHookTransactor // Write-only binding to the contract
+	SpectraExchangeRedeemHookFilterer   // Log filterer for contract events
+}
+
+// SpectraExchangeRedeemHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SpectraExchangeRedeemHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpectraExchangeRedeemHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SpectraExchangeRedeemHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpectraExchangeRedeemHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SpectraExchangeRedeemHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SpectraExchangeRedeemHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SpectraExchangeRedeemHookSession struct {
+	Contract     *SpectraExchangeRedeemHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts              // Call options to use throughout this session
+	TransactOpts bind.TransactOpts          // Transaction auth options to use throughout this session
+}
+
+// SpectraExchangeRedeemHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SpectraExchangeRedeemHookCallerSession struct {
+	Contract *SpectraExchangeRedeemHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                    // Call options to use throughout this session
+}
+
+// SpectraExchangeRedeemHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SpectraExchangeRedeemHookTransactorSession struct {
+	Contract     *SpectraExchangeRedeemHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                    // Transaction auth options to use throughout this session
+}
+
+// SpectraExchangeRedeemHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SpectraExchangeRedeemHookRaw struct {
+	Contract *SpectraExchangeRedeemHook // Generic contract binding to access the raw methods on
+}
+
+// SpectraExchangeRedeemHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SpectraExchangeRedeemHookCallerRaw struct {
+	Contract *SpectraExchangeRedeemHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SpectraExchangeRedeemHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SpectraExchangeRedeemHookTransactorRaw struct {
+	Contract *SpectraExchangeRedeemHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSpectraExchangeRedeemHook creates a new instance of SpectraExchangeRedeemHook, bound to a specific deployed contract.
+func NewSpectraExchangeRedeemHook(address common.Address, backend bind.ContractBackend) (*SpectraExchangeRedeemHook, error) {
+	contract, err := bindSpectraExchangeRedeemHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeRedeemHook{SpectraExchangeRedeemHookCaller: SpectraExchangeRedeemHookCaller{contract: contract}, SpectraExchangeRedeemHookTransactor: SpectraExchangeRedeemHookTransactor{contract: contract}, SpectraExchangeRedeemHookFilterer: SpectraExchangeRedeemHookFilterer{contract: contract}}, nil
+}
+
+// NewSpectraExchangeRedeemHookCaller creates a new read-only instance of SpectraExchangeRedeemHook, bound to a specific deployed contract.
+func NewSpectraExchangeRedeemHookCaller(address common.Address, caller bind.ContractCaller) (*SpectraExchangeRedeemHookCaller, error) {
+	contract, err := bindSpectraExchangeRedeemHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeRedeemHookCaller{contract: contract}, nil
+}
+
+// NewSpectraExchangeRedeemHookTransactor creates a new write-only instance of SpectraExchangeRedeemHook, bound to a specific deployed contract.
+func NewSpectraExchangeRedeemHookTransactor(address common.Address, transactor bind.ContractTransactor) (*SpectraExchangeRedeemHookTransactor, error) {
+	contract, err := bindSpectraExchangeRedeemHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeRedeemHookTransactor{contract: contract}, nil
+}
+
+// NewSpectraExchangeRedeemHookFilterer creates a new log filterer instance of SpectraExchangeRedeemHook, bound to a specific deployed contract.
+func NewSpectraExchangeRedeemHookFilterer(address common.Address, filterer bind.ContractFilterer) (*SpectraExchangeRedeemHookFilterer, error) {
+	contract, err := bindSpectraExchangeRedeemHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SpectraExchangeRedeemHookFilterer{contract: contract}, nil
+}
+
+// bindSpectraExchangeRedeemHook binds a generic wrapper to an already deployed contract.
+func bindSpectraExchangeRedeemHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SpectraExchangeRedeemHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SpectraExchangeRedeemHook.Contract.SpectraExchangeRedeemHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.SpectraExchangeRedeemHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.SpectraExchangeRedeemHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SpectraExchangeRedeemHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// REDEEMIBTFORASSET is a free data retrieval call binding the contract method 0xcf48b542.
+//
+// Solidity: function REDEEM_IBT_FOR_ASSET() view returns(bytes1)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) REDEEMIBTFORASSET(opts *bind.CallOpts) ([1]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "REDEEM_IBT_FOR_ASSET")
+
+	if err != nil {
+		return *new([1]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+
+	return out0, err
+
+}
+
+// REDEEMIBTFORASSET is a free data retrieval call binding the contract method 0xcf48b542.
+//
+// Solidity: function REDEEM_IBT_FOR_ASSET() view returns(bytes1)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) REDEEMIBTFORASSET() ([1]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.REDEEMIBTFORASSET(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// REDEEMIBTFORASSET is a free data retrieval call binding the contract method 0xcf48b542.
+//
+// Solidity: function REDEEM_IBT_FOR_ASSET() view returns(bytes1)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) REDEEMIBTFORASSET() ([1]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.REDEEMIBTFORASSET(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// REDEEMPTFORASSET is a free data retrieval call binding the contract method 0xe2302d14.
+//
+// Solidity: function REDEEM_PT_FOR_ASSET() view returns(bytes1)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) REDEEMPTFORASSET(opts *bind.CallOpts) ([1]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "REDEEM_PT_FOR_ASSET")
+
+	if err != nil {
+		return *new([1]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([1]byte)).(*[1]byte)
+
+	return out0, err
+
+}
+
+// REDEEMPTFORASSET is a free data retrieval call binding the contract method 0xe2302d14.
+//
+// Solidity: function REDEEM_PT_FOR_ASSET() view returns(bytes1)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) REDEEMPTFORASSET() ([1]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.REDEEMPTFORASSET(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// REDEEMPTFORASSET is a free data retrieval call binding the contract method 0xe2302d14.
+//
+// Solidity: function REDEEM_PT_FOR_ASSET() view returns(bytes1)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) REDEEMPTFORASSET() ([1]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.REDEEMPTFORASSET(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// ROUTER is a free data retrieval call binding the contract method 0x32fe7b26.
+//
+// Solidity: function ROUTER() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) ROUTER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "ROUTER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ROUTER is a free data retrieval call binding the contract method 0x32fe7b26.
+//
+// Solidity: function ROUTER() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) ROUTER() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.ROUTER(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// ROUTER is a free data retrieval call binding the contract method 0x32fe7b26.
+//
+// Solidity: function ROUTER() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) ROUTER() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.ROUTER(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// SELECTOR is a free data retrieval call binding the contract method 0x053c238d.
+//
+// Solidity: function SELECTOR() view returns(bytes4)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) SELECTOR(opts *bind.CallOpts) ([4]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "SELECTOR")
+
+	if err != nil {
+		return *new([4]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([4]byte)).(*[4]byte)
+
+	return out0, err
+
+}
+
+// SELECTOR is a free data retrieval call binding the contract method 0x053c238d.
+//
+// Solidity: function SELECTOR() view returns(bytes4)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) SELECTOR() ([4]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.SELECTOR(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// SELECTOR is a free data retrieval call binding the contract method 0x053c238d.
+//
+// Solidity: function SELECTOR() view returns(bytes4)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) SELECTOR() ([4]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.SELECTOR(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) Asset() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.Asset(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) Asset() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.Asset(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SpectraExchangeRedeemHook.Contract.Build(&_SpectraExchangeRedeemHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SpectraExchangeRedeemHook.Contract.Build(&_SpectraExchangeRedeemHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SpectraExchangeRedeemHook.Contract.DecodeUsePrevHookAmount(&_SpectraExchangeRedeemHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SpectraExchangeRedeemHook.Contract.DecodeUsePrevHookAmount(&_SpectraExchangeRedeemHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) ExecutionNonce() (*big.Int, error) {
+	return _SpectraExchangeRedeemHook.Contract.ExecutionNonce(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _SpectraExchangeRedeemHook.Contract.ExecutionNonce(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SpectraExchangeRedeemHook.Contract.GetOutAmount(&_SpectraExchangeRedeemHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SpectraExchangeRedeemHook.Contract.GetOutAmount(&_SpectraExchangeRedeemHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) HookType() (uint8, error) {
+	return _SpectraExchangeRedeemHook.Contract.HookType(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) HookType() (uint8, error) {
+	return _SpectraExchangeRedeemHook.Contract.HookType(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) Inspect(data []byte) ([]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.Inspect(&_SpectraExchangeRedeemHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.Inspect(&_SpectraExchangeRedeemHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) LastCaller() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.LastCaller(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) LastCaller() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.LastCaller(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) PostExecuteMutex() (bool, error) {
+	return _SpectraExchangeRedeemHook.Contract.PostExecuteMutex(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _SpectraExchangeRedeemHook.Contract.PostExecuteMutex(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) PreExecuteMutex() (bool, error) {
+	return _SpectraExchangeRedeemHook.Contract.PreExecuteMutex(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _SpectraExchangeRedeemHook.Contract.PreExecuteMutex(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) SpToken() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.SpToken(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) SpToken() (common.Address, error) {
+	return _SpectraExchangeRedeemHook.Contract.SpToken(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) SubType() ([32]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.SubType(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) SubType() ([32]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.SubType(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) Subtype() ([32]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.Subtype(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) Subtype() ([32]byte, error) {
+	return _SpectraExchangeRedeemHook.Contract.Subtype(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraExchangeRedeemHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) UsedShares() (*big.Int, error) {
+	return _SpectraExchangeRedeemHook.Contract.UsedShares(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookCallerSession) UsedShares() (*big.Int, error) {
+	return _SpectraExchangeRedeemHook.Contract.UsedShares(&_SpectraExchangeRedeemHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.PostExecute(&_SpectraExchangeRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.PostExecute(&_SpectraExchangeRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.PreExecute(&_SpectraExchangeRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.PreExecute(&_SpectraExchangeRedeemHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.ResetExecutionState(&_SpectraExchangeRedeemHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.ResetExecutionState(&_SpectraExchangeRedeemHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.SetExecutionContext(&_SpectraExchangeRedeemHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.SetExecutionContext(&_SpectraExchangeRedeemHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.SetOutAmount(&_SpectraExchangeRedeemHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SpectraExchangeRedeemHook *SpectraExchangeRedeemHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SpectraExchangeRedeemHook.Contract.SetOutAmount(&_SpectraExchangeRedeemHook.TransactOpts, _outAmount, caller)
+}
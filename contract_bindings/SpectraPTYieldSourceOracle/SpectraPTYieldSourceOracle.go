@@ -31,13 +31,35 @@ var (
 
 // SpectraPTYieldSourceOracleMetaData contains all meta data concerning the SpectraPTYieldSourceOracle contract.
 var SpectraPTYieldSourceOracleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"ptAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b506040516112e13803806112e1833981016040819052602b91603b565b6001600160a01b03166080526066565b5f60208284031215604a575f5ffd5b81516001600160a01b0381168114605f575f5ffd5b9392505050565b60805161125c6100855f395f81816101660152610332015261125c5ff3fe608060405234801561000f575f5ffd5b50600436106100cb575f3560e01c80638717164a11610088578063cacc7b0e11610063578063cacc7b0e146101d3578063d449a832146101e6578063ec422afd1461020b578063fea8af5f1461021e575f5ffd5b80638717164a14610161578063a7a128b4146101a0578063aa5815fd146101c0575f5ffd5b8063056f143c146100cf5780630f40517a146100f55780632f112c461461010857806334f99b481461011b5780634fecb2661461013b5780637eeb81071461014e575b5f5ffd5b6100e26100dd366004610c60565b610231565b6040519081526020015b60405180910390f35b6100e2610103366004610c9e565b6102a5565b6100e2610116366004610cb9565b61030c565b61012e610129366004610deb565b610571565b6040516100ec9190610ec9565b6100e2610149366004610f55565b610717565b6100e261015c366004610c60565b61079e565b6101887f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100ec565b6101b36101ae366004610f8c565b610857565b6040516100ec9190610fc6565b6100e26101ce366004610c60565b6108f9565b6101b36101e1366004610f8c565b610928565b6101f96101f4366004610c9e565b6109c3565b60405160ff90911681526020016100ec565b6100e2610219366004610c9e565b6109cd565b6100e261022c366004610f55565b610a4f565b6040516325a8d87d60e01b8152600481018290525f906001600160a01b038516906325a8d87d906024015b602060405180830381865afa158015610277573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029b9190611008565b90505b9392505050565b5f816001600160a01b03166301e1d1146040518163ffffffff1660e01b8152600401602060405180830381865afa1580156102e2573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103069190611008565b92915050565b5f5f6103198686856108f9565b604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa92505050801561039d575060408051601f3d908101601f1916820190925261039a9181019061101f565b60015b6103a8579050610568565b5f81602001511180156103c7575060808101516001600160a01b031615155b1561056457805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa158015610413573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104379190611008565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa158015610483573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104a791906110ae565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa158015610528573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061054c9190611008565b905061055881866110e2565b95505050505050610568565b5090505b95945050505050565b8151815160609190811461059857604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff8111156105b1576105b1610d10565b6040519080825280602002602001820160405280156105e457816020015b60608152602001906001900390816105cf5790505b5091505f5b8181101561070f575f858281518110610604576106046110f5565b602002602001015190505f858381518110610621576106216110f5565b602002602001015190505f815190508067ffffffffffffffff81111561064957610649610d10565b604051908082528060200260200182016040528015610672578160200160208202803683370190505b50868581518110610685576106856110f5565b60200260200101819052505f5b81811015610700575f6106be858584815181106106b1576106b16110f5565b6020026020010151610717565b9050808887815181106106d3576106d36110f5565b602002602001015183815181106106ec576106ec6110f5565b602090810291909101015250600101610692565b505050508060010190506105e9565b505092915050565b5f82816107248285610a56565b9050805f03610737575f92505050610306565b604051631dc7f52160e01b8152600481018290526001600160a01b03831690631dc7f52190602401602060405180830381865afa15801561077a573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105689190611008565b5f5f6107a985610a9d565b90505f6001600160a01b038616631dc7f5216107c684600a6111ec565b6040518263ffffffff1660e01b81526004016107e491815260200190565b602060405180830381865afa1580156107ff573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108239190611008565b9050805f03610836575f9250505061029e565b61084d8461084584600a6111ec565b836001610afe565b9695505050505050565b80516060908067ffffffffffffffff81111561087557610875610d10565b60405190808252806020026020018201604052801561089e578160200160208202803683370190505b5091505f5b818110156108f2576108cd8482815181106108c0576108c06110f5565b60200260200101516109cd565b8382815181106108df576108df6110f5565b60209081029190910101526001016108a3565b5050919050565b604051631dc7f52160e01b8152600481018290525f906001600160a01b03851690631dc7f5219060240161025c565b80516060908067ffffffffffffffff81111561094657610946610d10565b60405190808252806020026020018201604052801561096f578160200160208202803683370190505b5091505f5b818110156108f25761099e848281518110610991576109916110f5565b60200260200101516102a5565b8382815181106109b0576109b06110f5565b6020908102919091010152600101610974565b5f61030682610a9d565b5f816001600160a01b038116631dc7f5216109e783610a9d565b6109f290600a6111ec565b6040518263ffffffff1660e01b8152600401610a1091815260200190565b602060405180830381865afa158015610a2b573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029e9190611008565b5f61029e83835b6040516370a0823160e01b81526001600160a01b0382811660048301525f91908416906370a0823190602401602060405180830381865afa158015610a2b573d5f5f3e3d5ffd5b5f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610ada573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061030691906110ae565b5f610b2b610b0b83610b40565b8015610b2657505f8480610b2157610b216111fa565b868809115b151590565b610b36868686610b6c565b61056891906110e2565b5f6002826003811115610b5557610b5561120e565b610b5f9190611222565b60ff166001149050919050565b5f5f5f610b798686610c1c565b91509150815f03610b9d57838181610b9357610b936111fa565b049250505061029e565b818411610bb457610bb46003851502601118610c38565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b0381168114610c5d575f5ffd5b50565b5f5f5f60608486031215610c72575f5ffd5b8335610c7d81610c49565b92506020840135610c8d81610c49565b929592945050506040919091013590565b5f60208284031215610cae575f5ffd5b813561029e81610c49565b5f5f5f5f5f60a08688031215610ccd575f5ffd5b853594506020860135610cdf81610c49565b93506040860135610cef81610c49565b92506060860135610cff81610c49565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610d4d57610d4d610d10565b604052919050565b5f67ffffffffffffffff821115610d6e57610d6e610d10565b5060051b60200190565b5f82601f830112610d87575f5ffd5b8135610d9a610d9582610d55565b610d24565b8082825260208201915060208360051b860101925085831115610dbb575f5ffd5b602085015b83811015610de1578035610dd381610c49565b835260209283019201610dc0565b5095945050505050565b5f5f60408385031215610dfc575f5ffd5b823567ffffffffffffffff811115610e12575f5ffd5b610e1e85828601610d78565b925050602083013567ffffffffffffffff811115610e3a575f5ffd5b8301601f81018513610e4a575f5ffd5b8035610e58610d9582610d55565b8082825260208201915060208360051b850101925087831115610e79575f5ffd5b602084015b83811015610eba57803567ffffffffffffffff811115610e9c575f5ffd5b610eab8a602083890101610d78565b84525060209283019201610e7e565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610f4957868503603f19018452815180518087526020918201918701905f5b81811015610f30578351835260209384019390920191600101610f12565b5090965050506020938401939190910190600101610eef565b50929695505050505050565b5f5f60408385031215610f66575f5ffd5b8235610f7181610c49565b91506020830135610f8181610c49565b809150509250929050565b5f60208284031215610f9c575f5ffd5b813567ffffffffffffffff811115610fb2575f5ffd5b610fbe84828501610d78565b949350505050565b602080825282518282018190525f918401906040840190835b81811015610ffd578351835260209384019390920191600101610fdf565b509095945050505050565b5f60208284031215611018575f5ffd5b5051919050565b5f60a0828403128015611030575f5ffd5b5060405160a0810167ffffffffffffffff8111828210171561105457611054610d10565b604052825161106281610c49565b815260208381015190820152604083015161107c81610c49565b6040820152606083015161108f81610c49565b606082015260808301516110a281610c49565b60808201529392505050565b5f602082840312156110be575f5ffd5b815160ff8116811461029e575f5ffd5b634e487b7160e01b5f52601160045260245ffd5b80820180821115610306576103066110ce565b634e487b7160e01b5f52603260045260245ffd5b6001815b600184111561114457808504811115611128576111286110ce565b600184161561113657908102905b60019390931c92800261110d565b935093915050565b5f8261115a57506001610306565b8161116657505f610306565b816001811461117c5760028114611186576111a2565b6001915050610306565b60ff841115611197576111976110ce565b50506001821b610306565b5060208310610133831016604e8410600b84101617156111c5575081810a610306565b6111d15f198484611109565b805f19048211156111e4576111e46110ce565b029392505050565b5f61029e60ff84168361114c565b634e487b7160e01b5f52601260045260245ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60ff83168061124057634e487b7160e01b5f52601260045260245ffd5b8060ff8416069150509291505056fea164736f6c634300081e000a",
 }
 
 // SpectraPTYieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use SpectraPTYieldSourceOracleMetaData.ABI instead.
 var SpectraPTYieldSourceOracleABI = SpectraPTYieldSourceOracleMetaData.ABI
 
+// SpectraPTYieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SpectraPTYieldSourceOracleMetaData.Bin instead.
+var SpectraPTYieldSourceOracleBin = SpectraPTYieldSourceOracleMetaData.Bin
+
+// DeploySpectraPTYieldSourceOracle deploys a new Ethereum contract, binding an instance of SpectraPTYieldSourceOracle to it.
+func DeploySpectraPTYieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *SpectraPTYieldSourceOracle, error) {
+	parsed, err := SpectraPTYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SpectraPTYieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SpectraPTYieldSourceOracle{SpectraPTYieldSourceOracleCaller: SpectraPTYieldSourceOracleCaller{contract: contract}, SpectraPTYieldSourceOracleTransactor: SpectraPTYieldSourceOracleTransactor{contract: contract}, SpectraPTYieldSourceOracleFilterer: SpectraPTYieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // SpectraPTYieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type SpectraPTYieldSourceOracle struct {
 	SpectraPTYieldSourceOracleCaller     // Read-only binding to the contract
@@ -551,3 +573,34 @@ func (_SpectraPTYieldSourceOracle *SpectraPTYieldSourceOracleSession) GetTVLMult
 func (_SpectraPTYieldSourceOracle *SpectraPTYieldSourceOracleCallerSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
 	return _SpectraPTYieldSourceOracle.Contract.GetTVLMultiple(&_SpectraPTYieldSourceOracle.CallOpts, yieldSourceAddresses)
 }
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address ptAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SpectraPTYieldSourceOracle *SpectraPTYieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, ptAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SpectraPTYieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", ptAddress, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address ptAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SpectraPTYieldSourceOracle *SpectraPTYieldSourceOracleSession) GetWithdrawalShareOutput(ptAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _SpectraPTYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_SpectraPTYieldSourceOracle.CallOpts, ptAddress, arg1, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address ptAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SpectraPTYieldSourceOracle *SpectraPTYieldSourceOracleCallerSession) GetWithdrawalShareOutput(ptAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _SpectraPTYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_SpectraPTYieldSourceOracle.CallOpts, ptAddress, arg1, assetsIn)
+}
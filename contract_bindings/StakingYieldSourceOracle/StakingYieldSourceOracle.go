@@ -31,13 +31,35 @@ var (
 
 // StakingYieldSourceOracleMetaData contains all meta data concerning the StakingYieldSourceOracle contract.
 var StakingYieldSourceOracleMetaData = &bind.MetaData{
-	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604051610d5f380380610d5f833981016040819052602b91603b565b6001600160a01b03166080526066565b5f60208284031215604a575f5ffd5b81516001600160a01b0381168114605f575f5ffd5b9392505050565b608051610cda6100855f395f8181610154015261027f0152610cda5ff3fe608060405234801561000f575f5ffd5b50600436106100cb575f3560e01c80638717164a11610088578063cacc7b0e11610063578063cacc7b0e146101ae578063d449a832146101c1578063ec422afd146101e7578063fea8af5f1461013c575f5ffd5b80638717164a1461014f578063a7a128b41461018e578063aa5815fd146100cf575f5ffd5b8063056f143c146100cf5780630f40517a146100f65780632f112c461461010957806334f99b481461011c5780634fecb2661461013c5780637eeb8107146100cf575b5f5ffd5b6100e36100dd36600461082c565b92915050565b6040519081526020015b60405180910390f35b6100e361010436600461086a565b610202565b6100e3610117366004610885565b610263565b61012f61012a3660046109b7565b6104be565b6040516100ed9190610a95565b6100e361014a366004610b21565b610664565b6101767f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100ed565b6101a161019c366004610b58565b6106d6565b6040516100ed9190610b92565b6101a16101bc366004610b58565b61077a565b6101d56101cf36600461086a565b50601290565b60405160ff90911681526020016100ed565b6100e36101f536600461086a565b50670de0b6b3a764000090565b5f816001600160a01b03166318160ddd6040518163ffffffff1660e01b8152600401602060405180830381865afa15801561023f573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906100dd9190610bd4565b5f8082604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa9250505080156102ea575060408051601f3d908101601f191682019092526102e791810190610beb565b60015b6102f55790506104b5565b5f8160200151118015610314575060808101516001600160a01b031615155b156104b157805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa158015610360573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103849190610bd4565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa1580156103d0573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103f49190610c7a565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa158015610475573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104999190610bd4565b90506104a58186610c9a565b955050505050506104b5565b5090505b95945050505050565b815181516060919081146104e557604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff8111156104fe576104fe6108dc565b60405190808252806020026020018201604052801561053157816020015b606081526020019060019003908161051c5790505b5091505f5b8181101561065c575f85828151811061055157610551610cb9565b602002602001015190505f85838151811061056e5761056e610cb9565b602002602001015190505f815190508067ffffffffffffffff811115610596576105966108dc565b6040519080825280602002602001820160405280156105bf578160200160208202803683370190505b508685815181106105d2576105d2610cb9565b60200260200101819052505f5b8181101561064d575f61060b858584815181106105fe576105fe610cb9565b6020026020010151610664565b90508088878151811061062057610620610cb9565b6020026020010151838151811061063957610639610cb9565b6020908102919091010152506001016105df565b50505050806001019050610536565b505092915050565b6040516370a0823160e01b81526001600160a01b0382811660048301525f91908416906370a0823190602401602060405180830381865afa1580156106ab573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906106cf9190610bd4565b9392505050565b80516060908067ffffffffffffffff8111156106f4576106f46108dc565b60405190808252806020026020018201604052801561071d578160200160208202803683370190505b5091505f5b818110156107735761074e84828151811061073f5761073f610cb9565b50670de0b6b3a7640000919050565b83828151811061076057610760610cb9565b6020908102919091010152600101610722565b5050919050565b80516060908067ffffffffffffffff811115610798576107986108dc565b6040519080825280602002602001820160405280156107c1578160200160208202803683370190505b5091505f5b81811015610773576107f08482815181106107e3576107e3610cb9565b6020026020010151610202565b83828151811061080257610802610cb9565b60209081029190910101526001016107c6565b6001600160a01b0381168114610829575f5ffd5b50565b5f5f5f6060848603121561083e575f5ffd5b833561084981610815565b9250602084013561085981610815565b929592945050506040919091013590565b5f6020828403121561087a575f5ffd5b81356106cf81610815565b5f5f5f5f5f60a08688031215610899575f5ffd5b8535945060208601356108ab81610815565b935060408601356108bb81610815565b925060608601356108cb81610815565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610919576109196108dc565b604052919050565b5f67ffffffffffffffff82111561093a5761093a6108dc565b5060051b60200190565b5f82601f830112610953575f5ffd5b813561096661096182610921565b6108f0565b8082825260208201915060208360051b860101925085831115610987575f5ffd5b602085015b838110156109ad57803561099f81610815565b83526020928301920161098c565b5095945050505050565b5f5f604083850312156109c8575f5ffd5b823567ffffffffffffffff8111156109de575f5ffd5b6109ea85828601610944565b925050602083013567ffffffffffffffff811115610a06575f5ffd5b8301601f81018513610a16575f5ffd5b8035610a2461096182610921565b8082825260208201915060208360051b850101925087831115610a45575f5ffd5b602084015b83811015610a8657803567ffffffffffffffff811115610a68575f5ffd5b610a778a602083890101610944565b84525060209283019201610a4a565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610b1557868503603f19018452815180518087526020918201918701905f5b81811015610afc578351835260209384019390920191600101610ade565b5090965050506020938401939190910190600101610abb565b50929695505050505050565b5f5f60408385031215610b32575f5ffd5b8235610b3d81610815565b91506020830135610b4d81610815565b809150509250929050565b5f60208284031215610b68575f5ffd5b813567ffffffffffffffff811115610b7e575f5ffd5b610b8a84828501610944565b949350505050565b602080825282518282018190525f918401906040840190835b81811015610bc9578351835260209384019390920191600101610bab565b509095945050505050565b5f60208284031215610be4575f5ffd5b5051919050565b5f60a0828403128015610bfc575f5ffd5b5060405160a0810167ffffffffffffffff81118282101715610c2057610c206108dc565b6040528251610c2e81610815565b8152602083810151908201526040830151610c4881610815565b60408201526060830151610c5b81610815565b60608201526080830151610c6e81610815565b60808201529392505050565b5f60208284031215610c8a575f5ffd5b815160ff811681146106cf575f5ffd5b808201808211156100dd57634e487b7160e01b5f52601160045260245ffd5b634e487b7160e01b5f52603260045260245ffdfea164736f6c634300081e000a",
 }
 
 // StakingYieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use StakingYieldSourceOracleMetaData.ABI instead.
 var StakingYieldSourceOracleABI = StakingYieldSourceOracleMetaData.ABI
 
+// StakingYieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use StakingYieldSourceOracleMetaData.Bin instead.
+var StakingYieldSourceOracleBin = StakingYieldSourceOracleMetaData.Bin
+
+// DeployStakingYieldSourceOracle deploys a new Ethereum contract, binding an instance of StakingYieldSourceOracle to it.
+func DeployStakingYieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *StakingYieldSourceOracle, error) {
+	parsed, err := StakingYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(StakingYieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &StakingYieldSourceOracle{StakingYieldSourceOracleCaller: StakingYieldSourceOracleCaller{contract: contract}, StakingYieldSourceOracleTransactor: StakingYieldSourceOracleTransactor{contract: contract}, StakingYieldSourceOracleFilterer: StakingYieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // StakingYieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type StakingYieldSourceOracle struct {
 	StakingYieldSourceOracleCaller     // Read-only binding to the contract
@@ -551,3 +573,34 @@ func (_StakingYieldSourceOracle *StakingYieldSourceOracleSession) GetTVLMultiple
 func (_StakingYieldSourceOracle *StakingYieldSourceOracleCallerSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
 	return _StakingYieldSourceOracle.Contract.GetTVLMultiple(&_StakingYieldSourceOracle.CallOpts, yieldSourceAddresses)
 }
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address , address , uint256 assetsIn) pure returns(uint256)
+func (_StakingYieldSourceOracle *StakingYieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, arg0 common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _StakingYieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", arg0, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address , address , uint256 assetsIn) pure returns(uint256)
+func (_StakingYieldSourceOracle *StakingYieldSourceOracleSession) GetWithdrawalShareOutput(arg0 common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _StakingYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_StakingYieldSourceOracle.CallOpts, arg0, arg1, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address , address , uint256 assetsIn) pure returns(uint256)
+func (_StakingYieldSourceOracle *StakingYieldSourceOracleCallerSession) GetWithdrawalShareOutput(arg0 common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _StakingYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_StakingYieldSourceOracle.CallOpts, arg0, arg1, assetsIn)
+}
@@ -39,12 +39,34 @@ type Execution struct {
 // SuperDestinationExecutorMetaData contains all meta data concerning the SuperDestinationExecutor contract.
 var SuperDestinationExecutorMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"ledgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"superDestinationValidator_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISuperLedgerConfiguration\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUPER_DESTINATION_VALIDATOR\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"execute\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isInitialized\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isMerkleRootUsed\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"merkleRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isModuleType\",\"inputs\":[{\"name\":\"typeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"markRootsAsUsed\",\"inputs\":[{\"name\":\"roots\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"onInstall\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onUninstall\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"processBridgedExecution\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"dstTokens\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"intentAmounts\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"},{\"name\":\"initData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"executorCalldata\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"userSignatureData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"usedMerkleRoots\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"merkleRoot\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"used\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"validateHookCompliance\",\"inputs\":[{\"name\":\"hook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"version\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"event\",\"name\":\"AccountCreated\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"salt\",\"type\":\"bytes32\",\"indexed\":false,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperDestinationExecutorExecuted\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperDestinationExecutorInvalidIntentAmount\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"intentAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperDestinationExecutorMarkRootsAsUsed\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"roots\",\"type\":\"bytes32[]\",\"indexed\":false,\"internalType\":\"bytes32[]\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperDestinationExecutorReceivedButNoHooks\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperDestinationExecutorReceivedButNotEnoughBalance\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"token\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"intentAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"available\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperDestinationExecutorReceivedButRootUsedAlready\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"root\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperPositionMintRequested\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"spToken\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"dstChainId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ACCOUNT_NOT_CREATED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_ACCOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ALREADY_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"FEE_NOT_TRANSFERRED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INSUFFICIENT_BALANCE_FOR_FEE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ACCOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_CHAIN_ID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_FEE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SIGNATURE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_YIELD_SOURCE_ORACLE_ID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MALICIOUS_HOOK_DETECTED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MANAGER_NOT_SET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MERKLE_ROOT_ALREADY_USED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ModuleAlreadyInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NO_HOOKS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SENDER_CREATOR_NOT_VALID\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b50604051612da8380380612da883398101604081905261002e916100b4565b60015f55816001600160a01b03811661005a57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b03908116608052811661008757604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a052506100e5565b80516001600160a01b03811681146100af575f5ffd5b919050565b5f5f604083850312156100c5575f5ffd5b6100ce83610099565b91506100dc60208401610099565b90509250929050565b60805160a051612c946101145f395f81816101b2015261074c01525f8181610217015261139a0152612c945ff3fe608060405234801561000f575f5ffd5b50600436106100e5575f3560e01c80638a91b0e311610088578063a5c08d3d11610063578063a5c08d3d14610266578063d60b347f14610279578063ecd05961146102a4578063ed71d9d1146102b8575f5ffd5b80638a91b0e3146101ff578063a5310a6914610212578063a5b6f20814610239575f5ffd5b80634a03fda4116100c35780634a03fda41461016c57806354fd4d501461018c5780635a0ed186146101ad5780636d61fe70146101ec575f5ffd5b806306fdde03146100e957806309c5eabe14610134578063244cd76714610149575b5f5ffd5b60408051808201909152601881527f537570657244657374696e6174696f6e4578656375746f72000000000000000060208201525b60405161012b9190611c55565b60405180910390f35b610147610142366004611c67565b6102cb565b005b61015c610157366004611cfa565b610313565b604051901515815260200161012b565b61017f61017a366004611e4c565b610340565b60405161012b9190611ebc565b604080518082019091526005815264302e302e3160d81b602082015261011e565b6101d47f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b03909116815260200161012b565b6101476101fa366004611c67565b6105a2565b61014761020d366004611c67565b6105f2565b6101d47f000000000000000000000000000000000000000000000000000000000000000081565b61015c610247366004611cfa565b600260209081525f928352604080842090915290825290205460ff1681565b610147610274366004611f6b565b61063b565b61015c610287366004611ffb565b6001600160a01b03165f9081526001602052604090205460ff1690565b61015c6102b2366004612016565b60021490565b6101476102c63660046120f6565b6106e3565b335f9081526001602052604090205460ff166102fa57604051630f68fe6360e21b815260040160405180910390fd5b61030f3361030a838501856121f4565b6109f7565b5050565b6001600160a01b0382165f90815260026020908152604080832084845290915290205460ff165b92915050565b604080515f808252602082019092526060919081610387565b60408051606080820183525f8083526020830152918101919091528152602001906001900390816103595790505b5090505f866001600160a01b0316633b5896bc8787876040518463ffffffff1660e01b81526004016103bb93929190612302565b5f60405180830381865afa1580156103d5573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526103fc9190810190612385565b90506002815110156104105750905061059a565b866001600160a01b0316815f8151811061042c5761042c612489565b60200260200101515f01516001600160a01b03161461044d5750905061059a565b5f815f8151811061046057610460612489565b6020026020010151604001516104759061249d565b90506001600160e01b03198116632ae2fe3d60e01b1461049a5782935050505061059a565b5f600183516104a991906124ef565b9050886001600160a01b03168382815181106104c7576104c7612489565b60200260200101515f01516001600160a01b0316146104ec578394505050505061059a565b5f8382815181106104ff576104ff612489565b6020026020010151604001516105149061249d565b90506001600160e01b031981166305b4fe9160e01b1461053b57849550505050505061059a565b60015b82811015610591578a6001600160a01b031685828151811061056257610562612489565b60200260200101515f01516001600160a01b0316036105895785965050505050505061059a565b60010161053e565b50929450505050505b949350505050565b335f9081526001602052604090205460ff16156105d25760405163439a74c960e01b815260040160405180910390fd5b5050335f908152600160208190526040909120805460ff19169091179055565b335f9081526001602052604090205460ff1661062157604051630f68fe6360e21b815260040160405180910390fd5b5050335f908152600160205260409020805460ff19169055565b80515f5b8181101561069d57335f90815260026020526040812084516001929086908590811061066d5761066d612489565b60209081029190910181015182528101919091526040015f20805460ff191691151591909117905560010161063f565b50336001600160a01b03167f2a2e76694cfe1777579407d33b992a385876cdac566ec14689232edd2425d40e836040516106d79190612502565b60405180910390a25050565b84518451811461070657604051634456f5e960e11b815260040160405180910390fd5b6107108785610ada565b5f61071a83610b73565b90505f84468a308b8b6040516020016107389695949392919061257e565b60405160208183030381529060405290505f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316635c2ec0f38b878560405160200161078d92919061261a565b6040516020818303038152906040526040518363ffffffff1660e01b81526004016107b992919061263e565b602060405180830381865afa1580156107d4573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906107f89190612661565b90506001600160e01b03198116635c2ec0f360e01b1461082b57604051631468054760e31b815260040160405180910390fd5b6108368a8a8a610b97565b61084357505050506109ee565b6001600160a01b038a165f90815260026020908152604080832086845290915290205460ff16156108ac5760405183906001600160a01b038c16907fc85a4c9cf6ceb3da81d38c466e43c8b89a7f2857440772a73d2189d718b57841905f90a3505050506109ee565b6001600160a01b038a165f9081526002602090815260408083208684529091529020805460ff191660011790556108e286610db3565b15610923576040516001600160a01b038b16907fb159537e384a9a796d3957f8a925c701e1a32cb359781d4b26ac895b02125f78905f90a2505050506109ee565b6040805160018082528183019092525f91816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816109395790505090506040518060600160405280306001600160a01b031681526020015f815260200188815250815f8151811061099f5761099f612489565b60200260200101819052506109b48b82610df7565b506040516001600160a01b038c16907f98f6e69f6c380877e68c669d19d23a062e9e5a9c18103278c08537aae6fd825e905f90a250505050505b50505050505050565b8051515f819003610a1b57604051632c4df46b60e11b815260040160405180910390fd5b8160200151518114610a405760405163899ef10d60e01b815260040160405180910390fd5b5f5f5f5b83811015610ad2578451805182908110610a6057610a60612489565b602002602001015191505f6001600160a01b0316826001600160a01b031603610a9c57604051630f58648f60e01b815260040160405180910390fd5b610ac586838588602001518581518110610ab857610ab8612489565b6020026020010151610e84565b9091508190600101610a44565b505050505050565b5f8151118015610af257506001600160a01b0382163b155b15610b37575f610b018261101b565b9050806001600160a01b0316836001600160a01b031614610b355760405163d248522760e01b815260040160405180910390fd5b505b6001600160a01b0382161580610b5557506001600160a01b0382163b155b1561030f5760405163526a392d60e01b815260040160405180910390fd5b5f5f82806020019051810190610b899190612983565b509198975050505050505050565b81515f90815b81811015610da5575f858281518110610bb857610bb8612489565b602002602001015190505f858381518110610bd557610bd5612489565b60200260200101519050805f03610c3f57816001600160a01b0316886001600160a01b03167ffe3e30b591c8199a91f575b16b49e2d2b7d947c4e1490f570b41f1aa448decb883604051610c2b91815260200190565b60405180910390a35f945050505050610dac565b6001600160a01b038216610cb6578015801590610c65575080886001600160a01b031631105b15610cb157604080518281526001600160a01b038a8116803160208401529085169290917f2a147d47d8d7c5f6b2c7eebb350802ba5ed6008e8eb811f40b78d2090b329c869101610c2b565b610d9b565b6040516370a0823160e01b81526001600160a01b0389811660048301525f91908416906370a0823190602401602060405180830381865afa158015610cfd573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610d219190612a53565b90508115801590610d3157508181105b15610d9957826001600160a01b0316896001600160a01b03167f2a147d47d8d7c5f6b2c7eebb350802ba5ed6008e8eb811f40b78d2090b329c868484604051610d84929190918252602082015260400190565b60405180910390a35f95505050505050610dac565b505b5050600101610b9d565b5060019150505b9392505050565b5f5f610dc1835f6004611102565b610dca9061249d565b90506001600160e01b031981166304e2f55f60e11b14610ded5750600192915050565b50505160e4101590565b60605f610e0a600160f81b82808061120c565b9050836001600160a01b031663d691c96482610e2586611276565b6040518363ffffffff1660e01b8152600401610e42929190612a6a565b5f604051808303815f875af1158015610e5d573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f1916820160405261059a9190810190612a82565b610e8c61129f565b5f610e9984848785610340565b905080515f03610ebc5760405163addfcd9d60e01b815260040160405180910390fd5b6040516314cb37cf60e01b81526001600160a01b0386811660048301528516906314cb37cf906024015f604051808303815f87803b158015610efc575f5ffd5b505af1158015610f0e573d5f5f3e3d5ffd5b50505050610f1c8582610df7565b505f846001600160a01b0316632113522a6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610f5a573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610f7e9190612b26565b90506001600160a01b0381163014610fa9576040516306a8611160e11b815260040160405180910390fd5b60405163d06a1e8960e01b81526001600160a01b03878116600483015286169063d06a1e89906024015f604051808303815f87803b158015610fe9575f5ffd5b505af1158015610ffb573d5f5f3e3d5ffd5b5050505061100a8686856112c7565b505061101560015f55565b50505050565b5f5f611027835f6117f1565b90506001600160a01b03811661105057604051630f58648f60e01b815260040160405180910390fd5b806001600160a01b03163b5f0361107a5760405163f7b0af8560e01b815260040160405180910390fd5b5f61109384601480875161108e91906124ef565b611102565b604051632b870d1b60e11b81529091506001600160a01b0383169063570e1a36906110c2908490600401611c55565b6020604051808303815f875af11580156110de573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061059a9190612b26565b60608182601f01101561114d5760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b60448201526064015b60405180910390fd5b6111578284612b41565b8451101561119b5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401611144565b6060821580156111b95760405191505f825260208201604052611203565b6040519150601f8416801560200281840101858101878315602002848b0101015b818310156111f25780518352602092830192016111da565b5050858452601f01601f1916604052505b50949350505050565b604080516001600160f81b03198087166020830152851660218201525f602282018190526001600160e01b03198516602683015269ffffffffffffffffffff198416602a830152910160405160208183030381529060405261126d90612b54565b95945050505050565b6060816040516020016112899190611ebc565b6040516020818303038152906040529050919050565b60025f54036112c157604051633ee5aeb560e01b815260040160405180910390fd5b60025f55565b5f5f836001600160a01b031663e445e7dd6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611305573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906113299190612b7a565b9050600181600281111561133f5761133f612b98565b148061135c5750600281600281111561135a5761135a612b98565b145b156117ea575f61136b84611855565b90505f6113778561186b565b604051630b47673760e41b8152600481018490529091505f906001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000169063b47673709060240160a060405180830381865afa1580156113df573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906114039190612bac565b60608101519091506001600160a01b031661143157604051638077659960e01b815260040160405180910390fd5b6040516381cbe69160e01b81526001600160a01b0389811660048301525f91908916906381cbe69190602401602060405180830381865afa158015611478573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061149c9190612a53565b60808301519091506001600160a01b031663fd8cd53f8a858760018a60028111156114c9576114c9612b98565b14868e6001600160a01b031663685a943c6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611507573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061152b9190612a53565b6040516001600160e01b031960e089901b1681526001600160a01b039687166004820152959094166024860152604485019290925215156064840152608483015260a482015260c4016020604051808303815f875af1158015611590573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906115b49190612a53565b95505f861180156115d6575060028560028111156115d4576115d4612b98565b145b156117e557808611156115fc57604051632fb15b8760e01b815260040160405180910390fd5b5f886001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa158015611639573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061165d9190612b26565b90506001600160a01b038116158061169157506001600160a01b03811673eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee145b156116d557868a6001600160a01b03163110156116c157604051638e10f0c960e01b815260040160405180910390fd5b6116d08a846040015189611877565b61176f565b6040516370a0823160e01b81526001600160a01b038b811660048301528891908316906370a0823190602401602060405180830381865afa15801561171c573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906117409190612a53565b101561175f57604051638e10f0c960e01b815260040160405180910390fd5b61176f8a8285604001518a6118d4565b6001600160a01b038916631f26461961178889856124ef565b6040516001600160e01b031960e084901b16815260048101919091526001600160a01b038d1660248201526044015f604051808303815f87803b1580156117cd575f5ffd5b505af11580156117df573d5f5f3e3d5ffd5b50505050505b505050505b5050505050565b5f6117fd826014612b41565b835110156118455760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401611144565b500160200151600160601b900490565b5f611862825f6020611102565b61033a90612b54565b5f61033a8260206117f1565b5f826001600160a01b031631905061189f84848460405180602001604052805f815250611a6c565b506001600160a01b03831631826118b683836124ef565b146117ea5760405163102831ad60e11b815260040160405180910390fd5b6040516370a0823160e01b81526001600160a01b0383811660048301525f91908516906370a0823190602401602060405180830381865afa15801561191b573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061193f9190612a53565b6040516001600160a01b03851660248201526044810184905290915061199790869086905f9060640160408051601f198184030181529190526020810180516001600160e01b031663a9059cbb60e01b179052611a6c565b506040516370a0823160e01b81526001600160a01b0384811660048301525f91908616906370a0823190602401602060405180830381865afa1580156119df573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190611a039190612a53565b90505f611a1083836124ef565b90505f611a23856103e8620186a0611b1b565b9050611a2f81866124ef565b821080611a445750611a418186612b41565b82115b15611a625760405163102831ad60e11b815260040160405180910390fd5b5050505050505050565b60605f611a7b8180808061120c565b9050856001600160a01b031663d691c96482611a98888888611bcb565b6040518363ffffffff1660e01b8152600401611ab5929190612a6a565b5f604051808303815f875af1158015611ad0573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f19168201604052611af79190810190612a82565b5f81518110611b0857611b08612489565b6020026020010151915050949350505050565b5f5f5f611b288686611bfa565b91509150815f03611b4c57838181611b4257611b42612c3a565b0492505050610dac565b818411611b6357611b636003851502601118611c16565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b6060838383604051602001611be293929190612c4e565b60405160208183030381529060405290509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f610dac6020830184611c27565b5f5f60208385031215611c78575f5ffd5b82356001600160401b03811115611c8d575f5ffd5b8301601f81018513611c9d575f5ffd5b80356001600160401b03811115611cb2575f5ffd5b856020828401011115611cc3575f5ffd5b6020919091019590945092505050565b6001600160a01b0381168114611ce7575f5ffd5b50565b8035611cf581611cd3565b919050565b5f5f60408385031215611d0b575f5ffd5b8235611d1681611cd3565b946020939093013593505050565b634e487b7160e01b5f52604160045260245ffd5b604080519081016001600160401b0381118282101715611d5a57611d5a611d24565b60405290565b604051606081016001600160401b0381118282101715611d5a57611d5a611d24565b60405160c081016001600160401b0381118282101715611d5a57611d5a611d24565b604051601f8201601f191681016001600160401b0381118282101715611dcc57611dcc611d24565b604052919050565b5f6001600160401b03821115611dec57611dec611d24565b50601f01601f191660200190565b5f82601f830112611e09575f5ffd5b8135611e1c611e1782611dd4565b611da4565b818152846020838601011115611e30575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f5f5f60808587031215611e5f575f5ffd5b8435611e6a81611cd3565b93506020850135611e7a81611cd3565b92506040850135611e8a81611cd3565b915060608501356001600160401b03811115611ea4575f5ffd5b611eb087828801611dfa565b91505092959194509250565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611f3d57868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611f2790870182611c27565b9550506020938401939190910190600101611ee2565b50929695505050505050565b5f6001600160401b03821115611f6157611f61611d24565b5060051b60200190565b5f60208284031215611f7b575f5ffd5b81356001600160401b03811115611f90575f5ffd5b8201601f81018413611fa0575f5ffd5b8035611fae611e1782611f49565b8082825260208201915060208360051b850101925086831115611fcf575f5ffd5b6020840193505b82841015611ff1578335825260209384019390910190611fd6565b9695505050505050565b5f6020828403121561200b575f5ffd5b8135610dac81611cd3565b5f60208284031215612026575f5ffd5b5035919050565b5f82601f83011261203c575f5ffd5b813561204a611e1782611f49565b8082825260208201915060208360051b86010192508583111561206b575f5ffd5b602085015b8381101561209157803561208381611cd3565b835260209283019201612070565b5095945050505050565b5f82601f8301126120aa575f5ffd5b81356120b8611e1782611f49565b8082825260208201915060208360051b8601019250858311156120d9575f5ffd5b602085015b838110156120915780358352602092830192016120de565b5f5f5f5f5f5f5f60e0888a03121561210c575f5ffd5b61211588611cea565b965061212360208901611cea565b955060408801356001600160401b0381111561213d575f5ffd5b6121498a828b0161202d565b95505060608801356001600160401b03811115612164575f5ffd5b6121708a828b0161209b565b94505060808801356001600160401b0381111561218b575f5ffd5b6121978a828b01611dfa565b93505060a08801356001600160401b038111156121b2575f5ffd5b6121be8a828b01611dfa565b92505060c08801356001600160401b038111156121d9575f5ffd5b6121e58a828b01611dfa565b91505092959891949750929550565b5f60208284031215612204575f5ffd5b81356001600160401b03811115612219575f5ffd5b82016040818503121561222a575f5ffd5b612232611d38565b81356001600160401b03811115612247575f5ffd5b6122538682850161202d565b82525060208201356001600160401b0381111561226e575f5ffd5b80830192505084601f830112612282575f5ffd5b8135612290611e1782611f49565b8082825260208201915060208360051b8601019250878311156122b1575f5ffd5b602085015b838110156122f15780356001600160401b038111156122d3575f5ffd5b6122e28a6020838a0101611dfa565b845250602092830192016122b6565b506020840152509095945050505050565b6001600160a01b038481168252831660208201526060604082018190525f9061126d90830184611c27565b8051611cf581611cd3565b5f82601f830112612347575f5ffd5b8151612355611e1782611dd4565b818152846020838601011115612369575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f60208284031215612395575f5ffd5b81516001600160401b038111156123aa575f5ffd5b8201601f810184136123ba575f5ffd5b80516123c8611e1782611f49565b8082825260208201915060208360051b8501019250868311156123e9575f5ffd5b602084015b8381101561247e5780516001600160401b0381111561240b575f5ffd5b85016060818a03601f19011215612420575f5ffd5b612428611d60565b602082015161243681611cd3565b81526040820151602082015260608201516001600160401b0381111561245a575f5ffd5b6124698b602083860101612338565b604083015250845250602092830192016123ee565b509695505050505050565b634e487b7160e01b5f52603260045260245ffd5b805160208201516001600160e01b03198116919060048210156124d4576001600160e01b0319600483900360031b81901b82161692505b5050919050565b634e487b7160e01b5f52601160045260245ffd5b8181038181111561033a5761033a6124db565b602080825282518282018190525f918401906040840190835b8181101561253957835183526020938401939092019160010161251b565b509095945050505050565b5f8151808452602084019350602083015f5b82811015612574578151865260209586019590910190600101612556565b5093949350505050565b60c081525f61259060c0830189611c27565b6001600160401b0388166020848101919091526001600160a01b038881166040860152871660608501528382036080850152855180835286820192909101905f5b818110156125f85783516001600160a01b03168352602093840193909201916001016125d1565b505083810360a085015261260c8186612544565b9a9950505050505050505050565b604081525f61262c6040830185611c27565b828103602084015261126d8185611c27565b6001600160a01b03831681526040602082018190525f9061059a90830184611c27565b5f60208284031215612671575f5ffd5b81516001600160e01b031981168114610dac575f5ffd5b80516001600160401b0381168114611cf5575f5ffd5b5f82601f8301126126ad575f5ffd5b81516126bb611e1782611f49565b8082825260208201915060208360051b8601019250858311156126dc575f5ffd5b602085015b83811015612091576126f281612688565b8352602092830192016126e1565b805165ffffffffffff81168114611cf5575f5ffd5b5f82601f830112612724575f5ffd5b8151612732611e1782611f49565b8082825260208201915060208360051b860101925085831115612753575f5ffd5b602085015b83811015612091578051835260209283019201612758565b5f82601f83011261277f575f5ffd5b815161278d611e1782611f49565b8082825260208201915060208360051b8601019250858311156127ae575f5ffd5b602085015b838110156120915780516127c681611cd3565b8352602092830192016127b3565b5f82601f8301126127e3575f5ffd5b81516127f1611e1782611f49565b8082825260208201915060208360051b860101925085831115612812575f5ffd5b602085015b838110156120915780516001600160401b03811115612834575f5ffd5b86016060818903601f19011215612849575f5ffd5b612851611d60565b60208201516001600160401b03811115612869575f5ffd5b6128788a602083860101612715565b82525061288760408301612688565b602082015260608201516001600160401b038111156128a4575f5ffd5b60208184010192505060c0828a0312156128bc575f5ffd5b6128c4611d82565b6128cd8361232d565b81526128db6020840161232d565b602082015260408301516001600160401b038111156128f8575f5ffd5b6129048b828601612770565b60408301525060608301516001600160401b03811115612922575f5ffd5b61292e8b828601612715565b6060830152506129406080840161232d565b608082015260a08301516001600160401b0381111561295d575f5ffd5b6129698b828601612338565b60a083015250604082015284525060209283019201612817565b5f5f5f5f5f5f5f60e0888a031215612999575f5ffd5b87516001600160401b038111156129ae575f5ffd5b6129ba8a828b0161269e565b9750506129c960208901612700565b95506129d760408901612700565b606089015160808a015191965094506001600160401b038111156129f9575f5ffd5b612a058a828b01612715565b93505060a08801516001600160401b03811115612a20575f5ffd5b612a2c8a828b016127d4565b92505060c08801516001600160401b03811115612a47575f5ffd5b6121e58a828b01612338565b5f60208284031215612a63575f5ffd5b5051919050565b828152604060208201525f61059a6040830184611c27565b5f60208284031215612a92575f5ffd5b81516001600160401b03811115612aa7575f5ffd5b8201601f81018413612ab7575f5ffd5b8051612ac5611e1782611f49565b8082825260208201915060208360051b850101925086831115612ae6575f5ffd5b602084015b8381101561247e5780516001600160401b03811115612b08575f5ffd5b612b1789602083890101612338565b84525060209283019201612aeb565b5f60208284031215612b36575f5ffd5b8151610dac81611cd3565b8082018082111561033a5761033a6124db565b80516020808301519190811015612b74575f198160200360031b1b821691505b50919050565b5f60208284031215612b8a575f5ffd5b815160038110610dac575f5ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60a0828403128015612bbd575f5ffd5b5060405160a081016001600160401b0381118282101715612be057612be0611d24565b6040528251612bee81611cd3565b8152602083810151908201526040830151612c0881611cd3565b60408201526060830151612c1b81611cd3565b60608201526080830151612c2e81611cd3565b60808201529392505050565b634e487b7160e01b5f52601260045260245ffd5b6bffffffffffffffffffffffff198460601b1681528260148201525f82518060208501603485015e5f920160340191825250939250505056fea164736f6c634300081e000a",
 }
 
 // SuperDestinationExecutorABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperDestinationExecutorMetaData.ABI instead.
 var SuperDestinationExecutorABI = SuperDestinationExecutorMetaData.ABI
 
+// SuperDestinationExecutorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperDestinationExecutorMetaData.Bin instead.
+var SuperDestinationExecutorBin = SuperDestinationExecutorMetaData.Bin
+
+// DeploySuperDestinationExecutor deploys a new Ethereum contract, binding an instance of SuperDestinationExecutor to it.
+func DeploySuperDestinationExecutor(auth *bind.TransactOpts, backend bind.ContractBackend, ledgerConfiguration_ common.Address, superDestinationValidator_ common.Address) (common.Address, *types.Transaction, *SuperDestinationExecutor, error) {
+	parsed, err := SuperDestinationExecutorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperDestinationExecutorBin), backend, ledgerConfiguration_, superDestinationValidator_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperDestinationExecutor{SuperDestinationExecutorCaller: SuperDestinationExecutorCaller{contract: contract}, SuperDestinationExecutorTransactor: SuperDestinationExecutorTransactor{contract: contract}, SuperDestinationExecutorFilterer: SuperDestinationExecutorFilterer{contract: contract}}, nil
+}
+
 // SuperDestinationExecutor is an auto generated Go binding around an Ethereum contract.
 type SuperDestinationExecutor struct {
 	SuperDestinationExecutorCaller     // Read-only binding to the contract
@@ -45,12 +45,34 @@ type PackedUserOperation struct {
 // SuperDestinationValidatorMetaData contains all meta data concerning the SuperDestinationValidator contract.
 var SuperDestinationValidatorMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"function\",\"name\":\"getAccountOwner\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isInitialized\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isModuleType\",\"inputs\":[{\"name\":\"typeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"isValidDestinationSignature\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isValidSignatureWithSender\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"namespace\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"onInstall\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onUninstall\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validateUserOp\",\"inputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"ERC7579ValidatorBase.ValidationData\"}],\"stateMutability\":\"pure\"},{\"type\":\"event\",\"name\":\"AccountOwnerSet\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AccountUnset\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ALREADY_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"name\":\"length\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"EMPTY_DESTINATION_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_CHAIN_ID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DESTINATION_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_MERKLE_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SENDER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ModuleAlreadyInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"NOT_EIP1271_SIGNER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_IMPLEMENTED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"PROOF_COUNT_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PROOF_NOT_FOUND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNEXPECTED_CHAIN_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ADDRESS\",\"inputs\":[]}]",
+	Bin: "0x6080604052348015600e575f5ffd5b506120798061001c5f395ff3fe608060405234801561000f575f5ffd5b5060043610610090575f3560e01c80638a91b0e3116100635780638a91b0e3146101325780639700320314610145578063d60b347f14610166578063ecd05961146101a1578063f551e2ee146101b5575f5ffd5b8063442b172c146100945780635c2ec0f3146100dc5780636d61fe70146101085780637c015a891461011d575b5f5ffd5b6100bf6100a2366004611420565b6001600160a01b039081165f908152600160205260409020541690565b6040516001600160a01b0390911681526020015b60405180910390f35b6100ef6100ea36600461147f565b6101c3565b6040516001600160e01b031990911681526020016100d3565b61011b6101163660046114cf565b610282565b005b610125610355565b6040516100d3919061153b565b61011b6101403660046114cf565b610387565b61015861015336600461154d565b61040c565b6040519081526020016100d3565b610191610174366004611420565b6001600160a01b03165f9081526020819052604090205460ff1690565b60405190151581526020016100d3565b6101916101af366004611593565b60011490565b6100ef6101533660046115aa565b6001600160a01b0383165f9081526020819052604081205460ff166101fb57604051630f68fe6360e21b815260040160405180910390fd5b5f5f61023b85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061042692505050565b915091505f61024b87848461046d565b5090505f61025e828986602001516104e6565b90508061026b575f610274565b635c2ec0f360e01b5b9450505050505b9392505050565b335f9081526020819052604090205460ff16156102b25760405163439a74c960e01b815260040160405180910390fd5b5f6102bf82840184611420565b90506001600160a01b0381166102e85760405163538ba4f960e01b815260040160405180910390fd5b335f81815260208181526040808320805460ff1916600190811790915590915280822080546001600160a01b0386166001600160a01b0319909116811790915590519092917fbe3f5c5c79d582b53d2c89a48a099e3d039cd3a249a2ad0f932cc39357c69fad91a3505050565b606061038260408051808201909152600e81526d29bab832b92b30b634b230ba37b960911b602082015290565b905090565b335f9081526020819052604090205460ff166103b657604051630f68fe6360e21b815260040160405180910390fd5b335f81815260208181526040808320805460ff19169055600190915280822080546001600160a01b0319169055517f231ed5455a8a61ef69418a03c2725cbfb235bcb6c08ca6ad51a9064465f7c3a29190a25050565b5f6040516343f6e4ab60e01b815260040160405180910390fd5b61042e611374565b6104366113be565b5f5f8480602001905181019061044c91906116fa565b91509150610459826104fb565b61046282610569565b935093505050915091565b5f5f61049c836040516020016104839190611797565b60405160208183030381529060405285602001516105d7565b90506104b56104aa85610604565b856060015183610694565b6104d25760405163712eb08760e01b815260040160405180910390fd5b6104dc85856106a9565b9150935093915050565b5f6104f38484845f610830565b949350505050565b610503611374565b5f5f5f5f5f5f5f8880602001905181019061051e9190611b93565b6040805160e08101825297885265ffffffffffff968716602089015294909516938601939093526060850191909152608084015260a083015260c08201529998505050505050505050565b6105716113be565b5f5f5f5f5f5f8780602001905181019061058b9190611c72565b6040805160c0810182529687526001600160401b0390951660208701526001600160a01b039384169486019490945291166060840152608083015260a082015298975050505050505050565b5f5f838060200190518101906105ed9190611d33565b90506105fa818430610909565b9150505b92915050565b60a0810151516060905f5b8181101561067a57468460a00151828151811061062e5761062e611e24565b6020026020010151602001516001600160401b031603610672578360a00151818151811061065e5761065e611e24565b60200260200101515f015192505050919050565b60010161060f565b506040516375da1edb60e11b815260040160405180910390fd5b5f826106a08584610978565b14949350505050565b5f6106d7836001600160a01b0316803b806020016040519081016040528181525f908060200190933c6109ba565b156106ec576106e5826109db565b90506105fe565b6001600160a01b038084165f9081526001602052604090205416803b158061073c575061073c816001600160a01b0316803b806020016040519081016040528181525f908060200190933c6109ba565b156107525761074a836109db565b9150506105fe565b5f6107608460600151610a2a565b90506107766001600160a01b0383168583610a62565b15610783575090506105fe565b60c0840151604051630b135d3f60e11b81526001600160a01b03841691631626ba7e916107b4918591600401611e38565b602060405180830381865afa9250505080156107ed575060408051601f3d908101601f191682019092526107ea91810190611e50565b60015b15610817576374eca2c160e11b6001600160e01b0319821601610815578293505050506105fe565b505b6040516331d42a1b60e21b815260040160405180910390fd5b5f5f8265ffffffffffff16421015801561087d575065ffffffffffff8416158061087d5750428465ffffffffffff161015801561087d57508365ffffffffffff168365ffffffffffff1611155b90506108ac856001600160a01b0316803b806020016040519081016040528181525f908060200190933c6109ba565b156108d757846001600160a01b0316866001600160a01b03161480156108cf5750805b9150506104f3565b6001600160a01b038086165f9081526001602052604090205487821691161480156108ff5750805b9695505050505050565b82516020808501516040808701516060880151608089015160a08a015193515f9761093c97909695918b918b9101611e77565b60408051601f19818403018152828252805160209182012090830152016040516020818303038152906040528051906020012090509392505050565b5f81815b84518110156109b2576109a88286838151811061099b5761099b611e24565b6020026020010151610d9a565b915060010161097c565b509392505050565b5f61ef0160f01b6109ca83611f3b565b6001600160e81b0319161492915050565b5f5f6109ea8360600151610a2a565b7f19457468657265756d205369676e6564204d6573736167653a0a3332000000005f908152601c829052603c8120919250506104f3818560c00151610dc3565b5f610a33610355565b82604051602001610a45929190611f78565b604051602081830303815290604052805190602001209050919050565b5f60605f856001600160a01b031663a0e67e2b6040518163ffffffff1660e01b81526004015f60405180830381865afa925050508015610ac357506040513d5f823e601f3d908101601f19168201604052610ac09190810190611f99565b60015b610ad1575f9250505061027b565b9150856001600160a01b031663e75235b86040518163ffffffff1660e01b8152600401602060405180830381865afa925050508015610b2d575060408051601f3d908101601f19168201909252610b2a91810190611fca565b60015b610b3b575f9250505061027b565b604080518082018252600d81526c5375706572666f726d5361666560981b6020918201528151808301835260058152640312e302e360dc1b9082015281517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f818301527fe2c6934fb785b5f60008275f0609066361e48bc175bda8e1160d2680b4c1849d818401527f06c015bd22b4c69690933c1058878ebdfef31f9aaae40bbe86d8a09fe1b2972c6060820152600160808201526001600160a01b038a1660a0808301919091528351808303909101815260c0808301855281519184019190912060e08084018b905285518085039091018152610100840186528051908501207f60b3cbf8b4a223d68d641b3b6ddf9a298e7f33710cf3d3a9d1146b5a6150fbca610120850152610140808501919091528551808503909101815261016084018652805190850120601960f81b610180850152600160f81b61018185015261018284018290526101a280850191909152855180850390910181526101c2909301909452815191909201209088015192935090915f908190610cdf90849087610deb565b9150915084821015610cf9575f965050505050505061027b565b610d0281610f6b565b610d0b86610f6b565b610d1486610f77565b85515f90815b81811015610d88575f610d4f8a8381518110610d3857610d38611e24565b602002602001015186610f8090919063ffffffff16565b5090508015610d7f5783610d6281611ff5565b945050888410610d7f5760019a505050505050505050505061027b565b50600101610d1a565b505f9c9b505050505050505050505050565b5f818310610db4575f82815260208490526040902061027b565b505f9182526020526040902090565b5f5f5f5f610dd18686610fa1565b925092509250610de18282610fea565b5090949350505050565b81515f9060609082610dfe60418361200d565b9050806001600160401b03811115610e1857610e18611601565b604051908082528060200260200182016040528015610e41578160200160208202803683370190505b50925084811015610e56575f93505050610f63565b5f5b81811015610f5f575f5f5f5f610e858b8660410201602081015160408201516060909201515f1a92909190565b9250925092508260ff165f03610ea957610ea28c8c84848b6110ab565b9350610f07565b601e8360ff161115610ef857610ea2610ee68d6020527b19457468657265756d205369676e6564204d6573736167653a0a33325f52603c60042090565b610ef160048661202c565b8484611173565b610f048c848484611173565b93505b6001600160a01b03841615610f245788610f2081611ff5565b9950505b83888681518110610f3757610f37611e24565b6001600160a01b0390921660209283029190910190910152505060019092019150610e589050565b5050505b935093915050565b610f74816111ad565b50565b610f7481611202565b5f80610f9684846001600160a01b03165f61124b565b909590945092505050565b5f5f5f8351604103610fd8576020840151604085015160608601515f1a610fca888285856112ac565b955095509550505050610fe3565b505081515f91506002905b9250925092565b5f826003811115610ffd57610ffd612045565b03611006575050565b600182600381111561101a5761101a612045565b036110385760405163f645eedf60e01b815260040160405180910390fd5b600282600381111561104c5761104c612045565b036110725760405163fce698f760e01b8152600481018290526024015b60405180910390fd5b600382600381111561108657611086612045565b036110a7576040516335e2f38360e21b815260048101829052602401611069565b5050565b838201602001518390826110bf8583612059565b6110ca906020612059565b11156110d9575f91505061116a565b604051630b135d3f60e11b808252878601602001916001600160a01b03851690631626ba7e9061110f908c908690600401611e38565b602060405180830381865afa15801561112a573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061114e9190611e50565b6001600160e01b03191614611167575f9250505061116a565b50505b95945050505050565b5f604051855f5260ff851660205283604052826060526020604060805f60015afa505f6060523d6060185191508060405250949350505050565b80515f82528060051b8201601f19602084015b6020018281116111fb57805182820180518281116111e0575050506111c0565b5b6020820152830180518281116111e15750602001526111c0565b5050509052565b6002815110610f74576020810160408201600183510160051b83015b815183511461123257602083019250815183525b60208201915080820361121e57505081900360051c9052565b5f5f5f19600186515f87870197505b81830160011c94508460051b89015187019050878114828411176112945780881161128957838501915061125a565b60018501925061125a565b84151597148716989290930190950295509350505050565b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156112e557505f9150600390508261136a565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa158015611336573d5f5f3e3d5ffd5b5050604051601f1901519150506001600160a01b03811661136157505f92506001915082905061136a565b92505f91508190505b9450945094915050565b6040518060e00160405280606081526020015f65ffffffffffff1681526020015f65ffffffffffff1681526020015f81526020016060815260200160608152602001606081525090565b6040518060c00160405280606081526020015f6001600160401b031681526020015f6001600160a01b031681526020015f6001600160a01b0316815260200160608152602001606081525090565b6001600160a01b0381168114610f74575f5ffd5b5f60208284031215611430575f5ffd5b813561027b8161140c565b5f5f83601f84011261144b575f5ffd5b5081356001600160401b03811115611461575f5ffd5b602083019150836020828501011115611478575f5ffd5b9250929050565b5f5f5f60408486031215611491575f5ffd5b833561149c8161140c565b925060208401356001600160401b038111156114b6575f5ffd5b6114c28682870161143b565b9497909650939450505050565b5f5f602083850312156114e0575f5ffd5b82356001600160401b038111156114f5575f5ffd5b6115018582860161143b565b90969095509350505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f61027b602083018461150d565b5f5f6040838503121561155e575f5ffd5b82356001600160401b03811115611573575f5ffd5b83016101208186031215611585575f5ffd5b946020939093013593505050565b5f602082840312156115a3575f5ffd5b5035919050565b5f5f5f5f606085870312156115bd575f5ffd5b84356115c88161140c565b93506020850135925060408501356001600160401b038111156115e9575f5ffd5b6115f58782880161143b565b95989497509550505050565b634e487b7160e01b5f52604160045260245ffd5b604051606081016001600160401b038111828210171561163757611637611601565b60405290565b60405160c081016001600160401b038111828210171561163757611637611601565b604051601f8201601f191681016001600160401b038111828210171561168757611687611601565b604052919050565b5f82601f83011261169e575f5ffd5b81516001600160401b038111156116b7576116b7611601565b6116ca601f8201601f191660200161165f565b8181528460208386010111156116de575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f5f6040838503121561170b575f5ffd5b82516001600160401b03811115611720575f5ffd5b61172c8582860161168f565b92505060208301516001600160401b03811115611747575f5ffd5b6117538582860161168f565b9150509250929050565b5f8151808452602084019350602083015f5b8281101561178d57815186526020958601959091019060010161176f565b5093949350505050565b602081525f825160c060208401526117b260e084018261150d565b6020858101516001600160401b03166040868101919091528601516001600160a01b0390811660608088019190915287015116608080870191909152860151858303601f190160a0870152805180845290820193505f92909101905b808310156118395783516001600160a01b03168252602093840193600193909301929091019061180e565b5060a0860151858203601f190160c087015292506108ff818461175d565b5f6001600160401b0382111561186f5761186f611601565b5060051b60200190565b80516001600160401b038116811461188f575f5ffd5b919050565b5f82601f8301126118a3575f5ffd5b81516118b66118b182611857565b61165f565b8082825260208201915060208360051b8601019250858311156118d7575f5ffd5b602085015b838110156118fb576118ed81611879565b8352602092830192016118dc565b5095945050505050565b805165ffffffffffff8116811461188f575f5ffd5b5f82601f830112611929575f5ffd5b81516119376118b182611857565b8082825260208201915060208360051b860101925085831115611958575f5ffd5b602085015b838110156118fb57805183526020928301920161195d565b805161188f8161140c565b5f82601f83011261198f575f5ffd5b815161199d6118b182611857565b8082825260208201915060208360051b8601019250858311156119be575f5ffd5b602085015b838110156118fb5780516119d68161140c565b8352602092830192016119c3565b5f82601f8301126119f3575f5ffd5b8151611a016118b182611857565b8082825260208201915060208360051b860101925085831115611a22575f5ffd5b602085015b838110156118fb5780516001600160401b03811115611a44575f5ffd5b86016060818903601f19011215611a59575f5ffd5b611a61611615565b60208201516001600160401b03811115611a79575f5ffd5b611a888a60208386010161191a565b825250611a9760408301611879565b602082015260608201516001600160401b03811115611ab4575f5ffd5b60208184010192505060c0828a031215611acc575f5ffd5b611ad461163d565b611add83611975565b8152611aeb60208401611975565b602082015260408301516001600160401b03811115611b08575f5ffd5b611b148b828601611980565b60408301525060608301516001600160401b03811115611b32575f5ffd5b611b3e8b82860161191a565b606083015250611b5060808401611975565b608082015260a08301516001600160401b03811115611b6d575f5ffd5b611b798b82860161168f565b60a083015250604082015284525060209283019201611a27565b5f5f5f5f5f5f5f60e0888a031215611ba9575f5ffd5b87516001600160401b03811115611bbe575f5ffd5b611bca8a828b01611894565b975050611bd960208901611905565b9550611be760408901611905565b606089015160808a015191965094506001600160401b03811115611c09575f5ffd5b611c158a828b0161191a565b93505060a08801516001600160401b03811115611c30575f5ffd5b611c3c8a828b016119e4565b92505060c08801516001600160401b03811115611c57575f5ffd5b611c638a828b0161168f565b91505092959891949750929550565b5f5f5f5f5f5f60c08789031215611c87575f5ffd5b86516001600160401b03811115611c9c575f5ffd5b611ca889828a0161168f565b965050611cb760208801611879565b94506040870151611cc78161140c565b6060880151909450611cd88161140c565b60808801519093506001600160401b03811115611cf3575f5ffd5b611cff89828a01611980565b92505060a08701516001600160401b03811115611d1a575f5ffd5b611d2689828a0161191a565b9150509295509295509295565b5f60208284031215611d43575f5ffd5b81516001600160401b03811115611d58575f5ffd5b820160c08185031215611d69575f5ffd5b611d7161163d565b81516001600160401b03811115611d86575f5ffd5b611d928682850161168f565b825250611da160208301611879565b6020820152611db260408301611975565b6040820152611dc360608301611975565b606082015260808201516001600160401b03811115611de0575f5ffd5b611dec86828501611980565b60808301525060a08201516001600160401b03811115611e0a575f5ffd5b611e168682850161191a565b60a083015250949350505050565b634e487b7160e01b5f52603260045260245ffd5b828152604060208201525f6104f3604083018461150d565b5f60208284031215611e60575f5ffd5b81516001600160e01b03198116811461027b575f5ffd5b61010081525f611e8b61010083018b61150d565b6001600160401b038a166020848101919091526001600160a01b038a81166040860152891660608501528382036080850152875180835288820192909101905f5b81811015611ef35783516001600160a01b0316835260209384019390920191600101611ecc565b505083810360a0850152611f07818861175d565b92505050611f1f60c083018565ffffffffffff169052565b6001600160a01b03831660e08301529998505050505050505050565b805160208201516001600160e81b0319811691906003821015611f71576001600160e81b03196003838103901b81901b82161692505b5050919050565b604081525f611f8a604083018561150d565b90508260208301529392505050565b5f60208284031215611fa9575f5ffd5b81516001600160401b03811115611fbe575f5ffd5b6105fa84828501611980565b5f60208284031215611fda575f5ffd5b5051919050565b634e487b7160e01b5f52601160045260245ffd5b5f6001820161200657612006611fe1565b5060010190565b5f8261202757634e487b7160e01b5f52601260045260245ffd5b500490565b60ff82811682821603908111156105fe576105fe611fe1565b634e487b7160e01b5f52602160045260245ffd5b808201808211156105fe576105fe611fe156fea164736f6c634300081e000a",
 }
 
 // SuperDestinationValidatorABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperDestinationValidatorMetaData.ABI instead.
 var SuperDestinationValidatorABI = SuperDestinationValidatorMetaData.ABI
 
+// SuperDestinationValidatorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperDestinationValidatorMetaData.Bin instead.
+var SuperDestinationValidatorBin = SuperDestinationValidatorMetaData.Bin
+
+// DeploySuperDestinationValidator deploys a new Ethereum contract, binding an instance of SuperDestinationValidator to it.
+func DeploySuperDestinationValidator(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SuperDestinationValidator, error) {
+	parsed, err := SuperDestinationValidatorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperDestinationValidatorBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperDestinationValidator{SuperDestinationValidatorCaller: SuperDestinationValidatorCaller{contract: contract}, SuperDestinationValidatorTransactor: SuperDestinationValidatorTransactor{contract: contract}, SuperDestinationValidatorFilterer: SuperDestinationValidatorFilterer{contract: contract}}, nil
+}
+
 // SuperDestinationValidator is an auto generated Go binding around an Ethereum contract.
 type SuperDestinationValidator struct {
 	SuperDestinationValidatorCaller     // Read-only binding to the contract
@@ -39,12 +39,34 @@ type Execution struct {
 // SuperExecutorMetaData contains all meta data concerning the SuperExecutor contract.
 var SuperExecutorMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"ledgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISuperLedgerConfiguration\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"execute\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"isInitialized\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isModuleType\",\"inputs\":[{\"name\":\"typeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"name\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"onInstall\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onUninstall\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"validateHookCompliance\",\"inputs\":[{\"name\":\"hook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"version\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"event\",\"name\":\"SuperPositionMintRequested\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"spToken\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"dstChainId\",\"type\":\"uint256\",\"indexed\":true,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ALREADY_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"FEE_NOT_TRANSFERRED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INSUFFICIENT_BALANCE_FOR_FEE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_CHAIN_ID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_FEE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_YIELD_SOURCE_ORACLE_ID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MALICIOUS_HOOK_DETECTED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MANAGER_NOT_SET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ModuleAlreadyInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NO_HOOKS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"ReentrancyGuardReentrantCall\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604051611ccd380380611ccd833981016040819052602b916067565b60015f55806001600160a01b038116605657604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b0316608052506092565b5f602082840312156076575f5ffd5b81516001600160a01b0381168114608b575f5ffd5b9392505050565b608051611c1c6100b15f395f8181610150015261091e0152611c1c5ff3fe608060405234801561000f575f5ffd5b5060043610610090575f3560e01c80636d61fe70116100635780636d61fe70146101255780638a91b0e314610138578063a5310a691461014b578063d60b347f1461018a578063ecd05961146101c5575f5ffd5b806306fdde031461009457806309c5eabe146100cf5780634a03fda4146100e457806354fd4d5014610104575b5f5ffd5b60408051808201909152600d81526c29bab832b922bc32b1baba37b960991b60208201525b6040516100c6919061137e565b60405180910390f35b6100e26100dd366004611390565b6101d9565b005b6100f76100f2366004611519565b610221565b6040516100c69190611589565b604080518082019091526005815264302e302e3160d81b60208201526100b9565b6100e2610133366004611390565b610483565b6100e2610146366004611390565b6104d3565b6101727f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100c6565b6101b5610198366004611616565b6001600160a01b03165f9081526001602052604090205460ff1690565b60405190151581526020016100c6565b6101b56101d3366004611631565b60021490565b335f9081526001602052604090205460ff1661020857604051630f68fe6360e21b815260040160405180910390fd5b61021d33610218838501856116f2565b61051c565b5050565b604080515f808252602082019092526060919081610268565b60408051606080820183525f80835260208301529181019190915281526020019060019003908161023a5790505b5090505f866001600160a01b0316633b5896bc8787876040518463ffffffff1660e01b815260040161029c939291906117e6565b5f60405180830381865afa1580156102b6573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526102dd919081019061185e565b90506002815110156102f15750905061047b565b866001600160a01b0316815f8151811061030d5761030d611962565b60200260200101515f01516001600160a01b03161461032e5750905061047b565b5f815f8151811061034157610341611962565b60200260200101516040015161035690611976565b90506001600160e01b03198116632ae2fe3d60e01b1461037b5782935050505061047b565b5f6001835161038a91906119c8565b9050886001600160a01b03168382815181106103a8576103a8611962565b60200260200101515f01516001600160a01b0316146103cd578394505050505061047b565b5f8382815181106103e0576103e0611962565b6020026020010151604001516103f590611976565b90506001600160e01b031981166305b4fe9160e01b1461041c57849550505050505061047b565b60015b82811015610472578a6001600160a01b031685828151811061044357610443611962565b60200260200101515f01516001600160a01b03160361046a5785965050505050505061047b565b60010161041f565b50929450505050505b949350505050565b335f9081526001602052604090205460ff16156104b35760405163439a74c960e01b815260040160405180910390fd5b5050335f908152600160208190526040909120805460ff19169091179055565b335f9081526001602052604090205460ff1661050257604051630f68fe6360e21b815260040160405180910390fd5b5050335f908152600160205260409020805460ff19169055565b8051515f81900361054057604051632c4df46b60e11b815260040160405180910390fd5b81602001515181146105655760405163899ef10d60e01b815260040160405180910390fd5b5f5f5f5b838110156105f757845180518290811061058557610585611962565b602002602001015191505f6001600160a01b0316826001600160a01b0316036105c157604051630f58648f60e01b815260040160405180910390fd5b6105ea868385886020015185815181106105dd576105dd611962565b60200260200101516105ff565b9091508190600101610569565b505050505050565b610607610796565b5f61061484848785610221565b905080515f036106375760405163addfcd9d60e01b815260040160405180910390fd5b6040516314cb37cf60e01b81526001600160a01b0386811660048301528516906314cb37cf906024015f604051808303815f87803b158015610677575f5ffd5b505af1158015610689573d5f5f3e3d5ffd5b5050505061069785826107be565b505f846001600160a01b0316632113522a6040518163ffffffff1660e01b8152600401602060405180830381865afa1580156106d5573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906106f991906119db565b90506001600160a01b0381163014610724576040516306a8611160e11b815260040160405180910390fd5b60405163d06a1e8960e01b81526001600160a01b03878116600483015286169063d06a1e89906024015f604051808303815f87803b158015610764575f5ffd5b505af1158015610776573d5f5f3e3d5ffd5b5050505061078586868561084b565b505061079060015f55565b50505050565b60025f54036107b857604051633ee5aeb560e01b815260040160405180910390fd5b60025f55565b60605f6107d1600160f81b828080610d75565b9050836001600160a01b031663d691c964826107ec86610ddf565b6040518363ffffffff1660e01b81526004016108099291906119f6565b5f604051808303815f875af1158015610824573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f1916820160405261047b9190810190611a0e565b5f5f836001600160a01b031663e445e7dd6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610889573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108ad9190611ab2565b905060018160028111156108c3576108c3611ad0565b14806108e0575060028160028111156108de576108de611ad0565b145b15610d6e575f6108ef84610e08565b90505f6108fb85610e24565b604051630b47673760e41b8152600481018490529091505f906001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000169063b47673709060240160a060405180830381865afa158015610963573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109879190611ae4565b60608101519091506001600160a01b03166109b557604051638077659960e01b815260040160405180910390fd5b6040516381cbe69160e01b81526001600160a01b0389811660048301525f91908916906381cbe69190602401602060405180830381865afa1580156109fc573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a209190611b72565b60808301519091506001600160a01b031663fd8cd53f8a858760018a6002811115610a4d57610a4d611ad0565b14868e6001600160a01b031663685a943c6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610a8b573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610aaf9190611b72565b6040516001600160e01b031960e089901b1681526001600160a01b039687166004820152959094166024860152604485019290925215156064840152608483015260a482015260c4016020604051808303815f875af1158015610b14573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b389190611b72565b95505f86118015610b5a57506002856002811115610b5857610b58611ad0565b145b15610d695780861115610b8057604051632fb15b8760e01b815260040160405180910390fd5b5f886001600160a01b03166338d52e0f6040518163ffffffff1660e01b8152600401602060405180830381865afa158015610bbd573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610be191906119db565b90506001600160a01b0381161580610c1557506001600160a01b03811673eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee145b15610c5957868a6001600160a01b0316311015610c4557604051638e10f0c960e01b815260040160405180910390fd5b610c548a846040015189610e30565b610cf3565b6040516370a0823160e01b81526001600160a01b038b811660048301528891908316906370a0823190602401602060405180830381865afa158015610ca0573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610cc49190611b72565b1015610ce357604051638e10f0c960e01b815260040160405180910390fd5b610cf38a8285604001518a610e8d565b6001600160a01b038916631f264619610d0c89856119c8565b6040516001600160e01b031960e084901b16815260048101919091526001600160a01b038d1660248201526044015f604051808303815f87803b158015610d51575f5ffd5b505af1158015610d63573d5f5f3e3d5ffd5b50505050505b505050505b5050505050565b604080516001600160f81b03198087166020830152851660218201525f602282018190526001600160e01b03198516602683015269ffffffffffffffffffff198416602a8301529101604051602081830303815290604052610dd690611b89565b95945050505050565b606081604051602001610df29190611589565b6040516020818303038152906040529050919050565b5f610e15825f6020611025565b610e1e90611b89565b92915050565b5f610e1e826020611131565b5f826001600160a01b0316319050610e5884848460405180602001604052805f815250611195565b506001600160a01b0383163182610e6f83836119c8565b14610d6e5760405163102831ad60e11b815260040160405180910390fd5b6040516370a0823160e01b81526001600160a01b0383811660048301525f91908516906370a0823190602401602060405180830381865afa158015610ed4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610ef89190611b72565b6040516001600160a01b038516602482015260448101849052909150610f5090869086905f9060640160408051601f198184030181529190526020810180516001600160e01b031663a9059cbb60e01b179052611195565b506040516370a0823160e01b81526001600160a01b0384811660048301525f91908616906370a0823190602401602060405180830381865afa158015610f98573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610fbc9190611b72565b90505f610fc983836119c8565b90505f610fdc856103e8620186a0611244565b9050610fe881866119c8565b821080610ffd5750610ffa8186611baf565b82115b1561101b5760405163102831ad60e11b815260040160405180910390fd5b5050505050505050565b60608182601f0110156110705760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b60448201526064015b60405180910390fd5b61107a8284611baf565b845110156110be5760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401611067565b6060821580156110dc5760405191505f825260208201604052611126565b6040519150601f8416801560200281840101858101878315602002848b0101015b818310156111155780518352602092830192016110fd565b5050858452601f01601f1916604052505b5090505b9392505050565b5f61113d826014611baf565b835110156111855760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401611067565b500160200151600160601b900490565b60605f6111a481808080610d75565b9050856001600160a01b031663d691c964826111c18888886112f4565b6040518363ffffffff1660e01b81526004016111de9291906119f6565b5f604051808303815f875af11580156111f9573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526112209190810190611a0e565b5f8151811061123157611231611962565b6020026020010151915050949350505050565b5f5f5f6112518686611323565b91509150815f036112755783818161126b5761126b611bc2565b049250505061112a565b81841161128c5761128c600385150260111861133f565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b606083838360405160200161130b93929190611bd6565b60405160208183030381529060405290509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f61112a6020830184611350565b5f5f602083850312156113a1575f5ffd5b82356001600160401b038111156113b6575f5ffd5b8301601f810185136113c6575f5ffd5b80356001600160401b038111156113db575f5ffd5b8560208284010111156113ec575f5ffd5b6020919091019590945092505050565b6001600160a01b0381168114611410575f5ffd5b50565b634e487b7160e01b5f52604160045260245ffd5b604080519081016001600160401b038111828210171561144957611449611413565b60405290565b604051606081016001600160401b038111828210171561144957611449611413565b604051601f8201601f191681016001600160401b038111828210171561149957611499611413565b604052919050565b5f6001600160401b038211156114b9576114b9611413565b50601f01601f191660200190565b5f82601f8301126114d6575f5ffd5b81356114e96114e4826114a1565b611471565b8181528460208386010111156114fd575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f5f5f6080858703121561152c575f5ffd5b8435611537816113fc565b93506020850135611547816113fc565b92506040850135611557816113fc565b915060608501356001600160401b03811115611571575f5ffd5b61157d878288016114c7565b91505092959194509250565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561160a57868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906115f490870182611350565b95505060209384019391909101906001016115af565b50929695505050505050565b5f60208284031215611626575f5ffd5b813561112a816113fc565b5f60208284031215611641575f5ffd5b5035919050565b5f6001600160401b0382111561166057611660611413565b5060051b60200190565b5f82601f830112611679575f5ffd5b81356116876114e482611648565b8082825260208201915060208360051b8601019250858311156116a8575f5ffd5b602085015b838110156116e85780356001600160401b038111156116ca575f5ffd5b6116d9886020838a01016114c7565b845250602092830192016116ad565b5095945050505050565b5f60208284031215611702575f5ffd5b81356001600160401b03811115611717575f5ffd5b820160408185031215611728575f5ffd5b611730611427565b81356001600160401b03811115611745575f5ffd5b8201601f81018613611755575f5ffd5b80356117636114e482611648565b8082825260208201915060208360051b850101925088831115611784575f5ffd5b6020840193505b828410156117af57833561179e816113fc565b82526020938401939091019061178b565b845250505060208201356001600160401b038111156117cc575f5ffd5b6117d88682850161166a565b602083015250949350505050565b6001600160a01b038481168252831660208201526060604082018190525f90610dd690830184611350565b5f82601f830112611820575f5ffd5b815161182e6114e4826114a1565b818152846020838601011115611842575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f6020828403121561186e575f5ffd5b81516001600160401b03811115611883575f5ffd5b8201601f81018413611893575f5ffd5b80516118a16114e482611648565b8082825260208201915060208360051b8501019250868311156118c2575f5ffd5b602084015b838110156119575780516001600160401b038111156118e4575f5ffd5b85016060818a03601f190112156118f9575f5ffd5b61190161144f565b602082015161190f816113fc565b81526040820151602082015260608201516001600160401b03811115611933575f5ffd5b6119428b602083860101611811565b604083015250845250602092830192016118c7565b509695505050505050565b634e487b7160e01b5f52603260045260245ffd5b805160208201516001600160e01b03198116919060048210156119ad576001600160e01b0319600483900360031b81901b82161692505b5050919050565b634e487b7160e01b5f52601160045260245ffd5b81810381811115610e1e57610e1e6119b4565b5f602082840312156119eb575f5ffd5b815161112a816113fc565b828152604060208201525f61047b6040830184611350565b5f60208284031215611a1e575f5ffd5b81516001600160401b03811115611a33575f5ffd5b8201601f81018413611a43575f5ffd5b8051611a516114e482611648565b8082825260208201915060208360051b850101925086831115611a72575f5ffd5b602084015b838110156119575780516001600160401b03811115611a94575f5ffd5b611aa389602083890101611811565b84525060209283019201611a77565b5f60208284031215611ac2575f5ffd5b81516003811061112a575f5ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60a0828403128015611af5575f5ffd5b5060405160a081016001600160401b0381118282101715611b1857611b18611413565b6040528251611b26816113fc565b8152602083810151908201526040830151611b40816113fc565b60408201526060830151611b53816113fc565b60608201526080830151611b66816113fc565b60808201529392505050565b5f60208284031215611b82575f5ffd5b5051919050565b80516020808301519190811015611ba9575f198160200360031b1b821691505b50919050565b80820180821115610e1e57610e1e6119b4565b634e487b7160e01b5f52601260045260245ffd5b6bffffffffffffffffffffffff198460601b1681528260148201525f82518060208501603485015e5f920160340191825250939250505056fea164736f6c634300081e000a",
 }
 
 // SuperExecutorABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperExecutorMetaData.ABI instead.
 var SuperExecutorABI = SuperExecutorMetaData.ABI
 
+// SuperExecutorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperExecutorMetaData.Bin instead.
+var SuperExecutorBin = SuperExecutorMetaData.Bin
+
+// DeploySuperExecutor deploys a new Ethereum contract, binding an instance of SuperExecutor to it.
+func DeploySuperExecutor(auth *bind.TransactOpts, backend bind.ContractBackend, ledgerConfiguration_ common.Address) (common.Address, *types.Transaction, *SuperExecutor, error) {
+	parsed, err := SuperExecutorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperExecutorBin), backend, ledgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperExecutor{SuperExecutorCaller: SuperExecutorCaller{contract: contract}, SuperExecutorTransactor: SuperExecutorTransactor{contract: contract}, SuperExecutorFilterer: SuperExecutorFilterer{contract: contract}}, nil
+}
+
 // SuperExecutor is an auto generated Go binding around an Ethereum contract.
 type SuperExecutor struct {
 	SuperExecutorCaller     // Read-only binding to the contract
@@ -32,12 +32,34 @@ var (
 // SuperLedgerMetaData contains all meta data concerning the SuperLedger contract.
 var SuperLedgerMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"ledgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"allowedExecutors_\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractISuperLedgerConfiguration\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"allowedExecutors\",\"inputs\":[{\"name\":\"executor\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"isAllowed\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"calculateCostBasisView\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSource\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"costBasis\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"previewFees\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"amountAssets\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"pps\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"decimals\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"feeAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"updateAccounting\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSource\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"isInflow\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"amountSharesOrAssets\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"feeAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"usersAccumulatorCostBasis\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSource\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"costBasis\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usersAccumulatorShares\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSource\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"shares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"event\",\"name\":\"AccountingInflow\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"yieldSource\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"pps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AccountingOutflow\",\"inputs\":[{\"name\":\"user\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"yieldSource\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"amount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"UsedSharesCapped\",\"inputs\":[{\"name\":\"originalVal\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"cappedVal\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"FEE_NOT_SET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"HOOK_NOT_FOUND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_FEE_PERCENT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_LEDGER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PRICE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MANAGER_NOT_SET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_MANAGER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ADDRESS_NOT_ALLOWED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ID_NOT_ALLOWED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_LENGTH\",\"inputs\":[]}]",
+	Bin: "0x60a060405234801561000f575f5ffd5b50604051610e6f380380610e6f83398101604081905261002e916100fa565b81816001600160a01b03821661005757604051636fe7b73760e01b815260040160405180910390fd5b6001600160a01b03821660805280515f5b818110156100c057600160025f858481518110610087576100876101d8565b6020908102919091018101516001600160a01b031682528101919091526040015f20805460ff1916911515919091179055600101610068565b5050505050506101ec565b80516001600160a01b03811681146100e1575f5ffd5b919050565b634e487b7160e01b5f52604160045260245ffd5b5f5f6040838503121561010b575f5ffd5b610114836100cb565b60208401519092506001600160401b0381111561012f575f5ffd5b8301601f8101851361013f575f5ffd5b80516001600160401b03811115610158576101586100e6565b604051600582901b90603f8201601f191681016001600160401b0381118282101715610186576101866100e6565b6040529182526020818401810192908101888411156101a3575f5ffd5b6020850194505b838510156101c9576101bb856100cb565b8152602094850194016101aa565b50809450505050509250929050565b634e487b7160e01b5f52603260045260245ffd5b608051610c6561020a5f395f818160ef01526103c60152610c655ff3fe608060405234801561000f575f5ffd5b506004361061007a575f3560e01c8063e4367cd311610058578063e4367cd314610129578063e70833d314610151578063f0512d2c1461017b578063fd8cd53f1461018e575f5ffd5b80632c119fca1461007e57806337cb6736146100b85780638717164a146100ea575b5f5ffd5b6100a561008c3660046108e0565b5f60208181529281526040808220909352908152205481565b6040519081526020015b60405180910390f35b6100da6100c6366004610917565b60026020525f908152604090205460ff1681565b60405190151581526020016100af565b6101117f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100af565b61013c610137366004610932565b6101a1565b604080519283526020830191909152016100af565b6100a561015f3660046108e0565b600160209081525f928352604080842090915290825290205481565b6100a5610189366004610970565b61020f565b6100a561019c3660046109cd565b610259565b6001600160a01b038084165f81815260208181526040808320948716808452948252808320549383526001825280832094835293905291822054829190818511156101ea578194505b5f85116101f7575f610202565b610202818684610275565b9794965093945050505050565b5f5f5f61021d8a8a896101a1565b915091508087146102405761023d818661023887600a610b2b565b610275565b97505b61024b828988610326565b9a9950505050505050505050565b5f61026887878787878761037f565b90505b9695505050505050565b5f5f5f61028286866106ed565b91509150815f036102a65783818161029c5761029c610b36565b049250505061031f565b8184116102bd576102bd6003851502601118610709565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150505b9392505050565b5f5f848411610335575f61033f565b61033f8585610b4a565b9050801561037757825f0361036757604051632e069cd360e01b815260040160405180910390fd5b6103748184612710610275565b91505b509392505050565b335f9081526002602052604081205460ff166103ae57604051633d83866f60e01b815260040160405180910390fd5b604051630b47673760e41b8152600481018690525f907f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa158015610413573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104379190610b6d565b60608101519091506001600160a01b031661046557604051638077659960e01b815260040160405180910390fd5b60808101516001600160a01b0316301461049257604051632a076cc160e11b815260040160405180910390fd5b805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa1580156104d9573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104fd9190610c00565b9050805f0361051f576040516325f9ce5d60e11b815260040160405180910390fd5b85156105f6578151604051636a24d41960e11b81526001600160a01b03808b1660048301526105a3928c9289928d92879291169063d449a83290602401602060405180830381865afa158015610577573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061059b9190610c17565b60ff1661071a565b815160408051878152602081018490526001600160a01b03808c1693811692908d16917f0739bc8e7ea8aa32e4aed717e94d5448d6c652c3d2c346961880a6878b9a2247910160405180910390a46106e1565b8151604051636a24d41960e11b81526001600160a01b038a811660048301525f92169063d449a83290602401602060405180830381865afa15801561063d573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906106619190610c17565b9050856106738b8b83898888886107a6565b9450896001600160a01b0316845f01516001600160a01b03168c6001600160a01b03167fc1eb34142f486694077c8fe62a1e5ea0af28fa50ecb14190c84a7f60ffbb05fd8a896040516106d0929190918252602082015260400190565b60405180910390a45050505061026b565b50509695505050505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b038086165f908152602081815260408083209387168352929052908120805486929061074e908490610c37565b909155506107649050848361023884600a610b2b565b6001600160a01b038087165f9081526001602090815260408083209388168352929052908120805490919061079a908490610c37565b90915550505050505050565b5f5f5f6107b48a8a896107f8565b915091508087146107d2576107cf818661023887600a610c4a565b97505b6020860151156107eb5761024b82898860200151610326565b5050979650505050505050565b5f5f6108058585856101a1565b909250905082811461084b5760408051848152602081018390527fcbf6e1ecca8662a52fbda88e401671949eb8ef33066b8afbc19ecca7f127b22d910160405180910390a15b6001600160a01b038086165f908152602081815260408083209388168352929052908120805483929061087f908490610b4a565b90915550506001600160a01b038086165f908152600160209081526040808320938816835292905290812080548492906108ba908490610b4a565b92505081905550935093915050565b6001600160a01b03811681146108dd575f5ffd5b50565b5f5f604083850312156108f1575f5ffd5b82356108fc816108c9565b9150602083013561090c816108c9565b809150509250929050565b5f60208284031215610927575f5ffd5b813561031f816108c9565b5f5f5f60608486031215610944575f5ffd5b833561094f816108c9565b9250602084013561095f816108c9565b929592945050506040919091013590565b5f5f5f5f5f5f5f60e0888a031215610986575f5ffd5b8735610991816108c9565b965060208801356109a1816108c9565b96999698505050506040850135946060810135946080820135945060a0820135935060c0909101359150565b5f5f5f5f5f5f60c087890312156109e2575f5ffd5b86356109ed816108c9565b955060208701356109fd816108c9565b94506040870135935060608701358015158114610a18575f5ffd5b9598949750929560808101359460a0909101359350915050565b634e487b7160e01b5f52601160045260245ffd5b6001815b6001841115610a8157808504811115610a6557610a65610a32565b6001841615610a7357908102905b60019390931c928002610a4a565b935093915050565b5f82610a9757506001610b25565b81610aa357505f610b25565b8160018114610ab95760028114610ac357610adf565b6001915050610b25565b60ff841115610ad457610ad4610a32565b50506001821b610b25565b5060208310610133831016604e8410600b8410161715610b02575081810a610b25565b610b0e5f198484610a46565b805f1904821115610b2157610b21610a32565b0290505b92915050565b5f61031f8383610a89565b634e487b7160e01b5f52601260045260245ffd5b81810381811115610b2557610b25610a32565b8051610b68816108c9565b919050565b5f60a0828403128015610b7e575f5ffd5b5060405160a0810167ffffffffffffffff81118282101715610bae57634e487b7160e01b5f52604160045260245ffd5b604052610bba83610b5d565b815260208381015190820152610bd260408401610b5d565b6040820152610be360608401610b5d565b6060820152610bf460808401610b5d565b60808201529392505050565b5f60208284031215610c10575f5ffd5b5051919050565b5f60208284031215610c27575f5ffd5b815160ff8116811461031f575f5ffd5b80820180821115610b2557610b25610a32565b5f61031f60ff841683610a8956fea164736f6c634300081e000a",
 }
 
 // SuperLedgerABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperLedgerMetaData.ABI instead.
 var SuperLedgerABI = SuperLedgerMetaData.ABI
 
+// SuperLedgerBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperLedgerMetaData.Bin instead.
+var SuperLedgerBin = SuperLedgerMetaData.Bin
+
+// DeploySuperLedger deploys a new Ethereum contract, binding an instance of SuperLedger to it.
+func DeploySuperLedger(auth *bind.TransactOpts, backend bind.ContractBackend, ledgerConfiguration_ common.Address, allowedExecutors_ []common.Address) (common.Address, *types.Transaction, *SuperLedger, error) {
+	parsed, err := SuperLedgerMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperLedgerBin), backend, ledgerConfiguration_, allowedExecutors_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperLedger{SuperLedgerCaller: SuperLedgerCaller{contract: contract}, SuperLedgerTransactor: SuperLedgerTransactor{contract: contract}, SuperLedgerFilterer: SuperLedgerFilterer{contract: contract}}, nil
+}
+
 // SuperLedger is an auto generated Go binding around an Ethereum contract.
 type SuperLedger struct {
 	SuperLedgerCaller     // Read-only binding to the contract
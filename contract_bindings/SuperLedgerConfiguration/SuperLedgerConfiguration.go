@@ -49,12 +49,34 @@ type ISuperLedgerConfigurationYieldSourceOracleConfigArgs struct {
 // SuperLedgerConfigurationMetaData contains all meta data concerning the SuperLedgerConfiguration contract.
 var SuperLedgerConfigurationMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"function\",\"name\":\"acceptManagerRole\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"acceptYieldSourceOracleConfigProposal\",\"inputs\":[{\"name\":\"yieldSourceOracleIds\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"cancelYieldSourceOracleConfigProposal\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"getAllYieldSourceOracleIdsByOwner\",\"inputs\":[{\"name\":\"owner\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getYieldSourceOracleConfig\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structISuperLedgerConfiguration.YieldSourceOracleConfig\",\"components\":[{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"manager\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getYieldSourceOracleConfigs\",\"inputs\":[{\"name\":\"yieldSourceOracleIds\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"}],\"outputs\":[{\"name\":\"configs\",\"type\":\"tuple[]\",\"internalType\":\"structISuperLedgerConfiguration.YieldSourceOracleConfig[]\",\"components\":[{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"manager\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"proposeYieldSourceOracleConfig\",\"inputs\":[{\"name\":\"yieldSourceOracleIds\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"},{\"name\":\"configs\",\"type\":\"tuple[]\",\"internalType\":\"structISuperLedgerConfiguration.YieldSourceOracleConfigArgs[]\",\"components\":[{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setYieldSourceOracles\",\"inputs\":[{\"name\":\"salts\",\"type\":\"bytes32[]\",\"internalType\":\"bytes32[]\"},{\"name\":\"configs\",\"type\":\"tuple[]\",\"internalType\":\"structISuperLedgerConfiguration.YieldSourceOracleConfigArgs[]\",\"components\":[{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"internalType\":\"address\"}]}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"transferManagerRole\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"newManager\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"ManagerRoleTransferAccepted\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"newManager\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"ManagerRoleTransferStarted\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"currentManager\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"newManager\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"YieldSourceOracleConfigAccepted\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"manager\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"YieldSourceOracleConfigProposalCancelled\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"manager\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"YieldSourceOracleConfigProposalSet\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"manager\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"YieldSourceOracleConfigSet\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"indexed\":true,\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"feePercent\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"feeRecipient\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"manager\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"},{\"name\":\"ledger\",\"type\":\"address\",\"indexed\":false,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"CANNOT_ACCEPT_YET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CHANGE_ALREADY_PROPOSED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CONFIG_EXISTS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CONFIG_NOT_FOUND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_FEE_PERCENT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"MANAGER_NOT_MATCHED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_MANAGER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_PENDING_MANAGER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NO_PENDING_PROPOSAL\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ADDRESS_NOT_ALLOWED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ID_NOT_ALLOWED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_LENGTH\",\"inputs\":[]}]",
+	Bin: "0x6080604052348015600e575f5ffd5b506117308061001c5f395ff3fe608060405234801561000f575f5ffd5b5060043610610090575f3560e01c8063b6fd1b5211610063578063b6fd1b5214610184578063cd708da7146101a4578063dd3cf00b146101b7578063edd95d06146101ca578063fe46d282146101dd575f5ffd5b8063351166c61461009457806397d4c17f146100bd5780639ffba1fb146100d2578063b4767370146100e5575b5f5ffd5b6100a76100a2366004611426565b6101f0565b6040516100b491906114a2565b60405180910390f35b6100d06100cb3660046114ef565b610317565b005b6100d06100e036600461158b565b6107de565b6101776100f336600461158b565b6040805160a0810182525f80825260208201819052918101829052606081018290526080810191909152505f9081526020818152604091829020825160a08101845281546001600160a01b03908116825260018301549382019390935260028201548316938101939093526003810154821660608401526004015416608082015290565b6040516100b491906115a2565b6101976101923660046115cb565b610875565b6040516100b491906115e4565b6100d06101b236600461158b565b6108de565b6100d06101c536600461161b565b610b3a565b6100d06101d83660046114ef565b610c36565b6100d06101eb366004611426565b610cfe565b6060818067ffffffffffffffff81111561020c5761020c611645565b60405190808252806020026020018201604052801561026357816020015b6040805160a0810182525f808252602080830182905292820181905260608201819052608082015282525f1990920191018161022a5790505b5091505f5b8181101561030f575f5f86868481811061028457610284611659565b602090810292909201358352508181019290925260409081015f20815160a08101835281546001600160a01b039081168252600183015494820194909452600282015484169281019290925260038101548316606083015260040154909116608082015283518490839081106102fc576102fc611659565b6020908102919091010152600101610268565b505092915050565b805f819003610339576040516358f3f89d60e01b815260040160405180910390fd5b8084146103595760405163899ef10d60e01b815260040160405180910390fd5b5f5b818110156107d6573684848381811061037657610376611659565b90506080020190505f5f5f89898681811061039357610393611659565b602090810292909201358352508181019290925260409081015f20815160a08101835281546001600160a01b039081168252600183015494820194909452600282015484169281019290925260038101548316606083015260040154909116608082018190529091501580610413575060608101516001600160a01b0316155b156104315760405163259d781b60e21b815260040160405180910390fd5b60608101516001600160a01b0316331461045e57604051634df0b5ad60e11b815260040160405180910390fd5b4260025f8a8a8781811061047457610474611659565b9050602002013581526020019081526020015f205411156104a85760405163345eeddb60e21b815260040160405180910390fd5b60208101511561053f5760208201351561053a575f6104df82602001516113886127106104d59190611681565b6127106001610fc8565b90505f61050283602001516113886127106104fa9190611694565b612710611013565b905081846020013510806105195750808460200135115b15610537576040516342c9b52360e01b815260040160405180910390fd5b50505b610580565b602081015115801561055457505f8260200135115b15610580576109c482602001351115610580576040516342c9b52360e01b815260040160405180910390fd5b6105d888888581811061059557610595611659565b90506020020135835f0160208101906105ae91906115cb565b60208501356105c360608701604088016115cb565b6105d360808801606089016115cb565b6110c4565b6040805160a08101909152806105f160208501856115cb565b6001600160a01b031681526020018360200135815260200183604001602081019061061c91906115cb565b6001600160a01b0316815260200182606001516001600160a01b0316815260200183606001602081019061065091906115cb565b6001600160a01b0316905260015f8a8a8781811061067057610670611659565b602090810292909201358352508181019290925260409081015f20835181546001600160a01b03199081166001600160a01b039283161783559385015160018301559184015160028201805485169184169190911790556060840151600382018054851691841691909117905560809093015160049093018054909216921691909117905561070262093a8042611694565b60025f8a8a8781811061071757610717611659565b9050602002013581526020019081526020015f2081905550815f01602081019061074191906115cb565b6001600160a01b031688888581811061075c5761075c611659565b905060200201357f573d854ab453d79ebca19a017648a4f975f0550a209a72f386a1682e196c5b65846020013585604001602081019061079c91906115cb565b85606001518760600160208101906107b491906115cb565b6040516107c494939291906116a7565b60405180910390a3505060010161035b565b505050505050565b5f818152600460205260409020546001600160a01b0316331461081457604051631f75f4ff60e11b815260040160405180910390fd5b5f818152602081815260408083206003018054336001600160a01b031991821681179092556004909352818420805490931690925551909183917fb129e473bec7ed12bc0faacf7e7da6677f0b79f93ca6e88667329193166b007d9190a350565b6001600160a01b0381165f908152600360209081526040918290208054835181840281018401909452808452606093928301828280156108d257602002820191905f5260205f20905b8154815260200190600101908083116108be575b50505050509050919050565b5f818152602081905260409020600301546001600160a01b0316331461091757604051634df0b5ad60e11b815260040160405180910390fd5b5f818152600260205260408120549003610944576040516373f8011760e01b815260040160405180910390fd5b5f60015f8381526020019081526020015f206040518060a00160405290815f82015f9054906101000a90046001600160a01b03166001600160a01b03166001600160a01b0316815260200160018201548152602001600282015f9054906101000a90046001600160a01b03166001600160a01b03166001600160a01b03168152602001600382015f9054906101000a90046001600160a01b03166001600160a01b03166001600160a01b03168152602001600482015f9054906101000a90046001600160a01b03166001600160a01b03166001600160a01b031681525050905060015f8381526020019081526020015f205f5f82015f6101000a8154906001600160a01b030219169055600182015f9055600282015f6101000a8154906001600160a01b030219169055600382015f6101000a8154906001600160a01b030219169055600482015f6101000a8154906001600160a01b030219169055505060025f8381526020019081526020015f205f9055817fae0e289a9d3651a0fff20fac99910f80b76acfe3d401d1dc4433ada542be7790825f01518360200151846040015185606001518660800151604051610b2e9594939291906001600160a01b0395861681526020810194909452918416604084015283166060830152909116608082015260a00190565b60405180910390a25050565b5f8281526020818152604091829020825160a08101845281546001600160a01b0390811682526001830154938201939093526002820154831693810193909352600381015482166060840181905260049091015490911660808301523314610bb557604051634df0b5ad60e11b815260040160405180910390fd5b6001600160a01b038216610bdc57604051636fe7b73760e01b815260040160405180910390fd5b5f8381526004602052604080822080546001600160a01b0319166001600160a01b03861690811790915590519091339186917fe7c22852fbb0b4ee248bdc050be6600234e1f5b3b5d748ce19463a1bdb47547b91a4505050565b805f819003610c58576040516358f3f89d60e01b815260040160405180910390fd5b808414610c785760405163899ef10d60e01b815260040160405180910390fd5b5f5b818110156107d65736848483818110610c9557610c95611659565b9050608002019050610cf5878784818110610cb257610cb2611659565b90506020020135825f016020810190610ccb91906115cb565b6020840135610ce060608601604087016115cb565b610cf060808701606088016115cb565b611181565b50600101610c7a565b805f819003610d20576040516358f3f89d60e01b815260040160405180910390fd5b5f5b81811015610fc2575f848483818110610d3d57610d3d611659565b602090810292909201355f81815260018085526040808320815160a0808201845282546001600160a01b03908116835283860154838b0152600280850154821684870152600380860154831660608087019190915260049687015484166080808801919091528b8b528a8e529988902088519586018952805485168652988901549c85019c909c529087015482169583019590955293850154841698810198909852920154811692860192909252805192955093925016158015610e0c575060408201516001600160a01b0316155b8015610e23575060808201516001600160a01b0316155b15610e415760405163259d781b60e21b815260040160405180910390fd5b60608101516001600160a01b03163314610e6e57604051634df0b5ad60e11b815260040160405180910390fd5b6060808201516001600160a01b0316908301525f83815260026020526040902054421015610eaf57604051630779faef60e11b815260040160405180910390fd5b5f83815260208181526040808320855181546001600160a01b03199081166001600160a01b03928316178355878501805160018086019190915589860180516002808801805487169288169290921790915560608c0180516003808a0180548916928a169290921790915560808e01805160049a8b0180548a16918b16919091179055858c528a8d208054891681559586018d90558584018054891690559085018054881690559390970180549095169094559290965284872096909655875195519051925194519351959091169488947fc20741f4f1b5c8af4594296c1807952e9d77ea10c701b4cba482967d82b62a2494610fac94926116a7565b60405180910390a3505050806001019050610d22565b50505050565b5f610ff5610fd583611337565b8015610ff057505f8480610feb57610feb6116ce565b868809115b151590565b611000868686611013565b61100a9190611694565b95945050505050565b5f5f5f6110208686611363565b91509150815f036110445783818161103a5761103a6116ce565b04925050506110bd565b81841161105b5761105b600385150260111861137f565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150505b9392505050565b6001600160a01b0384166110eb57604051636fe7b73760e01b815260040160405180910390fd5b6001600160a01b03821661111257604051636fe7b73760e01b815260040160405180910390fd5b6001600160a01b03811661113957604051636fe7b73760e01b815260040160405180910390fd5b61138883111561115c576040516342c9b52360e01b815260040160405180910390fd5b8461117a57604051636465ff8760e01b815260040160405180910390fd5b5050505050565b61118e85858585856110c4565b5f6111998633611390565b335f9081526003602081815260408084208054600181810183559186528386200186905585855284835293819020815160a08101835281546001600160a01b039081168252958201549381019390935260028101548516918301919091529182015483166060820181905260049092015490921660808301529192509015801590611230575060808101516001600160a01b031615155b1561124e57604051636d50011360e11b815260040160405180910390fd5b6040805160a0810182526001600160a01b0380891680835260208084018a815289841685870190815233606087018181528b8716608089019081525f8c81529586905294899020975188549088166001600160a01b0319918216178955935160018901559151600288018054918816918516919091179055905160038701805491871691841691909117905591516004909501805495909416941693909317909155915184917f7ab0c7c67b786595d83b9e3657bb135d591d1c8847e4211cf8a4a7bf0eb6535491611326918a918a91908a906116a7565b60405180910390a350505050505050565b5f600282600381111561134c5761134c6116e2565b61135691906116f6565b60ff166001149050919050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b5f82826040516020016113bf92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b6040516020818303038152906040528051906020012090505b92915050565b5f5f83601f8401126113ee575f5ffd5b50813567ffffffffffffffff811115611405575f5ffd5b6020830191508360208260051b850101111561141f575f5ffd5b9250929050565b5f5f60208385031215611437575f5ffd5b823567ffffffffffffffff81111561144d575f5ffd5b611459858286016113de565b90969095509350505050565b80516001600160a01b0390811683526020808301519084015260408083015182169084015260608083015182169084015260809182015116910152565b602080825282518282018190525f918401906040840190835b818110156114e4576114ce838551611465565b6020939093019260a092909201916001016114bb565b509095945050505050565b5f5f5f5f60408587031215611502575f5ffd5b843567ffffffffffffffff811115611518575f5ffd5b611524878288016113de565b909550935050602085013567ffffffffffffffff811115611543575f5ffd5b8501601f81018713611553575f5ffd5b803567ffffffffffffffff811115611569575f5ffd5b8760208260071b840101111561157d575f5ffd5b949793965060200194505050565b5f6020828403121561159b575f5ffd5b5035919050565b60a081016113d88284611465565b80356001600160a01b03811681146115c6575f5ffd5b919050565b5f602082840312156115db575f5ffd5b6110bd826115b0565b602080825282518282018190525f918401906040840190835b818110156114e45783518352602093840193909201916001016115fd565b5f5f6040838503121561162c575f5ffd5b8235915061163c602084016115b0565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b634e487b7160e01b5f52603260045260245ffd5b634e487b7160e01b5f52601160045260245ffd5b818103818111156113d8576113d861166d565b808201808211156113d8576113d861166d565b9384526001600160a01b039283166020850152908216604084015216606082015260800190565b634e487b7160e01b5f52601260045260245ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60ff83168061171457634e487b7160e01b5f52601260045260245ffd5b8060ff8416069150509291505056fea164736f6c634300081e000a",
 }
 
 // SuperLedgerConfigurationABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperLedgerConfigurationMetaData.ABI instead.
 var SuperLedgerConfigurationABI = SuperLedgerConfigurationMetaData.ABI
 
+// SuperLedgerConfigurationBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperLedgerConfigurationMetaData.Bin instead.
+var SuperLedgerConfigurationBin = SuperLedgerConfigurationMetaData.Bin
+
+// DeploySuperLedgerConfiguration deploys a new Ethereum contract, binding an instance of SuperLedgerConfiguration to it.
+func DeploySuperLedgerConfiguration(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SuperLedgerConfiguration, error) {
+	parsed, err := SuperLedgerConfigurationMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperLedgerConfigurationBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperLedgerConfiguration{SuperLedgerConfigurationCaller: SuperLedgerConfigurationCaller{contract: contract}, SuperLedgerConfigurationTransactor: SuperLedgerConfigurationTransactor{contract: contract}, SuperLedgerConfigurationFilterer: SuperLedgerConfigurationFilterer{contract: contract}}, nil
+}
+
 // SuperLedgerConfiguration is an auto generated Go binding around an Ethereum contract.
 type SuperLedgerConfiguration struct {
 	SuperLedgerConfigurationCaller     // Read-only binding to the contract
@@ -85,12 +85,34 @@ type PackedUserOperation struct {
 // SuperNativePaymasterMetaData contains all meta data concerning the SuperNativePaymaster contract.
 var SuperNativePaymasterMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_entryPoint\",\"type\":\"address\",\"internalType\":\"contractIEntryPoint\"}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"calculateRefund\",\"inputs\":[{\"name\":\"maxGasLimit\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualGasCost\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"nodeOperatorPremium\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"refund\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"entryPoint\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIEntryPoint\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getDeposit\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"handleOps\",\"inputs\":[{\"name\":\"ops\",\"type\":\"tuple[]\",\"internalType\":\"structPackedUserOperation[]\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"postOp\",\"inputs\":[{\"name\":\"mode\",\"type\":\"uint8\",\"internalType\":\"enumIPaymaster.PostOpMode\"},{\"name\":\"context\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"actualGasCost\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"actualUserOpFeePerGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"simulateHandleOp\",\"inputs\":[{\"name\":\"op\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIEntryPointSimulations.ExecutionResult\",\"components\":[{\"name\":\"preOpGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paid\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"accountValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"targetSuccess\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"targetResult\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"simulateValidation\",\"inputs\":[{\"name\":\"op\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"outputs\":[{\"name\":\"\",\"type\":\"tuple\",\"internalType\":\"structIEntryPointSimulations.ValidationResult\",\"components\":[{\"name\":\"returnInfo\",\"type\":\"tuple\",\"internalType\":\"structIEntryPoint.ReturnInfo\",\"components\":[{\"name\":\"preOpGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"prefund\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"accountValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterValidationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"paymasterContext\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"senderInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"factoryInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"paymasterInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"name\":\"aggregatorInfo\",\"type\":\"tuple\",\"internalType\":\"structIEntryPoint.AggregatorStakeInfo\",\"components\":[{\"name\":\"aggregator\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"stakeInfo\",\"type\":\"tuple\",\"internalType\":\"structIStakeManager.StakeInfo\",\"components\":[{\"name\":\"stake\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"unstakeDelaySec\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]}]}]}],\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"validatePaymasterUserOp\",\"inputs\":[{\"name\":\"userOp\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"userOpHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"maxCost\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"context\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"validationData\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"SuperNativePaymasterPostOp\",\"inputs\":[{\"name\":\"context\",\"type\":\"bytes\",\"indexed\":false,\"internalType\":\"bytes\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"SuperNativePaymasterRefund\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"refundAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"initialRefund\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"UserOperationsHandled\",\"inputs\":[{\"name\":\"sender\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"numOps\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"initialAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"},{\"name\":\"withdrawnAmount\",\"type\":\"uint256\",\"indexed\":false,\"internalType\":\"uint256\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"EMPTY_MESSAGE_VALUE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INSUFFICIENT_BALANCE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_MAX_GAS_LIMIT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_NODE_OPERATOR_PREMIUM\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ADDRESS\",\"inputs\":[]}]",
+	Bin: "0x60a0604052604051611a13380380611a13833981016040819052610022916100fe565b8061002c8161003e565b6001600160a01b03166080525061014a565b6040516301ffc9a760e01b815263122a0e9b60e31b60048201526001600160a01b038216906301ffc9a790602401602060405180830381865afa158015610087573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906100ab919061012b565b6100fb5760405162461bcd60e51b815260206004820152601e60248201527f49456e747279506f696e7420696e74657266616365206d69736d617463680000604482015260640160405180910390fd5b50565b5f6020828403121561010e575f5ffd5b81516001600160a01b0381168114610124575f5ffd5b9392505050565b5f6020828403121561013b575f5ffd5b81518015158114610124575f5ffd5b60805161186b6101a85f395f818161014101528181610246015281816102ec01528181610368015281816103fa0152818161051701528181610625015281816106c60152818161086f01528181610a120152610abf015261186b5ff3fe608060405260043610610079575f3560e01c806397b2dcb91161004c57806397b2dcb914610110578063b0d691fe14610130578063c399ec881461017b578063c3bce0091461018f575f5ffd5b806324a29b4f1461007d57806352b7512c146100af57806357956b58146100dc5780637c627b21146100f1575b5f5ffd5b348015610088575f5ffd5b5061009c610097366004610d0d565b6101af565b6040519081526020015b60405180910390f35b3480156100ba575f5ffd5b506100ce6100c9366004610d53565b61021a565b6040516100a6929190610dca565b6100ef6100ea366004610deb565b61023c565b005b3480156100fc575f5ffd5b506100ef61010b366004610e9e565b6104a2565b61012361011e366004610f29565b6104be565b6040516100a69190610fa0565b34801561013b575f5ffd5b506101637f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100a6565b348015610186575f5ffd5b5061009c61060e565b6101a261019d366004610ff6565b61069b565b6040516100a69190611027565b5f6127108211156101d357604051634ac0eaf160e01b815260040160405180910390fd5b5f6101ec846101e48561271061110d565b6127106107b4565b90505f6101f98688611120565b9050808210156102105761020d8282611137565b92505b5050949350505050565b60605f610225610864565b6102308585856108da565b91509150935093915050565b4780156102d5575f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316826040515f6040518083038185875af1925050503d805f81146102ac576040519150601f19603f3d011682016040523d82523d5f602084013e6102b1565b606091505b50509050806102d357604051632858f9ab60e11b815260040160405180910390fd5b505b60405163765e827f60e01b81526001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000169063765e827f9061032590869086903390600401611288565b5f604051808303815f87803b15801561033c575f5ffd5b505af115801561034e573d5f5f3e3d5ffd5b5050604051632943e70960e11b81523060048201525f92507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169150635287ce129060240160a060405180830381865afa1580156103b6573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103da91906113a1565b5160405163040b850f60e31b8152336004820152602481018290529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063205c2878906044015f604051808303815f87803b158015610443575f5ffd5b505af1158015610455573d5f5f3e3d5ffd5b505060408051868152602081018690529081018490523392507fc2983a53d118d29e96e7fe9b12bc6892b76bee666f67db5b9168badefb6f6b38915060600160405180910390a250505050565b6104aa610864565b6104b785858585856109a5565b5050505050565b6104f46040518060c001604052805f81526020015f81526020015f81526020015f81526020015f15158152602001606081525090565b345f036105145760405163c894453360e01b815260040160405180910390fd5b5f7f000000000000000000000000000000000000000000000000000000000000000060405163b760faf960e01b81523060048201529091506001600160a01b0382169063b760faf99034906024015f604051808303818588803b158015610579575f5ffd5b505af115801561058b573d5f5f3e3d5ffd5b50506040516397b2dcb960e01b81526001600160a01b03851693506397b2dcb992506105c291508990899089908990600401611434565b5f604051808303815f875af11580156105dd573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f1916820160405261060491908101906114fc565b9695505050505050565b6040516370a0823160e01b81523060048201525f907f00000000000000000000000000000000000000000000000000000000000000006001600160a01b0316906370a0823190602401602060405180830381865afa158015610672573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610696919061159f565b905090565b6106a3610c2d565b345f036106c35760405163c894453360e01b815260040160405180910390fd5b5f7f000000000000000000000000000000000000000000000000000000000000000060405163b760faf960e01b81523060048201529091506001600160a01b0382169063b760faf99034906024015f604051808303818588803b158015610728575f5ffd5b505af115801561073a573d5f5f3e3d5ffd5b505060405163c3bce00960e01b81526001600160a01b038516935063c3bce009925061076b915086906004016115b6565b5f604051808303815f875af1158015610786573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526107ad9190810190611636565b9392505050565b5f5f5f6107c18686610ba7565b91509150815f036107e5578381816107db576107db611742565b04925050506107ad565b8184116107fc576107fc6003851502601118610bc3565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146108d85760405162461bcd60e51b815260206004820152601560248201527414d95b99195c881b9bdd08115b9d1c9e541bda5b9d605a1b604482015260640160405180910390fd5b565b60605f8080806108ed60e0890189611756565b6108fb916034908290611798565b81019061090891906117bf565b92509250925061271082111561093157604051634ac0eaf160e01b815260040160405180910390fd5b61093e60208901896117e8565b61094789610bd4565b6109508a610bf3565b604080516001600160a01b03909416602085015283019190915260608201526080810184905260a0810183905260c0810182905260e00160408051601f19818403018152919052985f98509650505050505050565b5f80808080806109b7898b018b611803565b9550955095509550955095505f6109ce8686610c01565b90506109da8183611120565b6109e4908a61110d565b98505f6109f385888c876101af565b90508015610b5f57604051632943e70960e11b81523060048201525f907f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031690635287ce129060240160a060405180830381865afa158015610a5f573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a8391906113a1565b5190505f818311610a945782610a96565b815b60405163040b850f60e31b81526001600160a01b038c81166004830152602482018390529192507f00000000000000000000000000000000000000000000000000000000000000009091169063205c2878906044015f604051808303815f87803b158015610b02575f5ffd5b505af1158015610b14573d5f5f3e3d5ffd5b505060408051848152602081018790526001600160a01b038e1693507ff311b1f65baf2e52d246ec3fc2cb4f5698f0622142592e4e5794b09746e0d06b92500160405180910390a250505b7f34b2c95a547acd4b4fa4f6947733d3b663b320b4c98ce28006f00f0d4756de548c8c604051610b9092919061184b565b60405180910390a150505050505050505050505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b5f6fffffffffffffffffffffffffffffffff60c0830135165b92915050565b5f60c082013560801c610bed565b5f818303610c10575081610bed565b6107ad83610c1e488561110d565b5f8282188284100282186107ad565b6040518060a00160405280610c666040518060a001604052805f81526020015f81526020015f81526020015f8152602001606081525090565b8152602001610c8660405180604001604052805f81526020015f81525090565b8152602001610ca660405180604001604052805f81526020015f81525090565b8152602001610cc660405180604001604052805f81526020015f81525090565b8152602001610cd3610cd8565b905290565b60405180604001604052805f6001600160a01b03168152602001610cd360405180604001604052805f81526020015f81525090565b5f5f5f5f60808587031215610d20575f5ffd5b5050823594602084013594506040840135936060013592509050565b5f6101208284031215610d4d575f5ffd5b50919050565b5f5f5f60608486031215610d65575f5ffd5b83356001600160401b03811115610d7a575f5ffd5b610d8686828701610d3c565b9660208601359650604090950135949350505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b604081525f610ddc6040830185610d9c565b90508260208301529392505050565b5f5f60208385031215610dfc575f5ffd5b82356001600160401b03811115610e11575f5ffd5b8301601f81018513610e21575f5ffd5b80356001600160401b03811115610e36575f5ffd5b8560208260051b8401011115610e4a575f5ffd5b6020919091019590945092505050565b5f5f83601f840112610e6a575f5ffd5b5081356001600160401b03811115610e80575f5ffd5b602083019150836020828501011115610e97575f5ffd5b9250929050565b5f5f5f5f5f60808688031215610eb2575f5ffd5b853560038110610ec0575f5ffd5b945060208601356001600160401b03811115610eda575f5ffd5b610ee688828901610e5a565b9699909850959660408101359660609091013595509350505050565b6001600160a01b0381168114610f16575f5ffd5b50565b8035610f2481610f02565b919050565b5f5f5f5f60608587031215610f3c575f5ffd5b84356001600160401b03811115610f51575f5ffd5b610f5d87828801610d3c565b9450506020850135610f6e81610f02565b925060408501356001600160401b03811115610f88575f5ffd5b610f9487828801610e5a565b95989497509550505050565b60208152815160208201526020820151604082015260408201516060820152606082015160808201526080820151151560a08201525f60a083015160c080840152610fee60e0840182610d9c565b949350505050565b5f60208284031215611006575f5ffd5b81356001600160401b0381111561101b575f5ffd5b610fee84828501610d3c565b602080825282516101408383015280516101608401529081015161018083015260408101516101a083015260608101516101c08301526080015160a06101e08301525f90611079610200840182610d9c565b90506020840151611097604085018280518252602090810151910152565b506040840151805160808581019190915260209182015160a08601526060860151805160c087015282015160e086015285015180516001600160a01b031661010086015280820151805161012087015290910151610140850152509392505050565b634e487b7160e01b5f52601160045260245ffd5b80820180821115610bed57610bed6110f9565b8082028115828204841417610bed57610bed6110f9565b81810381811115610bed57610bed6110f9565b5f5f8335601e1984360301811261115f575f5ffd5b83016020810192503590506001600160401b0381111561117d575f5ffd5b803603821315610e97575f5ffd5b81835281816020850137505f828201602090810191909152601f909101601f19169091010190565b6111cd826111c083610f19565b6001600160a01b03169052565b602081810135908301525f6111e5604083018361114a565b61012060408601526111fc6101208601828461118b565b91505061120c606084018461114a565b858303606087015261121f83828461118b565b6080868101359088015260a0808701359088015260c08087013590880152925061124f91505060e084018461114a565b85830360e087015261126283828461118b565b9250505061127461010084018461114a565b85830361010087015261060483828461118b565b604080825281018390525f6060600585901b83018101908301868361011e1936839003015b888210156112f157868503605f1901845282358181126112cb575f5ffd5b6112d7868c83016111b3565b9550506020830192506020840193506001820191506112ad565b505050506001600160a01b0393909316602092909201919091525092915050565b634e487b7160e01b5f52604160045260245ffd5b60405160a081016001600160401b038111828210171561134857611348611312565b60405290565b60405160c081016001600160401b038111828210171561134857611348611312565b604080519081016001600160401b038111828210171561134857611348611312565b80518015158114610f24575f5ffd5b5f60a08284031280156113b2575f5ffd5b506113bb611326565b825181526113cb60208401611392565b602082015260408301516dffffffffffffffffffffffffffff811681146113f0575f5ffd5b6040820152606083015163ffffffff8116811461140b575f5ffd5b6060820152608083015165ffffffffffff81168114611428575f5ffd5b60808201529392505050565b606081525f61144660608301876111b3565b6001600160a01b0386166020840152828103604084015261146881858761118b565b979650505050505050565b5f82601f830112611482575f5ffd5b81516001600160401b0381111561149b5761149b611312565b604051601f8201601f19908116603f011681016001600160401b03811182821017156114c9576114c9611312565b6040528181528382016020018510156114e0575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f6020828403121561150c575f5ffd5b81516001600160401b03811115611521575f5ffd5b820160c08185031215611532575f5ffd5b61153a61134e565b8151815260208083015190820152604080830151908201526060808301519082015261156860808301611392565b608082015260a08201516001600160401b03811115611585575f5ffd5b61159186828501611473565b60a083015250949350505050565b5f602082840312156115af575f5ffd5b5051919050565b602081525f6107ad60208301846111b3565b5f604082840312156115d8575f5ffd5b6115e0611370565b825181526020928301519281019290925250919050565b5f60608284031215611607575f5ffd5b61160f611370565b9050815161161c81610f02565b815261162b83602084016115c8565b602082015292915050565b5f60208284031215611646575f5ffd5b81516001600160401b0381111561165b575f5ffd5b8201610140818503121561166d575f5ffd5b611675611326565b81516001600160401b0381111561168a575f5ffd5b820160a0818703121561169b575f5ffd5b6116a3611326565b8151815260208083015190820152604080830151908201526060808301519082015260808201516001600160401b038111156116dd575f5ffd5b6116e988828501611473565b6080830152508252506116ff85602084016115c8565b602082015261171185606084016115c8565b60408201526117238560a084016115c8565b60608201526117358560e084016115f7565b6080820152949350505050565b634e487b7160e01b5f52601260045260245ffd5b5f5f8335601e1984360301811261176b575f5ffd5b8301803591506001600160401b03821115611784575f5ffd5b602001915036819003821315610e97575f5ffd5b5f5f858511156117a6575f5ffd5b838611156117b2575f5ffd5b5050820193919092039150565b5f5f5f606084860312156117d1575f5ffd5b505081359360208301359350604090920135919050565b5f602082840312156117f8575f5ffd5b81356107ad81610f02565b5f5f5f5f5f5f60c08789031215611818575f5ffd5b863561182381610f02565b9860208801359850604088013597606081013597506080810135965060a00135945092505050565b602081525f610fee60208301848661118b56fea164736f6c634300081e000a",
 }
 
 // SuperNativePaymasterABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperNativePaymasterMetaData.ABI instead.
 var SuperNativePaymasterABI = SuperNativePaymasterMetaData.ABI
 
+// SuperNativePaymasterBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperNativePaymasterMetaData.Bin instead.
+var SuperNativePaymasterBin = SuperNativePaymasterMetaData.Bin
+
+// DeploySuperNativePaymaster deploys a new Ethereum contract, binding an instance of SuperNativePaymaster to it.
+func DeploySuperNativePaymaster(auth *bind.TransactOpts, backend bind.ContractBackend, _entryPoint common.Address) (common.Address, *types.Transaction, *SuperNativePaymaster, error) {
+	parsed, err := SuperNativePaymasterMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperNativePaymasterBin), backend, _entryPoint)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperNativePaymaster{SuperNativePaymasterCaller: SuperNativePaymasterCaller{contract: contract}, SuperNativePaymasterTransactor: SuperNativePaymasterTransactor{contract: contract}, SuperNativePaymasterFilterer: SuperNativePaymasterFilterer{contract: contract}}, nil
+}
+
 // SuperNativePaymaster is an auto generated Go binding around an Ethereum contract.
 type SuperNativePaymaster struct {
 	SuperNativePaymasterCaller     // Read-only binding to the contract
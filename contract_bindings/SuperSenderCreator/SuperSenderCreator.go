@@ -32,12 +32,34 @@ var (
 // SuperSenderCreatorMetaData contains all meta data concerning the SuperSenderCreator contract.
 var SuperSenderCreatorMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"function\",\"name\":\"createSender\",\"inputs\":[{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"}]",
+	Bin: "0x6080604052348015600e575f5ffd5b5061028f8061001c5f395ff3fe608060405234801561000f575f5ffd5b5060043610610029575f3560e01c8063570e1a361461002d575b5f5ffd5b61004061003b36600461015d565b61005c565b6040516001600160a01b03909116815260200160405180910390f35b5f601482101561006d57505f610157565b5f61007b60148285876101cb565b610084916101f2565b60601c90505f61009784601481886101cb565b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920182905250604051949550938493506001600160a01b03871692506100e89150859061023f565b5f604051808303815f865af19150503d805f8114610121576040519150601f19603f3d011682016040523d82523d5f602084013e610126565b606091505b50915091508161013c575f945050505050610157565b808060200190518101906101509190610255565b9450505050505b92915050565b5f5f6020838503121561016e575f5ffd5b823567ffffffffffffffff811115610184575f5ffd5b8301601f81018513610194575f5ffd5b803567ffffffffffffffff8111156101aa575f5ffd5b8560208284010111156101bb575f5ffd5b6020919091019590945092505050565b5f5f858511156101d9575f5ffd5b838611156101e5575f5ffd5b5050820193919092039150565b80356bffffffffffffffffffffffff198116906014841015610238576bffffffffffffffffffffffff196bffffffffffffffffffffffff198560140360031b1b82161691505b5092915050565b5f82518060208501845e5f920191825250919050565b5f60208284031215610265575f5ffd5b81516001600160a01b038116811461027b575f5ffd5b939250505056fea164736f6c634300081e000a",
 }
 
 // SuperSenderCreatorABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperSenderCreatorMetaData.ABI instead.
 var SuperSenderCreatorABI = SuperSenderCreatorMetaData.ABI
 
+// SuperSenderCreatorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperSenderCreatorMetaData.Bin instead.
+var SuperSenderCreatorBin = SuperSenderCreatorMetaData.Bin
+
+// DeploySuperSenderCreator deploys a new Ethereum contract, binding an instance of SuperSenderCreator to it.
+func DeploySuperSenderCreator(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SuperSenderCreator, error) {
+	parsed, err := SuperSenderCreatorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperSenderCreatorBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperSenderCreator{SuperSenderCreatorCaller: SuperSenderCreatorCaller{contract: contract}, SuperSenderCreatorTransactor: SuperSenderCreatorTransactor{contract: contract}, SuperSenderCreatorFilterer: SuperSenderCreatorFilterer{contract: contract}}, nil
+}
+
 // SuperSenderCreator is an auto generated Go binding around an Ethereum contract.
 type SuperSenderCreator struct {
 	SuperSenderCreatorCaller     // Read-only binding to the contract
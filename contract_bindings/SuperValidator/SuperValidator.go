@@ -45,12 +45,34 @@ type PackedUserOperation struct {
 // SuperValidatorMetaData contains all meta data concerning the SuperValidator contract.
 var SuperValidatorMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"function\",\"name\":\"getAccountOwner\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isInitialized\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"isModuleType\",\"inputs\":[{\"name\":\"typeId\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"isValidSignatureWithSender\",\"inputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"dataHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes4\",\"internalType\":\"bytes4\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"namespace\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"string\",\"internalType\":\"string\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"onInstall\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"onUninstall\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"retrieveSignatureData\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"validateUserOp\",\"inputs\":[{\"name\":\"_userOp\",\"type\":\"tuple\",\"internalType\":\"structPackedUserOperation\",\"components\":[{\"name\":\"sender\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"nonce\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"initCode\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"accountGasLimits\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"preVerificationGas\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"gasFees\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"paymasterAndData\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"signature\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]},{\"name\":\"_userOpHash\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"ERC7579ValidatorBase.ValidationData\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"event\",\"name\":\"AccountOwnerSet\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"},{\"name\":\"owner\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"event\",\"name\":\"AccountUnset\",\"inputs\":[{\"name\":\"account\",\"type\":\"address\",\"indexed\":true,\"internalType\":\"address\"}],\"anonymous\":false},{\"type\":\"error\",\"name\":\"ALREADY_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignature\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureLength\",\"inputs\":[{\"name\":\"length\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"ECDSAInvalidSignatureS\",\"inputs\":[{\"name\":\"s\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}]},{\"type\":\"error\",\"name\":\"EMPTY_DESTINATION_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_CHAIN_ID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DESTINATION_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_MERKLE_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SENDER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_USER_OP\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_USER_OP\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ModuleAlreadyInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"NOT_EIP1271_SIGNER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_IMPLEMENTED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_INITIALIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NotInitialized\",\"inputs\":[{\"name\":\"smartAccount\",\"type\":\"address\",\"internalType\":\"address\"}]},{\"type\":\"error\",\"name\":\"PROOF_COUNT_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PROOF_NOT_FOUND\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNEXPECTED_CHAIN_PROOF\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ADDRESS\",\"inputs\":[]}]",
+	Bin: "0x6080604052348015600e575f5ffd5b506121c08061001c5f395ff3fe608060405234801561000f575f5ffd5b5060043610610090575f3560e01c80638a91b0e3116100635780638a91b0e31461011d5780639700320314610130578063d60b347f14610151578063ecd059611461018c578063f551e2ee146101a0575f5ffd5b80630f65bac514610094578063442b172c146100bd5780636d61fe70146101005780637c015a8914610115575b5f5ffd5b6100a76100a236600461174e565b6101cc565b6040516100b49190611797565b60405180910390f35b6100e86100cb36600461174e565b6001600160a01b039081165f908152600160205260409020541690565b6040516001600160a01b0390911681526020016100b4565b61011361010e3660046117ed565b6101e8565b005b6100a76102bb565b61011361012b3660046117ed565b6102ed565b61014361013e36600461182b565b610372565b6040519081526020016100b4565b61017c61015f36600461174e565b6001600160a01b03165f9081526020819052604090205460ff1690565b60405190151581526020016100b4565b61017c61019a366004611871565b60011490565b6101b36101ae366004611888565b610688565b6040516001600160e01b031990911681526020016100b4565b60606001600160a01b0382166101e181610754565b9392505050565b335f9081526020819052604090205460ff16156102185760405163439a74c960e01b815260040160405180910390fd5b5f6102258284018461174e565b90506001600160a01b03811661024e5760405163538ba4f960e01b815260040160405180910390fd5b335f81815260208181526040808320805460ff1916600190811790915590915280822080546001600160a01b0386166001600160a01b0319909116811790915590519092917fbe3f5c5c79d582b53d2c89a48a099e3d039cd3a249a2ad0f932cc39357c69fad91a3505050565b60606102e860408051808201909152600e81526d29bab832b92b30b634b230ba37b960911b602082015290565b905090565b335f9081526020819052604090205460ff1661031c57604051630f68fe6360e21b815260040160405180910390fd5b335f81815260208181526040808320805460ff19169055600190915280822080546001600160a01b0319169055517f231ed5455a8a61ef69418a03c2725cbfb235bcb6c08ca6ad51a9064465f7c3a29190a25050565b5f6103ac610383602085018561174e565b6001600160a01b0316803b806020016040519081016040528181525f908060200190933c6107dd565b1580156103e157505f806103c3602086018661174e565b6001600160a01b0316815260208101919091526040015f205460ff16155b156103ff57604051630f68fe6360e21b815260040160405180910390fd5b5f61044a6104116101008601866118df565b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152506107fe92505050565b90505f61046461045d602087018761174e565b83866108b1565b5090505f61048882610479602089018961174e565b8560200151866040015161092f565b9050808015610498575082515115155b156106685760a0830151518351515f8290036104c7576040516334a214ff60e21b815260040160405180910390fd5b8082146104e7576040516302b3e0c160e31b815260040160405180910390fd5b5f5b82811015610631575f8660a00151828151811061050857610508611921565b60200260200101519050865f0151828151811061052757610527611921565b60200260200101516001600160401b031681602001516001600160401b0316146105645760405163038f304d60e31b815260040160405180910390fd5b5f6040518060c00160405280836040015160a00151815260200183602001516001600160401b0316815260200183604001515f01516001600160a01b031681526020018360400151602001516001600160a01b03168152602001836040015160400151815260200183604001516060015181525090505f6105f2828a60200151856040015160800151610a08565b9050610606835f01518a6060015183610a77565b61062357604051637d23b90160e01b815260040160405180910390fd5b5050508060010190506104e9565b505f61064060208a018a61174e565b6001600160a01b0316905061066461065c6101008b018b6118df565b839190610a8c565b5050505b61067c811584602001518560400151610af1565b93505050505b92915050565b5f6106b6336001600160a01b0316803b806020016040519081016040528181525f908060200190933c6107dd565b1580156106d25750335f9081526020819052604090205460ff16155b156106f057604051630f68fe6360e21b815260040160405180910390fd5b5f6106fd838501856119e9565b90505f610709826107fe565b90505f6107173383896108b1565b5090505f61072f82338560200151866040015161092f565b90508061073c575f610745565b630b135d3f60e11b5b9450505050505b949350505050565b60605f61076083610afd565b9050805c806001600160401b0381111561077c5761077c611935565b6040519080825280601f01601f1916602001820160405280156107a6576020820181803683370190505b5092505f5b818110156107d557602081810181900484015c8583018201526107ce9082611a76565b90506107ab565b505050919050565b5f61ef0160f01b6107ed83611a89565b6001600160e81b0319161492915050565b61084b6040518060e00160405280606081526020015f65ffffffffffff1681526020015f65ffffffffffff1681526020015f81526020016060815260200160608152602001606081525090565b5f5f5f5f5f5f5f888060200190518101906108669190611e4a565b6040805160e08101825297885265ffffffffffff968716602089015294909516938601939093526060850191909152608084015260a083015260c08201529998505050505050505050565b5f5f6108e9836040516020016108c991815260200190565b60408051601f198184030181529181526020870151908701518751610b50565b90506108fe8460800151856060015183610a77565b61091b5760405163712eb08760e01b815260040160405180910390fd5b6109258585610bbf565b9150935093915050565b5f5f8265ffffffffffff16421015801561097c575065ffffffffffff8416158061097c5750428465ffffffffffff161015801561097c57508365ffffffffffff168365ffffffffffff1611155b90506109ab856001600160a01b0316803b806020016040519081016040528181525f908060200190933c6107dd565b156109d657846001600160a01b0316866001600160a01b03161480156109ce5750805b91505061074c565b6001600160a01b038086165f9081526001602052604090205487821691161480156109fe5750805b9695505050505050565b82516020808501516040808701516060880151608089015160a08a015193515f97610a3b97909695918b918b9101611f63565b60408051601f19818403018152828252805160209182012090830152016040516020818303038152906040528051906020012090509392505050565b5f82610a838584610d46565b14949350505050565b5f610a9684610afd565b9050805c8015610ab95760405163a9bd11f360e01b815260040160405180910390fd5b8280835d5f5b81811015610ae857858101358060208084010486015d50610ae1602082611a76565b9050610abf565b50505050505050565b5f61074c848484610d88565b604080517f10f373395e5c9f816ee3fda0a2b50417c869ccbb40eb3cb5bb0a96939cc2618d60208201529081018290525f906060015b604051602081830303815290604052805190602001209050919050565b5f5f85806020019051810190610b669190612027565b90508085858530604051602001610b8195949392919061203e565b60408051601f198184030181528282528051602091820120908301520160405160208183030381529060405280519060200120915050949350505050565b5f610bed836001600160a01b0316803b806020016040519081016040528181525f908060200190933c6107dd565b15610c0257610bfb82610dbe565b9050610682565b6001600160a01b038084165f9081526001602052604090205416803b1580610c525750610c52816001600160a01b0316803b806020016040519081016040528181525f908060200190933c6107dd565b15610c6857610c6083610dbe565b915050610682565b5f610c768460600151610e0d565b9050610c8c6001600160a01b0383168583610e28565b15610c9957509050610682565b60c0840151604051630b135d3f60e11b81526001600160a01b03841691631626ba7e91610cca9185916004016120be565b602060405180830381865afa925050508015610d03575060408051601f3d908101601f19168201909252610d00918101906120d6565b60015b15610d2d576374eca2c160e11b6001600160e01b0319821601610d2b57829350505050610682565b505b6040516331d42a1b60e21b815260040160405180910390fd5b5f81815b8451811015610d8057610d7682868381518110610d6957610d69611921565b6020026020010151611160565b9150600101610d4a565b509392505050565b5f60d08265ffffffffffff16901b60a08465ffffffffffff16901b85610dae575f610db1565b60015b60ff161717949350505050565b5f5f610dcd8360600151610e0d565b7f19457468657265756d205369676e6564204d6573736167653a0a3332000000005f908152601c829052603c81209192505061074c818560c00151611189565b5f610e166102bb565b82604051602001610b339291906120fd565b5f60605f856001600160a01b031663a0e67e2b6040518163ffffffff1660e01b81526004015f60405180830381865afa925050508015610e8957506040513d5f823e601f3d908101601f19168201604052610e86919081019061211e565b60015b610e97575f925050506101e1565b9150856001600160a01b031663e75235b86040518163ffffffff1660e01b8152600401602060405180830381865afa925050508015610ef3575060408051601f3d908101601f19168201909252610ef091810190612027565b60015b610f01575f925050506101e1565b604080518082018252600d81526c5375706572666f726d5361666560981b6020918201528151808301835260058152640312e302e360dc1b9082015281517f8b73c3c69bb8fe3d512ecc4cf759cc79239f7b179b0ffacaa9a75d522b39400f818301527fe2c6934fb785b5f60008275f0609066361e48bc175bda8e1160d2680b4c1849d818401527f06c015bd22b4c69690933c1058878ebdfef31f9aaae40bbe86d8a09fe1b2972c6060820152600160808201526001600160a01b038a1660a0808301919091528351808303909101815260c0808301855281519184019190912060e08084018b905285518085039091018152610100840186528051908501207f60b3cbf8b4a223d68d641b3b6ddf9a298e7f33710cf3d3a9d1146b5a6150fbca610120850152610140808501919091528551808503909101815261016084018652805190850120601960f81b610180850152600160f81b61018185015261018284018290526101a280850191909152855180850390910181526101c2909301909452815191909201209088015192935090915f9081906110a5908490876111b1565b91509150848210156110bf575f96505050505050506101e1565b6110c881611331565b6110d186611331565b6110da8661133d565b85515f90815b8181101561114e575f6111158a83815181106110fe576110fe611921565b60200260200101518661134690919063ffffffff16565b509050801561114557836111288161214f565b9450508884106111455760019a50505050505050505050506101e1565b506001016110e0565b505f9c9b505050505050505050505050565b5f81831061117a575f8281526020849052604090206101e1565b505f9182526020526040902090565b5f5f5f5f6111978686611367565b9250925092506111a782826113b0565b5090949350505050565b81515f90606090826111c4604183612167565b9050806001600160401b038111156111de576111de611935565b604051908082528060200260200182016040528015611207578160200160208202803683370190505b5092508481101561121c575f93505050611329565b5f5b81811015611325575f5f5f5f61124b8b8660410201602081015160408201516060909201515f1a92909190565b9250925092508260ff165f0361126f576112688c8c84848b611471565b93506112cd565b601e8360ff1611156112be576112686112ac8d6020527b19457468657265756d205369676e6564204d6573736167653a0a33325f52603c60042090565b6112b7600486612186565b8484611539565b6112ca8c848484611539565b93505b6001600160a01b038416156112ea57886112e68161214f565b9950505b838886815181106112fd576112fd611921565b6001600160a01b039092166020928302919091019091015250506001909201915061121e9050565b5050505b935093915050565b61133a81611573565b50565b61133a816115c8565b5f8061135c84846001600160a01b03165f611611565b909590945092505050565b5f5f5f835160410361139e576020840151604085015160608601515f1a61139088828585611672565b9550955095505050506113a9565b505081515f91506002905b9250925092565b5f8260038111156113c3576113c361219f565b036113cc575050565b60018260038111156113e0576113e061219f565b036113fe5760405163f645eedf60e01b815260040160405180910390fd5b60028260038111156114125761141261219f565b036114385760405163fce698f760e01b8152600481018290526024015b60405180910390fd5b600382600381111561144c5761144c61219f565b0361146d576040516335e2f38360e21b81526004810182905260240161142f565b5050565b838201602001518390826114858583611a76565b611490906020611a76565b111561149f575f915050611530565b604051630b135d3f60e11b808252878601602001916001600160a01b03851690631626ba7e906114d5908c9086906004016120be565b602060405180830381865afa1580156114f0573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061151491906120d6565b6001600160e01b0319161461152d575f92505050611530565b50505b95945050505050565b5f604051855f5260ff851660205283604052826060526020604060805f60015afa505f6060523d6060185191508060405250949350505050565b80515f82528060051b8201601f19602084015b6020018281116115c157805182820180518281116115a657505050611586565b5b6020820152830180518281116115a7575060200152611586565b5050509052565b600281511061133a576020810160408201600183510160051b83015b81518351146115f857602083019250815183525b6020820191508082036115e457505081900360051c9052565b5f5f5f19600186515f87870197505b81830160011c94508460051b890151870190508781148284111761165a5780881161164f578385019150611620565b600185019250611620565b84151597148716989290930190950295509350505050565b5f80807f7fffffffffffffffffffffffffffffff5d576e7357a4501ddfe92f46681b20a08411156116ab57505f91506003905082611730565b604080515f808252602082018084528a905260ff891692820192909252606081018790526080810186905260019060a0016020604051602081039080840390855afa1580156116fc573d5f5f3e3d5ffd5b5050604051601f1901519150506001600160a01b03811661172757505f925060019150829050611730565b92505f91508190505b9450945094915050565b6001600160a01b038116811461133a575f5ffd5b5f6020828403121561175e575f5ffd5b81356101e18161173a565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f6101e16020830184611769565b5f5f83601f8401126117b9575f5ffd5b5081356001600160401b038111156117cf575f5ffd5b6020830191508360208285010111156117e6575f5ffd5b9250929050565b5f5f602083850312156117fe575f5ffd5b82356001600160401b03811115611813575f5ffd5b61181f858286016117a9565b90969095509350505050565b5f5f6040838503121561183c575f5ffd5b82356001600160401b03811115611851575f5ffd5b83016101208186031215611863575f5ffd5b946020939093013593505050565b5f60208284031215611881575f5ffd5b5035919050565b5f5f5f5f6060858703121561189b575f5ffd5b84356118a68161173a565b93506020850135925060408501356001600160401b038111156118c7575f5ffd5b6118d3878288016117a9565b95989497509550505050565b5f5f8335601e198436030181126118f4575f5ffd5b8301803591506001600160401b0382111561190d575f5ffd5b6020019150368190038213156117e6575f5ffd5b634e487b7160e01b5f52603260045260245ffd5b634e487b7160e01b5f52604160045260245ffd5b604051606081016001600160401b038111828210171561196b5761196b611935565b60405290565b60405160c081016001600160401b038111828210171561196b5761196b611935565b604051601f8201601f191681016001600160401b03811182821017156119bb576119bb611935565b604052919050565b5f6001600160401b038211156119db576119db611935565b50601f01601f191660200190565b5f602082840312156119f9575f5ffd5b81356001600160401b03811115611a0e575f5ffd5b8201601f81018413611a1e575f5ffd5b8035611a31611a2c826119c3565b611993565b818152856020838501011115611a45575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561068257610682611a62565b805160208201516001600160e81b0319811691906003821015611abf576001600160e81b03196003838103901b81901b82161692505b5050919050565b5f6001600160401b03821115611ade57611ade611935565b5060051b60200190565b80516001600160401b0381168114611afe575f5ffd5b919050565b5f82601f830112611b12575f5ffd5b8151611b20611a2c82611ac6565b8082825260208201915060208360051b860101925085831115611b41575f5ffd5b602085015b83811015611b6557611b5781611ae8565b835260209283019201611b46565b5095945050505050565b805165ffffffffffff81168114611afe575f5ffd5b5f82601f830112611b93575f5ffd5b8151611ba1611a2c82611ac6565b8082825260208201915060208360051b860101925085831115611bc2575f5ffd5b602085015b83811015611b65578051835260209283019201611bc7565b8051611afe8161173a565b5f82601f830112611bf9575f5ffd5b8151611c07611a2c82611ac6565b8082825260208201915060208360051b860101925085831115611c28575f5ffd5b602085015b83811015611b65578051611c408161173a565b835260209283019201611c2d565b5f82601f830112611c5d575f5ffd5b8151611c6b611a2c826119c3565b818152846020838601011115611c7f575f5ffd5b8160208501602083015e5f918101602001919091529392505050565b5f82601f830112611caa575f5ffd5b8151611cb8611a2c82611ac6565b8082825260208201915060208360051b860101925085831115611cd9575f5ffd5b602085015b83811015611b655780516001600160401b03811115611cfb575f5ffd5b86016060818903601f19011215611d10575f5ffd5b611d18611949565b60208201516001600160401b03811115611d30575f5ffd5b611d3f8a602083860101611b84565b825250611d4e60408301611ae8565b602082015260608201516001600160401b03811115611d6b575f5ffd5b60208184010192505060c0828a031215611d83575f5ffd5b611d8b611971565b611d9483611bdf565b8152611da260208401611bdf565b602082015260408301516001600160401b03811115611dbf575f5ffd5b611dcb8b828601611bea565b60408301525060608301516001600160401b03811115611de9575f5ffd5b611df58b828601611b84565b606083015250611e0760808401611bdf565b608082015260a08301516001600160401b03811115611e24575f5ffd5b611e308b828601611c4e565b60a083015250604082015284525060209283019201611cde565b5f5f5f5f5f5f5f60e0888a031215611e60575f5ffd5b87516001600160401b03811115611e75575f5ffd5b611e818a828b01611b03565b975050611e9060208901611b6f565b9550611e9e60408901611b6f565b606089015160808a015191965094506001600160401b03811115611ec0575f5ffd5b611ecc8a828b01611b84565b93505060a08801516001600160401b03811115611ee7575f5ffd5b611ef38a828b01611c9b565b92505060c08801516001600160401b03811115611f0e575f5ffd5b611f1a8a828b01611c4e565b91505092959891949750929550565b5f8151808452602084019350602083015f5b82811015611f59578151865260209586019590910190600101611f3b565b5093949350505050565b61010081525f611f7761010083018b611769565b6001600160401b038a166020848101919091526001600160a01b038a81166040860152891660608501528382036080850152875180835288820192909101905f5b81811015611fdf5783516001600160a01b0316835260209384019390920191600101611fb8565b505083810360a0850152611ff38188611f29565b9250505061200b60c083018565ffffffffffff169052565b6001600160a01b03831660e08301529998505050505050505050565b5f60208284031215612037575f5ffd5b5051919050565b5f60a0820187835265ffffffffffff8716602084015265ffffffffffff8616604084015260a0606084015280855180835260c0850191506020870192505f5b818110156120a45783516001600160401b031683526020938401939092019160010161207d565b50506001600160a01b038516608085015291506109fe9050565b828152604060208201525f61074c6040830184611769565b5f602082840312156120e6575f5ffd5b81516001600160e01b0319811681146101e1575f5ffd5b604081525f61210f6040830185611769565b90508260208301529392505050565b5f6020828403121561212e575f5ffd5b81516001600160401b03811115612143575f5ffd5b61074c84828501611bea565b5f6001820161216057612160611a62565b5060010190565b5f8261218157634e487b7160e01b5f52601260045260245ffd5b500490565b60ff828116828216039081111561068257610682611a62565b634e487b7160e01b5f52602160045260245ffdfea164736f6c634300081e000a",
 }
 
 // SuperValidatorABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperValidatorMetaData.ABI instead.
 var SuperValidatorABI = SuperValidatorMetaData.ABI
 
+// SuperValidatorBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperValidatorMetaData.Bin instead.
+var SuperValidatorBin = SuperValidatorMetaData.Bin
+
+// DeploySuperValidator deploys a new Ethereum contract, binding an instance of SuperValidator to it.
+func DeploySuperValidator(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SuperValidator, error) {
+	parsed, err := SuperValidatorMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperValidatorBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperValidator{SuperValidatorCaller: SuperValidatorCaller{contract: contract}, SuperValidatorTransactor: SuperValidatorTransactor{contract: contract}, SuperValidatorFilterer: SuperValidatorFilterer{contract: contract}}, nil
+}
+
 // SuperValidator is an auto generated Go binding around an Ethereum contract.
 type SuperValidator struct {
 	SuperValidatorCaller     // Read-only binding to the contract
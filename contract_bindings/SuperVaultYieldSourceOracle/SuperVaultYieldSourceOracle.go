@@ -0,0 +1,606 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package SuperVaultYieldSourceOracle
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// SuperVaultYieldSourceOracleMetaData contains all meta data concerning the SuperVaultYieldSourceOracle contract.
+var SuperVaultYieldSourceOracleMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"superLedgerConfiguration_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUPER_LEDGER_CONFIGURATION\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decimals\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"uint8\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"sharesIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getAssetOutputWithFees\",\"inputs\":[{\"name\":\"yieldSourceOracleId\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"},{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetOut\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"user\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"usedShares\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getBalanceOfOwner\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShare\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVL\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfShares\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getWithdrawalShareOutput\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"assetsIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_BASE_ASSET\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b5060405161140b38038061140b833981016040819052602b91603b565b6001600160a01b03166080526066565b5f60208284031215604a575f5ffd5b81516001600160a01b0381168114605f575f5ffd5b9392505050565b6080516113866100855f395f8181610166015261033201526113865ff3fe608060405234801561000f575f5ffd5b50600436106100cb575f3560e01c80638717164a11610088578063cacc7b0e11610063578063cacc7b0e146101d3578063d449a832146101e6578063ec422afd1461020b578063fea8af5f1461021e575f5ffd5b80638717164a14610161578063a7a128b4146101a0578063aa5815fd146101c0575f5ffd5b8063056f143c146100cf5780630f40517a146100f55780632f112c461461010857806334f99b481461011b5780634fecb2661461013b5780637eeb81071461014e575b5f5ffd5b6100e26100dd366004610d95565b610231565b6040519081526020015b60405180910390f35b6100e2610103366004610dd3565b6102a5565b6100e2610116366004610dee565b61030c565b61012e610129366004610f20565b610571565b6040516100ec9190610ffe565b6100e261014936600461108a565b610717565b6100e261015c366004610d95565b610800565b6101887f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b0390911681526020016100ec565b6101b36101ae3660046110c1565b610911565b6040516100ec91906110f3565b6100e26101ce366004610d95565b6109b3565b6101b36101e13660046110c1565b6109e2565b6101f96101f4366004610dd3565b610a7d565b60405160ff90911681526020016100ec565b6100e2610219366004610dd3565b610ade565b6100e261022c36600461108a565b610bc8565b60405163ef8b30f760e01b8152600481018290525f906001600160a01b0385169063ef8b30f7906024015b602060405180830381865afa158015610277573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029b9190611135565b90505b9392505050565b5f816001600160a01b03166301e1d1146040518163ffffffff1660e01b8152600401602060405180830381865afa1580156102e2573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906103069190611135565b92915050565b5f5f6103198686856109b3565b604051630b47673760e41b8152600481018990529091507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03169063b47673709060240160a060405180830381865afa92505050801561039d575060408051601f3d908101601f1916820190925261039a9181019061114c565b60015b6103a8579050610568565b5f81602001511180156103c7575060808101516001600160a01b031615155b1561056457805160405163ec422afd60e01b81526001600160a01b0389811660048301525f92169063ec422afd90602401602060405180830381865afa158015610413573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104379190611135565b8251604051636a24d41960e11b81526001600160a01b038b811660048301529293505f929091169063d449a83290602401602060405180830381865afa158015610483573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906104a791906111db565b60808401516020850151604051633c144b4b60e21b81526001600160a01b038b811660048301528d8116602483015260448201899052606482018b9052608482019290925260a4810186905260ff841660c48201529293505f9291169063f0512d2c9060e401602060405180830381865afa158015610528573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061054c9190611135565b9050610558818661120f565b95505050505050610568565b5090505b95945050505050565b8151815160609190811461059857604051634456f5e960e11b815260040160405180910390fd5b8067ffffffffffffffff8111156105b1576105b1610e45565b6040519080825280602002602001820160405280156105e457816020015b60608152602001906001900390816105cf5790505b5091505f5b8181101561070f575f85828151811061060457610604611222565b602002602001015190505f85838151811061062157610621611222565b602002602001015190505f815190508067ffffffffffffffff81111561064957610649610e45565b604051908082528060200260200182016040528015610672578160200160208202803683370190505b5086858151811061068557610685611222565b60200260200101819052505f5b81811015610700575f6106be858584815181106106b1576106b1611222565b6020026020010151610717565b9050808887815181106106d3576106d3611222565b602002602001015183815181106106ec576106ec611222565b602090810291909101015250600101610692565b505050508060010190506105e9565b505092915050565b6040516370a0823160e01b81526001600160a01b0382811660048301525f9184918391908316906370a0823190602401602060405180830381865afa158015610762573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906107869190611135565b9050805f03610799575f92505050610306565b6040516303d1689d60e11b8152600481018290526001600160a01b038316906307a2d13a90602401602060405180830381865afa1580156107dc573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105689190611135565b5f5f8490505f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610842573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061086691906111db565b60ff1690505f61087782600a611319565b6040516303d1689d60e11b8152600481018290529091505f906001600160a01b038516906307a2d13a90602401602060405180830381865afa1580156108bf573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906108e39190611135565b9050805f036108f8575f94505050505061029e565b6109058683836001610c33565b98975050505050505050565b80516060908067ffffffffffffffff81111561092f5761092f610e45565b604051908082528060200260200182016040528015610958578160200160208202803683370190505b5091505f5b818110156109ac5761098784828151811061097a5761097a611222565b6020026020010151610ade565b83828151811061099957610999611222565b602090810291909101015260010161095d565b5050919050565b6040516303d1689d60e11b8152600481018290525f906001600160a01b038516906307a2d13a9060240161025c565b80516060908067ffffffffffffffff811115610a0057610a00610e45565b604051908082528060200260200182016040528015610a29578160200160208202803683370190505b5091505f5b818110156109ac57610a58848281518110610a4b57610a4b611222565b60200260200101516102a5565b838281518110610a6a57610a6a611222565b6020908102919091010152600101610a2e565b5f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610aba573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061030691906111db565b5f5f8290505f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa158015610b20573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b4491906111db565b60ff1690506001600160a01b0382166307a2d13a610b6383600a611319565b6040518263ffffffff1660e01b8152600401610b8191815260200190565b602060405180830381865afa158015610b9c573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610bc09190611135565b949350505050565b6040516370a0823160e01b81526001600160a01b0382811660048301525f91908416906370a0823190602401602060405180830381865afa158015610c0f573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061029e9190611135565b5f610c60610c4083610c75565b8015610c5b57505f8480610c5657610c56611324565b868809115b151590565b610c6b868686610ca1565b610568919061120f565b5f6002826003811115610c8a57610c8a611338565b610c94919061134c565b60ff166001149050919050565b5f5f5f610cae8686610d51565b91509150815f03610cd257838181610cc857610cc8611324565b049250505061029e565b818411610ce957610ce96003851502601118610d6d565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b0381168114610d92575f5ffd5b50565b5f5f5f60608486031215610da7575f5ffd5b8335610db281610d7e565b92506020840135610dc281610d7e565b929592945050506040919091013590565b5f60208284031215610de3575f5ffd5b813561029e81610d7e565b5f5f5f5f5f60a08688031215610e02575f5ffd5b853594506020860135610e1481610d7e565b93506040860135610e2481610d7e565b92506060860135610e3481610d7e565b949793965091946080013592915050565b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f1916810167ffffffffffffffff81118282101715610e8257610e82610e45565b604052919050565b5f67ffffffffffffffff821115610ea357610ea3610e45565b5060051b60200190565b5f82601f830112610ebc575f5ffd5b8135610ecf610eca82610e8a565b610e59565b8082825260208201915060208360051b860101925085831115610ef0575f5ffd5b602085015b83811015610f16578035610f0881610d7e565b835260209283019201610ef5565b5095945050505050565b5f5f60408385031215610f31575f5ffd5b823567ffffffffffffffff811115610f47575f5ffd5b610f5385828601610ead565b925050602083013567ffffffffffffffff811115610f6f575f5ffd5b8301601f81018513610f7f575f5ffd5b8035610f8d610eca82610e8a565b8082825260208201915060208360051b850101925087831115610fae575f5ffd5b602084015b83811015610fef57803567ffffffffffffffff811115610fd1575f5ffd5b610fe08a602083890101610ead565b84525060209283019201610fb3565b50809450505050509250929050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561107e57868503603f19018452815180518087526020918201918701905f5b81811015611065578351835260209384019390920191600101611047565b5090965050506020938401939190910190600101611024565b50929695505050505050565b5f5f6040838503121561109b575f5ffd5b82356110a681610d7e565b915060208301356110b681610d7e565b809150509250929050565b5f602082840312156110d1575f5ffd5b813567ffffffffffffffff8111156110e7575f5ffd5b610bc084828501610ead565b602080825282518282018190525f918401906040840190835b8181101561112a57835183526020938401939092019160010161110c565b509095945050505050565b5f60208284031215611145575f5ffd5b5051919050565b5f60a082840312801561115d575f5ffd5b5060405160a0810167ffffffffffffffff8111828210171561118157611181610e45565b604052825161118f81610d7e565b81526020838101519082015260408301516111a981610d7e565b604082015260608301516111bc81610d7e565b606082015260808301516111cf81610d7e565b60808201529392505050565b5f602082840312156111eb575f5ffd5b815160ff8116811461029e575f5ffd5b634e487b7160e01b5f52601160045260245ffd5b80820180821115610306576103066111fb565b634e487b7160e01b5f52603260045260245ffd5b6001815b600184111561127157808504811115611255576112556111fb565b600184161561126357908102905b60019390931c92800261123a565b935093915050565b5f8261128757506001610306565b8161129357505f610306565b81600181146112a957600281146112b3576112cf565b6001915050610306565b60ff8411156112c4576112c46111fb565b50506001821b610306565b5060208310610133831016604e8410600b84101617156112f2575081810a610306565b6112fe5f198484611236565b805f1904821115611311576113116111fb565b029392505050565b5f61029e8383611279565b634e487b7160e01b5f52601260045260245ffd5b634e487b7160e01b5f52602160045260245ffd5b5f60ff83168061136a57634e487b7160e01b5f52601260045260245ffd5b8060ff8416069150509291505056fea164736f6c634300081e000a",
+}
+
+// SuperVaultYieldSourceOracleABI is the input ABI used to generate the binding from.
+// Deprecated: Use SuperVaultYieldSourceOracleMetaData.ABI instead.
+var SuperVaultYieldSourceOracleABI = SuperVaultYieldSourceOracleMetaData.ABI
+
+// SuperVaultYieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperVaultYieldSourceOracleMetaData.Bin instead.
+var SuperVaultYieldSourceOracleBin = SuperVaultYieldSourceOracleMetaData.Bin
+
+// DeploySuperVaultYieldSourceOracle deploys a new Ethereum contract, binding an instance of SuperVaultYieldSourceOracle to it.
+func DeploySuperVaultYieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, superLedgerConfiguration_ common.Address) (common.Address, *types.Transaction, *SuperVaultYieldSourceOracle, error) {
+	parsed, err := SuperVaultYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperVaultYieldSourceOracleBin), backend, superLedgerConfiguration_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperVaultYieldSourceOracle{SuperVaultYieldSourceOracleCaller: SuperVaultYieldSourceOracleCaller{contract: contract}, SuperVaultYieldSourceOracleTransactor: SuperVaultYieldSourceOracleTransactor{contract: contract}, SuperVaultYieldSourceOracleFilterer: SuperVaultYieldSourceOracleFilterer{contract: contract}}, nil
+}
+
+// SuperVaultYieldSourceOracle is an auto generated Go binding around an Ethereum contract.
+type SuperVaultYieldSourceOracle struct {
+	SuperVaultYieldSourceOracleCaller     // Read-only binding to the contract
+	SuperVaultYieldSourceOracleTransactor // Write-only binding to the contract
+	SuperVaultYieldSourceOracleFilterer   // Log filterer for contract events
+}
+
+// SuperVaultYieldSourceOracleCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SuperVaultYieldSourceOracleCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SuperVaultYieldSourceOracleTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SuperVaultYieldSourceOracleTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SuperVaultYieldSourceOracleFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SuperVaultYieldSourceOracleFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SuperVaultYieldSourceOracleSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SuperVaultYieldSourceOracleSession struct {
+	Contract     *SuperVaultYieldSourceOracle // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts                // Call options to use throughout this session
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// SuperVaultYieldSourceOracleCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SuperVaultYieldSourceOracleCallerSession struct {
+	Contract *SuperVaultYieldSourceOracleCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                      // Call options to use throughout this session
+}
+
+// SuperVaultYieldSourceOracleTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SuperVaultYieldSourceOracleTransactorSession struct {
+	Contract     *SuperVaultYieldSourceOracleTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                      // Transaction auth options to use throughout this session
+}
+
+// SuperVaultYieldSourceOracleRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SuperVaultYieldSourceOracleRaw struct {
+	Contract *SuperVaultYieldSourceOracle // Generic contract binding to access the raw methods on
+}
+
+// SuperVaultYieldSourceOracleCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SuperVaultYieldSourceOracleCallerRaw struct {
+	Contract *SuperVaultYieldSourceOracleCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SuperVaultYieldSourceOracleTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SuperVaultYieldSourceOracleTransactorRaw struct {
+	Contract *SuperVaultYieldSourceOracleTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSuperVaultYieldSourceOracle creates a new instance of SuperVaultYieldSourceOracle, bound to a specific deployed contract.
+func NewSuperVaultYieldSourceOracle(address common.Address, backend bind.ContractBackend) (*SuperVaultYieldSourceOracle, error) {
+	contract, err := bindSuperVaultYieldSourceOracle(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperVaultYieldSourceOracle{SuperVaultYieldSourceOracleCaller: SuperVaultYieldSourceOracleCaller{contract: contract}, SuperVaultYieldSourceOracleTransactor: SuperVaultYieldSourceOracleTransactor{contract: contract}, SuperVaultYieldSourceOracleFilterer: SuperVaultYieldSourceOracleFilterer{contract: contract}}, nil
+}
+
+// NewSuperVaultYieldSourceOracleCaller creates a new read-only instance of SuperVaultYieldSourceOracle, bound to a specific deployed contract.
+func NewSuperVaultYieldSourceOracleCaller(address common.Address, caller bind.ContractCaller) (*SuperVaultYieldSourceOracleCaller, error) {
+	contract, err := bindSuperVaultYieldSourceOracle(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperVaultYieldSourceOracleCaller{contract: contract}, nil
+}
+
+// NewSuperVaultYieldSourceOracleTransactor creates a new write-only instance of SuperVaultYieldSourceOracle, bound to a specific deployed contract.
+func NewSuperVaultYieldSourceOracleTransactor(address common.Address, transactor bind.ContractTransactor) (*SuperVaultYieldSourceOracleTransactor, error) {
+	contract, err := bindSuperVaultYieldSourceOracle(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperVaultYieldSourceOracleTransactor{contract: contract}, nil
+}
+
+// NewSuperVaultYieldSourceOracleFilterer creates a new log filterer instance of SuperVaultYieldSourceOracle, bound to a specific deployed contract.
+func NewSuperVaultYieldSourceOracleFilterer(address common.Address, filterer bind.ContractFilterer) (*SuperVaultYieldSourceOracleFilterer, error) {
+	contract, err := bindSuperVaultYieldSourceOracle(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SuperVaultYieldSourceOracleFilterer{contract: contract}, nil
+}
+
+// bindSuperVaultYieldSourceOracle binds a generic wrapper to an already deployed contract.
+func bindSuperVaultYieldSourceOracle(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SuperVaultYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SuperVaultYieldSourceOracle.Contract.SuperVaultYieldSourceOracleCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SuperVaultYieldSourceOracle.Contract.SuperVaultYieldSourceOracleTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SuperVaultYieldSourceOracle.Contract.SuperVaultYieldSourceOracleTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SuperVaultYieldSourceOracle.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SuperVaultYieldSourceOracle.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SuperVaultYieldSourceOracle.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUPERLEDGERCONFIGURATION is a free data retrieval call binding the contract method 0x8717164a.
+//
+// Solidity: function SUPER_LEDGER_CONFIGURATION() view returns(address)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) SUPERLEDGERCONFIGURATION(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "SUPER_LEDGER_CONFIGURATION")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SUPERLEDGERCONFIGURATION is a free data retrieval call binding the contract method 0x8717164a.
+//
+// Solidity: function SUPER_LEDGER_CONFIGURATION() view returns(address)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) SUPERLEDGERCONFIGURATION() (common.Address, error) {
+	return _SuperVaultYieldSourceOracle.Contract.SUPERLEDGERCONFIGURATION(&_SuperVaultYieldSourceOracle.CallOpts)
+}
+
+// SUPERLEDGERCONFIGURATION is a free data retrieval call binding the contract method 0x8717164a.
+//
+// Solidity: function SUPER_LEDGER_CONFIGURATION() view returns(address)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) SUPERLEDGERCONFIGURATION() (common.Address, error) {
+	return _SuperVaultYieldSourceOracle.Contract.SUPERLEDGERCONFIGURATION(&_SuperVaultYieldSourceOracle.CallOpts)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0xd449a832.
+//
+// Solidity: function decimals(address yieldSourceAddress) view returns(uint8)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) Decimals(opts *bind.CallOpts, yieldSourceAddress common.Address) (uint8, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "decimals", yieldSourceAddress)
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// Decimals is a free data retrieval call binding the contract method 0xd449a832.
+//
+// Solidity: function decimals(address yieldSourceAddress) view returns(uint8)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) Decimals(yieldSourceAddress common.Address) (uint8, error) {
+	return _SuperVaultYieldSourceOracle.Contract.Decimals(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress)
+}
+
+// Decimals is a free data retrieval call binding the contract method 0xd449a832.
+//
+// Solidity: function decimals(address yieldSourceAddress) view returns(uint8)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) Decimals(yieldSourceAddress common.Address) (uint8, error) {
+	return _SuperVaultYieldSourceOracle.Contract.Decimals(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress)
+}
+
+// GetAssetOutput is a free data retrieval call binding the contract method 0xaa5815fd.
+//
+// Solidity: function getAssetOutput(address yieldSourceAddress, address , uint256 sharesIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetAssetOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, sharesIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getAssetOutput", yieldSourceAddress, arg1, sharesIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetAssetOutput is a free data retrieval call binding the contract method 0xaa5815fd.
+//
+// Solidity: function getAssetOutput(address yieldSourceAddress, address , uint256 sharesIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetAssetOutput(yieldSourceAddress common.Address, arg1 common.Address, sharesIn *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetAssetOutput(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, arg1, sharesIn)
+}
+
+// GetAssetOutput is a free data retrieval call binding the contract method 0xaa5815fd.
+//
+// Solidity: function getAssetOutput(address yieldSourceAddress, address , uint256 sharesIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetAssetOutput(yieldSourceAddress common.Address, arg1 common.Address, sharesIn *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetAssetOutput(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, arg1, sharesIn)
+}
+
+// GetAssetOutputWithFees is a free data retrieval call binding the contract method 0x2f112c46.
+//
+// Solidity: function getAssetOutputWithFees(bytes32 yieldSourceOracleId, address yieldSourceAddress, address assetOut, address user, uint256 usedShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetAssetOutputWithFees(opts *bind.CallOpts, yieldSourceOracleId [32]byte, yieldSourceAddress common.Address, assetOut common.Address, user common.Address, usedShares *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getAssetOutputWithFees", yieldSourceOracleId, yieldSourceAddress, assetOut, user, usedShares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetAssetOutputWithFees is a free data retrieval call binding the contract method 0x2f112c46.
+//
+// Solidity: function getAssetOutputWithFees(bytes32 yieldSourceOracleId, address yieldSourceAddress, address assetOut, address user, uint256 usedShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetAssetOutputWithFees(yieldSourceOracleId [32]byte, yieldSourceAddress common.Address, assetOut common.Address, user common.Address, usedShares *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetAssetOutputWithFees(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceOracleId, yieldSourceAddress, assetOut, user, usedShares)
+}
+
+// GetAssetOutputWithFees is a free data retrieval call binding the contract method 0x2f112c46.
+//
+// Solidity: function getAssetOutputWithFees(bytes32 yieldSourceOracleId, address yieldSourceAddress, address assetOut, address user, uint256 usedShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetAssetOutputWithFees(yieldSourceOracleId [32]byte, yieldSourceAddress common.Address, assetOut common.Address, user common.Address, usedShares *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetAssetOutputWithFees(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceOracleId, yieldSourceAddress, assetOut, user, usedShares)
+}
+
+// GetBalanceOfOwner is a free data retrieval call binding the contract method 0xfea8af5f.
+//
+// Solidity: function getBalanceOfOwner(address yieldSourceAddress, address ownerOfShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetBalanceOfOwner(opts *bind.CallOpts, yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getBalanceOfOwner", yieldSourceAddress, ownerOfShares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetBalanceOfOwner is a free data retrieval call binding the contract method 0xfea8af5f.
+//
+// Solidity: function getBalanceOfOwner(address yieldSourceAddress, address ownerOfShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetBalanceOfOwner(yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetBalanceOfOwner(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, ownerOfShares)
+}
+
+// GetBalanceOfOwner is a free data retrieval call binding the contract method 0xfea8af5f.
+//
+// Solidity: function getBalanceOfOwner(address yieldSourceAddress, address ownerOfShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetBalanceOfOwner(yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetBalanceOfOwner(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, ownerOfShares)
+}
+
+// GetPricePerShare is a free data retrieval call binding the contract method 0xec422afd.
+//
+// Solidity: function getPricePerShare(address yieldSourceAddress) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetPricePerShare(opts *bind.CallOpts, yieldSourceAddress common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getPricePerShare", yieldSourceAddress)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetPricePerShare is a free data retrieval call binding the contract method 0xec422afd.
+//
+// Solidity: function getPricePerShare(address yieldSourceAddress) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetPricePerShare(yieldSourceAddress common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetPricePerShare(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress)
+}
+
+// GetPricePerShare is a free data retrieval call binding the contract method 0xec422afd.
+//
+// Solidity: function getPricePerShare(address yieldSourceAddress) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetPricePerShare(yieldSourceAddress common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetPricePerShare(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress)
+}
+
+// GetPricePerShareMultiple is a free data retrieval call binding the contract method 0xa7a128b4.
+//
+// Solidity: function getPricePerShareMultiple(address[] yieldSourceAddresses) view returns(uint256[] pricesPerShare)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetPricePerShareMultiple(opts *bind.CallOpts, yieldSourceAddresses []common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getPricePerShareMultiple", yieldSourceAddresses)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetPricePerShareMultiple is a free data retrieval call binding the contract method 0xa7a128b4.
+//
+// Solidity: function getPricePerShareMultiple(address[] yieldSourceAddresses) view returns(uint256[] pricesPerShare)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetPricePerShareMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetPricePerShareMultiple(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddresses)
+}
+
+// GetPricePerShareMultiple is a free data retrieval call binding the contract method 0xa7a128b4.
+//
+// Solidity: function getPricePerShareMultiple(address[] yieldSourceAddresses) view returns(uint256[] pricesPerShare)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetPricePerShareMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetPricePerShareMultiple(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddresses)
+}
+
+// GetShareOutput is a free data retrieval call binding the contract method 0x056f143c.
+//
+// Solidity: function getShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getShareOutput", yieldSourceAddress, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetShareOutput is a free data retrieval call binding the contract method 0x056f143c.
+//
+// Solidity: function getShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetShareOutput(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
+
+// GetShareOutput is a free data retrieval call binding the contract method 0x056f143c.
+//
+// Solidity: function getShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetShareOutput(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
+
+// GetTVL is a free data retrieval call binding the contract method 0x0f40517a.
+//
+// Solidity: function getTVL(address yieldSourceAddress) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetTVL(opts *bind.CallOpts, yieldSourceAddress common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getTVL", yieldSourceAddress)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTVL is a free data retrieval call binding the contract method 0x0f40517a.
+//
+// Solidity: function getTVL(address yieldSourceAddress) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetTVL(yieldSourceAddress common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVL(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress)
+}
+
+// GetTVL is a free data retrieval call binding the contract method 0x0f40517a.
+//
+// Solidity: function getTVL(address yieldSourceAddress) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetTVL(yieldSourceAddress common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVL(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress)
+}
+
+// GetTVLByOwnerOfShares is a free data retrieval call binding the contract method 0x4fecb266.
+//
+// Solidity: function getTVLByOwnerOfShares(address yieldSourceAddress, address ownerOfShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetTVLByOwnerOfShares(opts *bind.CallOpts, yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getTVLByOwnerOfShares", yieldSourceAddress, ownerOfShares)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetTVLByOwnerOfShares is a free data retrieval call binding the contract method 0x4fecb266.
+//
+// Solidity: function getTVLByOwnerOfShares(address yieldSourceAddress, address ownerOfShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetTVLByOwnerOfShares(yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVLByOwnerOfShares(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, ownerOfShares)
+}
+
+// GetTVLByOwnerOfShares is a free data retrieval call binding the contract method 0x4fecb266.
+//
+// Solidity: function getTVLByOwnerOfShares(address yieldSourceAddress, address ownerOfShares) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetTVLByOwnerOfShares(yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVLByOwnerOfShares(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, ownerOfShares)
+}
+
+// GetTVLByOwnerOfSharesMultiple is a free data retrieval call binding the contract method 0x34f99b48.
+//
+// Solidity: function getTVLByOwnerOfSharesMultiple(address[] yieldSourceAddresses, address[][] ownersOfShares) view returns(uint256[][] userTvls)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetTVLByOwnerOfSharesMultiple(opts *bind.CallOpts, yieldSourceAddresses []common.Address, ownersOfShares [][]common.Address) ([][]*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getTVLByOwnerOfSharesMultiple", yieldSourceAddresses, ownersOfShares)
+
+	if err != nil {
+		return *new([][]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([][]*big.Int)).(*[][]*big.Int)
+
+	return out0, err
+
+}
+
+// GetTVLByOwnerOfSharesMultiple is a free data retrieval call binding the contract method 0x34f99b48.
+//
+// Solidity: function getTVLByOwnerOfSharesMultiple(address[] yieldSourceAddresses, address[][] ownersOfShares) view returns(uint256[][] userTvls)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetTVLByOwnerOfSharesMultiple(yieldSourceAddresses []common.Address, ownersOfShares [][]common.Address) ([][]*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVLByOwnerOfSharesMultiple(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddresses, ownersOfShares)
+}
+
+// GetTVLByOwnerOfSharesMultiple is a free data retrieval call binding the contract method 0x34f99b48.
+//
+// Solidity: function getTVLByOwnerOfSharesMultiple(address[] yieldSourceAddresses, address[][] ownersOfShares) view returns(uint256[][] userTvls)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetTVLByOwnerOfSharesMultiple(yieldSourceAddresses []common.Address, ownersOfShares [][]common.Address) ([][]*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVLByOwnerOfSharesMultiple(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddresses, ownersOfShares)
+}
+
+// GetTVLMultiple is a free data retrieval call binding the contract method 0xcacc7b0e.
+//
+// Solidity: function getTVLMultiple(address[] yieldSourceAddresses) view returns(uint256[] tvls)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetTVLMultiple(opts *bind.CallOpts, yieldSourceAddresses []common.Address) ([]*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getTVLMultiple", yieldSourceAddresses)
+
+	if err != nil {
+		return *new([]*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]*big.Int)).(*[]*big.Int)
+
+	return out0, err
+
+}
+
+// GetTVLMultiple is a free data retrieval call binding the contract method 0xcacc7b0e.
+//
+// Solidity: function getTVLMultiple(address[] yieldSourceAddresses) view returns(uint256[] tvls)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVLMultiple(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddresses)
+}
+
+// GetTVLMultiple is a free data retrieval call binding the contract method 0xcacc7b0e.
+//
+// Solidity: function getTVLMultiple(address[] yieldSourceAddresses) view returns(uint256[] tvls)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetTVLMultiple(yieldSourceAddresses []common.Address) ([]*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetTVLMultiple(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddresses)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCaller) GetWithdrawalShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	var out []interface{}
+	err := _SuperVaultYieldSourceOracle.contract.Call(opts, &out, "getWithdrawalShareOutput", yieldSourceAddress, arg1, assetsIn)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
+
+// GetWithdrawalShareOutput is a free data retrieval call binding the contract method 0x7eeb8107.
+//
+// Solidity: function getWithdrawalShareOutput(address yieldSourceAddress, address , uint256 assetsIn) view returns(uint256)
+func (_SuperVaultYieldSourceOracle *SuperVaultYieldSourceOracleCallerSession) GetWithdrawalShareOutput(yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return _SuperVaultYieldSourceOracle.Contract.GetWithdrawalShareOutput(&_SuperVaultYieldSourceOracle.CallOpts, yieldSourceAddress, arg1, assetsIn)
+}
@@ -32,12 +32,34 @@ var (
 // SuperYieldSourceOracleMetaData contains all meta data concerning the SuperYieldSourceOracle contract.
 var SuperYieldSourceOracleMetaData = &bind.MetaData{
 	ABI: "[{\"type\":\"function\",\"name\":\"getPricePerShareMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"yieldSourceOracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShare\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareMultipleQuote\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"yieldSourceOracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"baseAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"quoteAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"oracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"pricesPerShareQuote\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getPricePerShareQuote\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"base\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"quote\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"pricePerShareQuote\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"yieldSourceOracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"userTvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesMultipleQuote\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"yieldSourceOracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"ownersOfShares\",\"type\":\"address[][]\",\"internalType\":\"address[][]\"},{\"name\":\"baseAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"quoteAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"oracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"userTvlsQuote\",\"type\":\"uint256[][]\",\"internalType\":\"uint256[][]\"},{\"name\":\"totalTvlsQuote\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLByOwnerOfSharesQuote\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"ownerOfShares\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"base\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"quote\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"tvlQuote\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultiple\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"yieldSourceOracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvls\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLMultipleQuote\",\"inputs\":[{\"name\":\"yieldSourceAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"yieldSourceOracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"baseAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"quoteAddresses\",\"type\":\"address[]\",\"internalType\":\"address[]\"},{\"name\":\"oracles\",\"type\":\"address[]\",\"internalType\":\"address[]\"}],\"outputs\":[{\"name\":\"tvlsQuote\",\"type\":\"uint256[]\",\"internalType\":\"uint256[]\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getTVLQuote\",\"inputs\":[{\"name\":\"yieldSourceAddress\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"yieldSourceOracle\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"base\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"quote\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"oracle\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"tvlQuote\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ARRAY_LENGTH_MISMATCH\",\"inputs\":[]}]",
+	Bin: "0x6080604052348015600e575f5ffd5b50611ac38061001c5f395ff3fe608060405234801561000f575f5ffd5b5060043610610090575f3560e01c80637b9efab7116100635780637b9efab71461011257806390df098d14610125578063a36cdfb514610138578063cc84c43c1461014b578063d49917c61461015e575f5ffd5b8063266f3aa5146100945780632a3e703b146100bd5780634a55f4de146100de57806366a6749f146100ff575b5f5ffd5b6100a76100a236600461139f565b610171565b6040516100b49190611464565b60405180910390f35b6100d06100cb3660046114f4565b6102ef565b6040516100b49291906115fa565b6100f16100ec366004611699565b610715565b6040519081526020016100b4565b6100a761010d366004611717565b610807565b6100f161012036600461177a565b610955565b6100a76101333660046117e7565b610a3e565b6100a76101463660046117e7565b610c73565b6100f161015936600461177a565b610e47565b6100a761016c366004611717565b610e54565b825182516060919081141580610188575082518114155b156101a657604051634456f5e960e11b815260040160405180910390fd5b806001600160401b038111156101be576101be6112af565b6040519080825280602002602001820160405280156101e7578160200160208202803683370190505b5091505f5b818110156102e657848181518110610206576102066118c5565b60200260200101516001600160a01b0316634fecb26687838151811061022e5761022e6118c5565b6020026020010151868481518110610248576102486118c5565b60200260200101516040518363ffffffff1660e01b81526004016102829291906001600160a01b0392831681529116602082015260400190565b602060405180830381865afa15801561029d573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906102c191906118d9565b8382815181106102d3576102d36118c5565b60209081029190910101526001016101ec565b50509392505050565b6060806103356040518060e001604052805f81526020015f6001600160a01b03168152602001606081526020015f81526020015f81526020015f81526020015f81525090565b8851808252875114158061034b57508551815114155b8061035857508451815114155b8061036557508751815114155b8061037257508351815114155b1561039057604051634456f5e960e11b815260040160405180910390fd5b80516001600160401b038111156103a9576103a96112af565b6040519080825280602002602001820160405280156103dc57816020015b60608152602001906001900390816103c75790505b5081519093506001600160401b038111156103f9576103f96112af565b604051908082528060200260200182016040528015610422578160200160208202803683370190505b5091505f5b815181101561070857898181518110610442576104426118c5565b6020908102919091018101516001600160a01b031690830152875188908290811061046f5761046f6118c5565b60209081029190910101516040830181905251606083018190525f60808401526001600160401b038111156104a6576104a66112af565b6040519080825280602002602001820160405280156104cf578160200160208202803683370190505b508482815181106104e2576104e26118c5565b60209081029190910101525f5b82606001518110156106dd5789828151811061050d5761050d6118c5565b60200260200101516001600160a01b0316634fecb26684602001518560400151848151811061053e5761053e6118c5565b60200260200101516040518363ffffffff1660e01b81526004016105789291906001600160a01b0392831681529116602082015260400190565b602060405180830381865afa158015610593573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906105b791906118d9565b60a084015285518690839081106105d0576105d06118c5565b60200260200101516001600160a01b031663ae68676c8460a001518a85815181106105fd576105fd6118c5565b60200260200101518a8681518110610617576106176118c5565b60200260200101516040518463ffffffff1660e01b815260040161063d939291906118f0565b602060405180830381865afa158015610658573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061067c91906118d9565b60c084018190528551869084908110610697576106976118c5565b602002602001015182815181106106b0576106b06118c5565b6020026020010181815250508260c00151836080018181516106d29190611923565b9052506001016104ef565b5081608001518382815181106106f5576106f56118c5565b6020908102919091010152600101610427565b5050965096945050505050565b6040516327f6593360e11b81526001600160a01b03878116600483015285811660248301525f918291881690634fecb26690604401602060405180830381865afa158015610765573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061078991906118d9565b604051632b9a19db60e21b81529091506001600160a01b0384169063ae68676c906107bc908490899089906004016118f0565b602060405180830381865afa1580156107d7573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906107fb91906118d9565b98975050505050505050565b8151815160609190811461082e57604051634456f5e960e11b815260040160405180910390fd5b806001600160401b03811115610846576108466112af565b60405190808252806020026020018201604052801561086f578160200160208202803683370190505b5091505f5b8181101561094d5783818151811061088e5761088e6118c5565b60200260200101516001600160a01b031663ec422afd8683815181106108b6576108b66118c5565b60200260200101516040518263ffffffff1660e01b81526004016108e991906001600160a01b0391909116815260200190565b602060405180830381865afa158015610904573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061092891906118d9565b83828151811061093a5761093a6118c5565b6020908102919091010152600101610874565b505092915050565b6040516307a028bd60e11b81526001600160a01b0386811660048301525f918291871690630f40517a90602401602060405180830381865afa15801561099d573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906109c191906118d9565b604051632b9a19db60e21b81529091506001600160a01b0384169063ae68676c906109f4908490899089906004016118f0565b602060405180830381865afa158015610a0f573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610a3391906118d9565b979650505050505050565b845183516060919081141580610a55575083518114155b80610a61575085518114155b80610a6d575082518114155b15610a8b57604051634456f5e960e11b815260040160405180910390fd5b806001600160401b03811115610aa357610aa36112af565b604051908082528060200260200182016040528015610acc578160200160208202803683370190505b5091505f5b81811015610c68575f878281518110610aec57610aec6118c5565b60200260200101516001600160a01b0316630f40517a8a8481518110610b1457610b146118c5565b60200260200101516040518263ffffffff1660e01b8152600401610b4791906001600160a01b0391909116815260200190565b602060405180830381865afa158015610b62573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b8691906118d9565b9050848281518110610b9a57610b9a6118c5565b60200260200101516001600160a01b031663ae68676c82898581518110610bc357610bc36118c5565b6020026020010151898681518110610bdd57610bdd6118c5565b60200260200101516040518463ffffffff1660e01b8152600401610c03939291906118f0565b602060405180830381865afa158015610c1e573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c4291906118d9565b848381518110610c5457610c546118c5565b602090810291909101015250600101610ad1565b505095945050505050565b845183516060919081141580610c8a575083518114155b80610c96575085518114155b80610ca2575082518114155b15610cc057604051634456f5e960e11b815260040160405180910390fd5b806001600160401b03811115610cd857610cd86112af565b604051908082528060200260200182016040528015610d01578160200160208202803683370190505b5091505f5b81811015610c68575f610d65898381518110610d2457610d246118c5565b6020026020010151898481518110610d3e57610d3e6118c5565b6020026020010151898581518110610d5857610d586118c5565b6020026020010151610f9a565b9050848281518110610d7957610d796118c5565b60200260200101516001600160a01b031663ae68676c82898581518110610da257610da26118c5565b6020026020010151898681518110610dbc57610dbc6118c5565b60200260200101516040518463ffffffff1660e01b8152600401610de2939291906118f0565b602060405180830381865afa158015610dfd573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610e2191906118d9565b848381518110610e3357610e336118c5565b602090810291909101015250600101610d06565b5f5f6109c1878787610f9a565b81518151606091908114610e7b57604051634456f5e960e11b815260040160405180910390fd5b806001600160401b03811115610e9357610e936112af565b604051908082528060200260200182016040528015610ebc578160200160208202803683370190505b5091505f5b8181101561094d57838181518110610edb57610edb6118c5565b60200260200101516001600160a01b0316630f40517a868381518110610f0357610f036118c5565b60200260200101516040518263ffffffff1660e01b8152600401610f3691906001600160a01b0391909116815260200190565b602060405180830381865afa158015610f51573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610f7591906118d9565b838281518110610f8757610f876118c5565b6020908102919091010152600101610ec1565b60405163ec422afd60e01b81526001600160a01b0384811660048301525f91829185169063ec422afd90602401602060405180830381865afa158015610fe2573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061100691906118d9565b90505f6110128661108b565b9050600181600281111561102857611028611936565b14806110455750600281600281111561104357611043611936565b145b15611080575f61105485611165565b90506110768361106860ff8416600a611a2d565b670de0b6b3a76400006111d2565b9350505050611084565b5090505b9392505050565b5f816001600160a01b0316632c8ce6bc6040518163ffffffff1660e01b8152600401606060405180830381865afa9250505080156110e6575060408051601f3d908101601f191682019092526110e391810190611a38565b60015b156110f657506001949350505050565b816001600160a01b0316633ba0b9a96040518163ffffffff1660e01b8152600401602060405180830381865afa925050508015611150575060408051601f3d908101601f1916820190925261114d918101906118d9565b60015b1561115e5750600292915050565b505f919050565b5f816001600160a01b031663313ce5676040518163ffffffff1660e01b8152600401602060405180830381865afa9250505080156111c0575060408051601f3d908101601f191682019092526111bd91810190611a82565b60015b6111cc57506012919050565b92915050565b5f5f5f6111df8686611282565b91509150815f03611203578381816111f9576111f9611aa2565b0492505050611084565b81841161121a5761121a600385150260111861129e565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b634e487b7160e01b5f52604160045260245ffd5b604051601f8201601f191681016001600160401b03811182821017156112eb576112eb6112af565b604052919050565b5f6001600160401b0382111561130b5761130b6112af565b5060051b60200190565b6001600160a01b0381168114611329575f5ffd5b50565b5f82601f83011261133b575f5ffd5b813561134e611349826112f3565b6112c3565b8082825260208201915060208360051b86010192508583111561136f575f5ffd5b602085015b8381101561139557803561138781611315565b835260209283019201611374565b5095945050505050565b5f5f5f606084860312156113b1575f5ffd5b83356001600160401b038111156113c6575f5ffd5b6113d28682870161132c565b93505060208401356001600160401b038111156113ed575f5ffd5b6113f98682870161132c565b92505060408401356001600160401b03811115611414575f5ffd5b6114208682870161132c565b9150509250925092565b5f8151808452602084019350602083015f5b8281101561145a57815186526020958601959091019060010161143c565b5093949350505050565b602081525f611084602083018461142a565b5f82601f830112611485575f5ffd5b8135611493611349826112f3565b8082825260208201915060208360051b8601019250858311156114b4575f5ffd5b602085015b838110156113955780356001600160401b038111156114d6575f5ffd5b6114e5886020838a010161132c565b845250602092830192016114b9565b5f5f5f5f5f5f60c08789031215611509575f5ffd5b86356001600160401b0381111561151e575f5ffd5b61152a89828a0161132c565b96505060208701356001600160401b03811115611545575f5ffd5b61155189828a0161132c565b95505060408701356001600160401b0381111561156c575f5ffd5b61157889828a01611476565b94505060608701356001600160401b03811115611593575f5ffd5b61159f89828a0161132c565b93505060808701356001600160401b038111156115ba575f5ffd5b6115c689828a0161132c565b92505060a08701356001600160401b038111156115e1575f5ffd5b6115ed89828a0161132c565b9150509295509295509295565b5f604082016040835280855180835260608501915060608160051b8601019250602087015f5b8281101561167a57868503605f19018452815180518087526020918201918701905f5b81811015611661578351835260209384019390920191600101611643565b5090965050506020938401939190910190600101611620565b505050508281036020840152611690818561142a565b95945050505050565b5f5f5f5f5f5f60c087890312156116ae575f5ffd5b86356116b981611315565b955060208701356116c981611315565b945060408701356116d981611315565b935060608701356116e981611315565b925060808701356116f981611315565b915060a087013561170981611315565b809150509295509295509295565b5f5f60408385031215611728575f5ffd5b82356001600160401b0381111561173d575f5ffd5b6117498582860161132c565b92505060208301356001600160401b03811115611764575f5ffd5b6117708582860161132c565b9150509250929050565b5f5f5f5f5f60a0868803121561178e575f5ffd5b853561179981611315565b945060208601356117a981611315565b935060408601356117b981611315565b925060608601356117c981611315565b915060808601356117d981611315565b809150509295509295909350565b5f5f5f5f5f60a086880312156117fb575f5ffd5b85356001600160401b03811115611810575f5ffd5b61181c8882890161132c565b95505060208601356001600160401b03811115611837575f5ffd5b6118438882890161132c565b94505060408601356001600160401b0381111561185e575f5ffd5b61186a8882890161132c565b93505060608601356001600160401b03811115611885575f5ffd5b6118918882890161132c565b92505060808601356001600160401b038111156118ac575f5ffd5b6118b88882890161132c565b9150509295509295909350565b634e487b7160e01b5f52603260045260245ffd5b5f602082840312156118e9575f5ffd5b5051919050565b9283526001600160a01b03918216602084015216604082015260600190565b634e487b7160e01b5f52601160045260245ffd5b808201808211156111cc576111cc61190f565b634e487b7160e01b5f52602160045260245ffd5b6001815b6001841115611985578085048111156119695761196961190f565b600184161561197757908102905b60019390931c92800261194e565b935093915050565b5f8261199b575060016111cc565b816119a757505f6111cc565b81600181146119bd57600281146119c7576119e3565b60019150506111cc565b60ff8411156119d8576119d861190f565b50506001821b6111cc565b5060208310610133831016604e8410600b8410161715611a06575081810a6111cc565b611a125f19848461194a565b805f1904821115611a2557611a2561190f565b029392505050565b5f611084838361198d565b5f5f5f60608486031215611a4a575f5ffd5b8351611a5581611315565b6020850151909350611a6681611315565b6040850151909250611a7781611315565b809150509250925092565b5f60208284031215611a92575f5ffd5b815160ff81168114611084575f5ffd5b634e487b7160e01b5f52601260045260245ffdfea164736f6c634300081e000a",
 }
 
 // SuperYieldSourceOracleABI is the input ABI used to generate the binding from.
 // Deprecated: Use SuperYieldSourceOracleMetaData.ABI instead.
 var SuperYieldSourceOracleABI = SuperYieldSourceOracleMetaData.ABI
 
+// SuperYieldSourceOracleBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SuperYieldSourceOracleMetaData.Bin instead.
+var SuperYieldSourceOracleBin = SuperYieldSourceOracleMetaData.Bin
+
+// DeploySuperYieldSourceOracle deploys a new Ethereum contract, binding an instance of SuperYieldSourceOracle to it.
+func DeploySuperYieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *SuperYieldSourceOracle, error) {
+	parsed, err := SuperYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SuperYieldSourceOracleBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SuperYieldSourceOracle{SuperYieldSourceOracleCaller: SuperYieldSourceOracleCaller{contract: contract}, SuperYieldSourceOracleTransactor: SuperYieldSourceOracleTransactor{contract: contract}, SuperYieldSourceOracleFilterer: SuperYieldSourceOracleFilterer{contract: contract}}, nil
+}
+
 // SuperYieldSourceOracle is an auto generated Go binding around an Ethereum contract.
 type SuperYieldSourceOracle struct {
 	SuperYieldSourceOracleCaller     // Read-only binding to the contract
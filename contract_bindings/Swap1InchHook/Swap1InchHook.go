@@ -0,0 +1,749 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package Swap1InchHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Swap1InchHookMetaData contains all meta data concerning the Swap1InchHook contract.
+var Swap1InchHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"aggregationRouter_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"AGGREGATION_ROUTER\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractI1InchAggregationRouterV6\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"NATIVE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"packed\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_DESTINATION_TOKEN\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_INPUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_OUTPUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_RECEIVER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SELECTOR\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_SELECTOR_OFFSET\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_TOKEN_PAIR\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PARTIAL_FILL_NOT_ALLOWED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"SafeCastOverflowedIntDowncast\",\"inputs\":[{\"name\":\"bits\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"value\",\"type\":\"int256\",\"internalType\":\"int256\"}]},{\"type\":\"error\",\"name\":\"SafeCastOverflowedUintDowncast\",\"inputs\":[{\"name\":\"bits\",\"type\":\"uint8\",\"internalType\":\"uint8\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_ADDRESS\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b506040516121c83803806121c883398101604081905261002e916100af565b6040805180820190915260048152630537761760e41b6020909101525f805460ff191690557fbce316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab6080526001600160a01b03811661009e5760405163538ba4f960e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a0516120bd61010b5f395f81816101560152610b9601525f818161020f015261028501526120bd5ff3fe608060405234801561000f575f5ffd5b506004361061011c575f3560e01c80635492e677116100a9578063984d6e7a1161006e578063984d6e7a14610280578063a0cf0aea146102a7578063d06a1e89146102c2578063e445e7dd146102d5578063e7745517146102ee575f5ffd5b80635492e6771461020d578063685a943c1461023357806381cbe6911461023b5780638c4313c11461024e5780638e1487761461026e575f5ffd5b80631f264619116100ef5780631f264619146101a35780632113522a146101b65780632ae2fe3d146101c857806338d52e0f146101db5780633b5896bc146101ed575f5ffd5b806301bd43ef1461012057806305b4fe911461013c5780630621153b1461015157806314cb37cf14610190575b5f5ffd5b61012960035c81565b6040519081526020015b60405180910390f35b61014f61014a3660046119d7565b610311565b005b6101787f000000000000000000000000000000000000000000000000000000000000000081565b6040516001600160a01b039091168152602001610133565b61014f61019e366004611a38565b61038b565b61014f6101b1366004611a53565b6103ac565b6101786001600160a01b0360045c1681565b61014f6101d63660046119d7565b610405565b6101786001600160a01b0360025c1681565b6102006101fb3660046119d7565b610478565b6040516101339190611aaf565b7f0000000000000000000000000000000000000000000000000000000000000000610129565b6101295f5c81565b610129610249366004611a38565b610691565b61026161025c366004611b3c565b6106a9565b6040516101339190611b7b565b6101786001600160a01b0360015c1681565b6101297f000000000000000000000000000000000000000000000000000000000000000081565b61017873eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee81565b61014f6102d0366004611a38565b6108ee565b5f546102e19060ff1681565b6040516101339190611ba1565b6103016102fc366004611c8f565b61096b565b6040519015158152602001610133565b336001600160a01b0384161461033a5760405163e15e56c960e01b815260040160405180910390fd5b5f61034484610977565b905061034f8161098a565b1561036d5760405163945b63f560e01b815260040160405180910390fd5b610378816001610997565b610384858585856109ad565b5050505050565b610394816109da565b50336004805c6001600160a01b0319168217905d5050565b5f6103b682610977565b90506103c181610a0c565b806103d057506103d08161098a565b156103ee5760405163441e4c7360e11b815260040160405180910390fd5b5f6103fa826001610a15565b905083815d50505050565b336001600160a01b0384161461042e5760405163e15e56c960e01b815260040160405180910390fd5b5f61043884610977565b905061044381610a0c565b1561046157604051630bbb04d960e11b815260040160405180910390fd5b61046c816001610a6a565b61038485858585610a76565b60605f61048786868686610a84565b9050805160026104979190611cdd565b67ffffffffffffffff8111156104af576104af611bc7565b6040519080825280602002602001820160405280156104fb57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104cd5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d898989896040516024016105449493929190611cf0565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f8151811061058657610586611d38565b60209081029190910101525f5b81518110156105e7578181815181106105ae576105ae611d38565b6020026020010151838260016105c49190611cdd565b815181106105d4576105d4611d38565b6020908102919091010152600101610593565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161062e9493929190611cf0565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161066d9190611d4c565b8151811061067d5761067d611d38565b602002602001018190525050949350505050565b5f6106a361069e83610977565b610cb7565b92915050565b6060365f6106ba8460498188611d5f565b90925090505f6106cd6004828486611d5f565b6106d691611d86565b9050630e9b51bf60e11b6001600160e01b031982160161077f575f80806107008560048189611d5f565b81019061070d9190611dbc565b9450505092509250610725836001600160a01b031690565b6001600160a01b0383166001600160a01b0383166040516001600160601b0319606094851b8116602083015292841b83166034820152921b166048820152605c0160405160208183030381529060405296505050506108e5565b63f812dc8760e01b6001600160e01b0319821601610824575f806107a68460048188611d5f565b8101906107b39190611df3565b50805160208083015160408085015160608087015192516001600160601b031989831b81169682019690965295811b8516603487015292831b84166048860152821b8316605c850152901b1660708201529193509150608401604051602081830303815290604052955050506108e5565b633b29ad5160e01b6001600160e01b03198216016108cc575f80808061084d866004818a611d5f565b81019061085a9190611eca565b505050505093509350935093508383610879846001600160a01b031690565b6040516001600160601b0319606094851b8116602083015292841b8316603482015290831b821660488201529183901b16605c8201526070016040516020818303038152906040529750505050506108e5565b60405163d858d3d360e01b815260040160405180910390fd5b50505092915050565b336001600160a01b0360045c16146109195760405163e15e56c960e01b815260040160405180910390fd5b5f61092382610977565b905061092e81610a0c565b1580610940575061093e8161098a565b155b1561095e57604051634bd439b560e11b815260040160405180910390fd5b61096781610cc4565b5050565b5f6106a3826048610cdb565b5f5f61098283610d07565b5c9392505050565b5f5f610982836003610a15565b5f6109a3836003610a15565b905081815d505050565b6109d46109b984610691565b6109c4848487610d6e565b6109ce9190611d4c565b84610e6e565b50505050565b5f6003805c90826109ea83611f45565b9190505d505f6109f983610d07565b905060035c80825d505060035c92915050565b5f5f6109828360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6109a3836002610a15565b6109d46109ce838386610d6e565b60605f610a946014828587611d5f565b610a9d91611f5d565b60601c90505f610ab1602860148688611d5f565b610aba91611f5d565b60601c90505f610ace604860288789611d5f565b610ad791611f93565b5f1c90505f610b1d87878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060489250610cdb915050565b9050365f610b2e886049818c611d5f565b915091505f610b4287878e8e888888610e86565b604080516001808252818301909252919250816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610b5957905050975060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03168152602001858015610bcf57505f87115b610bd95786610c41565b6040516381cbe69160e01b81526001600160a01b038e811660048301528f16906381cbe69190602401602060405180830381865afa158015610c1d573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c419190611fb0565b815260200185610c865784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610c8892505050565b825b815250885f81518110610c9d57610c9d611d38565b602002602001018190525050505050505050949350505050565b5f5f610982836001610a15565b610cce815f610a6a565b610cd8815f610997565b50565b5f828281518110610cee57610cee611d38565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610d5192919091825260601b6001600160601b031916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f80610d7d6014828688611d5f565b610d8691611f5d565b60601c90505f610d9a602860148789611d5f565b610da391611f5d565b60601c905080610db05750825b6001600160a01b03821673eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee1480610de257506001600160a01b038216155b15610dfa576001600160a01b0316319150610e679050565b6040516370a0823160e01b81526001600160a01b0382811660048301528316906370a0823190602401602060405180830381865afa158015610e3e573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610e629190611fb0565b925050505b9392505050565b5f610e7882610977565b90505f6103fa826001610a15565b60605f610e966004828587611d5f565b610e9f91611d86565b9050630e9b51bf60e11b6001600160e01b0319821601610eda57610ed3610ec98460048188611d5f565b8a8c8b8b8b610f76565b9150610f3e565b63f812dc8760e01b6001600160e01b0319821601610f0c57610ed3610f028460048188611d5f565b8a8c8b8b8b61141d565b633b29ad5160e01b6001600160e01b03198216016108cc57610ed3610f348460048188611d5f565b8a8c8b8b8b6115dc565b815115610f6a578082604051602001610f58929190611fc7565b60405160208183030381529060405291505b50979650505050505050565b60605f80808080610f898c8e018e611dbc565b945094509450945094505f5f610f9e8361177c565b90506002816002811115610fb457610fb4611b8d565b0361114f5760ff60d084901c81169060d885901c165f610fd382611793565b9050825f0361104b576040516387cb4f5760e01b8152600481018390526001600160a01b038716906387cb4f57906024015b602060405180830381865afa158015611020573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906110449190611ff2565b9450611147565b8260040361109b576001600160a01b0386166323746eb86110746001600160801b0384166117cf565b6040516001600160e01b031960e084901b168152600f9190910b6004820152602401611005565b826008036110d05760405163c661065760e01b8152600481018390526001600160a01b0387169063c661065790602401611005565b82600c036110f9576001600160a01b03861663b739953e6110746001600160801b0384166117cf565b8260100361112e57604051630b9947eb60e41b8152600481018390526001600160a01b0387169063b9947eb090602401611005565b604051632a3b8b8360e11b815260040160405180910390fd5b505050611282565b5f6001600160a01b0384166001600160a01b0316630dfe16816040518163ffffffff1660e01b8152600401602060405180830381865afa158015611195573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906111b99190611ff2565b90505f6001600160a01b0385166001600160a01b031663d21220a76040518163ffffffff1660e01b8152600401602060405180830381865afa158015611201573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906112259190611ff2565b90506001600160a01b038881169083168190036112445781945061127e565b806001600160a01b0316826001600160a01b0316036112655782945061127e565b604051630374f15d60e31b815260040160405180910390fd5b5050505b61128b83611803565b156112a85773eeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeeee91505b881561132a576040516381cbe69160e01b81526001600160a01b038b811660048301528691908d16906381cbe69190602401602060405180830381865afa1580156112f5573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906113199190611fb0565b9550611326868287611812565b9450505b845f0361134a5760405163324dfcff60e11b815260040160405180910390fd5b835f0361136a576040516385b1b8ff60e01b815260040160405180910390fd5b816001600160a01b03168c6001600160a01b03161461139c57604051630912106360e41b815260040160405180910390fd5b6001600160a01b03878116908e1681146113c957604051632b0ddb9160e01b815260040160405180910390fd5b891561140a5760408051602081018a9052908101889052606081018790526080810186905260a0810185905260c00160405160208183030381529060405298505b5050505050505050979650505050505050565b60605f808061142e8a8c018c611df3565b92509250925060018260c00151165f1461145b5760405163bfc016d560e01b815260040160405180910390fd5b876001600160a01b031682602001516001600160a01b03161461149157604051630912106360e41b815260040160405180910390fd5b886001600160a01b031682606001516001600160a01b0316146114c757604051632b0ddb9160e01b815260040160405180910390fd5b84156115595760808201516040516381cbe69160e01b81526001600160a01b0388811660048301528916906381cbe69190602401602060405180830381865afa158015611516573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061153a9190611fb0565b6080840181905260a084015161155291908390611812565b60a0840152505b81608001515f0361157d5760405163324dfcff60e11b815260040160405180910390fd5b8160a001515f036115a1576040516385b1b8ff60e01b815260040160405180910390fd5b84156115ce578282826040516020016115bc9392919061200d565b60405160208183030381529060405293505b505050979650505050505050565b60605f8080806115ee8b8d018d611eca565b50505095509550955050945050896001600160a01b0316846001600160a01b03161461162d57604051632b0ddb9160e01b815260040160405180910390fd5b886001600160a01b0316836001600160a01b03161461165f57604051630912106360e41b815260040160405180910390fd5b85156116e1576040516381cbe69160e01b81526001600160a01b0388811660048301528391908a16906381cbe69190602401602060405180830381865afa1580156116ac573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906116d09190611fb0565b92506116dd838284611812565b9150505b815f036117015760405163324dfcff60e11b815260040160405180910390fd5b805f03611721576040516385b1b8ff60e01b815260040160405180910390fd5b851561176d578b8b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525050505060a0810183905260c0810182905294505b50505050979650505050505050565b5f60fd82901c60028111156106a3576106a3611b8d565b5f6001600160801b038211156117cb576040516306dfcc6560e41b815260806004820152602481018390526044015b60405180910390fd5b5090565b80600f81900b81146117fe5760405163327269a760e01b815260806004820152602481018390526044016117c2565b919050565b5f600160fc1b821615156106a3565b5f825f03611821575080610e67565b82841461189a578284111561186a575f61184861183e8587611d4c565b620186a0866118a1565b90506118588382620186a06118a1565b6118629084611cdd565b92505061189a565b5f61187861183e8686611d4c565b90505f6118898483620186a06118a1565b90506118958185611d4c565b935050505b5092915050565b5f5f5f6118ae8686611951565b91509150815f036118d2578381816118c8576118c861209c565b0492505050610e67565b8184116118e9576118e9600385150260111861196d565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b6001600160a01b0381168114610cd8575f5ffd5b5f5f83601f8401126119a2575f5ffd5b50813567ffffffffffffffff8111156119b9575f5ffd5b6020830191508360208285010111156119d0575f5ffd5b9250929050565b5f5f5f5f606085870312156119ea575f5ffd5b84356119f58161197e565b93506020850135611a058161197e565b9250604085013567ffffffffffffffff811115611a20575f5ffd5b611a2c87828801611992565b95989497509550505050565b5f60208284031215611a48575f5ffd5b8135610e678161197e565b5f5f60408385031215611a64575f5ffd5b823591506020830135611a768161197e565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015611b3057868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290611b1a90870182611a81565b9550506020938401939190910190600101611ad5565b50929695505050505050565b5f5f60208385031215611b4d575f5ffd5b823567ffffffffffffffff811115611b63575f5ffd5b611b6f85828601611992565b90969095509350505050565b602081525f610e676020830184611a81565b634e487b7160e01b5f52602160045260245ffd5b6020810160038310611bc157634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b60405160e0810167ffffffffffffffff81118282101715611bfe57611bfe611bc7565b60405290565b5f82601f830112611c13575f5ffd5b813567ffffffffffffffff811115611c2d57611c2d611bc7565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715611c5c57611c5c611bc7565b604052818152838201602001851015611c73575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f60208284031215611c9f575f5ffd5b813567ffffffffffffffff811115611cb5575f5ffd5b611cc184828501611c04565b949350505050565b634e487b7160e01b5f52601160045260245ffd5b808201808211156106a3576106a3611cc9565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156106a3576106a3611cc9565b5f5f85851115611d6d575f5ffd5b83861115611d79575f5ffd5b5050820193919092039150565b80356001600160e01b0319811690600484101561189a576001600160e01b031960049490940360031b84901b1690921692915050565b5f5f5f5f5f60a08688031215611dd0575f5ffd5b505083359560208501359550604085013594606081013594506080013592509050565b5f5f5f838503610120811215611e07575f5ffd5b8435611e128161197e565b935060e0601f1982011215611e25575f5ffd5b50611e2e611bdb565b6020850135611e3c8161197e565b81526040850135611e4c8161197e565b60208201526060850135611e5f8161197e565b60408201526080850135611e728161197e565b606082015260a085810135608083015260c0808701359183019190915260e086013590820152915061010084013567ffffffffffffffff811115611eb4575f5ffd5b611ec086828701611c04565b9150509250925092565b5f5f5f5f5f5f5f5f5f6101208a8c031215611ee3575f5ffd5b8935611eee8161197e565b985060208a0135611efe8161197e565b975060408a0135965060608a0135611f158161197e565b989b979a50959860808101359760a0820135975060c0820135965060e08201359550610100909101359350915050565b5f60018201611f5657611f56611cc9565b5060010190565b80356001600160601b0319811690601484101561189a576001600160601b031960149490940360031b84901b1690921692915050565b803560208310156106a3575f19602084900360031b1b1692915050565b5f60208284031215611fc0575f5ffd5b5051919050565b6001600160e01b03198316815281515f908060208501600485015e5f92016004019182525092915050565b5f60208284031215612002575f5ffd5b8151610e678161197e565b60018060a01b038416815260018060a01b03835116602082015260018060a01b03602084015116604082015260018060a01b03604084015116606082015260018060a01b036060840151166080820152608083015160a082015260a083015160c082015260c083015160e08201526101206101008201525f612093610120830184611a81565b95945050505050565b634e487b7160e01b5f52601260045260245ffdfea164736f6c634300081e000a",
+}
+
+// Swap1InchHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use Swap1InchHookMetaData.ABI instead.
+var Swap1InchHookABI = Swap1InchHookMetaData.ABI
+
+// Swap1InchHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use Swap1InchHookMetaData.Bin instead.
+var Swap1InchHookBin = Swap1InchHookMetaData.Bin
+
+// DeploySwap1InchHook deploys a new Ethereum contract, binding an instance of Swap1InchHook to it.
+func DeploySwap1InchHook(auth *bind.TransactOpts, backend bind.ContractBackend, aggregationRouter_ common.Address) (common.Address, *types.Transaction, *Swap1InchHook, error) {
+	parsed, err := Swap1InchHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(Swap1InchHookBin), backend, aggregationRouter_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &Swap1InchHook{Swap1InchHookCaller: Swap1InchHookCaller{contract: contract}, Swap1InchHookTransactor: Swap1InchHookTransactor{contract: contract}, Swap1InchHookFilterer: Swap1InchHookFilterer{contract: contract}}, nil
+}
+
+// Swap1InchHook is an auto generated Go binding around an Ethereum contract.
+type Swap1InchHook struct {
+	Swap1InchHookCaller     // Read-only binding to the contract
+	Swap1InchHookTransactor // Write-only binding to the contract
+	Swap1InchHookFilterer   // Log filterer for contract events
+}
+
+// Swap1InchHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type Swap1InchHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Swap1InchHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type Swap1InchHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Swap1InchHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type Swap1InchHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// Swap1InchHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type Swap1InchHookSession struct {
+	Contract     *Swap1InchHook    // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// Swap1InchHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type Swap1InchHookCallerSession struct {
+	Contract *Swap1InchHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts        // Call options to use throughout this session
+}
+
+// Swap1InchHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type Swap1InchHookTransactorSession struct {
+	Contract     *Swap1InchHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// Swap1InchHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type Swap1InchHookRaw struct {
+	Contract *Swap1InchHook // Generic contract binding to access the raw methods on
+}
+
+// Swap1InchHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type Swap1InchHookCallerRaw struct {
+	Contract *Swap1InchHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// Swap1InchHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type Swap1InchHookTransactorRaw struct {
+	Contract *Swap1InchHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSwap1InchHook creates a new instance of Swap1InchHook, bound to a specific deployed contract.
+func NewSwap1InchHook(address common.Address, backend bind.ContractBackend) (*Swap1InchHook, error) {
+	contract, err := bindSwap1InchHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Swap1InchHook{Swap1InchHookCaller: Swap1InchHookCaller{contract: contract}, Swap1InchHookTransactor: Swap1InchHookTransactor{contract: contract}, Swap1InchHookFilterer: Swap1InchHookFilterer{contract: contract}}, nil
+}
+
+// NewSwap1InchHookCaller creates a new read-only instance of Swap1InchHook, bound to a specific deployed contract.
+func NewSwap1InchHookCaller(address common.Address, caller bind.ContractCaller) (*Swap1InchHookCaller, error) {
+	contract, err := bindSwap1InchHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Swap1InchHookCaller{contract: contract}, nil
+}
+
+// NewSwap1InchHookTransactor creates a new write-only instance of Swap1InchHook, bound to a specific deployed contract.
+func NewSwap1InchHookTransactor(address common.Address, transactor bind.ContractTransactor) (*Swap1InchHookTransactor, error) {
+	contract, err := bindSwap1InchHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &Swap1InchHookTransactor{contract: contract}, nil
+}
+
+// NewSwap1InchHookFilterer creates a new log filterer instance of Swap1InchHook, bound to a specific deployed contract.
+func NewSwap1InchHookFilterer(address common.Address, filterer bind.ContractFilterer) (*Swap1InchHookFilterer, error) {
+	contract, err := bindSwap1InchHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &Swap1InchHookFilterer{contract: contract}, nil
+}
+
+// bindSwap1InchHook binds a generic wrapper to an already deployed contract.
+func bindSwap1InchHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := Swap1InchHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Swap1InchHook *Swap1InchHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Swap1InchHook.Contract.Swap1InchHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Swap1InchHook *Swap1InchHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.Swap1InchHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Swap1InchHook *Swap1InchHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.Swap1InchHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_Swap1InchHook *Swap1InchHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _Swap1InchHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_Swap1InchHook *Swap1InchHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_Swap1InchHook *Swap1InchHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// AGGREGATIONROUTER is a free data retrieval call binding the contract method 0x0621153b.
+//
+// Solidity: function AGGREGATION_ROUTER() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCaller) AGGREGATIONROUTER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "AGGREGATION_ROUTER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// AGGREGATIONROUTER is a free data retrieval call binding the contract method 0x0621153b.
+//
+// Solidity: function AGGREGATION_ROUTER() view returns(address)
+func (_Swap1InchHook *Swap1InchHookSession) AGGREGATIONROUTER() (common.Address, error) {
+	return _Swap1InchHook.Contract.AGGREGATIONROUTER(&_Swap1InchHook.CallOpts)
+}
+
+// AGGREGATIONROUTER is a free data retrieval call binding the contract method 0x0621153b.
+//
+// Solidity: function AGGREGATION_ROUTER() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCallerSession) AGGREGATIONROUTER() (common.Address, error) {
+	return _Swap1InchHook.Contract.AGGREGATIONROUTER(&_Swap1InchHook.CallOpts)
+}
+
+// NATIVE is a free data retrieval call binding the contract method 0xa0cf0aea.
+//
+// Solidity: function NATIVE() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCaller) NATIVE(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "NATIVE")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// NATIVE is a free data retrieval call binding the contract method 0xa0cf0aea.
+//
+// Solidity: function NATIVE() view returns(address)
+func (_Swap1InchHook *Swap1InchHookSession) NATIVE() (common.Address, error) {
+	return _Swap1InchHook.Contract.NATIVE(&_Swap1InchHook.CallOpts)
+}
+
+// NATIVE is a free data retrieval call binding the contract method 0xa0cf0aea.
+//
+// Solidity: function NATIVE() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCallerSession) NATIVE() (common.Address, error) {
+	return _Swap1InchHook.Contract.NATIVE(&_Swap1InchHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Swap1InchHook *Swap1InchHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Swap1InchHook *Swap1InchHookSession) SUBTYPE() ([32]byte, error) {
+	return _Swap1InchHook.Contract.SUBTYPE(&_Swap1InchHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_Swap1InchHook *Swap1InchHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _Swap1InchHook.Contract.SUBTYPE(&_Swap1InchHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Swap1InchHook *Swap1InchHookSession) Asset() (common.Address, error) {
+	return _Swap1InchHook.Contract.Asset(&_Swap1InchHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCallerSession) Asset() (common.Address, error) {
+	return _Swap1InchHook.Contract.Asset(&_Swap1InchHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Swap1InchHook *Swap1InchHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Swap1InchHook *Swap1InchHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Swap1InchHook.Contract.Build(&_Swap1InchHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_Swap1InchHook *Swap1InchHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _Swap1InchHook.Contract.Build(&_Swap1InchHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Swap1InchHook *Swap1InchHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Swap1InchHook *Swap1InchHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Swap1InchHook.Contract.DecodeUsePrevHookAmount(&_Swap1InchHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_Swap1InchHook *Swap1InchHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _Swap1InchHook.Contract.DecodeUsePrevHookAmount(&_Swap1InchHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookSession) ExecutionNonce() (*big.Int, error) {
+	return _Swap1InchHook.Contract.ExecutionNonce(&_Swap1InchHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _Swap1InchHook.Contract.ExecutionNonce(&_Swap1InchHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Swap1InchHook.Contract.GetOutAmount(&_Swap1InchHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _Swap1InchHook.Contract.GetOutAmount(&_Swap1InchHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Swap1InchHook *Swap1InchHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Swap1InchHook *Swap1InchHookSession) HookType() (uint8, error) {
+	return _Swap1InchHook.Contract.HookType(&_Swap1InchHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_Swap1InchHook *Swap1InchHookCallerSession) HookType() (uint8, error) {
+	return _Swap1InchHook.Contract.HookType(&_Swap1InchHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes packed)
+func (_Swap1InchHook *Swap1InchHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes packed)
+func (_Swap1InchHook *Swap1InchHookSession) Inspect(data []byte) ([]byte, error) {
+	return _Swap1InchHook.Contract.Inspect(&_Swap1InchHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes packed)
+func (_Swap1InchHook *Swap1InchHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _Swap1InchHook.Contract.Inspect(&_Swap1InchHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Swap1InchHook *Swap1InchHookSession) LastCaller() (common.Address, error) {
+	return _Swap1InchHook.Contract.LastCaller(&_Swap1InchHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCallerSession) LastCaller() (common.Address, error) {
+	return _Swap1InchHook.Contract.LastCaller(&_Swap1InchHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Swap1InchHook *Swap1InchHookSession) SpToken() (common.Address, error) {
+	return _Swap1InchHook.Contract.SpToken(&_Swap1InchHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_Swap1InchHook *Swap1InchHookCallerSession) SpToken() (common.Address, error) {
+	return _Swap1InchHook.Contract.SpToken(&_Swap1InchHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Swap1InchHook *Swap1InchHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Swap1InchHook *Swap1InchHookSession) Subtype() ([32]byte, error) {
+	return _Swap1InchHook.Contract.Subtype(&_Swap1InchHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_Swap1InchHook *Swap1InchHookCallerSession) Subtype() ([32]byte, error) {
+	return _Swap1InchHook.Contract.Subtype(&_Swap1InchHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _Swap1InchHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookSession) UsedShares() (*big.Int, error) {
+	return _Swap1InchHook.Contract.UsedShares(&_Swap1InchHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_Swap1InchHook *Swap1InchHookCallerSession) UsedShares() (*big.Int, error) {
+	return _Swap1InchHook.Contract.UsedShares(&_Swap1InchHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Swap1InchHook *Swap1InchHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Swap1InchHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Swap1InchHook *Swap1InchHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.PostExecute(&_Swap1InchHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_Swap1InchHook *Swap1InchHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.PostExecute(&_Swap1InchHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Swap1InchHook *Swap1InchHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Swap1InchHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Swap1InchHook *Swap1InchHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.PreExecute(&_Swap1InchHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_Swap1InchHook *Swap1InchHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.PreExecute(&_Swap1InchHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Swap1InchHook *Swap1InchHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Swap1InchHook *Swap1InchHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.ResetExecutionState(&_Swap1InchHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_Swap1InchHook *Swap1InchHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.ResetExecutionState(&_Swap1InchHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Swap1InchHook *Swap1InchHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Swap1InchHook *Swap1InchHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.SetExecutionContext(&_Swap1InchHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_Swap1InchHook *Swap1InchHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.SetExecutionContext(&_Swap1InchHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Swap1InchHook *Swap1InchHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Swap1InchHook *Swap1InchHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.SetOutAmount(&_Swap1InchHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_Swap1InchHook *Swap1InchHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _Swap1InchHook.Contract.SetOutAmount(&_Swap1InchHook.TransactOpts, _outAmount, caller)
+}
@@ -0,0 +1,718 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package SwapOdosV2Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// SwapOdosV2HookMetaData contains all meta data concerning the SwapOdosV2Hook contract.
+var SwapOdosV2HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"_routerV2\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"ODOS_ROUTER_V2\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIOdosRouterV2\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b506040516119d03803806119d083398101604081905261002e916100af565b6040805180820190915260048152630537761760e41b6020909101525f805460ff191690557fbce316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab6080526001600160a01b03811661009e57604051630f58648f60e01b815260040160405180910390fd5b6001600160a01b031660a0526100dc565b5f602082840312156100bf575f5ffd5b81516001600160a01b03811681146100d5575f5ffd5b9392505050565b60805160a0516118c561010b5f395f81816102a60152610ce601525f81816101dd015261025301526118c55ff3fe608060405234801561000f575f5ffd5b5060043610610111575f3560e01c8063685a943c1161009e578063984d6e7a1161006e578063984d6e7a1461024e578063d06a1e8914610275578063e445e7dd14610288578063e50c1f66146102a1578063e7745517146102c8575f5ffd5b8063685a943c1461020157806381cbe691146102095780638c4313c11461021c5780638e1487761461023c575f5ffd5b80632113522a116100e45780632113522a1461016c5780632ae2fe3d1461019657806338d52e0f146101a95780633b5896bc146101bb5780635492e677146101db575f5ffd5b806301bd43ef1461011557806305b4fe911461013157806314cb37cf146101465780631f26461914610159575b5f5ffd5b61011e60035c81565b6040519081526020015b60405180910390f35b61014461013f3660046114b4565b6102eb565b005b610144610154366004611511565b610365565b61014461016736600461152a565b610386565b61017e6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610128565b6101446101a43660046114b4565b6103df565b61017e6001600160a01b0360025c1681565b6101ce6101c93660046114b4565b610452565b6040516101289190611582565b7f000000000000000000000000000000000000000000000000000000000000000061011e565b61011e5f5c81565b61011e610217366004611511565b61066b565b61022f61022a36600461160f565b610683565b604051610128919061164e565b61017e6001600160a01b0360015c1681565b61011e7f000000000000000000000000000000000000000000000000000000000000000081565b610144610283366004611511565b61082e565b5f546102949060ff1681565b6040516101289190611660565b61017e7f000000000000000000000000000000000000000000000000000000000000000081565b6102db6102d636600461169a565b6108ab565b6040519015158152602001610128565b336001600160a01b038416146103145760405163e15e56c960e01b815260040160405180910390fd5b5f61031e846108b7565b9050610329816108ca565b156103475760405163945b63f560e01b815260040160405180910390fd5b6103528160016108d7565b61035e858585856108ed565b5050505050565b61036e8161094e565b50336004805c6001600160a01b0319168217905d5050565b5f610390826108b7565b905061039b81610980565b806103aa57506103aa816108ca565b156103c85760405163441e4c7360e11b815260040160405180910390fd5b5f6103d4826001610989565b905083815d50505050565b336001600160a01b038416146104085760405163e15e56c960e01b815260040160405180910390fd5b5f610412846108b7565b905061041d81610980565b1561043b57604051630bbb04d960e11b815260040160405180910390fd5b6104468160016109de565b61035e858585856109ea565b60605f61046186868686610a2c565b9050805160026104719190611761565b67ffffffffffffffff81111561048957610489611686565b6040519080825280602002602001820160405280156104d557816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104a75790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161051e9493929190611774565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f81518110610560576105606117bc565b60209081029190910101525f5b81518110156105c157818181518110610588576105886117bc565b60200260200101518382600161059e9190611761565b815181106105ae576105ae6117bc565b602090810291909101015260010161056d565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016106089493929190611774565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161064791906117d0565b81518110610657576106576117bc565b602002602001018190525050949350505050565b5f61067d610678836108b7565b610de7565b92915050565b60605f6106c784848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609d9250610df4915050565b90505f61071585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610710925086915060bd9050611761565b610e56565b905061075585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610e56915050565b61079686868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610e56915050565b6107d787878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060489250610e56915050565b6040516bffffffffffffffffffffffff19606094851b8116602083015292841b8316603482015290831b821660488201529183901b16605c8201526070016040516020818303038152906040529250505092915050565b336001600160a01b0360045c16146108595760405163e15e56c960e01b815260040160405180910390fd5b5f610863826108b7565b905061086e81610980565b1580610880575061087e816108ca565b155b1561089e57604051634bd439b560e11b815260040160405180910390fd5b6108a781610eba565b5050565b5f61067d82609c610ed1565b5f5f6108c283610efd565b5c9392505050565b5f5f6108c2836003610989565b5f6108e3836003610989565b905081815d505050565b6109486108f98461066b565b6109388585858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f6992505050565b61094291906117d0565b84611008565b50505050565b5f6003805c908261095e836117e3565b9190505d505f61096d83610efd565b905060035c80825d505060035c92915050565b5f5f6108c28360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6108e3836002610989565b6109486109428484848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610f6992505050565b60605f610a7084848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609d9250610df4915050565b90505f610ab685858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060bd92508691506110209050565b90505f610aff86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610710925087915060bd9050611761565b90505f610b5887878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610b48925088915060bd9050611761565b610b53906014611761565b611127565b90505f610b9988888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201829052509250610e56915050565b90505f610bdd89898080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060149250610df4915050565b90505f610c218a8a8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250609c9250610ed1915050565b90508015610c94576040516381cbe69160e01b81526001600160a01b038c811660048301528d16906381cbe69190602401602060405180830381865afa158015610c6d573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c9191906117fb565b91505b60408051600180825281830190925290816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610ca957905050975060405180606001604052807f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031681526020015f6001600160a01b0316856001600160a01b031614610d32575f610d34565b835b8152602001610d798d8f8e8e8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525061118392505050565b888888604051602401610d8f9493929190611812565b60408051601f198184030181529190526020810180516001600160e01b0316630ed8d73960e21b1790529052885189905f90610dcd57610dcd6117bc565b602002602001018190525050505050505050949350505050565b5f5f6108c2836001610989565b5f610e00826020611761565b83511015610e4d5760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b60448201526064015b60405180910390fd5b50016020015190565b5f610e62826014611761565b83511015610eaa5760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401610e44565b500160200151600160601b900490565b610ec4815f6109de565b610ece815f6108d7565b50565b5f828281518110610ee457610ee46117bc565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610f4c92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f5f610f76836048610e56565b90506001600160a01b038116610f985750506001600160a01b0382163161067d565b6040516370a0823160e01b81526001600160a01b0385811660048301528216906370a0823190602401602060405180830381865afa158015610fdc573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061100091906117fb565b949350505050565b5f611012826108b7565b90505f6103d4826001610989565b60608182601f0110156110665760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610e44565b6110708284611761565b845110156110b45760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610e44565b6060821580156110d25760405191505f82526020820160405261111c565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101561110b5780518352602092830192016110f3565b5050858452601f01601f1916604052505b5090505b9392505050565b5f611133826004611761565b8351101561117a5760405162461bcd60e51b8152602060048201526014602482015273746f55696e7433325f6f75744f66426f756e647360601b6044820152606401610e44565b50016004015190565b6040805160e0810182525f80825260208201819052918101829052606081018290526080810182905260a0810182905260c08101829052906111c58382610e56565b90505f6111d3846014610df4565b90505f6111e1856034610e56565b90505f6111ef866048610e56565b90505f6111fd87605c610df4565b90505f61120b88607c610df4565b90505f61121989609c610ed1565b9050801561129d576040516381cbe69160e01b81526001600160a01b038c811660048301528791908c16906381cbe69190602401602060405180830381865afa158015611268573d5f5f3e3d5ffd5b505050506040513d601f19601f8201168201806040525081019061128c91906117fb565b96506112998782856112e8565b9250505b506040805160e0810182526001600160a01b0397881681526020810196909652938616938501939093529084166060840152608083015260a0820152941660c0850152509192915050565b5f825f036112f7575080611120565b8284146113705782841115611340575f61131e61131485876117d0565b620186a086611377565b905061132e8382620186a0611377565b6113389084611761565b925050611370565b5f61134e61131486866117d0565b90505f61135f8483620186a0611377565b905061136b81856117d0565b935050505b5092915050565b5f5f5f6113848686611427565b91509150815f036113a85783818161139e5761139e6118a4565b0492505050611120565b8184116113bf576113bf6003851502601118611443565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010185841190960395909502919093039390930492909217029150509392505050565b5f805f1983850993909202808410938190039390930393915050565b634e487b715f52806020526024601cfd5b80356001600160a01b038116811461146a575f5ffd5b919050565b5f5f83601f84011261147f575f5ffd5b50813567ffffffffffffffff811115611496575f5ffd5b6020830191508360208285010111156114ad575f5ffd5b9250929050565b5f5f5f5f606085870312156114c7575f5ffd5b6114d085611454565b93506114de60208601611454565b9250604085013567ffffffffffffffff8111156114f9575f5ffd5b6115058782880161146f565b95989497509550505050565b5f60208284031215611521575f5ffd5b61112082611454565b5f5f6040838503121561153b575f5ffd5b8235915061154b60208401611454565b90509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b8281101561160357868503603f19018452815180516001600160a01b03168652602080820151908701526040908101516060918701829052906115ed90870182611554565b95505060209384019391909101906001016115a8565b50929695505050505050565b5f5f60208385031215611620575f5ffd5b823567ffffffffffffffff811115611636575f5ffd5b6116428582860161146f565b90969095509350505050565b602081525f6111206020830184611554565b602081016003831061168057634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52604160045260245ffd5b5f602082840312156116aa575f5ffd5b813567ffffffffffffffff8111156116c0575f5ffd5b8201601f810184136116d0575f5ffd5b803567ffffffffffffffff8111156116ea576116ea611686565b604051601f8201601f19908116603f0116810167ffffffffffffffff8111828210171561171957611719611686565b604052818152828201602001861015611730575f5ffd5b816020840160208301375f91810160200191909152949350505050565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561067d5761067d61174d565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561067d5761067d61174d565b5f600182016117f4576117f461174d565b5060010190565b5f6020828403121561180b575f5ffd5b5051919050565b84516001600160a01b039081168252602080870151908301526040808701518216908301526060808701518216908301526080808701519083015260a0868101519083015260c0808701519091169082015261014060e082018190525f9061187c90830186611554565b6001600160a01b038516610100840152905063ffffffff831661012083015295945050505050565b634e487b7160e01b5f52601260045260245ffdfea164736f6c634300081e000a",
+}
+
+// SwapOdosV2HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use SwapOdosV2HookMetaData.ABI instead.
+var SwapOdosV2HookABI = SwapOdosV2HookMetaData.ABI
+
+// SwapOdosV2HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SwapOdosV2HookMetaData.Bin instead.
+var SwapOdosV2HookBin = SwapOdosV2HookMetaData.Bin
+
+// DeploySwapOdosV2Hook deploys a new Ethereum contract, binding an instance of SwapOdosV2Hook to it.
+func DeploySwapOdosV2Hook(auth *bind.TransactOpts, backend bind.ContractBackend, _routerV2 common.Address) (common.Address, *types.Transaction, *SwapOdosV2Hook, error) {
+	parsed, err := SwapOdosV2HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SwapOdosV2HookBin), backend, _routerV2)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SwapOdosV2Hook{SwapOdosV2HookCaller: SwapOdosV2HookCaller{contract: contract}, SwapOdosV2HookTransactor: SwapOdosV2HookTransactor{contract: contract}, SwapOdosV2HookFilterer: SwapOdosV2HookFilterer{contract: contract}}, nil
+}
+
+// SwapOdosV2Hook is an auto generated Go binding around an Ethereum contract.
+type SwapOdosV2Hook struct {
+	SwapOdosV2HookCaller     // Read-only binding to the contract
+	SwapOdosV2HookTransactor // Write-only binding to the contract
+	SwapOdosV2HookFilterer   // Log filterer for contract events
+}
+
+// SwapOdosV2HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SwapOdosV2HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SwapOdosV2HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SwapOdosV2HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SwapOdosV2HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SwapOdosV2HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SwapOdosV2HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SwapOdosV2HookSession struct {
+	Contract     *SwapOdosV2Hook   // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// SwapOdosV2HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SwapOdosV2HookCallerSession struct {
+	Contract *SwapOdosV2HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts         // Call options to use throughout this session
+}
+
+// SwapOdosV2HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SwapOdosV2HookTransactorSession struct {
+	Contract     *SwapOdosV2HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts         // Transaction auth options to use throughout this session
+}
+
+// SwapOdosV2HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SwapOdosV2HookRaw struct {
+	Contract *SwapOdosV2Hook // Generic contract binding to access the raw methods on
+}
+
+// SwapOdosV2HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SwapOdosV2HookCallerRaw struct {
+	Contract *SwapOdosV2HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SwapOdosV2HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SwapOdosV2HookTransactorRaw struct {
+	Contract *SwapOdosV2HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSwapOdosV2Hook creates a new instance of SwapOdosV2Hook, bound to a specific deployed contract.
+func NewSwapOdosV2Hook(address common.Address, backend bind.ContractBackend) (*SwapOdosV2Hook, error) {
+	contract, err := bindSwapOdosV2Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapOdosV2Hook{SwapOdosV2HookCaller: SwapOdosV2HookCaller{contract: contract}, SwapOdosV2HookTransactor: SwapOdosV2HookTransactor{contract: contract}, SwapOdosV2HookFilterer: SwapOdosV2HookFilterer{contract: contract}}, nil
+}
+
+// NewSwapOdosV2HookCaller creates a new read-only instance of SwapOdosV2Hook, bound to a specific deployed contract.
+func NewSwapOdosV2HookCaller(address common.Address, caller bind.ContractCaller) (*SwapOdosV2HookCaller, error) {
+	contract, err := bindSwapOdosV2Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapOdosV2HookCaller{contract: contract}, nil
+}
+
+// NewSwapOdosV2HookTransactor creates a new write-only instance of SwapOdosV2Hook, bound to a specific deployed contract.
+func NewSwapOdosV2HookTransactor(address common.Address, transactor bind.ContractTransactor) (*SwapOdosV2HookTransactor, error) {
+	contract, err := bindSwapOdosV2Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapOdosV2HookTransactor{contract: contract}, nil
+}
+
+// NewSwapOdosV2HookFilterer creates a new log filterer instance of SwapOdosV2Hook, bound to a specific deployed contract.
+func NewSwapOdosV2HookFilterer(address common.Address, filterer bind.ContractFilterer) (*SwapOdosV2HookFilterer, error) {
+	contract, err := bindSwapOdosV2Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapOdosV2HookFilterer{contract: contract}, nil
+}
+
+// bindSwapOdosV2Hook binds a generic wrapper to an already deployed contract.
+func bindSwapOdosV2Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SwapOdosV2HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SwapOdosV2Hook *SwapOdosV2HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SwapOdosV2Hook.Contract.SwapOdosV2HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SwapOdosV2Hook *SwapOdosV2HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.SwapOdosV2HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SwapOdosV2Hook *SwapOdosV2HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.SwapOdosV2HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SwapOdosV2Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// ODOSROUTERV2 is a free data retrieval call binding the contract method 0xe50c1f66.
+//
+// Solidity: function ODOS_ROUTER_V2() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) ODOSROUTERV2(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "ODOS_ROUTER_V2")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// ODOSROUTERV2 is a free data retrieval call binding the contract method 0xe50c1f66.
+//
+// Solidity: function ODOS_ROUTER_V2() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) ODOSROUTERV2() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.ODOSROUTERV2(&_SwapOdosV2Hook.CallOpts)
+}
+
+// ODOSROUTERV2 is a free data retrieval call binding the contract method 0xe50c1f66.
+//
+// Solidity: function ODOS_ROUTER_V2() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) ODOSROUTERV2() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.ODOSROUTERV2(&_SwapOdosV2Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) SUBTYPE() ([32]byte, error) {
+	return _SwapOdosV2Hook.Contract.SUBTYPE(&_SwapOdosV2Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _SwapOdosV2Hook.Contract.SUBTYPE(&_SwapOdosV2Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) Asset() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.Asset(&_SwapOdosV2Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) Asset() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.Asset(&_SwapOdosV2Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SwapOdosV2Hook.Contract.Build(&_SwapOdosV2Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SwapOdosV2Hook.Contract.Build(&_SwapOdosV2Hook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SwapOdosV2Hook.Contract.DecodeUsePrevHookAmount(&_SwapOdosV2Hook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SwapOdosV2Hook.Contract.DecodeUsePrevHookAmount(&_SwapOdosV2Hook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) ExecutionNonce() (*big.Int, error) {
+	return _SwapOdosV2Hook.Contract.ExecutionNonce(&_SwapOdosV2Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _SwapOdosV2Hook.Contract.ExecutionNonce(&_SwapOdosV2Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SwapOdosV2Hook.Contract.GetOutAmount(&_SwapOdosV2Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SwapOdosV2Hook.Contract.GetOutAmount(&_SwapOdosV2Hook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) HookType() (uint8, error) {
+	return _SwapOdosV2Hook.Contract.HookType(&_SwapOdosV2Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) HookType() (uint8, error) {
+	return _SwapOdosV2Hook.Contract.HookType(&_SwapOdosV2Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) Inspect(data []byte) ([]byte, error) {
+	return _SwapOdosV2Hook.Contract.Inspect(&_SwapOdosV2Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _SwapOdosV2Hook.Contract.Inspect(&_SwapOdosV2Hook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) LastCaller() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.LastCaller(&_SwapOdosV2Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) LastCaller() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.LastCaller(&_SwapOdosV2Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) SpToken() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.SpToken(&_SwapOdosV2Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) SpToken() (common.Address, error) {
+	return _SwapOdosV2Hook.Contract.SpToken(&_SwapOdosV2Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) Subtype() ([32]byte, error) {
+	return _SwapOdosV2Hook.Contract.Subtype(&_SwapOdosV2Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) Subtype() ([32]byte, error) {
+	return _SwapOdosV2Hook.Contract.Subtype(&_SwapOdosV2Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SwapOdosV2Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) UsedShares() (*big.Int, error) {
+	return _SwapOdosV2Hook.Contract.UsedShares(&_SwapOdosV2Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SwapOdosV2Hook *SwapOdosV2HookCallerSession) UsedShares() (*big.Int, error) {
+	return _SwapOdosV2Hook.Contract.UsedShares(&_SwapOdosV2Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.PostExecute(&_SwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.PostExecute(&_SwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.PreExecute(&_SwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.PreExecute(&_SwapOdosV2Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.ResetExecutionState(&_SwapOdosV2Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.ResetExecutionState(&_SwapOdosV2Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.SetExecutionContext(&_SwapOdosV2Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.SetExecutionContext(&_SwapOdosV2Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.SetOutAmount(&_SwapOdosV2Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SwapOdosV2Hook *SwapOdosV2HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SwapOdosV2Hook.Contract.SetOutAmount(&_SwapOdosV2Hook.TransactOpts, _outAmount, caller)
+}
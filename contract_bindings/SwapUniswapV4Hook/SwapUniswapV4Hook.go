@@ -0,0 +1,814 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package SwapUniswapV4Hook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// PoolKey is an auto generated low-level Go binding around an user-defined struct.
+type PoolKey struct {
+	Currency0   common.Address
+	Currency1   common.Address
+	Fee         *big.Int
+	TickSpacing *big.Int
+	Hooks       common.Address
+}
+
+// SwapUniswapV4HookQuoteParams is an auto generated low-level Go binding around an user-defined struct.
+type SwapUniswapV4HookQuoteParams struct {
+	PoolKey           PoolKey
+	ZeroForOne        bool
+	AmountIn          *big.Int
+	SqrtPriceLimitX96 *big.Int
+}
+
+// SwapUniswapV4HookQuoteResult is an auto generated low-level Go binding around an user-defined struct.
+type SwapUniswapV4HookQuoteResult struct {
+	AmountOut         *big.Int
+	SqrtPriceX96After *big.Int
+}
+
+// SwapUniswapV4HookMetaData contains all meta data concerning the SwapUniswapV4Hook contract.
+var SwapUniswapV4HookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"poolManager_\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"receive\",\"stateMutability\":\"payable\"},{\"type\":\"function\",\"name\":\"POOL_MANAGER\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"contractIPoolManager\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"usePrevHookAmount\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getQuote\",\"inputs\":[{\"name\":\"params\",\"type\":\"tuple\",\"internalType\":\"structSwapUniswapV4Hook.QuoteParams\",\"components\":[{\"name\":\"poolKey\",\"type\":\"tuple\",\"internalType\":\"structPoolKey\",\"components\":[{\"name\":\"currency0\",\"type\":\"address\",\"internalType\":\"Currency\"},{\"name\":\"currency1\",\"type\":\"address\",\"internalType\":\"Currency\"},{\"name\":\"fee\",\"type\":\"uint24\",\"internalType\":\"uint24\"},{\"name\":\"tickSpacing\",\"type\":\"int24\",\"internalType\":\"int24\"},{\"name\":\"hooks\",\"type\":\"address\",\"internalType\":\"contractIHooks\"}]},{\"name\":\"zeroForOne\",\"type\":\"bool\",\"internalType\":\"bool\"},{\"name\":\"amountIn\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"sqrtPriceLimitX96\",\"type\":\"uint160\",\"internalType\":\"uint160\"}]}],\"outputs\":[{\"name\":\"result\",\"type\":\"tuple\",\"internalType\":\"structSwapUniswapV4Hook.QuoteResult\",\"components\":[{\"name\":\"amountOut\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"sqrtPriceX96After\",\"type\":\"uint160\",\"internalType\":\"uint160\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"unlockCallback\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"EXCESSIVE_SLIPPAGE_DEVIATION\",\"inputs\":[{\"name\":\"actualDeviation\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"maxAllowed\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"HOOK_BALANCE_NOT_CLEARED\",\"inputs\":[{\"name\":\"token\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"remaining\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INSUFFICIENT_OUTPUT_AMOUNT\",\"inputs\":[{\"name\":\"actual\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"minimum\",\"type\":\"uint256\",\"internalType\":\"uint256\"}]},{\"type\":\"error\",\"name\":\"INVALID_ACTUAL_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_HOOK_DATA\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_ORIGINAL_AMOUNTS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_OUTPUT_DELTA\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PREVIOUS_NATIVE_TRANSFER_HOOK_USAGE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_PRICE_LIMIT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_REMAINING_NATIVE_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"OUTPUT_AMOUNT_DIFFERENT_THAN_TRUE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"QUOTE_DEVIATION_EXCEEDS_SAFETY_BOUNDS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLBACK\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_LIQUIDITY\",\"inputs\":[]}]",
+	Bin: "0x60c060405234801561000f575f5ffd5b506040516133ce3803806133ce83398101604081905261002e91610088565b6040805180820190915260048152630537761760e41b6020909101525f805460ff191690557fbce316f0d9d2a3affa97de1d99bb2aac0538e2666d0d8545545ead241ef0ccab6080526001600160a01b031660a0526100b5565b5f60208284031215610098575f5ffd5b81516001600160a01b03811681146100ae575f5ffd5b9392505050565b60805160a0516132ab6101235f395f8181610292015281816108ae015281816109ba01528181610a7f01528181610aeb01528181610b6901528181610c1f01528181610d5501528181610e8b01528181610eef015261106901525f818161025d015261036101526132ab5ff3fe60806040526004361061011e575f3560e01c8063685a943c1161009d578063984d6e7a11610062578063984d6e7a14610350578063d06a1e8914610383578063d323e185146103a2578063e445e7dd146103e5578063e77455171461040a575f5ffd5b8063685a943c146102b457806381cbe691146102c85780638c4313c1146102e75780638e1487761461031357806391dd734614610331575f5ffd5b80632ae2fe3d116100e35780632ae2fe3d146101e657806338d52e0f146102055780633b5896bc146102235780635492e6771461024f57806362308e8514610281575f5ffd5b806301bd43ef1461012957806305b4fe911461015157806314cb37cf146101725780631f264619146101915780632113522a146101b0575f5ffd5b3661012557005b5f5ffd5b348015610134575f5ffd5b5061013e60035c81565b6040519081526020015b60405180910390f35b34801561015c575f5ffd5b5061017061016b366004612b40565b610439565b005b34801561017d575f5ffd5b5061017061018c366004612ba1565b6104b3565b34801561019c575f5ffd5b506101706101ab366004612bbc565b6104d4565b3480156101bb575f5ffd5b506101ce6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610148565b3480156101f1575f5ffd5b50610170610200366004612b40565b61052d565b348015610210575f5ffd5b506101ce6001600160a01b0360025c1681565b34801561022e575f5ffd5b5061024261023d366004612b40565b6105a0565b6040516101489190612c18565b34801561025a575f5ffd5b507f000000000000000000000000000000000000000000000000000000000000000061013e565b34801561028c575f5ffd5b506101ce7f000000000000000000000000000000000000000000000000000000000000000081565b3480156102bf575f5ffd5b5061013e5f5c81565b3480156102d3575f5ffd5b5061013e6102e2366004612ba1565b6107b9565b3480156102f2575f5ffd5b50610306610301366004612ca5565b6107d1565b6040516101489190612ce4565b34801561031e575f5ffd5b506101ce6001600160a01b0360015c1681565b34801561033c575f5ffd5b5061030661034b366004612ca5565b6108a1565b34801561035b575f5ffd5b5061013e7f000000000000000000000000000000000000000000000000000000000000000081565b34801561038e575f5ffd5b5061017061039d366004612ba1565b610de5565b3480156103ad575f5ffd5b506103c16103bc366004612e00565b610e62565b60408051825181526020928301516001600160a01b03169281019290925201610148565b3480156103f0575f5ffd5b505f546103fd9060ff1681565b6040516101489190612e7c565b348015610415575f5ffd5b50610429610424366004612ca5565b610fba565b6040519015158152602001610148565b336001600160a01b038416146104625760405163e15e56c960e01b815260040160405180910390fd5b5f61046c84611025565b905061047781611038565b156104955760405163945b63f560e01b815260040160405180910390fd5b6104a0816001611045565b6104ac8585858561105b565b5050505050565b6104bc8161123a565b50336004805c6001600160a01b0319168217905d5050565b5f6104de82611025565b90506104e98161126c565b806104f857506104f881611038565b156105165760405163441e4c7360e11b815260040160405180910390fd5b5f610522826001611275565b905083815d50505050565b336001600160a01b038416146105565760405163e15e56c960e01b815260040160405180910390fd5b5f61056084611025565b905061056b8161126c565b1561058957604051630bbb04d960e11b815260040160405180910390fd5b6105948160016112ca565b6104ac858585856112d6565b60605f6105af86868686611406565b9050805160026105bf9190612eb6565b67ffffffffffffffff8111156105d7576105d7612cf6565b60405190808252806020026020018201604052801561062357816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816105f55790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161066c9493929190612ec9565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106106ae576106ae612f11565b60209081029190910101525f5b815181101561070f578181815181106106d6576106d6612f11565b6020026020010151838260016106ec9190612eb6565b815181106106fc576106fc612f11565b60209081029190910101526001016106bb565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe91898989896040516024016107569493929190612ec9565b604051602081830303815290604052915060e01b6020820180516001600160e01b03838183161783525050505081525082600184516107959190612f25565b815181106107a5576107a5612f11565b602002602001018190525050949350505050565b5f6107cb6107c683611025565b6114f7565b92915050565b60605f6108165f85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90505f61085c601486868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b6040516bffffffffffffffffffffffff19606085811b8216602084015283901b1660348201529091506048016040516020818303038152906040529250505092915050565b6060336001600160a01b037f000000000000000000000000000000000000000000000000000000000000000016146108ec57604051633d046d8960e21b815260040160405180910390fd5b5f8080808080806108ff898b018b612f5f565b96509650965096509650965096505f836001600160a01b03165f14610924578361095d565b8261094d57610948600173fffd8963efd1fc6a506488495d951d5263988d26613037565b61095d565b61095d6401000276a36001613056565b90505f8361096f578860200151610972565b88515b90505f84610981578951610987565b89602001515b9050816001600160a01b038116610a60578947146109b857604051632982115760e11b815260040160405180910390fd5b7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166311da60b48b6040518263ffffffff1660e01b815260040160206040518083038185885af1158015610a16573d5f5f3e3d5ffd5b50505050506040513d601f19601f82011682018060405250810190610a3b9190613075565b504715610a5b576040516320047b3d60e01b815260040160405180910390fd5b610bea565b604051632961046560e21b81526001600160a01b0384811660048301527f0000000000000000000000000000000000000000000000000000000000000000169063a5841194906024015f604051808303815f87803b158015610ac0575f5ffd5b505af1158015610ad2573d5f5f3e3d5ffd5b505060405163a9059cbb60e01b81526001600160a01b037f000000000000000000000000000000000000000000000000000000000000000081166004830152602482018e90528416925063a9059cbb91506044016020604051808303815f875af1158015610b42573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610b66919061308c565b507f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166311da60b46040518163ffffffff1660e01b81526004016020604051808303815f875af1158015610bc4573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610be89190613075565b505b5f604051806060016040528088151581526020018c610c08906130a7565b8152602001866001600160a01b031681525090505f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b031663f3cd914c8e848a6040518463ffffffff1660e01b8152600401610c6d93929190613104565b6020604051808303815f875af1158015610c89573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610cad9190613075565b90505f88610cc457610cbf8260801d90565b610cce565b610cce82600f0b90565b90505f81600f0b13610cf35760405163171eaf1f60e11b815260040160405180910390fd5b600f81900b8c811015610d27576040516293a4a360e41b815260048101829052602481018e90526044015b60405180910390fd5b604051630b0d9c0960e01b81526001600160a01b0387811660048301528d81166024830152604482018390527f00000000000000000000000000000000000000000000000000000000000000001690630b0d9c09906064015f604051808303815f87803b158015610d96575f5ffd5b505af1158015610da8573d5f5f3e3d5ffd5b5050505080604051602001610dbf91815260200190565b6040516020818303038152906040529f5050505050505050505050505050505092915050565b336001600160a01b0360045c1614610e105760405163e15e56c960e01b815260040160405180910390fd5b5f610e1a82611025565b9050610e258161126c565b1580610e375750610e3581611038565b155b15610e5557604051634bd439b560e11b815260040160405180910390fd5b610e5e81611568565b5050565b604080518082019091525f8082526020820152815160a090205f8080610eb16001600160a01b037f0000000000000000000000000000000000000000000000000000000000000000168561157f565b93509350509250826001600160a01b03165f03610ee15760405163039271df60e11b815260040160405180910390fd5b5f610f156001600160a01b037f00000000000000000000000000000000000000000000000000000000000000001686611631565b90505f87606001516001600160a01b03165f14610f36578760600151610f73565b8760200151610f6357610f5e600173fffd8963efd1fc6a506488495d951d5263988d26613037565b610f73565b610f736401000276a36001613056565b90505f5f610f9a8784868d60400151610f8b906130a7565b610f958b8b61314d565b6116bd565b508b52506001600160a01b031660208a0152509698975050505050505050565b5f60da821015610fdd576040516373a0474d60e11b815260040160405180910390fd5b61101e83838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060d99250611820915050565b9392505050565b5f5f6110308361184c565b5c9392505050565b5f5f611030836003611275565b5f611051836003611275565b905081815d505050565b5f6110646118b8565b90505f7f00000000000000000000000000000000000000000000000000000000000000006001600160a01b03166348c89491836040518263ffffffff1660e01b81526004016110b39190612ce4565b5f604051808303815f875af11580156110ce573d5f5f3e3d5ffd5b505050506040513d5f823e601f3d908101601f191682016040526110f59190810190613168565b90506110ff611955565b5f818060200190518101906111149190613075565b90505f611121868661197c565b90505f611167604488888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90505f6001600160a01b03831661118957506001600160a01b038116316111f4565b6040516370a0823160e01b81526001600160a01b0383811660048301528416906370a0823190602401602060405180830381865afa1580156111cd573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906111f19190613075565b90505b5f61120160055c83612f25565b9050808514611223576040516366bbfe4960e01b815260040160405180910390fd5b61122d858b611a63565b5050505050505050505050565b5f6003805c908261124a836131dd565b9190505d505f6112598361184c565b905060035c80825d505060035c92915050565b5f5f6110308360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f611051836002611275565b6112e284848484611a7b565b50806002805c6001600160a01b0319166001600160a01b03831617905d50505f61130c838361197c565b90505f611352604485858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90506001600160a01b038216611377576001600160a01b038116318060055d506113e5565b6040516370a0823160e01b81526001600160a01b0382811660048301528316906370a0823190602401602060405180830381865afa1580156113bb573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906113df9190613075565b8060055d505b5f6113f287878787611c67565b90506113fd81611d85565b50505050505050565b60605f5f61141687878787611a7b565b90925090506001600160a01b038216156114ed5760408051600180825281830190925290816020015b60408051606080820183525f80835260208301529181019190915281526020019060019003908161143f575050604080516060810182526001600160a01b03851681525f602080830182905283513060248201526044808201889052855180830390910181526064909101855290810180516001600160e01b031663a9059cbb60e01b179052928201929092528251929550918591906114e1576114e1612f11565b60200260200101819052505b5050949350505050565b5f5f611030836001611275565b5f611510826014612eb6565b835110156115585760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b6044820152606401610d1e565b500160200151600160601b900490565b611572815f6112ca565b61157c815f611045565b50565b5f5f5f5f5f61158d86611de3565b604051631e2eaeaf60e01b8152600481018290529091505f906001600160a01b03891690631e2eaeaf90602401602060405180830381865afa1580156115d5573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906115f99190613075565b90506001600160a01b03811695508060a01c60020b945062ffffff8160b81c16935062ffffff8160d01c169250505092959194509250565b5f5f61163c83611de3565b90505f61164a600383612eb6565b604051631e2eaeaf60e01b8152600481018290529091506001600160a01b03861690631e2eaeaf90602401602060405180830381865afa158015611690573d5f5f3e3d5ffd5b505050506040513d601f19601f820116820180604052508101906116b49190613075565b95945050505050565b5f80808062ffffff85166001600160a01b03808a16908b1610158288128015611793575f6116f68a5f0385620f424003620f4240611e02565b90508261170f5761170a8d8d8d6001611e9e565b61171c565b61171c8c8e8d6001611eea565b9650868110611750578b9750620f4240841461174757611742878586620f424003611faf565b611749565b865b9450611769565b80965061175f8d8c8386611fdf565b9750868a5f030394505b8261177f5761177a8d898d5f611eea565b61178b565b61178b888e8d5f611e9e565b955050611811565b816117a9576117a48c8c8c5f611eea565b6117b5565b6117b58b8d8c5f611e9e565b94508489106117c6578a96506117d8565b8894506117d58c8b878561202d565b96505b816117ef576117ea8c888c6001611e9e565b6117fc565b6117fc878d8c6001611eea565b955061180e868485620f424003611faf565b93505b50505095509550955095915050565b5f82828151811061183357611833612f11565b01602001516001600160f81b0319161515905092915050565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b8260405160200161189b92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b60607f627aa3803339ac43821d56e4daaf1b561068359bd683c25d3b3f5e42787753c1805c8067ffffffffffffffff8111156118f6576118f6612cf6565b6040519080825280601f01601f191660200182016040528015611920576020820181803683370190505b5092505f5b8181101561194f57602081810181900484015c8583018201526119489082612eb6565b9050611925565b50505090565b7f627aa3803339ac43821d56e4daaf1b561068359bd683c25d3b3f5e42787753c15f815d50565b5f5f6119c05f85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90505f611a06601486868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90505f611a4a86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060d89250611820915050565b905080611a575782611a59565b815b9695505050505050565b5f611a6d82611025565b90505f610522826001611275565b5f5f5f611ac05f86868080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90505f611b06601487878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b90505f611b4a87878080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060d89250611820915050565b90505f611b8e88888080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060d99250611820915050565b905081611b9b5782611b9d565b835b95508015611c14576040516381cbe69160e01b81526001600160a01b038a811660048301528b16906381cbe69190602401602060405180830381865afa158015611be9573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190611c0d9190613075565b9450611c5a565b611c57607889898080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506120749050565b94505b5050505094509492505050565b60605f5f5f5f5f5f5f5f5f611c7c8c8c6120d1565b9850985098509850985098509850985098505f82611c9a5786611d14565b8e6001600160a01b03166381cbe6918f6040518263ffffffff1660e01b8152600401611cd591906001600160a01b0391909116815260200190565b602060405180830381865afa158015611cf0573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190611d149190613075565b90505f611d4260405180608001604052808a8152602001898152602001848152602001888152508c8761258a565b90508a82828c8c8988604051602001611d6197969594939291906131f5565b6040516020818303038152906040529b505050505050505050505050949350505050565b80517f627aa3803339ac43821d56e4daaf1b561068359bd683c25d3b3f5e42787753c19080825d5f5b81811015611ddd575f8160208601015190508060208084010485015d50611dd6602082612eb6565b9050611dae565b50505050565b6040515f9061189b908390600690602001918252602082015260400190565b5f838302815f1985870982811083820303915050808411611e21575f5ffd5b805f03611e335750829004905061101e565b5f848688095f868103871696879004966002600389028118808a02820302808a02820302808a02820302808a02820302808a02820302808a02909103029181900381900460010186841190950394909402919094039290920491909117919091029150509392505050565b5f6001600160a01b038481169086160360ff81901d90810118600160601b6001600160801b038516611ed1818484611e02565b9350845f83858409111684019350505050949350505050565b5f836001600160a01b0316856001600160a01b03161115611f09579293925b6001600160a01b038516611f235762bfc9215f526004601cfd5b600160601b600160e01b03606084901b166001600160a01b038686031683611f7657866001600160a01b0316611f638383896001600160a01b0316611e02565b81611f7057611f70613254565b04611fa2565b611fa2611f8d8383896001600160a01b0316611faf565b886001600160a01b0316808204910615150190565b925050505b949350505050565b5f611fbb848484611e02565b90508180611fcb57611fcb613254565b8385091561101e576001018061101e575f5ffd5b5f6001600160801b038416156001600160a01b03861615171561200957634f2461b85f526004601cfd5b816120205761201b8585856001612686565b6116b4565b6116b48585856001612771565b5f6001600160801b038416156001600160a01b03861615171561205757634f2461b85f526004601cfd5b816120685761201b8585855f612771565b6116b48585855f612686565b5f612080826020612eb6565b835110156120c85760405162461bcd60e51b8152602060048201526015602482015274746f55696e743235365f6f75744f66426f756e647360581b6044820152606401610d1e565b50016020015190565b6040805160a0810182525f808252602082018190529181018290526060810182905260808101919091525f808080808080606060da8a1015612126576040516373a0474d60e11b815260040160405180910390fd5b6040518060a001604052806121735f8e8e8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b6001600160a01b031681526020016121c460148e8e8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b6001600160a01b0316815260200161221560288e8e8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506128539050565b62ffffff168152602001612262602c8e8e8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506128539050565b60020b81526020016122ad60308e8e8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b6001600160a01b0316815250985088602001516001600160a01b0316895f01516001600160a01b0316036122f4576040516373a0474d60e11b815260040160405180910390fd5b886040015162ffffff165f0361231d576040516373a0474d60e11b815260040160405180910390fd5b886060015160020b5f03612344576040516373a0474d60e11b815260040160405180910390fd5b61238760448c8c8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506115049050565b97506123cc60588c8c8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506120749050565b965061241160788c8c8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506120749050565b955061245660988c8c8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506120749050565b945061249b60b88c8c8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525092939250506120749050565b93506124de8b8b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060d89250611820915050565b92506125218b8b8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060d99250611820915050565b915060da8a111561257d5761257a60da61253b818d612f25565b8d8d8080601f0160208091040260200160405190810160405280939291908181526020018383808284375f920191909152509294939250506128af9050565b90505b9295985092959850929598565b82515f90158061259c57506020840151155b156125ba57604051630add8eb160e21b815260040160405180910390fd5b83604001515f036125de576040516313a4d0d960e31b815260040160405180910390fd5b835160408501515f91906125fa90670de0b6b3a7640000613268565b612604919061327f565b9050670de0b6b3a764000081866020015161261f9190613268565b612629919061327f565b91505f612635826129b4565b9050856060015181111561266c576060860151604051630e61211560e31b8152610d1e918391600401918252602082015260400190565b61267c8587604001518587612a0a565b5050509392505050565b5f81156126f6575f6001600160a01b038411156126ba576126b584600160601b876001600160801b0316611e02565b6126d1565b6126d16001600160801b038616606086901b61327f565b90506126ee6126e9826001600160a01b038916612eb6565b612ac3565b915050611fa7565b5f6001600160a01b038411156127235761271e84600160601b876001600160801b0316611faf565b612740565b612740606085901b6001600160801b038716808204910615150190565b9050806001600160a01b0387161161275f57634323a5555f526004601cfd5b6001600160a01b038616039050611fa7565b5f825f03612780575083611fa7565b600160601b600160e01b03606085901b168215612812576001600160a01b038616848102908582816127b4576127b4613254565b04036127e4578181018281106127e2576127d883896001600160a01b031683611faf565b9350505050611fa7565b505b506126ee81856127fd6001600160a01b038a168361327f565b6128079190612eb6565b808204910615150190565b6001600160a01b0386168481029085820414818311166128395763f5c787f15f526004601cfd5b8082036127d86126e9846001600160a01b038b1684611faf565b5f61285f826004612eb6565b835110156128a65760405162461bcd60e51b8152602060048201526014602482015273746f55696e7433325f6f75744f66426f756e647360601b6044820152606401610d1e565b50016004015190565b60608182601f0110156128f55760405162461bcd60e51b815260206004820152600e60248201526d736c6963655f6f766572666c6f7760901b6044820152606401610d1e565b6128ff8284612eb6565b845110156129435760405162461bcd60e51b8152602060048201526011602482015270736c6963655f6f75744f66426f756e647360781b6044820152606401610d1e565b6060821580156129615760405191505f8252602082016040526129ab565b6040519150601f8416801560200281840101858101878315602002848b0101015b8183101561299a578051835260209283019201612982565b5050858452601f01601f1916604052505b50949350505050565b5f670de0b6b3a76400008211156129ef57670de0b6b3a76400006127106129db8285612f25565b6129e59190613268565b6107cb919061327f565b670de0b6b3a76400006127106129db8483612f25565b919050565b5f5f612a40604051806080016040528088815260200185151581526020018781526020015f6001600160a01b0316815250610e62565b90505f84825f015111612a78578151859061271090612a5f9083612f25565b612a699190613268565b612a73919061327f565b612a9b565b8151612710612a878783612f25565b612a919190613268565b612a9b919061327f565b90506103e88111159250826114ed5760405163103e0f7360e01b815260040160405180910390fd5b806001600160a01b0381168114612a0557612a056393dafdf160e01b805f5260045ffd5b6001600160a01b038116811461157c575f5ffd5b5f5f83601f840112612b0b575f5ffd5b50813567ffffffffffffffff811115612b22575f5ffd5b602083019150836020828501011115612b39575f5ffd5b9250929050565b5f5f5f5f60608587031215612b53575f5ffd5b8435612b5e81612ae7565b93506020850135612b6e81612ae7565b9250604085013567ffffffffffffffff811115612b89575f5ffd5b612b9587828801612afb565b95989497509550505050565b5f60208284031215612bb1575f5ffd5b813561101e81612ae7565b5f5f60408385031215612bcd575f5ffd5b823591506020830135612bdf81612ae7565b809150509250929050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015612c9957868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290612c8390870182612bea565b9550506020938401939190910190600101612c3e565b50929695505050505050565b5f5f60208385031215612cb6575f5ffd5b823567ffffffffffffffff811115612ccc575f5ffd5b612cd885828601612afb565b90969095509350505050565b602081525f61101e6020830184612bea565b634e487b7160e01b5f52604160045260245ffd5b60405160a0810167ffffffffffffffff81118282101715612d2d57612d2d612cf6565b60405290565b604051601f8201601f1916810167ffffffffffffffff81118282101715612d5c57612d5c612cf6565b604052919050565b8035612a0581612ae7565b5f60a08284031215612d7f575f5ffd5b612d87612d0a565b90508135612d9481612ae7565b81526020820135612da481612ae7565b6020820152604082013562ffffff81168114612dbe575f5ffd5b60408201526060820135600281900b8114612dd7575f5ffd5b6060820152612de860808301612d64565b608082015292915050565b801515811461157c575f5ffd5b5f610100828403128015612e12575f5ffd5b506040516080810167ffffffffffffffff81118282101715612e3657612e36612cf6565b604052612e438484612d6f565b815260a0830135612e5381612df3565b602082015260c0830135604082015260e0830135612e7081612ae7565b60608201529392505050565b6020810160038310612e9c57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b808201808211156107cb576107cb612ea2565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b818103818111156107cb576107cb612ea2565b5f67ffffffffffffffff821115612f5157612f51612cf6565b50601f01601f191660200190565b5f5f5f5f5f5f5f610160888a031215612f76575f5ffd5b612f808989612d6f565b965060a0880135955060c0880135945060e0880135612f9e81612ae7565b9350610100880135612faf81612ae7565b9250610120880135612fc081612df3565b915061014088013567ffffffffffffffff811115612fdc575f5ffd5b8801601f81018a13612fec575f5ffd5b8035612fff612ffa82612f38565b612d33565b8181528b6020838501011115613013575f5ffd5b816020840160208301375f6020838301015280935050505092959891949750929550565b6001600160a01b0382811682821603908111156107cb576107cb612ea2565b6001600160a01b0381811683821601908111156107cb576107cb612ea2565b5f60208284031215613085575f5ffd5b5051919050565b5f6020828403121561309c575f5ffd5b815161101e81612df3565b5f600160ff1b82016130bb576130bb612ea2565b505f0390565b80516001600160a01b03908116835260208083015182169084015260408083015162ffffff169084015260608083015160020b9084015260809182015116910152565b61310e81856130c1565b8251151560a0820152602083015160c082015260408301516001600160a01b031660e082015261012061010082018190525f906116b490830184612bea565b62ffffff81811683821601908111156107cb576107cb612ea2565b5f60208284031215613178575f5ffd5b815167ffffffffffffffff81111561318e575f5ffd5b8201601f8101841361319e575f5ffd5b80516131ac612ffa82612f38565b8181528560208385010111156131c0575f5ffd5b8160208401602083015e5f91810160200191909152949350505050565b5f600182016131ee576131ee612ea2565b5060010190565b6131ff81896130c1565b60a0810187905260c081018690526001600160a01b0385811660e0830152841661010082015282151561012082015261016061014082018190525f9061324790830184612bea565b9998505050505050505050565b634e487b7160e01b5f52601260045260245ffd5b80820281158282048414176107cb576107cb612ea2565b5f8261329957634e487b7160e01b5f52601260045260245ffd5b50049056fea164736f6c634300081e000a",
+}
+
+// SwapUniswapV4HookABI is the input ABI used to generate the binding from.
+// Deprecated: Use SwapUniswapV4HookMetaData.ABI instead.
+var SwapUniswapV4HookABI = SwapUniswapV4HookMetaData.ABI
+
+// SwapUniswapV4HookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use SwapUniswapV4HookMetaData.Bin instead.
+var SwapUniswapV4HookBin = SwapUniswapV4HookMetaData.Bin
+
+// DeploySwapUniswapV4Hook deploys a new Ethereum contract, binding an instance of SwapUniswapV4Hook to it.
+func DeploySwapUniswapV4Hook(auth *bind.TransactOpts, backend bind.ContractBackend, poolManager_ common.Address) (common.Address, *types.Transaction, *SwapUniswapV4Hook, error) {
+	parsed, err := SwapUniswapV4HookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(SwapUniswapV4HookBin), backend, poolManager_)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &SwapUniswapV4Hook{SwapUniswapV4HookCaller: SwapUniswapV4HookCaller{contract: contract}, SwapUniswapV4HookTransactor: SwapUniswapV4HookTransactor{contract: contract}, SwapUniswapV4HookFilterer: SwapUniswapV4HookFilterer{contract: contract}}, nil
+}
+
+// SwapUniswapV4Hook is an auto generated Go binding around an Ethereum contract.
+type SwapUniswapV4Hook struct {
+	SwapUniswapV4HookCaller     // Read-only binding to the contract
+	SwapUniswapV4HookTransactor // Write-only binding to the contract
+	SwapUniswapV4HookFilterer   // Log filterer for contract events
+}
+
+// SwapUniswapV4HookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type SwapUniswapV4HookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SwapUniswapV4HookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type SwapUniswapV4HookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SwapUniswapV4HookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type SwapUniswapV4HookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// SwapUniswapV4HookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type SwapUniswapV4HookSession struct {
+	Contract     *SwapUniswapV4Hook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts      // Call options to use throughout this session
+	TransactOpts bind.TransactOpts  // Transaction auth options to use throughout this session
+}
+
+// SwapUniswapV4HookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type SwapUniswapV4HookCallerSession struct {
+	Contract *SwapUniswapV4HookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts            // Call options to use throughout this session
+}
+
+// SwapUniswapV4HookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type SwapUniswapV4HookTransactorSession struct {
+	Contract     *SwapUniswapV4HookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts            // Transaction auth options to use throughout this session
+}
+
+// SwapUniswapV4HookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type SwapUniswapV4HookRaw struct {
+	Contract *SwapUniswapV4Hook // Generic contract binding to access the raw methods on
+}
+
+// SwapUniswapV4HookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type SwapUniswapV4HookCallerRaw struct {
+	Contract *SwapUniswapV4HookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// SwapUniswapV4HookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type SwapUniswapV4HookTransactorRaw struct {
+	Contract *SwapUniswapV4HookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewSwapUniswapV4Hook creates a new instance of SwapUniswapV4Hook, bound to a specific deployed contract.
+func NewSwapUniswapV4Hook(address common.Address, backend bind.ContractBackend) (*SwapUniswapV4Hook, error) {
+	contract, err := bindSwapUniswapV4Hook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapUniswapV4Hook{SwapUniswapV4HookCaller: SwapUniswapV4HookCaller{contract: contract}, SwapUniswapV4HookTransactor: SwapUniswapV4HookTransactor{contract: contract}, SwapUniswapV4HookFilterer: SwapUniswapV4HookFilterer{contract: contract}}, nil
+}
+
+// NewSwapUniswapV4HookCaller creates a new read-only instance of SwapUniswapV4Hook, bound to a specific deployed contract.
+func NewSwapUniswapV4HookCaller(address common.Address, caller bind.ContractCaller) (*SwapUniswapV4HookCaller, error) {
+	contract, err := bindSwapUniswapV4Hook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapUniswapV4HookCaller{contract: contract}, nil
+}
+
+// NewSwapUniswapV4HookTransactor creates a new write-only instance of SwapUniswapV4Hook, bound to a specific deployed contract.
+func NewSwapUniswapV4HookTransactor(address common.Address, transactor bind.ContractTransactor) (*SwapUniswapV4HookTransactor, error) {
+	contract, err := bindSwapUniswapV4Hook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapUniswapV4HookTransactor{contract: contract}, nil
+}
+
+// NewSwapUniswapV4HookFilterer creates a new log filterer instance of SwapUniswapV4Hook, bound to a specific deployed contract.
+func NewSwapUniswapV4HookFilterer(address common.Address, filterer bind.ContractFilterer) (*SwapUniswapV4HookFilterer, error) {
+	contract, err := bindSwapUniswapV4Hook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &SwapUniswapV4HookFilterer{contract: contract}, nil
+}
+
+// bindSwapUniswapV4Hook binds a generic wrapper to an already deployed contract.
+func bindSwapUniswapV4Hook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := SwapUniswapV4HookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SwapUniswapV4Hook *SwapUniswapV4HookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SwapUniswapV4Hook.Contract.SwapUniswapV4HookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SwapUniswapV4Hook *SwapUniswapV4HookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.SwapUniswapV4HookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SwapUniswapV4Hook *SwapUniswapV4HookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.SwapUniswapV4HookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _SwapUniswapV4Hook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.contract.Transact(opts, method, params...)
+}
+
+// POOLMANAGER is a free data retrieval call binding the contract method 0x62308e85.
+//
+// Solidity: function POOL_MANAGER() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) POOLMANAGER(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "POOL_MANAGER")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// POOLMANAGER is a free data retrieval call binding the contract method 0x62308e85.
+//
+// Solidity: function POOL_MANAGER() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) POOLMANAGER() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.POOLMANAGER(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// POOLMANAGER is a free data retrieval call binding the contract method 0x62308e85.
+//
+// Solidity: function POOL_MANAGER() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) POOLMANAGER() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.POOLMANAGER(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) SUBTYPE() ([32]byte, error) {
+	return _SwapUniswapV4Hook.Contract.SUBTYPE(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _SwapUniswapV4Hook.Contract.SUBTYPE(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) Asset() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.Asset(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) Asset() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.Asset(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SwapUniswapV4Hook.Contract.Build(&_SwapUniswapV4Hook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _SwapUniswapV4Hook.Contract.Build(&_SwapUniswapV4Hook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool usePrevHookAmount)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool usePrevHookAmount)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SwapUniswapV4Hook.Contract.DecodeUsePrevHookAmount(&_SwapUniswapV4Hook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool usePrevHookAmount)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _SwapUniswapV4Hook.Contract.DecodeUsePrevHookAmount(&_SwapUniswapV4Hook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) ExecutionNonce() (*big.Int, error) {
+	return _SwapUniswapV4Hook.Contract.ExecutionNonce(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _SwapUniswapV4Hook.Contract.ExecutionNonce(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SwapUniswapV4Hook.Contract.GetOutAmount(&_SwapUniswapV4Hook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _SwapUniswapV4Hook.Contract.GetOutAmount(&_SwapUniswapV4Hook.CallOpts, caller)
+}
+
+// GetQuote is a free data retrieval call binding the contract method 0xd323e185.
+//
+// Solidity: function getQuote(((address,address,uint24,int24,address),bool,uint256,uint160) params) view returns((uint256,uint160) result)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) GetQuote(opts *bind.CallOpts, params SwapUniswapV4HookQuoteParams) (SwapUniswapV4HookQuoteResult, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "getQuote", params)
+
+	if err != nil {
+		return *new(SwapUniswapV4HookQuoteResult), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(SwapUniswapV4HookQuoteResult)).(*SwapUniswapV4HookQuoteResult)
+
+	return out0, err
+
+}
+
+// GetQuote is a free data retrieval call binding the contract method 0xd323e185.
+//
+// Solidity: function getQuote(((address,address,uint24,int24,address),bool,uint256,uint160) params) view returns((uint256,uint160) result)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) GetQuote(params SwapUniswapV4HookQuoteParams) (SwapUniswapV4HookQuoteResult, error) {
+	return _SwapUniswapV4Hook.Contract.GetQuote(&_SwapUniswapV4Hook.CallOpts, params)
+}
+
+// GetQuote is a free data retrieval call binding the contract method 0xd323e185.
+//
+// Solidity: function getQuote(((address,address,uint24,int24,address),bool,uint256,uint160) params) view returns((uint256,uint160) result)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) GetQuote(params SwapUniswapV4HookQuoteParams) (SwapUniswapV4HookQuoteResult, error) {
+	return _SwapUniswapV4Hook.Contract.GetQuote(&_SwapUniswapV4Hook.CallOpts, params)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) HookType() (uint8, error) {
+	return _SwapUniswapV4Hook.Contract.HookType(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) HookType() (uint8, error) {
+	return _SwapUniswapV4Hook.Contract.HookType(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) Inspect(data []byte) ([]byte, error) {
+	return _SwapUniswapV4Hook.Contract.Inspect(&_SwapUniswapV4Hook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _SwapUniswapV4Hook.Contract.Inspect(&_SwapUniswapV4Hook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) LastCaller() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.LastCaller(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) LastCaller() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.LastCaller(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) SpToken() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.SpToken(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) SpToken() (common.Address, error) {
+	return _SwapUniswapV4Hook.Contract.SpToken(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) Subtype() ([32]byte, error) {
+	return _SwapUniswapV4Hook.Contract.Subtype(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) Subtype() ([32]byte, error) {
+	return _SwapUniswapV4Hook.Contract.Subtype(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _SwapUniswapV4Hook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) UsedShares() (*big.Int, error) {
+	return _SwapUniswapV4Hook.Contract.UsedShares(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookCallerSession) UsedShares() (*big.Int, error) {
+	return _SwapUniswapV4Hook.Contract.UsedShares(&_SwapUniswapV4Hook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.PostExecute(&_SwapUniswapV4Hook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.PostExecute(&_SwapUniswapV4Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.PreExecute(&_SwapUniswapV4Hook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.PreExecute(&_SwapUniswapV4Hook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.ResetExecutionState(&_SwapUniswapV4Hook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.ResetExecutionState(&_SwapUniswapV4Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.SetExecutionContext(&_SwapUniswapV4Hook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.SetExecutionContext(&_SwapUniswapV4Hook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.SetOutAmount(&_SwapUniswapV4Hook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.SetOutAmount(&_SwapUniswapV4Hook.TransactOpts, _outAmount, caller)
+}
+
+// UnlockCallback is a paid mutator transaction binding the contract method 0x91dd7346.
+//
+// Solidity: function unlockCallback(bytes data) returns(bytes)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) UnlockCallback(opts *bind.TransactOpts, data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.Transact(opts, "unlockCallback", data)
+}
+
+// UnlockCallback is a paid mutator transaction binding the contract method 0x91dd7346.
+//
+// Solidity: function unlockCallback(bytes data) returns(bytes)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) UnlockCallback(data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.UnlockCallback(&_SwapUniswapV4Hook.TransactOpts, data)
+}
+
+// UnlockCallback is a paid mutator transaction binding the contract method 0x91dd7346.
+//
+// Solidity: function unlockCallback(bytes data) returns(bytes)
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) UnlockCallback(data []byte) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.UnlockCallback(&_SwapUniswapV4Hook.TransactOpts, data)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactor) Receive(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.contract.RawTransact(opts, nil) // calldata is disallowed for receive function
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookSession) Receive() (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.Receive(&_SwapUniswapV4Hook.TransactOpts)
+}
+
+// Receive is a paid mutator transaction binding the contract receive function.
+//
+// Solidity: receive() payable returns()
+func (_SwapUniswapV4Hook *SwapUniswapV4HookTransactorSession) Receive() (*types.Transaction, error) {
+	return _SwapUniswapV4Hook.Contract.Receive(&_SwapUniswapV4Hook.TransactOpts)
+}
@@ -0,0 +1,696 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package WithdrawWETHHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// WithdrawWETHHookMetaData contains all meta data concerning the WithdrawWETHHook contract.
+var WithdrawWETHHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[{\"name\":\"weth\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"SUB_TYPE\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"WETH\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INSUFFICIENT_WETH_BALANCE\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ZERO_WETH_AMOUNT\",\"inputs\":[]}]",
+}
+
+// WithdrawWETHHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use WithdrawWETHHookMetaData.ABI instead.
+var WithdrawWETHHookABI = WithdrawWETHHookMetaData.ABI
+
+// WithdrawWETHHook is an auto generated Go binding around an Ethereum contract.
+type WithdrawWETHHook struct {
+	WithdrawWETHHookCaller     // Read-only binding to the contract
+	WithdrawWETHHookTransactor // Write-only binding to the contract
+	WithdrawWETHHookFilterer   // Log filterer for contract events
+}
+
+// WithdrawWETHHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type WithdrawWETHHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WithdrawWETHHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type WithdrawWETHHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WithdrawWETHHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type WithdrawWETHHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// WithdrawWETHHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type WithdrawWETHHookSession struct {
+	Contract     *WithdrawWETHHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts     // Call options to use throughout this session
+	TransactOpts bind.TransactOpts // Transaction auth options to use throughout this session
+}
+
+// WithdrawWETHHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type WithdrawWETHHookCallerSession struct {
+	Contract *WithdrawWETHHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts           // Call options to use throughout this session
+}
+
+// WithdrawWETHHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type WithdrawWETHHookTransactorSession struct {
+	Contract     *WithdrawWETHHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts           // Transaction auth options to use throughout this session
+}
+
+// WithdrawWETHHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type WithdrawWETHHookRaw struct {
+	Contract *WithdrawWETHHook // Generic contract binding to access the raw methods on
+}
+
+// WithdrawWETHHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type WithdrawWETHHookCallerRaw struct {
+	Contract *WithdrawWETHHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// WithdrawWETHHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type WithdrawWETHHookTransactorRaw struct {
+	Contract *WithdrawWETHHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewWithdrawWETHHook creates a new instance of WithdrawWETHHook, bound to a specific deployed contract.
+func NewWithdrawWETHHook(address common.Address, backend bind.ContractBackend) (*WithdrawWETHHook, error) {
+	contract, err := bindWithdrawWETHHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawWETHHook{WithdrawWETHHookCaller: WithdrawWETHHookCaller{contract: contract}, WithdrawWETHHookTransactor: WithdrawWETHHookTransactor{contract: contract}, WithdrawWETHHookFilterer: WithdrawWETHHookFilterer{contract: contract}}, nil
+}
+
+// NewWithdrawWETHHookCaller creates a new read-only instance of WithdrawWETHHook, bound to a specific deployed contract.
+func NewWithdrawWETHHookCaller(address common.Address, caller bind.ContractCaller) (*WithdrawWETHHookCaller, error) {
+	contract, err := bindWithdrawWETHHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawWETHHookCaller{contract: contract}, nil
+}
+
+// NewWithdrawWETHHookTransactor creates a new write-only instance of WithdrawWETHHook, bound to a specific deployed contract.
+func NewWithdrawWETHHookTransactor(address common.Address, transactor bind.ContractTransactor) (*WithdrawWETHHookTransactor, error) {
+	contract, err := bindWithdrawWETHHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawWETHHookTransactor{contract: contract}, nil
+}
+
+// NewWithdrawWETHHookFilterer creates a new log filterer instance of WithdrawWETHHook, bound to a specific deployed contract.
+func NewWithdrawWETHHookFilterer(address common.Address, filterer bind.ContractFilterer) (*WithdrawWETHHookFilterer, error) {
+	contract, err := bindWithdrawWETHHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &WithdrawWETHHookFilterer{contract: contract}, nil
+}
+
+// bindWithdrawWETHHook binds a generic wrapper to an already deployed contract.
+func bindWithdrawWETHHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := WithdrawWETHHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WithdrawWETHHook *WithdrawWETHHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WithdrawWETHHook.Contract.WithdrawWETHHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WithdrawWETHHook *WithdrawWETHHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.WithdrawWETHHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WithdrawWETHHook *WithdrawWETHHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.WithdrawWETHHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_WithdrawWETHHook *WithdrawWETHHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _WithdrawWETHHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) SUBTYPE(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "SUB_TYPE")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) SUBTYPE() ([32]byte, error) {
+	return _WithdrawWETHHook.Contract.SUBTYPE(&_WithdrawWETHHook.CallOpts)
+}
+
+// SUBTYPE is a free data retrieval call binding the contract method 0x984d6e7a.
+//
+// Solidity: function SUB_TYPE() view returns(bytes32)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) SUBTYPE() ([32]byte, error) {
+	return _WithdrawWETHHook.Contract.SUBTYPE(&_WithdrawWETHHook.CallOpts)
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) WETH(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "WETH")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) WETH() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.WETH(&_WithdrawWETHHook.CallOpts)
+}
+
+// WETH is a free data retrieval call binding the contract method 0xad5c4648.
+//
+// Solidity: function WETH() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) WETH() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.WETH(&_WithdrawWETHHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) Asset() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.Asset(&_WithdrawWETHHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) Asset() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.Asset(&_WithdrawWETHHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _WithdrawWETHHook.Contract.Build(&_WithdrawWETHHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _WithdrawWETHHook.Contract.Build(&_WithdrawWETHHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", data)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _WithdrawWETHHook.Contract.DecodeUsePrevHookAmount(&_WithdrawWETHHook.CallOpts, data)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes data) pure returns(bool)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) DecodeUsePrevHookAmount(data []byte) (bool, error) {
+	return _WithdrawWETHHook.Contract.DecodeUsePrevHookAmount(&_WithdrawWETHHook.CallOpts, data)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) ExecutionNonce() (*big.Int, error) {
+	return _WithdrawWETHHook.Contract.ExecutionNonce(&_WithdrawWETHHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _WithdrawWETHHook.Contract.ExecutionNonce(&_WithdrawWETHHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _WithdrawWETHHook.Contract.GetOutAmount(&_WithdrawWETHHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _WithdrawWETHHook.Contract.GetOutAmount(&_WithdrawWETHHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) HookType() (uint8, error) {
+	return _WithdrawWETHHook.Contract.HookType(&_WithdrawWETHHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) HookType() (uint8, error) {
+	return _WithdrawWETHHook.Contract.HookType(&_WithdrawWETHHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) Inspect(opts *bind.CallOpts, arg0 []byte) ([]byte, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "inspect", arg0)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _WithdrawWETHHook.Contract.Inspect(&_WithdrawWETHHook.CallOpts, arg0)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes ) view returns(bytes)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) Inspect(arg0 []byte) ([]byte, error) {
+	return _WithdrawWETHHook.Contract.Inspect(&_WithdrawWETHHook.CallOpts, arg0)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) LastCaller() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.LastCaller(&_WithdrawWETHHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) LastCaller() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.LastCaller(&_WithdrawWETHHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) SpToken() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.SpToken(&_WithdrawWETHHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) SpToken() (common.Address, error) {
+	return _WithdrawWETHHook.Contract.SpToken(&_WithdrawWETHHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) Subtype() ([32]byte, error) {
+	return _WithdrawWETHHook.Contract.Subtype(&_WithdrawWETHHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) Subtype() ([32]byte, error) {
+	return _WithdrawWETHHook.Contract.Subtype(&_WithdrawWETHHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _WithdrawWETHHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookSession) UsedShares() (*big.Int, error) {
+	return _WithdrawWETHHook.Contract.UsedShares(&_WithdrawWETHHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_WithdrawWETHHook *WithdrawWETHHookCallerSession) UsedShares() (*big.Int, error) {
+	return _WithdrawWETHHook.Contract.UsedShares(&_WithdrawWETHHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _WithdrawWETHHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.PostExecute(&_WithdrawWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.PostExecute(&_WithdrawWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _WithdrawWETHHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.PreExecute(&_WithdrawWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.PreExecute(&_WithdrawWETHHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.ResetExecutionState(&_WithdrawWETHHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.ResetExecutionState(&_WithdrawWETHHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.SetExecutionContext(&_WithdrawWETHHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.SetExecutionContext(&_WithdrawWETHHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.SetOutAmount(&_WithdrawWETHHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_WithdrawWETHHook *WithdrawWETHHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _WithdrawWETHHook.Contract.SetOutAmount(&_WithdrawWETHHook.TransactOpts, _outAmount, caller)
+}
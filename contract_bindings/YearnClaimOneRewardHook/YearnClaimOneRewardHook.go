@@ -0,0 +1,811 @@
+// Code generated - DO NOT EDIT.
+// This file is a generated binding and any manual changes will be lost.
+
+package YearnClaimOneRewardHook
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+
+	ethereum "github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var (
+	_ = errors.New
+	_ = big.NewInt
+	_ = strings.NewReader
+	_ = ethereum.NotFound
+	_ = bind.Bind
+	_ = common.Big1
+	_ = types.BloomLookup
+	_ = event.NewSubscription
+	_ = abi.ConvertType
+)
+
+// Execution is an auto generated low-level Go binding around an user-defined struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// YearnClaimOneRewardHookMetaData contains all meta data concerning the YearnClaimOneRewardHook contract.
+var YearnClaimOneRewardHookMetaData = &bind.MetaData{
+	ABI: "[{\"type\":\"constructor\",\"inputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"asset\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"build\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"hookData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"executions\",\"type\":\"tuple[]\",\"internalType\":\"structExecution[]\",\"components\":[{\"name\":\"target\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"value\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"callData\",\"type\":\"bytes\",\"internalType\":\"bytes\"}]}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"decodeAmount\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"decodeUsePrevHookAmount\",\"inputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"executionNonce\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"getOutAmount\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"hookType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint8\",\"internalType\":\"enumISuperHook.HookType\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"inspect\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"lastCaller\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"postExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"postExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"preExecute\",\"inputs\":[{\"name\":\"prevHook\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"account\",\"type\":\"address\",\"internalType\":\"address\"},{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"preExecuteMutex\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bool\",\"internalType\":\"bool\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"replaceCalldataAmount\",\"inputs\":[{\"name\":\"data\",\"type\":\"bytes\",\"internalType\":\"bytes\"},{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"outputs\":[{\"name\":\"\",\"type\":\"bytes\",\"internalType\":\"bytes\"}],\"stateMutability\":\"pure\"},{\"type\":\"function\",\"name\":\"resetExecutionState\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setExecutionContext\",\"inputs\":[{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"setOutAmount\",\"inputs\":[{\"name\":\"_outAmount\",\"type\":\"uint256\",\"internalType\":\"uint256\"},{\"name\":\"caller\",\"type\":\"address\",\"internalType\":\"address\"}],\"outputs\":[],\"stateMutability\":\"nonpayable\"},{\"type\":\"function\",\"name\":\"spToken\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"address\",\"internalType\":\"address\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subType\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"subtype\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"bytes32\",\"internalType\":\"bytes32\"}],\"stateMutability\":\"view\"},{\"type\":\"function\",\"name\":\"usedShares\",\"inputs\":[],\"outputs\":[{\"name\":\"\",\"type\":\"uint256\",\"internalType\":\"uint256\"}],\"stateMutability\":\"view\"},{\"type\":\"error\",\"name\":\"ADDRESS_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"AMOUNT_NOT_VALID\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"ASSET_ZERO_ADDRESS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"CANNOT_SET_OUT_AMOUNT\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INCOMPLETE_HOOK_EXECUTION\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"INVALID_REWARD_TOKEN\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"NOT_AUTHORIZED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"POST_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"PRE_EXECUTE_ALREADY_CALLED\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"REWARD_TOKEN_ZERO_ADDRESS\",\"inputs\":[]},{\"type\":\"error\",\"name\":\"UNAUTHORIZED_CALLER\",\"inputs\":[]}]",
+	Bin: "0x60a0604052348015600e575f5ffd5b50604080518082019091526005815264436c61696d60d81b6020909101525f805460ff191660021790557f5c2db6855baf6c374b9d0065771a33d4c31a8627d7b9d40f3fc6aa5dbd7b8a4860805260805161115661007b5f395f818161021e01526102ce01526111565ff3fe608060405234801561000f575f5ffd5b5060043610610132575f3560e01c806360b5be29116100b45780638e148776116100795780638e148776146102a4578063ac440f72146102b6578063cf0a6181146102c9578063d06a1e89146102f0578063e445e7dd14610303578063e77455171461031c575f5ffd5b806360b5be2914610242578063685a943c1461026457806381cbe6911461026c57806384542a241461027f5780638c4313c114610291575f5ffd5b80632ae2fe3d116100fa5780632ae2fe3d146101b757806338d52e0f146101ca5780633a28b0ab146101dc5780633b5896bc146101fc5780635492e6771461021c575f5ffd5b806301bd43ef1461013657806305b4fe911461015257806314cb37cf146101675780631f2646191461017a5780632113522a1461018d575b5f5ffd5b61013f60035c81565b6040519081526020015b60405180910390f35b610165610160366004610d98565b61032a565b005b610165610175366004610df5565b6103a4565b610165610188366004610e15565b6103c5565b61019f6001600160a01b0360045c1681565b6040516001600160a01b039091168152602001610149565b6101656101c5366004610d98565b61041e565b61019f6001600160a01b0360025c1681565b6101ef6101ea366004610ede565b610491565b6040516101499190610f4e565b61020f61020a366004610d98565b610499565b6040516101499190610f60565b7f000000000000000000000000000000000000000000000000000000000000000061013f565b61025460ff600160a81b60025c041681565b6040519015158152602001610149565b61013f5f5c81565b61013f61027a366004610df5565b6106b2565b61025460ff600160a01b60025c041681565b6101ef61029f366004610fed565b6106c4565b61019f6001600160a01b0360015c1681565b61013f6102c436600461102c565b505f90565b61013f7f000000000000000000000000000000000000000000000000000000000000000081565b6101656102fe366004610df5565b610786565b5f5461030f9060ff1681565b604051610149919061105e565b6102546102c436600461102c565b336001600160a01b038416146103535760405163e15e56c960e01b815260040160405180910390fd5b5f61035d846107d8565b9050610368816107eb565b156103865760405163945b63f560e01b815260040160405180910390fd5b6103918160016107f8565b61039d8585858561080e565b5050505050565b6103ad81610870565b50336004805c6001600160a01b0319168217905d5050565b5f6103cf826107d8565b90506103da816108a2565b806103e957506103e9816107eb565b156104075760405163441e4c7360e11b815260040160405180910390fd5b5f6104138260016108ab565b905083815d50505050565b336001600160a01b038416146104475760405163e15e56c960e01b815260040160405180910390fd5b5f610451846107d8565b905061045c816108a2565b1561047a57604051630bbb04d960e11b815260040160405180910390fd5b610485816001610900565b61039d8585858561090c565b815b92915050565b60605f6104a8868686866109d5565b9050805160026104b89190611098565b67ffffffffffffffff8111156104d0576104d0610e3f565b60405190808252806020026020018201604052801561051c57816020015b60408051606080820183525f8083526020830152918101919091528152602001906001900390816104ee5790505b5091506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b0316632ae2fe3d8989898960405160240161056594939291906110ab565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250825f815181106105a7576105a76110f3565b60209081029190910101525f5b8151811015610608578181815181106105cf576105cf6110f3565b6020026020010151838260016105e59190611098565b815181106105f5576105f56110f3565b60209081029190910101526001016105b4565b506040518060600160405280306001600160a01b031681526020015f8152602001306001600160a01b03166305b4fe918989898960405160240161064f94939291906110ab565b604051602081830303815290604052915060e01b6020820180516001600160e01b038381831617835250505050815250826001845161068e9190611107565b8151811061069e5761069e6110f3565b602002602001018190525050949350505050565b5f6104936106bf836107d8565b610aec565b606061070483838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610af992505050565b61074584848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610b01915050565b6040516bffffffffffffffffffffffff19606093841b811660208301529190921b166034820152604801604051602081830303815290604052905092915050565b5f610790826107d8565b905061079b816108a2565b15806107ad57506107ab816107eb565b155b156107cb57604051634bd439b560e11b815260040160405180910390fd5b6107d481610b69565b5050565b5f5f6107e383610b80565b5c9392505050565b5f5f6107e38360036108ab565b5f6108048360036108ab565b905081815d505050565b61086a61081a846106b2565b61085a84848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250899250610bec915050565b6108649190611107565b84610c92565b50505050565b5f6003805c90826108808361111a565b9190505d505f61088f83610b80565b905060035c80825d505060035c92915050565b5f5f6107e38360025b604080517fc41a07c57a776f6217c6d4278dfe7a5d114742afe55627ce458c2802aa4d703460208083019190915281830194909452606080820193909352815180820390930183526080019052805191012090565b5f6108048360026108ab565b61094d82828080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610b01915050565b6002805c6001600160a01b0319166001600160a01b03831617905d5060025c6001600160a01b03166109925760405163a51bd6b160e01b815260040160405180910390fd5b61086a61086483838080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250889250610bec915050565b60605f610a1684848080601f0160208091040260200160405190810160405280939291908181526020018383808284375f92019190915250610af992505050565b90505f610a5a85858080601f0160208091040260200160405190810160405280939291908181526020018383808284375f9201919091525060349250610b01915050565b90506001600160a01b0382161580610a7957506001600160a01b038116155b15610a9757604051630f58648f60e01b815260040160405180910390fd5b6040516001600160a01b0382166024820152610ae190839060440160408051601f198184030181529190526020810180516001600160e01b0316634ef5230560e01b179052610caa565b979650505050505050565b5f5f6107e38360016108ab565b5f6104938260205b5f610b0d826014611098565b83511015610b595760405162461bcd60e51b8152602060048201526015602482015274746f416464726573735f6f75744f66426f756e647360581b604482015260640160405180910390fd5b500160200151600160601b900490565b610b73815f610900565b610b7d815f6107f8565b50565b5f7f8ce092d4869bdfa1a1ce579ddddb2b776c39ace5d7b5d7e70e97ece2a78f4f9b82604051602001610bcf92919091825260601b6bffffffffffffffffffffffff1916602082015260340190565b604051602081830303815290604052805190602001209050919050565b5f5f610bf9846034610b01565b90506001600160a01b038116610c2257604051634c32fa5b60e11b815260040160405180910390fd5b6040516370a0823160e01b81526001600160a01b0384811660048301528216906370a0823190602401602060405180830381865afa158015610c66573d5f5f3e3d5ffd5b505050506040513d601f19601f82011682018060405250810190610c8a9190611132565b949350505050565b5f610c9c826107d8565b90505f6104138260016108ab565b604080516001808252818301909252606091816020015b60408051606080820183525f808352602083015291810191909152815260200190600190039081610cc15790505090506040518060600160405280846001600160a01b031681526020015f815260200183815250815f81518110610d2757610d276110f3565b602002602001018190525092915050565b80356001600160a01b0381168114610d4e575f5ffd5b919050565b5f5f83601f840112610d63575f5ffd5b50813567ffffffffffffffff811115610d7a575f5ffd5b602083019150836020828501011115610d91575f5ffd5b9250929050565b5f5f5f5f60608587031215610dab575f5ffd5b610db485610d38565b9350610dc260208601610d38565b9250604085013567ffffffffffffffff811115610ddd575f5ffd5b610de987828801610d53565b95989497509550505050565b5f60208284031215610e05575f5ffd5b610e0e82610d38565b9392505050565b5f5f60408385031215610e26575f5ffd5b82359150610e3660208401610d38565b90509250929050565b634e487b7160e01b5f52604160045260245ffd5b5f82601f830112610e62575f5ffd5b813567ffffffffffffffff811115610e7c57610e7c610e3f565b604051601f8201601f19908116603f0116810167ffffffffffffffff81118282101715610eab57610eab610e3f565b604052818152838201602001851015610ec2575f5ffd5b816020850160208301375f918101602001919091529392505050565b5f5f60408385031215610eef575f5ffd5b823567ffffffffffffffff811115610f05575f5ffd5b610f1185828601610e53565b95602094909401359450505050565b5f81518084528060208401602086015e5f602082860101526020601f19601f83011685010191505092915050565b602081525f610e0e6020830184610f20565b5f602082016020835280845180835260408501915060408160051b8601019250602086015f5b82811015610fe157868503603f19018452815180516001600160a01b0316865260208082015190870152604090810151606091870182905290610fcb90870182610f20565b9550506020938401939190910190600101610f86565b50929695505050505050565b5f5f60208385031215610ffe575f5ffd5b823567ffffffffffffffff811115611014575f5ffd5b61102085828601610d53565b90969095509350505050565b5f6020828403121561103c575f5ffd5b813567ffffffffffffffff811115611052575f5ffd5b610c8a84828501610e53565b602081016003831061107e57634e487b7160e01b5f52602160045260245ffd5b91905290565b634e487b7160e01b5f52601160045260245ffd5b8082018082111561049357610493611084565b6001600160a01b038581168252841660208201526060604082018190528101829052818360808301375f818301608090810191909152601f909201601f191601019392505050565b634e487b7160e01b5f52603260045260245ffd5b8181038181111561049357610493611084565b5f6001820161112b5761112b611084565b5060010190565b5f60208284031215611142575f5ffd5b505191905056fea164736f6c634300081e000a",
+}
+
+// YearnClaimOneRewardHookABI is the input ABI used to generate the binding from.
+// Deprecated: Use YearnClaimOneRewardHookMetaData.ABI instead.
+var YearnClaimOneRewardHookABI = YearnClaimOneRewardHookMetaData.ABI
+
+// YearnClaimOneRewardHookBin is the compiled bytecode used for deploying new contracts.
+// Deprecated: Use YearnClaimOneRewardHookMetaData.Bin instead.
+var YearnClaimOneRewardHookBin = YearnClaimOneRewardHookMetaData.Bin
+
+// DeployYearnClaimOneRewardHook deploys a new Ethereum contract, binding an instance of YearnClaimOneRewardHook to it.
+func DeployYearnClaimOneRewardHook(auth *bind.TransactOpts, backend bind.ContractBackend) (common.Address, *types.Transaction, *YearnClaimOneRewardHook, error) {
+	parsed, err := YearnClaimOneRewardHookMetaData.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	if parsed == nil {
+		return common.Address{}, nil, nil, errors.New("GetABI returned nil")
+	}
+
+	address, tx, contract, err := bind.DeployContract(auth, *parsed, common.FromHex(YearnClaimOneRewardHookBin), backend)
+	if err != nil {
+		return common.Address{}, nil, nil, err
+	}
+	return address, tx, &YearnClaimOneRewardHook{YearnClaimOneRewardHookCaller: YearnClaimOneRewardHookCaller{contract: contract}, YearnClaimOneRewardHookTransactor: YearnClaimOneRewardHookTransactor{contract: contract}, YearnClaimOneRewardHookFilterer: YearnClaimOneRewardHookFilterer{contract: contract}}, nil
+}
+
+// YearnClaimOneRewardHook is an auto generated Go binding around an Ethereum contract.
+type YearnClaimOneRewardHook struct {
+	YearnClaimOneRewardHookCaller     // Read-only binding to the contract
+	YearnClaimOneRewardHookTransactor // Write-only binding to the contract
+	YearnClaimOneRewardHookFilterer   // Log filterer for contract events
+}
+
+// YearnClaimOneRewardHookCaller is an auto generated read-only Go binding around an Ethereum contract.
+type YearnClaimOneRewardHookCaller struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// YearnClaimOneRewardHookTransactor is an auto generated write-only Go binding around an Ethereum contract.
+type YearnClaimOneRewardHookTransactor struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// YearnClaimOneRewardHookFilterer is an auto generated log filtering Go binding around an Ethereum contract events.
+type YearnClaimOneRewardHookFilterer struct {
+	contract *bind.BoundContract // Generic contract wrapper for the low level calls
+}
+
+// YearnClaimOneRewardHookSession is an auto generated Go binding around an Ethereum contract,
+// with pre-set call and transact options.
+type YearnClaimOneRewardHookSession struct {
+	Contract     *YearnClaimOneRewardHook // Generic contract binding to set the session for
+	CallOpts     bind.CallOpts            // Call options to use throughout this session
+	TransactOpts bind.TransactOpts        // Transaction auth options to use throughout this session
+}
+
+// YearnClaimOneRewardHookCallerSession is an auto generated read-only Go binding around an Ethereum contract,
+// with pre-set call options.
+type YearnClaimOneRewardHookCallerSession struct {
+	Contract *YearnClaimOneRewardHookCaller // Generic contract caller binding to set the session for
+	CallOpts bind.CallOpts                  // Call options to use throughout this session
+}
+
+// YearnClaimOneRewardHookTransactorSession is an auto generated write-only Go binding around an Ethereum contract,
+// with pre-set transact options.
+type YearnClaimOneRewardHookTransactorSession struct {
+	Contract     *YearnClaimOneRewardHookTransactor // Generic contract transactor binding to set the session for
+	TransactOpts bind.TransactOpts                  // Transaction auth options to use throughout this session
+}
+
+// YearnClaimOneRewardHookRaw is an auto generated low-level Go binding around an Ethereum contract.
+type YearnClaimOneRewardHookRaw struct {
+	Contract *YearnClaimOneRewardHook // Generic contract binding to access the raw methods on
+}
+
+// YearnClaimOneRewardHookCallerRaw is an auto generated low-level read-only Go binding around an Ethereum contract.
+type YearnClaimOneRewardHookCallerRaw struct {
+	Contract *YearnClaimOneRewardHookCaller // Generic read-only contract binding to access the raw methods on
+}
+
+// YearnClaimOneRewardHookTransactorRaw is an auto generated low-level write-only Go binding around an Ethereum contract.
+type YearnClaimOneRewardHookTransactorRaw struct {
+	Contract *YearnClaimOneRewardHookTransactor // Generic write-only contract binding to access the raw methods on
+}
+
+// NewYearnClaimOneRewardHook creates a new instance of YearnClaimOneRewardHook, bound to a specific deployed contract.
+func NewYearnClaimOneRewardHook(address common.Address, backend bind.ContractBackend) (*YearnClaimOneRewardHook, error) {
+	contract, err := bindYearnClaimOneRewardHook(address, backend, backend, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &YearnClaimOneRewardHook{YearnClaimOneRewardHookCaller: YearnClaimOneRewardHookCaller{contract: contract}, YearnClaimOneRewardHookTransactor: YearnClaimOneRewardHookTransactor{contract: contract}, YearnClaimOneRewardHookFilterer: YearnClaimOneRewardHookFilterer{contract: contract}}, nil
+}
+
+// NewYearnClaimOneRewardHookCaller creates a new read-only instance of YearnClaimOneRewardHook, bound to a specific deployed contract.
+func NewYearnClaimOneRewardHookCaller(address common.Address, caller bind.ContractCaller) (*YearnClaimOneRewardHookCaller, error) {
+	contract, err := bindYearnClaimOneRewardHook(address, caller, nil, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &YearnClaimOneRewardHookCaller{contract: contract}, nil
+}
+
+// NewYearnClaimOneRewardHookTransactor creates a new write-only instance of YearnClaimOneRewardHook, bound to a specific deployed contract.
+func NewYearnClaimOneRewardHookTransactor(address common.Address, transactor bind.ContractTransactor) (*YearnClaimOneRewardHookTransactor, error) {
+	contract, err := bindYearnClaimOneRewardHook(address, nil, transactor, nil)
+	if err != nil {
+		return nil, err
+	}
+	return &YearnClaimOneRewardHookTransactor{contract: contract}, nil
+}
+
+// NewYearnClaimOneRewardHookFilterer creates a new log filterer instance of YearnClaimOneRewardHook, bound to a specific deployed contract.
+func NewYearnClaimOneRewardHookFilterer(address common.Address, filterer bind.ContractFilterer) (*YearnClaimOneRewardHookFilterer, error) {
+	contract, err := bindYearnClaimOneRewardHook(address, nil, nil, filterer)
+	if err != nil {
+		return nil, err
+	}
+	return &YearnClaimOneRewardHookFilterer{contract: contract}, nil
+}
+
+// bindYearnClaimOneRewardHook binds a generic wrapper to an already deployed contract.
+func bindYearnClaimOneRewardHook(address common.Address, caller bind.ContractCaller, transactor bind.ContractTransactor, filterer bind.ContractFilterer) (*bind.BoundContract, error) {
+	parsed, err := YearnClaimOneRewardHookMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return bind.NewBoundContract(address, *parsed, caller, transactor, filterer), nil
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _YearnClaimOneRewardHook.Contract.YearnClaimOneRewardHookCaller.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.YearnClaimOneRewardHookTransactor.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.YearnClaimOneRewardHookTransactor.contract.Transact(opts, method, params...)
+}
+
+// Call invokes the (constant) contract method with params as input values and
+// sets the output to result. The result type might be a single field for simple
+// returns, a slice of interfaces for anonymous returns and a struct for named
+// returns.
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerRaw) Call(opts *bind.CallOpts, result *[]interface{}, method string, params ...interface{}) error {
+	return _YearnClaimOneRewardHook.Contract.contract.Call(opts, result, method, params...)
+}
+
+// Transfer initiates a plain transaction to move funds to the contract, calling
+// its default method if one is available.
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorRaw) Transfer(opts *bind.TransactOpts) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.contract.Transfer(opts)
+}
+
+// Transact invokes the (paid) contract method with params as input values.
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorRaw) Transact(opts *bind.TransactOpts, method string, params ...interface{}) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.contract.Transact(opts, method, params...)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) Asset(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "asset")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) Asset() (common.Address, error) {
+	return _YearnClaimOneRewardHook.Contract.Asset(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// Asset is a free data retrieval call binding the contract method 0x38d52e0f.
+//
+// Solidity: function asset() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) Asset() (common.Address, error) {
+	return _YearnClaimOneRewardHook.Contract.Asset(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) Build(opts *bind.CallOpts, prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "build", prevHook, account, hookData)
+
+	if err != nil {
+		return *new([]Execution), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]Execution)).(*[]Execution)
+
+	return out0, err
+
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _YearnClaimOneRewardHook.Contract.Build(&_YearnClaimOneRewardHook.CallOpts, prevHook, account, hookData)
+}
+
+// Build is a free data retrieval call binding the contract method 0x3b5896bc.
+//
+// Solidity: function build(address prevHook, address account, bytes hookData) view returns((address,uint256,bytes)[] executions)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) Build(prevHook common.Address, account common.Address, hookData []byte) ([]Execution, error) {
+	return _YearnClaimOneRewardHook.Contract.Build(&_YearnClaimOneRewardHook.CallOpts, prevHook, account, hookData)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes ) pure returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) DecodeAmount(opts *bind.CallOpts, arg0 []byte) (*big.Int, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "decodeAmount", arg0)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes ) pure returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) DecodeAmount(arg0 []byte) (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.DecodeAmount(&_YearnClaimOneRewardHook.CallOpts, arg0)
+}
+
+// DecodeAmount is a free data retrieval call binding the contract method 0xac440f72.
+//
+// Solidity: function decodeAmount(bytes ) pure returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) DecodeAmount(arg0 []byte) (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.DecodeAmount(&_YearnClaimOneRewardHook.CallOpts, arg0)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes ) pure returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) DecodeUsePrevHookAmount(opts *bind.CallOpts, arg0 []byte) (bool, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "decodeUsePrevHookAmount", arg0)
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes ) pure returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) DecodeUsePrevHookAmount(arg0 []byte) (bool, error) {
+	return _YearnClaimOneRewardHook.Contract.DecodeUsePrevHookAmount(&_YearnClaimOneRewardHook.CallOpts, arg0)
+}
+
+// DecodeUsePrevHookAmount is a free data retrieval call binding the contract method 0xe7745517.
+//
+// Solidity: function decodeUsePrevHookAmount(bytes ) pure returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) DecodeUsePrevHookAmount(arg0 []byte) (bool, error) {
+	return _YearnClaimOneRewardHook.Contract.DecodeUsePrevHookAmount(&_YearnClaimOneRewardHook.CallOpts, arg0)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "executionNonce")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) ExecutionNonce() (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.ExecutionNonce(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// ExecutionNonce is a free data retrieval call binding the contract method 0x01bd43ef.
+//
+// Solidity: function executionNonce() view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) ExecutionNonce() (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.ExecutionNonce(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "getOutAmount", caller)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.GetOutAmount(&_YearnClaimOneRewardHook.CallOpts, caller)
+}
+
+// GetOutAmount is a free data retrieval call binding the contract method 0x81cbe691.
+//
+// Solidity: function getOutAmount(address caller) view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) GetOutAmount(caller common.Address) (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.GetOutAmount(&_YearnClaimOneRewardHook.CallOpts, caller)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) HookType(opts *bind.CallOpts) (uint8, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "hookType")
+
+	if err != nil {
+		return *new(uint8), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(uint8)).(*uint8)
+
+	return out0, err
+
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) HookType() (uint8, error) {
+	return _YearnClaimOneRewardHook.Contract.HookType(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// HookType is a free data retrieval call binding the contract method 0xe445e7dd.
+//
+// Solidity: function hookType() view returns(uint8)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) HookType() (uint8, error) {
+	return _YearnClaimOneRewardHook.Contract.HookType(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "inspect", data)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) Inspect(data []byte) ([]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.Inspect(&_YearnClaimOneRewardHook.CallOpts, data)
+}
+
+// Inspect is a free data retrieval call binding the contract method 0x8c4313c1.
+//
+// Solidity: function inspect(bytes data) pure returns(bytes)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) Inspect(data []byte) ([]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.Inspect(&_YearnClaimOneRewardHook.CallOpts, data)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "lastCaller")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) LastCaller() (common.Address, error) {
+	return _YearnClaimOneRewardHook.Contract.LastCaller(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// LastCaller is a free data retrieval call binding the contract method 0x2113522a.
+//
+// Solidity: function lastCaller() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) LastCaller() (common.Address, error) {
+	return _YearnClaimOneRewardHook.Contract.LastCaller(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) PostExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "postExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) PostExecuteMutex() (bool, error) {
+	return _YearnClaimOneRewardHook.Contract.PostExecuteMutex(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// PostExecuteMutex is a free data retrieval call binding the contract method 0x60b5be29.
+//
+// Solidity: function postExecuteMutex() view returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) PostExecuteMutex() (bool, error) {
+	return _YearnClaimOneRewardHook.Contract.PostExecuteMutex(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) PreExecuteMutex(opts *bind.CallOpts) (bool, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "preExecuteMutex")
+
+	if err != nil {
+		return *new(bool), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(bool)).(*bool)
+
+	return out0, err
+
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) PreExecuteMutex() (bool, error) {
+	return _YearnClaimOneRewardHook.Contract.PreExecuteMutex(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// PreExecuteMutex is a free data retrieval call binding the contract method 0x84542a24.
+//
+// Solidity: function preExecuteMutex() view returns(bool)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) PreExecuteMutex() (bool, error) {
+	return _YearnClaimOneRewardHook.Contract.PreExecuteMutex(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 ) pure returns(bytes)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) ReplaceCalldataAmount(opts *bind.CallOpts, data []byte, arg1 *big.Int) ([]byte, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "replaceCalldataAmount", data, arg1)
+
+	if err != nil {
+		return *new([]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([]byte)).(*[]byte)
+
+	return out0, err
+
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 ) pure returns(bytes)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) ReplaceCalldataAmount(data []byte, arg1 *big.Int) ([]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.ReplaceCalldataAmount(&_YearnClaimOneRewardHook.CallOpts, data, arg1)
+}
+
+// ReplaceCalldataAmount is a free data retrieval call binding the contract method 0x3a28b0ab.
+//
+// Solidity: function replaceCalldataAmount(bytes data, uint256 ) pure returns(bytes)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) ReplaceCalldataAmount(data []byte, arg1 *big.Int) ([]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.ReplaceCalldataAmount(&_YearnClaimOneRewardHook.CallOpts, data, arg1)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "spToken")
+
+	if err != nil {
+		return *new(common.Address), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(common.Address)).(*common.Address)
+
+	return out0, err
+
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) SpToken() (common.Address, error) {
+	return _YearnClaimOneRewardHook.Contract.SpToken(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// SpToken is a free data retrieval call binding the contract method 0x8e148776.
+//
+// Solidity: function spToken() view returns(address)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) SpToken() (common.Address, error) {
+	return _YearnClaimOneRewardHook.Contract.SpToken(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) SubType(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "subType")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) SubType() ([32]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.SubType(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// SubType is a free data retrieval call binding the contract method 0xcf0a6181.
+//
+// Solidity: function subType() view returns(bytes32)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) SubType() ([32]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.SubType(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) Subtype(opts *bind.CallOpts) ([32]byte, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "subtype")
+
+	if err != nil {
+		return *new([32]byte), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new([32]byte)).(*[32]byte)
+
+	return out0, err
+
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) Subtype() ([32]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.Subtype(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// Subtype is a free data retrieval call binding the contract method 0x5492e677.
+//
+// Solidity: function subtype() view returns(bytes32)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) Subtype() ([32]byte, error) {
+	return _YearnClaimOneRewardHook.Contract.Subtype(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCaller) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	var out []interface{}
+	err := _YearnClaimOneRewardHook.contract.Call(opts, &out, "usedShares")
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) UsedShares() (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.UsedShares(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// UsedShares is a free data retrieval call binding the contract method 0x685a943c.
+//
+// Solidity: function usedShares() view returns(uint256)
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookCallerSession) UsedShares() (*big.Int, error) {
+	return _YearnClaimOneRewardHook.Contract.UsedShares(&_YearnClaimOneRewardHook.CallOpts)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactor) PostExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.contract.Transact(opts, "postExecute", prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.PostExecute(&_YearnClaimOneRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// PostExecute is a paid mutator transaction binding the contract method 0x05b4fe91.
+//
+// Solidity: function postExecute(address prevHook, address account, bytes data) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorSession) PostExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.PostExecute(&_YearnClaimOneRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactor) PreExecute(opts *bind.TransactOpts, prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.contract.Transact(opts, "preExecute", prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.PreExecute(&_YearnClaimOneRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// PreExecute is a paid mutator transaction binding the contract method 0x2ae2fe3d.
+//
+// Solidity: function preExecute(address prevHook, address account, bytes data) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorSession) PreExecute(prevHook common.Address, account common.Address, data []byte) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.PreExecute(&_YearnClaimOneRewardHook.TransactOpts, prevHook, account, data)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactor) ResetExecutionState(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.contract.Transact(opts, "resetExecutionState", caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.ResetExecutionState(&_YearnClaimOneRewardHook.TransactOpts, caller)
+}
+
+// ResetExecutionState is a paid mutator transaction binding the contract method 0xd06a1e89.
+//
+// Solidity: function resetExecutionState(address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorSession) ResetExecutionState(caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.ResetExecutionState(&_YearnClaimOneRewardHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactor) SetExecutionContext(opts *bind.TransactOpts, caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.contract.Transact(opts, "setExecutionContext", caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.SetExecutionContext(&_YearnClaimOneRewardHook.TransactOpts, caller)
+}
+
+// SetExecutionContext is a paid mutator transaction binding the contract method 0x14cb37cf.
+//
+// Solidity: function setExecutionContext(address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorSession) SetExecutionContext(caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.SetExecutionContext(&_YearnClaimOneRewardHook.TransactOpts, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactor) SetOutAmount(opts *bind.TransactOpts, _outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.contract.Transact(opts, "setOutAmount", _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.SetOutAmount(&_YearnClaimOneRewardHook.TransactOpts, _outAmount, caller)
+}
+
+// SetOutAmount is a paid mutator transaction binding the contract method 0x1f264619.
+//
+// Solidity: function setOutAmount(uint256 _outAmount, address caller) returns()
+func (_YearnClaimOneRewardHook *YearnClaimOneRewardHookTransactorSession) SetOutAmount(_outAmount *big.Int, caller common.Address) (*types.Transaction, error) {
+	return _YearnClaimOneRewardHook.Contract.SetOutAmount(&_YearnClaimOneRewardHook.TransactOpts, _outAmount, caller)
+}
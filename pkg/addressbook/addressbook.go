@@ -0,0 +1,343 @@
+// Package addressbook loads the deployment addresses written under
+// script/output and resolves them per environment and chain.
+//
+// Each environment directory (prod, staging, dev, ...) may contain:
+//
+//	<chainId>/<Chain>-latest.json   flat {contractName: address} map written by DeployV2Base
+//	latest.json                     aggregated {"networks": {<Chain>: {"contracts": {...}}}}
+//	historical/<label>.json         earlier snapshots of latest.json
+//
+// Per-chain files take precedence over latest.json; names only present in
+// latest.json (such as the Nexus contracts) are merged in.
+package addressbook
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Environment names a directory under script/output.
+type Environment string
+
+const (
+	Prod    Environment = "prod"
+	Staging Environment = "staging"
+	Dev     Environment = "dev"
+)
+
+var (
+	// ErrUnknownEnvironment is returned for an environment that was not loaded.
+	ErrUnknownEnvironment = errors.New("addressbook: unknown environment")
+	// ErrUnknownChain is returned for a chain with no deployment in an environment.
+	ErrUnknownChain = errors.New("addressbook: unknown chain")
+	// ErrUnknownContract is returned for a contract name with no address on a chain.
+	ErrUnknownContract = errors.New("addressbook: unknown contract")
+)
+
+// knownChainIDs maps the network names used in latest.json to chain IDs, for
+// networks that have no per-chain directory to infer the ID from.
+var knownChainIDs = map[string]uint64{
+	"Ethereum":     1,
+	"Optimism":     10,
+	"BNB":          56,
+	"Gnosis":       100,
+	"Unichain":     130,
+	"Polygon":      137,
+	"Sonic":        146,
+	"Worldchain":   480,
+	"Base":         8453,
+	"Arbitrum":     42161,
+	"Avalanche":    43114,
+	"Berachain":    80094,
+	"Base_Sepolia": 84532,
+	"Sepolia":      11155111,
+	"OP_Sepolia":   11155420,
+}
+
+// Chain holds the contracts deployed on one chain in one environment.
+type Chain struct {
+	Name      string
+	ChainID   uint64
+	Counter   int
+	VnetID    string
+	Contracts map[string]common.Address
+}
+
+// Address returns the address deployed under name.
+func (c *Chain) Address(name string) (common.Address, error) {
+	addr, ok := c.Contracts[name]
+	if !ok {
+		return common.Address{}, fmt.Errorf("%w: %s on %s (%d)", ErrUnknownContract, name, c.Name, c.ChainID)
+	}
+	return addr, nil
+}
+
+// Names returns the deployed contract names, sorted.
+func (c *Chain) Names() []string {
+	names := make([]string, 0, len(c.Contracts))
+	for name := range c.Contracts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// Snapshot is one aggregated latest.json, current or historical.
+type Snapshot struct {
+	Label     string
+	UpdatedAt time.Time
+	Chains    map[uint64]*Chain
+}
+
+// Chain returns the snapshot's deployment on chainID.
+func (s *Snapshot) Chain(chainID uint64) (*Chain, error) {
+	c, ok := s.Chains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d in snapshot %s", ErrUnknownChain, chainID, s.Label)
+	}
+	return c, nil
+}
+
+// Deployment is everything loaded for one environment.
+type Deployment struct {
+	Environment Environment
+	// Chains merges the per-chain files with latest.json.
+	Chains map[uint64]*Chain
+	// Latest is latest.json as written, or nil if the environment has none.
+	Latest *Snapshot
+	// Historical holds historical/*.json, ordered by label.
+	Historical []*Snapshot
+}
+
+// Chain returns the deployment on chainID.
+func (d *Deployment) Chain(chainID uint64) (*Chain, error) {
+	c, ok := d.Chains[chainID]
+	if !ok {
+		return nil, fmt.Errorf("%w: %d in %s", ErrUnknownChain, chainID, d.Environment)
+	}
+	return c, nil
+}
+
+// Address resolves a contract name on chainID.
+func (d *Deployment) Address(chainID uint64, name string) (common.Address, error) {
+	c, err := d.Chain(chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return c.Address(name)
+}
+
+// ChainIDs returns the chains with a deployment, sorted.
+func (d *Deployment) ChainIDs() []uint64 {
+	ids := make([]uint64, 0, len(d.Chains))
+	for id := range d.Chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
+
+// Snapshot returns the historical snapshot with the given label.
+func (d *Deployment) Snapshot(label string) (*Snapshot, error) {
+	for _, s := range d.Historical {
+		if s.Label == label {
+			return s, nil
+		}
+	}
+	return nil, fmt.Errorf("addressbook: no snapshot %q in %s", label, d.Environment)
+}
+
+// Book is a set of loaded environments.
+type Book struct {
+	Deployments map[Environment]*Deployment
+}
+
+// Load reads the given environments from root, usually script/output. With no
+// environments it loads prod, staging and dev, skipping any that are missing.
+func Load(root string, envs ...Environment) (*Book, error) {
+	optional := len(envs) == 0
+	if optional {
+		envs = []Environment{Prod, Staging, Dev}
+	}
+	b := &Book{Deployments: make(map[Environment]*Deployment)}
+	for _, env := range envs {
+		dir := filepath.Join(root, string(env))
+		if _, err := os.Stat(dir); optional && errors.Is(err, os.ErrNotExist) {
+			continue
+		}
+		d, err := LoadDeployment(dir, env)
+		if err != nil {
+			return nil, err
+		}
+		b.Deployments[env] = d
+	}
+	return b, nil
+}
+
+// Deployment returns the loaded environment env.
+func (b *Book) Deployment(env Environment) (*Deployment, error) {
+	d, ok := b.Deployments[env]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownEnvironment, env)
+	}
+	return d, nil
+}
+
+// Chain returns the deployment of env on chainID.
+func (b *Book) Chain(env Environment, chainID uint64) (*Chain, error) {
+	d, err := b.Deployment(env)
+	if err != nil {
+		return nil, err
+	}
+	return d.Chain(chainID)
+}
+
+// Address resolves a contract name on chainID in env.
+func (b *Book) Address(env Environment, chainID uint64, name string) (common.Address, error) {
+	c, err := b.Chain(env, chainID)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return c.Address(name)
+}
+
+// LoadDeployment reads a single environment directory.
+func LoadDeployment(dir string, env Environment) (*Deployment, error) {
+	d := &Deployment{Environment: env, Chains: make(map[uint64]*Chain)}
+
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, fmt.Errorf("addressbook: %w", err)
+	}
+	// Per-chain files also teach us the chain ID behind each network name.
+	names := make(map[string]uint64)
+	for _, e := range entries {
+		if !e.IsDir() {
+			continue
+		}
+		chainID, err := strconv.ParseUint(e.Name(), 10, 64)
+		if err != nil {
+			continue
+		}
+		c, err := loadChainDir(filepath.Join(dir, e.Name()), chainID)
+		if err != nil {
+			return nil, err
+		}
+		if c != nil {
+			d.Chains[chainID] = c
+			names[c.Name] = chainID
+		}
+	}
+
+	latestPath := filepath.Join(dir, "latest.json")
+	if _, err := os.Stat(latestPath); err == nil {
+		if d.Latest, err = loadSnapshot(latestPath, "latest", names); err != nil {
+			return nil, err
+		}
+		for id, lc := range d.Latest.Chains {
+			c, ok := d.Chains[id]
+			if !ok {
+				d.Chains[id] = lc.clone()
+				continue
+			}
+			c.Counter, c.VnetID = lc.Counter, lc.VnetID
+			for name, addr := range lc.Contracts {
+				if _, ok := c.Contracts[name]; !ok {
+					c.Contracts[name] = addr
+				}
+			}
+		}
+	}
+
+	historical, _ := filepath.Glob(filepath.Join(dir, "historical", "*.json"))
+	sort.Strings(historical)
+	for _, path := range historical {
+		label := strings.TrimSuffix(filepath.Base(path), ".json")
+		s, err := loadSnapshot(path, label, names)
+		if err != nil {
+			return nil, err
+		}
+		d.Historical = append(d.Historical, s)
+	}
+	return d, nil
+}
+
+// loadChainDir reads the <Chain>-latest.json file in a chain directory.
+func loadChainDir(dir string, chainID uint64) (*Chain, error) {
+	files, err := filepath.Glob(filepath.Join(dir, "*-latest.json"))
+	if err != nil || len(files) == 0 {
+		return nil, err
+	}
+	if len(files) > 1 {
+		return nil, fmt.Errorf("addressbook: multiple deployment files in %s", dir)
+	}
+	raw, err := os.ReadFile(files[0])
+	if err != nil {
+		return nil, fmt.Errorf("addressbook: %w", err)
+	}
+	contracts := make(map[string]common.Address)
+	if err := json.Unmarshal(raw, &contracts); err != nil {
+		return nil, fmt.Errorf("addressbook: %s: %w", files[0], err)
+	}
+	return &Chain{
+		Name:      strings.TrimSuffix(filepath.Base(files[0]), "-latest.json"),
+		ChainID:   chainID,
+		Contracts: contracts,
+	}, nil
+}
+
+type snapshotJSON struct {
+	Networks map[string]struct {
+		Counter   int                       `json:"counter"`
+		VnetID    string                    `json:"vnet_id"`
+		Contracts map[string]common.Address `json:"contracts"`
+	} `json:"networks"`
+	UpdatedAt string `json:"updated_at"`
+}
+
+// loadSnapshot reads an aggregated latest.json. Network names are resolved to
+// chain IDs through names first and knownChainIDs second.
+func loadSnapshot(path, label string, names map[string]uint64) (*Snapshot, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("addressbook: %w", err)
+	}
+	var sj snapshotJSON
+	if err := json.Unmarshal(raw, &sj); err != nil {
+		return nil, fmt.Errorf("addressbook: %s: %w", path, err)
+	}
+	s := &Snapshot{Label: label, Chains: make(map[uint64]*Chain)}
+	if sj.UpdatedAt != "" {
+		if s.UpdatedAt, err = time.Parse(time.RFC3339, sj.UpdatedAt); err != nil {
+			return nil, fmt.Errorf("addressbook: %s: updated_at: %w", path, err)
+		}
+	}
+	for name, n := range sj.Networks {
+		id, ok := names[name]
+		if !ok {
+			if id, ok = knownChainIDs[name]; !ok {
+				return nil, fmt.Errorf("addressbook: %s: no chain ID for network %q", path, name)
+			}
+		}
+		s.Chains[id] = &Chain{Name: name, ChainID: id, Counter: n.Counter, VnetID: n.VnetID, Contracts: n.Contracts}
+	}
+	return s, nil
+}
+
+func (c *Chain) clone() *Chain {
+	cp := *c
+	cp.Contracts = make(map[string]common.Address, len(c.Contracts))
+	for k, v := range c.Contracts {
+		cp.Contracts[k] = v
+	}
+	return &cp
+}
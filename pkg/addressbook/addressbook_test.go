@@ -0,0 +1,93 @@
+package addressbook
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+const outputDir = "../../script/output"
+
+func TestLoadProd(t *testing.T) {
+	book, err := Load(outputDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, env := range []Environment{Prod, Staging, Dev} {
+		if _, err := book.Deployment(env); err != nil {
+			t.Fatalf("%s not loaded: %v", env, err)
+		}
+	}
+
+	addr, err := book.Address(Prod, 1, "SuperExecutor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := loadChainDir(outputDir+"/prod/1", 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := c.Contracts["SuperExecutor"]; addr != want || addr == (common.Address{}) {
+		t.Fatalf("SuperExecutor = %s, want %s", addr, want)
+	}
+
+	// Nexus contracts only live in the aggregated latest.json.
+	if _, err := book.Address(Prod, 1, "NexusAccountFactory"); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := book.Address(Prod, 1, "NoSuchContract"); !errors.Is(err, ErrUnknownContract) {
+		t.Fatalf("err = %v, want ErrUnknownContract", err)
+	}
+	if _, err := book.Chain(Prod, 999); !errors.Is(err, ErrUnknownChain) {
+		t.Fatalf("err = %v, want ErrUnknownChain", err)
+	}
+	if _, err := book.Chain("nope", 1); !errors.Is(err, ErrUnknownEnvironment) {
+		t.Fatalf("err = %v, want ErrUnknownEnvironment", err)
+	}
+}
+
+func TestHistorical(t *testing.T) {
+	book, err := Load(outputDir, Prod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	d, _ := book.Deployment(Prod)
+	if d.Latest == nil || d.Latest.UpdatedAt.IsZero() {
+		t.Fatal("latest.json not loaded")
+	}
+	s, err := d.Snapshot("latest1")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !s.UpdatedAt.Before(d.Latest.UpdatedAt) {
+		t.Fatalf("snapshot %s not older than latest", s.Label)
+	}
+	c, err := s.Chain(8453)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if c.Name != "Base" {
+		t.Fatalf("chain 8453 name = %q", c.Name)
+	}
+}
+
+func TestBindings(t *testing.T) {
+	book, err := Load(outputDir, Prod)
+	if err != nil {
+		t.Fatal(err)
+	}
+	c, err := book.Chain(Prod, 8453)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SuperExecutor(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.SuperLedger(nil); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := c.ERC7540YieldSourceOracle(nil); !errors.Is(err, ErrUnknownContract) {
+		t.Fatalf("err = %v, want ErrUnknownContract", err)
+	}
+}
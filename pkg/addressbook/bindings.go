@@ -0,0 +1,124 @@
+package addressbook
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/AcrossV3Adapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/DebridgeAdapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC4626YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC5115YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC7540YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/PendlePTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SpectraPTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/StakingYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationExecutor"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutor"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperNativePaymaster"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperSenderCreator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperYieldSourceOracle"
+)
+
+// bindAt resolves name on c and binds it with newFn.
+func bindAt[T any](c *Chain, name string, backend bind.ContractBackend, newFn func(common.Address, bind.ContractBackend) (*T, error)) (*T, error) {
+	addr, err := c.Address(name)
+	if err != nil {
+		return nil, err
+	}
+	return newFn(addr, backend)
+}
+
+// FlatFeeLedger binds the FlatFeeLedger deployment, which shares the
+// ISuperLedger ABI with SuperLedger.
+func (c *Chain) FlatFeeLedger(backend bind.ContractBackend) (*SuperLedger.SuperLedger, error) {
+	return bindAt(c, "FlatFeeLedger", backend, SuperLedger.NewSuperLedger)
+}
+
+// SuperExecutor binds the SuperExecutor deployment.
+func (c *Chain) SuperExecutor(backend bind.ContractBackend) (*SuperExecutor.SuperExecutor, error) {
+	return bindAt(c, "SuperExecutor", backend, SuperExecutor.NewSuperExecutor)
+}
+
+// SuperDestinationExecutor binds the SuperDestinationExecutor deployment.
+func (c *Chain) SuperDestinationExecutor(backend bind.ContractBackend) (*SuperDestinationExecutor.SuperDestinationExecutor, error) {
+	return bindAt(c, "SuperDestinationExecutor", backend, SuperDestinationExecutor.NewSuperDestinationExecutor)
+}
+
+// SuperLedger binds the SuperLedger deployment.
+func (c *Chain) SuperLedger(backend bind.ContractBackend) (*SuperLedger.SuperLedger, error) {
+	return bindAt(c, "SuperLedger", backend, SuperLedger.NewSuperLedger)
+}
+
+// SuperLedgerConfiguration binds the SuperLedgerConfiguration deployment.
+func (c *Chain) SuperLedgerConfiguration(backend bind.ContractBackend) (*SuperLedgerConfiguration.SuperLedgerConfiguration, error) {
+	return bindAt(c, "SuperLedgerConfiguration", backend, SuperLedgerConfiguration.NewSuperLedgerConfiguration)
+}
+
+// SuperValidator binds the SuperValidator deployment.
+func (c *Chain) SuperValidator(backend bind.ContractBackend) (*SuperValidator.SuperValidator, error) {
+	return bindAt(c, "SuperValidator", backend, SuperValidator.NewSuperValidator)
+}
+
+// SuperDestinationValidator binds the SuperDestinationValidator deployment.
+func (c *Chain) SuperDestinationValidator(backend bind.ContractBackend) (*SuperDestinationValidator.SuperDestinationValidator, error) {
+	return bindAt(c, "SuperDestinationValidator", backend, SuperDestinationValidator.NewSuperDestinationValidator)
+}
+
+// SuperNativePaymaster binds the SuperNativePaymaster deployment.
+func (c *Chain) SuperNativePaymaster(backend bind.ContractBackend) (*SuperNativePaymaster.SuperNativePaymaster, error) {
+	return bindAt(c, "SuperNativePaymaster", backend, SuperNativePaymaster.NewSuperNativePaymaster)
+}
+
+// SuperSenderCreator binds the SuperSenderCreator deployment.
+func (c *Chain) SuperSenderCreator(backend bind.ContractBackend) (*SuperSenderCreator.SuperSenderCreator, error) {
+	return bindAt(c, "SuperSenderCreator", backend, SuperSenderCreator.NewSuperSenderCreator)
+}
+
+// AcrossV3Adapter binds the AcrossV3Adapter deployment.
+func (c *Chain) AcrossV3Adapter(backend bind.ContractBackend) (*AcrossV3Adapter.AcrossV3Adapter, error) {
+	return bindAt(c, "AcrossV3Adapter", backend, AcrossV3Adapter.NewAcrossV3Adapter)
+}
+
+// DebridgeAdapter binds the DebridgeAdapter deployment.
+func (c *Chain) DebridgeAdapter(backend bind.ContractBackend) (*DebridgeAdapter.DebridgeAdapter, error) {
+	return bindAt(c, "DebridgeAdapter", backend, DebridgeAdapter.NewDebridgeAdapter)
+}
+
+// SuperYieldSourceOracle binds the SuperYieldSourceOracle deployment.
+func (c *Chain) SuperYieldSourceOracle(backend bind.ContractBackend) (*SuperYieldSourceOracle.SuperYieldSourceOracle, error) {
+	return bindAt(c, "SuperYieldSourceOracle", backend, SuperYieldSourceOracle.NewSuperYieldSourceOracle)
+}
+
+// ERC4626YieldSourceOracle binds the ERC4626YieldSourceOracle deployment.
+func (c *Chain) ERC4626YieldSourceOracle(backend bind.ContractBackend) (*ERC4626YieldSourceOracle.ERC4626YieldSourceOracle, error) {
+	return bindAt(c, "ERC4626YieldSourceOracle", backend, ERC4626YieldSourceOracle.NewERC4626YieldSourceOracle)
+}
+
+// ERC5115YieldSourceOracle binds the ERC5115YieldSourceOracle deployment.
+func (c *Chain) ERC5115YieldSourceOracle(backend bind.ContractBackend) (*ERC5115YieldSourceOracle.ERC5115YieldSourceOracle, error) {
+	return bindAt(c, "ERC5115YieldSourceOracle", backend, ERC5115YieldSourceOracle.NewERC5115YieldSourceOracle)
+}
+
+// ERC7540YieldSourceOracle binds the ERC7540YieldSourceOracle deployment.
+func (c *Chain) ERC7540YieldSourceOracle(backend bind.ContractBackend) (*ERC7540YieldSourceOracle.ERC7540YieldSourceOracle, error) {
+	return bindAt(c, "ERC7540YieldSourceOracle", backend, ERC7540YieldSourceOracle.NewERC7540YieldSourceOracle)
+}
+
+// PendlePTYieldSourceOracle binds the PendlePTYieldSourceOracle deployment.
+func (c *Chain) PendlePTYieldSourceOracle(backend bind.ContractBackend) (*PendlePTYieldSourceOracle.PendlePTYieldSourceOracle, error) {
+	return bindAt(c, "PendlePTYieldSourceOracle", backend, PendlePTYieldSourceOracle.NewPendlePTYieldSourceOracle)
+}
+
+// SpectraPTYieldSourceOracle binds the SpectraPTYieldSourceOracle deployment.
+func (c *Chain) SpectraPTYieldSourceOracle(backend bind.ContractBackend) (*SpectraPTYieldSourceOracle.SpectraPTYieldSourceOracle, error) {
+	return bindAt(c, "SpectraPTYieldSourceOracle", backend, SpectraPTYieldSourceOracle.NewSpectraPTYieldSourceOracle)
+}
+
+// StakingYieldSourceOracle binds the StakingYieldSourceOracle deployment.
+func (c *Chain) StakingYieldSourceOracle(backend bind.ContractBackend) (*StakingYieldSourceOracle.StakingYieldSourceOracle, error) {
+	return bindAt(c, "StakingYieldSourceOracle", backend, StakingYieldSourceOracle.NewStakingYieldSourceOracle)
+}
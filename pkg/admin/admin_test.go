@@ -0,0 +1,154 @@
+package admin
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/v2-core/pkg/harness"
+)
+
+func TestParseState(t *testing.T) {
+	yml := `
+manager: 0x00000000000000000000000000000000000000aa
+oracles:
+  - name: vault-a
+    salt: 0x0000000000000000000000000000000000000000000000000000000000000001
+    yieldSourceOracle: 0x00000000000000000000000000000000000000b1
+    feePercent: 1000
+    feeRecipient: 0x00000000000000000000000000000000000000c1
+    ledger: 0x00000000000000000000000000000000000000d1
+`
+	s, err := ParseState([]byte(yml))
+	if err != nil {
+		t.Fatal(err)
+	}
+	salt := common.HexToHash("0x01")
+	if want := crypto.Keccak256Hash(salt[:], common.HexToAddress("0xaa").Bytes()); *s.Oracles[0].ID != want {
+		t.Fatalf("id = %s, want %s", s.Oracles[0].ID.Hex(), want.Hex())
+	}
+	if s.Oracles[0].FeePercent != 1000 || s.Oracles[0].Ledger != common.HexToAddress("0xd1") {
+		t.Fatalf("entry = %+v", s.Oracles[0])
+	}
+
+	js := `{"manager":"0x00000000000000000000000000000000000000aa","oracles":[{"id":"0x0000000000000000000000000000000000000000000000000000000000000002",
+		"yieldSourceOracle":"0x00000000000000000000000000000000000000b1","feePercent":6000,
+		"feeRecipient":"0x00000000000000000000000000000000000000c1","ledger":"0x00000000000000000000000000000000000000d1"}]}`
+	if _, err := ParseState([]byte(js)); err == nil {
+		t.Fatal("expected fee above maximum to be rejected")
+	}
+}
+
+func TestCheckFeeChange(t *testing.T) {
+	for _, tt := range []struct {
+		cur, next uint64
+		ok        bool
+	}{
+		{1000, 1500, true}, {1000, 1501, false}, {1000, 500, true}, {1000, 499, false},
+		{1000, 0, true}, {0, 2500, true}, {0, 2501, false}, {3, 2, true}, {3, 1, false},
+	} {
+		if got := checkFeeChange(tt.cur, tt.next) == ""; got != tt.ok {
+			t.Errorf("checkFeeChange(%d, %d) ok = %v, want %v", tt.cur, tt.next, got, tt.ok)
+		}
+	}
+}
+
+func TestPlanAndApply(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	sim, auth, manager := stack.Backend, stack.Auth, stack.Auth.From
+
+	c, err := NewClient(stack.Addresses["SuperLedgerConfiguration"], stack.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// Mine in the background so Apply's WaitMined calls return.
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go func() {
+		tick := time.NewTicker(10 * time.Millisecond)
+		defer tick.Stop()
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-tick.C:
+				sim.Commit()
+			}
+		}
+	}()
+
+	entry := func(salt byte, fee uint64) Entry {
+		s := common.Hash{salt}
+		return Entry{Name: string(rune('a' + salt)), Salt: &s, YieldSourceOracle: common.Address{0xb1}, FeePercent: fee,
+			FeeRecipient: common.Address{0xc1}, Ledger: common.Address{0xd1}}
+	}
+	state := func(entries ...Entry) *State {
+		raw, _ := json.Marshal(&State{Manager: manager, Oracles: entries})
+		s, err := ParseState(raw)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return s
+	}
+
+	plan, err := c.Plan(ctx, state(entry(1, 1000), entry(2, 0)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Kind != StepSet || len(plan.Steps[0].Salts) != 2 {
+		t.Fatalf("initial plan:\n%s", plan)
+	}
+	if _, err := c.Apply(ctx, auth, plan, true); err != nil {
+		t.Fatalf("dry run: %v", err)
+	}
+	if _, err := c.Apply(ctx, auth, plan, false); err != nil {
+		t.Fatal(err)
+	}
+
+	// Raise one fee and drop the other entry from the state.
+	desired := state(entry(1, 1200))
+	plan, err = c.Plan(ctx, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Kind != StepPropose || len(plan.Unmanaged) != 1 {
+		t.Fatalf("update plan:\n%s", plan)
+	}
+	if _, err := c.Apply(ctx, auth, plan, false); err != nil {
+		t.Fatal(err)
+	}
+
+	plan, err = c.Plan(ctx, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !plan.Empty() || len(plan.Waiting) != 1 {
+		t.Fatalf("plan during delay:\n%s", plan)
+	}
+
+	if err := sim.AdjustTime(proposalDelay + time.Minute); err != nil {
+		t.Fatal(err)
+	}
+	plan, err = c.Plan(ctx, desired)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plan.Steps) != 1 || plan.Steps[0].Kind != StepAccept {
+		t.Fatalf("plan after delay:\n%s", plan)
+	}
+	if _, err := c.Apply(ctx, auth, plan, false); err != nil {
+		t.Fatal(err)
+	}
+	if plan, err = c.Plan(ctx, desired); err != nil || !plan.Empty() || len(plan.Waiting) != 0 {
+		t.Fatalf("final plan (%v):\n%s", err, plan)
+	}
+}
@@ -0,0 +1,95 @@
+package admin
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Result is the outcome of one plan step.
+type Result struct {
+	Step Step
+	// Tx is the signed transaction; in a dry run it is not sent.
+	Tx *types.Transaction
+	// Skipped is set in dry runs for steps that depend on earlier ones.
+	Skipped bool
+	Err     error
+}
+
+// Apply executes plan with auth, which must be the state's manager. Steps
+// run in order and each waits to be mined before the next; the first failure
+// stops execution.
+//
+// With dryRun, every step is simulated against the current chain state by
+// gas estimation and signed but not sent. Proposals that follow a
+// cancellation in the same plan cannot be simulated and are skipped.
+func (c *Client) Apply(ctx context.Context, auth *bind.TransactOpts, plan *Plan, dryRun bool) ([]Result, error) {
+	opts := *auth
+	opts.Context = ctx
+	opts.NoSend = dryRun
+
+	var results []Result
+	for _, step := range plan.Steps {
+		res := Result{Step: step}
+		if dryRun && step.AfterCancel {
+			res.Skipped = true
+			results = append(results, res)
+			continue
+		}
+		res.Tx, res.Err = c.send(&opts, step)
+		if res.Err == nil && !dryRun {
+			res.Err = c.wait(ctx, res.Tx)
+		}
+		results = append(results, res)
+		if res.Err != nil && !dryRun {
+			return results, fmt.Errorf("admin: %s(%v): %w", step.Kind, step.Names, res.Err)
+		}
+	}
+	var errs []error
+	for _, r := range results {
+		if r.Err != nil {
+			errs = append(errs, fmt.Errorf("%s(%v): %w", r.Step.Kind, r.Step.Names, r.Err))
+		}
+	}
+	if len(errs) > 0 {
+		return results, fmt.Errorf("admin: dry run: %w", errors.Join(errs...))
+	}
+	return results, nil
+}
+
+func (c *Client) send(opts *bind.TransactOpts, step Step) (*types.Transaction, error) {
+	switch step.Kind {
+	case StepCancel:
+		return c.Configuration.CancelYieldSourceOracleConfigProposal(opts, step.IDs[0])
+	case StepSet:
+		return c.Configuration.SetYieldSourceOracles(opts, hashes(step.Salts), step.Args)
+	case StepPropose:
+		return c.Configuration.ProposeYieldSourceOracleConfig(opts, hashes(step.IDs), step.Args)
+	case StepAccept:
+		return c.Configuration.AcceptYieldSourceOracleConfigProposal(opts, hashes(step.IDs))
+	}
+	return nil, fmt.Errorf("unknown step %q", step.Kind)
+}
+
+func (c *Client) wait(ctx context.Context, tx *types.Transaction) error {
+	receipt, err := bind.WaitMined(ctx, c.Backend, tx)
+	if err != nil {
+		return err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return nil
+}
+
+func hashes(hs []common.Hash) [][32]byte {
+	out := make([][32]byte, len(hs))
+	for i, h := range hs {
+		out[i] = h
+	}
+	return out
+}
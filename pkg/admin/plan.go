@@ -0,0 +1,338 @@
+package admin
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+)
+
+// Limits enforced by SuperLedgerConfiguration.
+const (
+	maxFeePercent        = 5000
+	maxFeePercentChange  = 5000
+	maxInitialFeePercent = 2500
+	proposalDelay        = 7 * 24 * time.Hour
+)
+
+// Backend is what the planner needs from a chain client.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// StepKind is the configuration call a step makes.
+type StepKind string
+
+const (
+	StepCancel  StepKind = "cancelYieldSourceOracleConfigProposal"
+	StepSet     StepKind = "setYieldSourceOracles"
+	StepPropose StepKind = "proposeYieldSourceOracleConfig"
+	StepAccept  StepKind = "acceptYieldSourceOracleConfigProposal"
+)
+
+// Step is one transaction of a plan.
+type Step struct {
+	Kind StepKind
+	// IDs are the affected oracle IDs; Salts are set for StepSet instead.
+	IDs   []common.Hash
+	Salts []common.Hash
+	Args  []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs
+	// Names labels the affected entries, in order.
+	Names []string
+	// AfterCancel marks a proposal that only succeeds once the plan's
+	// cancellations are mined; dry runs cannot simulate it.
+	AfterCancel bool
+}
+
+// Pending describes an entry that needs no call yet.
+type Pending struct {
+	Name string
+	ID   common.Hash
+	// Reason explains why nothing is planned, e.g. a proposal still in its
+	// one-week delay.
+	Reason string
+	// AcceptableAt is when a matching proposal can be accepted.
+	AcceptableAt time.Time
+}
+
+// Plan is the ordered set of calls that moves the chain to the desired state.
+type Plan struct {
+	Steps   []Step
+	Waiting []Pending
+	// Unmanaged lists IDs owned by the manager but absent from the state.
+	// The contract cannot delete configs, so they are only reported.
+	Unmanaged []common.Hash
+	// Invalid lists entries whose change the contract would reject.
+	Invalid []Pending
+}
+
+// Empty reports whether the plan makes no calls.
+func (p *Plan) Empty() bool { return len(p.Steps) == 0 }
+
+// String renders the plan for review.
+func (p *Plan) String() string {
+	var b strings.Builder
+	for _, s := range p.Steps {
+		fmt.Fprintf(&b, "%s(%s)", s.Kind, strings.Join(s.Names, ", "))
+		if s.AfterCancel {
+			b.WriteString(" [after cancel]")
+		}
+		b.WriteByte('\n')
+	}
+	for _, w := range p.Waiting {
+		fmt.Fprintf(&b, "wait %s: %s\n", w.Name, w.Reason)
+	}
+	for _, w := range p.Invalid {
+		fmt.Fprintf(&b, "invalid %s: %s\n", w.Name, w.Reason)
+	}
+	for _, id := range p.Unmanaged {
+		fmt.Fprintf(&b, "unmanaged %s\n", id.Hex())
+	}
+	return b.String()
+}
+
+// Client plans and applies configuration changes for one manager.
+type Client struct {
+	Address       common.Address
+	Configuration *SuperLedgerConfiguration.SuperLedgerConfiguration
+	Backend       Backend
+	// FromBlock bounds the proposal event scan.
+	FromBlock uint64
+}
+
+// NewClient binds the configuration at address.
+func NewClient(address common.Address, backend Backend) (*Client, error) {
+	c, err := SuperLedgerConfiguration.NewSuperLedgerConfiguration(address, backend)
+	if err != nil {
+		return nil, err
+	}
+	return &Client{Address: address, Configuration: c, Backend: backend}, nil
+}
+
+type proposal struct {
+	args     SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs
+	proposed time.Time
+}
+
+// Plan diffs the desired state against the chain and returns the minimal
+// calls to converge: one batched set for new configs, one batched propose for
+// changed ones, one batched accept for proposals past their delay, and a
+// cancel for each conflicting proposal still in its delay.
+func (c *Client) Plan(ctx context.Context, state *State) (*Plan, error) {
+	opts := &bind.CallOpts{Context: ctx}
+	owned, err := c.Configuration.GetAllYieldSourceOracleIdsByOwner(opts, state.Manager)
+	if err != nil {
+		return nil, fmt.Errorf("admin: getAllYieldSourceOracleIdsByOwner: %w", err)
+	}
+	ids := make([][32]byte, len(state.Oracles))
+	for i, e := range state.Oracles {
+		ids[i] = *e.ID
+	}
+	var current []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfig
+	if len(ids) > 0 {
+		if current, err = c.Configuration.GetYieldSourceOracleConfigs(opts, ids); err != nil {
+			return nil, fmt.Errorf("admin: getYieldSourceOracleConfigs: %w", err)
+		}
+	}
+	pending, err := c.pendingProposals(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	head, err := c.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("admin: head: %w", err)
+	}
+	now := time.Unix(int64(head.Time), 0)
+
+	plan := &Plan{}
+	var set, propose, afterCancel, accept Step
+	set.Kind, propose.Kind, afterCancel.Kind, accept.Kind = StepSet, StepPropose, StepPropose, StepAccept
+	afterCancel.AfterCancel = true
+
+	wanted := make(map[common.Hash]bool)
+	for i := range state.Oracles {
+		e := &state.Oracles[i]
+		id := *e.ID
+		wanted[id] = true
+		cur := current[i]
+		args := e.Args()
+
+		if cur.Manager == (common.Address{}) {
+			if e.Salt == nil {
+				plan.Invalid = append(plan.Invalid, Pending{Name: e.label(), ID: id, Reason: "config does not exist and no salt to create it"})
+				continue
+			}
+			set.Salts = append(set.Salts, *e.Salt)
+			set.Args = append(set.Args, args)
+			set.Names = append(set.Names, e.label())
+			continue
+		}
+		if cur.Manager != state.Manager {
+			plan.Invalid = append(plan.Invalid, Pending{Name: e.label(), ID: id, Reason: "managed by " + cur.Manager.Hex()})
+			continue
+		}
+
+		p, hasProposal := pending[id]
+		if sameConfig(cur, args) {
+			if hasProposal && now.Before(p.proposed.Add(proposalDelay)) {
+				plan.Waiting = append(plan.Waiting, Pending{Name: e.label(), ID: id, Reason: "in sync, but an unrelated proposal is pending"})
+			}
+			continue
+		}
+		if hasProposal && sameArgs(p.args, args) {
+			at := p.proposed.Add(proposalDelay)
+			if now.Before(at) {
+				plan.Waiting = append(plan.Waiting, Pending{Name: e.label(), ID: id, Reason: "proposal in delay", AcceptableAt: at})
+				continue
+			}
+			accept.IDs = append(accept.IDs, id)
+			accept.Names = append(accept.Names, e.label())
+			continue
+		}
+		if reason := checkFeeChange(cur.FeePercent.Uint64(), e.FeePercent); reason != "" {
+			plan.Invalid = append(plan.Invalid, Pending{Name: e.label(), ID: id, Reason: reason})
+			continue
+		}
+		target := &propose
+		if hasProposal && now.Before(p.proposed.Add(proposalDelay)) {
+			// proposeYieldSourceOracleConfig reverts with
+			// CHANGE_ALREADY_PROPOSED until the other proposal is cancelled.
+			plan.Steps = append(plan.Steps, Step{Kind: StepCancel, IDs: []common.Hash{id}, Names: []string{e.label()}})
+			target = &afterCancel
+		}
+		target.IDs = append(target.IDs, id)
+		target.Args = append(target.Args, args)
+		target.Names = append(target.Names, e.label())
+	}
+
+	for _, s := range []Step{set, propose, afterCancel, accept} {
+		if len(s.Names) > 0 {
+			plan.Steps = append(plan.Steps, s)
+		}
+	}
+	for _, id := range owned {
+		if !wanted[id] {
+			plan.Unmanaged = append(plan.Unmanaged, id)
+		}
+	}
+	sort.Slice(plan.Unmanaged, func(i, j int) bool { return plan.Unmanaged[i].Cmp(plan.Unmanaged[j]) < 0 })
+	return plan, nil
+}
+
+// pendingProposals returns the latest proposal of each ID not followed by a
+// cancellation or acceptance.
+func (c *Client) pendingProposals(ctx context.Context, ids [][32]byte) (map[common.Hash]proposal, error) {
+	out := make(map[common.Hash]proposal)
+	if len(ids) == 0 {
+		return out, nil
+	}
+	opts := &bind.FilterOpts{Start: c.FromBlock, Context: ctx}
+	type mark struct {
+		block uint64
+		index uint
+	}
+	after := func(a, b mark) bool { return a.block > b.block || (a.block == b.block && a.index > b.index) }
+	last := make(map[common.Hash]mark)
+	props := make(map[common.Hash]*SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigProposalSet)
+
+	set, err := c.Configuration.FilterYieldSourceOracleConfigProposalSet(opts, ids, nil)
+	if err != nil {
+		return nil, fmt.Errorf("admin: filter proposals: %w", err)
+	}
+	for set.Next() {
+		ev := set.Event
+		m := mark{ev.Raw.BlockNumber, ev.Raw.Index}
+		if prev, ok := last[ev.YieldSourceOracleId]; !ok || after(m, prev) {
+			last[ev.YieldSourceOracleId] = m
+			props[ev.YieldSourceOracleId] = ev
+		}
+	}
+	if err := set.Error(); err != nil {
+		return nil, fmt.Errorf("admin: proposals: %w", err)
+	}
+	set.Close()
+
+	settle := func(id common.Hash, m mark) {
+		if prev, ok := last[id]; ok && after(m, prev) {
+			delete(props, id)
+		}
+	}
+	cancelled, err := c.Configuration.FilterYieldSourceOracleConfigProposalCancelled(opts, ids)
+	if err != nil {
+		return nil, fmt.Errorf("admin: filter cancellations: %w", err)
+	}
+	for cancelled.Next() {
+		settle(cancelled.Event.YieldSourceOracleId, mark{cancelled.Event.Raw.BlockNumber, cancelled.Event.Raw.Index})
+	}
+	if err := cancelled.Error(); err != nil {
+		return nil, fmt.Errorf("admin: cancellations: %w", err)
+	}
+	cancelled.Close()
+	accepted, err := c.Configuration.FilterYieldSourceOracleConfigAccepted(opts, ids, nil)
+	if err != nil {
+		return nil, fmt.Errorf("admin: filter acceptances: %w", err)
+	}
+	for accepted.Next() {
+		settle(accepted.Event.YieldSourceOracleId, mark{accepted.Event.Raw.BlockNumber, accepted.Event.Raw.Index})
+	}
+	if err := accepted.Error(); err != nil {
+		return nil, fmt.Errorf("admin: acceptances: %w", err)
+	}
+	accepted.Close()
+
+	for id, ev := range props {
+		head, err := c.Backend.HeaderByNumber(ctx, new(big.Int).SetUint64(ev.Raw.BlockNumber))
+		if err != nil {
+			return nil, fmt.Errorf("admin: header %d: %w", ev.Raw.BlockNumber, err)
+		}
+		out[id] = proposal{
+			args: SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{
+				YieldSourceOracle: ev.YieldSourceOracle,
+				FeePercent:        ev.FeePercent,
+				FeeRecipient:      ev.FeeRecipient,
+				Ledger:            ev.Ledger,
+			},
+			proposed: time.Unix(int64(head.Time), 0),
+		}
+	}
+	return out, nil
+}
+
+// checkFeeChange mirrors the fee bounds of proposeYieldSourceOracleConfig.
+func checkFeeChange(current, proposed uint64) string {
+	switch {
+	case current > 0 && proposed > 0:
+		minFee := (current*(10_000-maxFeePercentChange) + 9_999) / 10_000
+		maxFee := current * (10_000 + maxFeePercentChange) / 10_000
+		if proposed < minFee || proposed > maxFee {
+			return fmt.Sprintf("feePercent %d outside [%d, %d] allowed from %d", proposed, minFee, maxFee, current)
+		}
+	case current == 0 && proposed > maxInitialFeePercent:
+		return fmt.Sprintf("feePercent %d above initial maximum %d", proposed, maxInitialFeePercent)
+	}
+	return ""
+}
+
+func sameConfig(cur SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfig, args SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs) bool {
+	return sameArgs(SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{
+		YieldSourceOracle: cur.YieldSourceOracle,
+		FeePercent:        cur.FeePercent,
+		FeeRecipient:      cur.FeeRecipient,
+		Ledger:            cur.Ledger,
+	}, args)
+}
+
+func sameArgs(a, b SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs) bool {
+	return a.YieldSourceOracle == b.YieldSourceOracle && a.FeePercent.Cmp(b.FeePercent) == 0 &&
+		a.FeeRecipient == b.FeeRecipient && a.Ledger == b.Ledger
+}
@@ -0,0 +1,127 @@
+// Package admin plans and applies SuperLedgerConfiguration changes from a
+// declarative description of the desired yield source oracle configs.
+package admin
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+
+	"github.com/ethereum/go-ethereum/common"
+	"gopkg.in/yaml.v3"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/pkg/oracleid"
+)
+
+// State is the desired configuration of every yield source oracle owned by
+// Manager. JSON is accepted as well as YAML:
+//
+//	manager: 0x...
+//	oracles:
+//	  - name: morpho-usdc
+//	    salt: 0x...              # or id: 0x... for configs set elsewhere
+//	    yieldSourceOracle: 0x...
+//	    feePercent: 1000         # basis points
+//	    feeRecipient: 0x...
+//	    ledger: 0x...
+type State struct {
+	Manager common.Address `yaml:"manager" json:"manager"`
+	Oracles []Entry        `yaml:"oracles" json:"oracles"`
+}
+
+// Entry is the desired configuration of one yield source oracle ID.
+type Entry struct {
+	Name string `yaml:"name,omitempty" json:"name,omitempty"`
+	// Salt is required to create the config; the ID is then derived from
+	// (Salt, Manager). ID alone can only update an existing config.
+	Salt              *common.Hash   `yaml:"salt,omitempty" json:"salt,omitempty"`
+	ID                *common.Hash   `yaml:"id,omitempty" json:"id,omitempty"`
+	YieldSourceOracle common.Address `yaml:"yieldSourceOracle" json:"yieldSourceOracle"`
+	FeePercent        uint64         `yaml:"feePercent" json:"feePercent"`
+	FeeRecipient      common.Address `yaml:"feeRecipient" json:"feeRecipient"`
+	Ledger            common.Address `yaml:"ledger" json:"ledger"`
+}
+
+// Args returns the entry as the contract's YieldSourceOracleConfigArgs.
+func (e *Entry) Args() SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs {
+	return SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{
+		YieldSourceOracle: e.YieldSourceOracle,
+		FeePercent:        new(big.Int).SetUint64(e.FeePercent),
+		FeeRecipient:      e.FeeRecipient,
+		Ledger:            e.Ledger,
+	}
+}
+
+// label names the entry in plans and errors.
+func (e *Entry) label() string {
+	if e.Name != "" {
+		return e.Name
+	}
+	if e.ID != nil {
+		return e.ID.Hex()
+	}
+	return e.Salt.Hex()
+}
+
+// LoadState reads a YAML or JSON state file.
+func LoadState(path string) (*State, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("admin: %w", err)
+	}
+	return ParseState(raw)
+}
+
+// ParseState parses YAML or JSON state, derives missing IDs and validates
+// every entry against the contract's constraints.
+func ParseState(raw []byte) (*State, error) {
+	var s State
+	if err := yaml.Unmarshal(raw, &s); err != nil {
+		return nil, fmt.Errorf("admin: parse state: %w", err)
+	}
+	if s.Manager == (common.Address{}) {
+		return nil, errors.New("admin: state has no manager")
+	}
+	seen := make(map[common.Hash]string)
+	for i := range s.Oracles {
+		e := &s.Oracles[i]
+		if e.Salt == nil && e.ID == nil {
+			return nil, fmt.Errorf("admin: oracle %d: salt or id required", i)
+		}
+		if e.Salt != nil {
+			if *e.Salt == (common.Hash{}) {
+				return nil, fmt.Errorf("admin: %s: zero salt", e.label())
+			}
+			id := oracleid.DeriveID(*e.Salt, s.Manager)
+			if e.ID != nil && *e.ID != id {
+				return nil, fmt.Errorf("admin: %s: id %s does not match salt (derived %s)", e.label(), e.ID.Hex(), id.Hex())
+			}
+			e.ID = &id
+		}
+		if prev, ok := seen[*e.ID]; ok {
+			return nil, fmt.Errorf("admin: %s and %s share id %s", prev, e.label(), e.ID.Hex())
+		}
+		seen[*e.ID] = e.label()
+		if err := validateArgs(e); err != nil {
+			return nil, err
+		}
+	}
+	return &s, nil
+}
+
+// validateArgs mirrors SuperLedgerConfiguration._validateYieldSourceOracleConfig.
+func validateArgs(e *Entry) error {
+	switch {
+	case e.YieldSourceOracle == (common.Address{}):
+		return fmt.Errorf("admin: %s: zero yieldSourceOracle", e.label())
+	case e.FeeRecipient == (common.Address{}):
+		return fmt.Errorf("admin: %s: zero feeRecipient", e.label())
+	case e.Ledger == (common.Address{}):
+		return fmt.Errorf("admin: %s: zero ledger", e.label())
+	case e.FeePercent > maxFeePercent:
+		return fmt.Errorf("admin: %s: feePercent %d above %d", e.label(), e.FeePercent, maxFeePercent)
+	}
+	return nil
+}
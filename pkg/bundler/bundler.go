@@ -0,0 +1,245 @@
+// Package bundler submits user operations through
+// SuperNativePaymaster.handleOps and keeps a ledger of what each batch cost
+// the bundler, what the paymaster refunded and the node operator premium
+// earned.
+package bundler
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/pkg/userop"
+)
+
+// Paymaster is the part of the SuperNativePaymaster binding the bundler
+// uses; *SuperNativePaymaster.SuperNativePaymaster implements it.
+type Paymaster interface {
+	GetDeposit(opts *bind.CallOpts) (*big.Int, error)
+	HandleOps(opts *bind.TransactOpts, ops []userop.PackedUserOperation) (*types.Transaction, error)
+}
+
+// ErrInsufficientDeposit is returned when a batch needs more value than
+// Bundler.MaxValue allows on top of the paymaster's deposit.
+var ErrInsufficientDeposit = errors.New("bundler: paymaster deposit too low")
+
+// Bundler batches user operations and submits them through handleOps.
+type Bundler struct {
+	Backend   bind.DeployBackend
+	Paymaster Paymaster
+	// Address is the paymaster contract, used to match receipt logs.
+	Address common.Address
+	// EntryPoint defaults to userop.EntryPointV07.
+	EntryPoint common.Address
+
+	// MaxOps and MaxBatchGas bound a batch by operation count and by the sum
+	// of the operations' TotalGasLimit; zero means unbounded.
+	MaxOps      int
+	MaxBatchGas uint64
+	// MaxValue caps the native value sent with one batch; nil for no cap.
+	MaxValue *big.Int
+
+	// Ledger records every sent batch, failed ones included; nil to skip
+	// recording.
+	Ledger *Ledger
+}
+
+// Batches splits ops in order by MaxOps and MaxBatchGas. An operation over
+// MaxBatchGas on its own gets a batch of its own.
+func (b *Bundler) Batches(ops []*userop.UserOp) [][]*userop.UserOp {
+	var batches [][]*userop.UserOp
+	var cur []*userop.UserOp
+	var gas uint64
+	for _, op := range ops {
+		opGas := op.TotalGasLimit().Uint64()
+		full := b.MaxOps > 0 && len(cur) >= b.MaxOps
+		over := b.MaxBatchGas > 0 && gas+opGas > b.MaxBatchGas
+		if len(cur) > 0 && (full || over) {
+			batches = append(batches, cur)
+			cur, gas = nil, 0
+		}
+		cur = append(cur, op)
+		gas += opGas
+	}
+	if len(cur) > 0 {
+		batches = append(batches, cur)
+	}
+	return batches
+}
+
+// Submit sends ops in batches with auth, waiting for each to be mined
+// before the next. Before each batch the paymaster deposit is read and the
+// shortfall against the batch's maximum cost is sent as value. The first
+// failure stops submission; the reports of sent batches, the failed one
+// included, are returned with it.
+func (b *Bundler) Submit(ctx context.Context, auth *bind.TransactOpts, ops []*userop.UserOp) ([]*BatchReport, error) {
+	var reports []*BatchReport
+	for i, batch := range b.Batches(ops) {
+		report, err := b.submit(ctx, auth, batch)
+		if report != nil {
+			reports = append(reports, report)
+		}
+		if err != nil {
+			return reports, fmt.Errorf("bundler: batch %d: %w", i, err)
+		}
+	}
+	return reports, nil
+}
+
+func (b *Bundler) submit(ctx context.Context, auth *bind.TransactOpts, batch []*userop.UserOp) (*BatchReport, error) {
+	packed := make([]userop.PackedUserOperation, len(batch))
+	required := new(big.Int)
+	for i, op := range batch {
+		p, err := op.Pack()
+		if err != nil {
+			return nil, fmt.Errorf("op %d: %w", i, err)
+		}
+		packed[i] = p
+		required.Add(required, op.MaxCost())
+	}
+
+	deposit, err := b.Paymaster.GetDeposit(&bind.CallOpts{Context: ctx})
+	if err != nil {
+		return nil, fmt.Errorf("getDeposit: %w", err)
+	}
+	value := new(big.Int).Sub(required, deposit)
+	if value.Sign() < 0 {
+		value.SetInt64(0)
+	}
+	if b.MaxValue != nil && value.Cmp(b.MaxValue) > 0 {
+		return nil, fmt.Errorf("%w: deposit %s, batch needs %s, cap %s", ErrInsufficientDeposit, deposit, required, b.MaxValue)
+	}
+
+	opts := *auth
+	opts.Context = ctx
+	opts.Value = value
+	tx, err := b.Paymaster.HandleOps(&opts, packed)
+	if err != nil {
+		return nil, fmt.Errorf("handleOps: %w", err)
+	}
+	receipt, err := bind.WaitMined(ctx, b.Backend, tx)
+	if err != nil {
+		return b.fail(&BatchReport{Tx: tx.Hash(), Status: types.ReceiptStatusFailed, DepositBefore: deposit}, err)
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		// A reverted handleOps sends no value but still costs its fee.
+		report := newBatchReport(receipt)
+		report.DepositBefore = deposit
+		return b.fail(report, fmt.Errorf("transaction %s reverted", tx.Hash()))
+	}
+	entryPoint := b.EntryPoint
+	if entryPoint == (common.Address{}) {
+		entryPoint = userop.EntryPointV07
+	}
+	report, err := ParseReceipt(receipt, b.Address, entryPoint)
+	if err != nil {
+		report = newBatchReport(receipt)
+		report.DepositBefore, report.Value = deposit, value
+		return b.fail(report, err)
+	}
+	report.DepositBefore = deposit
+	report.Value = value
+	if b.Ledger != nil {
+		b.Ledger.Add(report)
+	}
+	return report, nil
+}
+
+// fail records the report of a sent batch that failed with err.
+func (b *Bundler) fail(report *BatchReport, err error) (*BatchReport, error) {
+	report.Error = err.Error()
+	if b.Ledger != nil {
+		b.Ledger.Add(report)
+	}
+	return report, err
+}
+
+// Ledger accumulates batch reports. It is safe for concurrent use.
+type Ledger struct {
+	mu      sync.Mutex
+	batches []*BatchReport
+}
+
+// Totals sums a ledger.
+type Totals struct {
+	// Batches counts every recorded batch, Failed those that failed.
+	Batches int      `json:"batches"`
+	Failed  int      `json:"failed"`
+	Ops     int      `json:"ops"`
+	Value   *big.Int `json:"value"`
+	TxFees  *big.Int `json:"txFees"`
+	GasCost *big.Int `json:"gasCost"`
+	Refunds *big.Int `json:"refunds"`
+	Premium *big.Int `json:"premium"`
+	// Net is the sum of BatchReport.Net.
+	Net *big.Int `json:"net"`
+}
+
+// Add records report.
+func (l *Ledger) Add(report *BatchReport) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.batches = append(l.batches, report)
+}
+
+// Batches returns the recorded reports in order.
+func (l *Ledger) Batches() []*BatchReport {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return append([]*BatchReport(nil), l.batches...)
+}
+
+// Op returns the record of the operation with hash, if any.
+func (l *Ledger) Op(hash common.Hash) (OpRecord, bool) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	for _, b := range l.batches {
+		for _, op := range b.Ops {
+			if op.Hash == hash {
+				return op, true
+			}
+		}
+	}
+	return OpRecord{}, false
+}
+
+// Totals sums every recorded batch.
+func (l *Ledger) Totals() Totals {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	t := Totals{Value: new(big.Int), TxFees: new(big.Int), GasCost: new(big.Int), Refunds: new(big.Int), Premium: new(big.Int), Net: new(big.Int)}
+	for _, b := range l.batches {
+		t.Batches++
+		if b.Failed() {
+			t.Failed++
+		}
+		t.Ops += len(b.Ops)
+		t.Value.Add(t.Value, orZero(b.Value))
+		t.TxFees.Add(t.TxFees, orZero(b.TxFee))
+		t.Net.Add(t.Net, b.Net())
+		for _, op := range b.Ops {
+			t.GasCost.Add(t.GasCost, orZero(op.ActualGasCost))
+			t.Refunds.Add(t.Refunds, orZero(op.Refund))
+			t.Premium.Add(t.Premium, orZero(op.Premium))
+		}
+	}
+	return t
+}
+
+// WriteJSON writes the recorded batches and their totals as indented JSON.
+func (l *Ledger) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(struct {
+		Batches []*BatchReport `json:"batches"`
+		Totals  Totals         `json:"totals"`
+	}{l.Batches(), l.Totals()})
+}
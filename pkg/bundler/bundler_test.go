@@ -0,0 +1,258 @@
+package bundler
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperNativePaymaster"
+	"github.com/superform-xyz/v2-core/pkg/userop"
+)
+
+var _ Paymaster = (*SuperNativePaymaster.SuperNativePaymaster)(nil)
+
+var (
+	paymaster = common.Address{0x77}
+	bundler   = common.Address{0xbb}
+	alice     = common.Address{0xa1}
+	bob       = common.Address{0xb0}
+)
+
+func mustLog(t *testing.T, addr common.Address, ev abi.Event, topics []common.Hash, args ...any) *types.Log {
+	t.Helper()
+	data, err := ev.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return &types.Log{Address: addr, Topics: append([]common.Hash{ev.ID}, topics...), Data: data}
+}
+
+func postOpContext(t *testing.T, sender common.Address, maxFee, maxGas, premium, postOpGas int64) []byte {
+	t.Helper()
+	ctx, err := postOpContextArgs.Pack(sender, big.NewInt(maxFee), big.NewInt(maxFee), big.NewInt(maxGas), big.NewInt(premium), big.NewInt(postOpGas))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return ctx
+}
+
+func userOpLog(t *testing.T, hash common.Hash, sender, pm common.Address, cost, used int64) *types.Log {
+	return mustLog(t, userop.EntryPointV07, userOperationEvent,
+		[]common.Hash{hash, common.BytesToHash(sender[:]), common.BytesToHash(pm[:])},
+		big.NewInt(0), true, big.NewInt(cost), big.NewInt(used))
+}
+
+// batchReceipt has alice's op refunded, bob's op charged the full max cost,
+// and a third op sponsored by another paymaster.
+func batchReceipt(t *testing.T) *types.Receipt {
+	logs := []*types.Log{
+		mustLog(t, paymaster, refundEvent, []common.Hash{common.BytesToHash(alice[:])}, big.NewInt(600_000), big.NewInt(700_000)),
+		mustLog(t, paymaster, postOpEvent, nil, postOpContext(t, alice, 10, 100_000, 1_000, 5_000)),
+		userOpLog(t, common.Hash{1}, alice, paymaster, 300_000, 30_000),
+		mustLog(t, paymaster, postOpEvent, nil, postOpContext(t, bob, 10, 50_000, 0, 0)),
+		userOpLog(t, common.Hash{2}, bob, paymaster, 500_000, 50_000),
+		userOpLog(t, common.Hash{3}, alice, common.Address{0x99}, 100_000, 10_000),
+		mustLog(t, paymaster, handledEvent, []common.Hash{common.BytesToHash(bundler[:])}, big.NewInt(3), big.NewInt(2_000_000), big.NewInt(1_400_000)),
+	}
+	for i, l := range logs {
+		l.Index = uint(i)
+	}
+	return &types.Receipt{
+		Status:            types.ReceiptStatusSuccessful,
+		TxHash:            common.Hash{0xee},
+		BlockNumber:       big.NewInt(12),
+		GasUsed:           1_000,
+		EffectiveGasPrice: big.NewInt(10),
+		Logs:              logs,
+	}
+}
+
+func TestParseReceipt(t *testing.T) {
+	r, err := ParseReceipt(batchReceipt(t), paymaster, userop.EntryPointV07)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if r.NumOps != 3 || r.WithdrawnAmount.Int64() != 1_400_000 || r.TxFee.Int64() != 10_000 || len(r.Ops) != 3 {
+		t.Fatalf("report = %+v", r)
+	}
+
+	a := r.Ops[0]
+	// maxCost 1_000_000 with 600_000 of a 700_000 refund paid out: charged
+	// 400_000 including a 10% premium.
+	if a.Hash != (common.Hash{1}) || a.MaxCost.Int64() != 1_000_000 || a.Refund.Int64() != 600_000 || a.InitialRefund.Int64() != 700_000 {
+		t.Fatalf("alice = %+v", a)
+	}
+	if a.Charged.Int64() != 400_000 || a.Premium.Int64() != 36_363 || a.PostOpGas.Int64() != 5_000 {
+		t.Fatalf("alice accounting = %+v", a)
+	}
+	b := r.Ops[1]
+	if b.Refund.Sign() != 0 || b.Charged.Int64() != 500_000 || b.Premium.Sign() != 0 {
+		t.Fatalf("bob = %+v", b)
+	}
+	if other := r.Ops[2]; other.MaxCost != nil || other.ActualGasCost.Int64() != 100_000 {
+		t.Fatalf("foreign op = %+v", other)
+	}
+	// 900_000 beneficiary payment + 1_400_000 withdrawn - 10_000 fee.
+	if r.Net().Int64() != 2_290_000 {
+		t.Fatalf("net = %s", r.Net())
+	}
+
+	empty := &types.Receipt{Status: 1}
+	if _, err := ParseReceipt(empty, paymaster, userop.EntryPointV07); !errors.Is(err, ErrNotHandled) {
+		t.Fatalf("empty receipt: %v", err)
+	}
+}
+
+func op(gas int64) *userop.UserOp {
+	return &userop.UserOp{
+		VerificationGasLimit: big.NewInt(gas),
+		CallGasLimit:         big.NewInt(0),
+		PreVerificationGas:   big.NewInt(0),
+		MaxFeePerGas:         big.NewInt(2),
+	}
+}
+
+func TestBatches(t *testing.T) {
+	b := &Bundler{MaxOps: 2, MaxBatchGas: 100}
+	got := b.Batches([]*userop.UserOp{op(10), op(10), op(10), op(95), op(200), op(1)})
+	var sizes []int
+	for _, batch := range got {
+		sizes = append(sizes, len(batch))
+	}
+	want := []int{2, 1, 1, 1, 1}
+	if len(sizes) != len(want) {
+		t.Fatalf("batch sizes = %v, want %v", sizes, want)
+	}
+	for i := range want {
+		if sizes[i] != want[i] {
+			t.Fatalf("batch sizes = %v, want %v", sizes, want)
+		}
+	}
+}
+
+type fakePaymaster struct {
+	deposit *big.Int
+	sent    *big.Int
+	// tx is returned by HandleOps; nil fails the send.
+	tx *types.Transaction
+}
+
+func (f *fakePaymaster) GetDeposit(*bind.CallOpts) (*big.Int, error) { return f.deposit, nil }
+
+func (f *fakePaymaster) HandleOps(opts *bind.TransactOpts, _ []userop.PackedUserOperation) (*types.Transaction, error) {
+	f.sent = opts.Value
+	if f.tx == nil {
+		return nil, errors.New("not sent")
+	}
+	return f.tx, nil
+}
+
+// fakeBackend serves one receipt, or none so WaitMined waits for ctx.
+type fakeBackend struct {
+	bind.DeployBackend
+	receipt *types.Receipt
+}
+
+func (f *fakeBackend) TransactionReceipt(context.Context, common.Hash) (*types.Receipt, error) {
+	if f.receipt == nil {
+		return nil, ethereum.NotFound
+	}
+	return f.receipt, nil
+}
+
+func TestSubmitDepositCheck(t *testing.T) {
+	pm := &fakePaymaster{deposit: big.NewInt(150)}
+	b := &Bundler{Paymaster: pm, MaxValue: big.NewInt(100)}
+	// Max cost 2 * (100 + 100) = 400, deposit 150: needs 250 over a cap of 100.
+	_, err := b.Submit(context.Background(), &bind.TransactOpts{}, []*userop.UserOp{op(100), op(100)})
+	if !errors.Is(err, ErrInsufficientDeposit) || pm.sent != nil {
+		t.Fatalf("err = %v, sent = %v", err, pm.sent)
+	}
+
+	b.MaxValue = nil
+	if _, err := b.Submit(context.Background(), &bind.TransactOpts{}, []*userop.UserOp{op(100), op(100)}); err == nil {
+		t.Fatal("expected send error")
+	}
+	if pm.sent.Int64() != 250 {
+		t.Fatalf("value = %s, want 250", pm.sent)
+	}
+}
+
+func TestSubmitRecordsFailures(t *testing.T) {
+	tx := types.NewTx(&types.LegacyTx{Nonce: 1})
+	failed := &types.Receipt{Status: types.ReceiptStatusFailed, TxHash: tx.Hash(), BlockNumber: big.NewInt(5), GasUsed: 300, EffectiveGasPrice: big.NewInt(2)}
+	unparsable := &types.Receipt{Status: types.ReceiptStatusSuccessful, TxHash: tx.Hash(), BlockNumber: big.NewInt(6), GasUsed: 100, EffectiveGasPrice: big.NewInt(2)}
+
+	for _, tc := range []struct {
+		name    string
+		receipt *types.Receipt
+		status  uint64
+		fee     int64
+		value   bool
+	}{
+		{"reverted", failed, types.ReceiptStatusFailed, 600, false},
+		{"unparsable", unparsable, types.ReceiptStatusSuccessful, 200, true},
+		{"not mined", nil, types.ReceiptStatusFailed, 0, false},
+	} {
+		l := &Ledger{}
+		b := &Bundler{Backend: &fakeBackend{receipt: tc.receipt}, Paymaster: &fakePaymaster{deposit: big.NewInt(150), tx: tx}, Ledger: l}
+		ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+		reports, err := b.Submit(ctx, &bind.TransactOpts{}, []*userop.UserOp{op(100)})
+		cancel()
+		if err == nil || len(reports) != 1 {
+			t.Fatalf("%s: reports = %v, err = %v", tc.name, reports, err)
+		}
+		batches := l.Batches()
+		if len(batches) != 1 || batches[0] != reports[0] {
+			t.Fatalf("%s: ledger = %+v", tc.name, batches)
+		}
+		r := batches[0]
+		if !r.Failed() || r.Tx != tx.Hash() || r.Status != tc.status || orZero(r.TxFee).Int64() != tc.fee ||
+			(r.Value != nil) != tc.value || r.DepositBefore.Int64() != 150 {
+			t.Fatalf("%s: report = %+v", tc.name, r)
+		}
+		if tot := l.Totals(); tot.Failed != 1 || tot.TxFees.Int64() != tc.fee {
+			t.Fatalf("%s: totals = %+v", tc.name, tot)
+		}
+	}
+}
+
+func TestLedger(t *testing.T) {
+	r, err := ParseReceipt(batchReceipt(t), paymaster, userop.EntryPointV07)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r.Value = big.NewInt(2_000_000)
+	var l Ledger
+	l.Add(r)
+	l.Add(r)
+
+	tot := l.Totals()
+	if tot.Batches != 2 || tot.Ops != 6 || tot.Refunds.Int64() != 1_200_000 || tot.Premium.Int64() != 72_726 || tot.Net.Int64() != 580_000 {
+		t.Fatalf("totals = %+v", tot)
+	}
+	if rec, ok := l.Op(common.Hash{2}); !ok || rec.Sender != bob {
+		t.Fatalf("op lookup = %+v, %v", rec, ok)
+	}
+
+	var buf bytes.Buffer
+	if err := l.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var out struct {
+		Batches []BatchReport `json:"batches"`
+	}
+	if err := json.Unmarshal(buf.Bytes(), &out); err != nil || len(out.Batches) != 2 {
+		t.Fatalf("json: %v, %s", err, buf.String())
+	}
+}
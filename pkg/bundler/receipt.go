@@ -0,0 +1,252 @@
+package bundler
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperNativePaymaster"
+	"github.com/superform-xyz/v2-core/pkg/userop"
+)
+
+// OpRecord is the accounting of one user operation handled in a batch.
+type OpRecord struct {
+	Hash    common.Hash    `json:"userOpHash"`
+	Sender  common.Address `json:"sender"`
+	Nonce   *big.Int       `json:"nonce"`
+	Success bool           `json:"success"`
+
+	// ActualGasCost and ActualGasUsed come from the EntryPoint's
+	// UserOperationEvent; the cost is what the paymaster deposit paid.
+	ActualGasCost *big.Int `json:"actualGasCost"`
+	ActualGasUsed *big.Int `json:"actualGasUsed"`
+
+	// The fields below are decoded from SuperNativePaymasterPostOp and are
+	// nil for operations sponsored by another paymaster.
+	MaxFeePerGas *big.Int `json:"maxFeePerGas,omitempty"`
+	MaxGasLimit  *big.Int `json:"maxGasLimit,omitempty"`
+	PremiumBps   *big.Int `json:"premiumBps,omitempty"`
+	PostOpGas    *big.Int `json:"postOpGas,omitempty"`
+	// MaxCost is maxGasLimit * maxFeePerGas, the most the sender is charged.
+	MaxCost *big.Int `json:"maxCost,omitempty"`
+	// InitialRefund is calculateRefund's result and Refund what was paid
+	// out; they differ when the paymaster deposit ran short.
+	InitialRefund *big.Int `json:"initialRefund,omitempty"`
+	Refund        *big.Int `json:"refund,omitempty"`
+	// Charged is MaxCost minus Refund; Premium is the node operator premium
+	// included in it.
+	Charged *big.Int `json:"charged,omitempty"`
+	Premium *big.Int `json:"premium,omitempty"`
+}
+
+// BatchReport is the accounting of one handleOps transaction.
+type BatchReport struct {
+	Tx     common.Hash `json:"tx"`
+	Block  uint64      `json:"block"`
+	Status uint64      `json:"status"`
+
+	// DepositBefore is the paymaster's EntryPoint deposit read before
+	// submitting, and Value the native amount sent with handleOps; nil when
+	// the transaction reverted or its outcome is unknown.
+	DepositBefore *big.Int `json:"depositBefore,omitempty"`
+	Value         *big.Int `json:"value,omitempty"`
+
+	// NumOps, InitialAmount and WithdrawnAmount come from
+	// UserOperationsHandled.
+	NumOps          uint64   `json:"numOps"`
+	InitialAmount   *big.Int `json:"initialAmount"`
+	WithdrawnAmount *big.Int `json:"withdrawnAmount"`
+
+	// TxFee is gasUsed * effectiveGasPrice of the handleOps transaction.
+	TxFee *big.Int `json:"txFee"`
+
+	Ops []OpRecord `json:"ops"`
+
+	// Error is set when the batch failed after it was sent: it reverted,
+	// was not seen mined, or its receipt could not be parsed. TxFee is then
+	// known only if it was mined, and the operations are not recorded.
+	Error string `json:"error,omitempty"`
+}
+
+// Failed reports whether the batch failed after it was sent.
+func (b *BatchReport) Failed() bool {
+	return b.Error != ""
+}
+
+// Received is what the bundler got back from the batch: the EntryPoint's
+// beneficiary payment, the sum of ActualGasCost, plus the withdrawn deposit.
+func (b *BatchReport) Received() *big.Int {
+	total := new(big.Int).Set(orZero(b.WithdrawnAmount))
+	for _, op := range b.Ops {
+		total.Add(total, orZero(op.ActualGasCost))
+	}
+	return total
+}
+
+// Net is Received minus the value sent and the transaction fee.
+func (b *BatchReport) Net() *big.Int {
+	net := b.Received()
+	net.Sub(net, orZero(b.Value))
+	return net.Sub(net, orZero(b.TxFee))
+}
+
+// ErrNotHandled is returned for a receipt without UserOperationsHandled.
+var ErrNotHandled = errors.New("bundler: receipt has no UserOperationsHandled event")
+
+// userOperationEventABI is the EntryPoint v0.7 UserOperationEvent; the
+// EntryPoint has no binding in this repository.
+var userOperationEventABI, _ = abi.JSON(strings.NewReader(`[{"type":"event","name":"UserOperationEvent","anonymous":false,"inputs":[
+	{"name":"userOpHash","type":"bytes32","indexed":true},
+	{"name":"sender","type":"address","indexed":true},
+	{"name":"paymaster","type":"address","indexed":true},
+	{"name":"nonce","type":"uint256","indexed":false},
+	{"name":"success","type":"bool","indexed":false},
+	{"name":"actualGasCost","type":"uint256","indexed":false},
+	{"name":"actualGasUsed","type":"uint256","indexed":false}]}]`))
+
+var (
+	userOperationEvent = userOperationEventABI.Events["UserOperationEvent"]
+	paymasterABI, _    = SuperNativePaymaster.SuperNativePaymasterMetaData.GetAbi()
+	postOpEvent        = paymasterABI.Events["SuperNativePaymasterPostOp"]
+	refundEvent        = paymasterABI.Events["SuperNativePaymasterRefund"]
+	handledEvent       = paymasterABI.Events["UserOperationsHandled"]
+
+	addressType, _ = abi.NewType("address", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	// postOpContextArgs is the context returned by
+	// SuperNativePaymaster._validatePaymasterUserOp.
+	postOpContextArgs = abi.Arguments{
+		{Name: "sender", Type: addressType}, {Name: "maxFeePerGas", Type: uint256Type},
+		{Name: "maxPriorityFeePerGas", Type: uint256Type}, {Name: "maxGasLimit", Type: uint256Type},
+		{Name: "nodeOperatorPremium", Type: uint256Type}, {Name: "postOpGas", Type: uint256Type},
+	}
+)
+
+// newBatchReport returns the report fields every receipt carries.
+func newBatchReport(receipt *types.Receipt) *BatchReport {
+	report := &BatchReport{
+		Tx:     receipt.TxHash,
+		Status: receipt.Status,
+		TxFee:  new(big.Int).Mul(new(big.Int).SetUint64(receipt.GasUsed), orZero(receipt.EffectiveGasPrice)),
+	}
+	if receipt.BlockNumber != nil {
+		report.Block = receipt.BlockNumber.Uint64()
+	}
+	return report
+}
+
+// ParseReceipt builds the batch report of a handleOps receipt. Within an
+// operation the paymaster emits SuperNativePaymasterRefund (if any) and
+// SuperNativePaymasterPostOp from postOp, before the EntryPoint emits its
+// UserOperationEvent; the paymaster events are attached to the next
+// UserOperationEvent of the same sender.
+func ParseReceipt(receipt *types.Receipt, paymaster, entryPoint common.Address) (*BatchReport, error) {
+	filterer, err := SuperNativePaymaster.NewSuperNativePaymasterFilterer(paymaster, nil)
+	if err != nil {
+		return nil, err
+	}
+	report := newBatchReport(receipt)
+
+	var pending *OpRecord
+	handled := false
+	for _, l := range receipt.Logs {
+		if len(l.Topics) == 0 {
+			continue
+		}
+		switch {
+		case l.Address == paymaster && l.Topics[0] == refundEvent.ID:
+			ev, err := filterer.ParseSuperNativePaymasterRefund(*l)
+			if err != nil {
+				return nil, fmt.Errorf("bundler: log %d: %w", l.Index, err)
+			}
+			pending = &OpRecord{Sender: ev.Sender, Refund: ev.RefundAmount, InitialRefund: ev.InitialRefund}
+
+		case l.Address == paymaster && l.Topics[0] == postOpEvent.ID:
+			ev, err := filterer.ParseSuperNativePaymasterPostOp(*l)
+			if err != nil {
+				return nil, fmt.Errorf("bundler: log %d: %w", l.Index, err)
+			}
+			vals, err := postOpContextArgs.Unpack(ev.Context)
+			if err != nil {
+				return nil, fmt.Errorf("bundler: log %d: decode postOp context: %w", l.Index, err)
+			}
+			sender := vals[0].(common.Address)
+			if pending == nil || pending.Sender != sender {
+				pending = &OpRecord{Sender: sender}
+			}
+			pending.MaxFeePerGas = vals[1].(*big.Int)
+			pending.MaxGasLimit = vals[3].(*big.Int)
+			pending.PremiumBps = vals[4].(*big.Int)
+			pending.PostOpGas = vals[5].(*big.Int)
+
+		case l.Address == entryPoint && l.Topics[0] == userOperationEvent.ID:
+			op, err := parseUserOperationEvent(l)
+			if err != nil {
+				return nil, fmt.Errorf("bundler: log %d: %w", l.Index, err)
+			}
+			if pending != nil && pending.Sender == op.Sender && pending.MaxGasLimit != nil {
+				op.MaxFeePerGas, op.MaxGasLimit, op.PremiumBps, op.PostOpGas = pending.MaxFeePerGas, pending.MaxGasLimit, pending.PremiumBps, pending.PostOpGas
+				op.Refund, op.InitialRefund = orZero(pending.Refund), orZero(pending.InitialRefund)
+				account(&op)
+			}
+			pending = nil
+			report.Ops = append(report.Ops, op)
+
+		case l.Address == paymaster && l.Topics[0] == handledEvent.ID:
+			ev, err := filterer.ParseUserOperationsHandled(*l)
+			if err != nil {
+				return nil, fmt.Errorf("bundler: log %d: %w", l.Index, err)
+			}
+			handled = true
+			report.NumOps = ev.NumOps.Uint64()
+			report.InitialAmount = ev.InitialAmount
+			report.WithdrawnAmount = ev.WithdrawnAmount
+		}
+	}
+	if !handled {
+		return report, ErrNotHandled
+	}
+	return report, nil
+}
+
+func parseUserOperationEvent(l *types.Log) (OpRecord, error) {
+	if len(l.Topics) != 4 {
+		return OpRecord{}, errors.New("UserOperationEvent: wrong topic count")
+	}
+	vals, err := userOperationEvent.Inputs.NonIndexed().Unpack(l.Data)
+	if err != nil {
+		return OpRecord{}, fmt.Errorf("UserOperationEvent: %w", err)
+	}
+	return OpRecord{
+		Hash:          l.Topics[1],
+		Sender:        common.BytesToAddress(l.Topics[2].Bytes()),
+		Nonce:         vals[0].(*big.Int),
+		Success:       vals[1].(bool),
+		ActualGasCost: vals[2].(*big.Int),
+		ActualGasUsed: vals[3].(*big.Int),
+	}, nil
+}
+
+// account fills MaxCost, Charged and Premium from the decoded postOp
+// context and refund. Without a refund event calculateRefund returned zero,
+// so the sender was charged MaxCost.
+func account(op *OpRecord) {
+	op.MaxCost = new(big.Int).Mul(op.MaxGasLimit, op.MaxFeePerGas)
+	op.Charged = new(big.Int).Sub(op.MaxCost, op.Refund)
+	// Charged = cost * (10000 + premium) / 10000 when the refund was paid
+	// in full.
+	op.Premium = new(big.Int).Mul(op.Charged, op.PremiumBps)
+	op.Premium.Quo(op.Premium, new(big.Int).Add(big.NewInt(userop.MaxNodeOperatorPremium), op.PremiumBps))
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
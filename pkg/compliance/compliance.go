@@ -0,0 +1,344 @@
+// Package compliance checks an ExecutorEntry before a UserOp is built
+// around it. Offline rules catch what the executor or ledger would only
+// reject at execution time: malformed entries, hooks outside the address
+// book, undecodable hook data, a first hook chaining from nothing and
+// unregistered yieldSourceOracleIds. The on-chain rule runs
+// SuperExecutorBase.validateHookCompliance over every hook in one Multicall3
+// call, flagging the hooks the executor would reject with
+// MALICIOUS_HOOK_DETECTED.
+//
+// validateHookCompliance calls the hook's build, and hooks that chain read
+// the previous hook's out amount there, which is only set once that hook
+// has run. Given the out amounts, e.g. from a preview, each check passes a
+// stub answering its amount as prevHook, overridden into state for the call.
+// Without them the check of a chaining hook is inconclusive and reported as
+// a warning.
+package compliance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutor"
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+)
+
+// Severity grades a Diagnostic.
+type Severity string
+
+const (
+	// Error: execution will revert.
+	Error Severity = "error"
+	// Warning: the check was inconclusive or the entry is suspicious.
+	Warning Severity = "warning"
+)
+
+// Rule names the check that produced a Diagnostic.
+type Rule string
+
+const (
+	// RuleEntry mirrors the structural checks of SuperExecutorBase._execute.
+	RuleEntry Rule = "entry"
+	// RuleAllowlist requires hooks to be address book hook contracts.
+	RuleAllowlist Rule = "allowlist"
+	// RuleHookData requires hook data to decode with the hook's layout.
+	RuleHookData Rule = "hook_data"
+	// RuleFirstHook rejects a first hook that uses the previous hook amount.
+	RuleFirstHook Rule = "first_hook"
+	// RuleOracleID requires accounting hooks to name a registered
+	// yieldSourceOracleId.
+	RuleOracleID Rule = "oracle_id"
+	// RuleCompliance is validateHookCompliance on chain.
+	RuleCompliance Rule = "compliance"
+)
+
+// Diagnostic is one finding. Index is the hook's position in the entry, or
+// -1 for the entry as a whole.
+type Diagnostic struct {
+	Index    int            `json:"index"`
+	Hook     common.Address `json:"hook"`
+	Rule     Rule           `json:"rule"`
+	Severity Severity       `json:"severity"`
+	Message  string         `json:"message"`
+}
+
+func (d Diagnostic) String() string {
+	if d.Index < 0 {
+		return fmt.Sprintf("%s [%s] entry: %s", d.Severity, d.Rule, d.Message)
+	}
+	return fmt.Sprintf("%s [%s] hook %d %s: %s", d.Severity, d.Rule, d.Index, d.Hook.Hex(), d.Message)
+}
+
+// Report is the outcome of Checker.Check.
+type Report struct {
+	Account     common.Address `json:"account"`
+	Diagnostics []Diagnostic   `json:"diagnostics"`
+	// OnChain reports whether the on-chain rule ran.
+	OnChain bool `json:"onChain"`
+}
+
+// OK reports whether no diagnostic is an error.
+func (r *Report) OK() bool {
+	for _, d := range r.Diagnostics {
+		if d.Severity == Error {
+			return false
+		}
+	}
+	return true
+}
+
+// Hook returns the diagnostics of the hook at index.
+func (r *Report) Hook(index int) []Diagnostic {
+	var out []Diagnostic
+	for _, d := range r.Diagnostics {
+		if d.Index == index {
+			out = append(out, d)
+		}
+	}
+	return out
+}
+
+func (r *Report) add(index int, hook common.Address, rule Rule, sev Severity, format string, args ...any) {
+	r.Diagnostics = append(r.Diagnostics, Diagnostic{Index: index, Hook: hook, Rule: rule, Severity: sev, Message: fmt.Sprintf(format, args...)})
+}
+
+// Checker checks entries for one chain.
+type Checker struct {
+	// Chain is the allowlist: hooks must be contracts of the chain whose
+	// name ends in "Hook". Its ChainID scopes OracleIDs.
+	Chain *addressbook.Chain
+	// OracleIDs enables RuleOracleID; oracleid.Registry implements it.
+	OracleIDs hooks.OracleIDs
+	// Calls enables RuleCompliance; nil checks offline only.
+	Calls Caller
+	// Multicall3 aggregates the on-chain rule; DefaultMulticall3 when zero.
+	Multicall3 common.Address
+	// Executor runs validateHookCompliance; the chain's SuperExecutor when
+	// zero. SuperDestinationExecutor shares the check.
+	Executor common.Address
+	// OutAmount returns the amount the hook at index of entry hands to the
+	// next one, or nil when unknown. preview.Step.OutAmount is one source.
+	// Nil leaves every chaining hook's check inconclusive.
+	OutAmount func(entry *executor.ExecutorEntry, index int) *big.Int
+	// Block pins the on-chain rule; nil for latest.
+	Block *big.Int
+
+	names map[common.Address]string
+}
+
+// ErrNoExecutor is returned by Check when the on-chain rule has no executor
+// to call.
+var ErrNoExecutor = errors.New("compliance: no executor address")
+
+var executorABI, _ = SuperExecutor.SuperExecutorMetaData.GetAbi()
+
+// Check runs every rule over entry for account. Rule failures are reported
+// as diagnostics; only RPC failures are returned as errors. The on-chain
+// rule is skipped when the entry is structurally invalid.
+func (c *Checker) Check(ctx context.Context, account common.Address, entry *executor.ExecutorEntry) (*Report, error) {
+	r := c.CheckOffline(entry)
+	r.Account = account
+	if c.Calls == nil || entry.Validate() != nil {
+		return r, nil
+	}
+	executorAddr := c.Executor
+	if executorAddr == (common.Address{}) && c.Chain != nil {
+		executorAddr = c.Chain.Contracts["SuperExecutor"]
+	}
+	if executorAddr == (common.Address{}) {
+		return nil, ErrNoExecutor
+	}
+
+	n := entry.Len()
+	calls := make([]call3, n)
+	overrides := make(map[common.Address]gethclient.OverrideAccount)
+	// inconclusive marks the hooks checked against a stale previous out
+	// amount; decodes maps extra calls to the hooks whose layout is unknown.
+	inconclusive := make([]bool, n)
+	decodes := make(map[int]int)
+	for i, hook := range entry.HooksAddresses {
+		var prevHook common.Address
+		if i > 0 {
+			prevHook = entry.HooksAddresses[i-1]
+			var amount *big.Int
+			if c.OutAmount != nil {
+				amount = c.OutAmount(entry, i-1)
+			}
+			switch usesPrev, known := c.usesPrevHookAmount(hook, entry.HooksData[i]); {
+			case amount != nil:
+				prevHook = stubAddress(i)
+				overrides[prevHook] = gethclient.OverrideAccount{Code: outAmountStub(amount)}
+			case known:
+				inconclusive[i] = usesPrev
+			default:
+				data, err := hooks.ABI.Pack("decodeUsePrevHookAmount", entry.HooksData[i])
+				if err != nil {
+					return nil, err
+				}
+				decodes[len(calls)] = i
+				calls = append(calls, call3{Target: hook, CallData: data})
+			}
+		}
+		data, err := executorABI.Pack("validateHookCompliance", hook, prevHook, account, entry.HooksData[i])
+		if err != nil {
+			return nil, err
+		}
+		calls[i] = call3{Target: executorAddr, CallData: data}
+	}
+	results, err := c.aggregate(ctx, calls, overrides)
+	if err != nil {
+		return nil, fmt.Errorf("compliance: %w", err)
+	}
+	for k, i := range decodes {
+		// Hooks without decodeUsePrevHookAmount revert without data and
+		// cannot chain.
+		res := results[k]
+		if !res.Success {
+			inconclusive[i] = len(res.ReturnData) > 0
+			continue
+		}
+		vals, err := hooks.ABI.Unpack("decodeUsePrevHookAmount", res.ReturnData)
+		inconclusive[i] = err == nil && vals[0].(bool)
+	}
+	for i, res := range results[:n] {
+		hook := entry.HooksAddresses[i]
+		var problem string
+		if !res.Success {
+			problem = fmt.Sprintf("build reverted: %v", reverts.Decode(res.ReturnData))
+		} else {
+			vals, err := executorABI.Unpack("validateHookCompliance", res.ReturnData)
+			if err != nil {
+				return nil, fmt.Errorf("compliance: hook %d: %w", i, err)
+			}
+			if reflect.ValueOf(vals[0]).Len() == 0 {
+				problem = "executor rejects the hook with MALICIOUS_HOOK_DETECTED: build is not wrapped in preExecute and postExecute"
+			}
+		}
+		switch {
+		case inconclusive[i]:
+			if problem == "" {
+				problem = "passed"
+			}
+			r.add(i, hook, RuleCompliance, Warning, "inconclusive: the hook uses the previous hook amount, which OutAmount does not give; with its current value: %s", problem)
+		case problem != "":
+			r.add(i, hook, RuleCompliance, Error, "%s", problem)
+		}
+	}
+	r.OnChain = true
+	return r, nil
+}
+
+// CheckOffline runs the rules that need no RPC.
+func (c *Checker) CheckOffline(entry *executor.ExecutorEntry) *Report {
+	r := &Report{}
+	switch err := entry.Validate(); {
+	case errors.Is(err, executor.ErrNoHooks), errors.Is(err, executor.ErrLengthMismatch):
+		r.add(-1, common.Address{}, RuleEntry, Error, "%v", err)
+		return r
+	}
+	for i, hook := range entry.HooksAddresses {
+		if hook == (common.Address{}) {
+			r.add(i, hook, RuleEntry, Error, "%v", executor.ErrZeroHook)
+			continue
+		}
+		c.checkHook(r, i, hook, entry.HooksData[i])
+	}
+	return r
+}
+
+func (c *Checker) checkHook(r *Report, i int, hook common.Address, data []byte) {
+	name := c.name(hook)
+	if c.Chain != nil {
+		switch {
+		case name == "":
+			r.add(i, hook, RuleAllowlist, Error, "not a contract of %s", c.Chain.Name)
+			return
+		case !strings.HasSuffix(name, "Hook"):
+			r.add(i, hook, RuleAllowlist, Error, "%s is not a hook", name)
+			return
+		}
+	}
+	spec, ok := hooks.Lookup(name)
+	if !ok {
+		if name != "" {
+			r.add(i, hook, RuleHookData, Warning, "no data layout for %s; data not checked", name)
+		}
+		return
+	}
+	decoded, err := spec.Decode(data)
+	if err != nil {
+		r.add(i, hook, RuleHookData, Error, "%s: %v", name, err)
+		return
+	}
+	if i == 0 && prevHookAmount(decoded) {
+		r.add(i, hook, RuleFirstHook, Error, "first hook uses the previous hook amount")
+	}
+	if c.OracleIDs != nil && c.Chain != nil && spec.Type != hooks.NonAccounting {
+		h, ok := hooks.HeaderOf(decoded)
+		switch {
+		case !ok:
+			r.add(i, hook, RuleOracleID, Error, "%s: accounting hook without header", name)
+		case !c.OracleIDs.Registered(c.Chain.ChainID, h.YieldSourceOracleId):
+			r.add(i, hook, RuleOracleID, Error, "%s: yieldSourceOracleId %x not registered on chain %d", name, h.YieldSourceOracleId, c.Chain.ChainID)
+		}
+	}
+}
+
+// usesPrevHookAmount reads the usePrevHookAmount flag of data for hooks
+// with a known layout; known is false for the others.
+func (c *Checker) usesPrevHookAmount(hook common.Address, data []byte) (usesPrev, known bool) {
+	spec, ok := hooks.Lookup(c.name(hook))
+	if !ok {
+		return false, false
+	}
+	d, err := spec.Decode(data)
+	if err != nil {
+		// RuleHookData reports it; the build will revert regardless.
+		return false, true
+	}
+	return prevHookAmount(d), true
+}
+
+// prevHookAmount reads the usePrevHookAmount flag of typed hook data.
+func prevHookAmount(d hooks.HookData) bool {
+	c, ok := d.(hooks.Chaining)
+	return ok && c.UsesPrevHookAmount()
+}
+
+func (c *Checker) name(a common.Address) string {
+	if c.names == nil {
+		c.names = make(map[common.Address]string)
+		if c.Chain != nil {
+			for name, addr := range c.Chain.Contracts {
+				// An address with several names gets the best ranked,
+				// ties broken by name, whatever the map order.
+				if prev, ok := c.names[addr]; !ok || rank(name) < rank(prev) || rank(name) == rank(prev) && name < prev {
+					c.names[addr] = name
+				}
+			}
+		}
+	}
+	return c.names[a]
+}
+
+// rank orders the names of one address: hooks with a known layout, other
+// hooks, then the rest.
+func rank(name string) int {
+	if _, ok := hooks.Lookup(name); ok {
+		return 0
+	}
+	if strings.HasSuffix(name, "Hook") {
+		return 1
+	}
+	return 2
+}
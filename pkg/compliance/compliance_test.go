@@ -0,0 +1,359 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/ApproveERC20Hook"
+	"github.com/superform-xyz/v2-core/contract_bindings/Deposit4626VaultHook"
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+	"github.com/superform-xyz/v2-core/pkg/oracleid"
+)
+
+var (
+	account = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	token   = common.HexToAddress("0x0000000000000000000000000000000000007041")
+	vault   = common.HexToAddress("0x000000000000000000000000000000000000ba17")
+)
+
+// emptyBuildCode deploys a hook whose build, like any call to it, returns
+// an empty Execution[].
+var emptyBuildCode = common.FromHex("0x" +
+	"6760205f5260405ff3" + // PUSH8 runtime: mstore(0, 0x20) return(0, 64)
+	"5f52" + // PUSH0 MSTORE
+	"6008" + "6018" + "f3") // return(24, 8)
+
+// multicall3Asm implements Multicall3.aggregate3, whose artifact the tree
+// lacks. Memory holds i, n, the output end p and the calls array at 0x00 to
+// 0x60; the output starts at 0x80.
+const multicall3Asm = `
+	PUSH 4
+	CALLDATALOAD
+	PUSH 4
+	ADD
+	PUSH 0x60
+	MSTORE
+	PUSH 0x60
+	MLOAD
+	CALLDATALOAD
+	PUSH 0x20
+	MSTORE
+	PUSH 0x20
+	PUSH 0x80
+	MSTORE
+	PUSH 0x20
+	MLOAD
+	PUSH 0xa0
+	MSTORE
+	PUSH 0x20
+	MLOAD
+	PUSH 32
+	MUL
+	PUSH 0xc0
+	ADD
+	PUSH 0x40
+	MSTORE
+loop:
+	PUSH 0x20
+	MLOAD
+	PUSH 0
+	MLOAD
+	LT
+	ISZERO
+	JUMPI @done
+	PUSH 0xc0
+	PUSH 0x40
+	MLOAD
+	SUB
+	PUSH 0
+	MLOAD
+	PUSH 32
+	MUL
+	PUSH 0xc0
+	ADD
+	MSTORE
+	PUSH 0x60
+	MLOAD
+	PUSH 32
+	ADD
+	DUP1
+	PUSH 0
+	MLOAD
+	PUSH 32
+	MUL
+	ADD
+	CALLDATALOAD
+	ADD
+	DUP1
+	PUSH 64
+	ADD
+	CALLDATALOAD
+	DUP2
+	ADD
+	DUP1
+	CALLDATALOAD
+	DUP1
+	DUP3
+	PUSH 32
+	ADD
+	PUSH 0x40
+	MLOAD
+	PUSH 96
+	ADD
+	CALLDATACOPY
+	PUSH 0
+	PUSH 0
+	DUP3
+	PUSH 0x40
+	MLOAD
+	PUSH 96
+	ADD
+	PUSH 0
+	DUP8
+	CALLDATALOAD
+	GAS
+	CALL
+	DUP1
+	JUMPI @ok
+	DUP4
+	PUSH 32
+	ADD
+	CALLDATALOAD
+	JUMPI @ok
+	PUSH 0
+	PUSH 0
+	REVERT
+ok:
+	PUSH 0x40
+	MLOAD
+	MSTORE
+	PUSH 0x40
+	PUSH 0x40
+	MLOAD
+	PUSH 32
+	ADD
+	MSTORE
+	RETURNDATASIZE
+	PUSH 0x40
+	MLOAD
+	PUSH 64
+	ADD
+	MSTORE
+	RETURNDATASIZE
+	PUSH 0
+	PUSH 0x40
+	MLOAD
+	PUSH 96
+	ADD
+	RETURNDATACOPY
+	PUSH 0
+	RETURNDATASIZE
+	PUSH 0x40
+	MLOAD
+	PUSH 96
+	ADD
+	ADD
+	MSTORE
+	PUSH 31
+	RETURNDATASIZE
+	ADD
+	PUSH 5
+	SHR
+	PUSH 5
+	SHL
+	PUSH 96
+	ADD
+	PUSH 0x40
+	MLOAD
+	ADD
+	PUSH 0x40
+	MSTORE
+	POP
+	POP
+	POP
+	PUSH 0
+	MLOAD
+	PUSH 1
+	ADD
+	PUSH 0
+	MSTORE
+	JUMP @loop
+done:
+	PUSH 0x80
+	PUSH 0x40
+	MLOAD
+	SUB
+	PUSH 0x80
+	RETURN
+`
+
+func TestCheck(t *testing.T) {
+	ctx := context.Background()
+	s, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+
+	approveHook, tx, _, err := ApproveERC20Hook.DeployApproveERC20Hook(s.Auth, s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Mine(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	depositHook, tx, _, err := Deposit4626VaultHook.DeployDeposit4626VaultHook(s.Auth, s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Mine(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	nonce, err := s.Client.PendingNonceAt(ctx, s.Auth.From)
+	if err != nil {
+		t.Fatal(err)
+	}
+	tx, err = s.Auth.Signer(s.Auth.From, types.NewContractCreation(nonce, new(big.Int), 100_000, big.NewInt(10e9), emptyBuildCode))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := s.Client.SendTransaction(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	receipt, err := s.Mine(ctx, tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	emptyHook := receipt.ContractAddress
+
+	oracleID, err := s.RegisterOracle(ctx, common.Hash{1}, s.Addresses["ERC4626YieldSourceOracle"], big.NewInt(100), common.Address{0xfe})
+	if err != nil {
+		t.Fatal(err)
+	}
+	chain := s.Chain()
+	ids := oracleid.NewRegistry()
+	if err := ids.Register(chain.ChainID, "mock", oracleID); err != nil {
+		t.Fatal(err)
+	}
+	chain.Contracts["ApproveERC20Hook"] = approveHook
+	chain.Contracts["Deposit4626VaultHook"] = depositHook
+	chain.Contracts["EmptyBuildHook"] = emptyHook
+
+	encode := func(d hooks.HookData) []byte {
+		data, err := d.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	approve := encode(&hooks.ApproveERC20{Token: token, Spender: vault, Amount: big.NewInt(100)})
+	chained := encode(&hooks.Deposit4626Vault{Header: hooks.Header{YieldSourceOracleId: oracleID, YieldSource: vault}, Amount: big.NewInt(1), UsePrevHookAmount: true})
+
+	multicall, tx, err := harness.DeployAsm(s.Auth, s.Client, multicall3Asm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := s.Mine(ctx, tx); err != nil {
+		t.Fatal(err)
+	}
+	entry := executor.NewExecutorEntry().Append(approveHook, approve).Append(depositHook, chained)
+	c := &Checker{Chain: chain, OracleIDs: ids, Calls: s.Geth, Multicall3: multicall}
+
+	// Without the approval's out amount the chained deposit is inconclusive.
+	r, err := c.Check(ctx, account, entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d := r.Hook(1); !r.OK() || !r.OnChain || len(r.Diagnostics) != 1 || d[0].Rule != RuleCompliance || d[0].Severity != Warning {
+		t.Fatalf("diagnostics = %v", r.Diagnostics)
+	}
+	// With it, the deposit builds against a stub answering the amount, and
+	// reverts like any other hook on an amount build rejects.
+	var out int64 = 100
+	c.OutAmount = func(e *executor.ExecutorEntry, i int) *big.Int {
+		if e != entry || i != 0 {
+			t.Fatalf("OutAmount(%d)", i)
+		}
+		return big.NewInt(out)
+	}
+	if r, err = c.Check(ctx, account, entry); err != nil || len(r.Diagnostics) != 0 {
+		t.Fatalf("diagnostics = %v, %v", r.Diagnostics, err)
+	}
+	out = 0
+	if r, err = c.Check(ctx, account, entry); err != nil {
+		t.Fatal(err)
+	}
+	if d := r.Hook(1); len(d) != 1 || d[0].Severity != Error || !strings.Contains(d[0].Message, "AMOUNT_NOT_VALID") {
+		t.Fatalf("diagnostics = %v", r.Diagnostics)
+	}
+	c.OutAmount = nil
+
+	unregistered := encode(&hooks.Deposit4626Vault{Header: hooks.Header{YieldSource: vault}, Amount: big.NewInt(1)})
+	r, err = c.Check(ctx, account, executor.NewExecutorEntry().
+		Append(depositHook, chained).
+		Append(common.HexToAddress("0xdead"), approve).
+		Append(approveHook, approve[:20]).
+		Append(depositHook, unregistered).
+		Append(emptyHook, nil))
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		rule Rule
+		sev  Severity
+	}{
+		{RuleFirstHook, Error},
+		{RuleAllowlist, Error},
+		{RuleHookData, Error},
+		{RuleOracleID, Error},
+		{RuleHookData, Warning},
+	}
+	for i, w := range want {
+		if d := r.Hook(i); len(d) == 0 || d[0].Rule != w.rule || d[0].Severity != w.sev {
+			t.Fatalf("hook %d = %v, want %s %s", i, d, w.sev, w.rule)
+		}
+	}
+	if d := r.Hook(4); len(d) != 2 || d[1].Rule != RuleCompliance || d[1].Severity != Error {
+		t.Fatalf("empty build hook = %v", d)
+	}
+	if r.OK() {
+		t.Fatal("report OK")
+	}
+	c.Multicall3 = common.Address{}
+	if _, err := c.Check(ctx, account, entry); !errors.Is(err, ErrNoMulticall3) {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+func TestCheckOfflineEntry(t *testing.T) {
+	c := &Checker{}
+	r := c.CheckOffline(&executor.ExecutorEntry{HooksAddresses: []common.Address{{1}}})
+	if len(r.Diagnostics) != 1 || r.Diagnostics[0].Index != -1 || r.Diagnostics[0].Rule != RuleEntry {
+		t.Fatalf("diagnostics = %v", r.Diagnostics)
+	}
+	r = c.CheckOffline(executor.NewExecutorEntry().Append(common.Address{}, nil))
+	if d := r.Hook(0); len(d) != 1 || d[0].Rule != RuleEntry {
+		t.Fatalf("zero hook = %v", d)
+	}
+}
+
+func TestCheckerName(t *testing.T) {
+	a := common.Address{1}
+	c := &Checker{Chain: &addressbook.Chain{Contracts: map[string]common.Address{
+		"Treasury":             a,
+		"LegacyHook":           a,
+		"Deposit4626VaultHook": a,
+	}}}
+	if name := c.name(a); name != "Deposit4626VaultHook" {
+		t.Fatalf("name = %q", name)
+	}
+}
@@ -0,0 +1,109 @@
+package compliance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+// Caller runs eth_call with state overrides; gethclient.Client implements
+// it.
+type Caller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int, overrides *map[common.Address]gethclient.OverrideAccount) ([]byte, error)
+}
+
+// DefaultMulticall3 is the address Multicall3 is deployed at on every chain
+// Superform supports.
+var DefaultMulticall3 = common.HexToAddress("0xcA11bde05977b3631167028862bE2a173976CA11")
+
+// ErrNoMulticall3 is returned by Check when the Multicall3 address holds no
+// contract.
+var ErrNoMulticall3 = errors.New("compliance: no Multicall3 contract")
+
+var multicallABI = mustParseABI(`[{"type":"function","name":"aggregate3","stateMutability":"payable","inputs":[{"name":"calls","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"allowFailure","type":"bool"},{"name":"callData","type":"bytes"}]}],"outputs":[{"name":"returnData","type":"tuple[]","components":[{"name":"success","type":"bool"},{"name":"returnData","type":"bytes"}]}]}]`)
+
+func mustParseABI(def string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(fmt.Sprintf("compliance: ABI: %v", err))
+	}
+	return parsed
+}
+
+// call3 is Multicall3.Call3.
+type call3 struct {
+	Target       common.Address
+	AllowFailure bool
+	CallData     []byte
+}
+
+// result is Multicall3.Result.
+type result struct {
+	Success    bool
+	ReturnData []byte
+}
+
+// aggregate runs calls through Multicall3.aggregate3 in one eth_call,
+// letting each fail on its own.
+func (c *Checker) aggregate(ctx context.Context, calls []call3, overrides map[common.Address]gethclient.OverrideAccount) ([]result, error) {
+	for i := range calls {
+		calls[i].AllowFailure = true
+	}
+	data, err := multicallABI.Pack("aggregate3", calls)
+	if err != nil {
+		return nil, err
+	}
+	multicall := c.Multicall3
+	if multicall == (common.Address{}) {
+		multicall = DefaultMulticall3
+	}
+	var o *map[common.Address]gethclient.OverrideAccount
+	if len(overrides) > 0 {
+		o = &overrides
+	}
+	ret, err := c.Calls.CallContract(ctx, ethereum.CallMsg{To: &multicall, Data: data}, c.Block, o)
+	if err != nil {
+		return nil, err
+	}
+	if len(ret) == 0 {
+		return nil, fmt.Errorf("%w at %s", ErrNoMulticall3, multicall.Hex())
+	}
+	vals, err := multicallABI.Unpack("aggregate3", ret)
+	if err != nil {
+		return nil, fmt.Errorf("aggregate3: %w", err)
+	}
+	results := *abi.ConvertType(vals[0], new([]result)).(*[]result)
+	if len(results) != len(calls) {
+		return nil, fmt.Errorf("aggregate3: %d results for %d calls", len(results), len(calls))
+	}
+	return results, nil
+}
+
+// stubAddress is where the previous hook of the hook at index is stubbed.
+// Each check gets its own address, so one override never replaces the code
+// of a hook another check builds.
+func stubAddress(index int) common.Address {
+	return common.BytesToAddress(crypto.Keccak256([]byte(fmt.Sprintf("superform.compliance.prevHook.%d", index))))
+}
+
+// outAmountStub returns runtime code answering amount to any call, which
+// covers the getOutAmount(account) hooks make on prevHook in build.
+func outAmountStub(amount *big.Int) []byte {
+	code := []byte{0x7f} // PUSH32 amount
+	code = append(code, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return append(code,
+		0x5f,       // PUSH0
+		0x52,       // MSTORE
+		0x60, 0x20, // PUSH1 32
+		0x5f, // PUSH0
+		0xf3, // RETURN
+	)
+}
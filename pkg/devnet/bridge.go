@@ -0,0 +1,168 @@
+package devnet
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// bridgeABI holds the source-chain calls the bridge hooks make: Across
+// depositV3Now and DLN createOrder.
+var bridgeABI, _ = abi.JSON(strings.NewReader(`[
+	{"type":"function","name":"depositV3Now","stateMutability":"payable","inputs":[
+		{"name":"depositor","type":"address"},
+		{"name":"recipient","type":"address"},
+		{"name":"inputToken","type":"address"},
+		{"name":"outputToken","type":"address"},
+		{"name":"inputAmount","type":"uint256"},
+		{"name":"outputAmount","type":"uint256"},
+		{"name":"destinationChainId","type":"uint256"},
+		{"name":"exclusiveRelayer","type":"address"},
+		{"name":"fillDeadlineOffset","type":"uint32"},
+		{"name":"exclusivityDeadline","type":"uint32"},
+		{"name":"message","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"createOrder","stateMutability":"payable","inputs":[
+		{"name":"order","type":"tuple","components":[
+			{"name":"giveTokenAddress","type":"address"},
+			{"name":"giveAmount","type":"uint256"},
+			{"name":"takeTokenAddress","type":"bytes"},
+			{"name":"takeAmount","type":"uint256"},
+			{"name":"takeChainId","type":"uint256"},
+			{"name":"receiverDst","type":"bytes"},
+			{"name":"givePatchAuthoritySrc","type":"address"},
+			{"name":"orderAuthorityAddressDst","type":"bytes"},
+			{"name":"allowedTakerDst","type":"bytes"},
+			{"name":"externalCall","type":"bytes"},
+			{"name":"allowedCancelBeneficiarySrc","type":"bytes"}]},
+		{"name":"affiliateFee","type":"bytes"},
+		{"name":"referralCode","type":"uint32"},
+		{"name":"permitEnvelope","type":"bytes"}],"outputs":[{"name":"","type":"bytes32"}]}
+]`))
+
+// externalCallArgs is the DLN ExternalCallEnvelopV1 tuple.
+var externalCallArgs = func() abi.Arguments {
+	t, _ := abi.NewType("tuple", "", []abi.ArgumentMarshaling{
+		{Name: "fallbackAddress", Type: "address"},
+		{Name: "executorAddress", Type: "address"},
+		{Name: "executionFee", Type: "uint160"},
+		{Name: "allowDelayedExecution", Type: "bool"},
+		{Name: "requireSuccessfullExecution", Type: "bool"},
+		{Name: "payload", Type: "bytes"},
+	})
+	return abi.Arguments{{Type: t}}
+}()
+
+// AcrossDeposit is a depositV3Now call, as AcrossSendFundsAndExecuteOnDstHook
+// makes it. Recipient is the destination AcrossV3Adapter and Message the
+// encoded intents.Message, SigData included.
+type AcrossDeposit struct {
+	Depositor           common.Address
+	Recipient           common.Address
+	InputToken          common.Address
+	OutputToken         common.Address
+	InputAmount         *big.Int
+	OutputAmount        *big.Int
+	DestinationChainId  *big.Int
+	ExclusiveRelayer    common.Address
+	FillDeadlineOffset  uint32
+	ExclusivityDeadline uint32
+	Message             []byte
+}
+
+// Calldata packs the depositV3Now call.
+func (d *AcrossDeposit) Calldata() ([]byte, error) {
+	return bridgeABI.Pack("depositV3Now", d.Depositor, d.Recipient, d.InputToken, d.OutputToken,
+		orZero(d.InputAmount), orZero(d.OutputAmount), orZero(d.DestinationChainId), d.ExclusiveRelayer,
+		d.FillDeadlineOffset, d.ExclusivityDeadline, orEmpty(d.Message))
+}
+
+// OrderCreation is the DLN order of a createOrder call.
+type OrderCreation struct {
+	GiveTokenAddress            common.Address
+	GiveAmount                  *big.Int
+	TakeTokenAddress            []byte
+	TakeAmount                  *big.Int
+	TakeChainId                 *big.Int
+	ReceiverDst                 []byte
+	GivePatchAuthoritySrc       common.Address
+	OrderAuthorityAddressDst    []byte
+	AllowedTakerDst             []byte
+	ExternalCall                []byte
+	AllowedCancelBeneficiarySrc []byte
+}
+
+// DebridgeOrder is a createOrder call, as
+// DeBridgeSendOrderAndExecuteOnDstHook makes it.
+type DebridgeOrder struct {
+	Order          OrderCreation
+	AffiliateFee   []byte
+	ReferralCode   uint32
+	PermitEnvelope []byte
+}
+
+// Calldata packs the createOrder call.
+func (o *DebridgeOrder) Calldata() ([]byte, error) {
+	order := o.Order
+	order.GiveAmount, order.TakeAmount, order.TakeChainId = orZero(order.GiveAmount), orZero(order.TakeAmount), orZero(order.TakeChainId)
+	order.TakeTokenAddress, order.ReceiverDst = orEmpty(order.TakeTokenAddress), orEmpty(order.ReceiverDst)
+	order.OrderAuthorityAddressDst, order.AllowedTakerDst = orEmpty(order.OrderAuthorityAddressDst), orEmpty(order.AllowedTakerDst)
+	order.ExternalCall, order.AllowedCancelBeneficiarySrc = orEmpty(order.ExternalCall), orEmpty(order.AllowedCancelBeneficiarySrc)
+	return bridgeABI.Pack("createOrder", order, orEmpty(o.AffiliateFee), o.ReferralCode, orEmpty(o.PermitEnvelope))
+}
+
+// ExternalCall is the DLN ExternalCallEnvelopV1 carried in
+// OrderCreation.ExternalCall. Payload is the encoded intents.Message.
+type ExternalCall struct {
+	FallbackAddress             common.Address
+	ExecutorAddress             common.Address
+	ExecutionFee                *big.Int
+	AllowDelayedExecution       bool
+	RequireSuccessfullExecution bool
+	Payload                     []byte
+}
+
+// ExternalCallVersion is the envelope version the deBridge hook writes.
+const ExternalCallVersion = 1
+
+// Encode returns the version byte followed by the encoded envelope.
+func (e *ExternalCall) Encode() ([]byte, error) {
+	c := *e
+	c.ExecutionFee, c.Payload = orZero(c.ExecutionFee), orEmpty(c.Payload)
+	enc, err := externalCallArgs.Pack(c)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{ExternalCallVersion}, enc...), nil
+}
+
+// DecodeExternalCall decodes an OrderCreation.ExternalCall.
+func DecodeExternalCall(data []byte) (*ExternalCall, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("devnet: empty external call")
+	}
+	if data[0] != ExternalCallVersion {
+		return nil, fmt.Errorf("devnet: unsupported external call version %d", data[0])
+	}
+	vals, err := externalCallArgs.Unpack(data[1:])
+	if err != nil {
+		return nil, fmt.Errorf("devnet: decode external call: %w", err)
+	}
+	return abi.ConvertType(vals[0], new(ExternalCall)).(*ExternalCall), nil
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+func orEmpty(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
@@ -0,0 +1,425 @@
+// Package devnet runs two simulated chains with the Superform stack and a
+// mock bridge relayer, so Across and deBridge flows can be tested end to end
+// offline.
+//
+// Each chain has the core stack from package harness plus:
+//
+//   - SpokePool and DlnSource, bridge entry mocks that log every call; bridge
+//     hooks configured with them, or Chain.Bridge, record deposits there
+//   - AcrossV3Adapter, whose spoke pool is the relayer
+//   - DebridgeAdapter, behind a DLN destination mock naming the relayer as
+//     its external call adapter
+//   - Token, a mintable token the relayer mints on fills
+//   - AccountCode, a minimal ERC-7579 account that accounts delegate to
+//     (EIP-7702), executing for themselves, SuperExecutor and
+//     SuperDestinationExecutor
+//   - AcrossSendFundsAndExecuteOnDstHook, DeBridgeSendOrderAndExecuteOnDstHook
+//     and TransferERC20Hook, the bridge hooks calling SpokePool and DlnSource
+//
+// The Relayer reads the bridge entry logs of every chain and delivers each
+// deposit to the destination adapter, as a bridge filler would.
+package devnet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/holiman/uint256"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/AcrossSendFundsAndExecuteOnDstHook"
+	"github.com/superform-xyz/v2-core/contract_bindings/AcrossV3Adapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/DeBridgeSendOrderAndExecuteOnDstHook"
+	"github.com/superform-xyz/v2-core/contract_bindings/DebridgeAdapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/TransferERC20Hook"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+	"github.com/superform-xyz/v2-core/pkg/userop"
+)
+
+var validatorABI, _ = SuperValidator.SuperValidatorMetaData.GetAbi()
+
+// DefaultChainIDs are the chain IDs New uses.
+var DefaultChainIDs = []uint64{31337, 31338}
+
+// Chain is one devnet chain.
+type Chain struct {
+	*harness.Stack
+
+	SpokePool       common.Address
+	DlnSource       common.Address
+	DlnDestination  common.Address
+	Token           common.Address
+	AccountCode     common.Address
+	AcrossAdapter   *AcrossV3Adapter.AcrossV3Adapter
+	DebridgeAdapter *DebridgeAdapter.DebridgeAdapter
+}
+
+// Devnet is a set of chains and the relayer between them.
+type Devnet struct {
+	Chains  []*Chain
+	Relayer *Relayer
+}
+
+// New starts a devnet on DefaultChainIDs.
+func New(ctx context.Context) (*Devnet, error) {
+	return NewWithChainIDs(ctx, DefaultChainIDs...)
+}
+
+// NewWithChainIDs starts a devnet with one chain per ID.
+func NewWithChainIDs(ctx context.Context, chainIDs ...uint64) (*Devnet, error) {
+	if len(chainIDs) < 2 {
+		return nil, fmt.Errorf("devnet: need at least two chains, got %d", len(chainIDs))
+	}
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	d := &Devnet{Relayer: newRelayer(key)}
+	for _, id := range chainIDs {
+		if d.Chain(id) != nil {
+			d.Close()
+			return nil, fmt.Errorf("devnet: duplicate chain ID %d", id)
+		}
+		c, err := newChain(ctx, id, d.Relayer.Address)
+		if err != nil {
+			d.Close()
+			return nil, err
+		}
+		d.Chains = append(d.Chains, c)
+		if err := d.Relayer.add(ctx, c); err != nil {
+			d.Close()
+			return nil, err
+		}
+	}
+	return d, nil
+}
+
+// Chain returns the chain with id, or nil.
+func (d *Devnet) Chain(id uint64) *Chain {
+	for _, c := range d.Chains {
+		if c.ChainID.Uint64() == id {
+			return c
+		}
+	}
+	return nil
+}
+
+// Close stops every chain.
+func (d *Devnet) Close() {
+	for _, c := range d.Chains {
+		c.Close()
+	}
+}
+
+func newChain(ctx context.Context, chainID uint64, relayer common.Address) (*Chain, error) {
+	s, err := harness.NewChain(chainID)
+	if err != nil {
+		return nil, err
+	}
+	c := &Chain{Stack: s}
+	if err := c.deploy(ctx, relayer); err != nil {
+		s.Close()
+		return nil, fmt.Errorf("devnet: chain %d: %w", chainID, err)
+	}
+	return c, nil
+}
+
+func (c *Chain) deploy(ctx context.Context, relayer common.Address) error {
+	auth, client := c.Auth, c.Client
+	mocks := []struct {
+		name string
+		addr *common.Address
+		src  string
+	}{
+		{"SpokePool", &c.SpokePool, bridgeEntryAsm},
+		{"DlnSource", &c.DlnSource, bridgeEntryAsm},
+		{"DlnDestination", &c.DlnDestination, fmt.Sprintf(dlnDestinationAsm, relayer.Hex())},
+		{"Token", &c.Token, tokenAsm},
+		{"AccountCode", &c.AccountCode, fmt.Sprintf(accountAsm, c.Addresses["SuperExecutor"].Hex(), c.Addresses["SuperDestinationExecutor"].Hex())},
+	}
+	for _, m := range mocks {
+		addr, tx, err := harness.DeployAsm(auth, client, m.src)
+		if err != nil {
+			return fmt.Errorf("deploy %s: %w", m.name, err)
+		}
+		if _, err := c.Mine(ctx, tx); err != nil {
+			return fmt.Errorf("deploy %s: %w", m.name, err)
+		}
+		*m.addr = addr
+		c.Addresses[m.name] = addr
+	}
+
+	executor := c.Addresses["SuperDestinationExecutor"]
+	addr, tx, across, err := AcrossV3Adapter.DeployAcrossV3Adapter(auth, client, relayer, executor)
+	if err != nil {
+		return fmt.Errorf("deploy AcrossV3Adapter: %w", err)
+	}
+	if _, err := c.Mine(ctx, tx); err != nil {
+		return fmt.Errorf("deploy AcrossV3Adapter: %w", err)
+	}
+	c.AcrossAdapter, c.Addresses["AcrossV3Adapter"] = across, addr
+
+	addr, tx, debridge, err := DebridgeAdapter.DeployDebridgeAdapter(auth, client, c.DlnDestination, executor)
+	if err != nil {
+		return fmt.Errorf("deploy DebridgeAdapter: %w", err)
+	}
+	if _, err := c.Mine(ctx, tx); err != nil {
+		return fmt.Errorf("deploy DebridgeAdapter: %w", err)
+	}
+	c.DebridgeAdapter, c.Addresses["DebridgeAdapter"] = debridge, addr
+
+	validator := c.Addresses["SuperValidator"]
+	hooks := []struct {
+		name   string
+		deploy func() (common.Address, *types.Transaction, error)
+	}{
+		{"AcrossSendFundsAndExecuteOnDstHook", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := AcrossSendFundsAndExecuteOnDstHook.DeployAcrossSendFundsAndExecuteOnDstHook(auth, client, c.SpokePool, validator)
+			return addr, tx, err
+		}},
+		{"DeBridgeSendOrderAndExecuteOnDstHook", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := DeBridgeSendOrderAndExecuteOnDstHook.DeployDeBridgeSendOrderAndExecuteOnDstHook(auth, client, c.DlnSource, validator)
+			return addr, tx, err
+		}},
+		{"TransferERC20Hook", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := TransferERC20Hook.DeployTransferERC20Hook(auth, client)
+			return addr, tx, err
+		}},
+	}
+	for _, h := range hooks {
+		addr, tx, err := h.deploy()
+		if err != nil {
+			return fmt.Errorf("deploy %s: %w", h.name, err)
+		}
+		if _, err := c.Mine(ctx, tx); err != nil {
+			return fmt.Errorf("deploy %s: %w", h.name, err)
+		}
+		c.Addresses[h.name] = addr
+	}
+	return nil
+}
+
+// Mint mints amount of the chain's token to to.
+func (c *Chain) Mint(ctx context.Context, auth *bind.TransactOpts, to common.Address, amount *big.Int) error {
+	opts := *auth
+	opts.Context = ctx
+	tx, err := bind.NewBoundContract(c.Token, tokenABI, c.Client, c.Client, c.Client).Transact(&opts, "mint", to, amount)
+	if err != nil {
+		return fmt.Errorf("devnet: mint: %w", err)
+	}
+	_, err = c.Mine(ctx, tx)
+	return err
+}
+
+// TokenBalance returns the token balance of holder.
+func (c *Chain) TokenBalance(ctx context.Context, holder common.Address) (*big.Int, error) {
+	var out []any
+	err := bind.NewBoundContract(c.Token, tokenABI, c.Client, nil, nil).Call(&bind.CallOpts{Context: ctx}, &out, "balanceOf", holder)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// Account is an EOA delegated to AccountCode with SuperValidator,
+// SuperDestinationValidator, SuperExecutor and SuperDestinationExecutor
+// installed, owned by its own key. It runs userOps through Chain.HandleOp,
+// and destination messages for it execute their hooks. Empty executor
+// calldata reverts in SuperDestinationExecutor.
+type Account struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+
+	auth map[uint64]*bind.TransactOpts
+}
+
+// Auth returns the account's transactor on c, which must have adopted it.
+func (a *Account) Auth(c *Chain) *bind.TransactOpts {
+	return a.auth[c.ChainID.Uint64()]
+}
+
+// NewAccount creates a funded account on c.
+func (c *Chain) NewAccount(ctx context.Context) (*Account, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	a := &Account{Key: key, Address: crypto.PubkeyToAddress(key.PublicKey)}
+	if err := c.Adopt(ctx, a); err != nil {
+		return nil, err
+	}
+	return a, nil
+}
+
+// Adopt sets a up on c: funds it, delegates it to AccountCode and installs
+// both validators and both executors. Adopting the same key on several chains gives one
+// account address everywhere, as a counterfactual smart account would.
+func (c *Chain) Adopt(ctx context.Context, a *Account) error {
+	auth, err := bind.NewKeyedTransactorWithChainID(a.Key, c.ChainID)
+	if err != nil {
+		return err
+	}
+	if a.auth == nil {
+		a.auth = make(map[uint64]*bind.TransactOpts)
+	}
+	a.auth[c.ChainID.Uint64()] = auth
+	balance, err := c.Client.BalanceAt(ctx, a.Address, nil)
+	if err != nil {
+		return err
+	}
+	if balance.Sign() == 0 {
+		if err := c.Fund(ctx, a.Address, harness.DefaultBalance); err != nil {
+			return err
+		}
+	}
+	if err := c.delegate(ctx, a.Key); err != nil {
+		return fmt.Errorf("devnet: delegate account: %w", err)
+	}
+	owner := common.LeftPadBytes(a.Address.Bytes(), 32)
+	tx, err := c.Validator.OnInstall(auth, owner)
+	if err != nil {
+		return fmt.Errorf("devnet: install SuperValidator: %w", err)
+	}
+	if _, err := c.Mine(ctx, tx); err != nil {
+		return fmt.Errorf("devnet: install SuperValidator: %w", err)
+	}
+	if tx, err = c.DestinationValidator.OnInstall(auth, owner); err != nil {
+		return fmt.Errorf("devnet: install SuperDestinationValidator: %w", err)
+	}
+	if _, err := c.Mine(ctx, tx); err != nil {
+		return fmt.Errorf("devnet: install SuperDestinationValidator: %w", err)
+	}
+	if tx, err = c.Executor.OnInstall(auth, nil); err != nil {
+		return fmt.Errorf("devnet: install SuperExecutor: %w", err)
+	}
+	if _, err := c.Mine(ctx, tx); err != nil {
+		return fmt.Errorf("devnet: install SuperExecutor: %w", err)
+	}
+	if tx, err = c.DestinationExecutor.OnInstall(auth, nil); err != nil {
+		return fmt.Errorf("devnet: install SuperDestinationExecutor: %w", err)
+	}
+	if _, err := c.Mine(ctx, tx); err != nil {
+		return fmt.Errorf("devnet: install SuperDestinationExecutor: %w", err)
+	}
+	return nil
+}
+
+// delegate sets the code of key's account to a delegation to AccountCode,
+// sponsored by the deployer.
+func (c *Chain) delegate(ctx context.Context, key *ecdsa.PrivateKey) error {
+	account := crypto.PubkeyToAddress(key.PublicKey)
+	nonce, err := c.Client.PendingNonceAt(ctx, account)
+	if err != nil {
+		return err
+	}
+	chainID := uint256.MustFromBig(c.ChainID)
+	authorization, err := types.SignSetCode(key, types.SetCodeAuthorization{ChainID: *chainID, Address: c.AccountCode, Nonce: nonce})
+	if err != nil {
+		return err
+	}
+	sponsorNonce, err := c.Client.PendingNonceAt(ctx, c.Auth.From)
+	if err != nil {
+		return err
+	}
+	tip, err := c.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := c.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	feeCap := new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2)))
+	tx, err := types.SignNewTx(c.Key, types.LatestSignerForChainID(c.ChainID), &types.SetCodeTx{
+		ChainID:   chainID,
+		Nonce:     sponsorNonce,
+		GasTipCap: uint256.MustFromBig(tip),
+		GasFeeCap: uint256.MustFromBig(feeCap),
+		Gas:       100_000,
+		To:        account,
+		Value:     new(uint256.Int),
+		AuthList:  []types.SetCodeAuthorization{authorization},
+	})
+	if err != nil {
+		return err
+	}
+	if err := c.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	_, err = c.Mine(ctx, tx)
+	return err
+}
+
+// HandleOp runs op from a in one transaction, the way the EntryPoint would
+// without gas accounting: SuperValidator.validateUserOp checks op.Signature
+// against hash and stores it for the bridge hooks, then the account runs
+// op.CallData, typically set with UserOp.SetSuperExecutorCall. It fails if
+// the validator rejects the signature.
+func (c *Chain) HandleOp(ctx context.Context, a *Account, op *userop.UserOp, hash common.Hash) (*types.Receipt, error) {
+	packed, err := op.Pack()
+	if err != nil {
+		return nil, err
+	}
+	validate, err := validatorABI.Pack("validateUserOp", packed, hash)
+	if err != nil {
+		return nil, err
+	}
+	validator := c.Addresses["SuperValidator"]
+	ret, err := c.Client.CallContract(ctx, ethereum.CallMsg{From: a.Address, To: &validator, Data: validate}, nil)
+	if err != nil {
+		return nil, fmt.Errorf("devnet: validate userOp: %w", reverts.Wrap(err))
+	}
+	// The low 160 bits of the validation data are 1 when the signature
+	// failed.
+	if new(big.Int).SetBytes(ret).Bit(0) == 1 {
+		return nil, errors.New("devnet: validate userOp: signature rejected")
+	}
+	calldata, err := userop.AccountExecuteCalldata(
+		userop.Execution{Target: validator, CallData: validate},
+		userop.Execution{Target: a.Address, CallData: op.CallData},
+	)
+	if err != nil {
+		return nil, err
+	}
+	opts := *a.Auth(c)
+	opts.Context = ctx
+	tx, err := bind.NewBoundContract(a.Address, abi.ABI{}, c.Client, c.Client, c.Client).RawTransact(&opts, calldata)
+	if err != nil {
+		return nil, fmt.Errorf("devnet: handle userOp: %w", reverts.Wrap(err))
+	}
+	return c.Mine(ctx, tx)
+}
+
+// Bridge sends calldata with value from auth to a bridge entry, typically
+// built with AcrossDeposit or DebridgeOrder, and mines it.
+func (c *Chain) Bridge(ctx context.Context, auth *bind.TransactOpts, entry common.Address, value *big.Int, calldata []byte) (*types.Receipt, error) {
+	opts := *auth
+	opts.Context = ctx
+	opts.Value = value
+	tx, err := bind.NewBoundContract(entry, tokenABI, c.Client, c.Client, c.Client).RawTransact(&opts, calldata)
+	if err != nil {
+		return nil, fmt.Errorf("devnet: bridge: %w", err)
+	}
+	return c.Mine(ctx, tx)
+}
+
+// chainIDs returns the IDs of chains, sorted.
+func chainIDs(chains map[uint64]*Chain) []uint64 {
+	ids := make([]uint64, 0, len(chains))
+	for id := range chains {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+	return ids
+}
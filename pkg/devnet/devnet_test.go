@@ -0,0 +1,332 @@
+package devnet
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+	"github.com/superform-xyz/v2-core/pkg/intents"
+	"github.com/superform-xyz/v2-core/pkg/userop"
+	"github.com/superform-xyz/v2-core/pkg/validator"
+)
+
+// sign signs the source userOp hash with one destination execution of
+// calldata on dst for acct and returns its signature data.
+func sign(t *testing.T, src, dst *Chain, acct *Account, hash common.Hash, tokens []common.Address, amounts []*big.Int, calldata []byte) *validator.SignatureData {
+	t.Helper()
+	batch := &validator.Batch{
+		ValidUntil: uint64(time.Now().Add(time.Hour).Unix()),
+		Ops: []validator.UserOp{{
+			Hash:      hash,
+			Validator: src.Addresses["SuperValidator"],
+			Destinations: []validator.Destination{{
+				ChainID: dst.ChainID.Uint64(),
+				Info: validator.DstInfo{
+					Account:       acct.Address,
+					Executor:      dst.Addresses["SuperDestinationExecutor"],
+					DstTokens:     tokens,
+					IntentAmounts: amounts,
+					Validator:     dst.Addresses["SuperDestinationValidator"],
+					Data:          calldata,
+				},
+			}},
+		}},
+	}
+	signed, err := batch.Sign(acct.Key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return signed.Signatures[0]
+}
+
+// bridge runs a userOp on src for acct executing the bridge hook built by
+// hookData from the destination message, signed over a destination transfer
+// of amount dst tokens to receiver. It returns the message, with SigData.
+func bridge(t *testing.T, src, dst *Chain, acct *Account, hook string, tokens []common.Address, amounts []*big.Int, receiver common.Address, amount *big.Int, hookData func(msg []byte) hooks.HookData) (*intents.Message, *validator.SignatureData, *types.Receipt) {
+	t.Helper()
+	ctx := context.Background()
+	encode := func(d hooks.HookData) []byte {
+		t.Helper()
+		data, err := d.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	dstCalldata, err := executor.NewExecutorEntry().
+		Append(dst.Addresses["TransferERC20Hook"], encode(&hooks.TransferERC20{Token: dst.Token, To: receiver, Amount: amount})).
+		ExecuteCalldata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	msg := &intents.Message{Account: acct.Address, DstTokens: tokens, IntentAmounts: amounts, ExecutorCalldata: dstCalldata}
+	hookMsg, err := msg.EncodeHookMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	op := &userop.UserOp{Sender: acct.Address}
+	entry := executor.NewExecutorEntry().Append(src.Addresses[hook], encode(hookData(hookMsg)))
+	if err := op.SetSuperExecutorCall(src.Addresses["SuperExecutor"], entry); err != nil {
+		t.Fatal(err)
+	}
+	hash, err := op.Hash(userop.EntryPointV07, src.ChainID)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := sign(t, src, dst, acct, hash, tokens, amounts, dstCalldata)
+	if op.Signature, err = sig.Encode(); err != nil {
+		t.Fatal(err)
+	}
+	msg.SigData = op.Signature
+	receipt, err := src.HandleOp(ctx, acct, op, hash)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return msg, sig, receipt
+}
+
+// executed reports whether SuperDestinationExecutor on c emitted Executed
+// for acct in tx.
+func executed(t *testing.T, c *Chain, acct *Account, tx *common.Hash) bool {
+	t.Helper()
+	if tx == nil {
+		return false
+	}
+	receipt, err := c.Client.TransactionReceipt(context.Background(), *tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, l := range receipt.Logs {
+		if ev, err := c.DestinationExecutor.ParseSuperDestinationExecutorExecuted(*l); err == nil && ev.Account == acct.Address {
+			return true
+		}
+	}
+	return false
+}
+
+func TestDevnet(t *testing.T) {
+	ctx := context.Background()
+	d, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	a, b := d.Chains[0], d.Chains[1]
+
+	acct, err := a.NewAccount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := b.Adopt(ctx, acct); err != nil {
+		t.Fatal(err)
+	}
+	receiver := common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+	// Across: 5 tokens bridged to the account's adapter on b, 3 of which
+	// the destination entry sends on.
+	amount := big.NewInt(5)
+	msg, sig, receipt := bridge(t, a, b, acct, "AcrossSendFundsAndExecuteOnDstHook", []common.Address{b.Token}, []*big.Int{amount}, receiver, big.NewInt(3), func(msg []byte) hooks.HookData {
+		return &hooks.AcrossSendFundsAndExecuteOnDst{
+			Value:              new(big.Int),
+			Recipient:          b.Addresses["AcrossV3Adapter"],
+			InputToken:         a.Token,
+			OutputToken:        b.Token,
+			InputAmount:        amount,
+			OutputAmount:       amount,
+			DestinationChainId: b.ChainID,
+			DestinationMessage: msg,
+		}
+	})
+
+	tr := intents.NewTracker()
+	if _, err := tr.Track(intents.SourceCall{Bridge: intents.BridgeAcross, SourceChain: a.ChainID.Uint64(), DestinationChain: b.ChainID.Uint64(), TxHash: receipt.TxHash, Message: msg}); err != nil {
+		t.Fatal(err)
+	}
+
+	deliveries, err := d.Relayer.Relay(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Error != "" || deliveries[0].SourceTx != receipt.TxHash {
+		t.Fatalf("deliveries = %+v", deliveries)
+	}
+	if !executed(t, b, acct, deliveries[0].Tx) {
+		t.Fatal("destination entry not executed")
+	}
+	if used, err := b.DestinationExecutor.IsMerkleRootUsed(nil, acct.Address, sig.MerkleRoot); err != nil || !used {
+		t.Fatalf("root used = %v, %v", used, err)
+	}
+	for holder, want := range map[common.Address]int64{acct.Address: 2, receiver: 3} {
+		if bal, err := b.TokenBalance(ctx, holder); err != nil || bal.Int64() != want {
+			t.Fatalf("%s balance = %v, %v", holder, bal, err)
+		}
+	}
+
+	head, err := b.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	dest := &intents.Destination{
+		ChainID:       b.ChainID.Uint64(),
+		Logs:          b.Client,
+		Executor:      b.Addresses["SuperDestinationExecutor"],
+		AcrossAdapter: b.Addresses["AcrossV3Adapter"],
+		Roots:         b.DestinationExecutor,
+	}
+	if err := tr.Sync(ctx, dest, head.Number.Uint64()); err != nil {
+		t.Fatal(err)
+	}
+	in, ok := tr.Intent(intents.Key{Account: acct.Address, MerkleRoot: sig.MerkleRoot})
+	if !ok || in.Status != intents.StatusExecuted {
+		t.Fatalf("intent = %+v", in)
+	}
+
+	// deBridge: native value bridged back from b to a through an order with
+	// an external call, whose entry sends on tokens the account holds on a.
+	if err := a.Mint(ctx, a.Auth, acct.Address, big.NewInt(7)); err != nil {
+		t.Fatal(err)
+	}
+	value := big.NewInt(1e18)
+	_, sig, _ = bridge(t, b, a, acct, "DeBridgeSendOrderAndExecuteOnDstHook", []common.Address{{}}, []*big.Int{value}, receiver, big.NewInt(7), func(msg []byte) hooks.HookData {
+		return &hooks.DeBridgeSendOrderAndExecuteOnDst{
+			Value:           value,
+			GiveAmount:      value,
+			Version:         ExternalCallVersion,
+			FallbackAddress: acct.Address,
+			ExecutorAddress: a.Addresses["DebridgeAdapter"],
+			// An empty destination message would drop the external call.
+			DestinationMessage: msg,
+			TakeAmount:         value,
+			TakeChainId:        a.ChainID,
+			ReceiverDst:        a.Addresses["DebridgeAdapter"].Bytes(),
+		}
+	})
+	before, err := a.Client.BalanceAt(ctx, acct.Address, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err = d.Relayer.Relay(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Error != "" || deliveries[0].OrderID == nil {
+		t.Fatalf("deliveries = %+v", deliveries)
+	}
+	if !executed(t, a, acct, deliveries[0].Tx) {
+		t.Fatal("destination entry not executed")
+	}
+	if used, err := a.DestinationExecutor.IsMerkleRootUsed(nil, acct.Address, sig.MerkleRoot); err != nil || !used {
+		t.Fatalf("root used = %v, %v", used, err)
+	}
+	after, err := a.Client.BalanceAt(ctx, acct.Address, nil)
+	if err != nil || new(big.Int).Sub(after, before).Cmp(value) != 0 {
+		t.Fatalf("native balance %v -> %v, %v", before, after, err)
+	}
+	if bal, err := a.TokenBalance(ctx, receiver); err != nil || bal.Int64() != 7 {
+		t.Fatalf("receiver balance = %v, %v", bal, err)
+	}
+
+	// Everything is relayed once.
+	if deliveries, err = d.Relayer.Relay(ctx); err != nil || len(deliveries) != 0 {
+		t.Fatalf("second relay = %+v, %v", deliveries, err)
+	}
+}
+
+func TestHandleOpRejectsSignature(t *testing.T) {
+	ctx := context.Background()
+	d, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	a, b := d.Chains[0], d.Chains[1]
+	acct, err := a.NewAccount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := sign(t, a, b, acct, common.Hash{1}, []common.Address{b.Token}, []*big.Int{big.NewInt(1)}, nil)
+	op := &userop.UserOp{Sender: acct.Address}
+	if op.Signature, err = sig.Encode(); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.HandleOp(ctx, acct, op, common.Hash{2}); err == nil {
+		t.Fatal("signature over another hash accepted")
+	}
+}
+
+func TestRelayUnknownChain(t *testing.T) {
+	ctx := context.Background()
+	d, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	a := d.Chains[0]
+
+	calldata, err := (&AcrossDeposit{OutputToken: a.Token, OutputAmount: big.NewInt(1), DestinationChainId: big.NewInt(1)}).Calldata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := a.Bridge(ctx, a.Auth, a.SpokePool, nil, calldata); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err := d.Relayer.Relay(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Error == "" {
+		t.Fatalf("deliveries = %+v", deliveries)
+	}
+}
+
+func TestRelayAfterFailedFill(t *testing.T) {
+	ctx := context.Background()
+	d, err := New(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer d.Close()
+	a, b := d.Chains[0], d.Chains[1]
+	receiver := common.HexToAddress("0x000000000000000000000000000000000000beef")
+
+	// A token deposit, then a native one the relayer cannot afford.
+	for _, token := range []common.Address{b.Token, {}} {
+		amount := big.NewInt(5)
+		if token == (common.Address{}) {
+			amount = harness.DefaultBalance
+		}
+		calldata, err := (&AcrossDeposit{Recipient: receiver, OutputToken: token, OutputAmount: amount, DestinationChainId: b.ChainID}).Calldata()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := a.Bridge(ctx, a.Auth, a.SpokePool, nil, calldata); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if deliveries, err := d.Relayer.Relay(ctx); err == nil {
+		t.Fatalf("deliveries = %+v", deliveries)
+	}
+
+	// The retry fills the native deposit only.
+	if err := b.Fund(ctx, d.Relayer.Address, harness.DefaultBalance); err != nil {
+		t.Fatal(err)
+	}
+	deliveries, err := d.Relayer.Relay(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(deliveries) != 1 || deliveries[0].Error != "" || deliveries[0].Token != (common.Address{}) {
+		t.Fatalf("deliveries = %+v", deliveries)
+	}
+	if bal, err := b.TokenBalance(ctx, receiver); err != nil || bal.Int64() != 5 {
+		t.Fatalf("token balance = %v, %v", bal, err)
+	}
+}
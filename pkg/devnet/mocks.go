@@ -0,0 +1,295 @@
+package devnet
+
+import (
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// The repository has no compiled spoke pool, DLN, ERC20 or ERC-7579 account
+// to deploy, so the devnet uses four small contracts written in EVM assembly.
+
+// BridgeCallTopic is the topic of the log the bridge entry mock emits for
+// every call: topics [BridgeCallTopic, caller, callvalue], data the raw
+// calldata.
+var BridgeCallTopic = crypto.Keccak256Hash([]byte("BridgeCall(address,uint256,bytes)"))
+
+// bridgeEntryAsm records every call, whatever its selector, and succeeds
+// with no return data. It stands in for the Across spoke pool and the DLN
+// source that the bridge hooks call on the source chain.
+var bridgeEntryAsm = `
+	CALLDATASIZE
+	PUSH 0
+	PUSH 0
+	CALLDATACOPY
+	CALLVALUE
+	CALLER
+	PUSH ` + BridgeCallTopic.Hex() + `
+	CALLDATASIZE
+	PUSH 0
+	LOG3
+	STOP
+`
+
+// dlnDestinationAsm answers every call, externalCallAdapter() included,
+// with the relayer address, so DebridgeAdapter accepts calls from it.
+const dlnDestinationAsm = `
+	PUSH %s
+	PUSH 0
+	MSTORE
+	PUSH 32
+	PUSH 0
+	RETURN
+`
+
+// tokenAsm is a mintable token with balanceOf(address), transfer(address,
+// uint256) and mint(address,uint256). Balances live at the slot equal to
+// the holder address. Anyone may mint.
+const tokenAsm = `
+	PUSH 0
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+	DUP1
+	PUSH 0x70a08231
+	EQ
+	JUMPI @balanceOf
+	DUP1
+	PUSH 0xa9059cbb
+	EQ
+	JUMPI @transfer
+	DUP1
+	PUSH 0x40c10f19
+	EQ
+	JUMPI @mint
+	JUMP @fail
+
+balanceOf:
+	PUSH 4
+	CALLDATALOAD
+	SLOAD
+	PUSH 0
+	MSTORE
+	PUSH 32
+	PUSH 0
+	RETURN
+
+transfer:
+	CALLER
+	SLOAD
+	PUSH 36
+	CALLDATALOAD
+	DUP1
+	DUP3
+	LT
+	JUMPI @fail
+	SWAP1
+	DUP2
+	SWAP1
+	SUB
+	CALLER
+	SSTORE
+	JUMP @credit
+
+mint:
+	PUSH 36
+	CALLDATALOAD
+
+credit:
+	PUSH 4
+	CALLDATALOAD
+	DUP1
+	SLOAD
+	DUP3
+	ADD
+	SWAP1
+	SSTORE
+	POP
+	PUSH 1
+	PUSH 0
+	MSTORE
+	PUSH 32
+	PUSH 0
+	RETURN
+
+fail:
+	PUSH 0
+	PUSH 0
+	REVERT
+`
+
+// tokenABI is the part of the ERC20 interface the token mock implements.
+var tokenABI, _ = abi.JSON(strings.NewReader(`[
+	{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"transfer","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"mint","stateMutability":"nonpayable","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]}
+]`))
+
+// accountAsm is the code accounts delegate to. It implements the ERC-7579
+// execute(bytes32,bytes) and executeFromExecutor(bytes32,bytes) entry points
+// for the single and batch call types, returning no results, and accepts
+// any other call, plain transfers included. Only the account itself and the
+// two executors given as format arguments, SuperExecutor and
+// SuperDestinationExecutor, may execute.
+const accountAsm = `
+	PUSH 0
+	CALLDATALOAD
+	PUSH 0xe0
+	SHR
+	DUP1
+	PUSH 0xe9ae5c53
+	EQ
+	JUMPI @execute
+	DUP1
+	PUSH 0xd691c964
+	EQ
+	JUMPI @execute
+	STOP
+
+execute:
+	CALLER
+	ADDRESS
+	EQ
+	CALLER
+	PUSH %s
+	EQ
+	OR
+	CALLER
+	PUSH %s
+	EQ
+	OR
+	ISZERO
+	JUMPI @fail
+	PUSH 0x24
+	CALLDATALOAD
+	PUSH 4
+	ADD
+	PUSH 4
+	CALLDATALOAD
+	PUSH 248
+	SHR
+	DUP1
+	ISZERO
+	JUMPI @single
+	PUSH 1
+	EQ
+	ISZERO
+	JUMPI @fail
+	PUSH 32
+	ADD
+	DUP1
+	CALLDATALOAD
+	ADD
+	DUP1
+	CALLDATALOAD
+	SWAP1
+	PUSH 32
+	ADD
+	PUSH 0
+
+loop:
+	DUP3
+	DUP2
+	LT
+	ISZERO
+	JUMPI @done
+	DUP2
+	DUP2
+	PUSH 32
+	MUL
+	DUP2
+	ADD
+	CALLDATALOAD
+	ADD
+	DUP1
+	PUSH 64
+	ADD
+	CALLDATALOAD
+	DUP2
+	ADD
+	DUP1
+	CALLDATALOAD
+	DUP1
+	SWAP2
+	PUSH 32
+	ADD
+	PUSH 0
+	CALLDATACOPY
+	PUSH 0
+	PUSH 0
+	DUP3
+	PUSH 0
+	DUP6
+	PUSH 32
+	ADD
+	CALLDATALOAD
+	DUP7
+	CALLDATALOAD
+	GAS
+	CALL
+	ISZERO
+	JUMPI @bubble
+	POP
+	POP
+	PUSH 1
+	ADD
+	JUMP @loop
+
+single:
+	POP
+	DUP1
+	CALLDATALOAD
+	PUSH 52
+	SWAP1
+	SUB
+	DUP1
+	DUP3
+	PUSH 84
+	ADD
+	PUSH 0
+	CALLDATACOPY
+	PUSH 0
+	PUSH 0
+	DUP3
+	PUSH 0
+	DUP6
+	PUSH 52
+	ADD
+	CALLDATALOAD
+	DUP7
+	PUSH 32
+	ADD
+	CALLDATALOAD
+	PUSH 96
+	SHR
+	GAS
+	CALL
+	ISZERO
+	JUMPI @bubble
+
+done:
+	PUSH 32
+	PUSH 0
+	MSTORE
+	PUSH 0
+	PUSH 32
+	MSTORE
+	PUSH 64
+	PUSH 0
+	RETURN
+
+bubble:
+	RETURNDATASIZE
+	PUSH 0
+	PUSH 0
+	RETURNDATACOPY
+	RETURNDATASIZE
+	PUSH 0
+	REVERT
+
+fail:
+	PUSH 0
+	PUSH 0
+	REVERT
+`
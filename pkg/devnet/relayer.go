@@ -0,0 +1,295 @@
+package devnet
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/AcrossV3Adapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/DebridgeAdapter"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/intents"
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+)
+
+var (
+	acrossAdapterABI, _   = AcrossV3Adapter.AcrossV3AdapterMetaData.GetAbi()
+	debridgeAdapterABI, _ = DebridgeAdapter.DebridgeAdapterMetaData.GetAbi()
+)
+
+// Delivery is one bridge deposit and its fill on the destination chain.
+type Delivery struct {
+	Bridge           intents.Bridge `json:"bridge"`
+	SourceChain      uint64         `json:"sourceChain"`
+	SourceTx         common.Hash    `json:"sourceTx"`
+	LogIndex         uint           `json:"logIndex"`
+	DestinationChain uint64         `json:"destinationChain"`
+	// Recipient is the destination adapter, or the plain receiver of a
+	// deposit without message.
+	Recipient common.Address `json:"recipient"`
+	// Token is the destination token, native when zero.
+	Token   common.Address `json:"token"`
+	Amount  *big.Int       `json:"amount"`
+	Message hexutil.Bytes  `json:"message,omitempty"`
+	// OrderID is the DLN order ID passed to DebridgeAdapter.
+	OrderID *common.Hash `json:"orderId,omitempty"`
+	// Tx is the last fill transaction sent.
+	Tx *common.Hash `json:"tx,omitempty"`
+	// Error is set when the fill could not be made or reverted.
+	Error string `json:"error,omitempty"`
+
+	fallback common.Address
+}
+
+// Relayer fills the deposits recorded by the bridge entries of every devnet
+// chain. It acts as the Across spoke pool and the DLN external call adapter
+// of every chain: it mints the output token to the adapter, or sends native
+// value, then calls the adapter with the deposit message.
+type Relayer struct {
+	Key     *ecdsa.PrivateKey
+	Address common.Address
+
+	mu     sync.Mutex
+	chains map[uint64]*Chain
+	auth   map[uint64]*bind.TransactOpts
+	// next is the first block of each chain not yet relayed in full, and
+	// skip the number of its logs already filled.
+	next map[uint64]uint64
+	skip map[uint64]uint
+}
+
+func newRelayer(key *ecdsa.PrivateKey) *Relayer {
+	return &Relayer{
+		Key:     key,
+		Address: crypto.PubkeyToAddress(key.PublicKey),
+		chains:  make(map[uint64]*Chain),
+		auth:    make(map[uint64]*bind.TransactOpts),
+		next:    make(map[uint64]uint64),
+		skip:    make(map[uint64]uint),
+	}
+}
+
+// add funds the relayer on c and starts watching it.
+func (r *Relayer) add(ctx context.Context, c *Chain) error {
+	if err := c.Fund(ctx, r.Address, harness.DefaultBalance); err != nil {
+		return fmt.Errorf("devnet: fund relayer: %w", err)
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(r.Key, c.ChainID)
+	if err != nil {
+		return err
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	id := c.ChainID.Uint64()
+	r.chains[id], r.auth[id] = c, auth
+	return nil
+}
+
+// Relay fills every deposit recorded since the last call, chain by chain in
+// chain ID order. Fill failures are reported on the deliveries; only RPC
+// failures are returned as errors. Each deposit is filled at most once: a
+// deposit is not retried once a fill transaction has been mined for it, even
+// if Relay then failed.
+func (r *Relayer) Relay(ctx context.Context) ([]Delivery, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	var out []Delivery
+	for _, id := range chainIDs(r.chains) {
+		c := r.chains[id]
+		head, err := c.Client.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return out, fmt.Errorf("devnet: chain %d head: %w", id, err)
+		}
+		from, to := r.next[id], head.Number.Uint64()
+		if from > to {
+			continue
+		}
+		logs, err := c.Client.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(to),
+			Addresses: []common.Address{c.SpokePool, c.DlnSource},
+			Topics:    [][]common.Hash{{BridgeCallTopic}},
+		})
+		if err != nil {
+			return out, fmt.Errorf("devnet: chain %d logs: %w", id, err)
+		}
+		for _, l := range logs {
+			if l.BlockNumber == from && l.Index < r.skip[id] {
+				continue
+			}
+			d, ok := parseBridgeCall(id, l)
+			if !ok {
+				continue
+			}
+			err := r.fill(ctx, d)
+			if err == nil || d.Tx != nil {
+				r.next[id], r.skip[id] = l.BlockNumber, l.Index+1
+			}
+			if err != nil {
+				return out, err
+			}
+			out = append(out, *d)
+		}
+		r.next[id], r.skip[id] = to+1, 0
+	}
+	return out, nil
+}
+
+// Watch relays every interval and hands each delivery to fn until ctx is
+// done.
+func (r *Relayer) Watch(ctx context.Context, interval time.Duration, fn func(Delivery)) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		deliveries, err := r.Relay(ctx)
+		for _, d := range deliveries {
+			fn(d)
+		}
+		if err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// parseBridgeCall decodes a bridge entry log. Calls other than depositV3Now
+// and createOrder are skipped; undecodable ones are reported as failed
+// deliveries.
+func parseBridgeCall(chain uint64, l types.Log) (*Delivery, bool) {
+	if len(l.Data) < 4 {
+		return nil, false
+	}
+	method, err := bridgeABI.MethodById(l.Data[:4])
+	if err != nil {
+		return nil, false
+	}
+	d := &Delivery{SourceChain: chain, SourceTx: l.TxHash, LogIndex: l.Index}
+	args, err := method.Inputs.Unpack(l.Data[4:])
+	if err != nil {
+		d.Error = fmt.Sprintf("decode %s: %v", method.Name, err)
+		return d, true
+	}
+	switch method.Name {
+	case "depositV3Now":
+		var dep AcrossDeposit
+		if err := method.Inputs.Copy(&dep, args); err != nil {
+			d.Error = fmt.Sprintf("decode %s: %v", method.Name, err)
+			return d, true
+		}
+		d.Bridge = intents.BridgeAcross
+		d.DestinationChain = dep.DestinationChainId.Uint64()
+		d.Recipient, d.Token, d.Amount, d.Message = dep.Recipient, dep.OutputToken, dep.OutputAmount, dep.Message
+	case "createOrder":
+		order := abi.ConvertType(args[0], new(OrderCreation)).(*OrderCreation)
+		id := crypto.Keccak256Hash(new(big.Int).SetUint64(chain).Bytes(), l.TxHash[:], new(big.Int).SetUint64(uint64(l.Index)).Bytes())
+		d.Bridge, d.OrderID = intents.BridgeDebridge, &id
+		d.DestinationChain = order.TakeChainId.Uint64()
+		d.Recipient = common.BytesToAddress(order.ReceiverDst)
+		d.Token, d.Amount = common.BytesToAddress(order.TakeTokenAddress), order.TakeAmount
+		if len(order.ExternalCall) > 0 {
+			ext, err := DecodeExternalCall(order.ExternalCall)
+			if err != nil {
+				d.Error = err.Error()
+				return d, true
+			}
+			if ext.ExecutorAddress != (common.Address{}) {
+				d.Recipient = ext.ExecutorAddress
+			}
+			d.Message, d.fallback = ext.Payload, ext.FallbackAddress
+		}
+	}
+	return d, true
+}
+
+// fill delivers d on its destination chain.
+func (r *Relayer) fill(ctx context.Context, d *Delivery) error {
+	if d.Error != "" {
+		return nil
+	}
+	c, ok := r.chains[d.DestinationChain]
+	if !ok {
+		d.Error = fmt.Sprintf("unknown destination chain %d", d.DestinationChain)
+		return nil
+	}
+	native := d.Token == (common.Address{})
+	if !native {
+		data, err := tokenABI.Pack("mint", d.Recipient, d.Amount)
+		if err != nil {
+			return err
+		}
+		if err := r.send(ctx, c, d, d.Token, nil, data); err != nil || d.Error != "" {
+			return err
+		}
+	}
+
+	var (
+		value *big.Int
+		data  []byte
+		err   error
+	)
+	switch {
+	case len(d.Message) == 0:
+		if !native {
+			return nil
+		}
+		value = d.Amount
+	case d.Bridge == intents.BridgeAcross:
+		if native {
+			d.Error = "native Across output is not supported"
+			return nil
+		}
+		data, err = acrossAdapterABI.Pack("handleV3AcrossMessage", d.Token, d.Amount, r.Address, []byte(d.Message))
+	case native:
+		value = d.Amount
+		data, err = debridgeAdapterABI.Pack("onEtherReceived", *d.OrderID, d.fallback, []byte(d.Message))
+	default:
+		data, err = debridgeAdapterABI.Pack("onERC20Received", *d.OrderID, d.Token, d.Amount, d.fallback, []byte(d.Message))
+	}
+	if err != nil {
+		return err
+	}
+	return r.send(ctx, c, d, d.Recipient, value, data)
+}
+
+// send transacts from the relayer on c and mines the transaction. Reverts
+// are recorded on d.
+func (r *Relayer) send(ctx context.Context, c *Chain, d *Delivery, to common.Address, value *big.Int, data []byte) error {
+	opts := *r.auth[d.DestinationChain]
+	opts.Context, opts.Value = ctx, value
+	// bind only estimates gas for calls to contracts, and native deposits
+	// without message go to plain receivers.
+	gas, err := c.Client.EstimateGas(ctx, ethereum.CallMsg{From: opts.From, To: &to, Value: value, Data: data})
+	var tx *types.Transaction
+	if err == nil {
+		opts.GasLimit = gas
+		tx, err = bind.NewBoundContract(to, abi.ABI{}, c.Client, c.Client, c.Client).RawTransact(&opts, data)
+	}
+	if err != nil {
+		if _, ok := reverts.Data(err); !ok {
+			return fmt.Errorf("devnet: fill on chain %d: %w", d.DestinationChain, err)
+		}
+		d.Error = reverts.Wrap(err).Error()
+		return nil
+	}
+	hash := tx.Hash()
+	d.Tx = &hash
+	if _, err := c.Mine(ctx, tx); err != nil {
+		d.Error = err.Error()
+	}
+	return nil
+}
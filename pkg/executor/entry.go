@@ -0,0 +1,124 @@
+// Package executor provides Go types and encoders for the payloads accepted by
+// SuperExecutor and SuperDestinationExecutor.
+package executor
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutor"
+)
+
+var (
+	// ErrNoHooks mirrors ISuperExecutor.NO_HOOKS.
+	ErrNoHooks = errors.New("executor: entry has no hooks")
+	// ErrLengthMismatch mirrors ISuperExecutor.LENGTH_MISMATCH.
+	ErrLengthMismatch = errors.New("executor: hooksAddresses and hooksData length mismatch")
+	// ErrZeroHook mirrors ISuperExecutor.ADDRESS_NOT_VALID for a zero hook address.
+	ErrZeroHook = errors.New("executor: zero hook address")
+)
+
+// ExecutorEntry is the Go counterpart of ISuperExecutor.ExecutorEntry.
+type ExecutorEntry struct {
+	HooksAddresses []common.Address
+	HooksData      [][]byte
+}
+
+// entryArgs is the ABI argument list for `abi.encode(ExecutorEntry)`.
+var entryArgs = func() abi.Arguments {
+	typ, err := abi.NewType("tuple", "struct ISuperExecutor.ExecutorEntry", []abi.ArgumentMarshaling{
+		{Name: "hooksAddresses", Type: "address[]"},
+		{Name: "hooksData", Type: "bytes[]"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Name: "entry", Type: typ}}
+}()
+
+// NewExecutorEntry returns an empty entry ready to be filled with Append.
+func NewExecutorEntry() *ExecutorEntry {
+	return &ExecutorEntry{}
+}
+
+// Append adds a hook and its data to the end of the entry.
+func (e *ExecutorEntry) Append(hook common.Address, data []byte) *ExecutorEntry {
+	e.HooksAddresses = append(e.HooksAddresses, hook)
+	e.HooksData = append(e.HooksData, data)
+	return e
+}
+
+// Len returns the number of hooks in the entry.
+func (e *ExecutorEntry) Len() int {
+	return len(e.HooksAddresses)
+}
+
+// Validate performs the same structural checks as SuperExecutorBase._execute.
+func (e *ExecutorEntry) Validate() error {
+	if len(e.HooksAddresses) == 0 {
+		return ErrNoHooks
+	}
+	if len(e.HooksAddresses) != len(e.HooksData) {
+		return ErrLengthMismatch
+	}
+	for i, hook := range e.HooksAddresses {
+		if hook == (common.Address{}) {
+			return fmt.Errorf("%w at index %d", ErrZeroHook, i)
+		}
+	}
+	return nil
+}
+
+// Encode returns `abi.encode(entry)`, the payload expected by
+// SuperExecutor.execute and SuperDestinationExecutor.execute.
+func (e *ExecutorEntry) Encode() ([]byte, error) {
+	return EncodeExecutorEntry(e)
+}
+
+// EncodeExecutorEntry ABI-encodes an entry exactly as Solidity's
+// `abi.encode(ISuperExecutor.ExecutorEntry)`. No semantic validation is
+// performed; call Validate first to reject entries the executor would revert on.
+func EncodeExecutorEntry(e *ExecutorEntry) ([]byte, error) {
+	if e == nil {
+		return nil, errors.New("executor: nil entry")
+	}
+	addrs := e.HooksAddresses
+	if addrs == nil {
+		addrs = []common.Address{}
+	}
+	data := e.HooksData
+	if data == nil {
+		data = [][]byte{}
+	}
+	return entryArgs.Pack(struct {
+		HooksAddresses []common.Address
+		HooksData      [][]byte
+	}{addrs, data})
+}
+
+// DecodeExecutorEntry decodes `abi.encode(ISuperExecutor.ExecutorEntry)`.
+func DecodeExecutorEntry(data []byte) (*ExecutorEntry, error) {
+	values, err := entryArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("executor: decode entry: %w", err)
+	}
+	entry := *abi.ConvertType(values[0], new(ExecutorEntry)).(*ExecutorEntry)
+	return &entry, nil
+}
+
+// ExecuteCalldata returns the calldata for `SuperExecutor.execute(abi.encode(entry))`.
+// The selector is shared with SuperDestinationExecutor.execute.
+func (e *ExecutorEntry) ExecuteCalldata() ([]byte, error) {
+	encoded, err := e.Encode()
+	if err != nil {
+		return nil, err
+	}
+	parsed, err := SuperExecutor.SuperExecutorMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	return parsed.Pack("execute", encoded)
+}
@@ -0,0 +1,113 @@
+package executor
+
+import (
+	"bytes"
+	"encoding/hex"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// words joins 32-byte hex words into a single byte slice.
+func words(t *testing.T, ws ...string) []byte {
+	t.Helper()
+	b, err := hex.DecodeString(strings.Join(ws, ""))
+	if err != nil {
+		t.Fatal(err)
+	}
+	return b
+}
+
+// Golden vectors come from SuperExecutorTest.test_WrongData in
+// test/unit/executors/SuperExecutor.t.sol: abi.encode of an empty entry is
+// 160 bytes and its execute calldata 228 (EMPTY_EXECUTION_LENGTH), and the
+// hand-crafted alternativeEntryData of the same length decodes to one zero
+// hook with empty data, which _execute rejects with ADDRESS_NOT_VALID.
+func TestEncodeExecutorEntryGolden(t *testing.T) {
+	empty := words(t,
+		"0000000000000000000000000000000000000000000000000000000000000020",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000060",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+	)
+	got, err := NewExecutorEntry().Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, empty) {
+		t.Fatalf("empty entry\n got %x\nwant %x", got, empty)
+	}
+	calldata, err := NewExecutorEntry().ExecuteCalldata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calldata) != 228 {
+		t.Fatalf("execute calldata length = %d, want 228", len(calldata))
+	}
+
+	alternative := words(t,
+		"0000000000000000000000000000000000000000000000000000000000000020",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000040",
+		"0000000000000000000000000000000000000000000000000000000000000001",
+		"0000000000000000000000000000000000000000000000000000000000000000",
+	)
+	decoded, err := DecodeExecutorEntry(alternative)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Len() != 1 || decoded.HooksAddresses[0] != (common.Address{}) || len(decoded.HooksData[0]) != 0 {
+		t.Fatalf("alternativeEntryData = %+v", decoded)
+	}
+	if err := decoded.Validate(); !errors.Is(err, ErrZeroHook) {
+		t.Fatalf("alternativeEntryData: %v", err)
+	}
+}
+
+func TestEncodeRoundTrip(t *testing.T) {
+	entry := NewExecutorEntry().
+		Append(common.HexToAddress("0x1111111111111111111111111111111111111111"), common.FromHex("0xeeeeeeee")).
+		Append(common.HexToAddress("0x2222222222222222222222222222222222222222"), common.FromHex("0xdddddddd"))
+	data, err := entry.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := DecodeExecutorEntry(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for i := range entry.HooksAddresses {
+		if decoded.HooksAddresses[i] != entry.HooksAddresses[i] || !bytes.Equal(decoded.HooksData[i], entry.HooksData[i]) {
+			t.Fatalf("hook %d round-trip mismatch", i)
+		}
+	}
+}
+
+func TestExecuteCalldata(t *testing.T) {
+	entry := NewExecutorEntry().Append(common.HexToAddress("0x1111111111111111111111111111111111111111"), []byte{0xee})
+	calldata, err := entry.ExecuteCalldata()
+	if err != nil {
+		t.Fatal(err)
+	}
+	// bytes4(keccak256("execute(bytes)"))
+	if got := hex.EncodeToString(calldata[:4]); got != "09c5eabe" {
+		t.Fatalf("selector = %s", got)
+	}
+}
+
+func TestValidate(t *testing.T) {
+	if err := NewExecutorEntry().Validate(); !errors.Is(err, ErrNoHooks) {
+		t.Fatalf("empty entry: %v", err)
+	}
+	mismatch := &ExecutorEntry{HooksAddresses: []common.Address{{1}}}
+	if err := mismatch.Validate(); !errors.Is(err, ErrLengthMismatch) {
+		t.Fatalf("mismatch: %v", err)
+	}
+	zero := NewExecutorEntry().Append(common.Address{}, nil)
+	if err := zero.Validate(); !errors.Is(err, ErrZeroHook) {
+		t.Fatalf("zero hook: %v", err)
+	}
+}
@@ -0,0 +1,133 @@
+package governance
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	"net/http"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Kind classifies an alert.
+type Kind string
+
+const (
+	KindProposalSet             Kind = "proposal_set"
+	KindProposalCancelled       Kind = "proposal_cancelled"
+	KindProposalAccepted        Kind = "proposal_accepted"
+	KindManagerTransferStarted  Kind = "manager_transfer_started"
+	KindManagerTransferAccepted Kind = "manager_transfer_accepted"
+	KindFeeIncrease             Kind = "fee_increase"
+	KindLedgerSwap              Kind = "ledger_swap"
+	KindUnknownManager          Kind = "unknown_manager"
+)
+
+// Severity ranks alerts.
+type Severity string
+
+const (
+	SeverityInfo     Severity = "info"
+	SeverityWarning  Severity = "warning"
+	SeverityCritical Severity = "critical"
+)
+
+// Alert is a governance event worth reporting.
+type Alert struct {
+	Kind                Kind        `json:"kind"`
+	Severity            Severity    `json:"severity"`
+	YieldSourceOracleId common.Hash `json:"yieldSourceOracleId"`
+	Message             string      `json:"message"`
+	Changes             []Change    `json:"changes,omitempty"`
+	Block               uint64      `json:"block"`
+	TxHash              common.Hash `json:"txHash"`
+}
+
+// Sink receives alerts.
+type Sink interface {
+	Send(ctx context.Context, a Alert) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(ctx context.Context, a Alert) error
+
+// Send implements Sink.
+func (f SinkFunc) Send(ctx context.Context, a Alert) error { return f(ctx, a) }
+
+// LogSink writes alerts to a structured logger.
+type LogSink struct {
+	Logger *slog.Logger
+}
+
+// Send implements Sink.
+func (s *LogSink) Send(ctx context.Context, a Alert) error {
+	level := slog.LevelInfo
+	switch a.Severity {
+	case SeverityWarning:
+		level = slog.LevelWarn
+	case SeverityCritical:
+		level = slog.LevelError
+	}
+	logger := s.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	logger.Log(ctx, level, a.Message,
+		"kind", a.Kind,
+		"yieldSourceOracleId", a.YieldSourceOracleId,
+		"changes", a.Changes,
+		"block", a.Block,
+		"tx", a.TxHash,
+	)
+	return nil
+}
+
+// WebhookSink posts alerts as JSON to a URL.
+type WebhookSink struct {
+	URL string
+	// Client defaults to http.DefaultClient.
+	Client *http.Client
+	// MinSeverity drops less severe alerts; empty sends everything.
+	MinSeverity Severity
+}
+
+// Send implements Sink.
+func (s *WebhookSink) Send(ctx context.Context, a Alert) error {
+	if rank(a.Severity) < rank(s.MinSeverity) {
+		return nil
+	}
+	body, err := json.Marshal(a)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.URL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	client := s.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	resp, err := client.Do(req)
+	if err != nil {
+		return err
+	}
+	resp.Body.Close()
+	if resp.StatusCode/100 != 2 {
+		return fmt.Errorf("webhook %s: status %s", s.URL, resp.Status)
+	}
+	return nil
+}
+
+func rank(s Severity) int {
+	switch s {
+	case SeverityWarning:
+		return 1
+	case SeverityCritical:
+		return 2
+	}
+	return 0
+}
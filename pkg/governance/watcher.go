@@ -0,0 +1,358 @@
+// Package governance watches SuperLedgerConfiguration for configuration
+// proposals and manager transfers, and raises alerts on risky changes.
+package governance
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/event"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+)
+
+// Config is a yield source oracle configuration as stored on-chain.
+type Config = SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfig
+
+// Change is one field that differs between the current configuration and a
+// proposal.
+type Change struct {
+	Field string `json:"field"`
+	From  string `json:"from"`
+	To    string `json:"to"`
+}
+
+// Diff lists the fields that differ between current and proposed.
+func Diff(current, proposed Config) []Change {
+	var out []Change
+	addr := func(field string, a, b common.Address) {
+		if a != b {
+			out = append(out, Change{Field: field, From: a.Hex(), To: b.Hex()})
+		}
+	}
+	addr("yieldSourceOracle", current.YieldSourceOracle, proposed.YieldSourceOracle)
+	if cmpBig(current.FeePercent, proposed.FeePercent) != 0 {
+		out = append(out, Change{Field: "feePercent", From: bigString(current.FeePercent), To: bigString(proposed.FeePercent)})
+	}
+	addr("feeRecipient", current.FeeRecipient, proposed.FeeRecipient)
+	addr("manager", current.Manager, proposed.Manager)
+	addr("ledger", current.Ledger, proposed.Ledger)
+	return out
+}
+
+// Proposal is a pending configuration change for one yield source oracle ID.
+type Proposal struct {
+	ID       common.Hash `json:"yieldSourceOracleId"`
+	Current  Config      `json:"current"`
+	Proposed Config      `json:"proposed"`
+	Changes  []Change    `json:"changes"`
+	Block    uint64      `json:"block"`
+	TxHash   common.Hash `json:"txHash"`
+}
+
+// ManagerTransfer is a started but not yet accepted manager role transfer.
+type ManagerTransfer struct {
+	ID         common.Hash    `json:"yieldSourceOracleId"`
+	Current    common.Address `json:"currentManager"`
+	NewManager common.Address `json:"newManager"`
+	Block      uint64         `json:"block"`
+	TxHash     common.Hash    `json:"txHash"`
+}
+
+// Watcher tracks pending proposals and manager transfers on a
+// SuperLedgerConfiguration and sends alerts to its sinks.
+type Watcher struct {
+	configuration *SuperLedgerConfiguration.SuperLedgerConfiguration
+	sinks         []Sink
+
+	// KnownManagers and KnownLedgers are the addresses considered safe. An
+	// empty set disables the corresponding alert.
+	KnownManagers map[common.Address]bool
+	KnownLedgers  map[common.Address]bool
+
+	// Logger records sink failures; slog.Default() when nil.
+	Logger *slog.Logger
+
+	mu        sync.Mutex
+	proposals map[common.Hash]*Proposal
+	transfers map[common.Hash]*ManagerTransfer
+}
+
+// NewWatcher returns a watcher for the configuration contract, alerting to
+// sinks.
+func NewWatcher(configuration *SuperLedgerConfiguration.SuperLedgerConfiguration, sinks ...Sink) *Watcher {
+	return &Watcher{
+		configuration: configuration,
+		sinks:         sinks,
+		proposals:     make(map[common.Hash]*Proposal),
+		transfers:     make(map[common.Hash]*ManagerTransfer),
+	}
+}
+
+// Proposals returns the pending proposals ordered by ID.
+func (w *Watcher) Proposals() []Proposal {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	out := make([]Proposal, 0, len(w.proposals))
+	for _, p := range w.proposals {
+		out = append(out, *p)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Cmp(out[j].ID) < 0 })
+	return out
+}
+
+// Transfers returns the pending manager transfers ordered by ID.
+func (w *Watcher) Transfers() []ManagerTransfer {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	out := make([]ManagerTransfer, 0, len(w.transfers))
+	for _, t := range w.transfers {
+		out = append(out, *t)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].ID.Cmp(out[j].ID) < 0 })
+	return out
+}
+
+// Run subscribes to the configuration's governance events from start (nil
+// for new blocks only) and processes them until ctx is cancelled, a
+// subscription fails or a configuration read fails. Sink failures are
+// logged and do not stop it.
+func (w *Watcher) Run(ctx context.Context, start *uint64) error {
+	opts := &bind.WatchOpts{Start: start, Context: ctx}
+	var (
+		proposed  = make(chan *SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigProposalSet)
+		cancelled = make(chan *SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigProposalCancelled)
+		accepted  = make(chan *SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigAccepted)
+		started   = make(chan *SuperLedgerConfiguration.SuperLedgerConfigurationManagerRoleTransferStarted)
+		taken     = make(chan *SuperLedgerConfiguration.SuperLedgerConfigurationManagerRoleTransferAccepted)
+	)
+	f := &w.configuration.SuperLedgerConfigurationFilterer
+	var subs []event.Subscription
+	defer func() {
+		for _, s := range subs {
+			s.Unsubscribe()
+		}
+	}()
+	for _, sub := range []func() (event.Subscription, error){
+		func() (event.Subscription, error) {
+			return f.WatchYieldSourceOracleConfigProposalSet(opts, proposed, nil, nil)
+		},
+		func() (event.Subscription, error) {
+			return f.WatchYieldSourceOracleConfigProposalCancelled(opts, cancelled, nil)
+		},
+		func() (event.Subscription, error) {
+			return f.WatchYieldSourceOracleConfigAccepted(opts, accepted, nil, nil)
+		},
+		func() (event.Subscription, error) {
+			return f.WatchManagerRoleTransferStarted(opts, started, nil, nil, nil)
+		},
+		func() (event.Subscription, error) { return f.WatchManagerRoleTransferAccepted(opts, taken, nil, nil) },
+	} {
+		s, err := sub()
+		if err != nil {
+			return fmt.Errorf("governance: subscribe: %w", err)
+		}
+		subs = append(subs, s)
+	}
+
+	errs := make(chan error, len(subs))
+	for _, s := range subs {
+		go func(s event.Subscription) {
+			if err, ok := <-s.Err(); ok && err != nil {
+				errs <- err
+			}
+		}(s)
+	}
+
+	for {
+		var err error
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case err = <-errs:
+			return fmt.Errorf("governance: subscription: %w", err)
+		case ev := <-proposed:
+			err = w.HandleProposalSet(ctx, ev)
+		case ev := <-cancelled:
+			err = w.HandleProposalCancelled(ctx, ev)
+		case ev := <-accepted:
+			err = w.HandleAccepted(ctx, ev)
+		case ev := <-started:
+			err = w.HandleManagerTransferStarted(ctx, ev)
+		case ev := <-taken:
+			err = w.HandleManagerTransferAccepted(ctx, ev)
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// HandleProposalSet records a proposal, diffs it against the current
+// configuration at the proposal's block and alerts on risky changes.
+func (w *Watcher) HandleProposalSet(ctx context.Context, ev *SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigProposalSet) error {
+	current, err := w.configuration.GetYieldSourceOracleConfig(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(ev.Raw.BlockNumber)}, ev.YieldSourceOracleId)
+	if err != nil {
+		return fmt.Errorf("governance: getYieldSourceOracleConfig(%x): %w", ev.YieldSourceOracleId, err)
+	}
+	p := &Proposal{
+		ID:      ev.YieldSourceOracleId,
+		Current: current,
+		Proposed: Config{
+			YieldSourceOracle: ev.YieldSourceOracle,
+			FeePercent:        ev.FeePercent,
+			FeeRecipient:      ev.FeeRecipient,
+			Manager:           ev.Manager,
+			Ledger:            ev.Ledger,
+		},
+		Block:  ev.Raw.BlockNumber,
+		TxHash: ev.Raw.TxHash,
+	}
+	p.Changes = Diff(p.Current, p.Proposed)
+
+	w.mu.Lock()
+	w.proposals[p.ID] = p
+	w.mu.Unlock()
+
+	alerts := []Alert{w.alert(KindProposalSet, SeverityInfo, p.ID, ev.Raw, "configuration change proposed", p.Changes)}
+	if cmpBig(p.Proposed.FeePercent, p.Current.FeePercent) > 0 {
+		alerts = append(alerts, w.alert(KindFeeIncrease, SeverityCritical, p.ID, ev.Raw,
+			fmt.Sprintf("fee increase proposed: %s -> %s bps", bigString(p.Current.FeePercent), bigString(p.Proposed.FeePercent)), p.Changes))
+	}
+	if p.Proposed.Ledger != p.Current.Ledger {
+		sev := SeverityWarning
+		if len(w.KnownLedgers) > 0 && !w.KnownLedgers[p.Proposed.Ledger] {
+			sev = SeverityCritical
+		}
+		alerts = append(alerts, w.alert(KindLedgerSwap, sev, p.ID, ev.Raw,
+			fmt.Sprintf("ledger swap proposed: %s -> %s", p.Current.Ledger.Hex(), p.Proposed.Ledger.Hex()), p.Changes))
+	}
+	if w.unknownManager(ev.Manager) {
+		alerts = append(alerts, w.alert(KindUnknownManager, SeverityCritical, p.ID, ev.Raw,
+			"proposal by unknown manager "+ev.Manager.Hex(), p.Changes))
+	}
+	w.send(ctx, alerts...)
+	return nil
+}
+
+// HandleProposalCancelled drops a pending proposal.
+func (w *Watcher) HandleProposalCancelled(ctx context.Context, ev *SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigProposalCancelled) error {
+	w.mu.Lock()
+	delete(w.proposals, ev.YieldSourceOracleId)
+	w.mu.Unlock()
+	w.send(ctx, w.alert(KindProposalCancelled, SeverityInfo, ev.YieldSourceOracleId, ev.Raw, "proposal cancelled", nil))
+	return nil
+}
+
+// HandleAccepted drops an accepted proposal and reports what took effect.
+func (w *Watcher) HandleAccepted(ctx context.Context, ev *SuperLedgerConfiguration.SuperLedgerConfigurationYieldSourceOracleConfigAccepted) error {
+	w.mu.Lock()
+	p := w.proposals[ev.YieldSourceOracleId]
+	delete(w.proposals, ev.YieldSourceOracleId)
+	w.mu.Unlock()
+
+	var changes []Change
+	if p != nil {
+		changes = p.Changes
+	}
+	w.send(ctx, w.alert(KindProposalAccepted, SeverityWarning, ev.YieldSourceOracleId, ev.Raw, "proposal accepted", changes))
+	return nil
+}
+
+// HandleManagerTransferStarted records a pending manager transfer.
+func (w *Watcher) HandleManagerTransferStarted(ctx context.Context, ev *SuperLedgerConfiguration.SuperLedgerConfigurationManagerRoleTransferStarted) error {
+	w.mu.Lock()
+	w.transfers[ev.YieldSourceOracleId] = &ManagerTransfer{
+		ID:         ev.YieldSourceOracleId,
+		Current:    ev.CurrentManager,
+		NewManager: ev.NewManager,
+		Block:      ev.Raw.BlockNumber,
+		TxHash:     ev.Raw.TxHash,
+	}
+	w.mu.Unlock()
+
+	changes := []Change{{Field: "manager", From: ev.CurrentManager.Hex(), To: ev.NewManager.Hex()}}
+	alerts := []Alert{w.alert(KindManagerTransferStarted, SeverityWarning, ev.YieldSourceOracleId, ev.Raw, "manager transfer started", changes)}
+	if w.unknownManager(ev.NewManager) {
+		alerts = append(alerts, w.alert(KindUnknownManager, SeverityCritical, ev.YieldSourceOracleId, ev.Raw,
+			"manager transfer to unknown address "+ev.NewManager.Hex(), changes))
+	}
+	w.send(ctx, alerts...)
+	return nil
+}
+
+// HandleManagerTransferAccepted completes a pending manager transfer.
+func (w *Watcher) HandleManagerTransferAccepted(ctx context.Context, ev *SuperLedgerConfiguration.SuperLedgerConfigurationManagerRoleTransferAccepted) error {
+	w.mu.Lock()
+	t := w.transfers[ev.YieldSourceOracleId]
+	delete(w.transfers, ev.YieldSourceOracleId)
+	w.mu.Unlock()
+
+	var changes []Change
+	if t != nil {
+		changes = []Change{{Field: "manager", From: t.Current.Hex(), To: ev.NewManager.Hex()}}
+	}
+	alerts := []Alert{w.alert(KindManagerTransferAccepted, SeverityWarning, ev.YieldSourceOracleId, ev.Raw, "manager transfer accepted", changes)}
+	if w.unknownManager(ev.NewManager) {
+		alerts = append(alerts, w.alert(KindUnknownManager, SeverityCritical, ev.YieldSourceOracleId, ev.Raw,
+			"unknown manager "+ev.NewManager.Hex()+" now controls the configuration", changes))
+	}
+	w.send(ctx, alerts...)
+	return nil
+}
+
+func (w *Watcher) unknownManager(m common.Address) bool {
+	return len(w.KnownManagers) > 0 && !w.KnownManagers[m]
+}
+
+func (w *Watcher) alert(kind Kind, sev Severity, id common.Hash, log types.Log, msg string, changes []Change) Alert {
+	return Alert{
+		Kind:                kind,
+		Severity:            sev,
+		YieldSourceOracleId: id,
+		Message:             msg,
+		Changes:             changes,
+		Block:               log.BlockNumber,
+		TxHash:              log.TxHash,
+	}
+}
+
+// send delivers alerts to every sink, logging the sinks that fail.
+func (w *Watcher) send(ctx context.Context, alerts ...Alert) {
+	logger := w.Logger
+	if logger == nil {
+		logger = slog.Default()
+	}
+	for _, a := range alerts {
+		for i, s := range w.sinks {
+			if err := s.Send(ctx, a); err != nil {
+				logger.ErrorContext(ctx, "governance: sink failed", "sink", i, "kind", a.Kind,
+					"yieldSourceOracleId", a.YieldSourceOracleId, "tx", a.TxHash, "err", err)
+			}
+		}
+	}
+}
+
+func cmpBig(a, b *big.Int) int {
+	if a == nil {
+		a = new(big.Int)
+	}
+	if b == nil {
+		b = new(big.Int)
+	}
+	return a.Cmp(b)
+}
+
+func bigString(x *big.Int) string {
+	if x == nil {
+		return "0"
+	}
+	return x.String()
+}
@@ -0,0 +1,138 @@
+package governance
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"log/slog"
+	"math/big"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+)
+
+func TestDiff(t *testing.T) {
+	cur := Config{FeePercent: big.NewInt(100), Ledger: common.Address{1}}
+	prop := Config{FeePercent: big.NewInt(150), Ledger: common.Address{2}}
+	changes := Diff(cur, prop)
+	if len(changes) != 2 || changes[0].Field != "feePercent" || changes[1].Field != "ledger" {
+		t.Fatalf("changes = %+v", changes)
+	}
+	if len(Diff(cur, cur)) != 0 {
+		t.Fatal("identical configs must not differ")
+	}
+}
+
+func TestWatcher(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	sim, auth, manager, cfg := stack.Backend, stack.Auth, stack.Auth.From, stack.LedgerConfiguration
+
+	oracle, recipient := common.Address{0x0a}, common.Address{0x0f}
+	ledger, newLedger := common.Address{0x1e}, common.Address{0x2e}
+	salt := [32]byte{0x5a}
+	if _, err := cfg.SetYieldSourceOracles(auth, [][32]byte{salt}, []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{
+		{YieldSourceOracle: oracle, FeePercent: big.NewInt(1000), FeeRecipient: recipient, Ledger: ledger},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+	id := crypto.Keccak256Hash(salt[:], manager.Bytes())
+
+	// A failing sink is logged and neither stops the watcher nor starves
+	// the other sinks.
+	alerts := make(chan Alert, 16)
+	w := NewWatcher(cfg,
+		SinkFunc(func(context.Context, Alert) error { return errors.New("webhook down") }),
+		SinkFunc(func(_ context.Context, a Alert) error {
+			alerts <- a
+			return nil
+		}))
+	w.KnownManagers = map[common.Address]bool{manager: true}
+	var logs syncBuffer
+	w.Logger = slog.New(slog.NewTextHandler(&logs, nil))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	start := uint64(0)
+	done := make(chan error, 1)
+	go func() { done <- w.Run(ctx, &start) }()
+
+	if _, err := cfg.ProposeYieldSourceOracleConfig(auth, [][32]byte{id}, []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{
+		{YieldSourceOracle: oracle, FeePercent: big.NewInt(1200), FeeRecipient: recipient, Ledger: newLedger},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+	if _, err := cfg.TransferManagerRole(auth, id, common.Address{0xba, 0xd}); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	want := []Kind{KindProposalSet, KindFeeIncrease, KindLedgerSwap, KindManagerTransferStarted, KindUnknownManager}
+	got := make(map[Kind]bool)
+	timeout := time.After(10 * time.Second)
+	for len(got) < len(want) {
+		select {
+		case a := <-alerts:
+			if a.YieldSourceOracleId != id {
+				t.Fatalf("alert for %x, want %x", a.YieldSourceOracleId, id)
+			}
+			got[a.Kind] = true
+		case err := <-done:
+			t.Fatalf("watcher stopped: %v", err)
+		case <-timeout:
+			t.Fatalf("timed out; got %v", got)
+		}
+	}
+	for _, k := range want {
+		if !got[k] {
+			t.Errorf("missing %s alert", k)
+		}
+	}
+
+	select {
+	case err := <-done:
+		t.Fatalf("watcher stopped: %v", err)
+	default:
+	}
+	if n := strings.Count(logs.String(), "webhook down"); n != len(want) {
+		t.Fatalf("logged %d sink failures:\n%s", n, logs.String())
+	}
+
+	props := w.Proposals()
+	if len(props) != 1 || len(props[0].Changes) != 2 || props[0].Current.FeePercent.Int64() != 1000 {
+		t.Fatalf("proposals = %+v", props)
+	}
+	if tr := w.Transfers(); len(tr) != 1 || tr[0].NewManager != (common.Address{0xba, 0xd}) {
+		t.Fatalf("transfers = %+v", tr)
+	}
+}
+
+// syncBuffer is a bytes.Buffer safe for the watcher goroutine to log to.
+type syncBuffer struct {
+	mu  sync.Mutex
+	buf bytes.Buffer
+}
+
+func (b *syncBuffer) Write(p []byte) (int, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.Write(p)
+}
+
+func (b *syncBuffer) String() string {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	return b.buf.String()
+}
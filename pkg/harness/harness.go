@@ -0,0 +1,384 @@
+// Package harness deploys the Superform core stack on go-ethereum's simulated
+// backend so integration tests run offline.
+//
+// New deploys, from the bytecode embedded in contract_bindings:
+//
+//	SuperLedgerConfiguration
+//	SuperValidator, SuperDestinationValidator
+//	SuperExecutor, SuperDestinationExecutor
+//	SuperLedger, allowing both executors
+//	SuperYieldSourceOracle and the ERC4626, ERC5115, ERC7540, Staking,
+//	PendlePT, SpectraPT and SuperVault yield source oracles
+//
+// and, from EVM assembly ports of test/mocks, MockSuperOracle and
+// MockYieldSourceOracle.
+package harness
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"fmt"
+	"math/big"
+	"os"
+	"path/filepath"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/eth/ethconfig"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+	"github.com/ethereum/go-ethereum/node"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC4626YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC5115YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC7540YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/PendlePTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SpectraPTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/StakingYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationExecutor"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutor"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperVaultYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/oracleid"
+)
+
+// DefaultBalance funds every account from NewAccount.
+var DefaultBalance = new(big.Int).Mul(big.NewInt(1_000), big.NewInt(1e18))
+
+// deployerBalance funds the deployer, which pays for NewAccount and Fund.
+var deployerBalance = new(big.Int).Lsh(big.NewInt(1), 128)
+
+// Stack is a simulated chain with the core contracts deployed.
+type Stack struct {
+	Backend *simulated.Backend
+	Client  simulated.Client
+	ChainID *big.Int
+	// RPC is a JSON-RPC client of the chain over IPC, for what Client does
+	// not expose: batches and the geth namespace. Geth wraps it and
+	// implements userop.OverrideCaller.
+	RPC  *rpc.Client
+	Geth *gethclient.Client
+
+	// Key and Auth belong to the deployer, which is the manager of every
+	// configuration RegisterOracle sets.
+	Key  *ecdsa.PrivateKey
+	Auth *bind.TransactOpts
+
+	LedgerConfiguration  *SuperLedgerConfiguration.SuperLedgerConfiguration
+	Ledger               *SuperLedger.SuperLedger
+	Validator            *SuperValidator.SuperValidator
+	DestinationValidator *SuperDestinationValidator.SuperDestinationValidator
+	Executor             *SuperExecutor.SuperExecutor
+	DestinationExecutor  *SuperDestinationExecutor.SuperDestinationExecutor
+	YieldSourceOracle    *SuperYieldSourceOracle.SuperYieldSourceOracle
+
+	// Addresses maps contract names, as in the deployment outputs under
+	// script/output, to their addresses.
+	Addresses map[string]common.Address
+
+	// dir holds the IPC endpoint.
+	dir string
+}
+
+// DefaultChainID is the chain ID of the simulated backend.
+const DefaultChainID = 1337
+
+// New starts a simulated chain with DefaultChainID and deploys the core
+// stack on it.
+func New() (*Stack, error) {
+	return NewChain(DefaultChainID)
+}
+
+// NewChain starts a simulated chain with chainID and deploys the core stack
+// on it. Stacks with distinct chain IDs can run side by side.
+func NewChain(chainID uint64) (*Stack, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, err
+	}
+	from := crypto.PubkeyToAddress(key.PublicKey)
+	dir, err := os.MkdirTemp("", "harness")
+	if err != nil {
+		return nil, err
+	}
+	ipc := filepath.Join(dir, "sim.ipc")
+	sim := simulated.NewBackend(types.GenesisAlloc{from: {Balance: deployerBalance}},
+		simulated.WithBlockGasLimit(60_000_000), withChainID(chainID), withIPC(ipc))
+	s := &Stack{Backend: sim, Client: sim.Client(), Key: key, Addresses: make(map[string]common.Address), dir: dir}
+	if s.RPC, err = rpc.Dial(ipc); err != nil {
+		s.Close()
+		return nil, err
+	}
+	s.Geth = gethclient.New(s.RPC)
+	if s.ChainID, err = s.Client.ChainID(context.Background()); err != nil {
+		s.Close()
+		return nil, err
+	}
+	if s.Auth, err = bind.NewKeyedTransactorWithChainID(key, s.ChainID); err != nil {
+		s.Close()
+		return nil, err
+	}
+	if err := s.deploy(); err != nil {
+		s.Close()
+		return nil, err
+	}
+	return s, nil
+}
+
+// withChainID replaces the chain ID of the simulated genesis.
+func withChainID(chainID uint64) func(*node.Config, *ethconfig.Config) {
+	return func(_ *node.Config, eth *ethconfig.Config) {
+		config := *eth.Genesis.Config
+		config.ChainID = new(big.Int).SetUint64(chainID)
+		eth.Genesis.Config = &config
+	}
+}
+
+// withIPC serves the node's JSON-RPC API on the IPC endpoint path.
+func withIPC(path string) func(*node.Config, *ethconfig.Config) {
+	return func(n *node.Config, _ *ethconfig.Config) {
+		n.IPCPath = path
+	}
+}
+
+// Close shuts the simulated chain down.
+func (s *Stack) Close() error {
+	if s.RPC != nil {
+		s.RPC.Close()
+	}
+	err := s.Backend.Close()
+	os.RemoveAll(s.dir)
+	return err
+}
+
+// deployFunc is the shape of the generated Deploy* functions, with the
+// bound contract dropped.
+type deployFunc func() (common.Address, *types.Transaction, error)
+
+func (s *Stack) deploy() error {
+	auth, client := s.Auth, s.Client
+
+	cfg, err := s.add("SuperLedgerConfiguration", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperLedgerConfiguration.DeploySuperLedgerConfiguration(auth, client)
+		s.LedgerConfiguration = c
+		return addr, tx, err
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = s.add("SuperValidator", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperValidator.DeploySuperValidator(auth, client)
+		s.Validator = c
+		return addr, tx, err
+	}); err != nil {
+		return err
+	}
+	dstValidator, err := s.add("SuperDestinationValidator", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperDestinationValidator.DeploySuperDestinationValidator(auth, client)
+		s.DestinationValidator = c
+		return addr, tx, err
+	})
+	if err != nil {
+		return err
+	}
+	executor, err := s.add("SuperExecutor", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperExecutor.DeploySuperExecutor(auth, client, cfg)
+		s.Executor = c
+		return addr, tx, err
+	})
+	if err != nil {
+		return err
+	}
+	dstExecutor, err := s.add("SuperDestinationExecutor", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperDestinationExecutor.DeploySuperDestinationExecutor(auth, client, cfg, dstValidator)
+		s.DestinationExecutor = c
+		return addr, tx, err
+	})
+	if err != nil {
+		return err
+	}
+	if _, err = s.add("SuperLedger", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperLedger.DeploySuperLedger(auth, client, cfg, []common.Address{executor, dstExecutor})
+		s.Ledger = c
+		return addr, tx, err
+	}); err != nil {
+		return err
+	}
+	if _, err = s.add("SuperYieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+		addr, tx, c, err := SuperYieldSourceOracle.DeploySuperYieldSourceOracle(auth, client)
+		s.YieldSourceOracle = c
+		return addr, tx, err
+	}); err != nil {
+		return err
+	}
+
+	oracles := []struct {
+		name   string
+		deploy deployFunc
+	}{
+		{"ERC4626YieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := ERC4626YieldSourceOracle.DeployERC4626YieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+		{"ERC5115YieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := ERC5115YieldSourceOracle.DeployERC5115YieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+		{"ERC7540YieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := ERC7540YieldSourceOracle.DeployERC7540YieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+		{"StakingYieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := StakingYieldSourceOracle.DeployStakingYieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+		{"PendlePTYieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := PendlePTYieldSourceOracle.DeployPendlePTYieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+		{"SpectraPTYieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := SpectraPTYieldSourceOracle.DeploySpectraPTYieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+		{"SuperVaultYieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+			addr, tx, _, err := SuperVaultYieldSourceOracle.DeploySuperVaultYieldSourceOracle(auth, client, cfg)
+			return addr, tx, err
+		}},
+	}
+	for _, o := range oracles {
+		if _, err := s.add(o.name, o.deploy); err != nil {
+			return err
+		}
+	}
+
+	// Mocks: a fixed 1:1 quote, and a yield source with price per share 1e18
+	// that reports validity.
+	if _, err := s.add("MockSuperOracle", func() (common.Address, *types.Transaction, error) {
+		return DeployMockSuperOracle(auth, client, big.NewInt(1e18))
+	}); err != nil {
+		return err
+	}
+	if _, err := s.add("MockYieldSourceOracle", func() (common.Address, *types.Transaction, error) {
+		return DeployMockYieldSourceOracle(auth, client, big.NewInt(1e18), new(big.Int), new(big.Int), true)
+	}); err != nil {
+		return err
+	}
+	return nil
+}
+
+// add runs a deployment, mines it and records the address under name.
+func (s *Stack) add(name string, deploy deployFunc) (common.Address, error) {
+	addr, tx, err := deploy()
+	if err != nil {
+		return common.Address{}, fmt.Errorf("harness: deploy %s: %w", name, err)
+	}
+	if _, err := s.Mine(context.Background(), tx); err != nil {
+		return common.Address{}, fmt.Errorf("harness: deploy %s: %w", name, err)
+	}
+	s.Addresses[name] = addr
+	return addr, nil
+}
+
+// Mine commits a block and returns the receipt of tx, failing if it
+// reverted.
+func (s *Stack) Mine(ctx context.Context, tx *types.Transaction) (*types.Receipt, error) {
+	s.Backend.Commit()
+	receipt, err := s.Client.TransactionReceipt(ctx, tx.Hash())
+	if err != nil {
+		return nil, err
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		return receipt, fmt.Errorf("transaction %s reverted", tx.Hash())
+	}
+	return receipt, nil
+}
+
+// NewAccount returns a fresh key funded with DefaultBalance, and its
+// transactor.
+func (s *Stack) NewAccount(ctx context.Context) (*ecdsa.PrivateKey, *bind.TransactOpts, error) {
+	key, err := crypto.GenerateKey()
+	if err != nil {
+		return nil, nil, err
+	}
+	to := crypto.PubkeyToAddress(key.PublicKey)
+	if err := s.Fund(ctx, to, DefaultBalance); err != nil {
+		return nil, nil, err
+	}
+	auth, err := bind.NewKeyedTransactorWithChainID(key, s.ChainID)
+	if err != nil {
+		return nil, nil, err
+	}
+	return key, auth, nil
+}
+
+// Fund sends amount wei from the deployer to to and mines it.
+func (s *Stack) Fund(ctx context.Context, to common.Address, amount *big.Int) error {
+	from := s.Auth.From
+	nonce, err := s.Client.PendingNonceAt(ctx, from)
+	if err != nil {
+		return err
+	}
+	tip, err := s.Client.SuggestGasTipCap(ctx)
+	if err != nil {
+		return err
+	}
+	head, err := s.Client.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return err
+	}
+	tx, err := types.SignNewTx(s.Key, types.LatestSignerForChainID(s.ChainID), &types.DynamicFeeTx{
+		ChainID:   s.ChainID,
+		Nonce:     nonce,
+		GasTipCap: tip,
+		GasFeeCap: new(big.Int).Add(tip, new(big.Int).Mul(head.BaseFee, big.NewInt(2))),
+		Gas:       21_000,
+		To:        &to,
+		Value:     amount,
+	})
+	if err != nil {
+		return err
+	}
+	if err := s.Client.SendTransaction(ctx, tx); err != nil {
+		return err
+	}
+	_, err = s.Mine(ctx, tx)
+	return err
+}
+
+// RegisterOracle sets a yield source oracle configuration managed by the
+// deployer, with the stack's SuperLedger, and returns its ID.
+func (s *Stack) RegisterOracle(ctx context.Context, salt common.Hash, oracle common.Address, feePercent *big.Int, feeRecipient common.Address) (common.Hash, error) {
+	opts := *s.Auth
+	opts.Context = ctx
+	tx, err := s.LedgerConfiguration.SetYieldSourceOracles(&opts, [][32]byte{salt}, []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{{
+		YieldSourceOracle: oracle,
+		FeePercent:        feePercent,
+		FeeRecipient:      feeRecipient,
+		Ledger:            s.Addresses["SuperLedger"],
+	}})
+	if err != nil {
+		return common.Hash{}, fmt.Errorf("harness: register oracle: %w", err)
+	}
+	if _, err := s.Mine(ctx, tx); err != nil {
+		return common.Hash{}, fmt.Errorf("harness: register oracle: %w", err)
+	}
+	return oracleid.DeriveID(salt, s.Auth.From), nil
+}
+
+// Chain returns the deployment as an address book chain, for packages that
+// resolve contracts by name.
+func (s *Stack) Chain() *addressbook.Chain {
+	contracts := make(map[string]common.Address, len(s.Addresses))
+	for name, addr := range s.Addresses {
+		contracts[name] = addr
+	}
+	return &addressbook.Chain{Name: "Simulated", ChainID: s.ChainID.Uint64(), Contracts: contracts}
+}
@@ -0,0 +1,90 @@
+package harness
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/MockSuperOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/MockYieldSourceOracle"
+)
+
+func TestNew(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	for name, addr := range s.Addresses {
+		code, err := s.Client.CodeAt(ctx, addr, nil)
+		if err != nil || len(code) == 0 {
+			t.Errorf("%s at %s has no code: %v", name, addr.Hex(), err)
+		}
+	}
+	if len(s.Addresses) != 16 {
+		t.Fatalf("deployed %d contracts", len(s.Addresses))
+	}
+	var rpcChainID hexutil.Big
+	if err := s.RPC.CallContext(ctx, &rpcChainID, "eth_chainId"); err != nil || rpcChainID.ToInt().Cmp(s.ChainID) != 0 {
+		t.Fatalf("RPC chain ID = %v, %v", rpcChainID.ToInt(), err)
+	}
+
+	for _, name := range []string{"SuperExecutor", "SuperDestinationExecutor"} {
+		if ok, err := s.Ledger.AllowedExecutors(nil, s.Addresses[name]); err != nil || !ok {
+			t.Errorf("%s not allowed on the ledger: %v", name, err)
+		}
+	}
+	if v, err := s.DestinationExecutor.SUPERDESTINATIONVALIDATOR(nil); err != nil || v != s.Addresses["SuperDestinationValidator"] {
+		t.Fatalf("destination validator = %s, %v", v.Hex(), err)
+	}
+
+	quote, err := MockSuperOracle.NewMockSuperOracle(s.Addresses["MockSuperOracle"], s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if q, err := quote.GetQuote(nil, big.NewInt(5), common.Address{1}, common.Address{2}); err != nil || q.Cmp(big.NewInt(1e18)) != 0 {
+		t.Fatalf("quote = %v, %v", q, err)
+	}
+	ys, err := MockYieldSourceOracle.NewMockYieldSourceOracle(s.Addresses["MockYieldSourceOracle"], s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if pps, err := ys.GetPricePerShare(nil, common.Address{1}); err != nil || pps.Cmp(big.NewInt(1e18)) != 0 {
+		t.Fatalf("price per share = %v, %v", pps, err)
+	}
+	if valid, err := ys.Validity(nil); err != nil || !valid {
+		t.Fatalf("validity = %v, %v", valid, err)
+	}
+	if d, err := ys.Decimals(nil, common.Address{1}); err != nil || d != 18 {
+		t.Fatalf("decimals = %v, %v", d, err)
+	}
+	if out, err := ys.GetAssetOutputWithFees(nil, [32]byte{}, common.Address{}, common.Address{}, common.Address{}, big.NewInt(7)); err != nil || out.Int64() != 7 {
+		t.Fatalf("asset output = %v, %v", out, err)
+	}
+
+	id, err := s.RegisterOracle(ctx, common.Hash{1}, s.Addresses["ERC4626YieldSourceOracle"], big.NewInt(100), common.Address{0xfe})
+	if err != nil {
+		t.Fatal(err)
+	}
+	cfg, err := s.LedgerConfiguration.GetYieldSourceOracleConfig(nil, id)
+	if err != nil || cfg.Manager != s.Auth.From || cfg.Ledger != s.Addresses["SuperLedger"] {
+		t.Fatalf("config = %+v, %v", cfg, err)
+	}
+
+	key, _, err := s.NewAccount(ctx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if bal, err := s.Client.BalanceAt(ctx, crypto.PubkeyToAddress(key.PublicKey), nil); err != nil || bal.Cmp(DefaultBalance) != 0 {
+		t.Fatalf("balance = %v, %v", bal, err)
+	}
+	if c := s.Chain(); c.ChainID != s.ChainID.Uint64() || c.Contracts["SuperLedger"] != s.Addresses["SuperLedger"] {
+		t.Fatalf("chain = %+v", c)
+	}
+}
@@ -0,0 +1,391 @@
+package harness
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/asm"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/MockSuperOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/MockYieldSourceOracle"
+)
+
+// MockSuperOracle and MockYieldSourceOracle deploy the forge bytecode of
+// test/mocks when their bindings carry it, i.e. when they were generated from
+// ./out. The ABI-only bindings from script/derived-abi fall back to ports of
+// the mocks to EVM assembly. The ports keep the Solidity storage layout,
+// answer every method of the bindings and take their constructor arguments
+// as initial storage.
+
+// mockSuperOracleRoutes maps the MockSuperOracle methods to the routes of
+// mockSuperOracleAsm. Slot 0 is quoteAmount.
+var mockSuperOracleRoutes = map[string]string{
+	"quoteAmount":    "load0",
+	"getQuote":       "load0",
+	"setQuoteAmount": "store0",
+}
+
+const mockSuperOracleAsm = `
+load0:
+	PUSH 0
+	SLOAD
+	PUSH 0
+	MSTORE
+	PUSH 32
+	PUSH 0
+	RETURN
+
+store0:
+	PUSH 4
+	CALLDATALOAD
+	PUSH 0
+	SSTORE
+	STOP
+`
+
+// mockYieldSourceOracleRoutes maps the MockYieldSourceOracle methods to the
+// routes of mockYieldSourceOracleAsm. Slots 0 to 3 are pricePerShare, tvl,
+// tvlByOwner and validity; validAssetMap is the mapping at slot 4.
+var mockYieldSourceOracleRoutes = map[string]string{
+	"pricePerShare":                 "load0",
+	"getPricePerShare":              "load0",
+	"tvl":                           "load1",
+	"getTVL":                        "load1",
+	"tvlByOwner":                    "load2",
+	"getBalanceOfOwner":             "load2",
+	"getTVLByOwnerOfShares":         "load2",
+	"validity":                      "load3",
+	"validAssetMap":                 "loadValidAsset",
+	"setPricePerShare":              "store0",
+	"setTVL":                        "store1",
+	"setTVLByOwner":                 "store2",
+	"setValidity":                   "store3",
+	"setValidAsset":                 "storeValidAsset",
+	"decimals":                      "decimals",
+	"getShareOutput":                "arg2",
+	"getWithdrawalShareOutput":      "arg2",
+	"getAssetOutput":                "arg2",
+	"getAssetOutputWithFees":        "arg4",
+	"getPricePerShareMultiple":      "multiple0",
+	"getTVLMultiple":                "multiple1",
+	"getTVLByOwnerOfSharesMultiple": "tvlByOwnerMultiple",
+}
+
+const mockYieldSourceOracleAsm = `
+load0:
+	PUSH 0
+	SLOAD
+	JUMP @return
+load1:
+	PUSH 1
+	SLOAD
+	JUMP @return
+load2:
+	PUSH 2
+	SLOAD
+	JUMP @return
+load3:
+	PUSH 3
+	SLOAD
+	JUMP @return
+loadValidAsset:
+	PUSH 4
+	CALLDATALOAD
+	PUSH 0
+	MSTORE
+	PUSH 4
+	PUSH 32
+	MSTORE
+	PUSH 64
+	PUSH 0
+	KECCAK256
+	SLOAD
+	JUMP @return
+decimals:
+	PUSH 18
+	JUMP @return
+arg2:
+	PUSH 0x44
+	CALLDATALOAD
+	JUMP @return
+arg4:
+	PUSH 0x84
+	CALLDATALOAD
+	JUMP @return
+
+return:
+	PUSH 0
+	MSTORE
+	PUSH 32
+	PUSH 0
+	RETURN
+
+store0:
+	PUSH 4
+	CALLDATALOAD
+	PUSH 0
+	SSTORE
+	STOP
+store1:
+	PUSH 4
+	CALLDATALOAD
+	PUSH 1
+	SSTORE
+	STOP
+store2:
+	PUSH 4
+	CALLDATALOAD
+	PUSH 2
+	SSTORE
+	STOP
+store3:
+	PUSH 4
+	CALLDATALOAD
+	ISZERO
+	ISZERO
+	PUSH 3
+	SSTORE
+	STOP
+storeValidAsset:
+	PUSH 36
+	CALLDATALOAD
+	ISZERO
+	ISZERO
+	PUSH 4
+	CALLDATALOAD
+	PUSH 0
+	MSTORE
+	PUSH 4
+	PUSH 32
+	MSTORE
+	PUSH 64
+	PUSH 0
+	KECCAK256
+	SSTORE
+	STOP
+
+multiple0:
+	PUSH 0
+	SLOAD
+	JUMP @single
+multiple1:
+	PUSH 1
+	SLOAD
+	JUMP @single
+
+single:
+	PUSH 64
+	MSTORE
+	PUSH 32
+	PUSH 0
+	MSTORE
+	PUSH 1
+	PUSH 32
+	MSTORE
+	PUSH 96
+	PUSH 0
+	RETURN
+
+tvlByOwnerMultiple:
+	PUSH 32
+	PUSH 0
+	MSTORE
+	PUSH 4
+	CALLDATALOAD
+	PUSH 4
+	ADD
+	CALLDATALOAD
+	DUP1
+	PUSH 32
+	MSTORE
+	PUSH 0
+
+loop:
+	DUP2
+	DUP2
+	LT
+	ISZERO
+	JUMPI @done
+	DUP1
+	PUSH 64
+	MUL
+	DUP3
+	PUSH 32
+	MUL
+	ADD
+	DUP2
+	PUSH 32
+	MUL
+	PUSH 64
+	ADD
+	MSTORE
+	DUP1
+	PUSH 64
+	MUL
+	DUP3
+	PUSH 32
+	MUL
+	ADD
+	PUSH 64
+	ADD
+	PUSH 1
+	DUP2
+	MSTORE
+	PUSH 2
+	SLOAD
+	SWAP1
+	PUSH 32
+	ADD
+	MSTORE
+	PUSH 1
+	ADD
+	JUMP @loop
+
+done:
+	POP
+	PUSH 96
+	MUL
+	PUSH 64
+	ADD
+	PUSH 0
+	RETURN
+`
+
+// DeployMockSuperOracle deploys the MockSuperOracle port quoting
+// quoteAmount for any pair.
+func DeployMockSuperOracle(auth *bind.TransactOpts, backend bind.ContractBackend, quoteAmount *big.Int) (common.Address, *types.Transaction, error) {
+	meta := MockSuperOracle.MockSuperOracleMetaData
+	if meta.Bin != "" {
+		return deployBin(auth, backend, meta, quoteAmount)
+	}
+	src, err := dispatch(meta, mockSuperOracleRoutes, mockSuperOracleAsm)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	return DeployAsm(auth, backend, src, quoteAmount)
+}
+
+// DeployMockYieldSourceOracle deploys the MockYieldSourceOracle port with
+// the arguments of its constructor.
+func DeployMockYieldSourceOracle(auth *bind.TransactOpts, backend bind.ContractBackend, pricePerShare, tvl, tvlByOwner *big.Int, validity bool) (common.Address, *types.Transaction, error) {
+	meta := MockYieldSourceOracle.MockYieldSourceOracleMetaData
+	if meta.Bin != "" {
+		return deployBin(auth, backend, meta, pricePerShare, tvl, tvlByOwner, validity)
+	}
+	src, err := dispatch(meta, mockYieldSourceOracleRoutes, mockYieldSourceOracleAsm)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	valid := new(big.Int)
+	if validity {
+		valid.SetInt64(1)
+	}
+	return DeployAsm(auth, backend, src, pricePerShare, tvl, tvlByOwner, valid)
+}
+
+// deployBin deploys the creation bytecode of meta with the constructor
+// arguments args.
+func deployBin(auth *bind.TransactOpts, backend bind.ContractBackend, meta *bind.MetaData, args ...any) (common.Address, *types.Transaction, error) {
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	address, tx, _, err := bind.DeployContract(auth, *parsed, common.FromHex(meta.Bin), backend, args...)
+	return address, tx, err
+}
+
+// dispatch prefixes body with a selector switch jumping to the route of
+// each method of meta, and a revert for unknown selectors. Every method
+// needs a route.
+func dispatch(meta *bind.MetaData, routes map[string]string, body string) (string, error) {
+	parsed, err := meta.GetAbi()
+	if err != nil {
+		return "", err
+	}
+	names := make([]string, 0, len(parsed.Methods))
+	for name := range parsed.Methods {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	var b strings.Builder
+	b.WriteString("\tPUSH 0\n\tCALLDATALOAD\n\tPUSH 0xe0\n\tSHR\n")
+	for _, name := range names {
+		route, ok := routes[name]
+		if !ok {
+			return "", fmt.Errorf("harness: no route for %s", parsed.Methods[name].Sig)
+		}
+		fmt.Fprintf(&b, "\tDUP1\n\tPUSH %#x\n\tEQ\n\tJUMPI @%s\n", parsed.Methods[name].ID, route)
+	}
+	b.WriteString("\tPUSH 0\n\tPUSH 0\n\tREVERT\n")
+	b.WriteString(body)
+	return b.String(), nil
+}
+
+// Assemble compiles EVM assembly, in the syntax of go-ethereum's core/asm,
+// to runtime bytecode.
+func Assemble(src string) ([]byte, error) {
+	// The compiler emits STOP for unknown mnemonics.
+	for _, line := range strings.Split(src, "\n") {
+		f := strings.Fields(line)
+		if len(f) == 0 || strings.HasSuffix(f[0], ":") || f[0] == "PUSH" || f[0] == "STOP" {
+			continue
+		}
+		if vm.StringToOp(f[0]) == vm.STOP {
+			return nil, fmt.Errorf("harness: assemble: unknown instruction %s", f[0])
+		}
+	}
+	c := asm.NewCompiler(false)
+	c.Feed(asm.Lex([]byte(src), false))
+	out, errs := c.Compile()
+	if len(errs) > 0 {
+		return nil, fmt.Errorf("harness: assemble: %w", errors.Join(errs...))
+	}
+	return common.FromHex(out), nil
+}
+
+// DeployAsm assembles src and deploys it as runtime code, with storage slot
+// i of the new contract set to storage[i].
+func DeployAsm(auth *bind.TransactOpts, backend bind.ContractBackend, src string, storage ...*big.Int) (common.Address, *types.Transaction, error) {
+	runtime, err := Assemble(src)
+	if err != nil {
+		return common.Address{}, nil, err
+	}
+	addr, tx, _, err := bind.DeployContract(auth, abi.ABI{}, creationCode(runtime, storage), backend)
+	return addr, tx, err
+}
+
+// creationCode returns init code that writes storage and returns runtime.
+func creationCode(runtime []byte, storage []*big.Int) []byte {
+	var init []byte
+	for slot, v := range storage {
+		if v == nil || v.Sign() == 0 {
+			continue
+		}
+		init = append(init, 0x7f) // PUSH32 v
+		init = append(init, common.LeftPadBytes(v.Bytes(), 32)...)
+		init = append(init,
+			0x60, byte(slot), // PUSH1 slot
+			0x55, // SSTORE
+		)
+	}
+	n, offset := len(runtime), len(init)+11
+	init = append(init,
+		0x61, byte(n>>8), byte(n), // PUSH2 n
+		0x80,                                // DUP1
+		0x61, byte(offset>>8), byte(offset), // PUSH2 offset
+		0x5f, // PUSH0
+		0x39, // CODECOPY
+		0x5f, // PUSH0
+		0xf3, // RETURN
+	)
+	return append(init, runtime...)
+}
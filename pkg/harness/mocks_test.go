@@ -0,0 +1,121 @@
+package harness
+
+import (
+	"context"
+	"math/big"
+	"os"
+	"path/filepath"
+	"regexp"
+	"slices"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/MockSuperOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/MockYieldSourceOracle"
+)
+
+func TestMockYieldSourceOracle(t *testing.T) {
+	s, err := New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	ctx := context.Background()
+
+	ys, err := MockYieldSourceOracle.NewMockYieldSourceOracle(s.Addresses["MockYieldSourceOracle"], s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	asset := common.Address{0xa5}
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Mine(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+	mine(ys.SetTVL(s.Auth, big.NewInt(300)))
+	mine(ys.SetTVLByOwner(s.Auth, big.NewInt(40)))
+	mine(ys.SetValidity(s.Auth, false))
+	mine(ys.SetValidAsset(s.Auth, asset, true))
+
+	if tvl, err := ys.GetTVL(nil, asset); err != nil || tvl.Int64() != 300 {
+		t.Fatalf("tvl = %v, %v", tvl, err)
+	}
+	if out, err := ys.GetWithdrawalShareOutput(nil, asset, asset, big.NewInt(25)); err != nil || out.Int64() != 25 {
+		t.Fatalf("withdrawal share output = %v, %v", out, err)
+	}
+	if owned, err := ys.GetTVLByOwnerOfShares(nil, asset, asset); err != nil || owned.Int64() != 40 {
+		t.Fatalf("tvl by owner = %v, %v", owned, err)
+	}
+	if valid, err := ys.Validity(nil); err != nil || valid {
+		t.Fatalf("validity = %v, %v", valid, err)
+	}
+	if ok, err := ys.ValidAssetMap(nil, asset); err != nil || !ok {
+		t.Fatalf("valid asset = %v, %v", ok, err)
+	}
+	if ok, err := ys.ValidAssetMap(nil, common.Address{1}); err != nil || ok {
+		t.Fatalf("other asset = %v, %v", ok, err)
+	}
+	if prices, err := ys.GetPricePerShareMultiple(nil, []common.Address{asset, asset}); err != nil || len(prices) != 1 || prices[0].Cmp(big.NewInt(1e18)) != 0 {
+		t.Fatalf("prices = %v, %v", prices, err)
+	}
+	owned, err := ys.GetTVLByOwnerOfSharesMultiple(nil, []common.Address{asset, asset, asset}, [][]common.Address{{asset}, {asset}, {asset}})
+	if err != nil || len(owned) != 3 {
+		t.Fatalf("tvl by owner multiple = %v, %v", owned, err)
+	}
+	for _, o := range owned {
+		if !slices.EqualFunc(o, []*big.Int{big.NewInt(40)}, func(a, b *big.Int) bool { return a.Cmp(b) == 0 }) {
+			t.Fatalf("tvl by owner multiple = %v", owned)
+		}
+	}
+}
+
+var solidityMember = regexp.MustCompile(`(?m)^\s*(?:function\s+(\w+)|\w+(?:\([^;]*\))?\s+public\s+(\w+)\s*;)`)
+
+// The bindings, and so the routes of the ports, must keep up with the
+// methods declared in test/mocks.
+func TestMocksMatchSources(t *testing.T) {
+	for name, meta := range map[string]*bind.MetaData{
+		"MockSuperOracle":       MockSuperOracle.MockSuperOracleMetaData,
+		"MockYieldSourceOracle": MockYieldSourceOracle.MockYieldSourceOracleMetaData,
+	} {
+		src, err := os.ReadFile(filepath.Join("../../test/mocks", name+".sol"))
+		if err != nil {
+			t.Fatal(err)
+		}
+		parsed, err := meta.GetAbi()
+		if err != nil {
+			t.Fatal(err)
+		}
+		declared := map[string]bool{}
+		for _, m := range solidityMember.FindAllStringSubmatch(string(src), -1) {
+			declared[m[1]+m[2]] = true
+		}
+		for member := range declared {
+			if _, ok := parsed.Methods[member]; !ok {
+				t.Errorf("%s.%s is not in the binding", name, member)
+			}
+		}
+		for member := range parsed.Methods {
+			if !declared[member] {
+				t.Errorf("%s.%s is not in test/mocks", name, member)
+			}
+		}
+	}
+}
+
+func TestAssembleUnknownInstruction(t *testing.T) {
+	if _, err := Assemble("\tPUSH 1\n\tSHA3\n"); err == nil {
+		t.Fatal("assembled an unknown instruction")
+	}
+	code, err := Assemble("\tPUSH 1\n\tKECCAK256\nend:\n\tSTOP\n")
+	if err != nil || len(code) != 5 {
+		t.Fatalf("code = %x, %v", code, err)
+	}
+}
@@ -0,0 +1,331 @@
+package hooks
+
+import "math/big"
+
+// Chaining is implemented by the payloads of hooks that can take their
+// amount from the previous hook.
+type Chaining interface {
+	HookData
+	// UsesPrevHookAmount returns the payload's usePrevHookAmount flag.
+	UsesPrevHookAmount() bool
+}
+
+func (d *AcrossSendFundsAndExecuteOnDst) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndAcrossSendFundsAndExecuteOnDst) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndDeposit4626Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndDeposit5115Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndFluidStake) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndGearboxStake) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndRequestDeposit7540Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveAndSwapOdosV2) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *ApproveERC20) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *BurnSuperPositions) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *CircleGatewayWallet) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *DeBridgeSendOrderAndExecuteOnDst) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Deposit4626Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Deposit5115Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Deposit7540Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *DepositWETH) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *EthenaCooldownShares) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *FluidStake) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *FluidUnstake) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *GearboxStake) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *GearboxUnstake) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *MintSuperPositions) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *MorphoBorrow) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *MorphoRepay) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *MorphoRepayAndWithdraw) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *MorphoSupply) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *MorphoSupplyAndBorrow) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *PendleRouterRedeem) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *PendleRouterSwap) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Redeem4626Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Redeem5115Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Redeem7540Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *RequestDeposit7540Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *RequestRedeem7540Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *SpectraExchangeDeposit) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *SpectraExchangeRedeem) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Swap1Inch) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *SwapOdosV2) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *SwapUniswapV4) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Transfer) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *TransferERC20) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *Withdraw7540Vault) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+func (d *WithdrawWETH) UsesPrevHookAmount() bool {
+	return d.UsePrevHookAmount
+}
+
+// Amounted is implemented by the payloads that carry one amount, or share
+// count, as an Amount or Shares field.
+type Amounted interface {
+	HookData
+	// DataAmount returns the payload's amount or share count.
+	DataAmount() *big.Int
+}
+
+func (d *ApproveAndDeposit4626Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *ApproveAndDeposit5115Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *ApproveAndFluidStake) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *ApproveAndGearboxStake) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *ApproveAndRequestDeposit7540Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *ApproveERC20) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *BurnSuperPositions) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *CircleGatewayWallet) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *Deposit4626Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *Deposit5115Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *Deposit7540Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *DepositWETH) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *EthenaCooldownShares) DataAmount() *big.Int {
+	return d.Shares
+}
+
+func (d *FluidStake) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *FluidUnstake) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *GearboxStake) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *GearboxUnstake) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MintSuperPositions) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MorphoBorrow) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MorphoRepay) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MorphoRepayAndWithdraw) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MorphoSupply) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MorphoSupplyAndBorrow) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *MorphoWithdraw) DataAmount() *big.Int {
+	return d.Shares
+}
+
+func (d *NativeTransfer) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *PendleRouterRedeem) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *Redeem4626Vault) DataAmount() *big.Int {
+	return d.Shares
+}
+
+func (d *Redeem5115Vault) DataAmount() *big.Int {
+	return d.Shares
+}
+
+func (d *Redeem7540Vault) DataAmount() *big.Int {
+	return d.Shares
+}
+
+func (d *RequestDeposit7540Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *RequestRedeem7540Vault) DataAmount() *big.Int {
+	return d.Shares
+}
+
+func (d *Transfer) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *TransferERC20) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *Withdraw7540Vault) DataAmount() *big.Int {
+	return d.Amount
+}
+
+func (d *WithdrawWETH) DataAmount() *big.Int {
+	return d.Amount
+}
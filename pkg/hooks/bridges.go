@@ -0,0 +1,207 @@
+package hooks
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// AcrossSendFundsAndExecuteOnDst is the data for
+// AcrossSendFundsAndExecuteOnDstHook, which bridges through the Across V3 spoke
+// pool with a destination message.
+type AcrossSendFundsAndExecuteOnDst struct {
+	Value              *big.Int
+	Recipient          common.Address
+	InputToken         common.Address
+	OutputToken        common.Address
+	InputAmount        *big.Int
+	OutputAmount       *big.Int
+	DestinationChainId *big.Int
+	ExclusiveRelayer   common.Address
+	FillDeadlineOffset uint32
+	ExclusivityPeriod  uint32
+	UsePrevHookAmount  bool
+	DestinationMessage []byte `packed:"rest"`
+}
+
+func (*AcrossSendFundsAndExecuteOnDst) HookName() string { return "AcrossSendFundsAndExecuteOnDstHook" }
+
+// Encode returns the packed AcrossSendFundsAndExecuteOnDstHook data.
+func (d *AcrossSendFundsAndExecuteOnDst) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeAcrossSendFundsAndExecuteOnDst decodes
+// AcrossSendFundsAndExecuteOnDstHook data.
+func DecodeAcrossSendFundsAndExecuteOnDst(data []byte) (*AcrossSendFundsAndExecuteOnDst, error) {
+	return decodeAs[AcrossSendFundsAndExecuteOnDst](data)
+}
+
+// ApproveAndAcrossSendFundsAndExecuteOnDst is the data for
+// ApproveAndAcrossSendFundsAndExecuteOnDstHook, which approves the Across V3
+// spoke pool and bridges through it with a destination message.
+type ApproveAndAcrossSendFundsAndExecuteOnDst struct {
+	Value              *big.Int
+	Recipient          common.Address
+	InputToken         common.Address
+	OutputToken        common.Address
+	InputAmount        *big.Int
+	OutputAmount       *big.Int
+	DestinationChainId *big.Int
+	ExclusiveRelayer   common.Address
+	FillDeadlineOffset uint32
+	ExclusivityPeriod  uint32
+	UsePrevHookAmount  bool
+	DestinationMessage []byte `packed:"rest"`
+}
+
+func (*ApproveAndAcrossSendFundsAndExecuteOnDst) HookName() string {
+	return "ApproveAndAcrossSendFundsAndExecuteOnDstHook"
+}
+
+// Encode returns the packed ApproveAndAcrossSendFundsAndExecuteOnDstHook data.
+func (d *ApproveAndAcrossSendFundsAndExecuteOnDst) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndAcrossSendFundsAndExecuteOnDst decodes
+// ApproveAndAcrossSendFundsAndExecuteOnDstHook data.
+func DecodeApproveAndAcrossSendFundsAndExecuteOnDst(data []byte) (*ApproveAndAcrossSendFundsAndExecuteOnDst, error) {
+	return decodeAs[ApproveAndAcrossSendFundsAndExecuteOnDst](data)
+}
+
+// CircleGatewayWallet is the data for CircleGatewayWalletHook, which approves
+// and deposits USDC into the Circle Gateway wallet.
+type CircleGatewayWallet struct {
+	Usdc              common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*CircleGatewayWallet) HookName() string { return "CircleGatewayWalletHook" }
+
+// Encode returns the packed CircleGatewayWalletHook data.
+func (d *CircleGatewayWallet) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeCircleGatewayWallet decodes CircleGatewayWalletHook data.
+func DecodeCircleGatewayWallet(data []byte) (*CircleGatewayWallet, error) {
+	return decodeAs[CircleGatewayWallet](data)
+}
+
+// CircleGatewayAddDelegate is the data for CircleGatewayAddDelegateHook, which
+// adds a delegate to the Circle Gateway wallet.
+type CircleGatewayAddDelegate struct {
+	Token    common.Address
+	Delegate common.Address
+}
+
+func (*CircleGatewayAddDelegate) HookName() string { return "CircleGatewayAddDelegateHook" }
+
+// Encode returns the packed CircleGatewayAddDelegateHook data.
+func (d *CircleGatewayAddDelegate) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeCircleGatewayAddDelegate decodes CircleGatewayAddDelegateHook data.
+func DecodeCircleGatewayAddDelegate(data []byte) (*CircleGatewayAddDelegate, error) {
+	return decodeAs[CircleGatewayAddDelegate](data)
+}
+
+// CircleGatewayRemoveDelegate is the data for CircleGatewayRemoveDelegateHook,
+// which removes a delegate from the Circle Gateway wallet.
+type CircleGatewayRemoveDelegate struct {
+	Token    common.Address
+	Delegate common.Address
+}
+
+func (*CircleGatewayRemoveDelegate) HookName() string { return "CircleGatewayRemoveDelegateHook" }
+
+// Encode returns the packed CircleGatewayRemoveDelegateHook data.
+func (d *CircleGatewayRemoveDelegate) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeCircleGatewayRemoveDelegate decodes CircleGatewayRemoveDelegateHook
+// data.
+func DecodeCircleGatewayRemoveDelegate(data []byte) (*CircleGatewayRemoveDelegate, error) {
+	return decodeAs[CircleGatewayRemoveDelegate](data)
+}
+
+// CircleGatewayMinter is the data for CircleGatewayMinterHook, which mints
+// through the Circle Gateway minter.
+type CircleGatewayMinter struct {
+	AttestationPayload []byte `packed:"len"`
+	Signature          []byte `packed:"len"`
+}
+
+func (*CircleGatewayMinter) HookName() string { return "CircleGatewayMinterHook" }
+
+// Encode returns the packed CircleGatewayMinterHook data.
+func (d *CircleGatewayMinter) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeCircleGatewayMinter decodes CircleGatewayMinterHook data.
+func DecodeCircleGatewayMinter(data []byte) (*CircleGatewayMinter, error) {
+	return decodeAs[CircleGatewayMinter](data)
+}
+
+// DeBridgeSendOrderAndExecuteOnDst is the data for
+// DeBridgeSendOrderAndExecuteOnDstHook, which creates a deBridge DLN order with
+// a destination external call.
+type DeBridgeSendOrderAndExecuteOnDst struct {
+	UsePrevHookAmount           bool
+	Value                       *big.Int
+	GiveTokenAddress            common.Address
+	GiveAmount                  *big.Int
+	Version                     uint8
+	FallbackAddress             common.Address
+	ExecutorAddress             common.Address
+	ExecutionFee                *big.Int
+	AllowDelayedExecution       bool
+	RequireSuccessfulExecution  bool
+	DestinationMessage          []byte `packed:"len"`
+	TakeTokenAddress            []byte `packed:"len"`
+	TakeAmount                  *big.Int
+	TakeChainId                 *big.Int
+	ReceiverDst                 []byte `packed:"len"`
+	GivePatchAuthoritySrc       common.Address
+	OrderAuthorityAddressDst    []byte `packed:"len"`
+	AllowedTakerDst             []byte `packed:"len"`
+	AllowedCancelBeneficiarySrc []byte `packed:"len"`
+	AffiliateFee                []byte `packed:"len"`
+	ReferralCode                uint32
+}
+
+func (*DeBridgeSendOrderAndExecuteOnDst) HookName() string {
+	return "DeBridgeSendOrderAndExecuteOnDstHook"
+}
+
+// Encode returns the packed DeBridgeSendOrderAndExecuteOnDstHook data.
+func (d *DeBridgeSendOrderAndExecuteOnDst) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeDeBridgeSendOrderAndExecuteOnDst decodes
+// DeBridgeSendOrderAndExecuteOnDstHook data.
+func DecodeDeBridgeSendOrderAndExecuteOnDst(data []byte) (*DeBridgeSendOrderAndExecuteOnDst, error) {
+	return decodeAs[DeBridgeSendOrderAndExecuteOnDst](data)
+}
+
+// DeBridgeCancelOrder is the data for DeBridgeCancelOrderHook, which cancels a
+// deBridge DLN order on the destination chain.
+type DeBridgeCancelOrder struct {
+	Value                       *big.Int
+	MakerOrderNonce             uint64
+	MakerSrc                    []byte `packed:"len"`
+	GiveTokenAddress            []byte `packed:"len"`
+	GiveAmount                  *big.Int
+	GiveChainId                 *big.Int
+	TakeChainId                 *big.Int
+	TakeTokenAddress            []byte `packed:"len"`
+	TakeAmount                  *big.Int
+	ReceiverDst                 []byte `packed:"len"`
+	GivePatchAuthoritySrc       []byte `packed:"len"`
+	OrderAuthorityAddressDst    []byte `packed:"len"`
+	AllowedTakerDst             []byte `packed:"len"`
+	AllowedCancelBeneficiarySrc []byte `packed:"len"`
+	ExecutionFee                *big.Int
+}
+
+func (*DeBridgeCancelOrder) HookName() string { return "DeBridgeCancelOrderHook" }
+
+// Encode returns the packed DeBridgeCancelOrderHook data.
+func (d *DeBridgeCancelOrder) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeDeBridgeCancelOrder decodes DeBridgeCancelOrderHook data.
+func DecodeDeBridgeCancelOrder(data []byte) (*DeBridgeCancelOrder, error) {
+	return decodeAs[DeBridgeCancelOrder](data)
+}
@@ -0,0 +1,157 @@
+package hooks
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// FluidClaimReward is the data for FluidClaimRewardHook, which claims
+// RewardToken from the Fluid staking contract in Header.YieldSource.
+type FluidClaimReward struct {
+	Header
+	RewardToken common.Address
+	Account     common.Address
+}
+
+func (*FluidClaimReward) HookName() string { return "FluidClaimRewardHook" }
+
+// Encode returns the packed FluidClaimRewardHook data.
+func (d *FluidClaimReward) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeFluidClaimReward decodes FluidClaimRewardHook data.
+func DecodeFluidClaimReward(data []byte) (*FluidClaimReward, error) {
+	return decodeAs[FluidClaimReward](data)
+}
+
+// GearboxClaimReward is the data for GearboxClaimRewardHook, which claims
+// RewardToken from the Gearbox farming pool in Header.YieldSource.
+type GearboxClaimReward struct {
+	Header
+	RewardToken common.Address
+	Account     common.Address
+}
+
+func (*GearboxClaimReward) HookName() string { return "GearboxClaimRewardHook" }
+
+// Encode returns the packed GearboxClaimRewardHook data.
+func (d *GearboxClaimReward) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeGearboxClaimReward decodes GearboxClaimRewardHook data.
+func DecodeGearboxClaimReward(data []byte) (*GearboxClaimReward, error) {
+	return decodeAs[GearboxClaimReward](data)
+}
+
+// YearnClaimOneReward is the data for YearnClaimOneRewardHook, which claims
+// RewardToken from the Yearn vault in Header.YieldSource.
+type YearnClaimOneReward struct {
+	Header
+	RewardToken common.Address
+	Account     common.Address
+}
+
+func (*YearnClaimOneReward) HookName() string { return "YearnClaimOneRewardHook" }
+
+// Encode returns the packed YearnClaimOneRewardHook data.
+func (d *YearnClaimOneReward) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeYearnClaimOneReward decodes YearnClaimOneRewardHook data.
+func DecodeYearnClaimOneReward(data []byte) (*YearnClaimOneReward, error) {
+	return decodeAs[YearnClaimOneReward](data)
+}
+
+// MerklClaimReward is the data for MerklClaimRewardHook, which claims Merkl
+// rewards for the account and pays FeePercent of each claimed amount to
+// FeeReceiver. Proofs[i] is the merkle proof for Tokens[i].
+type MerklClaimReward struct {
+	FeeReceiver common.Address
+	FeePercent  *big.Int
+	Tokens      []common.Address
+	Amounts     []*big.Int
+	Proofs      [][][32]byte
+}
+
+func (*MerklClaimReward) HookName() string { return "MerklClaimRewardHook" }
+
+// Encode returns the packed MerklClaimRewardHook data.
+func (d *MerklClaimReward) Encode() ([]byte, error) { return pack(d) }
+
+func (d *MerklClaimReward) encodePacked() ([]byte, error) {
+	n := len(d.Tokens)
+	if len(d.Amounts) != n || len(d.Proofs) != n {
+		return nil, errLengthMismatch
+	}
+	var w writer
+	w.raw(d.FeeReceiver.Bytes())
+	if err := w.uint256(d.FeePercent); err != nil {
+		return nil, fmt.Errorf("FeePercent: %w", err)
+	}
+	w.uint256(big.NewInt(int64(n)))
+	for _, t := range d.Tokens {
+		w.raw(t.Bytes())
+	}
+	for i, a := range d.Amounts {
+		if err := w.uint256(a); err != nil {
+			return nil, fmt.Errorf("Amounts[%d]: %w", i, err)
+		}
+	}
+	for _, proof := range d.Proofs {
+		w.uint256(big.NewInt(int64(len(proof))))
+		for _, node := range proof {
+			w.raw(node[:])
+		}
+	}
+	return w.buf, nil
+}
+
+func (d *MerklClaimReward) decodePacked(data []byte) error {
+	r := reader{data: data}
+	feeReceiver, err := r.take(common.AddressLength)
+	if err != nil {
+		return err
+	}
+	d.FeeReceiver = common.BytesToAddress(feeReceiver)
+	if d.FeePercent, err = r.uint256(); err != nil {
+		return err
+	}
+	n, err := r.length()
+	if err != nil {
+		return err
+	}
+	d.Tokens = make([]common.Address, n)
+	for i := range d.Tokens {
+		b, err := r.take(common.AddressLength)
+		if err != nil {
+			return err
+		}
+		d.Tokens[i] = common.BytesToAddress(b)
+	}
+	d.Amounts = make([]*big.Int, n)
+	for i := range d.Amounts {
+		if d.Amounts[i], err = r.uint256(); err != nil {
+			return err
+		}
+	}
+	d.Proofs = make([][][32]byte, n)
+	for i := range d.Proofs {
+		m, err := r.length()
+		if err != nil {
+			return err
+		}
+		d.Proofs[i] = make([][32]byte, m)
+		for j := range d.Proofs[i] {
+			b, err := r.take(32)
+			if err != nil {
+				return err
+			}
+			copy(d.Proofs[i][j][:], b)
+		}
+	}
+	return r.done()
+}
+
+// DecodeMerklClaimReward decodes MerklClaimRewardHook data.
+func DecodeMerklClaimReward(data []byte) (*MerklClaimReward, error) {
+	return decodeAs[MerklClaimReward](data)
+}
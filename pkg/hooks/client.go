@@ -0,0 +1,228 @@
+package hooks
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+)
+
+// ErrUnsupported is returned by Hook methods of an optional interface the
+// hook does not implement: the call reverts without data, as a call to a
+// missing function does.
+var ErrUnsupported = errors.New("hooks: method not implemented by hook")
+
+// ABI merges ISuperHook with its result, inspector, context-aware,
+// inflow/outflow, outflow, loan and async-cancel interfaces.
+var ABI = mustParseABI(`[
+	{"type":"function","name":"preExecute","stateMutability":"nonpayable","inputs":[{"name":"prevHook","type":"address"},{"name":"account","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"postExecute","stateMutability":"nonpayable","inputs":[{"name":"prevHook","type":"address"},{"name":"account","type":"address"},{"name":"data","type":"bytes"}],"outputs":[]},
+	{"type":"function","name":"build","stateMutability":"view","inputs":[{"name":"prevHook","type":"address"},{"name":"account","type":"address"},{"name":"data","type":"bytes"}],"outputs":[{"name":"executions","type":"tuple[]","components":[{"name":"target","type":"address"},{"name":"value","type":"uint256"},{"name":"callData","type":"bytes"}]}]},
+	{"type":"function","name":"subtype","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"bytes32"}]},
+	{"type":"function","name":"executionNonce","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"lastCaller","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"hookType","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint8"}]},
+	{"type":"function","name":"spToken","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"asset","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"getOutAmount","stateMutability":"view","inputs":[{"name":"caller","type":"address"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"inspect","stateMutability":"view","inputs":[{"name":"data","type":"bytes"}],"outputs":[{"name":"argsEncoded","type":"bytes"}]},
+	{"type":"function","name":"decodeUsePrevHookAmount","stateMutability":"pure","inputs":[{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"decodeAmount","stateMutability":"pure","inputs":[{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"replaceCalldataAmount","stateMutability":"pure","inputs":[{"name":"data","type":"bytes"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bytes"}]},
+	{"type":"function","name":"usedShares","stateMutability":"view","inputs":[],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getLoanTokenAddress","stateMutability":"pure","inputs":[{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"getCollateralTokenAddress","stateMutability":"view","inputs":[{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"address"}]},
+	{"type":"function","name":"getLoanTokenBalance","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"getCollateralTokenBalance","stateMutability":"view","inputs":[{"name":"account","type":"address"},{"name":"data","type":"bytes"}],"outputs":[{"name":"","type":"uint256"}]},
+	{"type":"function","name":"isAsyncCancelHook","stateMutability":"pure","inputs":[],"outputs":[{"name":"asyncType","type":"uint8"}]}
+]`)
+
+func mustParseABI(def string) abi.ABI {
+	parsed, err := abi.JSON(strings.NewReader(def))
+	if err != nil {
+		panic(fmt.Sprintf("hooks: ABI: %v", err))
+	}
+	return parsed
+}
+
+// Execution mirrors the ERC-7579 Execution struct returned by build.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// Hook reads any deployed hook through ISuperHook and its optional
+// interfaces. Methods of the optional interfaces return ErrUnsupported on
+// hooks that lack them.
+type Hook struct {
+	Address common.Address
+
+	contract *bind.BoundContract
+}
+
+// NewHook binds the hook at address.
+func NewHook(address common.Address, backend bind.ContractCaller) *Hook {
+	return &Hook{Address: address, contract: bind.NewBoundContract(address, ABI, backend, nil, nil)}
+}
+
+// call calls method and returns its single output.
+func (h *Hook) call(opts *bind.CallOpts, method string, args ...any) (any, error) {
+	var out []any
+	if err := h.contract.Call(opts, &out, method, args...); err != nil {
+		if reverts.IsEmptyRevert(err) {
+			return nil, fmt.Errorf("%w: %s", ErrUnsupported, method)
+		}
+		return nil, fmt.Errorf("hooks: %s: %w", method, reverts.Wrap(err))
+	}
+	return out[0], nil
+}
+
+// Build returns the executions the hook builds for account from data.
+func (h *Hook) Build(opts *bind.CallOpts, prevHook, account common.Address, data []byte) ([]Execution, error) {
+	out, err := h.call(opts, "build", prevHook, account, data)
+	if err != nil {
+		return nil, err
+	}
+	return *abi.ConvertType(out, new([]Execution)).(*[]Execution), nil
+}
+
+// HookType returns the hook's accounting type.
+func (h *Hook) HookType(opts *bind.CallOpts) (HookType, error) {
+	out, err := h.call(opts, "hookType")
+	if err != nil {
+		return 0, err
+	}
+	return HookType(out.(uint8)), nil
+}
+
+// Subtype returns the hook's HookSubTypes constant.
+func (h *Hook) Subtype(opts *bind.CallOpts) (SubType, error) {
+	out, err := h.call(opts, "subtype")
+	if err != nil {
+		return SubType{}, err
+	}
+	return SubType(out.([32]byte)), nil
+}
+
+// ExecutionNonce returns the nonce of the current execution context.
+func (h *Hook) ExecutionNonce(opts *bind.CallOpts) (*big.Int, error) {
+	out, err := h.call(opts, "executionNonce")
+	if err != nil {
+		return nil, err
+	}
+	return out.(*big.Int), nil
+}
+
+// LastCaller returns the last caller set by setExecutionContext.
+func (h *Hook) LastCaller(opts *bind.CallOpts) (common.Address, error) {
+	return h.address(opts, "lastCaller")
+}
+
+// SpToken returns the SuperPosition token the hook reports, if any.
+func (h *Hook) SpToken(opts *bind.CallOpts) (common.Address, error) {
+	return h.address(opts, "spToken")
+}
+
+// Asset returns the asset the hook processes, zero for native.
+func (h *Hook) Asset(opts *bind.CallOpts) (common.Address, error) {
+	return h.address(opts, "asset")
+}
+
+// GetOutAmount returns the amount the hook produced for caller.
+func (h *Hook) GetOutAmount(opts *bind.CallOpts, caller common.Address) (*big.Int, error) {
+	return h.amount(opts, "getOutAmount", caller)
+}
+
+// Inspect returns the packed addresses the hook's data touches.
+func (h *Hook) Inspect(opts *bind.CallOpts, data []byte) ([]byte, error) {
+	out, err := h.call(opts, "inspect", data)
+	if err != nil {
+		return nil, err
+	}
+	return out.([]byte), nil
+}
+
+// DecodeUsePrevHookAmount reports whether data takes its amount from the
+// previous hook.
+func (h *Hook) DecodeUsePrevHookAmount(opts *bind.CallOpts, data []byte) (bool, error) {
+	out, err := h.call(opts, "decodeUsePrevHookAmount", data)
+	if err != nil {
+		return false, err
+	}
+	return out.(bool), nil
+}
+
+// DecodeAmount returns the amount encoded in data, for inflow and outflow
+// hooks.
+func (h *Hook) DecodeAmount(opts *bind.CallOpts, data []byte) (*big.Int, error) {
+	return h.amount(opts, "decodeAmount", data)
+}
+
+// ReplaceCalldataAmount returns data with its amount replaced, for outflow
+// hooks.
+func (h *Hook) ReplaceCalldataAmount(opts *bind.CallOpts, data []byte, amount *big.Int) ([]byte, error) {
+	out, err := h.call(opts, "replaceCalldataAmount", data, amount)
+	if err != nil {
+		return nil, err
+	}
+	return out.([]byte), nil
+}
+
+// UsedShares returns the shares consumed by the last outflow.
+func (h *Hook) UsedShares(opts *bind.CallOpts) (*big.Int, error) {
+	return h.amount(opts, "usedShares")
+}
+
+// GetLoanTokenAddress returns the borrowed token of data, for loan hooks.
+func (h *Hook) GetLoanTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	return h.address(opts, "getLoanTokenAddress", data)
+}
+
+// GetCollateralTokenAddress returns the collateral token of data, for loan
+// hooks.
+func (h *Hook) GetCollateralTokenAddress(opts *bind.CallOpts, data []byte) (common.Address, error) {
+	return h.address(opts, "getCollateralTokenAddress", data)
+}
+
+// GetLoanTokenBalance returns account's outstanding loan, for loan hooks.
+func (h *Hook) GetLoanTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	return h.amount(opts, "getLoanTokenBalance", account, data)
+}
+
+// GetCollateralTokenBalance returns account's collateral, for loan hooks.
+func (h *Hook) GetCollateralTokenBalance(opts *bind.CallOpts, account common.Address, data []byte) (*big.Int, error) {
+	return h.amount(opts, "getCollateralTokenBalance", account, data)
+}
+
+// IsAsyncCancelHook returns the kind of request the hook cancels, for async
+// cancel hooks.
+func (h *Hook) IsAsyncCancelHook(opts *bind.CallOpts) (CancelationType, error) {
+	out, err := h.call(opts, "isAsyncCancelHook")
+	if err != nil {
+		return 0, err
+	}
+	return CancelationType(out.(uint8)), nil
+}
+
+func (h *Hook) address(opts *bind.CallOpts, method string, args ...any) (common.Address, error) {
+	out, err := h.call(opts, method, args...)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return out.(common.Address), nil
+}
+
+func (h *Hook) amount(opts *bind.CallOpts, method string, args ...any) (*big.Int, error) {
+	out, err := h.call(opts, method, args...)
+	if err != nil {
+		return nil, err
+	}
+	return out.(*big.Int), nil
+}
@@ -0,0 +1,167 @@
+package hooks_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/ClaimCancelDepositRequest7540Hook"
+	"github.com/superform-xyz/v2-core/contract_bindings/Redeem4626VaultHook"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+)
+
+func TestHookClient(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	auth, client := stack.Auth, stack.Client
+	oracleId := [32]byte{0xaa, 31: 0xbb}
+	yieldSource := common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token := common.HexToAddress("0x2222222222222222222222222222222222222222")
+
+	redeemAddr, _, _, err := Redeem4626VaultHook.DeployRedeem4626VaultHook(auth, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	cancelAddr, _, _, err := ClaimCancelDepositRequest7540Hook.DeployClaimCancelDepositRequest7540Hook(auth, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	stack.Backend.Commit()
+
+	redeem := hooks.NewHook(redeemAddr, client)
+	if typ, err := redeem.HookType(nil); err != nil || typ != mustLookup(t, "Redeem4626VaultHook").Type {
+		t.Fatalf("hookType = %s, %v", typ, err)
+	}
+	if sub, err := redeem.Subtype(nil); err != nil || sub != hooks.SubTypeERC4626 {
+		t.Fatalf("subtype = %s, %v", sub, err)
+	}
+
+	data, err := (&hooks.Redeem4626Vault{
+		Header: hooks.Header{YieldSourceOracleId: oracleId, YieldSource: yieldSource},
+		Owner:  token,
+		Shares: big.NewInt(7),
+	}).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if amount, err := redeem.DecodeAmount(nil, data); err != nil || amount.Int64() != 7 {
+		t.Fatalf("decodeAmount = %v, %v", amount, err)
+	}
+	replaced, err := redeem.ReplaceCalldataAmount(nil, data, big.NewInt(9))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if d, err := hooks.DecodeRedeem4626Vault(replaced); err != nil || d.Shares.Int64() != 9 {
+		t.Fatalf("replaced = %+v, %v", d, err)
+	}
+	if prev, err := redeem.DecodeUsePrevHookAmount(nil, data); err != nil || prev {
+		t.Fatalf("decodeUsePrevHookAmount = %v, %v", prev, err)
+	}
+	execs, err := redeem.Build(nil, [20]byte{}, token, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, e := range execs {
+		found = found || e.Target == yieldSource
+	}
+	if !found {
+		t.Fatalf("build = %+v", execs)
+	}
+	if _, err := redeem.IsAsyncCancelHook(nil); !errors.Is(err, hooks.ErrUnsupported) {
+		t.Fatalf("isAsyncCancelHook on redeem: %v", err)
+	}
+	if _, err := redeem.GetLoanTokenAddress(nil, data); !errors.Is(err, hooks.ErrUnsupported) {
+		t.Fatalf("getLoanTokenAddress on redeem: %v", err)
+	}
+
+	cancel := hooks.NewHook(cancelAddr, client)
+	if typ, err := cancel.IsAsyncCancelHook(nil); err != nil || typ != hooks.CancelInflow {
+		t.Fatalf("isAsyncCancelHook = %s, %v", typ, err)
+	}
+	if sub, err := cancel.Subtype(nil); err != nil || sub != hooks.SubTypeClaimCancelDepositRequest {
+		t.Fatalf("subtype = %s, %v", sub, err)
+	}
+}
+
+func mustLookup(t *testing.T, name string) hooks.Spec {
+	t.Helper()
+	spec, ok := hooks.Lookup(name)
+	if !ok {
+		t.Fatalf("%s not registered", name)
+	}
+	return spec
+}
+
+// artifactDirs are searched in the order generate-contract-bindings.sh
+// --locked uses.
+var artifactDirs = []string{
+	"../../script/locked-bytecode",
+	"../../script/generated-bytecode",
+	"../../script/locked-bytecode-other",
+	"../../script/derived-abi",
+}
+
+func artifactABI(name string) (abi.ABI, error) {
+	for _, dir := range artifactDirs {
+		raw, err := os.ReadFile(filepath.Join(dir, name+".json"))
+		if errors.Is(err, os.ErrNotExist) {
+			continue
+		} else if err != nil {
+			return abi.ABI{}, err
+		}
+		var artifact struct {
+			ABI json.RawMessage `json:"abi"`
+		}
+		if err := json.Unmarshal(raw, &artifact); err != nil {
+			return abi.ABI{}, err
+		}
+		return abi.JSON(bytes.NewReader(artifact.ABI))
+	}
+	return abi.ABI{}, os.ErrNotExist
+}
+
+// Every registered hook must have an artifact and a binding, and the merged
+// ABI must agree with the artifacts implementing each interface.
+func TestABIMatchesArtifacts(t *testing.T) {
+	seen := map[string]bool{}
+	for _, spec := range hooks.Specs() {
+		if _, err := os.Stat(filepath.Join("../../contract_bindings", spec.Name, spec.Name+".go")); err != nil {
+			t.Errorf("%s: no binding: %v", spec.Name, err)
+		}
+		parsed, err := artifactABI(spec.Name)
+		if err != nil {
+			t.Errorf("%s: artifact: %v", spec.Name, err)
+			continue
+		}
+		for _, name := range []string{"build", "hookType", "subtype", "getOutAmount"} {
+			if _, ok := parsed.Methods[name]; !ok {
+				t.Errorf("%s: artifact lacks %s", spec.Name, name)
+			}
+		}
+		for name, m := range parsed.Methods {
+			if own, ok := hooks.ABI.Methods[name]; ok {
+				seen[name] = true
+				if own.Sig != m.Sig || len(own.Outputs) != len(m.Outputs) {
+					t.Errorf("%s.%s: ABI %s, artifact %s", spec.Name, name, own.Sig, m.Sig)
+				}
+			}
+		}
+	}
+	for name := range hooks.ABI.Methods {
+		if !seen[name] {
+			t.Errorf("%s not in any artifact", name)
+		}
+	}
+}
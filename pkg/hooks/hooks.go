@@ -0,0 +1,222 @@
+// Package hooks builds and decodes the packed `hooksData` payloads consumed by
+// the hooks under src/hooks.
+//
+// Every hook reads its data with BytesLib at fixed offsets rather than through
+// abi.decode, so each payload is the `abi.encodePacked` concatenation of its
+// fields. The types in this package list those fields in on-chain order;
+// Encode produces the exact bytes the hook's build() decodes and the matching
+// Decode function turns captured calldata back into a typed value.
+package hooks
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// HookType mirrors ISuperHook.HookType.
+type HookType uint8
+
+const (
+	NonAccounting HookType = iota
+	Inflow
+	Outflow
+)
+
+func (t HookType) String() string {
+	switch t {
+	case NonAccounting:
+		return "NONACCOUNTING"
+	case Inflow:
+		return "INFLOW"
+	case Outflow:
+		return "OUTFLOW"
+	}
+	return fmt.Sprintf("HookType(%d)", uint8(t))
+}
+
+// HookData is implemented by every typed hook payload in this package.
+type HookData interface {
+	// HookName returns the Solidity contract name of the hook consuming the payload.
+	HookName() string
+	// Encode returns the packed bytes expected by the hook's build().
+	Encode() ([]byte, error)
+}
+
+// Header is the leading yieldSourceOracleId/yieldSource pair read by
+// HookDataDecoder. Hooks that do not touch accounting still reserve the first
+// 32 bytes as a placeholder so the yield source sits at offset 32.
+type Header struct {
+	YieldSourceOracleId [32]byte
+	YieldSource         common.Address
+}
+
+// HeaderLength is the number of bytes occupied by Header.
+const HeaderLength = 52
+
+var (
+	// ErrShortData is returned when a payload ends before all fields are read.
+	ErrShortData = errors.New("hooks: data too short")
+	// ErrTrailingData is returned when a payload has bytes left after all fields are read.
+	ErrTrailingData = errors.New("hooks: trailing data")
+	// ErrUnknownHook is returned by Decode for a hook name with no registered layout.
+	ErrUnknownHook = errors.New("hooks: unknown hook")
+	// ErrUnregisteredOracleID is returned by EncodeFor for an accounting hook
+	// payload whose yieldSourceOracleId is not registered on the target chain.
+	ErrUnregisteredOracleID = errors.New("hooks: unregistered yieldSourceOracleId")
+
+	errLengthMismatch = errors.New("array length mismatch")
+)
+
+// ExtractYieldSourceOracleId mirrors HookDataDecoder.extractYieldSourceOracleId.
+func ExtractYieldSourceOracleId(data []byte) ([32]byte, error) {
+	var id [32]byte
+	if len(data) < 32 {
+		return id, ErrShortData
+	}
+	copy(id[:], data[:32])
+	return id, nil
+}
+
+// ExtractYieldSource mirrors HookDataDecoder.extractYieldSource.
+func ExtractYieldSource(data []byte) (common.Address, error) {
+	if len(data) < HeaderLength {
+		return common.Address{}, ErrShortData
+	}
+	return common.BytesToAddress(data[32:HeaderLength]), nil
+}
+
+// Spec describes a shipped hook and how to decode its payload.
+type Spec struct {
+	// Name is the Solidity contract name, e.g. "Deposit4626VaultHook".
+	Name string
+	// Type is the hook's ISuperHook.HookType as set in its constructor.
+	Type HookType
+	// HasHeader reports whether the payload starts with a Header.
+	HasHeader bool
+
+	newData func() HookData
+	decode  func([]byte) (HookData, error)
+}
+
+// New returns an empty payload for the hook described by s.
+func (s Spec) New() HookData {
+	return s.newData()
+}
+
+// Decode decodes a payload for the hook described by s.
+func (s Spec) Decode(data []byte) (HookData, error) {
+	return s.decode(data)
+}
+
+var registry = make(map[string]Spec)
+
+// register adds the layout of T to the registry under T's hook name.
+func register[T any, PT interface {
+	*T
+	HookData
+}](typ HookType) {
+	var zero PT = new(T)
+	_, hasHeader := any(zero).(interface{ header() *Header })
+	registry[zero.HookName()] = Spec{
+		Name:      zero.HookName(),
+		Type:      typ,
+		HasHeader: hasHeader,
+		newData:   func() HookData { return PT(new(T)) },
+		decode: func(data []byte) (HookData, error) {
+			return decodeAs[T, PT](data)
+		},
+	}
+}
+
+// Lookup returns the Spec registered for a hook contract name.
+func Lookup(name string) (Spec, bool) {
+	s, ok := registry[name]
+	return s, ok
+}
+
+// Specs returns every registered hook, sorted by name.
+func Specs() []Spec {
+	out := make([]Spec, 0, len(registry))
+	for _, s := range registry {
+		out = append(out, s)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Name < out[j].Name })
+	return out
+}
+
+// Decode decodes a payload for the hook contract with the given name.
+func Decode(name string, data []byte) (HookData, error) {
+	s, ok := registry[name]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownHook, name)
+	}
+	return s.decode(data)
+}
+
+// HeaderOf returns the Header embedded in d, if its layout has one.
+func HeaderOf(d HookData) (*Header, bool) {
+	h, ok := d.(interface{ header() *Header })
+	if !ok {
+		return nil, false
+	}
+	return h.header(), true
+}
+
+func (h *Header) header() *Header { return h }
+
+// OracleIDs reports whether a yieldSourceOracleId is known on a chain.
+// oracleid.Registry implements it.
+type OracleIDs interface {
+	Registered(chainID uint64, id [32]byte) bool
+}
+
+// EncodeFor encodes d like d.Encode, but first checks that an INFLOW or
+// OUTFLOW hook's yieldSourceOracleId is registered on chainID. Accounting
+// hooks feed that ID straight into SuperLedgerConfiguration lookups, so an
+// unknown ID would only surface as a revert at execution time.
+func EncodeFor(d HookData, chainID uint64, ids OracleIDs) ([]byte, error) {
+	s, ok := registry[d.HookName()]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownHook, d.HookName())
+	}
+	if s.Type != NonAccounting {
+		h, ok := HeaderOf(d)
+		if !ok {
+			return nil, fmt.Errorf("hooks: %s: accounting hook without header", s.Name)
+		}
+		if !ids.Registered(chainID, h.YieldSourceOracleId) {
+			return nil, fmt.Errorf("%w: %s: %x on chain %d", ErrUnregisteredOracleID, s.Name, h.YieldSourceOracleId, chainID)
+		}
+	}
+	return d.Encode()
+}
+
+// decodeAs decodes data into a new T, using a custom decoder when T has one.
+func decodeAs[T any, PT interface {
+	*T
+	HookData
+}](data []byte) (PT, error) {
+	v := PT(new(T))
+	var err error
+	if d, ok := any(v).(packedDecoder); ok {
+		err = d.decodePacked(data)
+	} else {
+		err = unpack(data, v)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("hooks: decode %s: %w", v.HookName(), err)
+	}
+	return v, nil
+}
+
+// bigOrZero returns v, or zero when v is nil.
+func bigOrZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
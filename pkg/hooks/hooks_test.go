@@ -0,0 +1,257 @@
+package hooks
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"reflect"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+var (
+	oracleId    = [32]byte{0xaa, 31: 0xbb}
+	yieldSource = common.HexToAddress("0x1111111111111111111111111111111111111111")
+	token       = common.HexToAddress("0x2222222222222222222222222222222222222222")
+)
+
+func concat(parts ...[]byte) []byte {
+	return bytes.Join(parts, nil)
+}
+
+func word(v int64) []byte {
+	return common.LeftPadBytes(big.NewInt(v).Bytes(), 32)
+}
+
+// Mirrors InternalHelpers._createApproveAndDeposit4626HookData.
+func TestApproveAndDeposit4626VaultGolden(t *testing.T) {
+	d := &ApproveAndDeposit4626Vault{
+		Header:            Header{YieldSourceOracleId: oracleId, YieldSource: yieldSource},
+		Token:             token,
+		Amount:            big.NewInt(1e18),
+		UsePrevHookAmount: true,
+	}
+	want := concat(oracleId[:], yieldSource.Bytes(), token.Bytes(), word(1e18), []byte{1})
+	got, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("encoding mismatch\n got %x\nwant %x", got, want)
+	}
+	// usePrevHookAmount is read at offset 104 by the hook.
+	if got[104] != 1 {
+		t.Fatal("usePrevHookAmount not at offset 104")
+	}
+	decoded, err := DecodeApproveAndDeposit4626Vault(want)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, d) {
+		t.Fatalf("round trip mismatch: %+v", decoded)
+	}
+	if id, _ := ExtractYieldSourceOracleId(got); id != oracleId {
+		t.Fatalf("oracle id = %x", id)
+	}
+	if ys, _ := ExtractYieldSource(got); ys != yieldSource {
+		t.Fatalf("yield source = %s", ys)
+	}
+}
+
+// Mirrors InternalHelpers._createOdosSwapHookData.
+func TestSwapOdosV2Golden(t *testing.T) {
+	path := []byte{0xde, 0xad, 0xbe, 0xef}
+	executor := common.HexToAddress("0x3333333333333333333333333333333333333333")
+	d := &SwapOdosV2{
+		InputToken:     token,
+		InputAmount:    big.NewInt(100),
+		InputReceiver:  yieldSource,
+		OutputToken:    token,
+		OutputQuote:    big.NewInt(99),
+		OutputMin:      big.NewInt(98),
+		PathDefinition: path,
+		Executor:       executor,
+		ReferralCode:   7,
+	}
+	want := concat(token.Bytes(), word(100), yieldSource.Bytes(), token.Bytes(), word(99), word(98), []byte{0},
+		word(int64(len(path))), path, executor.Bytes(), []byte{0, 0, 0, 7})
+	got, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("encoding mismatch\n got %x\nwant %x", got, want)
+	}
+	decoded, err := Decode("SwapOdosV2Hook", got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, d) {
+		t.Fatalf("round trip mismatch: %+v", decoded)
+	}
+}
+
+// Mirrors UniswapV4Parser: int24 tickSpacing is packed as uint32(int32(x)).
+func TestSwapUniswapV4NegativeTickSpacing(t *testing.T) {
+	d := &SwapUniswapV4{Fee: 3000, TickSpacing: -60, AdditionalData: []byte{}}
+	got, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(got) != 218 {
+		t.Fatalf("length = %d, want 218", len(got))
+	}
+	if !bytes.Equal(got[44:48], []byte{0xff, 0xff, 0xff, 0xc4}) {
+		t.Fatalf("tickSpacing bytes = %x", got[44:48])
+	}
+	decoded, err := DecodeSwapUniswapV4(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if decoded.TickSpacing != -60 || decoded.Fee != 3000 {
+		t.Fatalf("decoded %d/%d", decoded.Fee, decoded.TickSpacing)
+	}
+}
+
+// Mirrors InternalHelpers._createBatchTransferFromHookData.
+func TestBatchTransferFromGolden(t *testing.T) {
+	sig := bytes.Repeat([]byte{0x41}, 65)
+	d := &BatchTransferFrom{
+		From:        yieldSource,
+		SigDeadline: big.NewInt(1000),
+		Tokens:      []common.Address{token},
+		Amounts:     []*big.Int{big.NewInt(5)},
+		Nonces:      []uint64{9},
+		Signature:   sig,
+	}
+	want := concat(yieldSource.Bytes(), word(1), word(1000), token.Bytes(), word(5), []byte{0, 0, 0, 0, 0, 9}, sig)
+	got, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("encoding mismatch\n got %x\nwant %x", got, want)
+	}
+	decoded, err := DecodeBatchTransferFrom(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, d) {
+		t.Fatalf("round trip mismatch: %+v", decoded)
+	}
+}
+
+// Mirrors InternalHelpers._createMerklClaimRewardHookData.
+func TestMerklClaimRewardGolden(t *testing.T) {
+	proof := [][32]byte{{1}, {2}}
+	d := &MerklClaimReward{
+		FeeReceiver: yieldSource,
+		FeePercent:  big.NewInt(100),
+		Tokens:      []common.Address{token},
+		Amounts:     []*big.Int{big.NewInt(3)},
+		Proofs:      [][][32]byte{proof},
+	}
+	want := concat(yieldSource.Bytes(), word(100), word(1), token.Bytes(), word(3), word(2), proof[0][:], proof[1][:])
+	got, err := d.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Equal(got, want) {
+		t.Fatalf("encoding mismatch\n got %x\nwant %x", got, want)
+	}
+	decoded, err := DecodeMerklClaimReward(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(decoded, d) {
+		t.Fatalf("round trip mismatch: %+v", decoded)
+	}
+}
+
+func TestDecodeErrors(t *testing.T) {
+	if _, err := DecodeDeposit4626Vault(make([]byte, 84)); !errors.Is(err, ErrShortData) {
+		t.Fatalf("short data: %v", err)
+	}
+	if _, err := DecodeDeposit4626Vault(make([]byte, 86)); !errors.Is(err, ErrTrailingData) {
+		t.Fatalf("trailing data: %v", err)
+	}
+	if _, err := Decode("NoSuchHook", nil); !errors.Is(err, ErrUnknownHook) {
+		t.Fatalf("unknown hook: %v", err)
+	}
+	if _, err := (&Deposit4626Vault{Amount: big.NewInt(-1)}).Encode(); err == nil {
+		t.Fatal("negative amount encoded")
+	}
+}
+
+// Every fixed-layout hook must survive an encode/decode round trip of its
+// zero value, and its Spec must agree with the presence of a Header.
+func TestRegistryRoundTrip(t *testing.T) {
+	for _, spec := range Specs() {
+		v := spec.New()
+		if _, custom := v.(packedEncoder); custom {
+			continue
+		}
+		data, err := v.Encode()
+		if err != nil {
+			t.Fatalf("%s: %v", spec.Name, err)
+		}
+		decoded, err := spec.Decode(data)
+		if err != nil {
+			t.Fatalf("%s: %v", spec.Name, err)
+		}
+		if decoded.HookName() != spec.Name {
+			t.Fatalf("%s: decoded as %s", spec.Name, decoded.HookName())
+		}
+		if _, ok := HeaderOf(decoded); ok != spec.HasHeader {
+			t.Fatalf("%s: HasHeader mismatch", spec.Name)
+		}
+	}
+}
+
+// TestChaining checks that exactly the payloads with a UsePrevHookAmount
+// field implement Chaining, and that they report the field.
+func TestChaining(t *testing.T) {
+	for _, spec := range Specs() {
+		v := spec.New()
+		f := reflect.ValueOf(v).Elem().FieldByName("UsePrevHookAmount")
+		c, ok := v.(Chaining)
+		if ok != f.IsValid() {
+			t.Errorf("%s: implements Chaining = %v, has UsePrevHookAmount = %v", spec.Name, ok, f.IsValid())
+			continue
+		}
+		if ok {
+			f.SetBool(true)
+			if !c.UsesPrevHookAmount() {
+				t.Errorf("%s: UsesPrevHookAmount = false", spec.Name)
+			}
+		}
+	}
+}
+
+// TestAmounted checks that exactly the payloads with an Amount, else
+// Shares, field implement Amounted, and that they return it.
+func TestAmounted(t *testing.T) {
+	for _, spec := range Specs() {
+		v := spec.New()
+		var f reflect.Value
+		for _, name := range []string{"Amount", "Shares"} {
+			if f = reflect.ValueOf(v).Elem().FieldByName(name); f.IsValid() && f.Type() == reflect.TypeOf(new(big.Int)) {
+				break
+			}
+			f = reflect.Value{}
+		}
+		a, ok := v.(Amounted)
+		if ok != f.IsValid() {
+			t.Errorf("%s: implements Amounted = %v, has amount field = %v", spec.Name, ok, f.IsValid())
+			continue
+		}
+		if ok {
+			amount := big.NewInt(7)
+			f.Set(reflect.ValueOf(amount))
+			if a.DataAmount() != amount {
+				t.Errorf("%s: DataAmount = %v", spec.Name, a.DataAmount())
+			}
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package hooks
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MorphoSupply is the data for MorphoSupplyHook, which supplies collateral to a
+// Morpho market.
+type MorphoSupply struct {
+	LoanToken         common.Address
+	CollateralToken   common.Address
+	Oracle            common.Address
+	Irm               common.Address
+	Amount            *big.Int
+	Lltv              *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*MorphoSupply) HookName() string { return "MorphoSupplyHook" }
+
+// Encode returns the packed MorphoSupplyHook data.
+func (d *MorphoSupply) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMorphoSupply decodes MorphoSupplyHook data.
+func DecodeMorphoSupply(data []byte) (*MorphoSupply, error) {
+	return decodeAs[MorphoSupply](data)
+}
+
+// MorphoBorrow is the data for MorphoBorrowHook, which borrows from a Morpho
+// market at LtvRatio.
+type MorphoBorrow struct {
+	LoanToken         common.Address
+	CollateralToken   common.Address
+	Oracle            common.Address
+	Irm               common.Address
+	Amount            *big.Int
+	LtvRatio          *big.Int
+	UsePrevHookAmount bool
+	Lltv              *big.Int
+	Placeholder       bool
+}
+
+func (*MorphoBorrow) HookName() string { return "MorphoBorrowHook" }
+
+// Encode returns the packed MorphoBorrowHook data.
+func (d *MorphoBorrow) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMorphoBorrow decodes MorphoBorrowHook data.
+func DecodeMorphoBorrow(data []byte) (*MorphoBorrow, error) {
+	return decodeAs[MorphoBorrow](data)
+}
+
+// MorphoSupplyAndBorrow is the data for MorphoSupplyAndBorrowHook, which
+// supplies collateral to a Morpho market and borrows against it at LtvRatio.
+type MorphoSupplyAndBorrow struct {
+	LoanToken         common.Address
+	CollateralToken   common.Address
+	Oracle            common.Address
+	Irm               common.Address
+	Amount            *big.Int
+	LtvRatio          *big.Int
+	UsePrevHookAmount bool
+	Lltv              *big.Int
+	Placeholder       bool
+}
+
+func (*MorphoSupplyAndBorrow) HookName() string { return "MorphoSupplyAndBorrowHook" }
+
+// Encode returns the packed MorphoSupplyAndBorrowHook data.
+func (d *MorphoSupplyAndBorrow) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMorphoSupplyAndBorrow decodes MorphoSupplyAndBorrowHook data.
+func DecodeMorphoSupplyAndBorrow(data []byte) (*MorphoSupplyAndBorrow, error) {
+	return decodeAs[MorphoSupplyAndBorrow](data)
+}
+
+// MorphoRepay is the data for MorphoRepayHook, which repays a Morpho loan.
+type MorphoRepay struct {
+	LoanToken         common.Address
+	CollateralToken   common.Address
+	Oracle            common.Address
+	Irm               common.Address
+	Amount            *big.Int
+	Lltv              *big.Int
+	UsePrevHookAmount bool
+	IsFullRepayment   bool
+}
+
+func (*MorphoRepay) HookName() string { return "MorphoRepayHook" }
+
+// Encode returns the packed MorphoRepayHook data.
+func (d *MorphoRepay) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMorphoRepay decodes MorphoRepayHook data.
+func DecodeMorphoRepay(data []byte) (*MorphoRepay, error) {
+	return decodeAs[MorphoRepay](data)
+}
+
+// MorphoRepayAndWithdraw is the data for MorphoRepayAndWithdrawHook, which
+// repays a Morpho loan and withdraws the released collateral.
+type MorphoRepayAndWithdraw struct {
+	LoanToken         common.Address
+	CollateralToken   common.Address
+	Oracle            common.Address
+	Irm               common.Address
+	Amount            *big.Int
+	Lltv              *big.Int
+	UsePrevHookAmount bool
+	IsFullRepayment   bool
+}
+
+func (*MorphoRepayAndWithdraw) HookName() string { return "MorphoRepayAndWithdrawHook" }
+
+// Encode returns the packed MorphoRepayAndWithdrawHook data.
+func (d *MorphoRepayAndWithdraw) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMorphoRepayAndWithdraw decodes MorphoRepayAndWithdrawHook data.
+func DecodeMorphoRepayAndWithdraw(data []byte) (*MorphoRepayAndWithdraw, error) {
+	return decodeAs[MorphoRepayAndWithdraw](data)
+}
+
+// MorphoWithdraw is the data for MorphoWithdrawHook, which withdraws collateral
+// from a Morpho market.
+type MorphoWithdraw struct {
+	LoanToken       common.Address
+	CollateralToken common.Address
+	Oracle          common.Address
+	Irm             common.Address
+	OnBehalf        common.Address
+	Recipient       common.Address
+	Lltv            *big.Int
+	Assets          *big.Int
+	Shares          *big.Int
+}
+
+func (*MorphoWithdraw) HookName() string { return "MorphoWithdrawHook" }
+
+// Encode returns the packed MorphoWithdrawHook data.
+func (d *MorphoWithdraw) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMorphoWithdraw decodes MorphoWithdrawHook data.
+func DecodeMorphoWithdraw(data []byte) (*MorphoWithdraw, error) {
+	return decodeAs[MorphoWithdraw](data)
+}
@@ -0,0 +1,312 @@
+package hooks
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"reflect"
+
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// Struct fields are packed in declaration order, following abi.encodePacked:
+//
+//	[N]byte (bytes32, address)  N bytes
+//	*big.Int                    32 bytes, uint256
+//	bool, uint8                 1 byte
+//	uint32, int32               4 bytes, big-endian
+//	uint64                      8 bytes, big-endian
+//	[]byte `packed:"len"`       32-byte length followed by the bytes
+//	[]byte `packed:"rest"`      remaining bytes, last field only
+//
+// Embedded structs are packed in place.
+
+// packedEncoder is implemented by layouts that cannot be described by field tags.
+type packedEncoder interface {
+	encodePacked() ([]byte, error)
+}
+
+// packedDecoder is the decoding counterpart of packedEncoder.
+type packedDecoder interface {
+	decodePacked([]byte) error
+}
+
+var (
+	bigType   = reflect.TypeOf((*big.Int)(nil))
+	bytesType = reflect.TypeOf([]byte(nil))
+)
+
+var errUint256 = errors.New("value does not fit in uint256")
+
+// pack packs v, which must be a pointer to a layout struct.
+func pack(v any) ([]byte, error) {
+	if e, ok := v.(packedEncoder); ok {
+		return e.encodePacked()
+	}
+	var w writer
+	if err := w.packStruct(reflectValue(v)); err != nil {
+		return nil, err
+	}
+	return w.buf, nil
+}
+
+// unpack unpacks data into v, which must be a pointer to a layout struct.
+func unpack(data []byte, v any) error {
+	r := reader{data: data}
+	if err := r.unpackStruct(reflectValue(v)); err != nil {
+		return err
+	}
+	return r.done()
+}
+
+type writer struct {
+	buf []byte
+}
+
+func (w *writer) uint256(v *big.Int) error {
+	v = bigOrZero(v)
+	if v.Sign() < 0 || v.BitLen() > 256 {
+		return errUint256
+	}
+	w.buf = append(w.buf, math.U256Bytes(new(big.Int).Set(v))...)
+	return nil
+}
+
+func (w *writer) uint(v uint64, size int) {
+	for i := size - 1; i >= 0; i-- {
+		w.buf = append(w.buf, byte(v>>(8*i)))
+	}
+}
+
+func (w *writer) bool(v bool) {
+	if v {
+		w.buf = append(w.buf, 1)
+	} else {
+		w.buf = append(w.buf, 0)
+	}
+}
+
+func (w *writer) raw(b []byte) {
+	w.buf = append(w.buf, b...)
+}
+
+func (w *writer) lenPrefixed(b []byte) {
+	w.uint256(big.NewInt(int64(len(b))))
+	w.raw(b)
+}
+
+func (w *writer) packStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f, fv := t.Field(i), v.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if err := w.packStruct(fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := w.packField(f, fv); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func (w *writer) packField(f reflect.StructField, v reflect.Value) error {
+	switch {
+	case f.Type == bigType:
+		return w.uint256(v.Interface().(*big.Int))
+	case f.Type == bytesType:
+		switch f.Tag.Get("packed") {
+		case "len":
+			w.lenPrefixed(v.Bytes())
+		case "rest":
+			w.raw(v.Bytes())
+		default:
+			return fmt.Errorf("bytes field without packed tag")
+		}
+		return nil
+	}
+	switch f.Type.Kind() {
+	case reflect.Array:
+		if f.Type.Elem().Kind() != reflect.Uint8 {
+			return fmt.Errorf("unsupported array type %s", f.Type)
+		}
+		for i := 0; i < v.Len(); i++ {
+			w.buf = append(w.buf, byte(v.Index(i).Uint()))
+		}
+	case reflect.Bool:
+		w.bool(v.Bool())
+	case reflect.Uint8, reflect.Uint32, reflect.Uint64:
+		w.uint(v.Uint(), int(f.Type.Size()))
+	case reflect.Int32:
+		w.uint(uint64(uint32(v.Int())), 4)
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type)
+	}
+	return nil
+}
+
+type reader struct {
+	data []byte
+	off  int
+}
+
+func (r *reader) take(n int) ([]byte, error) {
+	if n < 0 || len(r.data)-r.off < n {
+		return nil, fmt.Errorf("%w: need %d bytes at offset %d, have %d", ErrShortData, n, r.off, len(r.data))
+	}
+	b := r.data[r.off : r.off+n]
+	r.off += n
+	return b, nil
+}
+
+func (r *reader) uint256() (*big.Int, error) {
+	b, err := r.take(32)
+	if err != nil {
+		return nil, err
+	}
+	return new(big.Int).SetBytes(b), nil
+}
+
+func (r *reader) uint(size int) (uint64, error) {
+	b, err := r.take(size)
+	if err != nil {
+		return 0, err
+	}
+	var v uint64
+	for _, c := range b {
+		v = v<<8 | uint64(c)
+	}
+	return v, nil
+}
+
+func (r *reader) bool() (bool, error) {
+	b, err := r.take(1)
+	if err != nil {
+		return false, err
+	}
+	return b[0] != 0, nil
+}
+
+// length reads a uint256 length prefix and checks it against the remaining data.
+func (r *reader) length() (int, error) {
+	n, err := r.uint256()
+	if err != nil {
+		return 0, err
+	}
+	if !n.IsInt64() || n.Int64() > int64(len(r.data)-r.off) {
+		return 0, fmt.Errorf("%w: length %s at offset %d", ErrShortData, n, r.off-32)
+	}
+	return int(n.Int64()), nil
+}
+
+func (r *reader) lenPrefixed() ([]byte, error) {
+	n, err := r.length()
+	if err != nil {
+		return nil, err
+	}
+	return r.copy(n)
+}
+
+func (r *reader) copy(n int) ([]byte, error) {
+	b, err := r.take(n)
+	if err != nil {
+		return nil, err
+	}
+	return append([]byte{}, b...), nil
+}
+
+func (r *reader) rest() []byte {
+	b := append([]byte{}, r.data[r.off:]...)
+	r.off = len(r.data)
+	return b
+}
+
+func (r *reader) done() error {
+	if r.off != len(r.data) {
+		return fmt.Errorf("%w: %d bytes after offset %d", ErrTrailingData, len(r.data)-r.off, r.off)
+	}
+	return nil
+}
+
+func (r *reader) unpackStruct(v reflect.Value) error {
+	t := v.Type()
+	for i := 0; i < t.NumField(); i++ {
+		f, fv := t.Field(i), v.Field(i)
+		if f.Anonymous && f.Type.Kind() == reflect.Struct {
+			if err := r.unpackStruct(fv); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := r.unpackField(f, fv); err != nil {
+			return fmt.Errorf("%s: %w", f.Name, err)
+		}
+	}
+	return nil
+}
+
+func (r *reader) unpackField(f reflect.StructField, v reflect.Value) error {
+	switch {
+	case f.Type == bigType:
+		n, err := r.uint256()
+		if err != nil {
+			return err
+		}
+		v.Set(reflect.ValueOf(n))
+		return nil
+	case f.Type == bytesType:
+		var (
+			b   []byte
+			err error
+		)
+		switch f.Tag.Get("packed") {
+		case "len":
+			b, err = r.lenPrefixed()
+		case "rest":
+			b = r.rest()
+		default:
+			err = fmt.Errorf("bytes field without packed tag")
+		}
+		if err != nil {
+			return err
+		}
+		v.SetBytes(b)
+		return nil
+	}
+	switch f.Type.Kind() {
+	case reflect.Array:
+		b, err := r.take(v.Len())
+		if err != nil {
+			return err
+		}
+		reflect.Copy(v, reflect.ValueOf(b))
+	case reflect.Bool:
+		b, err := r.bool()
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Uint8, reflect.Uint32, reflect.Uint64:
+		n, err := r.uint(int(f.Type.Size()))
+		if err != nil {
+			return err
+		}
+		v.SetUint(n)
+	case reflect.Int32:
+		n, err := r.uint(4)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(int32(uint32(n))))
+	default:
+		return fmt.Errorf("unsupported field type %s", f.Type)
+	}
+	return nil
+}
+
+// reflectValue returns the addressable struct value behind a pointer.
+func reflectValue(v any) reflect.Value {
+	return reflect.ValueOf(v).Elem()
+}
@@ -0,0 +1,83 @@
+package hooks
+
+// init registers every hook shipped under src/hooks with its constructor HookType.
+func init() {
+	// vaults
+	register[ApproveAndDeposit4626Vault](Inflow)
+	register[Deposit4626Vault](Inflow)
+	register[Redeem4626Vault](Outflow)
+	register[ApproveAndDeposit5115Vault](Inflow)
+	register[Deposit5115Vault](Inflow)
+	register[Redeem5115Vault](Outflow)
+	register[ApproveAndRequestDeposit7540Vault](NonAccounting)
+	register[RequestDeposit7540Vault](NonAccounting)
+	register[RequestRedeem7540Vault](NonAccounting)
+	register[Deposit7540Vault](Inflow)
+	register[Redeem7540Vault](Outflow)
+	register[Withdraw7540Vault](Outflow)
+	register[CancelDepositRequest7540](NonAccounting)
+	register[CancelRedeemRequest7540](NonAccounting)
+	register[ClaimCancelDepositRequest7540](NonAccounting)
+	register[ClaimCancelRedeemRequest7540](NonAccounting)
+	register[SetOperator7540](NonAccounting)
+	register[EthenaCooldownShares](NonAccounting)
+	register[EthenaUnstake](Outflow)
+	register[MintSuperPositions](NonAccounting)
+	register[BurnSuperPositions](NonAccounting)
+
+	// stake
+	register[ApproveAndFluidStake](NonAccounting)
+	register[FluidStake](NonAccounting)
+	register[FluidUnstake](NonAccounting)
+	register[ApproveAndGearboxStake](NonAccounting)
+	register[GearboxStake](NonAccounting)
+	register[GearboxUnstake](NonAccounting)
+
+	// claim
+	register[FluidClaimReward](Outflow)
+	register[GearboxClaimReward](Outflow)
+	register[YearnClaimOneReward](Outflow)
+	register[MerklClaimReward](NonAccounting)
+
+	// tokens
+	register[ApproveERC20](NonAccounting)
+	register[TransferERC20](NonAccounting)
+	register[Transfer](NonAccounting)
+	register[NativeTransfer](NonAccounting)
+	register[DepositWETH](NonAccounting)
+	register[WithdrawWETH](NonAccounting)
+	register[BatchTransfer](NonAccounting)
+	register[OfframpTokens](NonAccounting)
+	register[BatchTransferFrom](NonAccounting)
+
+	// loan
+	register[MorphoSupply](NonAccounting)
+	register[MorphoBorrow](NonAccounting)
+	register[MorphoSupplyAndBorrow](NonAccounting)
+	register[MorphoRepay](NonAccounting)
+	register[MorphoRepayAndWithdraw](NonAccounting)
+	register[MorphoWithdraw](NonAccounting)
+
+	// swappers
+	register[SwapOdosV2](NonAccounting)
+	register[ApproveAndSwapOdosV2](NonAccounting)
+	register[Swap1Inch](NonAccounting)
+	register[PendleRouterSwap](NonAccounting)
+	register[PendleRouterRedeem](NonAccounting)
+	register[SpectraExchangeDeposit](NonAccounting)
+	register[SpectraExchangeRedeem](NonAccounting)
+	register[SwapUniswapV4](NonAccounting)
+
+	// bridges
+	register[AcrossSendFundsAndExecuteOnDst](NonAccounting)
+	register[ApproveAndAcrossSendFundsAndExecuteOnDst](NonAccounting)
+	register[CircleGatewayWallet](NonAccounting)
+	register[CircleGatewayAddDelegate](NonAccounting)
+	register[CircleGatewayRemoveDelegate](NonAccounting)
+	register[CircleGatewayMinter](NonAccounting)
+	register[DeBridgeSendOrderAndExecuteOnDst](NonAccounting)
+	register[DeBridgeCancelOrder](NonAccounting)
+
+	// superform
+	register[MarkRootAsUsed](NonAccounting)
+}
@@ -0,0 +1,117 @@
+package hooks
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ApproveAndFluidStake is the data for ApproveAndFluidStakeHook, which approves
+// Token and stakes it in a Fluid staking contract.
+type ApproveAndFluidStake struct {
+	Header
+	Token             common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*ApproveAndFluidStake) HookName() string { return "ApproveAndFluidStakeHook" }
+
+// Encode returns the packed ApproveAndFluidStakeHook data.
+func (d *ApproveAndFluidStake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndFluidStake decodes ApproveAndFluidStakeHook data.
+func DecodeApproveAndFluidStake(data []byte) (*ApproveAndFluidStake, error) {
+	return decodeAs[ApproveAndFluidStake](data)
+}
+
+// FluidStake is the data for FluidStakeHook, which stakes into a Fluid staking
+// contract.
+type FluidStake struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*FluidStake) HookName() string { return "FluidStakeHook" }
+
+// Encode returns the packed FluidStakeHook data.
+func (d *FluidStake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeFluidStake decodes FluidStakeHook data.
+func DecodeFluidStake(data []byte) (*FluidStake, error) {
+	return decodeAs[FluidStake](data)
+}
+
+// FluidUnstake is the data for FluidUnstakeHook, which unstakes from a Fluid
+// staking contract.
+type FluidUnstake struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*FluidUnstake) HookName() string { return "FluidUnstakeHook" }
+
+// Encode returns the packed FluidUnstakeHook data.
+func (d *FluidUnstake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeFluidUnstake decodes FluidUnstakeHook data.
+func DecodeFluidUnstake(data []byte) (*FluidUnstake, error) {
+	return decodeAs[FluidUnstake](data)
+}
+
+// ApproveAndGearboxStake is the data for ApproveAndGearboxStakeHook, which
+// approves Token and stakes it in a Gearbox farming pool.
+type ApproveAndGearboxStake struct {
+	Header
+	Token             common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*ApproveAndGearboxStake) HookName() string { return "ApproveAndGearboxStakeHook" }
+
+// Encode returns the packed ApproveAndGearboxStakeHook data.
+func (d *ApproveAndGearboxStake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndGearboxStake decodes ApproveAndGearboxStakeHook data.
+func DecodeApproveAndGearboxStake(data []byte) (*ApproveAndGearboxStake, error) {
+	return decodeAs[ApproveAndGearboxStake](data)
+}
+
+// GearboxStake is the data for GearboxStakeHook, which stakes into a Gearbox
+// farming pool.
+type GearboxStake struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*GearboxStake) HookName() string { return "GearboxStakeHook" }
+
+// Encode returns the packed GearboxStakeHook data.
+func (d *GearboxStake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeGearboxStake decodes GearboxStakeHook data.
+func DecodeGearboxStake(data []byte) (*GearboxStake, error) {
+	return decodeAs[GearboxStake](data)
+}
+
+// GearboxUnstake is the data for GearboxUnstakeHook, which unstakes from a
+// Gearbox farming pool.
+type GearboxUnstake struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*GearboxUnstake) HookName() string { return "GearboxUnstakeHook" }
+
+// Encode returns the packed GearboxUnstakeHook data.
+func (d *GearboxUnstake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeGearboxUnstake decodes GearboxUnstakeHook data.
+func DecodeGearboxUnstake(data []byte) (*GearboxUnstake, error) {
+	return decodeAs[GearboxUnstake](data)
+}
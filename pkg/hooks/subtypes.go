@@ -0,0 +1,90 @@
+package hooks
+
+import (
+	"fmt"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// SubType mirrors the HookSubTypes constants returned by ISuperHook.subtype:
+// the keccak256 hash of the subtype name.
+type SubType [32]byte
+
+// NewSubType mirrors HookSubTypes.getHookSubType.
+func NewSubType(name string) SubType {
+	return SubType(crypto.Keccak256Hash([]byte(name)))
+}
+
+var (
+	SubTypeBridge                    = NewSubType("Bridge")
+	SubTypeCancelDeposit             = NewSubType("CancelDeposit")
+	SubTypeCancelDepositRequest      = NewSubType("CancelDepositRequest")
+	SubTypeCancelRedeem              = NewSubType("CancelRedeem")
+	SubTypeCancelRedeemRequest       = NewSubType("CancelRedeemRequest")
+	SubTypeClaim                     = NewSubType("Claim")
+	SubTypeClaimCancelDepositRequest = NewSubType("ClaimCancelDepositRequest")
+	SubTypeClaimCancelRedeemRequest  = NewSubType("ClaimCancelRedeemRequest")
+	SubTypeCooldown                  = NewSubType("Cooldown")
+	SubTypeEthena                    = NewSubType("Ethena")
+	SubTypeERC4626                   = NewSubType("ERC4626")
+	SubTypeERC5115                   = NewSubType("ERC5115")
+	SubTypeERC7540                   = NewSubType("ERC7540")
+	SubTypeLoan                      = NewSubType("Loan")
+	SubTypeLoanRepay                 = NewSubType("LoanRepay")
+	SubTypeMisc                      = NewSubType("Misc")
+	SubTypeStake                     = NewSubType("Stake")
+	SubTypeSwap                      = NewSubType("Swap")
+	SubTypeToken                     = NewSubType("Token")
+	SubTypeUnstake                   = NewSubType("Unstake")
+	SubTypePTYT                      = NewSubType("PTYT")
+	SubTypeVaultBank                 = NewSubType("VaultBank")
+)
+
+// subTypeNames maps every HookSubTypes constant to its name.
+var subTypeNames = func() map[SubType]string {
+	m := make(map[SubType]string)
+	for _, name := range []string{
+		"Bridge", "CancelDeposit", "CancelDepositRequest", "CancelRedeem", "CancelRedeemRequest",
+		"Claim", "ClaimCancelDepositRequest", "ClaimCancelRedeemRequest", "Cooldown", "Ethena",
+		"ERC4626", "ERC5115", "ERC7540", "Loan", "LoanRepay", "Misc", "Stake", "Swap", "Token",
+		"Unstake", "PTYT", "VaultBank",
+	} {
+		m[NewSubType(name)] = name
+	}
+	return m
+}()
+
+// Name returns the HookSubTypes name of s, if it is one of the constants.
+func (s SubType) Name() (string, bool) {
+	name, ok := subTypeNames[s]
+	return name, ok
+}
+
+func (s SubType) String() string {
+	if name, ok := s.Name(); ok {
+		return name
+	}
+	return common.Hash(s).Hex()
+}
+
+// CancelationType mirrors ISuperHookAsyncCancelations.CancelationType.
+type CancelationType uint8
+
+const (
+	CancelNone CancelationType = iota
+	CancelInflow
+	CancelOutflow
+)
+
+func (t CancelationType) String() string {
+	switch t {
+	case CancelNone:
+		return "NONE"
+	case CancelInflow:
+		return "INFLOW"
+	case CancelOutflow:
+		return "OUTFLOW"
+	}
+	return fmt.Sprintf("CancelationType(%d)", uint8(t))
+}
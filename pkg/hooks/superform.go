@@ -0,0 +1,49 @@
+package hooks
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+)
+
+// MarkRootAsUsed is the data for MarkRootAsUsedHook, which marks MerkleRoots
+// as used on the SuperDestinationExecutor carried in Header.YieldSource. The
+// oracle ID is a placeholder and the roots are appended as abi.encode(bytes32[]).
+type MarkRootAsUsed struct {
+	Header
+	MerkleRoots [][32]byte
+}
+
+func (*MarkRootAsUsed) HookName() string { return "MarkRootAsUsedHook" }
+
+// Encode returns the packed MarkRootAsUsedHook data.
+func (d *MarkRootAsUsed) Encode() ([]byte, error) { return pack(d) }
+
+func (d *MarkRootAsUsed) encodePacked() ([]byte, error) {
+	roots, err := abi.Arguments{{Type: bytes32ArrayType}}.Pack(nonNil(d.MerkleRoots))
+	if err != nil {
+		return nil, err
+	}
+	var w writer
+	if err := w.packStruct(reflectValue(&d.Header)); err != nil {
+		return nil, err
+	}
+	w.raw(roots)
+	return w.buf, nil
+}
+
+func (d *MarkRootAsUsed) decodePacked(data []byte) error {
+	r := reader{data: data}
+	if err := r.unpackStruct(reflectValue(&d.Header)); err != nil {
+		return err
+	}
+	values, err := abi.Arguments{{Type: bytes32ArrayType}}.Unpack(r.rest())
+	if err != nil {
+		return err
+	}
+	d.MerkleRoots = values[0].([][32]byte)
+	return nil
+}
+
+// DecodeMarkRootAsUsed decodes MarkRootAsUsedHook data.
+func DecodeMarkRootAsUsed(data []byte) (*MarkRootAsUsed, error) {
+	return decodeAs[MarkRootAsUsed](data)
+}
@@ -0,0 +1,188 @@
+package hooks
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// SwapOdosV2 is the data for SwapOdosV2Hook, which swaps through the Odos V2
+// router.
+type SwapOdosV2 struct {
+	InputToken        common.Address
+	InputAmount       *big.Int
+	InputReceiver     common.Address
+	OutputToken       common.Address
+	OutputQuote       *big.Int
+	OutputMin         *big.Int
+	UsePrevHookAmount bool
+	PathDefinition    []byte `packed:"len"`
+	Executor          common.Address
+	ReferralCode      uint32
+}
+
+func (*SwapOdosV2) HookName() string { return "SwapOdosV2Hook" }
+
+// Encode returns the packed SwapOdosV2Hook data.
+func (d *SwapOdosV2) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeSwapOdosV2 decodes SwapOdosV2Hook data.
+func DecodeSwapOdosV2(data []byte) (*SwapOdosV2, error) {
+	return decodeAs[SwapOdosV2](data)
+}
+
+// ApproveAndSwapOdosV2 is the data for ApproveAndSwapOdosV2Hook, which approves
+// the Odos V2 router and swaps through it.
+type ApproveAndSwapOdosV2 struct {
+	InputToken        common.Address
+	InputAmount       *big.Int
+	InputReceiver     common.Address
+	OutputToken       common.Address
+	OutputQuote       *big.Int
+	OutputMin         *big.Int
+	UsePrevHookAmount bool
+	PathDefinition    []byte `packed:"len"`
+	Executor          common.Address
+	ReferralCode      uint32
+}
+
+func (*ApproveAndSwapOdosV2) HookName() string { return "ApproveAndSwapOdosV2Hook" }
+
+// Encode returns the packed ApproveAndSwapOdosV2Hook data.
+func (d *ApproveAndSwapOdosV2) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndSwapOdosV2 decodes ApproveAndSwapOdosV2Hook data.
+func DecodeApproveAndSwapOdosV2(data []byte) (*ApproveAndSwapOdosV2, error) {
+	return decodeAs[ApproveAndSwapOdosV2](data)
+}
+
+// Swap1Inch is the data for Swap1InchHook, which swaps through the 1inch
+// aggregation router. TxData is the full router calldata.
+type Swap1Inch struct {
+	DstToken          common.Address
+	DstReceiver       common.Address
+	Value             *big.Int
+	UsePrevHookAmount bool
+	TxData            []byte `packed:"rest"`
+}
+
+func (*Swap1Inch) HookName() string { return "Swap1InchHook" }
+
+// Encode returns the packed Swap1InchHook data.
+func (d *Swap1Inch) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeSwap1Inch decodes Swap1InchHook data.
+func DecodeSwap1Inch(data []byte) (*Swap1Inch, error) {
+	return decodeAs[Swap1Inch](data)
+}
+
+// PendleRouterSwap is the data for PendleRouterSwapHook, which swaps through
+// the Pendle V4 router. TxData is the full router calldata.
+type PendleRouterSwap struct {
+	Header
+	UsePrevHookAmount bool
+	Value             *big.Int
+	TxData            []byte `packed:"rest"`
+}
+
+func (*PendleRouterSwap) HookName() string { return "PendleRouterSwapHook" }
+
+// Encode returns the packed PendleRouterSwapHook data.
+func (d *PendleRouterSwap) Encode() ([]byte, error) { return pack(d) }
+
+// DecodePendleRouterSwap decodes PendleRouterSwapHook data.
+func DecodePendleRouterSwap(data []byte) (*PendleRouterSwap, error) {
+	return decodeAs[PendleRouterSwap](data)
+}
+
+// PendleRouterRedeem is the data for PendleRouterRedeemHook, which redeems
+// PT/YT through the Pendle V4 router. Output is abi.encode(TokenOutput).
+type PendleRouterRedeem struct {
+	Amount            *big.Int
+	Yt                common.Address
+	Pt                common.Address
+	TokenOut          common.Address
+	MinTokenOut       *big.Int
+	UsePrevHookAmount bool
+	Output            []byte `packed:"rest"`
+}
+
+func (*PendleRouterRedeem) HookName() string { return "PendleRouterRedeemHook" }
+
+// Encode returns the packed PendleRouterRedeemHook data.
+func (d *PendleRouterRedeem) Encode() ([]byte, error) { return pack(d) }
+
+// DecodePendleRouterRedeem decodes PendleRouterRedeemHook data.
+func DecodePendleRouterRedeem(data []byte) (*PendleRouterRedeem, error) {
+	return decodeAs[PendleRouterRedeem](data)
+}
+
+// SpectraExchangeDeposit is the data for SpectraExchangeDepositHook, which
+// deposits through the Spectra router. TxData is the full router calldata.
+type SpectraExchangeDeposit struct {
+	Header
+	UsePrevHookAmount bool
+	Value             *big.Int
+	TxData            []byte `packed:"rest"`
+}
+
+func (*SpectraExchangeDeposit) HookName() string { return "SpectraExchangeDepositHook" }
+
+// Encode returns the packed SpectraExchangeDepositHook data.
+func (d *SpectraExchangeDeposit) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeSpectraExchangeDeposit decodes SpectraExchangeDepositHook data.
+func DecodeSpectraExchangeDeposit(data []byte) (*SpectraExchangeDeposit, error) {
+	return decodeAs[SpectraExchangeDeposit](data)
+}
+
+// SpectraExchangeRedeem is the data for SpectraExchangeRedeemHook, which
+// redeems through the Spectra router. Header.YieldSource carries the asset and
+// Command is REDEEM_PT_FOR_ASSET or REDEEM_IBT_FOR_ASSET.
+type SpectraExchangeRedeem struct {
+	Header
+	Pt                common.Address
+	Recipient         common.Address
+	MinAssets         *big.Int
+	SharesToBurn      *big.Int
+	UsePrevHookAmount bool
+	Command           uint8
+}
+
+func (*SpectraExchangeRedeem) HookName() string { return "SpectraExchangeRedeemHook" }
+
+// Encode returns the packed SpectraExchangeRedeemHook data.
+func (d *SpectraExchangeRedeem) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeSpectraExchangeRedeem decodes SpectraExchangeRedeemHook data.
+func DecodeSpectraExchangeRedeem(data []byte) (*SpectraExchangeRedeem, error) {
+	return decodeAs[SpectraExchangeRedeem](data)
+}
+
+// SwapUniswapV4 is the data for SwapUniswapV4Hook, which swaps through a
+// Uniswap V4 pool. Fee and TickSpacing are packed as 4-byte values.
+type SwapUniswapV4 struct {
+	Currency0               common.Address
+	Currency1               common.Address
+	Fee                     uint32
+	TickSpacing             int32
+	Hooks                   common.Address
+	DstReceiver             common.Address
+	SqrtPriceLimitX96       *big.Int
+	OriginalAmountIn        *big.Int
+	OriginalMinAmountOut    *big.Int
+	MaxSlippageDeviationBps *big.Int
+	ZeroForOne              bool
+	UsePrevHookAmount       bool
+	AdditionalData          []byte `packed:"rest"`
+}
+
+func (*SwapUniswapV4) HookName() string { return "SwapUniswapV4Hook" }
+
+// Encode returns the packed SwapUniswapV4Hook data.
+func (d *SwapUniswapV4) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeSwapUniswapV4 decodes SwapUniswapV4Hook data.
+func DecodeSwapUniswapV4(data []byte) (*SwapUniswapV4, error) {
+	return decodeAs[SwapUniswapV4](data)
+}
@@ -0,0 +1,325 @@
+package hooks
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ApproveERC20 is the data for ApproveERC20Hook, which approves Spender for
+// Amount of Token.
+type ApproveERC20 struct {
+	Token             common.Address
+	Spender           common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*ApproveERC20) HookName() string { return "ApproveERC20Hook" }
+
+// Encode returns the packed ApproveERC20Hook data.
+func (d *ApproveERC20) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveERC20 decodes ApproveERC20Hook data.
+func DecodeApproveERC20(data []byte) (*ApproveERC20, error) {
+	return decodeAs[ApproveERC20](data)
+}
+
+// TransferERC20 is the data for TransferERC20Hook, which transfers Amount of
+// Token to To.
+type TransferERC20 struct {
+	Token             common.Address
+	To                common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*TransferERC20) HookName() string { return "TransferERC20Hook" }
+
+// Encode returns the packed TransferERC20Hook data.
+func (d *TransferERC20) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeTransferERC20 decodes TransferERC20Hook data.
+func DecodeTransferERC20(data []byte) (*TransferERC20, error) {
+	return decodeAs[TransferERC20](data)
+}
+
+// Transfer is the data for TransferHook, which transfers Amount of Token, or
+// native currency for the native sentinel, to To.
+type Transfer struct {
+	Token             common.Address
+	To                common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Transfer) HookName() string { return "TransferHook" }
+
+// Encode returns the packed TransferHook data.
+func (d *Transfer) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeTransfer decodes TransferHook data.
+func DecodeTransfer(data []byte) (*Transfer, error) {
+	return decodeAs[Transfer](data)
+}
+
+// NativeTransfer is the data for NativeTransferHook, which transfers Amount of
+// native currency to To.
+type NativeTransfer struct {
+	To     common.Address
+	Amount *big.Int
+}
+
+func (*NativeTransfer) HookName() string { return "NativeTransferHook" }
+
+// Encode returns the packed NativeTransferHook data.
+func (d *NativeTransfer) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeNativeTransfer decodes NativeTransferHook data.
+func DecodeNativeTransfer(data []byte) (*NativeTransfer, error) {
+	return decodeAs[NativeTransfer](data)
+}
+
+// DepositWETH is the data for DepositWETHHook, which wraps native currency into
+// WETH.
+type DepositWETH struct {
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*DepositWETH) HookName() string { return "DepositWETHHook" }
+
+// Encode returns the packed DepositWETHHook data.
+func (d *DepositWETH) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeDepositWETH decodes DepositWETHHook data.
+func DecodeDepositWETH(data []byte) (*DepositWETH, error) {
+	return decodeAs[DepositWETH](data)
+}
+
+// WithdrawWETH is the data for WithdrawWETHHook, which unwraps WETH into native
+// currency.
+type WithdrawWETH struct {
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*WithdrawWETH) HookName() string { return "WithdrawWETHHook" }
+
+// Encode returns the packed WithdrawWETHHook data.
+func (d *WithdrawWETH) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeWithdrawWETH decodes WithdrawWETHHook data.
+func DecodeWithdrawWETH(data []byte) (*WithdrawWETH, error) {
+	return decodeAs[WithdrawWETH](data)
+}
+
+var (
+	addressArrayType, _ = abi.NewType("address[]", "", nil)
+	uint256ArrayType, _ = abi.NewType("uint256[]", "", nil)
+	bytes32ArrayType, _ = abi.NewType("bytes32[]", "", nil)
+)
+
+// BatchTransfer is the data for BatchTransferHook, which transfers each of
+// Tokens to To. The arrays are appended as abi.encode(address[], uint256[]).
+type BatchTransfer struct {
+	To      common.Address
+	Tokens  []common.Address
+	Amounts []*big.Int
+}
+
+func (*BatchTransfer) HookName() string { return "BatchTransferHook" }
+
+// Encode returns the packed BatchTransferHook data.
+func (d *BatchTransfer) Encode() ([]byte, error) { return pack(d) }
+
+func (d *BatchTransfer) encodePacked() ([]byte, error) {
+	if len(d.Tokens) != len(d.Amounts) {
+		return nil, errLengthMismatch
+	}
+	amounts := make([]*big.Int, len(d.Amounts))
+	for i, a := range d.Amounts {
+		amounts[i] = bigOrZero(a)
+	}
+	arrays, err := abi.Arguments{{Type: addressArrayType}, {Type: uint256ArrayType}}.Pack(nonNil(d.Tokens), amounts)
+	if err != nil {
+		return nil, err
+	}
+	return append(d.To.Bytes(), arrays...), nil
+}
+
+func (d *BatchTransfer) decodePacked(data []byte) error {
+	r := reader{data: data}
+	to, err := r.take(common.AddressLength)
+	if err != nil {
+		return err
+	}
+	values, err := abi.Arguments{{Type: addressArrayType}, {Type: uint256ArrayType}}.Unpack(r.rest())
+	if err != nil {
+		return err
+	}
+	d.To = common.BytesToAddress(to)
+	d.Tokens = values[0].([]common.Address)
+	d.Amounts = values[1].([]*big.Int)
+	if len(d.Tokens) != len(d.Amounts) {
+		return errLengthMismatch
+	}
+	return nil
+}
+
+// DecodeBatchTransfer decodes BatchTransferHook data.
+func DecodeBatchTransfer(data []byte) (*BatchTransfer, error) {
+	return decodeAs[BatchTransfer](data)
+}
+
+// OfframpTokens is the data for OfframpTokensHook, which sends the account's
+// full balance of each of Tokens to To. The array is appended as
+// abi.encode(address[]).
+type OfframpTokens struct {
+	To     common.Address
+	Tokens []common.Address
+}
+
+func (*OfframpTokens) HookName() string { return "OfframpTokensHook" }
+
+// Encode returns the packed OfframpTokensHook data.
+func (d *OfframpTokens) Encode() ([]byte, error) { return pack(d) }
+
+func (d *OfframpTokens) encodePacked() ([]byte, error) {
+	arrays, err := abi.Arguments{{Type: addressArrayType}}.Pack(nonNil(d.Tokens))
+	if err != nil {
+		return nil, err
+	}
+	return append(d.To.Bytes(), arrays...), nil
+}
+
+func (d *OfframpTokens) decodePacked(data []byte) error {
+	r := reader{data: data}
+	to, err := r.take(common.AddressLength)
+	if err != nil {
+		return err
+	}
+	values, err := abi.Arguments{{Type: addressArrayType}}.Unpack(r.rest())
+	if err != nil {
+		return err
+	}
+	d.To = common.BytesToAddress(to)
+	d.Tokens = values[0].([]common.Address)
+	return nil
+}
+
+// DecodeOfframpTokens decodes OfframpTokensHook data.
+func DecodeOfframpTokens(data []byte) (*OfframpTokens, error) {
+	return decodeAs[OfframpTokens](data)
+}
+
+// permit2SignatureLength is the only signature size accepted by
+// BatchTransferFromHook; EIP-2098 compact signatures are not supported.
+const permit2SignatureLength = 65
+
+// BatchTransferFrom is the data for BatchTransferFromHook, which pulls Tokens
+// from From through a Permit2 batch permit. Nonces are Permit2 uint48 nonces.
+type BatchTransferFrom struct {
+	From        common.Address
+	SigDeadline *big.Int
+	Tokens      []common.Address
+	Amounts     []*big.Int
+	Nonces      []uint64
+	Signature   []byte
+}
+
+func (*BatchTransferFrom) HookName() string { return "BatchTransferFromHook" }
+
+// Encode returns the packed BatchTransferFromHook data.
+func (d *BatchTransferFrom) Encode() ([]byte, error) { return pack(d) }
+
+func (d *BatchTransferFrom) encodePacked() ([]byte, error) {
+	n := len(d.Tokens)
+	if len(d.Amounts) != n || len(d.Nonces) != n {
+		return nil, errLengthMismatch
+	}
+	if len(d.Signature) != permit2SignatureLength {
+		return nil, fmt.Errorf("signature must be %d bytes, got %d", permit2SignatureLength, len(d.Signature))
+	}
+	var w writer
+	w.raw(d.From.Bytes())
+	w.uint256(big.NewInt(int64(n)))
+	if err := w.uint256(d.SigDeadline); err != nil {
+		return nil, fmt.Errorf("SigDeadline: %w", err)
+	}
+	for _, t := range d.Tokens {
+		w.raw(t.Bytes())
+	}
+	for i, a := range d.Amounts {
+		if err := w.uint256(a); err != nil {
+			return nil, fmt.Errorf("Amounts[%d]: %w", i, err)
+		}
+	}
+	for i, nonce := range d.Nonces {
+		if nonce >= 1<<48 {
+			return nil, fmt.Errorf("Nonces[%d]: value does not fit in uint48", i)
+		}
+		w.uint(nonce, 6)
+	}
+	w.raw(d.Signature)
+	return w.buf, nil
+}
+
+func (d *BatchTransferFrom) decodePacked(data []byte) error {
+	r := reader{data: data}
+	from, err := r.take(common.AddressLength)
+	if err != nil {
+		return err
+	}
+	count, err := r.uint256()
+	if err != nil {
+		return err
+	}
+	if !count.IsInt64() || count.Int64() > int64(len(data)) {
+		return fmt.Errorf("%w: token count %s", ErrShortData, count)
+	}
+	n := int(count.Int64())
+	if d.SigDeadline, err = r.uint256(); err != nil {
+		return err
+	}
+	d.From = common.BytesToAddress(from)
+	d.Tokens = make([]common.Address, n)
+	for i := range d.Tokens {
+		b, err := r.take(common.AddressLength)
+		if err != nil {
+			return err
+		}
+		d.Tokens[i] = common.BytesToAddress(b)
+	}
+	d.Amounts = make([]*big.Int, n)
+	for i := range d.Amounts {
+		if d.Amounts[i], err = r.uint256(); err != nil {
+			return err
+		}
+	}
+	d.Nonces = make([]uint64, n)
+	for i := range d.Nonces {
+		if d.Nonces[i], err = r.uint(6); err != nil {
+			return err
+		}
+	}
+	if d.Signature, err = r.copy(permit2SignatureLength); err != nil {
+		return err
+	}
+	return r.done()
+}
+
+// DecodeBatchTransferFrom decodes BatchTransferFromHook data.
+func DecodeBatchTransferFrom(data []byte) (*BatchTransferFrom, error) {
+	return decodeAs[BatchTransferFrom](data)
+}
+
+// nonNil returns an empty slice for nil so abi packing accepts it.
+func nonNil[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
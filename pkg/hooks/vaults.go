@@ -0,0 +1,401 @@
+package hooks
+
+import (
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// ApproveAndDeposit4626Vault is the data for ApproveAndDeposit4626VaultHook,
+// which approves Token and deposits it into an ERC-4626 vault.
+type ApproveAndDeposit4626Vault struct {
+	Header
+	Token             common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*ApproveAndDeposit4626Vault) HookName() string { return "ApproveAndDeposit4626VaultHook" }
+
+// Encode returns the packed ApproveAndDeposit4626VaultHook data.
+func (d *ApproveAndDeposit4626Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndDeposit4626Vault decodes ApproveAndDeposit4626VaultHook data.
+func DecodeApproveAndDeposit4626Vault(data []byte) (*ApproveAndDeposit4626Vault, error) {
+	return decodeAs[ApproveAndDeposit4626Vault](data)
+}
+
+// Deposit4626Vault is the data for Deposit4626VaultHook, which deposits the
+// vault asset into an ERC-4626 vault.
+type Deposit4626Vault struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Deposit4626Vault) HookName() string { return "Deposit4626VaultHook" }
+
+// Encode returns the packed Deposit4626VaultHook data.
+func (d *Deposit4626Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeDeposit4626Vault decodes Deposit4626VaultHook data.
+func DecodeDeposit4626Vault(data []byte) (*Deposit4626Vault, error) {
+	return decodeAs[Deposit4626Vault](data)
+}
+
+// Redeem4626Vault is the data for Redeem4626VaultHook, which redeems ERC-4626
+// shares held by Owner.
+type Redeem4626Vault struct {
+	Header
+	Owner             common.Address
+	Shares            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Redeem4626Vault) HookName() string { return "Redeem4626VaultHook" }
+
+// Encode returns the packed Redeem4626VaultHook data.
+func (d *Redeem4626Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeRedeem4626Vault decodes Redeem4626VaultHook data.
+func DecodeRedeem4626Vault(data []byte) (*Redeem4626Vault, error) {
+	return decodeAs[Redeem4626Vault](data)
+}
+
+// ApproveAndDeposit5115Vault is the data for ApproveAndDeposit5115VaultHook,
+// which approves TokenIn and deposits it into an ERC-5115 vault.
+type ApproveAndDeposit5115Vault struct {
+	Header
+	TokenIn           common.Address
+	Amount            *big.Int
+	MinSharesOut      *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*ApproveAndDeposit5115Vault) HookName() string { return "ApproveAndDeposit5115VaultHook" }
+
+// Encode returns the packed ApproveAndDeposit5115VaultHook data.
+func (d *ApproveAndDeposit5115Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndDeposit5115Vault decodes ApproveAndDeposit5115VaultHook data.
+func DecodeApproveAndDeposit5115Vault(data []byte) (*ApproveAndDeposit5115Vault, error) {
+	return decodeAs[ApproveAndDeposit5115Vault](data)
+}
+
+// Deposit5115Vault is the data for Deposit5115VaultHook, which deposits TokenIn
+// into an ERC-5115 vault.
+type Deposit5115Vault struct {
+	Header
+	TokenIn           common.Address
+	Amount            *big.Int
+	MinSharesOut      *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Deposit5115Vault) HookName() string { return "Deposit5115VaultHook" }
+
+// Encode returns the packed Deposit5115VaultHook data.
+func (d *Deposit5115Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeDeposit5115Vault decodes Deposit5115VaultHook data.
+func DecodeDeposit5115Vault(data []byte) (*Deposit5115Vault, error) {
+	return decodeAs[Deposit5115Vault](data)
+}
+
+// Redeem5115Vault is the data for Redeem5115VaultHook, which redeems ERC-5115
+// shares for TokenOut.
+type Redeem5115Vault struct {
+	Header
+	TokenOut          common.Address
+	Shares            *big.Int
+	MinTokenOut       *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Redeem5115Vault) HookName() string { return "Redeem5115VaultHook" }
+
+// Encode returns the packed Redeem5115VaultHook data.
+func (d *Redeem5115Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeRedeem5115Vault decodes Redeem5115VaultHook data.
+func DecodeRedeem5115Vault(data []byte) (*Redeem5115Vault, error) {
+	return decodeAs[Redeem5115Vault](data)
+}
+
+// ApproveAndRequestDeposit7540Vault is the data for
+// ApproveAndRequestDeposit7540VaultHook, which approves Token and requests a
+// deposit into an ERC-7540 vault. The oracle ID is a placeholder.
+type ApproveAndRequestDeposit7540Vault struct {
+	Header
+	Token             common.Address
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*ApproveAndRequestDeposit7540Vault) HookName() string {
+	return "ApproveAndRequestDeposit7540VaultHook"
+}
+
+// Encode returns the packed ApproveAndRequestDeposit7540VaultHook data.
+func (d *ApproveAndRequestDeposit7540Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeApproveAndRequestDeposit7540Vault decodes
+// ApproveAndRequestDeposit7540VaultHook data.
+func DecodeApproveAndRequestDeposit7540Vault(data []byte) (*ApproveAndRequestDeposit7540Vault, error) {
+	return decodeAs[ApproveAndRequestDeposit7540Vault](data)
+}
+
+// RequestDeposit7540Vault is the data for RequestDeposit7540VaultHook, which
+// requests a deposit into an ERC-7540 vault. The oracle ID is a placeholder.
+type RequestDeposit7540Vault struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*RequestDeposit7540Vault) HookName() string { return "RequestDeposit7540VaultHook" }
+
+// Encode returns the packed RequestDeposit7540VaultHook data.
+func (d *RequestDeposit7540Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeRequestDeposit7540Vault decodes RequestDeposit7540VaultHook data.
+func DecodeRequestDeposit7540Vault(data []byte) (*RequestDeposit7540Vault, error) {
+	return decodeAs[RequestDeposit7540Vault](data)
+}
+
+// RequestRedeem7540Vault is the data for RequestRedeem7540VaultHook, which
+// requests a redemption from an ERC-7540 vault. The oracle ID is a placeholder.
+type RequestRedeem7540Vault struct {
+	Header
+	Shares            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*RequestRedeem7540Vault) HookName() string { return "RequestRedeem7540VaultHook" }
+
+// Encode returns the packed RequestRedeem7540VaultHook data.
+func (d *RequestRedeem7540Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeRequestRedeem7540Vault decodes RequestRedeem7540VaultHook data.
+func DecodeRequestRedeem7540Vault(data []byte) (*RequestRedeem7540Vault, error) {
+	return decodeAs[RequestRedeem7540Vault](data)
+}
+
+// Deposit7540Vault is the data for Deposit7540VaultHook, which claims a
+// fulfilled ERC-7540 deposit request.
+type Deposit7540Vault struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Deposit7540Vault) HookName() string { return "Deposit7540VaultHook" }
+
+// Encode returns the packed Deposit7540VaultHook data.
+func (d *Deposit7540Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeDeposit7540Vault decodes Deposit7540VaultHook data.
+func DecodeDeposit7540Vault(data []byte) (*Deposit7540Vault, error) {
+	return decodeAs[Deposit7540Vault](data)
+}
+
+// Redeem7540Vault is the data for Redeem7540VaultHook, which claims a fulfilled
+// ERC-7540 redeem request.
+type Redeem7540Vault struct {
+	Header
+	Shares            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Redeem7540Vault) HookName() string { return "Redeem7540VaultHook" }
+
+// Encode returns the packed Redeem7540VaultHook data.
+func (d *Redeem7540Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeRedeem7540Vault decodes Redeem7540VaultHook data.
+func DecodeRedeem7540Vault(data []byte) (*Redeem7540Vault, error) {
+	return decodeAs[Redeem7540Vault](data)
+}
+
+// Withdraw7540Vault is the data for Withdraw7540VaultHook, which withdraws
+// assets from a fulfilled ERC-7540 redeem request.
+type Withdraw7540Vault struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*Withdraw7540Vault) HookName() string { return "Withdraw7540VaultHook" }
+
+// Encode returns the packed Withdraw7540VaultHook data.
+func (d *Withdraw7540Vault) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeWithdraw7540Vault decodes Withdraw7540VaultHook data.
+func DecodeWithdraw7540Vault(data []byte) (*Withdraw7540Vault, error) {
+	return decodeAs[Withdraw7540Vault](data)
+}
+
+// CancelDepositRequest7540 is the data for CancelDepositRequest7540Hook, which
+// cancels a pending ERC-7540 deposit request. The oracle ID is a placeholder.
+type CancelDepositRequest7540 struct {
+	Header
+}
+
+func (*CancelDepositRequest7540) HookName() string { return "CancelDepositRequest7540Hook" }
+
+// Encode returns the packed CancelDepositRequest7540Hook data.
+func (d *CancelDepositRequest7540) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeCancelDepositRequest7540 decodes CancelDepositRequest7540Hook data.
+func DecodeCancelDepositRequest7540(data []byte) (*CancelDepositRequest7540, error) {
+	return decodeAs[CancelDepositRequest7540](data)
+}
+
+// CancelRedeemRequest7540 is the data for CancelRedeemRequest7540Hook, which
+// cancels a pending ERC-7540 redeem request. The oracle ID is a placeholder.
+type CancelRedeemRequest7540 struct {
+	Header
+}
+
+func (*CancelRedeemRequest7540) HookName() string { return "CancelRedeemRequest7540Hook" }
+
+// Encode returns the packed CancelRedeemRequest7540Hook data.
+func (d *CancelRedeemRequest7540) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeCancelRedeemRequest7540 decodes CancelRedeemRequest7540Hook data.
+func DecodeCancelRedeemRequest7540(data []byte) (*CancelRedeemRequest7540, error) {
+	return decodeAs[CancelRedeemRequest7540](data)
+}
+
+// ClaimCancelDepositRequest7540 is the data for
+// ClaimCancelDepositRequest7540Hook, which claims a cancelled ERC-7540 deposit
+// request. The oracle ID is a placeholder.
+type ClaimCancelDepositRequest7540 struct {
+	Header
+	Receiver common.Address
+}
+
+func (*ClaimCancelDepositRequest7540) HookName() string { return "ClaimCancelDepositRequest7540Hook" }
+
+// Encode returns the packed ClaimCancelDepositRequest7540Hook data.
+func (d *ClaimCancelDepositRequest7540) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeClaimCancelDepositRequest7540 decodes ClaimCancelDepositRequest7540Hook
+// data.
+func DecodeClaimCancelDepositRequest7540(data []byte) (*ClaimCancelDepositRequest7540, error) {
+	return decodeAs[ClaimCancelDepositRequest7540](data)
+}
+
+// ClaimCancelRedeemRequest7540 is the data for
+// ClaimCancelRedeemRequest7540Hook, which claims a cancelled ERC-7540 redeem
+// request. The oracle ID is a placeholder.
+type ClaimCancelRedeemRequest7540 struct {
+	Header
+	Receiver common.Address
+}
+
+func (*ClaimCancelRedeemRequest7540) HookName() string { return "ClaimCancelRedeemRequest7540Hook" }
+
+// Encode returns the packed ClaimCancelRedeemRequest7540Hook data.
+func (d *ClaimCancelRedeemRequest7540) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeClaimCancelRedeemRequest7540 decodes ClaimCancelRedeemRequest7540Hook
+// data.
+func DecodeClaimCancelRedeemRequest7540(data []byte) (*ClaimCancelRedeemRequest7540, error) {
+	return decodeAs[ClaimCancelRedeemRequest7540](data)
+}
+
+// SetOperator7540 is the data for SetOperator7540Hook, which sets operator
+// approval on an ERC-7540 vault, carried in Header.YieldSource. The oracle ID
+// is a placeholder.
+type SetOperator7540 struct {
+	Header
+	Operator common.Address
+	Approved bool
+}
+
+func (*SetOperator7540) HookName() string { return "SetOperator7540Hook" }
+
+// Encode returns the packed SetOperator7540Hook data.
+func (d *SetOperator7540) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeSetOperator7540 decodes SetOperator7540Hook data.
+func DecodeSetOperator7540(data []byte) (*SetOperator7540, error) {
+	return decodeAs[SetOperator7540](data)
+}
+
+// EthenaCooldownShares is the data for EthenaCooldownSharesHook, which starts
+// the sUSDe cooldown for Shares.
+type EthenaCooldownShares struct {
+	Header
+	Shares            *big.Int
+	UsePrevHookAmount bool
+}
+
+func (*EthenaCooldownShares) HookName() string { return "EthenaCooldownSharesHook" }
+
+// Encode returns the packed EthenaCooldownSharesHook data.
+func (d *EthenaCooldownShares) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeEthenaCooldownShares decodes EthenaCooldownSharesHook data.
+func DecodeEthenaCooldownShares(data []byte) (*EthenaCooldownShares, error) {
+	return decodeAs[EthenaCooldownShares](data)
+}
+
+// EthenaUnstake is the data for EthenaUnstakeHook, which unstakes sUSDe after
+// the cooldown has elapsed.
+type EthenaUnstake struct {
+	Header
+}
+
+func (*EthenaUnstake) HookName() string { return "EthenaUnstakeHook" }
+
+// Encode returns the packed EthenaUnstakeHook data.
+func (d *EthenaUnstake) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeEthenaUnstake decodes EthenaUnstakeHook data.
+func DecodeEthenaUnstake(data []byte) (*EthenaUnstake, error) {
+	return decodeAs[EthenaUnstake](data)
+}
+
+// MintSuperPositions is the data for MintSuperPositionsHook, which locks vault
+// shares in VaultBank and mints SuperPositions. Header.YieldSource carries the
+// SuperPosition token.
+type MintSuperPositions struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+	VaultBank         common.Address
+	DstChainId        *big.Int
+}
+
+func (*MintSuperPositions) HookName() string { return "MintSuperPositionsHook" }
+
+// Encode returns the packed MintSuperPositionsHook data.
+func (d *MintSuperPositions) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeMintSuperPositions decodes MintSuperPositionsHook data.
+func DecodeMintSuperPositions(data []byte) (*MintSuperPositions, error) {
+	return decodeAs[MintSuperPositions](data)
+}
+
+// BurnSuperPositions is the data for BurnSuperPositionsHook, which burns
+// SuperPositions held in VaultBank. Header.YieldSource carries the
+// SuperPosition token.
+type BurnSuperPositions struct {
+	Header
+	Amount            *big.Int
+	UsePrevHookAmount bool
+	VaultBank         common.Address
+	DstChainId        *big.Int
+}
+
+func (*BurnSuperPositions) HookName() string { return "BurnSuperPositionsHook" }
+
+// Encode returns the packed BurnSuperPositionsHook data.
+func (d *BurnSuperPositions) Encode() ([]byte, error) { return pack(d) }
+
+// DecodeBurnSuperPositions decodes BurnSuperPositionsHook data.
+func DecodeBurnSuperPositions(data []byte) (*BurnSuperPositions, error) {
+	return decodeAs[BurnSuperPositions](data)
+}
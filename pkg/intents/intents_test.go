@@ -0,0 +1,284 @@
+package intents
+
+import (
+	"context"
+	"math/big"
+	"path/filepath"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/ethclient/simulated"
+
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+	"github.com/superform-xyz/v2-core/pkg/validator"
+)
+
+var (
+	account  = common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	token    = common.HexToAddress("0x00000000000000000000000000000000000000cc")
+	exec     = common.HexToAddress("0x0000000000000000000000000000000000000e0e")
+	acrossHk = common.HexToAddress("0x0000000000000000000000000000000000000a0a")
+)
+
+func signed(t *testing.T, root byte) []byte {
+	t.Helper()
+	sig, err := (&validator.SignatureData{MerkleRoot: [32]byte{root}, Signature: []byte{1}}).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	return sig
+}
+
+func call(t *testing.T, root byte, amount int64) SourceCall {
+	return SourceCall{
+		Bridge:           BridgeAcross,
+		SourceChain:      1,
+		DestinationChain: 10,
+		Message: &Message{
+			Account:       account,
+			DstTokens:     []common.Address{token},
+			IntentAmounts: []*big.Int{big.NewInt(amount)},
+			SigData:       signed(t, root),
+		},
+	}
+}
+
+func TestMessageRoundTrip(t *testing.T) {
+	m := call(t, 1, 5).Message
+	m.ExecutorCalldata = []byte{0xde, 0xad}
+	enc, err := m.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeMessage(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Account != account || got.IntentAmounts[0].Int64() != 5 || string(got.SigData) != string(m.SigData) {
+		t.Fatalf("decoded %+v", got)
+	}
+	if root, _ := got.MerkleRoot(); root != (common.Hash{1}) {
+		t.Fatalf("root = %x", root)
+	}
+}
+
+func TestSourceCalls(t *testing.T) {
+	m := call(t, 1, 5).Message
+	dst, err := m.EncodeHookMessage()
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := (&hooks.AcrossSendFundsAndExecuteOnDst{
+		Value:              big.NewInt(0),
+		InputAmount:        big.NewInt(5),
+		OutputAmount:       big.NewInt(5),
+		DestinationChainId: big.NewInt(10),
+		DestinationMessage: dst,
+	}).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	entry := &executor.ExecutorEntry{
+		HooksAddresses: []common.Address{{0x99}, acrossHk},
+		HooksData:      [][]byte{{}, data},
+	}
+	resolve := ChainResolver(&addressbook.Chain{Contracts: map[string]common.Address{
+		"AcrossSendFundsAndExecuteOnDstHook": acrossHk,
+	}})
+	calls, err := SourceCalls(1, entry, m.SigData, resolve)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(calls) != 1 || calls[0].DestinationChain != 10 || calls[0].Hook != acrossHk || calls[0].Bridge != BridgeAcross {
+		t.Fatalf("calls = %+v", calls)
+	}
+	in, err := NewTracker().Track(calls[0])
+	if err != nil || in.MerkleRoot != (common.Hash{1}) || in.Status != StatusPending {
+		t.Fatalf("intent = %+v, %v", in, err)
+	}
+}
+
+// fakeChain serves logs and isMerkleRootUsed answers for one destination.
+type fakeChain struct {
+	logs []types.Log
+	// used maps a root to the block it was marked used in.
+	used map[common.Hash]uint64
+}
+
+func (f *fakeChain) FilterLogs(_ context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	var out []types.Log
+	for _, l := range f.logs {
+		if l.BlockNumber >= q.FromBlock.Uint64() && l.BlockNumber <= q.ToBlock.Uint64() {
+			out = append(out, l)
+		}
+	}
+	return out, nil
+}
+
+func (f *fakeChain) SubscribeFilterLogs(context.Context, ethereum.FilterQuery, chan<- types.Log) (ethereum.Subscription, error) {
+	return nil, nil
+}
+
+func (f *fakeChain) IsMerkleRootUsed(opts *bind.CallOpts, _ common.Address, root [32]byte) (bool, error) {
+	b, ok := f.used[root]
+	return ok && opts.BlockNumber.Uint64() >= b, nil
+}
+
+func (f *fakeChain) emit(t *testing.T, parsed *abi.ABI, name string, block uint64, topics []common.Hash, args ...any) {
+	t.Helper()
+	ev := parsed.Events[name]
+	data, err := ev.Inputs.NonIndexed().Pack(args...)
+	if err != nil {
+		t.Fatal(err)
+	}
+	f.logs = append(f.logs, types.Log{
+		Address:     exec,
+		Topics:      append([]common.Hash{ev.ID, common.BytesToHash(account[:])}, topics...),
+		Data:        data,
+		BlockNumber: block,
+		TxHash:      common.Hash{byte(block)},
+		Index:       uint(len(f.logs)),
+	})
+}
+
+func TestTrackerLifecycle(t *testing.T) {
+	chain := &fakeChain{used: map[common.Hash]uint64{{2}: 20}}
+	tokenTopic := common.BytesToHash(token[:])
+	// Root 1 is delivered short, root 2 executes, root 3 is cancelled.
+	chain.emit(t, executorABI, string(EventNotEnoughBalance), 10, []common.Hash{tokenTopic}, big.NewInt(7), big.NewInt(3))
+	chain.emit(t, executorABI, string(EventExecuted), 20, nil)
+	chain.emit(t, executorABI, string(EventMarkRootsAsUsed), 30, nil, [][32]byte{{3}})
+	chain.emit(t, executorABI, string(EventRootUsedAlready), 40, []common.Hash{{2}})
+
+	tr := NewTracker()
+	for _, c := range []SourceCall{call(t, 1, 7), call(t, 2, 5), call(t, 3, 1)} {
+		if _, err := tr.Track(c); err != nil {
+			t.Fatal(err)
+		}
+	}
+	dest := &Destination{ChainID: 10, Logs: chain, Executor: exec, Roots: chain, MaxRange: 15}
+	tr.SetNextBlock(10, 1)
+	if err := tr.Sync(context.Background(), dest, 25); err != nil {
+		t.Fatal(err)
+	}
+	assertIntent(t, tr, 1, StatusFailed, ReasonNotEnoughBalance, 1)
+	assertIntent(t, tr, 2, StatusExecuted, "", 1)
+	assertIntent(t, tr, 3, StatusPending, "", 0)
+	if tr.NextBlock(10) != 26 {
+		t.Fatalf("next block = %d", tr.NextBlock(10))
+	}
+
+	// Resume from the saved checkpoint.
+	path := filepath.Join(t.TempDir(), "intents.json")
+	if err := tr.SaveCheckpoint(path); err != nil {
+		t.Fatal(err)
+	}
+	tr, err := LoadCheckpoint(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := tr.Sync(context.Background(), dest, 50); err != nil {
+		t.Fatal(err)
+	}
+	assertIntent(t, tr, 1, StatusFailed, ReasonNotEnoughBalance, 1)
+	// A replay of an executed root does not downgrade it.
+	assertIntent(t, tr, 2, StatusExecuted, "", 2)
+	assertIntent(t, tr, 3, StatusFailed, ReasonRootMarkedUsed, 1)
+	if len(tr.Unmatched()) != 0 {
+		t.Fatalf("unmatched = %+v", tr.Unmatched())
+	}
+}
+
+func TestTrackLate(t *testing.T) {
+	chain := &fakeChain{}
+	chain.emit(t, executorABI, string(EventNoHooks), 5, nil)
+	tr := NewTracker()
+	if err := tr.Sync(context.Background(), &Destination{ChainID: 10, Logs: chain, Executor: exec}, 5); err != nil {
+		t.Fatal(err)
+	}
+	if len(tr.Unmatched()) != 1 {
+		t.Fatalf("unmatched = %+v", tr.Unmatched())
+	}
+	if _, err := tr.Track(call(t, 1, 1)); err != nil {
+		t.Fatal(err)
+	}
+	assertIntent(t, tr, 1, StatusDelivered, ReasonNoHooks, 1)
+	if len(tr.Unmatched()) != 0 {
+		t.Fatalf("unmatched after track = %+v", tr.Unmatched())
+	}
+}
+
+func assertIntent(t *testing.T, tr *Tracker, root byte, status Status, reason Reason, observations int) {
+	t.Helper()
+	in, ok := tr.Intent(Key{Account: account, MerkleRoot: common.Hash{root}})
+	if !ok {
+		t.Fatalf("root %d not tracked", root)
+	}
+	if in.Status != status || in.Reason != reason || len(in.Observations) != observations {
+		t.Fatalf("root %d: %s/%s with %d observations, want %s/%s with %d", root, in.Status, in.Reason, len(in.Observations), status, reason, observations)
+	}
+}
+
+type usedRoots map[common.Hash]bool
+
+func (u usedRoots) IsMerkleRootUsed(_ *bind.CallOpts, _ common.Address, root [32]byte) (bool, error) {
+	return u[root], nil
+}
+
+// TestRetrier plans native-token retries on a simulated chain. The executor
+// address has no code, so the simulated retry succeeds trivially.
+func TestRetrier(t *testing.T) {
+	sim := simulated.NewBackend(types.GenesisAlloc{account: {Balance: big.NewInt(6)}})
+	defer sim.Close()
+
+	tr := NewTracker()
+	// Root 1 is covered, root 2 is short, root 3 is used and root 4 has
+	// lost its message.
+	for i, amount := range []int64{5, 7, 5, 5} {
+		root := byte(i + 1)
+		c := call(t, root, amount)
+		c.Message.DstTokens = []common.Address{{}}
+		if _, err := tr.Track(c); err != nil {
+			t.Fatal(err)
+		}
+		tr.Observe(Observation{Event: EventNotEnoughBalance, Chain: 10, Account: account, MerkleRoot: &common.Hash{root}})
+	}
+	tr.intents[Key{account, common.Hash{4}}].Message = nil
+
+	var sent []Plan
+	r := &Retrier{
+		Tracker:  tr,
+		ChainID:  10,
+		Backend:  sim.Client(),
+		Executor: exec,
+		Roots:    usedRoots{{3}: true},
+		Sink:     SinkFunc(func(_ context.Context, p Plan) error { sent = append(sent, p); return nil }),
+	}
+	plans, err := r.Poll(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(plans) != 4 || len(sent) != 4 {
+		t.Fatalf("plans = %+v", plans)
+	}
+	want := map[byte]Action{1: ActionRetry, 2: ActionWait, 3: ActionDone, 4: ActionManual}
+	for _, p := range plans {
+		if p.Action != want[p.MerkleRoot[0]] {
+			t.Errorf("root %d: %s (%s), want %s", p.MerkleRoot[0], p.Action, p.Error, want[p.MerkleRoot[0]])
+		}
+	}
+	if s := plans[1].Shortfalls; len(s) != 1 || s[0].Balance.Int64() != 6 || s[0].IntentAmount.Int64() != 7 {
+		t.Fatalf("shortfalls = %+v", s)
+	}
+
+	vals, err := executorABI.Methods["processBridgedExecution"].Inputs.Unpack(plans[0].Calldata[4:])
+	if err != nil || vals[1].(common.Address) != account || string(vals[6].([]byte)) != string(signed(t, 1)) {
+		t.Fatalf("calldata = %v, %v", vals, err)
+	}
+}
@@ -0,0 +1,118 @@
+// Package intents tracks bridged intents from the source-chain bridge hook
+// call through AcrossV3Adapter or DebridgeAdapter to their outcome on
+// SuperDestinationExecutor.
+//
+// An intent is keyed by (account, merkleRoot): the destination account and
+// the root of the merkle tree the owner signed, which
+// SuperDestinationExecutor marks as used when it executes.
+package intents
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/superform-xyz/v2-core/pkg/validator"
+)
+
+// Message is the payload both adapters decode and pass to
+// SuperDestinationExecutor.processBridgedExecution.
+type Message struct {
+	InitData         hexutil.Bytes    `json:"initData"`
+	ExecutorCalldata hexutil.Bytes    `json:"executorCalldata"`
+	Account          common.Address   `json:"account"`
+	DstTokens        []common.Address `json:"dstTokens"`
+	IntentAmounts    []*big.Int       `json:"intentAmounts"`
+	// SigData is the source signature data. Bridge hooks append it on-chain
+	// from the validator's transient storage, so it is absent from hook data.
+	SigData hexutil.Bytes `json:"sigData"`
+}
+
+var (
+	bytesType, _        = abi.NewType("bytes", "", nil)
+	addressType, _      = abi.NewType("address", "", nil)
+	addressArrayType, _ = abi.NewType("address[]", "", nil)
+	uint256ArrayType, _ = abi.NewType("uint256[]", "", nil)
+
+	// hookMessageArgs is the destinationMessage carried in bridge hook data.
+	hookMessageArgs = abi.Arguments{
+		{Type: bytesType}, {Type: bytesType}, {Type: addressType}, {Type: addressArrayType}, {Type: uint256ArrayType},
+	}
+	// messageArgs is the message the adapters decode.
+	messageArgs = append(append(abi.Arguments{}, hookMessageArgs...), abi.Argument{Type: bytesType})
+)
+
+// Encode returns the adapter message, with SigData.
+func (m *Message) Encode() ([]byte, error) {
+	return messageArgs.Pack(orEmpty(m.InitData), orEmpty(m.ExecutorCalldata), m.Account, orAddresses(m.DstTokens), orAmounts(m.IntentAmounts), orEmpty(m.SigData))
+}
+
+// EncodeHookMessage returns the destinationMessage for bridge hook data,
+// without SigData.
+func (m *Message) EncodeHookMessage() ([]byte, error) {
+	return hookMessageArgs.Pack(orEmpty(m.InitData), orEmpty(m.ExecutorCalldata), m.Account, orAddresses(m.DstTokens), orAmounts(m.IntentAmounts))
+}
+
+// DecodeMessage decodes an adapter message.
+func DecodeMessage(data []byte) (*Message, error) {
+	vals, err := messageArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("intents: decode message: %w", err)
+	}
+	m := messageFrom(vals)
+	m.SigData = vals[5].([]byte)
+	return m, nil
+}
+
+// DecodeHookMessage decodes the destinationMessage of bridge hook data.
+func DecodeHookMessage(data []byte) (*Message, error) {
+	vals, err := hookMessageArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("intents: decode hook message: %w", err)
+	}
+	return messageFrom(vals), nil
+}
+
+func messageFrom(vals []any) *Message {
+	return &Message{
+		InitData:         vals[0].([]byte),
+		ExecutorCalldata: vals[1].([]byte),
+		Account:          vals[2].(common.Address),
+		DstTokens:        vals[3].([]common.Address),
+		IntentAmounts:    vals[4].([]*big.Int),
+	}
+}
+
+// MerkleRoot returns the root signed in SigData, the one
+// SuperDestinationExecutor._decodeMerkleRoot reads.
+func (m *Message) MerkleRoot() (common.Hash, error) {
+	sig, err := validator.DecodeSignatureData(m.SigData)
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return sig.MerkleRoot, nil
+}
+
+func orEmpty(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
+
+func orAddresses(a []common.Address) []common.Address {
+	if a == nil {
+		return []common.Address{}
+	}
+	return a
+}
+
+func orAmounts(a []*big.Int) []*big.Int {
+	if a == nil {
+		return []*big.Int{}
+	}
+	return a
+}
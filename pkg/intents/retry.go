@@ -0,0 +1,269 @@
+package intents
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+)
+
+// Action is what a retry plan recommends.
+type Action string
+
+const (
+	// ActionWait: the account still holds less than an intent amount.
+	ActionWait Action = "wait"
+	// ActionRetry: balances suffice and processBridgedExecution simulates
+	// cleanly; Calldata is ready to submit.
+	ActionRetry Action = "retry"
+	// ActionSubmitted: the retry was sent and mined successfully.
+	ActionSubmitted Action = "submitted"
+	// ActionDone: the root is already used on-chain, nothing to retry.
+	ActionDone Action = "done"
+	// ActionManual: the retry cannot be built or reverts; see Error.
+	ActionManual Action = "manual"
+)
+
+// Shortfall is a destination token the account holds too little of.
+type Shortfall struct {
+	Token        common.Address `json:"token"`
+	IntentAmount *big.Int       `json:"intentAmount"`
+	Balance      *big.Int       `json:"balance"`
+}
+
+// Plan is the retry recommendation for one intent.
+type Plan struct {
+	Key
+	Chain uint64 `json:"chain"`
+	// Block is the state the plan was made against.
+	Block      uint64      `json:"block"`
+	Action     Action      `json:"action"`
+	Shortfalls []Shortfall `json:"shortfalls,omitempty"`
+	// Calldata is the processBridgedExecution call to send to the executor.
+	Calldata hexutil.Bytes `json:"calldata,omitempty"`
+	Gas      uint64        `json:"gas,omitempty"`
+	Tx       *common.Hash  `json:"tx,omitempty"`
+	Error    string        `json:"error,omitempty"`
+}
+
+// Sink receives retry plans.
+type Sink interface {
+	Send(ctx context.Context, p Plan) error
+}
+
+// SinkFunc adapts a function to a Sink.
+type SinkFunc func(ctx context.Context, p Plan) error
+
+// Send implements Sink.
+func (f SinkFunc) Send(ctx context.Context, p Plan) error { return f(ctx, p) }
+
+// Backend is what the retrier needs from a destination chain client.
+type Backend interface {
+	bind.ContractBackend
+	bind.DeployBackend
+	BalanceAt(ctx context.Context, account common.Address, block *big.Int) (*big.Int, error)
+}
+
+// Retrier plans, and optionally submits, processBridgedExecution retries for
+// intents that reached the destination without enough balance. Such a
+// delivery leaves the merkle root unused, so the same signed message
+// executes once the account holds every intent amount; anyone may submit it.
+type Retrier struct {
+	Tracker  *Tracker
+	ChainID  uint64
+	Backend  Backend
+	Executor common.Address
+	// Roots reads isMerkleRootUsed on the executor.
+	Roots RootChecker
+	// Sink receives every plan; nil drops them.
+	Sink Sink
+	// Auth, when set, submits retries that simulate cleanly. Simulations are
+	// sent from Auth.From, or the zero address without it.
+	Auth *bind.TransactOpts
+}
+
+var erc20ABI, _ = abi.JSON(strings.NewReader(`[{"type":"function","name":"balanceOf","stateMutability":"view","inputs":[{"name":"account","type":"address"}],"outputs":[{"name":"","type":"uint256"}]}]`))
+
+// Candidates returns the intents on the retrier's chain that failed for
+// lack of balance.
+func (r *Retrier) Candidates() []Intent {
+	var out []Intent
+	for _, in := range r.Tracker.Intents() {
+		if in.DestinationChain == r.ChainID && in.Status == StatusFailed && in.Reason == ReasonNotEnoughBalance {
+			out = append(out, in)
+		}
+	}
+	return out
+}
+
+// Poll plans every candidate against the latest block, submits the ready
+// ones when Auth is set and sends each plan to the sink.
+func (r *Retrier) Poll(ctx context.Context) ([]Plan, error) {
+	head, err := r.Backend.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return nil, fmt.Errorf("intents: head: %w", err)
+	}
+	var plans []Plan
+	for _, in := range r.Candidates() {
+		p, err := r.PlanAt(ctx, in, head.Number)
+		if err != nil {
+			return plans, err
+		}
+		if p.Action == ActionRetry && r.Auth != nil {
+			r.submit(ctx, p)
+		}
+		if r.Sink != nil {
+			if err := r.Sink.Send(ctx, *p); err != nil {
+				return plans, fmt.Errorf("intents: sink: %w", err)
+			}
+		}
+		plans = append(plans, *p)
+	}
+	return plans, nil
+}
+
+// Watch syncs d into the tracker and polls every interval until ctx is
+// done.
+func (r *Retrier) Watch(ctx context.Context, d *Destination, interval time.Duration) error {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		head, err := r.Backend.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return fmt.Errorf("intents: head: %w", err)
+		}
+		if err := r.Tracker.Sync(ctx, d, head.Number.Uint64()); err != nil {
+			return err
+		}
+		if _, err := r.Poll(ctx); err != nil {
+			return err
+		}
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-ticker.C:
+		}
+	}
+}
+
+// PlanAt checks in against the state at block: root still unused, every
+// intent amount covered, and a clean processBridgedExecution simulation.
+// Only RPC failures are returned as errors.
+func (r *Retrier) PlanAt(ctx context.Context, in Intent, block *big.Int) (*Plan, error) {
+	p := &Plan{Key: in.Key, Chain: r.ChainID, Block: block.Uint64()}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: block}
+
+	used, err := r.Roots.IsMerkleRootUsed(opts, in.Account, in.MerkleRoot)
+	if err != nil {
+		return nil, fmt.Errorf("intents: isMerkleRootUsed: %w", err)
+	}
+	if used {
+		p.Action = ActionDone
+		return p, nil
+	}
+
+	for i, token := range in.DstTokens {
+		if i >= len(in.IntentAmounts) {
+			break
+		}
+		bal, err := r.balance(ctx, token, in.Account, block)
+		if err != nil {
+			return nil, fmt.Errorf("intents: balance of %s: %w", token, err)
+		}
+		if bal.Cmp(in.IntentAmounts[i]) < 0 {
+			p.Shortfalls = append(p.Shortfalls, Shortfall{Token: token, IntentAmount: in.IntentAmounts[i], Balance: bal})
+		}
+	}
+	if len(p.Shortfalls) > 0 {
+		p.Action = ActionWait
+		return p, nil
+	}
+
+	if in.Message == nil {
+		p.Action, p.Error = ActionManual, "source message unknown"
+		return p, nil
+	}
+	data, err := RetryCalldata(in.Message)
+	if err != nil {
+		p.Action, p.Error = ActionManual, err.Error()
+		return p, nil
+	}
+	p.Calldata = data
+
+	msg := ethereum.CallMsg{To: &r.Executor, Data: data}
+	if r.Auth != nil {
+		msg.From = r.Auth.From
+	}
+	if _, err := r.Backend.CallContract(ctx, msg, block); err != nil {
+		if _, ok := reverts.Data(err); !ok {
+			return nil, fmt.Errorf("intents: simulate retry: %w", err)
+		}
+		p.Action, p.Error = ActionManual, reverts.Wrap(err).Error()
+		return p, nil
+	}
+	gas, err := r.Backend.EstimateGas(ctx, msg)
+	if err != nil {
+		p.Action, p.Error = ActionManual, reverts.Wrap(err).Error()
+		return p, nil
+	}
+	p.Action, p.Gas = ActionRetry, gas
+	return p, nil
+}
+
+// submit sends a ready plan and records the outcome on it.
+func (r *Retrier) submit(ctx context.Context, p *Plan) {
+	opts := *r.Auth
+	opts.Context = ctx
+	if opts.GasLimit == 0 {
+		opts.GasLimit = p.Gas * 5 / 4
+	}
+	tx, err := bind.NewBoundContract(r.Executor, abi.ABI{}, r.Backend, r.Backend, r.Backend).RawTransact(&opts, p.Calldata)
+	if err != nil {
+		p.Action, p.Error = ActionManual, reverts.Wrap(err).Error()
+		return
+	}
+	hash := tx.Hash()
+	p.Tx = &hash
+	receipt, err := bind.WaitMined(ctx, r.Backend, tx)
+	if err != nil {
+		p.Action, p.Error = ActionManual, err.Error()
+		return
+	}
+	if receipt.Status != types.ReceiptStatusSuccessful {
+		p.Action, p.Error = ActionManual, fmt.Sprintf("transaction %s reverted", hash)
+		return
+	}
+	p.Action = ActionSubmitted
+}
+
+// balance returns the account's balance of token, native when token is the
+// zero address, as SuperDestinationExecutor._validateBalances reads it.
+func (r *Retrier) balance(ctx context.Context, token, account common.Address, block *big.Int) (*big.Int, error) {
+	if token == (common.Address{}) {
+		return r.Backend.BalanceAt(ctx, account, block)
+	}
+	out := []any{}
+	err := bind.NewBoundContract(token, erc20ABI, r.Backend, nil, nil).Call(&bind.CallOpts{Context: ctx, BlockNumber: block}, &out, "balanceOf", account)
+	if err != nil {
+		return nil, err
+	}
+	return out[0].(*big.Int), nil
+}
+
+// RetryCalldata packs processBridgedExecution for m exactly as the adapters
+// call it. The first argument, the bridged token, is unused by the executor.
+func RetryCalldata(m *Message) ([]byte, error) {
+	return executorABI.Pack("processBridgedExecution",
+		common.Address{}, m.Account, orAddresses(m.DstTokens), orAmounts(m.IntentAmounts),
+		orEmpty(m.InitData), orEmpty(m.ExecutorCalldata), orEmpty(m.SigData))
+}
@@ -0,0 +1,97 @@
+package intents
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+)
+
+// Bridge names the bridge an intent travels through.
+type Bridge string
+
+const (
+	BridgeAcross   Bridge = "across"
+	BridgeDebridge Bridge = "debridge"
+)
+
+// SourceCall is a bridge hook call with a destination execution.
+type SourceCall struct {
+	Bridge           Bridge         `json:"bridge"`
+	Hook             common.Address `json:"hook"`
+	SourceChain      uint64         `json:"sourceChain"`
+	DestinationChain uint64         `json:"destinationChain"`
+	// TxHash and Block locate the source transaction, when known.
+	TxHash  common.Hash `json:"txHash,omitempty"`
+	Block   uint64      `json:"block,omitempty"`
+	Message *Message    `json:"-"`
+}
+
+// Resolver names the contract at an address, or returns "" if unknown.
+type Resolver func(common.Address) string
+
+// ChainResolver resolves addresses against the contracts deployed on c.
+func ChainResolver(c *addressbook.Chain) Resolver {
+	names := make(map[common.Address]string, len(c.Contracts))
+	for name, addr := range c.Contracts {
+		names[addr] = name
+	}
+	return func(a common.Address) string { return names[a] }
+}
+
+// SourceCalls returns the bridge hook calls in entry that carry a
+// destination message, with sigData, the source signature data of the
+// operation executing entry, attached to each message. Hooks that resolve
+// to no known bridge hook are skipped.
+func SourceCalls(sourceChain uint64, entry *executor.ExecutorEntry, sigData []byte, resolve Resolver) ([]SourceCall, error) {
+	var calls []SourceCall
+	for i, hook := range entry.HooksAddresses {
+		name := resolve(hook)
+		if name == "" {
+			continue
+		}
+		spec, ok := hooks.Lookup(name)
+		if !ok {
+			continue
+		}
+		d, err := spec.Decode(entry.HooksData[i])
+		if err != nil {
+			return nil, fmt.Errorf("intents: hook %d (%s): %w", i, name, err)
+		}
+		var (
+			bridge Bridge
+			dst    *big.Int
+			msg    []byte
+		)
+		switch d := d.(type) {
+		case *hooks.AcrossSendFundsAndExecuteOnDst:
+			bridge, dst, msg = BridgeAcross, d.DestinationChainId, d.DestinationMessage
+		case *hooks.ApproveAndAcrossSendFundsAndExecuteOnDst:
+			bridge, dst, msg = BridgeAcross, d.DestinationChainId, d.DestinationMessage
+		case *hooks.DeBridgeSendOrderAndExecuteOnDst:
+			bridge, dst, msg = BridgeDebridge, d.TakeChainId, d.DestinationMessage
+		default:
+			continue
+		}
+		if len(msg) == 0 {
+			continue
+		}
+		m, err := DecodeHookMessage(msg)
+		if err != nil {
+			return nil, fmt.Errorf("intents: hook %d (%s): %w", i, name, err)
+		}
+		m.SigData = sigData
+		calls = append(calls, SourceCall{
+			Bridge:           bridge,
+			Hook:             hook,
+			SourceChain:      sourceChain,
+			DestinationChain: dst.Uint64(),
+			Message:          m,
+		})
+	}
+	return calls, nil
+}
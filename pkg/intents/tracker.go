@@ -0,0 +1,580 @@
+package intents
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/big"
+	"os"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/AcrossV3Adapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationExecutor"
+)
+
+// Status is the lifecycle stage of an intent.
+type Status string
+
+const (
+	// StatusPending: the source bridge call was seen, nothing on the
+	// destination yet.
+	StatusPending Status = "pending"
+	// StatusDelivered: funds arrived and the root was consumed, but there
+	// was nothing to execute.
+	StatusDelivered Status = "delivered"
+	// StatusExecuted: SuperDestinationExecutor executed the intent.
+	StatusExecuted Status = "executed"
+	// StatusFailed: a delivery attempt did not execute; see Reason.
+	StatusFailed Status = "failed"
+)
+
+// Reason explains a failed or delivered intent.
+type Reason string
+
+const (
+	ReasonNotEnoughBalance    Reason = "not_enough_balance"
+	ReasonInvalidIntentAmount Reason = "invalid_intent_amount"
+	ReasonRootUsedAlready     Reason = "root_used_already"
+	ReasonRootMarkedUsed      Reason = "root_marked_used"
+	ReasonExecutionFailed     Reason = "execution_failed"
+	ReasonNoHooks             Reason = "no_hooks"
+)
+
+// Retryable reports whether a later delivery can still execute an intent
+// that failed for r: the root was not consumed.
+func (r Reason) Retryable() bool {
+	return r == ReasonNotEnoughBalance || r == ReasonExecutionFailed
+}
+
+// Key identifies an intent.
+type Key struct {
+	Account    common.Address `json:"account"`
+	MerkleRoot common.Hash    `json:"merkleRoot"`
+}
+
+// Event names the destination events the tracker understands.
+type Event string
+
+const (
+	EventExecuted              Event = "SuperDestinationExecutorExecuted"
+	EventNoHooks               Event = "SuperDestinationExecutorReceivedButNoHooks"
+	EventNotEnoughBalance      Event = "SuperDestinationExecutorReceivedButNotEnoughBalance"
+	EventInvalidIntentAmount   Event = "SuperDestinationExecutorInvalidIntentAmount"
+	EventRootUsedAlready       Event = "SuperDestinationExecutorReceivedButRootUsedAlready"
+	EventMarkRootsAsUsed       Event = "SuperDestinationExecutorMarkRootsAsUsed"
+	EventAcrossExecuted        Event = "AcrossFundsReceivedAndExecuted"
+	EventAcrossExecutionFailed Event = "AcrossFundsReceivedButExecutionFailed"
+	EventAcrossNotEnoughFunds  Event = "AcrossFundsReceivedButNotEnoughBalance"
+)
+
+// Observation is one destination event.
+type Observation struct {
+	Event    Event          `json:"event"`
+	Chain    uint64         `json:"chain"`
+	Block    uint64         `json:"block"`
+	TxHash   common.Hash    `json:"txHash"`
+	LogIndex uint           `json:"logIndex"`
+	Account  common.Address `json:"account"`
+	// MerkleRoot is set by RootUsedAlready, or resolved on-chain for
+	// Executed and NoHooks.
+	MerkleRoot *common.Hash  `json:"merkleRoot,omitempty"`
+	Roots      []common.Hash `json:"roots,omitempty"`
+	// Token, IntentAmount and Available are set by the balance events.
+	Token        *common.Address `json:"token,omitempty"`
+	IntentAmount *big.Int        `json:"intentAmount,omitempty"`
+	Available    *big.Int        `json:"available,omitempty"`
+}
+
+// Intent is the tracked state of one bridged intent.
+type Intent struct {
+	Key
+	Bridge           Bridge           `json:"bridge"`
+	SourceChain      uint64           `json:"sourceChain"`
+	DestinationChain uint64           `json:"destinationChain"`
+	SourceTx         common.Hash      `json:"sourceTx,omitempty"`
+	SourceBlock      uint64           `json:"sourceBlock,omitempty"`
+	DstTokens        []common.Address `json:"dstTokens"`
+	IntentAmounts    []*big.Int       `json:"intentAmounts"`
+	// Message is the bridged payload, kept so the delivery can be retried.
+	Message *Message `json:"message,omitempty"`
+
+	Status Status `json:"status"`
+	Reason Reason `json:"reason,omitempty"`
+	// Observations are the destination events attributed to the intent, in
+	// order.
+	Observations []Observation `json:"observations,omitempty"`
+}
+
+// open reports whether a destination delivery can still change the intent.
+func (i *Intent) open() bool {
+	return i.Status == StatusPending || (i.Status == StatusFailed && i.Reason.Retryable())
+}
+
+func (i *Intent) wants(token common.Address, amount *big.Int) bool {
+	for j, t := range i.DstTokens {
+		if t == token && j < len(i.IntentAmounts) && i.IntentAmounts[j].Cmp(amount) == 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// Checkpoint is the persisted tracker state: every intent, the destination
+// events not yet attributed, and the next block to scan per chain.
+type Checkpoint struct {
+	NextBlock map[uint64]uint64 `json:"nextBlock"`
+	Intents   []Intent          `json:"intents"`
+	Unmatched []Observation     `json:"unmatched,omitempty"`
+}
+
+// Tracker correlates source bridge calls with destination events. It is
+// safe for concurrent use.
+type Tracker struct {
+	mu        sync.Mutex
+	intents   map[Key]*Intent
+	order     []Key
+	unmatched []Observation
+	next      map[uint64]uint64
+}
+
+// NewTracker returns an empty tracker.
+func NewTracker() *Tracker {
+	return &Tracker{intents: make(map[Key]*Intent), next: make(map[uint64]uint64)}
+}
+
+// Restore returns a tracker resuming from cp.
+func Restore(cp *Checkpoint) *Tracker {
+	t := NewTracker()
+	for chain, next := range cp.NextBlock {
+		t.next[chain] = next
+	}
+	for i := range cp.Intents {
+		in := cp.Intents[i]
+		t.intents[in.Key] = &in
+		t.order = append(t.order, in.Key)
+	}
+	t.unmatched = append(t.unmatched, cp.Unmatched...)
+	return t
+}
+
+// LoadCheckpoint reads a checkpoint written by SaveCheckpoint; a missing
+// file yields an empty tracker.
+func LoadCheckpoint(path string) (*Tracker, error) {
+	raw, err := os.ReadFile(path)
+	if errors.Is(err, os.ErrNotExist) {
+		return NewTracker(), nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	var cp Checkpoint
+	if err := json.Unmarshal(raw, &cp); err != nil {
+		return nil, fmt.Errorf("intents: %s: %w", path, err)
+	}
+	return Restore(&cp), nil
+}
+
+// Checkpoint returns a copy of the tracker state.
+func (t *Tracker) Checkpoint() *Checkpoint {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	cp := &Checkpoint{NextBlock: make(map[uint64]uint64, len(t.next))}
+	for chain, next := range t.next {
+		cp.NextBlock[chain] = next
+	}
+	for _, k := range t.order {
+		cp.Intents = append(cp.Intents, *t.intents[k])
+	}
+	cp.Unmatched = append(cp.Unmatched, t.unmatched...)
+	return cp
+}
+
+// SaveCheckpoint writes the tracker state to path, replacing it atomically.
+func (t *Tracker) SaveCheckpoint(path string) error {
+	raw, err := json.MarshalIndent(t.Checkpoint(), "", "  ")
+	if err != nil {
+		return err
+	}
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, raw, 0o644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
+
+// Intent returns the intent for k.
+func (t *Tracker) Intent(k Key) (Intent, bool) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	in, ok := t.intents[k]
+	if !ok {
+		return Intent{}, false
+	}
+	return *in, true
+}
+
+// Intents returns every intent in tracking order.
+func (t *Tracker) Intents() []Intent {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	out := make([]Intent, 0, len(t.order))
+	for _, k := range t.order {
+		out = append(out, *t.intents[k])
+	}
+	return out
+}
+
+// Unmatched returns the destination events not attributed to any intent.
+func (t *Tracker) Unmatched() []Observation {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return append([]Observation(nil), t.unmatched...)
+}
+
+// NextBlock returns the next block Sync scans on chain.
+func (t *Tracker) NextBlock(chain uint64) uint64 {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	return t.next[chain]
+}
+
+// SetNextBlock sets where Sync starts on chain, typically the deployment
+// block of the destination contracts.
+func (t *Tracker) SetNextBlock(chain, block uint64) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.next[chain] = block
+}
+
+// Track starts tracking the intent of a source call. Tracking the same
+// intent again only fills in missing source details. Destination events
+// seen before the source call are attributed to it.
+func (t *Tracker) Track(call SourceCall) (Intent, error) {
+	root, err := call.Message.MerkleRoot()
+	if err != nil {
+		return Intent{}, fmt.Errorf("intents: merkle root: %w", err)
+	}
+	k := Key{Account: call.Message.Account, MerkleRoot: root}
+
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if in, ok := t.intents[k]; ok {
+		if in.SourceTx == (common.Hash{}) {
+			in.SourceTx, in.SourceBlock = call.TxHash, call.Block
+		}
+		if in.Message == nil {
+			in.Message = call.Message
+		}
+		return *in, nil
+	}
+	in := &Intent{
+		Key:              k,
+		Bridge:           call.Bridge,
+		SourceChain:      call.SourceChain,
+		DestinationChain: call.DestinationChain,
+		SourceTx:         call.TxHash,
+		SourceBlock:      call.Block,
+		DstTokens:        call.Message.DstTokens,
+		IntentAmounts:    call.Message.IntentAmounts,
+		Message:          call.Message,
+		Status:           StatusPending,
+	}
+	t.intents[k] = in
+	t.order = append(t.order, k)
+
+	pending := t.unmatched
+	t.unmatched = nil
+	for _, o := range pending {
+		t.apply(o)
+	}
+	return *in, nil
+}
+
+// Observe attributes a destination event to its intent, or keeps it as
+// unmatched.
+func (t *Tracker) Observe(o Observation) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.apply(o)
+}
+
+// apply attributes o and updates the intent status; t.mu must be held.
+func (t *Tracker) apply(o Observation) {
+	switch o.Event {
+	case EventMarkRootsAsUsed:
+		matched := false
+		for _, r := range o.Roots {
+			if in, ok := t.intents[Key{o.Account, r}]; ok {
+				matched = true
+				in.Observations = append(in.Observations, o)
+				if in.Status != StatusExecuted && in.Status != StatusDelivered {
+					in.Status, in.Reason = StatusFailed, ReasonRootMarkedUsed
+				}
+			}
+		}
+		if !matched {
+			t.unmatched = append(t.unmatched, o)
+		}
+		return
+	case EventRootUsedAlready:
+		in, ok := t.intents[Key{o.Account, *o.MerkleRoot}]
+		if !ok {
+			t.unmatched = append(t.unmatched, o)
+			return
+		}
+		in.Observations = append(in.Observations, o)
+		if in.open() {
+			in.Status, in.Reason = StatusFailed, ReasonRootUsedAlready
+		}
+		return
+	}
+
+	in := t.match(o)
+	if in == nil {
+		t.unmatched = append(t.unmatched, o)
+		return
+	}
+	in.Observations = append(in.Observations, o)
+	switch o.Event {
+	case EventExecuted, EventAcrossExecuted:
+		in.Status, in.Reason = StatusExecuted, ""
+	case EventNoHooks:
+		in.Status, in.Reason = StatusDelivered, ReasonNoHooks
+	case EventNotEnoughBalance, EventAcrossNotEnoughFunds:
+		if in.open() {
+			in.Status, in.Reason = StatusFailed, ReasonNotEnoughBalance
+		}
+	case EventInvalidIntentAmount:
+		if in.open() {
+			in.Status, in.Reason = StatusFailed, ReasonInvalidIntentAmount
+		}
+	case EventAcrossExecutionFailed:
+		if in.open() {
+			in.Status, in.Reason = StatusFailed, ReasonExecutionFailed
+		}
+	}
+}
+
+// match picks the intent an event without a definite root belongs to: the
+// resolved root if any, else the oldest open intent of the account on that
+// chain that asked for the event's token and amount, else the oldest open
+// one. An executor event and the Across adapter event of the same delivery
+// land on the same intent because it is matched by transaction first.
+func (t *Tracker) match(o Observation) *Intent {
+	if o.MerkleRoot != nil {
+		if in, ok := t.intents[Key{o.Account, *o.MerkleRoot}]; ok {
+			return in
+		}
+	}
+	var open []*Intent
+	for _, k := range t.order {
+		in := t.intents[k]
+		if in.Account != o.Account || in.DestinationChain != o.Chain {
+			continue
+		}
+		for _, seen := range in.Observations {
+			if seen.TxHash == o.TxHash {
+				return in
+			}
+		}
+		if in.open() {
+			open = append(open, in)
+		}
+	}
+	if o.Token != nil && o.IntentAmount != nil {
+		for _, in := range open {
+			if in.wants(*o.Token, o.IntentAmount) {
+				return in
+			}
+		}
+	}
+	if len(open) > 0 {
+		return open[0]
+	}
+	return nil
+}
+
+// openRoots returns the roots of the open intents of account on chain.
+func (t *Tracker) openRoots(account common.Address, chain uint64) []common.Hash {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	var roots []common.Hash
+	for _, k := range t.order {
+		in := t.intents[k]
+		if in.Account == account && in.DestinationChain == chain && in.open() {
+			roots = append(roots, in.MerkleRoot)
+		}
+	}
+	return roots
+}
+
+// RootChecker reads SuperDestinationExecutor.isMerkleRootUsed;
+// *SuperDestinationExecutor.SuperDestinationExecutorCaller implements it.
+type RootChecker interface {
+	IsMerkleRootUsed(opts *bind.CallOpts, user common.Address, merkleRoot [32]byte) (bool, error)
+}
+
+// Destination is one destination chain to scan.
+type Destination struct {
+	ChainID  uint64
+	Logs     ethereum.LogFilterer
+	Executor common.Address
+	// AcrossAdapter is scanned for the Across adapter events when set.
+	AcrossAdapter common.Address
+	// Roots, when set, resolves which open intent an Executed or NoHooks
+	// event consumed by comparing isMerkleRootUsed before and after its
+	// block. Without it such events go to the oldest open intent.
+	Roots RootChecker
+	// MaxRange bounds the blocks of one eth_getLogs query; zero means
+	// DefaultMaxRange.
+	MaxRange uint64
+}
+
+// DefaultMaxRange is the log query range used when Destination.MaxRange is
+// zero.
+const DefaultMaxRange = 5_000
+
+var (
+	executorABI, _ = SuperDestinationExecutor.SuperDestinationExecutorMetaData.GetAbi()
+	acrossABI, _   = AcrossV3Adapter.AcrossV3AdapterMetaData.GetAbi()
+)
+
+// Sync scans d from the tracker's next block on d.ChainID through to and
+// applies every destination event. The next block advances after each
+// query, so an interrupted Sync resumes where it stopped.
+func (t *Tracker) Sync(ctx context.Context, d *Destination, to uint64) error {
+	step := d.MaxRange
+	if step == 0 {
+		step = DefaultMaxRange
+	}
+	addrs := []common.Address{d.Executor}
+	if d.AcrossAdapter != (common.Address{}) {
+		addrs = append(addrs, d.AcrossAdapter)
+	}
+	for from := t.NextBlock(d.ChainID); from <= to; from = t.NextBlock(d.ChainID) {
+		end := min(from+step-1, to)
+		logs, err := d.Logs.FilterLogs(ctx, ethereum.FilterQuery{
+			FromBlock: new(big.Int).SetUint64(from),
+			ToBlock:   new(big.Int).SetUint64(end),
+			Addresses: addrs,
+		})
+		if err != nil {
+			return fmt.Errorf("intents: chain %d logs %d-%d: %w", d.ChainID, from, end, err)
+		}
+		sort.Slice(logs, func(i, j int) bool {
+			if logs[i].BlockNumber != logs[j].BlockNumber {
+				return logs[i].BlockNumber < logs[j].BlockNumber
+			}
+			return logs[i].Index < logs[j].Index
+		})
+		for i := range logs {
+			o, ok, err := ParseLog(d.ChainID, &logs[i])
+			if err != nil {
+				return fmt.Errorf("intents: chain %d: %w", d.ChainID, err)
+			}
+			if !ok {
+				continue
+			}
+			if (o.Event == EventExecuted || o.Event == EventNoHooks) && d.Roots != nil {
+				if err := t.resolveRoot(ctx, d.Roots, &o); err != nil {
+					return fmt.Errorf("intents: chain %d: %w", d.ChainID, err)
+				}
+			}
+			t.Observe(o)
+		}
+		t.SetNextBlock(d.ChainID, end+1)
+	}
+	return nil
+}
+
+// resolveRoot sets o.MerkleRoot to the open root of o.Account that was
+// unused before o's block and used after it, if exactly one was.
+func (t *Tracker) resolveRoot(ctx context.Context, roots RootChecker, o *Observation) error {
+	var found []common.Hash
+	for _, r := range t.openRoots(o.Account, o.Chain) {
+		after, err := roots.IsMerkleRootUsed(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(o.Block)}, o.Account, r)
+		if err != nil {
+			return fmt.Errorf("isMerkleRootUsed: %w", err)
+		}
+		if !after {
+			continue
+		}
+		before := false
+		if o.Block > 0 {
+			before, err = roots.IsMerkleRootUsed(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(o.Block - 1)}, o.Account, r)
+			if err != nil {
+				return fmt.Errorf("isMerkleRootUsed: %w", err)
+			}
+		}
+		if !before {
+			found = append(found, r)
+		}
+	}
+	if len(found) == 1 {
+		o.MerkleRoot = &found[0]
+	}
+	return nil
+}
+
+// ParseLog decodes a destination event. ok is false for logs the tracker
+// does not follow.
+func ParseLog(chain uint64, l *types.Log) (o Observation, ok bool, err error) {
+	if len(l.Topics) < 2 {
+		return o, false, nil
+	}
+	o = Observation{
+		Chain:    chain,
+		Block:    l.BlockNumber,
+		TxHash:   l.TxHash,
+		LogIndex: l.Index,
+		Account:  common.BytesToAddress(l.Topics[1].Bytes()),
+	}
+	name, parsed := "", executorABI
+	if ev, err := executorABI.EventByID(l.Topics[0]); err == nil {
+		name = ev.Name
+	} else if ev, err := acrossABI.EventByID(l.Topics[0]); err == nil {
+		name, parsed = ev.Name, acrossABI
+	}
+	o.Event = Event(name)
+	switch o.Event {
+	case EventExecuted, EventNoHooks, EventAcrossExecuted, EventAcrossExecutionFailed, EventAcrossNotEnoughFunds:
+	case EventRootUsedAlready:
+		if len(l.Topics) < 3 {
+			return o, false, fmt.Errorf("%s: missing root topic", name)
+		}
+		root := l.Topics[2]
+		o.MerkleRoot = &root
+	case EventNotEnoughBalance, EventInvalidIntentAmount:
+		if len(l.Topics) < 3 {
+			return o, false, fmt.Errorf("%s: missing token topic", name)
+		}
+		vals, err := parsed.Events[name].Inputs.NonIndexed().Unpack(l.Data)
+		if err != nil {
+			return o, false, fmt.Errorf("%s: %w", name, err)
+		}
+		token := common.BytesToAddress(l.Topics[2].Bytes())
+		o.Token = &token
+		o.IntentAmount = vals[0].(*big.Int)
+		if len(vals) > 1 {
+			o.Available = vals[1].(*big.Int)
+		}
+	case EventMarkRootsAsUsed:
+		vals, err := parsed.Events[name].Inputs.NonIndexed().Unpack(l.Data)
+		if err != nil {
+			return o, false, fmt.Errorf("%s: %w", name, err)
+		}
+		for _, r := range vals[0].([][32]byte) {
+			o.Roots = append(o.Roots, r)
+		}
+	default:
+		return o, false, nil
+	}
+	return o, true, nil
+}
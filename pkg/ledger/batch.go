@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// CallResult is the outcome of one call in a batch. Err holds per-call
+// failures such as reverts; transport failures fail the whole batch.
+type CallResult struct {
+	Data []byte
+	Err  error
+}
+
+// CallBatcher executes read-only calls at a block (nil for latest).
+type CallBatcher interface {
+	BatchCall(ctx context.Context, calls []ethereum.CallMsg, block *big.Int) ([]CallResult, error)
+}
+
+// RPCBatcher sends calls as JSON-RPC batches of eth_call.
+type RPCBatcher struct {
+	Client *rpc.Client
+	// Size caps the calls per batch request; 100 when zero.
+	Size int
+}
+
+// BatchCall implements CallBatcher.
+func (b *RPCBatcher) BatchCall(ctx context.Context, calls []ethereum.CallMsg, block *big.Int) ([]CallResult, error) {
+	size := b.Size
+	if size <= 0 {
+		size = 100
+	}
+	blockArg := "latest"
+	if block != nil {
+		blockArg = hexutil.EncodeBig(block)
+	}
+	out := make([]CallResult, len(calls))
+	for start := 0; start < len(calls); start += size {
+		end := min(start+size, len(calls))
+		elems := make([]rpc.BatchElem, end-start)
+		results := make([]hexutil.Bytes, end-start)
+		for i, msg := range calls[start:end] {
+			arg := map[string]any{"to": msg.To, "data": hexutil.Bytes(msg.Data)}
+			if msg.From != (common.Address{}) {
+				arg["from"] = msg.From
+			}
+			elems[i] = rpc.BatchElem{Method: "eth_call", Args: []any{arg, blockArg}, Result: &results[i]}
+		}
+		if err := b.Client.BatchCallContext(ctx, elems); err != nil {
+			return nil, err
+		}
+		for i := range elems {
+			out[start+i] = CallResult{Data: results[i], Err: elems[i].Error}
+		}
+	}
+	return out, nil
+}
+
+// SequentialBatcher issues calls one at a time, for backends without
+// JSON-RPC batching such as the simulated backend.
+type SequentialBatcher struct {
+	Caller bind.ContractCaller
+}
+
+// BatchCall implements CallBatcher.
+func (b *SequentialBatcher) BatchCall(ctx context.Context, calls []ethereum.CallMsg, block *big.Int) ([]CallResult, error) {
+	out := make([]CallResult, len(calls))
+	for i, msg := range calls {
+		out[i].Data, out[i].Err = b.Caller.CallContract(ctx, msg, block)
+	}
+	return out, nil
+}
@@ -0,0 +1,229 @@
+// Package ledger replays SuperLedger accounting events off-chain.
+//
+// The ledger keeps, per (user, yieldSource), an accumulator of shares and
+// of their cost basis in asset terms. Inflows add amount shares at
+// amount*pps/10^decimals; outflows consume usedShares (capped to the
+// accumulator) and the proportional cost basis. Replayer mirrors BaseLedger
+// so positions can be rebuilt from AccountingInflow, AccountingOutflow and
+// UsedSharesCapped logs and compared with the contract's getters.
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+)
+
+// ErrUnknownUsedShares is returned for an outflow whose consumed shares cannot
+// be determined: it was not capped and no UsedSharesResolver is configured.
+var ErrUnknownUsedShares = errors.New("ledger: used shares unknown for outflow")
+
+// Key identifies a ledger position.
+type Key struct {
+	User        common.Address
+	YieldSource common.Address
+}
+
+// Position mirrors usersAccumulatorShares and usersAccumulatorCostBasis.
+type Position struct {
+	Shares    *big.Int
+	CostBasis *big.Int
+}
+
+// DecimalsSource returns the decimals a yield source oracle reports for a
+// yield source, as used by the ledger when taking inflow snapshots.
+type DecimalsSource interface {
+	Decimals(ctx context.Context, oracle, yieldSource common.Address, block uint64) (uint8, error)
+}
+
+// UsedSharesResolver returns the usedShares argument passed to
+// updateAccounting for an outflow. AccountingOutflow does not carry it.
+type UsedSharesResolver interface {
+	UsedShares(ctx context.Context, ev *SuperLedger.SuperLedgerAccountingOutflow) (*big.Int, error)
+}
+
+// Event is one ledger log; exactly one field is set.
+type Event struct {
+	Inflow  *SuperLedger.SuperLedgerAccountingInflow
+	Outflow *SuperLedger.SuperLedgerAccountingOutflow
+	Capped  *SuperLedger.SuperLedgerUsedSharesCapped
+}
+
+func (e *Event) position() (block uint64, tx, log uint) {
+	switch {
+	case e.Inflow != nil:
+		return e.Inflow.Raw.BlockNumber, e.Inflow.Raw.TxIndex, e.Inflow.Raw.Index
+	case e.Outflow != nil:
+		return e.Outflow.Raw.BlockNumber, e.Outflow.Raw.TxIndex, e.Outflow.Raw.Index
+	case e.Capped != nil:
+		return e.Capped.Raw.BlockNumber, e.Capped.Raw.TxIndex, e.Capped.Raw.Index
+	}
+	return 0, 0, 0
+}
+
+// SortEvents orders events by block, transaction and log index.
+func SortEvents(events []Event) {
+	sort.SliceStable(events, func(i, j int) bool {
+		bi, ti, li := events[i].position()
+		bj, tj, lj := events[j].position()
+		if bi != bj {
+			return bi < bj
+		}
+		if ti != tj {
+			return ti < tj
+		}
+		return li < lj
+	})
+}
+
+// Replayer rebuilds ledger positions from events applied in chain order.
+type Replayer struct {
+	// FlatFee replays a FlatFeeLedger, which tracks no cost basis: its
+	// accumulators stay at zero whatever the events.
+	FlatFee bool
+
+	decimals  DecimalsSource
+	resolver  UsedSharesResolver
+	positions map[Key]*Position
+	// capped holds the last UsedSharesCapped log, pending the outflow it
+	// belongs to.
+	capped *SuperLedger.SuperLedgerUsedSharesCapped
+}
+
+// NewReplayer returns a replayer. resolver may be nil, in which case only
+// capped outflows can be replayed.
+func NewReplayer(decimals DecimalsSource, resolver UsedSharesResolver) *Replayer {
+	return &Replayer{decimals: decimals, resolver: resolver, positions: make(map[Key]*Position)}
+}
+
+// Replay sorts events and applies them in order.
+func (r *Replayer) Replay(ctx context.Context, events []Event) error {
+	SortEvents(events)
+	for i := range events {
+		if err := r.Apply(ctx, &events[i]); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Apply applies a single event. Events must be applied in chain order.
+func (r *Replayer) Apply(ctx context.Context, e *Event) error {
+	switch {
+	case e.Inflow != nil:
+		return r.ApplyInflow(ctx, e.Inflow)
+	case e.Outflow != nil:
+		return r.ApplyOutflow(ctx, e.Outflow)
+	case e.Capped != nil:
+		r.capped = e.Capped
+	}
+	return nil
+}
+
+// ApplyInflow mirrors BaseLedger._takeSnapshot.
+func (r *Replayer) ApplyInflow(ctx context.Context, ev *SuperLedger.SuperLedgerAccountingInflow) error {
+	r.capped = nil
+	if r.FlatFee {
+		return nil
+	}
+	decimals, err := r.decimals.Decimals(ctx, ev.YieldSourceOracle, ev.YieldSource, ev.Raw.BlockNumber)
+	if err != nil {
+		return fmt.Errorf("ledger: decimals for %s at block %d: %w", ev.YieldSource, ev.Raw.BlockNumber, err)
+	}
+	p := r.position(Key{User: ev.User, YieldSource: ev.YieldSource})
+	p.Shares.Add(p.Shares, ev.Amount)
+	p.CostBasis.Add(p.CostBasis, mulDiv(ev.Amount, ev.Pps, pow10(decimals)))
+	return nil
+}
+
+// ApplyOutflow mirrors BaseLedger._calculateCostBasis for an outflow.
+func (r *Replayer) ApplyOutflow(ctx context.Context, ev *SuperLedger.SuperLedgerAccountingOutflow) error {
+	capped := r.capped
+	r.capped = nil
+	if r.FlatFee {
+		return nil
+	}
+	p := r.position(Key{User: ev.User, YieldSource: ev.YieldSource})
+
+	// UsedSharesCapped carries no user: it belongs to this outflow only if
+	// it is the log right before it, emitted by the same updateAccounting
+	// call. In a bundle, an earlier cap may belong to another account whose
+	// events were filtered out.
+	var used *big.Int
+	switch {
+	case capped != nil && capped.Raw.TxHash == ev.Raw.TxHash && capped.Raw.Index+1 == ev.Raw.Index:
+		used = capped.CappedVal
+	case r.resolver != nil:
+		var err error
+		if used, err = r.resolver.UsedShares(ctx, ev); err != nil {
+			return fmt.Errorf("ledger: used shares for outflow in %s: %w", ev.Raw.TxHash, err)
+		}
+	default:
+		return fmt.Errorf("%w in %s", ErrUnknownUsedShares, ev.Raw.TxHash)
+	}
+
+	costBasis, used := calculateCostBasis(p, used)
+	p.Shares.Sub(p.Shares, used)
+	p.CostBasis.Sub(p.CostBasis, costBasis)
+	return nil
+}
+
+// Position returns a copy of the replayed position for (user, yieldSource).
+func (r *Replayer) Position(user, yieldSource common.Address) Position {
+	p, ok := r.positions[Key{User: user, YieldSource: yieldSource}]
+	if !ok {
+		return Position{Shares: new(big.Int), CostBasis: new(big.Int)}
+	}
+	return Position{Shares: new(big.Int).Set(p.Shares), CostBasis: new(big.Int).Set(p.CostBasis)}
+}
+
+// Keys returns every position touched by the replay, sorted.
+func (r *Replayer) Keys() []Key {
+	keys := make([]Key, 0, len(r.positions))
+	for k := range r.positions {
+		keys = append(keys, k)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		if c := keys[i].User.Cmp(keys[j].User); c != 0 {
+			return c < 0
+		}
+		return keys[i].YieldSource.Cmp(keys[j].YieldSource) < 0
+	})
+	return keys
+}
+
+func (r *Replayer) position(k Key) *Position {
+	p, ok := r.positions[k]
+	if !ok {
+		p = &Position{Shares: new(big.Int), CostBasis: new(big.Int)}
+		r.positions[k] = p
+	}
+	return p
+}
+
+// calculateCostBasis mirrors BaseLedger.calculateCostBasisView.
+func calculateCostBasis(p *Position, usedShares *big.Int) (costBasis, shares *big.Int) {
+	shares = usedShares
+	if shares.Cmp(p.Shares) > 0 {
+		shares = p.Shares
+	}
+	if shares.Sign() == 0 {
+		return new(big.Int), new(big.Int)
+	}
+	return mulDiv(p.CostBasis, shares, p.Shares), new(big.Int).Set(shares)
+}
+
+func mulDiv(a, b, denominator *big.Int) *big.Int {
+	x := new(big.Int).Mul(a, b)
+	return x.Quo(x, denominator)
+}
+
+func pow10(decimals uint8) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(decimals)), nil)
+}
@@ -0,0 +1,179 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+)
+
+type fixedDecimals uint8
+
+func (d fixedDecimals) Decimals(context.Context, common.Address, common.Address, uint64) (uint8, error) {
+	return uint8(d), nil
+}
+
+type fixedUsed map[common.Hash]*big.Int
+
+func (f fixedUsed) UsedShares(_ context.Context, ev *SuperLedger.SuperLedgerAccountingOutflow) (*big.Int, error) {
+	return f[ev.Raw.TxHash], nil
+}
+
+var (
+	user  = common.HexToAddress("0x00000000000000000000000000000000000000a1")
+	vault = common.HexToAddress("0x00000000000000000000000000000000000000b1")
+)
+
+func raw(block uint64, tx common.Hash, index uint) types.Log {
+	return types.Log{BlockNumber: block, TxHash: tx, Index: index}
+}
+
+func inflow(block uint64, amount, pps int64) Event {
+	return Event{Inflow: &SuperLedger.SuperLedgerAccountingInflow{
+		User: user, YieldSource: vault, Amount: big.NewInt(amount), Pps: big.NewInt(pps),
+		Raw: raw(block, common.Hash{byte(block)}, 1),
+	}}
+}
+
+func outflow(block uint64, amount int64) Event {
+	return Event{Outflow: &SuperLedger.SuperLedgerAccountingOutflow{
+		User: user, YieldSource: vault, Amount: big.NewInt(amount), FeeAmount: new(big.Int),
+		Raw: raw(block, common.Hash{byte(block)}, 2),
+	}}
+}
+
+func capped(block uint64, original, cappedVal int64) Event {
+	return Event{Capped: &SuperLedger.SuperLedgerUsedSharesCapped{
+		OriginalVal: big.NewInt(original), CappedVal: big.NewInt(cappedVal),
+		Raw: raw(block, common.Hash{byte(block)}, 1),
+	}}
+}
+
+func TestReplay(t *testing.T) {
+	// decimals 2: cost basis = shares * pps / 100.
+	events := []Event{
+		outflow(3, 999),      // uses 50 shares of 300 via resolver
+		inflow(1, 100, 150),  // +100 shares, +150
+		inflow(2, 200, 300),  // +200 shares, +600
+		capped(4, 1000, 250), // remaining position fully consumed
+		outflow(4, 777),
+	}
+	r := NewReplayer(fixedDecimals(2), fixedUsed{{3}: big.NewInt(50)})
+
+	for _, e := range events[1:3] {
+		if err := r.Apply(context.Background(), &e); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if p := r.Position(user, vault); p.Shares.Int64() != 300 || p.CostBasis.Int64() != 750 {
+		t.Fatalf("after inflows: %v/%v", p.Shares, p.CostBasis)
+	}
+
+	r = NewReplayer(fixedDecimals(2), fixedUsed{{3}: big.NewInt(50)})
+	if err := r.Replay(context.Background(), events); err != nil {
+		t.Fatal(err)
+	}
+	if p := r.Position(user, vault); p.Shares.Sign() != 0 || p.CostBasis.Sign() != 0 {
+		t.Fatalf("after outflows: %v/%v, want 0/0", p.Shares, p.CostBasis)
+	}
+
+	// Without a resolver, the uncapped outflow cannot be replayed.
+	r = NewReplayer(fixedDecimals(2), nil)
+	if err := r.Replay(context.Background(), events); !errors.Is(err, ErrUnknownUsedShares) {
+		t.Fatalf("err = %v, want ErrUnknownUsedShares", err)
+	}
+
+	r = NewReplayer(fixedDecimals(2), nil)
+	r.FlatFee = true
+	if err := r.Replay(context.Background(), events); err != nil {
+		t.Fatal(err)
+	}
+	if p := r.Position(user, vault); p.Shares.Sign() != 0 {
+		t.Fatal("flat fee ledger must not track shares")
+	}
+}
+
+func TestReplayCapInBundle(t *testing.T) {
+	other := common.HexToAddress("0x00000000000000000000000000000000000000a2")
+	bundle := common.Hash{9}
+	// One handleOps transaction: the other account's outflow is capped at
+	// log 1 and emitted at log 2; user's outflow follows at log 4. A
+	// users-filtered fetch sees only the cap and user's outflow.
+	capLog := capped(9, 1000, 250)
+	capLog.Capped.Raw = raw(9, bundle, 1)
+	out := outflow(9, 777)
+	out.Outflow.Raw = raw(9, bundle, 4)
+	events := []Event{inflow(1, 100, 100), capLog, out}
+
+	r := NewReplayer(fixedDecimals(2), fixedUsed{bundle: big.NewInt(40)})
+	if err := r.Replay(context.Background(), events); err != nil {
+		t.Fatal(err)
+	}
+	if p := r.Position(user, vault); p.Shares.Int64() != 60 || p.CostBasis.Int64() != 60 {
+		t.Fatalf("position = %v/%v, want 60/60", p.Shares, p.CostBasis)
+	}
+	if err := NewReplayer(fixedDecimals(2), nil).Replay(context.Background(), events); !errors.Is(err, ErrUnknownUsedShares) {
+		t.Fatalf("err = %v, want ErrUnknownUsedShares", err)
+	}
+
+	// Fetched in full, the cap applies to the other account's outflow only.
+	otherOut := outflow(9, 555)
+	otherOut.Outflow.User = other
+	otherOut.Outflow.Raw = raw(9, bundle, 2)
+	otherIn := inflow(1, 300, 100)
+	otherIn.Inflow.User = other
+	otherIn.Inflow.Raw.Index = 2
+	r = NewReplayer(fixedDecimals(2), fixedUsed{bundle: big.NewInt(40)})
+	if err := r.Replay(context.Background(), append(events, otherIn, otherOut)); err != nil {
+		t.Fatal(err)
+	}
+	if p := r.Position(other, vault); p.Shares.Int64() != 50 {
+		t.Fatalf("other position = %v, want 50 shares", p.Shares)
+	}
+	if p := r.Position(user, vault); p.Shares.Int64() != 60 {
+		t.Fatalf("user position = %v, want 60 shares", p.Shares)
+	}
+}
+
+func TestCostBasisRounding(t *testing.T) {
+	// 3 shares with cost basis 10: using 1 share takes floor(10/3) = 3.
+	p := &Position{Shares: big.NewInt(3), CostBasis: big.NewInt(10)}
+	cost, used := calculateCostBasis(p, big.NewInt(1))
+	if cost.Int64() != 3 || used.Int64() != 1 {
+		t.Fatalf("cost %v used %v", cost, used)
+	}
+	cost, used = calculateCostBasis(p, big.NewInt(5))
+	if cost.Int64() != 10 || used.Int64() != 3 {
+		t.Fatalf("capped: cost %v used %v", cost, used)
+	}
+}
+
+func TestCrossCheck(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	caller := &stack.Ledger.SuperLedgerCaller
+
+	// The fresh ledger has empty accumulators, so a replayed inflow diverges
+	// on both fields.
+	r := NewReplayer(fixedDecimals(0), nil)
+	e := inflow(1, 5, 2)
+	if err := r.Apply(context.Background(), &e); err != nil {
+		t.Fatal(err)
+	}
+	divs, err := r.CrossCheck(context.Background(), caller, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(divs) != 2 || divs[0].Field != "shares" || divs[1].Field != "costBasis" || divs[1].Replayed.Int64() != 10 {
+		t.Fatalf("divergences = %v", divs)
+	}
+}
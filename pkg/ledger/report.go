@@ -0,0 +1,409 @@
+package ledger
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/big"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/IYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+)
+
+// maxUint256 makes calculateCostBasisView cap usedShares to the whole position.
+var maxUint256 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 256), big.NewInt(1))
+
+// FeeRow is one (user, yieldSource) position in a fee report.
+type FeeRow struct {
+	User              common.Address `json:"user"`
+	YieldSource       common.Address `json:"yieldSource"`
+	YieldSourceOracle common.Address `json:"yieldSourceOracle"`
+	// OracleIDs lists the configurations on this ledger using the oracle.
+	// Ambiguous is set when they disagree on fee settings; FeePercent and
+	// FeeRecipient then come from the first, in a fee recipient report one
+	// paying the recipient.
+	OracleIDs    []common.Hash  `json:"yieldSourceOracleIds"`
+	Ambiguous    bool           `json:"ambiguous,omitempty"`
+	FeePercent   *big.Int       `json:"feePercent"`
+	FeeRecipient common.Address `json:"feeRecipient"`
+
+	Shares     *big.Int `json:"shares"`
+	CostBasis  *big.Int `json:"costBasis"`
+	PPS        *big.Int `json:"pps"`
+	Decimals   uint8    `json:"decimals"`
+	Value      *big.Int `json:"value"`
+	PendingFee *big.Int `json:"pendingFee"`
+
+	RealizedFees *big.Int `json:"realizedFees"`
+	Outflows     int      `json:"outflows"`
+
+	// Error records a failed preview call; the preview fields are then
+	// incomplete.
+	Error string `json:"error,omitempty"`
+}
+
+// FeeReport is the result of a Reporter run.
+type FeeReport struct {
+	Ledger common.Address `json:"ledger"`
+	Block  *big.Int       `json:"block,omitempty"`
+	Rows   []FeeRow       `json:"rows"`
+}
+
+// Reporter builds fee reports over every position of a user or of a fee
+// recipient on one ledger.
+type Reporter struct {
+	Ledger        common.Address
+	LedgerEvents  *SuperLedger.SuperLedgerFilterer
+	Configuration *SuperLedgerConfiguration.SuperLedgerConfiguration
+	Calls         CallBatcher
+
+	// FromBlock bounds the event scans; Block pins the previews (nil for
+	// latest).
+	FromBlock uint64
+	Block     *big.Int
+}
+
+// ForUser reports every position of user.
+func (r *Reporter) ForUser(ctx context.Context, user common.Address) (*FeeReport, error) {
+	configs, err := r.oracleConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return r.report(ctx, configs, []common.Address{user}, nil)
+}
+
+// ForFeeRecipient reports every position priced by an oracle whose
+// configuration on this ledger pays recipient. The events do not name the
+// configuration, so each row takes its fee settings from a configuration
+// paying recipient and is Ambiguous when others of its oracle pay elsewhere.
+func (r *Reporter) ForFeeRecipient(ctx context.Context, recipient common.Address) (*FeeReport, error) {
+	configs, err := r.oracleConfigs(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var oracles []common.Address
+	for oracle, cs := range configs {
+		// Configurations paying recipient first; newFeeRow takes the fee
+		// settings from the first.
+		sort.SliceStable(cs, func(i, j int) bool {
+			return cs[i].cfg.FeeRecipient == recipient && cs[j].cfg.FeeRecipient != recipient
+		})
+		if cs[0].cfg.FeeRecipient == recipient {
+			oracles = append(oracles, oracle)
+		}
+	}
+	if len(oracles) == 0 {
+		return &FeeReport{Ledger: r.Ledger, Block: r.Block}, nil
+	}
+	return r.report(ctx, configs, nil, oracles)
+}
+
+type oracleConfig struct {
+	id  common.Hash
+	cfg SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfig
+}
+
+func (r *Reporter) report(ctx context.Context, configs map[common.Address][]oracleConfig, users, oracles []common.Address) (*FeeReport, error) {
+	opts := &bind.FilterOpts{Start: r.FromBlock, End: blockEnd(r.Block), Context: ctx}
+
+	rows := make(map[Key]*FeeRow)
+	in, err := r.LedgerEvents.FilterAccountingInflow(opts, users, oracles, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter inflows: %w", err)
+	}
+	for in.Next() {
+		ev := in.Event
+		k := Key{User: ev.User, YieldSource: ev.YieldSource}
+		if _, ok := rows[k]; !ok {
+			rows[k] = newFeeRow(ev.User, ev.YieldSource, ev.YieldSourceOracle, configs[ev.YieldSourceOracle])
+		}
+	}
+	if err := closeIter(in.Error(), in.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: inflows: %w", err)
+	}
+
+	out, err := r.LedgerEvents.FilterAccountingOutflow(opts, users, oracles, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter outflows: %w", err)
+	}
+	for out.Next() {
+		ev := out.Event
+		k := Key{User: ev.User, YieldSource: ev.YieldSource}
+		row, ok := rows[k]
+		if !ok {
+			// Inflow predates FromBlock.
+			row = newFeeRow(ev.User, ev.YieldSource, ev.YieldSourceOracle, configs[ev.YieldSourceOracle])
+			rows[k] = row
+		}
+		row.RealizedFees.Add(row.RealizedFees, ev.FeeAmount)
+		row.Outflows++
+	}
+	if err := closeIter(out.Error(), out.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: outflows: %w", err)
+	}
+
+	report := &FeeReport{Ledger: r.Ledger, Block: r.Block, Rows: make([]FeeRow, 0, len(rows))}
+	for _, row := range rows {
+		report.Rows = append(report.Rows, *row)
+	}
+	sort.Slice(report.Rows, func(i, j int) bool {
+		a, b := report.Rows[i], report.Rows[j]
+		if c := a.User.Cmp(b.User); c != 0 {
+			return c < 0
+		}
+		return a.YieldSource.Cmp(b.YieldSource) < 0
+	})
+	if err := r.preview(ctx, report.Rows); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+func newFeeRow(user, yieldSource, oracle common.Address, configs []oracleConfig) *FeeRow {
+	row := &FeeRow{
+		User:              user,
+		YieldSource:       yieldSource,
+		YieldSourceOracle: oracle,
+		FeePercent:        new(big.Int),
+		RealizedFees:      new(big.Int),
+	}
+	for i, c := range configs {
+		row.OracleIDs = append(row.OracleIDs, c.id)
+		if i == 0 {
+			row.FeePercent, row.FeeRecipient = c.cfg.FeePercent, c.cfg.FeeRecipient
+		} else if c.cfg.FeePercent.Cmp(row.FeePercent) != 0 || c.cfg.FeeRecipient != row.FeeRecipient {
+			row.Ambiguous = true
+		}
+	}
+	return row
+}
+
+// oracleConfigs discovers every oracle ID from the configuration's events and
+// returns the current configurations that point at this ledger, keyed by
+// oracle address.
+func (r *Reporter) oracleConfigs(ctx context.Context) (map[common.Address][]oracleConfig, error) {
+	opts := &bind.FilterOpts{Start: 0, End: blockEnd(r.Block), Context: ctx}
+	seen := make(map[common.Hash]bool)
+	var ids [][32]byte
+	add := func(id [32]byte) {
+		if !seen[id] {
+			seen[id] = true
+			ids = append(ids, id)
+		}
+	}
+
+	set, err := r.Configuration.FilterYieldSourceOracleConfigSet(opts, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter config set: %w", err)
+	}
+	for set.Next() {
+		add(set.Event.YieldSourceOracleId)
+	}
+	if err := closeIter(set.Error(), set.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: config set: %w", err)
+	}
+	accepted, err := r.Configuration.FilterYieldSourceOracleConfigAccepted(opts, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter config accepted: %w", err)
+	}
+	for accepted.Next() {
+		add(accepted.Event.YieldSourceOracleId)
+	}
+	if err := closeIter(accepted.Error(), accepted.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: config accepted: %w", err)
+	}
+
+	out := make(map[common.Address][]oracleConfig)
+	if len(ids) == 0 {
+		return out, nil
+	}
+	cfgs, err := r.Configuration.GetYieldSourceOracleConfigs(&bind.CallOpts{Context: ctx, BlockNumber: r.Block}, ids)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: getYieldSourceOracleConfigs: %w", err)
+	}
+	for i, cfg := range cfgs {
+		if cfg.Ledger != r.Ledger {
+			continue
+		}
+		out[cfg.YieldSourceOracle] = append(out[cfg.YieldSourceOracle], oracleConfig{id: ids[i], cfg: cfg})
+	}
+	return out, nil
+}
+
+// preview fills the position and preview fields of rows in two batches: cost
+// basis, pps and decimals first, then previewFees.
+func (r *Reporter) preview(ctx context.Context, rows []FeeRow) error {
+	ledgerABI, err := SuperLedger.SuperLedgerMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+	oracleABI, err := IYieldSourceOracle.IYieldSourceOracleMetaData.GetAbi()
+	if err != nil {
+		return err
+	}
+
+	var calls callList
+	for _, row := range rows {
+		calls.add(r.Ledger, ledgerABI, "calculateCostBasisView", row.User, row.YieldSource, maxUint256)
+		calls.add(row.YieldSourceOracle, oracleABI, "getPricePerShare", row.YieldSource)
+		calls.add(row.YieldSourceOracle, oracleABI, "decimals", row.YieldSource)
+	}
+	if calls.err != nil {
+		return calls.err
+	}
+	results, err := r.Calls.BatchCall(ctx, calls.msgs, r.Block)
+	if err != nil {
+		return fmt.Errorf("ledger: preview batch: %w", err)
+	}
+
+	var (
+		feeCalls callList
+		feeRows  []int
+	)
+	for i := range rows {
+		row := &rows[i]
+		cb, err := unpack(ledgerABI, "calculateCostBasisView", results[3*i])
+		if err == nil {
+			row.CostBasis, row.Shares = cb[0].(*big.Int), cb[1].(*big.Int)
+		}
+		pps, err2 := unpack(oracleABI, "getPricePerShare", results[3*i+1])
+		dec, err3 := unpack(oracleABI, "decimals", results[3*i+2])
+		if err := firstErr(err, err2, err3); err != nil {
+			row.Error = err.Error()
+			continue
+		}
+		row.PPS, row.Decimals = pps[0].(*big.Int), dec[0].(uint8)
+		row.Value = mulDiv(row.Shares, row.PPS, pow10(row.Decimals))
+		row.PendingFee = new(big.Int)
+		// previewFees reverts with FEE_NOT_SET on any profit when no fee is
+		// configured; there is nothing to preview then.
+		if row.FeePercent.Sign() == 0 || row.Shares.Sign() == 0 {
+			continue
+		}
+		feeCalls.add(r.Ledger, ledgerABI, "previewFees",
+			row.User, row.YieldSource, row.Value, row.Shares, row.FeePercent, row.PPS, big.NewInt(int64(row.Decimals)))
+		feeRows = append(feeRows, i)
+	}
+	if feeCalls.err != nil {
+		return feeCalls.err
+	}
+	if len(feeCalls.msgs) == 0 {
+		return nil
+	}
+	results, err = r.Calls.BatchCall(ctx, feeCalls.msgs, r.Block)
+	if err != nil {
+		return fmt.Errorf("ledger: previewFees batch: %w", err)
+	}
+	for j, i := range feeRows {
+		fee, err := unpack(ledgerABI, "previewFees", results[j])
+		if err != nil {
+			rows[i].Error = err.Error()
+			continue
+		}
+		rows[i].PendingFee = fee[0].(*big.Int)
+	}
+	return nil
+}
+
+// callList accumulates packed calls, keeping the first packing error.
+type callList struct {
+	msgs []ethereum.CallMsg
+	err  error
+}
+
+func (l *callList) add(to common.Address, parsed *abi.ABI, method string, args ...any) {
+	data, err := parsed.Pack(method, args...)
+	if err != nil {
+		if l.err == nil {
+			l.err = fmt.Errorf("ledger: pack %s: %w", method, err)
+		}
+		return
+	}
+	l.msgs = append(l.msgs, ethereum.CallMsg{To: &to, Data: data})
+}
+
+func unpack(parsed *abi.ABI, method string, res CallResult) ([]any, error) {
+	if res.Err != nil {
+		return nil, fmt.Errorf("%s: %w", method, res.Err)
+	}
+	vals, err := parsed.Unpack(method, res.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	return vals, nil
+}
+
+func firstErr(errs ...error) error {
+	for _, err := range errs {
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func blockEnd(block *big.Int) *uint64 {
+	if block == nil {
+		return nil
+	}
+	n := block.Uint64()
+	return &n
+}
+
+// WriteJSON writes the report as indented JSON.
+func (r *FeeReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+var feeCSVHeader = []string{
+	"user", "yieldSource", "yieldSourceOracle", "yieldSourceOracleIds", "ambiguous", "feePercent", "feeRecipient",
+	"shares", "costBasis", "pps", "decimals", "value", "pendingFee", "realizedFees", "outflows", "error",
+}
+
+// WriteCSV writes one line per position, with a header.
+func (r *FeeReport) WriteCSV(w io.Writer) error {
+	cw := csv.NewWriter(w)
+	if err := cw.Write(feeCSVHeader); err != nil {
+		return err
+	}
+	for _, row := range r.Rows {
+		ids := ""
+		for i, id := range row.OracleIDs {
+			if i > 0 {
+				ids += ";"
+			}
+			ids += id.Hex()
+		}
+		rec := []string{
+			row.User.Hex(), row.YieldSource.Hex(), row.YieldSourceOracle.Hex(), ids,
+			strconv.FormatBool(row.Ambiguous), bigString(row.FeePercent), row.FeeRecipient.Hex(),
+			bigString(row.Shares), bigString(row.CostBasis), bigString(row.PPS), strconv.Itoa(int(row.Decimals)),
+			bigString(row.Value), bigString(row.PendingFee), bigString(row.RealizedFees), strconv.Itoa(row.Outflows),
+			row.Error,
+		}
+		if err := cw.Write(rec); err != nil {
+			return err
+		}
+	}
+	cw.Flush()
+	return cw.Error()
+}
+
+func bigString(x *big.Int) string {
+	if x == nil {
+		return ""
+	}
+	return x.String()
+}
@@ -0,0 +1,159 @@
+package ledger
+
+import (
+	"bytes"
+	"context"
+	"encoding/csv"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/MockYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/oracleid"
+)
+
+func TestFeeRowConfigs(t *testing.T) {
+	oracle := common.Address{0xcc}
+	cfg := func(fee int64, recipient byte) SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfig {
+		return SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfig{
+			YieldSourceOracle: oracle, FeePercent: big.NewInt(fee), FeeRecipient: common.Address{recipient},
+		}
+	}
+
+	row := newFeeRow(user, vault, oracle, []oracleConfig{{id: common.Hash{1}, cfg: cfg(1000, 0xf1)}, {id: common.Hash{2}, cfg: cfg(1000, 0xf1)}})
+	if row.Ambiguous || row.FeePercent.Int64() != 1000 || len(row.OracleIDs) != 2 {
+		t.Fatalf("agreeing configs: %+v", row)
+	}
+	row = newFeeRow(user, vault, oracle, []oracleConfig{{id: common.Hash{1}, cfg: cfg(1000, 0xf1)}, {id: common.Hash{2}, cfg: cfg(500, 0xf1)}})
+	if !row.Ambiguous {
+		t.Fatal("differing fee percents must be flagged")
+	}
+	row = newFeeRow(user, vault, oracle, nil)
+	if row.FeePercent.Sign() != 0 || len(row.OracleIDs) != 0 {
+		t.Fatalf("unknown oracle: %+v", row)
+	}
+}
+
+func TestFeeReportCSV(t *testing.T) {
+	row := newFeeRow(user, vault, common.Address{0xcc}, nil)
+	row.Shares, row.RealizedFees, row.Outflows = big.NewInt(42), big.NewInt(7), 2
+	report := &FeeReport{Rows: []FeeRow{*row}}
+
+	var buf bytes.Buffer
+	if err := report.WriteCSV(&buf); err != nil {
+		t.Fatal(err)
+	}
+	recs, err := csv.NewReader(&buf).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(recs) != 2 || len(recs[1]) != len(feeCSVHeader) {
+		t.Fatalf("records = %v", recs)
+	}
+	if recs[1][7] != "42" || recs[1][13] != "7" || recs[1][14] != "2" || recs[1][8] != "" {
+		t.Fatalf("row = %v", recs[1])
+	}
+}
+
+func TestReporter(t *testing.T) {
+	ctx := context.Background()
+	s, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	mine := func(tx *types.Transaction, err error) {
+		t.Helper()
+		if err != nil {
+			t.Fatal(err)
+		}
+		if _, err := s.Mine(ctx, tx); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	// A ledger the deployer may update directly, and a second oracle.
+	ledgerAddr, tx, ledger, err := SuperLedger.DeploySuperLedger(s.Auth, s.Client, s.Addresses["SuperLedgerConfiguration"], []common.Address{s.Auth.From})
+	mine(tx, err)
+	oracleX := s.Addresses["MockYieldSourceOracle"]
+	oracleY, tx, err := harness.DeployMockYieldSourceOracle(s.Auth, s.Client, big.NewInt(1e18), new(big.Int), new(big.Int), true)
+	mine(tx, err)
+
+	// X is configured twice, paying A and B; Y once, paying B.
+	recipientA, recipientB := common.Address{0xfa}, common.Address{0xfb}
+	salts := [][32]byte{{1}, {2}, {3}}
+	mine(s.LedgerConfiguration.SetYieldSourceOracles(s.Auth, salts, []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{
+		{YieldSourceOracle: oracleX, FeePercent: big.NewInt(1000), FeeRecipient: recipientA, Ledger: ledgerAddr},
+		{YieldSourceOracle: oracleX, FeePercent: big.NewInt(1000), FeeRecipient: recipientB, Ledger: ledgerAddr},
+		{YieldSourceOracle: oracleY, FeePercent: big.NewInt(1000), FeeRecipient: recipientB, Ledger: ledgerAddr},
+	}))
+	id := func(i int) [32]byte { return oracleid.DeriveID(salts[i], s.Auth.From) }
+
+	other := common.Address{0xa2}
+	vaultY := common.Address{0xb2}
+	mine(ledger.UpdateAccounting(s.Auth, user, vault, id(0), true, big.NewInt(100), new(big.Int)))
+	mine(ledger.UpdateAccounting(s.Auth, user, vaultY, id(2), true, big.NewInt(50), new(big.Int)))
+	mine(ledger.UpdateAccounting(s.Auth, other, vaultY, id(2), true, big.NewInt(30), new(big.Int)))
+	// X doubles in price: user's 100 shares carry a 10% fee on 100 profit.
+	ys, err := MockYieldSourceOracle.NewMockYieldSourceOracle(oracleX, s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	mine(ys.SetPricePerShare(s.Auth, big.NewInt(2e18)))
+
+	filterer, err := SuperLedger.NewSuperLedgerFilterer(ledgerAddr, s.Client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	r := &Reporter{Ledger: ledgerAddr, LedgerEvents: filterer, Configuration: s.LedgerConfiguration, Calls: &SequentialBatcher{Caller: s.Client}}
+
+	report, err := r.ForUser(ctx, user)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rows) != 2 {
+		t.Fatalf("user rows = %+v", report.Rows)
+	}
+	for _, row := range report.Rows {
+		if row.User != user || row.Error != "" {
+			t.Fatalf("user row = %+v", row)
+		}
+	}
+
+	report, err = r.ForFeeRecipient(ctx, recipientA)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rows) != 1 {
+		t.Fatalf("recipient A rows = %+v", report.Rows)
+	}
+	row := report.Rows[0]
+	if row.YieldSource != vault || row.FeeRecipient != recipientA || !row.Ambiguous ||
+		row.Shares.Int64() != 100 || row.Value.Int64() != 200 || row.PendingFee.Int64() != 10 {
+		t.Fatalf("recipient A row = %+v", row)
+	}
+
+	// B is paid by the second configuration of X, which comes second.
+	report, err = r.ForFeeRecipient(ctx, recipientB)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(report.Rows) != 3 {
+		t.Fatalf("recipient B rows = %+v", report.Rows)
+	}
+	for _, row := range report.Rows {
+		if row.FeeRecipient != recipientB || row.Ambiguous != (row.YieldSourceOracle == oracleX) {
+			t.Fatalf("recipient B row = %+v", row)
+		}
+	}
+
+	report, err = r.ForFeeRecipient(ctx, common.Address{0xfc})
+	if err != nil || len(report.Rows) != 0 {
+		t.Fatalf("unknown recipient = %+v, %v", report, err)
+	}
+}
@@ -0,0 +1,197 @@
+package ledger
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/IYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+)
+
+// FetchEvents collects the ledger's inflow, outflow and capped logs in
+// [start, end] (end nil for latest), sorted in chain order. users optionally
+// restricts inflows and outflows; capped logs are not indexed and are always
+// fetched in full, so they may include other users' caps, which the
+// Replayer skips because they do not immediately precede a fetched outflow.
+func FetchEvents(ctx context.Context, ledger *SuperLedger.SuperLedgerFilterer, start uint64, end *uint64, users []common.Address) ([]Event, error) {
+	opts := &bind.FilterOpts{Start: start, End: end, Context: ctx}
+	var events []Event
+
+	in, err := ledger.FilterAccountingInflow(opts, users, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter inflows: %w", err)
+	}
+	for in.Next() {
+		events = append(events, Event{Inflow: in.Event})
+	}
+	if err := closeIter(in.Error(), in.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: inflows: %w", err)
+	}
+
+	out, err := ledger.FilterAccountingOutflow(opts, users, nil, nil)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter outflows: %w", err)
+	}
+	for out.Next() {
+		events = append(events, Event{Outflow: out.Event})
+	}
+	if err := closeIter(out.Error(), out.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: outflows: %w", err)
+	}
+
+	capped, err := ledger.FilterUsedSharesCapped(opts)
+	if err != nil {
+		return nil, fmt.Errorf("ledger: filter capped: %w", err)
+	}
+	for capped.Next() {
+		events = append(events, Event{Capped: capped.Event})
+	}
+	if err := closeIter(capped.Error(), capped.Close()); err != nil {
+		return nil, fmt.Errorf("ledger: capped: %w", err)
+	}
+
+	SortEvents(events)
+	return events, nil
+}
+
+func closeIter(iterErr, closeErr error) error {
+	if iterErr != nil {
+		return iterErr
+	}
+	return closeErr
+}
+
+// OracleDecimals reads decimals from the yield source oracles named in
+// inflow events, caching one value per (oracle, yieldSource).
+type OracleDecimals struct {
+	Backend bind.ContractCaller
+
+	mu    sync.Mutex
+	cache map[[2]common.Address]uint8
+}
+
+// Decimals implements DecimalsSource.
+func (o *OracleDecimals) Decimals(ctx context.Context, oracle, yieldSource common.Address, block uint64) (uint8, error) {
+	key := [2]common.Address{oracle, yieldSource}
+	o.mu.Lock()
+	d, ok := o.cache[key]
+	o.mu.Unlock()
+	if ok {
+		return d, nil
+	}
+	caller, err := IYieldSourceOracle.NewIYieldSourceOracleCaller(oracle, o.Backend)
+	if err != nil {
+		return 0, err
+	}
+	d, err = caller.Decimals(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}, yieldSource)
+	if err != nil {
+		return 0, err
+	}
+	o.mu.Lock()
+	if o.cache == nil {
+		o.cache = make(map[[2]common.Address]uint8)
+	}
+	o.cache[key] = d
+	o.mu.Unlock()
+	return d, nil
+}
+
+// TraceResolver recovers usedShares from the updateAccounting calls made to
+// the ledger, using debug_traceTransaction with the callTracer.
+type TraceResolver struct {
+	Client *rpc.Client
+	Ledger common.Address
+
+	mu sync.Mutex
+	// calls holds the outflow calls of a transaction not yet matched to an
+	// event, in execution order.
+	calls map[common.Hash][]updateAccountingCall
+}
+
+type updateAccountingCall struct {
+	user, yieldSource common.Address
+	amount, used      *big.Int
+}
+
+type callFrame struct {
+	To    *common.Address `json:"to"`
+	Input hexutil.Bytes   `json:"input"`
+	Error string          `json:"error"`
+	Calls []callFrame     `json:"calls"`
+}
+
+// UsedShares implements UsedSharesResolver.
+func (t *TraceResolver) UsedShares(ctx context.Context, ev *SuperLedger.SuperLedgerAccountingOutflow) (*big.Int, error) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	calls, ok := t.calls[ev.Raw.TxHash]
+	if !ok {
+		var err error
+		if calls, err = t.trace(ctx, ev.Raw.TxHash); err != nil {
+			return nil, err
+		}
+		if t.calls == nil {
+			t.calls = make(map[common.Hash][]updateAccountingCall)
+		}
+	}
+	for i, c := range calls {
+		if c.user == ev.User && c.yieldSource == ev.YieldSource && c.amount.Cmp(ev.Amount) == 0 {
+			t.calls[ev.Raw.TxHash] = append(calls[:i:i], calls[i+1:]...)
+			return c.used, nil
+		}
+	}
+	return nil, errors.New("no matching updateAccounting call in trace")
+}
+
+func (t *TraceResolver) trace(ctx context.Context, tx common.Hash) ([]updateAccountingCall, error) {
+	var root callFrame
+	if err := t.Client.CallContext(ctx, &root, "debug_traceTransaction", tx, map[string]any{"tracer": "callTracer"}); err != nil {
+		return nil, fmt.Errorf("trace %s: %w", tx, err)
+	}
+	parsed, err := SuperLedger.SuperLedgerMetaData.GetAbi()
+	if err != nil {
+		return nil, err
+	}
+	method := parsed.Methods["updateAccounting"]
+
+	var calls []updateAccountingCall
+	var walk func(f *callFrame) error
+	walk = func(f *callFrame) error {
+		if f.Error != "" {
+			// Reverted frames emitted no logs.
+			return nil
+		}
+		if f.To != nil && *f.To == t.Ledger && len(f.Input) >= 4 && string(f.Input[:4]) == string(method.ID) {
+			args, err := method.Inputs.Unpack(f.Input[4:])
+			if err != nil {
+				return err
+			}
+			if !args[3].(bool) {
+				calls = append(calls, updateAccountingCall{
+					user:        args[0].(common.Address),
+					yieldSource: args[1].(common.Address),
+					amount:      args[4].(*big.Int),
+					used:        args[5].(*big.Int),
+				})
+			}
+		}
+		for i := range f.Calls {
+			if err := walk(&f.Calls[i]); err != nil {
+				return err
+			}
+		}
+		return nil
+	}
+	if err := walk(&root); err != nil {
+		return nil, err
+	}
+	return calls, nil
+}
@@ -0,0 +1,77 @@
+package ledger
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/rpc"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+)
+
+// traceAPI serves debug_traceTransaction from fixed call frames.
+type traceAPI struct {
+	frames map[common.Hash]*callFrame
+	calls  int
+}
+
+func (a *traceAPI) TraceTransaction(_ context.Context, tx common.Hash, _ map[string]any) (*callFrame, error) {
+	a.calls++
+	return a.frames[tx], nil
+}
+
+func TestTraceResolver(t *testing.T) {
+	ledgerAddr := common.Address{0x1e}
+	other := common.HexToAddress("0x00000000000000000000000000000000000000a2")
+	parsed, _ := SuperLedger.SuperLedgerMetaData.GetAbi()
+	update := func(u common.Address, inflow bool, amount, used int64) hexutil.Bytes {
+		data, err := parsed.Pack("updateAccounting", u, vault, [32]byte{}, inflow, big.NewInt(amount), big.NewInt(used))
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	executor := common.Address{0xe0}
+	tx := common.Hash{7}
+	api := &traceAPI{frames: map[common.Hash]*callFrame{tx: {To: &executor, Calls: []callFrame{
+		{To: &ledgerAddr, Input: update(user, true, 100, 0)},
+		// A reverted outflow emitted no event and must be ignored.
+		{To: &ledgerAddr, Input: update(user, false, 50, 99), Error: "execution reverted"},
+		{To: &executor, Calls: []callFrame{{To: &ledgerAddr, Input: update(other, false, 50, 7)}}},
+		{To: &ledgerAddr, Input: update(user, false, 50, 11)},
+	}}}}
+	server := rpc.NewServer()
+	if err := server.RegisterName("debug", api); err != nil {
+		t.Fatal(err)
+	}
+	defer server.Stop()
+	client := rpc.DialInProc(server)
+	defer client.Close()
+
+	r := &TraceResolver{Client: client, Ledger: ledgerAddr}
+	ev := func(u common.Address) *SuperLedger.SuperLedgerAccountingOutflow {
+		return &SuperLedger.SuperLedgerAccountingOutflow{User: u, YieldSource: vault, Amount: big.NewInt(50), Raw: raw(1, tx, 0)}
+	}
+	for _, want := range []struct {
+		user common.Address
+		used int64
+	}{{user, 11}, {other, 7}} {
+		used, err := r.UsedShares(context.Background(), ev(want.user))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if used.Int64() != want.used {
+			t.Fatalf("%s used = %v, want %d", want.user, used, want.used)
+		}
+	}
+	// Each call is matched once, and the trace is fetched once per tx.
+	if _, err := r.UsedShares(context.Background(), ev(user)); err == nil {
+		t.Fatal("matched the same updateAccounting call twice")
+	}
+	if api.calls != 1 {
+		t.Fatalf("traced %d times", api.calls)
+	}
+}
@@ -0,0 +1,203 @@
+// Package oracleid derives SuperLedgerConfiguration yieldSourceOracleIds
+// off-chain and keeps a per-chain registry of human-readable names for them.
+//
+// setYieldSourceOracles stores each config under
+// keccak256(abi.encodePacked(salt, msg.sender)), and that ID is what every
+// accounting hook payload carries in its first 32 bytes. A Registry is the
+// off-chain source of truth for which IDs a caller expects to exist, and
+// Registry.Encode refuses to build an accounting payload for any other ID.
+package oracleid
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"gopkg.in/yaml.v3"
+
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+)
+
+var (
+	// ErrUnknownName is returned for a name with no ID on a chain.
+	ErrUnknownName = errors.New("oracleid: unknown name")
+	// ErrConflict is returned when a name or ID is registered twice with different values.
+	ErrConflict = errors.New("oracleid: conflicting registration")
+)
+
+// DeriveID mirrors SuperLedgerConfiguration._deriveWithSender: the ID a
+// config set or proposed by sender with the given salt is stored under.
+func DeriveID(salt common.Hash, sender common.Address) common.Hash {
+	return crypto.Keccak256Hash(salt[:], sender[:])
+}
+
+// Registry maps names to yieldSourceOracleIds per chain. The zero value is
+// empty and ready to use; a Registry is not safe for concurrent writes.
+type Registry struct {
+	ids   map[uint64]map[string]common.Hash
+	names map[uint64]map[common.Hash]string
+}
+
+// NewRegistry returns an empty Registry.
+func NewRegistry() *Registry {
+	return &Registry{}
+}
+
+// Register records id under name on chainID. Registering the same pair again
+// is a no-op; reusing a name for another ID, or an ID under another name,
+// returns ErrConflict.
+func (r *Registry) Register(chainID uint64, name string, id common.Hash) error {
+	if name == "" {
+		return errors.New("oracleid: empty name")
+	}
+	if id == (common.Hash{}) {
+		return fmt.Errorf("oracleid: %s: zero id", name)
+	}
+	if prev, ok := r.ids[chainID][name]; ok && prev != id {
+		return fmt.Errorf("%w: %s on chain %d is %s, not %s", ErrConflict, name, chainID, prev.Hex(), id.Hex())
+	}
+	if prev, ok := r.names[chainID][id]; ok && prev != name {
+		return fmt.Errorf("%w: %s on chain %d is already named %s", ErrConflict, id.Hex(), chainID, prev)
+	}
+	if r.ids == nil {
+		r.ids = make(map[uint64]map[string]common.Hash)
+		r.names = make(map[uint64]map[common.Hash]string)
+	}
+	if r.ids[chainID] == nil {
+		r.ids[chainID] = make(map[string]common.Hash)
+		r.names[chainID] = make(map[common.Hash]string)
+	}
+	r.ids[chainID][name] = id
+	r.names[chainID][id] = name
+	return nil
+}
+
+// RegisterSalt derives the ID for (salt, sender) and registers it under name.
+func (r *Registry) RegisterSalt(chainID uint64, name string, salt common.Hash, sender common.Address) (common.Hash, error) {
+	id := DeriveID(salt, sender)
+	return id, r.Register(chainID, name, id)
+}
+
+// ID returns the ID registered under name on chainID.
+func (r *Registry) ID(chainID uint64, name string) (common.Hash, error) {
+	id, ok := r.ids[chainID][name]
+	if !ok {
+		return common.Hash{}, fmt.Errorf("%w: %s on chain %d", ErrUnknownName, name, chainID)
+	}
+	return id, nil
+}
+
+// Name returns the name id is registered under on chainID.
+func (r *Registry) Name(chainID uint64, id common.Hash) (string, bool) {
+	name, ok := r.names[chainID][id]
+	return name, ok
+}
+
+// Registered reports whether id is registered on chainID. It satisfies
+// hooks.OracleIDs.
+func (r *Registry) Registered(chainID uint64, id [32]byte) bool {
+	_, ok := r.names[chainID][common.Hash(id)]
+	return ok
+}
+
+// Names returns the names registered on chainID, sorted.
+func (r *Registry) Names(chainID uint64) []string {
+	out := make([]string, 0, len(r.ids[chainID]))
+	for name := range r.ids[chainID] {
+		out = append(out, name)
+	}
+	sort.Strings(out)
+	return out
+}
+
+// ChainIDs returns the chains with at least one registered ID, sorted.
+func (r *Registry) ChainIDs() []uint64 {
+	out := make([]uint64, 0, len(r.ids))
+	for id := range r.ids {
+		out = append(out, id)
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i] < out[j] })
+	return out
+}
+
+// Encode encodes d for chainID, refusing accounting hook payloads whose
+// yieldSourceOracleId is not registered on that chain.
+func (r *Registry) Encode(chainID uint64, d hooks.HookData) ([]byte, error) {
+	return hooks.EncodeFor(d, chainID, r)
+}
+
+// Header returns the hook payload header for the named ID and yield source.
+func (r *Registry) Header(chainID uint64, name string, yieldSource common.Address) (hooks.Header, error) {
+	id, err := r.ID(chainID, name)
+	if err != nil {
+		return hooks.Header{}, err
+	}
+	return hooks.Header{YieldSourceOracleId: id, YieldSource: yieldSource}, nil
+}
+
+// fileEntry is one name in a registry file: either an explicit id or the
+// salt and sender it was derived from (or both, which must agree).
+type fileEntry struct {
+	ID     *common.Hash    `yaml:"id"`
+	Salt   *common.Hash    `yaml:"salt"`
+	Sender *common.Address `yaml:"sender"`
+}
+
+// LoadRegistry reads a registry file. See ParseRegistry for the format.
+func LoadRegistry(path string) (*Registry, error) {
+	raw, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("oracleid: %w", err)
+	}
+	return ParseRegistry(raw)
+}
+
+// ParseRegistry parses a YAML or JSON registry keyed by chain ID and name:
+//
+//	"8453":
+//	  morpho-usdc: {salt: 0x01..., sender: 0xab...}
+//	  euler-weth:  {id: 0x9f...}
+func ParseRegistry(raw []byte) (*Registry, error) {
+	var file map[string]map[string]fileEntry
+	if err := yaml.Unmarshal(raw, &file); err != nil {
+		return nil, fmt.Errorf("oracleid: parse registry: %w", err)
+	}
+	r := NewRegistry()
+	for chain, entries := range file {
+		chainID, err := strconv.ParseUint(chain, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("oracleid: chain %q: %w", chain, err)
+		}
+		for name, e := range entries {
+			id, err := e.resolve()
+			if err != nil {
+				return nil, fmt.Errorf("oracleid: %s on chain %d: %w", name, chainID, err)
+			}
+			if err := r.Register(chainID, name, id); err != nil {
+				return nil, err
+			}
+		}
+	}
+	return r, nil
+}
+
+func (e fileEntry) resolve() (common.Hash, error) {
+	if e.Salt == nil {
+		if e.ID == nil {
+			return common.Hash{}, errors.New("id or salt and sender required")
+		}
+		return *e.ID, nil
+	}
+	if e.Sender == nil {
+		return common.Hash{}, errors.New("salt without sender")
+	}
+	id := DeriveID(*e.Salt, *e.Sender)
+	if e.ID != nil && *e.ID != id {
+		return common.Hash{}, fmt.Errorf("id %s does not match salt (derived %s)", e.ID.Hex(), id.Hex())
+	}
+	return id, nil
+}
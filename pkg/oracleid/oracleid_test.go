@@ -0,0 +1,92 @@
+package oracleid_test
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+	"github.com/superform-xyz/v2-core/pkg/oracleid"
+)
+
+// TestDeriveIDMatchesContract sets a config on a simulated
+// SuperLedgerConfiguration and reads it back under the derived ID.
+func TestDeriveIDMatchesContract(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	sim, auth, sender, cfg := stack.Backend, stack.Auth, stack.Auth.From, stack.LedgerConfiguration
+
+	salt := common.HexToHash("0x5a17")
+	_, err = cfg.SetYieldSourceOracles(auth, [][32]byte{salt}, []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{{
+		YieldSourceOracle: common.Address{0xb1}, FeePercent: big.NewInt(100), FeeRecipient: common.Address{0xc1}, Ledger: common.Address{0xd1},
+	}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	got, err := cfg.GetYieldSourceOracleConfig(nil, oracleid.DeriveID(salt, sender))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got.Manager != sender || got.Ledger != (common.Address{0xd1}) {
+		t.Fatalf("config under derived id = %+v", got)
+	}
+}
+
+func TestRegistry(t *testing.T) {
+	sender := common.HexToAddress("0xab")
+	r, err := oracleid.ParseRegistry([]byte(`
+"8453":
+  morpho-usdc: {salt: "0x0000000000000000000000000000000000000000000000000000000000000001", sender: "0x00000000000000000000000000000000000000ab"}
+  euler-weth: {id: "0x00000000000000000000000000000000000000000000000000000000000000ee"}
+`))
+	if err != nil {
+		t.Fatal(err)
+	}
+	id, err := r.ID(8453, "morpho-usdc")
+	if err != nil || id != oracleid.DeriveID(common.HexToHash("0x01"), sender) {
+		t.Fatalf("ID = %s, %v", id.Hex(), err)
+	}
+	if name, ok := r.Name(8453, common.HexToHash("0xee")); !ok || name != "euler-weth" {
+		t.Fatalf("Name = %q, %v", name, ok)
+	}
+	if _, err := r.ID(1, "morpho-usdc"); !errors.Is(err, oracleid.ErrUnknownName) {
+		t.Fatalf("ID on other chain: %v", err)
+	}
+	if err := r.Register(8453, "morpho-usdc", common.HexToHash("0xff")); !errors.Is(err, oracleid.ErrConflict) {
+		t.Fatalf("rename: %v", err)
+	}
+	if err := r.Register(8453, "alias", id); !errors.Is(err, oracleid.ErrConflict) {
+		t.Fatalf("alias: %v", err)
+	}
+
+	h, err := r.Header(8453, "morpho-usdc", common.Address{0x01})
+	if err != nil {
+		t.Fatal(err)
+	}
+	deposit := &hooks.Deposit4626Vault{Header: h, Amount: big.NewInt(1)}
+	data, err := r.Encode(8453, deposit)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got, _ := hooks.ExtractYieldSourceOracleId(data); got != id {
+		t.Fatalf("encoded id = %x", got)
+	}
+	if _, err := r.Encode(1, deposit); !errors.Is(err, hooks.ErrUnregisteredOracleID) {
+		t.Fatalf("encode on unregistered chain: %v", err)
+	}
+
+	// Non-accounting hooks keep a placeholder ID and are not checked.
+	approve := &hooks.ApproveERC20{Token: common.Address{0x02}, Spender: common.Address{0x03}, Amount: big.NewInt(1)}
+	if _, err := r.Encode(1, approve); err != nil {
+		t.Fatal(err)
+	}
+}
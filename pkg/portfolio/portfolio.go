@@ -0,0 +1,383 @@
+// Package portfolio values accounts' yield-source positions in a single quote
+// asset through SuperYieldSourceOracle's multi-quote methods.
+//
+// SuperYieldSourceOracle takes parallel arrays of yield sources, oracles,
+// bases, quotes and price oracles. A Service assembles those arrays from a
+// list of accounts, deduplicates positions shared between accounts, splits
+// the work into calls that fit calldata and gas limits, and maps the results
+// back onto a per-account breakdown.
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/pkg/ledger"
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+)
+
+// USD is the quote address for US dollars in the euler price-oracle
+// convention used by SuperOracle: the ISO 4217 numeric code 840.
+var USD = common.BigToAddress(big.NewInt(840))
+
+// Position is a holding in one yield source, priced by YieldSourceOracle in
+// units of Base (the yield source's underlying asset).
+type Position struct {
+	YieldSource       common.Address `json:"yieldSource"`
+	YieldSourceOracle common.Address `json:"yieldSourceOracle"`
+	Base              common.Address `json:"base"`
+}
+
+// Account lists the positions to value for one owner of shares.
+type Account struct {
+	Address   common.Address
+	Positions []Position
+}
+
+// Quote selects the asset values are reported in and the IOracle
+// (typically SuperOracle) converting base amounts into it.
+type Quote struct {
+	Asset  common.Address `json:"asset"`
+	Oracle common.Address `json:"oracle"`
+}
+
+// Limits bounds the size of each eth_call. Zero fields take the defaults
+// noted below.
+type Limits struct {
+	// MaxCalldata caps the calldata of one call in bytes; 64 KiB by default.
+	MaxCalldata int
+	// MaxGas caps the estimated gas of one call; 25M by default, under the
+	// usual node eth_call cap.
+	MaxGas uint64
+	// GasPerQuote estimates one yield-source oracle read plus one price
+	// quote; 150k by default.
+	GasPerQuote uint64
+}
+
+func (l Limits) withDefaults() Limits {
+	if l.MaxCalldata <= 0 {
+		l.MaxCalldata = 64 << 10
+	}
+	if l.MaxGas == 0 {
+		l.MaxGas = 25_000_000
+	}
+	if l.GasPerQuote == 0 {
+		l.GasPerQuote = 150_000
+	}
+	return l
+}
+
+// PositionValue is the valuation of one account's position.
+type PositionValue struct {
+	Position
+	// PricePerShare is the quote value of one share as returned by
+	// getPricePerShareMultipleQuote.
+	PricePerShare *big.Int `json:"pricePerShare"`
+	// Value is the account's holding in the quote asset.
+	Value *big.Int `json:"value"`
+	// TVL is the whole yield source's TVL in the quote asset.
+	TVL *big.Int `json:"tvl"`
+	// Error records a call that reverted for this position; fields it
+	// would have filled are nil.
+	Error string `json:"error,omitempty"`
+}
+
+// AccountValue is the breakdown for one account. Total sums the Value of
+// every position without an error.
+type AccountValue struct {
+	Account   common.Address  `json:"account"`
+	Total     *big.Int        `json:"total"`
+	Positions []PositionValue `json:"positions"`
+}
+
+// Valuation is the result of Service.Value.
+type Valuation struct {
+	Quote    Quote          `json:"quote"`
+	Block    *big.Int       `json:"block,omitempty"`
+	Accounts []AccountValue `json:"accounts"`
+	// Calls is the number of eth_calls issued, including retries of split
+	// chunks.
+	Calls int `json:"calls"`
+}
+
+// Service values portfolios through a deployed SuperYieldSourceOracle.
+type Service struct {
+	// Address is the SuperYieldSourceOracle.
+	Address common.Address
+	Calls   ledger.CallBatcher
+	Quote   Quote
+	Limits  Limits
+	// Block pins the calls; nil for latest.
+	Block *big.Int
+}
+
+var superOracleABI, _ = SuperYieldSourceOracle.SuperYieldSourceOracleMetaData.GetAbi()
+
+const (
+	methodPPS    = "getPricePerShareMultipleQuote"
+	methodTVL    = "getTVLMultipleQuote"
+	methodOwners = "getTVLByOwnerOfSharesMultipleQuote"
+)
+
+// shape describes the ABI-encoded calldata size of a multi-quote method:
+// base bytes plus a fixed cost per position and per owner.
+type shape struct{ base, perItem, perOwner int }
+
+var shapes = map[string]shape{
+	// selector, five array offsets, five (length, n addresses) arrays
+	methodPPS: {4 + 5*32 + 5*32, 5 * 32, 0},
+	methodTVL: {4 + 5*32 + 5*32, 5 * 32, 0},
+	// selector, six offsets, five flat arrays, the outer address[][] length,
+	// and per item one offset plus an inner length
+	methodOwners: {4 + 6*32 + 5*32 + 32, 5*32 + 2*32, 32},
+}
+
+// item is one position in a call, with the owners to value for
+// getTVLByOwnerOfSharesMultipleQuote.
+type item struct {
+	pos    int
+	owners []common.Address
+}
+
+type chunk struct {
+	method string
+	items  []item
+}
+
+// Value values every position of accounts. Call failures are recorded per
+// position rather than returned; the error is reserved for transport and
+// configuration problems.
+func (s *Service) Value(ctx context.Context, accounts []Account) (*Valuation, error) {
+	if s.Quote.Asset == (common.Address{}) || s.Quote.Oracle == (common.Address{}) {
+		return nil, errors.New("portfolio: quote asset and oracle required")
+	}
+	limits := s.Limits.withDefaults()
+
+	// Group owners by distinct position so each yield source is quoted once.
+	var (
+		positions []Position
+		owners    [][]common.Address
+		index     = make(map[Position]int)
+		seen      = make(map[Position]map[common.Address]bool)
+	)
+	for _, a := range accounts {
+		for _, p := range a.Positions {
+			i, ok := index[p]
+			if !ok {
+				i = len(positions)
+				index[p] = i
+				positions = append(positions, p)
+				owners = append(owners, nil)
+				seen[p] = make(map[common.Address]bool)
+			}
+			if !seen[p][a.Address] {
+				seen[p][a.Address] = true
+				owners[i] = append(owners[i], a.Address)
+			}
+		}
+	}
+
+	var pending []chunk
+	for _, method := range []string{methodPPS, methodTVL, methodOwners} {
+		var items []item
+		for i := range positions {
+			if method != methodOwners {
+				items = append(items, item{pos: i})
+				continue
+			}
+			split, err := limits.splitOwners(owners[i])
+			if err != nil {
+				return nil, err
+			}
+			for _, o := range split {
+				items = append(items, item{pos: i, owners: o})
+			}
+		}
+		chunks, err := limits.chunk(method, items)
+		if err != nil {
+			return nil, err
+		}
+		pending = append(pending, chunks...)
+	}
+
+	var (
+		pps    = make([]*big.Int, len(positions))
+		tvl    = make([]*big.Int, len(positions))
+		values = make(map[Position]map[common.Address]*big.Int)
+		errs   = make(map[Position]error)
+		calls  int
+	)
+	for len(pending) > 0 {
+		msgs := make([]ethereum.CallMsg, len(pending))
+		for i, c := range pending {
+			data, err := s.pack(c, positions)
+			if err != nil {
+				return nil, err
+			}
+			msgs[i] = ethereum.CallMsg{To: &s.Address, Data: data}
+		}
+		results, err := s.Calls.BatchCall(ctx, msgs, s.Block)
+		if err != nil {
+			return nil, fmt.Errorf("portfolio: batch: %w", err)
+		}
+		calls += len(msgs)
+
+		var retry []chunk
+		for i, c := range pending {
+			vals, err := unpack(c.method, results[i])
+			if err != nil {
+				if !reverts.IsRevert(results[i].Err) {
+					return nil, fmt.Errorf("portfolio: %w", err)
+				}
+				// One bad position reverts the whole chunk; bisect to isolate it.
+				if len(c.items) > 1 {
+					mid := len(c.items) / 2
+					retry = append(retry, chunk{c.method, c.items[:mid]}, chunk{c.method, c.items[mid:]})
+					continue
+				}
+				p := positions[c.items[0].pos]
+				if errs[p] == nil {
+					errs[p] = err
+				}
+				continue
+			}
+			switch c.method {
+			case methodPPS, methodTVL:
+				out := vals[0].([]*big.Int)
+				dst := pps
+				if c.method == methodTVL {
+					dst = tvl
+				}
+				for j, it := range c.items {
+					dst[it.pos] = out[j]
+				}
+			case methodOwners:
+				out := vals[0].([][]*big.Int)
+				for j, it := range c.items {
+					p := positions[it.pos]
+					if values[p] == nil {
+						values[p] = make(map[common.Address]*big.Int)
+					}
+					for k, owner := range it.owners {
+						values[p][owner] = out[j][k]
+					}
+				}
+			}
+		}
+		pending = retry
+	}
+
+	v := &Valuation{Quote: s.Quote, Block: s.Block, Calls: calls, Accounts: make([]AccountValue, 0, len(accounts))}
+	for _, a := range accounts {
+		av := AccountValue{Account: a.Address, Total: new(big.Int), Positions: make([]PositionValue, 0, len(a.Positions))}
+		for _, p := range a.Positions {
+			i := index[p]
+			pv := PositionValue{Position: p, PricePerShare: pps[i], TVL: tvl[i], Value: values[p][a.Address]}
+			if err := errs[p]; err != nil {
+				pv.Error = err.Error()
+			} else if pv.Value != nil {
+				av.Total.Add(av.Total, pv.Value)
+			}
+			av.Positions = append(av.Positions, pv)
+		}
+		v.Accounts = append(v.Accounts, av)
+	}
+	return v, nil
+}
+
+// splitOwners cuts owners into groups small enough to fit a call on their own.
+func (l Limits) splitOwners(owners []common.Address) ([][]common.Address, error) {
+	sh := shapes[methodOwners]
+	limit := min((l.MaxCalldata-sh.base-sh.perItem)/sh.perOwner, int(l.MaxGas/l.GasPerQuote))
+	if limit < 1 {
+		return nil, errors.New("portfolio: limits too small for a single owner")
+	}
+	var out [][]common.Address
+	for len(owners) > limit {
+		out = append(out, owners[:limit])
+		owners = owners[limit:]
+	}
+	return append(out, owners), nil
+}
+
+// chunk packs items greedily into calls within the calldata and gas limits.
+func (l Limits) chunk(method string, items []item) ([]chunk, error) {
+	sh := shapes[method]
+	var (
+		out  []chunk
+		cur  []item
+		size int
+		gas  uint64
+	)
+	for _, it := range items {
+		quotes := uint64(1)
+		if method == methodOwners {
+			quotes = uint64(len(it.owners))
+		}
+		itemSize := sh.perItem + sh.perOwner*len(it.owners)
+		itemGas := quotes * l.GasPerQuote
+		if sh.base+itemSize > l.MaxCalldata || itemGas > l.MaxGas {
+			return nil, fmt.Errorf("portfolio: limits too small for a single %s entry", method)
+		}
+		if len(cur) > 0 && (sh.base+size+itemSize > l.MaxCalldata || gas+itemGas > l.MaxGas) {
+			out = append(out, chunk{method, cur})
+			cur, size, gas = nil, 0, 0
+		}
+		cur = append(cur, it)
+		size += itemSize
+		gas += itemGas
+	}
+	if len(cur) > 0 {
+		out = append(out, chunk{method, cur})
+	}
+	return out, nil
+}
+
+// pack builds the parallel argument arrays for c.
+func (s *Service) pack(c chunk, positions []Position) ([]byte, error) {
+	n := len(c.items)
+	var (
+		sources = make([]common.Address, n)
+		oracles = make([]common.Address, n)
+		bases   = make([]common.Address, n)
+		quotes  = make([]common.Address, n)
+		prices  = make([]common.Address, n)
+		owners  = make([][]common.Address, n)
+	)
+	for i, it := range c.items {
+		p := positions[it.pos]
+		sources[i], oracles[i], bases[i] = p.YieldSource, p.YieldSourceOracle, p.Base
+		quotes[i], prices[i] = s.Quote.Asset, s.Quote.Oracle
+		owners[i] = it.owners
+	}
+	var (
+		data []byte
+		err  error
+	)
+	if c.method == methodOwners {
+		data, err = superOracleABI.Pack(c.method, sources, oracles, owners, bases, quotes, prices)
+	} else {
+		data, err = superOracleABI.Pack(c.method, sources, oracles, bases, quotes, prices)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("portfolio: pack %s: %w", c.method, err)
+	}
+	return data, nil
+}
+
+func unpack(method string, res ledger.CallResult) ([]any, error) {
+	if res.Err != nil {
+		return nil, fmt.Errorf("%s: %w", method, res.Err)
+	}
+	vals, err := superOracleABI.Unpack(method, res.Data)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", method, err)
+	}
+	return vals, nil
+}
@@ -0,0 +1,151 @@
+package portfolio
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/pkg/ledger"
+)
+
+// fakeOracle answers multi-quote calls the way SuperYieldSourceOracle would,
+// with TVLs derived from addresses and one yield source that always reverts,
+// or fails with err when set.
+type fakeOracle struct {
+	bad   common.Address
+	err   error
+	calls []int
+}
+
+func (f *fakeOracle) BatchCall(_ context.Context, calls []ethereum.CallMsg, _ *big.Int) ([]ledger.CallResult, error) {
+	out := make([]ledger.CallResult, len(calls))
+	for i, msg := range calls {
+		method, err := superOracleABI.MethodById(msg.Data[:4])
+		if err != nil {
+			return nil, err
+		}
+		args, err := method.Inputs.Unpack(msg.Data[4:])
+		if err != nil {
+			return nil, err
+		}
+		sources := args[0].([]common.Address)
+		f.calls = append(f.calls, len(sources))
+		if containsAddr(sources, f.bad) {
+			out[i].Err = errors.New("execution reverted")
+			if f.err != nil {
+				out[i].Err = f.err
+			}
+			continue
+		}
+		switch method.Name {
+		case methodPPS, methodTVL:
+			vals := make([]*big.Int, len(sources))
+			for j, s := range sources {
+				vals[j] = new(big.Int).SetBytes(s[:])
+				if method.Name == methodTVL {
+					vals[j].Mul(vals[j], big.NewInt(1000))
+				}
+			}
+			out[i].Data, _ = method.Outputs.Pack(vals)
+		case methodOwners:
+			owners := args[2].([][]common.Address)
+			users := make([][]*big.Int, len(sources))
+			totals := make([]*big.Int, len(sources))
+			for j, s := range sources {
+				totals[j] = new(big.Int)
+				for _, o := range owners[j] {
+					v := new(big.Int).Add(new(big.Int).SetBytes(s[:]), new(big.Int).SetBytes(o[:]))
+					users[j] = append(users[j], v)
+					totals[j].Add(totals[j], v)
+				}
+			}
+			out[i].Data, _ = method.Outputs.Pack(users, totals)
+		}
+	}
+	return out, nil
+}
+
+func containsAddr(list []common.Address, a common.Address) bool {
+	for _, x := range list {
+		if x == a {
+			return true
+		}
+	}
+	return false
+}
+
+func TestShapesMatchEncoding(t *testing.T) {
+	s := &Service{Quote: Quote{Asset: USD, Oracle: common.Address{0x0f}}}
+	positions := []Position{{YieldSource: common.Address{1}}, {YieldSource: common.Address{2}}}
+	items := []item{{0, []common.Address{{0xa}}}, {1, []common.Address{{0xa}, {0xb}, {0xc}}}}
+	for _, method := range []string{methodPPS, methodTVL, methodOwners} {
+		data, err := s.pack(chunk{method, items}, positions)
+		if err != nil {
+			t.Fatal(err)
+		}
+		sh := shapes[method]
+		want := sh.base + 2*sh.perItem
+		if method == methodOwners {
+			want += 4 * sh.perOwner
+		}
+		if len(data) != want {
+			t.Errorf("%s: calldata %d bytes, shape predicts %d", method, len(data), want)
+		}
+	}
+}
+
+func TestValue(t *testing.T) {
+	pos := func(b byte) Position {
+		return Position{YieldSource: common.Address{19: b}, YieldSourceOracle: common.Address{0xee}, Base: common.Address{0xba}}
+	}
+	alice, bob := common.Address{19: 0xa0}, common.Address{19: 0xb0}
+	accounts := []Account{
+		{Address: alice, Positions: []Position{pos(1), pos(2), pos(3)}},
+		{Address: bob, Positions: []Position{pos(2), pos(3), pos(4)}},
+	}
+	oracle := &fakeOracle{bad: pos(3).YieldSource}
+	s := &Service{
+		Calls: oracle,
+		Quote: Quote{Asset: USD, Oracle: common.Address{0x0f}},
+		// Two quotes per call forces chunking and owner splitting.
+		Limits: Limits{GasPerQuote: 1, MaxGas: 2},
+	}
+	v, err := s.Value(context.Background(), accounts)
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, n := range oracle.calls {
+		if n > 2 {
+			t.Fatalf("call with %d positions exceeds the gas limit", n)
+		}
+	}
+
+	a := v.Accounts[0]
+	if got := a.Positions[1]; got.PricePerShare.Int64() != 2 || got.TVL.Int64() != 2000 || got.Value.Int64() != 2+0xa0 {
+		t.Fatalf("alice pos 2 = %+v", got)
+	}
+	if a.Positions[2].Error == "" || a.Positions[2].Value != nil {
+		t.Fatalf("reverting position not isolated: %+v", a.Positions[2])
+	}
+	if want := int64(1 + 0xa0 + 2 + 0xa0); a.Total.Int64() != want {
+		t.Fatalf("alice total = %s, want %d", a.Total, want)
+	}
+	if want := int64(2 + 0xb0 + 4 + 0xb0); v.Accounts[1].Total.Int64() != want {
+		t.Fatalf("bob total = %s, want %d", v.Accounts[1].Total, want)
+	}
+
+	if _, err := (&Service{Calls: oracle}).Value(context.Background(), accounts); err == nil {
+		t.Fatal("expected missing quote to be rejected")
+	}
+
+	// Errors other than reverts fail the valuation instead of a position.
+	timeout := errors.New("i/o timeout")
+	s.Calls = &fakeOracle{bad: pos(3).YieldSource, err: timeout}
+	if _, err := s.Value(context.Background(), accounts); !errors.Is(err, timeout) {
+		t.Fatalf("non-revert error = %v", err)
+	}
+}
@@ -0,0 +1,111 @@
+package ppshistory
+
+import (
+	"math"
+	"math/big"
+	"sort"
+	"time"
+)
+
+// Day is the length of the shortest trailing window.
+const Day = 24 * time.Hour
+
+// year is the annualisation period.
+const year = 365 * Day
+
+// Windows are the trailing APY windows reported by Trailing.
+var Windows = []time.Duration{Day, 7 * Day, 30 * Day}
+
+// DefaultTolerance is the fraction of a window an endpoint sample may lie
+// away from its target time.
+const DefaultTolerance = 0.25
+
+// APY is a trailing yield over one window.
+type APY struct {
+	Window time.Duration `json:"window"`
+	// Start and End are the samples the yield is measured between.
+	Start Sample `json:"start"`
+	End   Sample `json:"end"`
+	// Value is the compounded annual yield, 0.05 for 5%. It is annualised
+	// over the actual time between Start and End, so an endpoint picked off
+	// its target time does not skew it.
+	Value float64 `json:"value"`
+	// Reason is set, and Value meaningless, when the series has no usable
+	// sample near one of the window's endpoints.
+	Reason string `json:"reason,omitempty"`
+}
+
+// OK reports whether Value was computed.
+func (a APY) OK() bool { return a.Reason == "" }
+
+// Trailing computes the APY over each of Windows ending at end with
+// DefaultTolerance. samples must be in block order, as Store.Samples
+// returns them.
+func Trailing(samples []Sample, end time.Time) []APY {
+	out := make([]APY, len(Windows))
+	for i, w := range Windows {
+		out[i] = TrailingAPY(samples, w, end, DefaultTolerance)
+	}
+	return out
+}
+
+// TrailingAPY computes the APY over window ending at end. Each endpoint is
+// the sample nearest its target time, provided it lies within
+// tolerance*window of it; the end sample must also not be after end.
+func TrailingAPY(samples []Sample, window time.Duration, end time.Time, tolerance float64) APY {
+	res := APY{Window: window}
+	maxGap := time.Duration(float64(window) * tolerance)
+
+	// Last sample at or before end.
+	i := sort.Search(len(samples), func(i int) bool { return samples[i].Time.After(end) }) - 1
+	if i < 0 || end.Sub(samples[i].Time) > maxGap {
+		res.Reason = "no sample near window end"
+		return res
+	}
+	res.End = samples[i]
+
+	target := res.End.Time.Add(-window)
+	j := nearest(samples[:i], target)
+	if j < 0 || absDuration(samples[j].Time.Sub(target)) > maxGap {
+		res.Reason = "no sample near window start"
+		return res
+	}
+	res.Start = samples[j]
+
+	elapsed := res.End.Time.Sub(res.Start.Time)
+	if elapsed <= 0 {
+		res.Reason = "window endpoints coincide"
+		return res
+	}
+	if res.Start.PPS.Sign() == 0 {
+		res.Reason = "zero price per share at window start"
+		return res
+	}
+	ratio, _ := new(big.Rat).SetFrac(res.End.PPS, res.Start.PPS).Float64()
+	res.Value = math.Pow(ratio, float64(year)/float64(elapsed)) - 1
+	return res
+}
+
+// nearest returns the index of the sample closest in time to t, or -1.
+func nearest(samples []Sample, t time.Time) int {
+	if len(samples) == 0 {
+		return -1
+	}
+	i := sort.Search(len(samples), func(i int) bool { return !samples[i].Time.Before(t) })
+	switch {
+	case i == 0:
+		return 0
+	case i == len(samples):
+		return i - 1
+	case samples[i].Time.Sub(t) < t.Sub(samples[i-1].Time):
+		return i
+	}
+	return i - 1
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+	return d
+}
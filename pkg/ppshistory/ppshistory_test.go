@@ -0,0 +1,96 @@
+package ppshistory
+
+import (
+	"context"
+	"errors"
+	"math"
+	"math/big"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+var genesis = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+// fakeChain has one block per hour and a yield source growing at 5% a year
+// that only exists from block 10.
+type fakeChain struct{ head uint64 }
+
+func (c *fakeChain) Address() common.Address { return common.Address{0x0a} }
+
+func (c *fakeChain) PricePerShare(opts *bind.CallOpts, _ common.Address) (*big.Int, error) {
+	block := opts.BlockNumber.Uint64()
+	if block < 10 {
+		return nil, errors.New("execution reverted")
+	}
+	growth := math.Pow(1.05, float64(block)*float64(time.Hour)/float64(year))
+	v, _ := big.NewFloat(1e18 * growth).Int(nil)
+	return v, nil
+}
+
+func (c *fakeChain) HeaderByNumber(_ context.Context, number *big.Int) (*types.Header, error) {
+	n := c.head
+	if number != nil {
+		n = number.Uint64()
+	}
+	return &types.Header{Number: new(big.Int).SetUint64(n), Time: uint64(genesis.Add(time.Duration(n) * time.Hour).Unix())}, nil
+}
+
+func TestSamplerAndTrailing(t *testing.T) {
+	store, err := OpenStore(filepath.Join(t.TempDir(), "pps.db"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer store.Close()
+	chain := &fakeChain{head: 40 * 24}
+	s := &Sampler{ChainID: 1, Oracle: chain, Headers: chain, Store: store}
+
+	if b, err := s.BlockAt(context.Background(), genesis.Add(90*time.Minute)); err != nil || b != 1 {
+		t.Fatalf("BlockAt = %d, %v", b, err)
+	}
+
+	yieldSource := common.Address{0x55}
+	rep, err := s.SampleEvery(context.Background(), yieldSource, genesis, genesis.Add(40*Day), 6*time.Hour)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Added != 159 || len(rep.Failed) != 2 {
+		t.Fatalf("report = %+v", rep)
+	}
+	rep, err = s.SampleEvery(context.Background(), yieldSource, genesis, genesis.Add(40*Day), 6*time.Hour)
+	if err != nil || rep.Added != 0 || rep.Existing != 159 {
+		t.Fatalf("second run = %+v, %v", rep, err)
+	}
+
+	samples, err := store.Samples(s.Key(yieldSource), 0, 0)
+	if err != nil {
+		t.Fatal(err)
+	}
+	end := genesis.Add(40 * Day)
+	for _, apy := range Trailing(samples, end) {
+		if !apy.OK() || math.Abs(apy.Value-0.05) > 1e-6 {
+			t.Errorf("%s APY = %v (%s)", apy.Window, apy.Value, apy.Reason)
+		}
+	}
+
+	// Drop the samples around the 30d window start: 30d becomes unavailable
+	// while the shorter windows are unaffected.
+	var gappy []Sample
+	for _, smp := range samples {
+		if d := end.Add(-30 * Day).Sub(smp.Time); d > -9*Day && d < 9*Day {
+			continue
+		}
+		gappy = append(gappy, smp)
+	}
+	apys := Trailing(gappy, end)
+	if !apys[0].OK() || !apys[1].OK() || apys[2].OK() {
+		t.Fatalf("gappy trailing = %+v", apys)
+	}
+	if apy := TrailingAPY(samples, Day, end.Add(10*Day), DefaultTolerance); apy.OK() {
+		t.Fatalf("stale end accepted: %+v", apy)
+	}
+}
@@ -0,0 +1,155 @@
+package ppshistory
+
+import (
+	"context"
+	"fmt"
+	"math/big"
+	"sort"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+)
+
+// Oracle is the part of yieldoracle.YieldSourceOracle a Sampler uses.
+type Oracle interface {
+	Address() common.Address
+	PricePerShare(opts *bind.CallOpts, yieldSource common.Address) (*big.Int, error)
+}
+
+// HeaderReader resolves block timestamps; ethclient.Client implements it.
+type HeaderReader interface {
+	HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error)
+}
+
+// Report summarises one sampling run.
+type Report struct {
+	// Added counts new samples; Existing counts blocks already stored.
+	Added    int
+	Existing int
+	// Failed holds the blocks whose PPS call failed, e.g. because the yield
+	// source was not deployed yet. They are not stored and are retried on
+	// the next run.
+	Failed map[uint64]error
+}
+
+// Sampler fills the store with an oracle's PPS for one chain.
+type Sampler struct {
+	ChainID uint64
+	Oracle  Oracle
+	Headers HeaderReader
+	Store   *Store
+
+	times map[uint64]time.Time
+}
+
+// Key returns the series key for yieldSource.
+func (s *Sampler) Key(yieldSource common.Address) SeriesKey {
+	return SeriesKey{ChainID: s.ChainID, Oracle: s.Oracle.Address(), YieldSource: yieldSource}
+}
+
+// SampleBlocks stores the PPS of yieldSource at each block that is not
+// stored yet.
+func (s *Sampler) SampleBlocks(ctx context.Context, yieldSource common.Address, blocks []uint64) (*Report, error) {
+	key := s.Key(yieldSource)
+	rep := &Report{Failed: make(map[uint64]error)}
+	var batch []Sample
+	for _, block := range blocks {
+		ok, err := s.Store.Has(key, block)
+		if err != nil {
+			return rep, err
+		}
+		if ok {
+			rep.Existing++
+			continue
+		}
+		ts, err := s.blockTime(ctx, block)
+		if err != nil {
+			return rep, err
+		}
+		pps, err := s.Oracle.PricePerShare(&bind.CallOpts{Context: ctx, BlockNumber: new(big.Int).SetUint64(block)}, yieldSource)
+		if err != nil {
+			if ctx.Err() != nil {
+				return rep, ctx.Err()
+			}
+			rep.Failed[block] = err
+			continue
+		}
+		batch = append(batch, Sample{Block: block, Time: ts, PPS: pps})
+	}
+	if err := s.Store.Put(key, batch...); err != nil {
+		return rep, err
+	}
+	rep.Added = len(batch)
+	return rep, nil
+}
+
+// SampleEvery samples yieldSource at the last block at or before each step
+// from from to to inclusive.
+func (s *Sampler) SampleEvery(ctx context.Context, yieldSource common.Address, from, to time.Time, step time.Duration) (*Report, error) {
+	if step <= 0 {
+		return nil, fmt.Errorf("ppshistory: step must be positive, got %s", step)
+	}
+	seen := make(map[uint64]bool)
+	var blocks []uint64
+	for t := from; !t.After(to); t = t.Add(step) {
+		block, err := s.BlockAt(ctx, t)
+		if err != nil {
+			return nil, err
+		}
+		if !seen[block] {
+			seen[block] = true
+			blocks = append(blocks, block)
+		}
+	}
+	return s.SampleBlocks(ctx, yieldSource, blocks)
+}
+
+// BlockAt returns the last block whose timestamp is at or before t, or block
+// 0 when t precedes the chain.
+func (s *Sampler) BlockAt(ctx context.Context, t time.Time) (uint64, error) {
+	head, err := s.Headers.HeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, fmt.Errorf("ppshistory: head: %w", err)
+	}
+	if head.Time <= uint64(t.Unix()) {
+		return head.Number.Uint64(), nil
+	}
+	var searchErr error
+	// Find the first block after t; the block before it is the answer.
+	n := sort.Search(int(head.Number.Uint64()), func(i int) bool {
+		if searchErr != nil {
+			return true
+		}
+		ts, err := s.blockTime(ctx, uint64(i))
+		if err != nil {
+			searchErr = err
+			return true
+		}
+		return ts.After(t)
+	})
+	if searchErr != nil {
+		return 0, searchErr
+	}
+	if n == 0 {
+		return 0, nil
+	}
+	return uint64(n - 1), nil
+}
+
+func (s *Sampler) blockTime(ctx context.Context, block uint64) (time.Time, error) {
+	if ts, ok := s.times[block]; ok {
+		return ts, nil
+	}
+	h, err := s.Headers.HeaderByNumber(ctx, new(big.Int).SetUint64(block))
+	if err != nil {
+		return time.Time{}, fmt.Errorf("ppshistory: header %d: %w", block, err)
+	}
+	ts := time.Unix(int64(h.Time), 0).UTC()
+	if s.times == nil {
+		s.times = make(map[uint64]time.Time)
+	}
+	s.times[block] = ts
+	return ts, nil
+}
@@ -0,0 +1,174 @@
+// Package ppshistory samples yield source price-per-share at historical
+// blocks, keeps the series in a local bbolt file and derives trailing APYs
+// from it.
+//
+// Samples come from IYieldSourceOracle.getPricePerShare evaluated at a past
+// block through bind.CallOpts.BlockNumber, so every supported vault standard
+// is handled by the oracle that already prices it for accounting. A sample
+// is immutable once stored; re-running a Sampler only fills blocks that are
+// missing, which keeps the numbers reproducible.
+package ppshistory
+
+import (
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	bolt "go.etcd.io/bbolt"
+)
+
+// Sample is the price per share of a yield source at one block.
+type Sample struct {
+	Block uint64    `json:"block"`
+	Time  time.Time `json:"time"`
+	PPS   *big.Int  `json:"pps"`
+}
+
+// SeriesKey identifies one PPS series.
+type SeriesKey struct {
+	ChainID     uint64
+	Oracle      common.Address
+	YieldSource common.Address
+}
+
+func (k SeriesKey) String() string {
+	return fmt.Sprintf("%d/%s/%s", k.ChainID, k.Oracle.Hex(), k.YieldSource.Hex())
+}
+
+func (k SeriesKey) bucket() []byte {
+	b := make([]byte, 8+2*common.AddressLength)
+	binary.BigEndian.PutUint64(b, k.ChainID)
+	copy(b[8:], k.Oracle[:])
+	copy(b[8+common.AddressLength:], k.YieldSource[:])
+	return b
+}
+
+var rootBucket = []byte("pps")
+
+// Store is a bbolt-backed set of PPS series. Within a series samples are
+// keyed and ordered by block number.
+type Store struct {
+	db *bolt.DB
+}
+
+// OpenStore opens or creates the store at path.
+func OpenStore(path string) (*Store, error) {
+	db, err := bolt.Open(path, 0o600, &bolt.Options{Timeout: time.Second})
+	if err != nil {
+		return nil, fmt.Errorf("ppshistory: open %s: %w", path, err)
+	}
+	if err := db.Update(func(tx *bolt.Tx) error {
+		_, err := tx.CreateBucketIfNotExists(rootBucket)
+		return err
+	}); err != nil {
+		db.Close()
+		return nil, fmt.Errorf("ppshistory: init %s: %w", path, err)
+	}
+	return &Store{db: db}, nil
+}
+
+// Close closes the underlying file.
+func (s *Store) Close() error {
+	return s.db.Close()
+}
+
+// Put stores samples in the series for key. A sample for a block already in
+// the series is kept as stored.
+func (s *Store) Put(key SeriesKey, samples ...Sample) error {
+	return s.db.Update(func(tx *bolt.Tx) error {
+		b, err := tx.Bucket(rootBucket).CreateBucketIfNotExists(key.bucket())
+		if err != nil {
+			return err
+		}
+		for _, smp := range samples {
+			if smp.PPS == nil || smp.PPS.Sign() < 0 {
+				return fmt.Errorf("ppshistory: %s block %d: invalid pps", key, smp.Block)
+			}
+			k := blockKey(smp.Block)
+			if b.Get(k) != nil {
+				continue
+			}
+			v := make([]byte, 8, 8+32)
+			binary.BigEndian.PutUint64(v, uint64(smp.Time.Unix()))
+			if err := b.Put(k, append(v, smp.PPS.Bytes()...)); err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+}
+
+// Has reports whether the series for key has a sample at block.
+func (s *Store) Has(key SeriesKey, block uint64) (bool, error) {
+	var ok bool
+	err := s.db.View(func(tx *bolt.Tx) error {
+		if b := tx.Bucket(rootBucket).Bucket(key.bucket()); b != nil {
+			ok = b.Get(blockKey(block)) != nil
+		}
+		return nil
+	})
+	return ok, err
+}
+
+// Samples returns the samples for key between fromBlock and toBlock
+// inclusive, in block order. toBlock 0 means no upper bound.
+func (s *Store) Samples(key SeriesKey, fromBlock, toBlock uint64) ([]Sample, error) {
+	var out []Sample
+	err := s.db.View(func(tx *bolt.Tx) error {
+		b := tx.Bucket(rootBucket).Bucket(key.bucket())
+		if b == nil {
+			return nil
+		}
+		c := b.Cursor()
+		for k, v := c.Seek(blockKey(fromBlock)); k != nil; k, v = c.Next() {
+			block := binary.BigEndian.Uint64(k)
+			if toBlock != 0 && block > toBlock {
+				break
+			}
+			smp, err := decodeSample(block, v)
+			if err != nil {
+				return fmt.Errorf("ppshistory: %s: %w", key, err)
+			}
+			out = append(out, smp)
+		}
+		return nil
+	})
+	return out, err
+}
+
+// Series returns the keys of every stored series.
+func (s *Store) Series() ([]SeriesKey, error) {
+	var out []SeriesKey
+	err := s.db.View(func(tx *bolt.Tx) error {
+		return tx.Bucket(rootBucket).ForEachBucket(func(k []byte) error {
+			if len(k) != 8+2*common.AddressLength {
+				return nil
+			}
+			out = append(out, SeriesKey{
+				ChainID:     binary.BigEndian.Uint64(k),
+				Oracle:      common.BytesToAddress(k[8 : 8+common.AddressLength]),
+				YieldSource: common.BytesToAddress(k[8+common.AddressLength:]),
+			})
+			return nil
+		})
+	})
+	return out, err
+}
+
+func blockKey(block uint64) []byte {
+	return binary.BigEndian.AppendUint64(nil, block)
+}
+
+func decodeSample(block uint64, v []byte) (Sample, error) {
+	if len(v) < 8 {
+		return Sample{}, errors.New("corrupt sample")
+	}
+	return Sample{
+		Block: block,
+		Time:  time.Unix(int64(binary.BigEndian.Uint64(v)), 0).UTC(),
+		PPS:   new(big.Int).SetBytes(v[8:]),
+	}, nil
+}
@@ -0,0 +1,156 @@
+package preview
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/common/math"
+)
+
+// Flag marks a call worth a second look before signing.
+type Flag string
+
+const (
+	// FlagUnknownSpender: an approval to a spender that is neither a
+	// contract of the chain nor in Previewer.Spenders.
+	FlagUnknownSpender Flag = "unknown_spender"
+	// FlagUnlimitedAllowance: an approval of the maximum value of its type.
+	FlagUnlimitedAllowance Flag = "unlimited_allowance"
+)
+
+// Arg is a decoded call argument.
+type Arg struct {
+	Name  string `json:"name"`
+	Type  string `json:"type"`
+	Value string `json:"value"`
+}
+
+// Call is one execution built by a hook.
+type Call struct {
+	Target common.Address `json:"target"`
+	// TargetName is the chain contract name of Target, if any.
+	TargetName string        `json:"targetName,omitempty"`
+	Value      *big.Int      `json:"value"`
+	Data       hexutil.Bytes `json:"data"`
+	// Selector is empty for plain value transfers.
+	Selector hexutil.Bytes `json:"selector,omitempty"`
+	// Method is the signature of a known selector; Args are empty otherwise.
+	Method string `json:"method,omitempty"`
+	Args   []Arg  `json:"args,omitempty"`
+	Flags  []Flag `json:"flags,omitempty"`
+}
+
+// knownABI holds the calls hooks commonly build: ERC20, WETH, Permit2,
+// ERC4626 and ERC7540.
+var knownABI, _ = abi.JSON(strings.NewReader(`[
+	{"type":"function","name":"approve","inputs":[{"name":"spender","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"increaseAllowance","inputs":[{"name":"spender","type":"address"},{"name":"addedValue","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transfer","inputs":[{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"transferFrom","inputs":[{"name":"from","type":"address"},{"name":"to","type":"address"},{"name":"amount","type":"uint256"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"deposit","inputs":[],"outputs":[]},
+	{"type":"function","name":"withdraw","inputs":[{"name":"amount","type":"uint256"}],"outputs":[]},
+	{"type":"function","name":"approve","inputs":[{"name":"token","type":"address"},{"name":"spender","type":"address"},{"name":"amount","type":"uint160"},{"name":"expiration","type":"uint48"}],"outputs":[]},
+	{"type":"function","name":"deposit","inputs":[{"name":"assets","type":"uint256"},{"name":"receiver","type":"address"}],"outputs":[{"name":"shares","type":"uint256"}]},
+	{"type":"function","name":"mint","inputs":[{"name":"shares","type":"uint256"},{"name":"receiver","type":"address"}],"outputs":[{"name":"assets","type":"uint256"}]},
+	{"type":"function","name":"withdraw","inputs":[{"name":"assets","type":"uint256"},{"name":"receiver","type":"address"},{"name":"owner","type":"address"}],"outputs":[{"name":"shares","type":"uint256"}]},
+	{"type":"function","name":"redeem","inputs":[{"name":"shares","type":"uint256"},{"name":"receiver","type":"address"},{"name":"owner","type":"address"}],"outputs":[{"name":"assets","type":"uint256"}]},
+	{"type":"function","name":"deposit","inputs":[{"name":"assets","type":"uint256"},{"name":"receiver","type":"address"},{"name":"controller","type":"address"}],"outputs":[{"name":"shares","type":"uint256"}]},
+	{"type":"function","name":"mint","inputs":[{"name":"shares","type":"uint256"},{"name":"receiver","type":"address"},{"name":"controller","type":"address"}],"outputs":[{"name":"assets","type":"uint256"}]},
+	{"type":"function","name":"requestDeposit","inputs":[{"name":"assets","type":"uint256"},{"name":"controller","type":"address"},{"name":"owner","type":"address"}],"outputs":[{"name":"requestId","type":"uint256"}]},
+	{"type":"function","name":"requestRedeem","inputs":[{"name":"shares","type":"uint256"},{"name":"controller","type":"address"},{"name":"owner","type":"address"}],"outputs":[{"name":"requestId","type":"uint256"}]},
+	{"type":"function","name":"setOperator","inputs":[{"name":"operator","type":"address"},{"name":"approved","type":"bool"}],"outputs":[{"name":"","type":"bool"}]},
+	{"type":"function","name":"cancelDepositRequest","inputs":[{"name":"requestId","type":"uint256"},{"name":"controller","type":"address"}],"outputs":[]},
+	{"type":"function","name":"cancelRedeemRequest","inputs":[{"name":"requestId","type":"uint256"},{"name":"controller","type":"address"}],"outputs":[]},
+	{"type":"function","name":"claimCancelDepositRequest","inputs":[{"name":"requestId","type":"uint256"},{"name":"receiver","type":"address"},{"name":"controller","type":"address"}],"outputs":[{"name":"assets","type":"uint256"}]},
+	{"type":"function","name":"claimCancelRedeemRequest","inputs":[{"name":"requestId","type":"uint256"},{"name":"receiver","type":"address"},{"name":"controller","type":"address"}],"outputs":[{"name":"shares","type":"uint256"}]}
+]`))
+
+// Approval selectors and the maximum allowance of each.
+var (
+	erc20Approve      = [4]byte{0x09, 0x5e, 0xa7, 0xb3}
+	increaseAllowance = [4]byte{0x39, 0x50, 0x93, 0x51}
+	permit2Approve    = [4]byte{0x87, 0x51, 0x7c, 0x45}
+
+	maxUint160 = new(big.Int).Sub(new(big.Int).Lsh(big.NewInt(1), 160), big.NewInt(1))
+)
+
+// decode fills in the method, arguments and flags of c.
+func (p *Previewer) decode(c *Call) {
+	c.TargetName = p.name(c.Target)
+	if len(c.Data) < 4 {
+		return
+	}
+	c.Selector = c.Data[:4]
+	method := p.method(c.Data[:4])
+	if method == nil {
+		return
+	}
+	vals, err := method.Inputs.Unpack(c.Data[4:])
+	if err != nil {
+		return
+	}
+	c.Method = method.Sig
+	for i, in := range method.Inputs {
+		c.Args = append(c.Args, Arg{Name: in.Name, Type: in.Type.String(), Value: p.format(vals[i])})
+	}
+
+	var (
+		spender common.Address
+		amount  *big.Int
+		max     *big.Int
+	)
+	switch [4]byte(c.Selector) {
+	case erc20Approve, increaseAllowance:
+		spender, amount, max = vals[0].(common.Address), vals[1].(*big.Int), math.MaxBig256
+	case permit2Approve:
+		spender, amount, max = vals[1].(common.Address), vals[2].(*big.Int), maxUint160
+	default:
+		return
+	}
+	if !p.knownSpender(spender) {
+		c.Flags = append(c.Flags, FlagUnknownSpender)
+	}
+	if amount.Cmp(max) == 0 {
+		c.Flags = append(c.Flags, FlagUnlimitedAllowance)
+	}
+}
+
+// method returns the method with selector from the previewer's ABIs, the
+// hook interface and knownABI, in that order.
+func (p *Previewer) method(selector []byte) *abi.Method {
+	for _, a := range p.abis() {
+		if m, err := a.MethodById(selector); err == nil {
+			return m
+		}
+	}
+	return nil
+}
+
+func (p *Previewer) knownSpender(a common.Address) bool {
+	if _, ok := p.Spenders[a]; ok {
+		return true
+	}
+	return p.name(a) != ""
+}
+
+// format renders a decoded value, naming known addresses.
+func (p *Previewer) format(v any) string {
+	switch v := v.(type) {
+	case common.Address:
+		if name := p.name(v); name != "" {
+			return fmt.Sprintf("%s (%s)", v.Hex(), name)
+		}
+		return v.Hex()
+	case []byte:
+		return hexutil.Encode(v)
+	case [32]byte:
+		return hexutil.Encode(v[:])
+	case *big.Int:
+		return v.String()
+	}
+	return fmt.Sprint(v)
+}
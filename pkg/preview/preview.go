@@ -0,0 +1,291 @@
+// Package preview shows the calls an ExecutorEntry will make before it is
+// signed. Each hook's build is called through eth_call against live or
+// simulated state, in order, the way SuperExecutor calls it.
+//
+// Hooks that take their amount from the previous hook read it in build with
+// ISuperHookResult(prevHook).getOutAmount(account), which is only set once
+// the previous hook has run. The previewer instead overrides the previous
+// hook's code, for that one call, with a stub answering the previous step's
+// estimated out amount.
+package preview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+	"strings"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+	"github.com/superform-xyz/v2-core/pkg/userop"
+)
+
+// Previewer previews executor entries on one chain.
+type Previewer struct {
+	// Client serves the plain reads: decodeUsePrevHookAmount and
+	// decodeAmount.
+	Client bind.ContractCaller
+	// Calls serves build, with the previous hook overridden.
+	Calls userop.OverrideCaller
+	// Chain names hooks and call targets; its contracts are trusted
+	// approval spenders. Optional.
+	Chain *addressbook.Chain
+	// Spenders are further trusted approval spenders, e.g. the vaults the
+	// entry deposits into, with a label each.
+	Spenders map[common.Address]string
+	// ABIs decode calls beyond the built-in ERC20, WETH, Permit2, ERC4626,
+	// ERC7540 and hook methods; they take precedence.
+	ABIs []*abi.ABI
+	// OutAmount estimates the amount a step hands to the next hook. Nil
+	// assumes the step passes its input amount on unchanged.
+	OutAmount func(s *Step) *big.Int
+	// Block pins the preview; nil for latest.
+	Block *big.Int
+
+	names map[common.Address]string
+}
+
+// Step is the preview of one hook of the entry.
+type Step struct {
+	Index int            `json:"index"`
+	Hook  common.Address `json:"hook"`
+	// Name is the hook's chain contract name, if known.
+	Name string        `json:"name,omitempty"`
+	Data hexutil.Bytes `json:"data"`
+	// Decoded is the typed hook data, for known hooks.
+	Decoded           hooks.HookData `json:"decoded,omitempty"`
+	UsePrevHookAmount bool           `json:"usePrevHookAmount"`
+	// Amount is the input amount: the previous step's out amount when
+	// chaining, else the amount in Data. Nil when the hook has none.
+	Amount *big.Int `json:"amount,omitempty"`
+	// OutAmount is the estimate handed to a chaining next step.
+	OutAmount *big.Int `json:"outAmount,omitempty"`
+	// Calls are the executions build returned, preExecute and postExecute
+	// included.
+	Calls []Call `json:"calls,omitempty"`
+	// Error is set when build reverted or could not be called.
+	Error string `json:"error,omitempty"`
+}
+
+// Preview is the outcome of Previewer.Preview.
+type Preview struct {
+	Account common.Address `json:"account"`
+	Steps   []Step         `json:"steps"`
+}
+
+// Flagged returns the calls carrying flags, across all steps.
+func (p *Preview) Flagged() []Call {
+	var out []Call
+	for _, s := range p.Steps {
+		for _, c := range s.Calls {
+			if len(c.Flags) > 0 {
+				out = append(out, c)
+			}
+		}
+	}
+	return out
+}
+
+// Preview builds every hook of entry for account. Reverts are reported on
+// their step, and later steps still build when they do not chain from it;
+// only RPC failures are returned as errors.
+func (p *Previewer) Preview(ctx context.Context, account common.Address, entry *executor.ExecutorEntry) (*Preview, error) {
+	if err := entry.Validate(); err != nil {
+		return nil, err
+	}
+	out := &Preview{Account: account}
+	var prev *Step
+	for i, hook := range entry.HooksAddresses {
+		s := Step{Index: i, Hook: hook, Name: p.name(hook), Data: entry.HooksData[i]}
+		if err := p.step(ctx, account, prev, &s); err != nil {
+			return nil, fmt.Errorf("preview: step %d: %w", i, err)
+		}
+		out.Steps = append(out.Steps, s)
+		prev = &out.Steps[len(out.Steps)-1]
+	}
+	return out, nil
+}
+
+func (p *Previewer) step(ctx context.Context, account common.Address, prev *Step, s *Step) error {
+	if spec, ok := hooks.Lookup(s.Name); ok {
+		if d, err := spec.Decode(s.Data); err == nil {
+			s.Decoded = d
+		}
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: p.Block}
+	h := hooks.NewHook(s.Hook, p.Client)
+
+	usePrev, err := h.DecodeUsePrevHookAmount(opts, s.Data)
+	if err != nil && !errors.Is(err, hooks.ErrUnsupported) {
+		if reverts.IsRevert(err) {
+			s.Error = err.Error()
+			return nil
+		}
+		return err
+	}
+	s.UsePrevHookAmount = usePrev
+
+	var prevHook common.Address
+	overrides := map[common.Address]gethclient.OverrideAccount{}
+	switch {
+	case usePrev && prev == nil:
+		s.Error = "first hook uses the previous hook amount"
+		return nil
+	case usePrev && prev.OutAmount == nil:
+		s.Error = "previous hook out amount unknown"
+		return nil
+	case usePrev:
+		s.Amount = prev.OutAmount
+		// Overriding the hook being built would replace its own code.
+		prevHook = prev.Hook
+		if prevHook == s.Hook {
+			prevHook = stubAddress
+		}
+		overrides[prevHook] = gethclient.OverrideAccount{Code: outAmountStub(prev.OutAmount)}
+	default:
+		if prev != nil {
+			prevHook = prev.Hook
+		}
+		amount, err := h.DecodeAmount(opts, s.Data)
+		switch {
+		case err == nil:
+			s.Amount = amount
+		case errors.Is(err, hooks.ErrUnsupported):
+			s.Amount = dataAmount(s.Decoded)
+		case !reverts.IsRevert(err):
+			return err
+		}
+	}
+
+	data, err := hooks.ABI.Pack("build", prevHook, account, []byte(s.Data))
+	if err != nil {
+		return err
+	}
+	ret, err := p.Calls.CallContract(ctx, ethereum.CallMsg{From: account, To: &s.Hook, Data: data}, p.Block, &overrides)
+	if err != nil {
+		if reverts.IsRevert(err) {
+			s.Error = fmt.Sprintf("build: %v", reverts.Wrap(err))
+			return nil
+		}
+		return err
+	}
+	vals, err := hooks.ABI.Unpack("build", ret)
+	if err != nil {
+		s.Error = fmt.Sprintf("build: %v", err)
+		return nil
+	}
+	for _, e := range *abi.ConvertType(vals[0], new([]hooks.Execution)).(*[]hooks.Execution) {
+		c := Call{Target: e.Target, Value: e.Value, Data: e.CallData}
+		p.decode(&c)
+		s.Calls = append(s.Calls, c)
+	}
+
+	s.OutAmount = s.Amount
+	if p.OutAmount != nil {
+		s.OutAmount = p.OutAmount(s)
+	}
+	return nil
+}
+
+// stubAddress stands in for the previous hook when it is the hook being
+// built.
+var stubAddress = common.BytesToAddress(crypto.Keccak256([]byte("superform.preview.prevHook")))
+
+// outAmountStub returns runtime code answering amount to any call, which
+// covers the getOutAmount(account) hooks make on prevHook in build.
+func outAmountStub(amount *big.Int) []byte {
+	code := []byte{0x7f} // PUSH32 amount
+	code = append(code, common.LeftPadBytes(amount.Bytes(), 32)...)
+	return append(code,
+		0x5f,       // PUSH0
+		0x52,       // MSTORE
+		0x60, 0x20, // PUSH1 32
+		0x5f, // PUSH0
+		0xf3, // RETURN
+	)
+}
+
+// dataAmount returns the amount, else share count, in typed hook data, for
+// hooks without decodeAmount such as ApproveERC20Hook.
+func dataAmount(d hooks.HookData) *big.Int {
+	if a, ok := d.(hooks.Amounted); ok {
+		return a.DataAmount()
+	}
+	return nil
+}
+
+func (p *Previewer) name(a common.Address) string {
+	if p.names == nil {
+		p.names = make(map[common.Address]string)
+		if p.Chain != nil {
+			for name, addr := range p.Chain.Contracts {
+				p.names[addr] = name
+			}
+		}
+	}
+	return p.names[a]
+}
+
+func (p *Previewer) abis() []*abi.ABI {
+	return append(append(append([]*abi.ABI{}, p.ABIs...), &hooks.ABI), &knownABI)
+}
+
+// Render writes a human-readable listing of the preview.
+func (p *Preview) Render(w io.Writer) error {
+	var b strings.Builder
+	fmt.Fprintf(&b, "account %s\n", p.Account.Hex())
+	for _, s := range p.Steps {
+		name := s.Name
+		if name == "" {
+			name = "unknown hook"
+		}
+		fmt.Fprintf(&b, "#%d %s %s", s.Index, name, s.Hook.Hex())
+		if s.Amount != nil {
+			fmt.Fprintf(&b, " amount=%s", s.Amount)
+		}
+		if s.UsePrevHookAmount {
+			b.WriteString(" (previous hook amount)")
+		}
+		b.WriteString("\n")
+		if s.Error != "" {
+			fmt.Fprintf(&b, "   error: %s\n", s.Error)
+		}
+		for _, c := range s.Calls {
+			target := c.Target.Hex()
+			if c.TargetName != "" {
+				target += " (" + c.TargetName + ")"
+			}
+			fmt.Fprintf(&b, "   -> %s value=%s ", target, c.Value)
+			switch {
+			case c.Method != "":
+				args := make([]string, len(c.Args))
+				for i, a := range c.Args {
+					args[i] = a.Name + "=" + a.Value
+				}
+				fmt.Fprintf(&b, "%s [%s]", c.Method, strings.Join(args, ", "))
+			case len(c.Selector) > 0:
+				fmt.Fprintf(&b, "%s %s", c.Selector, c.Data)
+			default:
+				b.WriteString("(no calldata)")
+			}
+			for _, f := range c.Flags {
+				fmt.Fprintf(&b, " !%s", f)
+			}
+			b.WriteString("\n")
+		}
+	}
+	_, err := io.WriteString(w, b.String())
+	return err
+}
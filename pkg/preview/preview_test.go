@@ -0,0 +1,153 @@
+package preview
+
+import (
+	"bytes"
+	"context"
+	"math/big"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/math"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/ApproveERC20Hook"
+	"github.com/superform-xyz/v2-core/contract_bindings/Deposit4626VaultHook"
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/executor"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/hooks"
+)
+
+var (
+	account  = common.HexToAddress("0x00000000000000000000000000000000000a11ce")
+	token    = common.HexToAddress("0x0000000000000000000000000000000000007041")
+	vault    = common.HexToAddress("0x000000000000000000000000000000000000ba17")
+	stranger = common.HexToAddress("0x000000000000000000000000000000000000bad0")
+)
+
+func TestPreview(t *testing.T) {
+	s, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	auth, client := s.Auth, s.Client
+
+	approveHook, _, _, err := ApproveERC20Hook.DeployApproveERC20Hook(auth, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	depositHook, _, _, err := Deposit4626VaultHook.DeployDeposit4626VaultHook(auth, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Backend.Commit()
+
+	p := &Previewer{
+		Client: client,
+		Calls:  s.Geth,
+		Chain: &addressbook.Chain{Name: "sim", ChainID: 1337, Contracts: map[string]common.Address{
+			"ApproveERC20Hook":     approveHook,
+			"Deposit4626VaultHook": depositHook,
+		}},
+		Spenders: map[common.Address]string{vault: "vault"},
+	}
+	encode := func(d interface{ Encode() ([]byte, error) }) []byte {
+		data, err := d.Encode()
+		if err != nil {
+			t.Fatal(err)
+		}
+		return data
+	}
+	header := hooks.Header{YieldSource: vault}
+
+	entry := executor.NewExecutorEntry().
+		Append(approveHook, encode(&hooks.ApproveERC20{Token: token, Spender: vault, Amount: big.NewInt(100)})).
+		Append(depositHook, encode(&hooks.Deposit4626Vault{Header: header, Amount: big.NewInt(1), UsePrevHookAmount: true})).
+		Append(approveHook, encode(&hooks.ApproveERC20{Token: token, Spender: stranger, Amount: math.MaxBig256})).
+		Append(depositHook, encode(&hooks.Deposit4626Vault{Header: header, Amount: big.NewInt(5), UsePrevHookAmount: true}))
+	out, err := p.Preview(context.Background(), account, entry)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(out.Steps) != 4 {
+		t.Fatalf("steps = %d", len(out.Steps))
+	}
+	for _, s := range out.Steps {
+		if s.Error != "" {
+			t.Fatalf("step %d: %s", s.Index, s.Error)
+		}
+		// preExecute, the hook's executions, postExecute.
+		if first, last := s.Calls[0], s.Calls[len(s.Calls)-1]; first.Target != s.Hook || last.Target != s.Hook ||
+			!strings.HasPrefix(first.Method, "preExecute") || !strings.HasPrefix(last.Method, "postExecute") {
+			t.Fatalf("step %d calls = %+v", s.Index, s.Calls)
+		}
+	}
+
+	// The chained deposit builds with the approved amount, not its own.
+	deposit := out.Steps[1]
+	if !deposit.UsePrevHookAmount || deposit.Amount.Int64() != 100 || deposit.Name != "Deposit4626VaultHook" {
+		t.Fatalf("deposit step = %+v", deposit)
+	}
+	if _, ok := deposit.Decoded.(*hooks.Deposit4626Vault); !ok {
+		t.Fatalf("decoded = %T", deposit.Decoded)
+	}
+	call := deposit.Calls[1]
+	if call.Target != vault || call.Method != "deposit(uint256,address)" ||
+		call.Args[0].Value != "100" || call.Args[1].Value != account.Hex() {
+		t.Fatalf("deposit call = %+v", call)
+	}
+	if out.Steps[3].Calls[1].Args[0].Value != math.MaxBig256.String() {
+		t.Fatalf("second deposit = %+v", out.Steps[3].Calls[1])
+	}
+
+	// Approvals to the known vault pass; the unlimited one to a stranger
+	// carries both flags, its reset to zero only the spender flag.
+	if len(out.Steps[0].Calls[2].Flags) != 0 {
+		t.Fatalf("vault approval flags = %v", out.Steps[0].Calls[2].Flags)
+	}
+	flagged := out.Flagged()
+	if len(flagged) != 2 || !slices.Equal(flagged[0].Flags, []Flag{FlagUnknownSpender}) ||
+		!slices.Equal(flagged[1].Flags, []Flag{FlagUnknownSpender, FlagUnlimitedAllowance}) {
+		t.Fatalf("flagged = %+v", flagged)
+	}
+
+	var buf bytes.Buffer
+	if err := out.Render(&buf); err != nil {
+		t.Fatal(err)
+	}
+	for _, want := range []string{"#1 Deposit4626VaultHook", "amount=100 (previous hook amount)", "!unlimited_allowance"} {
+		if !strings.Contains(buf.String(), want) {
+			t.Fatalf("render missing %q:\n%s", want, buf.String())
+		}
+	}
+}
+
+func TestPreviewFirstHookChains(t *testing.T) {
+	s, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer s.Close()
+	auth, client := s.Auth, s.Client
+
+	depositHook, _, _, err := Deposit4626VaultHook.DeployDeposit4626VaultHook(auth, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	s.Backend.Commit()
+
+	data, err := (&hooks.Deposit4626Vault{Header: hooks.Header{YieldSource: vault}, Amount: big.NewInt(1), UsePrevHookAmount: true}).Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &Previewer{Client: client, Calls: s.Geth}
+	out, err := p.Preview(context.Background(), account, executor.NewExecutorEntry().Append(depositHook, data))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if s := out.Steps[0]; s.Error == "" || len(s.Calls) != 0 {
+		t.Fatalf("step = %+v", s)
+	}
+}
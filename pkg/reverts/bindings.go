@@ -0,0 +1,83 @@
+package reverts
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/AbstractYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/AcrossV3Adapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/DebridgeAdapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC4626YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC5115YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC7484RegistryAdapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC7540YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/IOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/IPPYLpOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ISuperYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/IYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/MockSuperOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/MockYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/PendlePTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/RegistryAdapter"
+	"github.com/superform-xyz/v2-core/contract_bindings/SpectraPTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/StakingYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationExecutor"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationExecutorSimulations"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperDestinationValidatorSimulations"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutor"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutorBase"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperExecutorBaseSimulations"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedger"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperNativePaymaster"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperSenderCreator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidator"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidatorBase"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidatorBaseSimulations"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperValidatorSimulations"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperVaultYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperYieldSourceOracle"
+)
+
+//go:generate go run gen_hookerrors.go
+
+// bindings lists the ABI of every package under contract_bindings.
+var bindings = []struct {
+	name string
+	meta *bind.MetaData
+}{
+	{"AbstractYieldSourceOracle", AbstractYieldSourceOracle.AbstractYieldSourceOracleMetaData},
+	{"AcrossV3Adapter", AcrossV3Adapter.AcrossV3AdapterMetaData},
+	{"DebridgeAdapter", DebridgeAdapter.DebridgeAdapterMetaData},
+	{"ERC4626YieldSourceOracle", ERC4626YieldSourceOracle.ERC4626YieldSourceOracleMetaData},
+	{"ERC5115YieldSourceOracle", ERC5115YieldSourceOracle.ERC5115YieldSourceOracleMetaData},
+	{"ERC7484RegistryAdapter", ERC7484RegistryAdapter.ERC7484RegistryAdapterMetaData},
+	{"ERC7540YieldSourceOracle", ERC7540YieldSourceOracle.ERC7540YieldSourceOracleMetaData},
+	{"IOracle", IOracle.IOracleMetaData},
+	{"IPPYLpOracle", IPPYLpOracle.IPPYLpOracleMetaData},
+	{"ISuperYieldSourceOracle", ISuperYieldSourceOracle.ISuperYieldSourceOracleMetaData},
+	{"IYieldSourceOracle", IYieldSourceOracle.IYieldSourceOracleMetaData},
+	{"MockSuperOracle", MockSuperOracle.MockSuperOracleMetaData},
+	{"MockYieldSourceOracle", MockYieldSourceOracle.MockYieldSourceOracleMetaData},
+	{"PendlePTYieldSourceOracle", PendlePTYieldSourceOracle.PendlePTYieldSourceOracleMetaData},
+	{"RegistryAdapter", RegistryAdapter.RegistryAdapterMetaData},
+	{"SpectraPTYieldSourceOracle", SpectraPTYieldSourceOracle.SpectraPTYieldSourceOracleMetaData},
+	{"StakingYieldSourceOracle", StakingYieldSourceOracle.StakingYieldSourceOracleMetaData},
+	{"SuperDestinationExecutor", SuperDestinationExecutor.SuperDestinationExecutorMetaData},
+	{"SuperDestinationExecutorSimulations", SuperDestinationExecutorSimulations.SuperDestinationExecutorSimulationsMetaData},
+	{"SuperDestinationValidator", SuperDestinationValidator.SuperDestinationValidatorMetaData},
+	{"SuperDestinationValidatorSimulations", SuperDestinationValidatorSimulations.SuperDestinationValidatorSimulationsMetaData},
+	{"SuperExecutor", SuperExecutor.SuperExecutorMetaData},
+	{"SuperExecutorBase", SuperExecutorBase.SuperExecutorBaseMetaData},
+	{"SuperExecutorBaseSimulations", SuperExecutorBaseSimulations.SuperExecutorBaseSimulationsMetaData},
+	{"SuperLedger", SuperLedger.SuperLedgerMetaData},
+	{"SuperLedgerConfiguration", SuperLedgerConfiguration.SuperLedgerConfigurationMetaData},
+	{"SuperNativePaymaster", SuperNativePaymaster.SuperNativePaymasterMetaData},
+	{"SuperSenderCreator", SuperSenderCreator.SuperSenderCreatorMetaData},
+	{"SuperValidator", SuperValidator.SuperValidatorMetaData},
+	{"SuperValidatorBase", SuperValidatorBase.SuperValidatorBaseMetaData},
+	{"SuperValidatorBaseSimulations", SuperValidatorBaseSimulations.SuperValidatorBaseSimulationsMetaData},
+	{"SuperValidatorSimulations", SuperValidatorSimulations.SuperValidatorSimulationsMetaData},
+	{"SuperVaultYieldSourceOracle", SuperVaultYieldSourceOracle.SuperVaultYieldSourceOracleMetaData},
+	{"SuperYieldSourceOracle", SuperYieldSourceOracle.SuperYieldSourceOracleMetaData},
+}
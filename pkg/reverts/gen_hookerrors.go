@@ -0,0 +1,120 @@
+//go:build ignore
+
+// gen_hookerrors collects the custom errors declared by every hook artifact
+// under script/ into hookerrors.go, so the package need not import every
+// hook binding for errors most of them share.
+//
+// Run with `go generate ./pkg/reverts` from the repository root.
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"go/format"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+)
+
+var dirs = []string{
+	"../../script/locked-bytecode",
+	"../../script/locked-bytecode-other",
+	"../../script/generated-bytecode",
+}
+
+type input struct {
+	Name         string `json:"name"`
+	Type         string `json:"type"`
+	InternalType string `json:"internalType,omitempty"`
+}
+
+type entry struct {
+	Type   string  `json:"type"`
+	Name   string  `json:"name"`
+	Inputs []input `json:"inputs"`
+}
+
+func (e entry) signature() string {
+	types := make([]string, len(e.Inputs))
+	for i, in := range e.Inputs {
+		types[i] = in.Type
+	}
+	return e.Name + "(" + strings.Join(types, ",") + ")"
+}
+
+func main() {
+	errs := map[string]entry{}
+	sources := map[string]map[string]bool{}
+	for _, dir := range dirs {
+		files, err := filepath.Glob(filepath.Join(dir, "*Hook.json"))
+		if err != nil {
+			log.Fatal(err)
+		}
+		for _, f := range files {
+			raw, err := os.ReadFile(f)
+			if err != nil {
+				log.Fatal(err)
+			}
+			var artifact struct {
+				ABI []entry `json:"abi"`
+			}
+			if err := json.Unmarshal(raw, &artifact); err != nil {
+				log.Fatalf("%s: %v", f, err)
+			}
+			name := strings.TrimSuffix(filepath.Base(f), ".json")
+			for _, e := range artifact.ABI {
+				if e.Type != "error" {
+					continue
+				}
+				sig := e.signature()
+				errs[sig] = e
+				if sources[sig] == nil {
+					sources[sig] = map[string]bool{}
+				}
+				sources[sig][name] = true
+			}
+		}
+	}
+
+	sigs := make([]string, 0, len(errs))
+	for sig := range errs {
+		sigs = append(sigs, sig)
+	}
+	sort.Strings(sigs)
+
+	var buf bytes.Buffer
+	buf.WriteString("// Code generated by gen_hookerrors.go; DO NOT EDIT.\n\npackage reverts\n\n")
+	buf.WriteString("// hookErrorsABI holds the custom errors of every hook artifact.\nconst hookErrorsABI = `[\n")
+	for i, sig := range sigs {
+		b, err := json.Marshal(errs[sig])
+		if err != nil {
+			log.Fatal(err)
+		}
+		sep := ","
+		if i == len(sigs)-1 {
+			sep = ""
+		}
+		fmt.Fprintf(&buf, "%s%s\n", b, sep)
+	}
+	buf.WriteString("]`\n\n// hookErrorSources lists the hooks declaring each error.\nvar hookErrorSources = map[string][]string{\n")
+	for _, sig := range sigs {
+		names := make([]string, 0, len(sources[sig]))
+		for n := range sources[sig] {
+			names = append(names, fmt.Sprintf("%q", n))
+		}
+		sort.Strings(names)
+		fmt.Fprintf(&buf, "%q: {%s},\n", sig, strings.Join(names, ", "))
+	}
+	buf.WriteString("}\n")
+
+	out, err := format.Source(buf.Bytes())
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile("hookerrors.go", out, 0o644); err != nil {
+		log.Fatal(err)
+	}
+}
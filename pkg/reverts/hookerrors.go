@@ -0,0 +1,179 @@
+// Code generated by gen_hookerrors.go; DO NOT EDIT.
+
+package reverts
+
+// hookErrorsABI holds the custom errors of every hook artifact.
+const hookErrorsABI = `[
+{"type":"error","name":"ADDRESS_NOT_VALID","inputs":[]},
+{"type":"error","name":"AMOUNT_IN_NOT_VALID","inputs":[]},
+{"type":"error","name":"AMOUNT_NOT_VALID","inputs":[]},
+{"type":"error","name":"AMOUNT_UNDERFLOW","inputs":[]},
+{"type":"error","name":"ASSET_ZERO_ADDRESS","inputs":[]},
+{"type":"error","name":"CANNOT_SET_OUT_AMOUNT","inputs":[]},
+{"type":"error","name":"CursorOutOfBounds","inputs":[]},
+{"type":"error","name":"DATA_NOT_VALID","inputs":[]},
+{"type":"error","name":"DESTINATION_TOKENS_DIFFER","inputs":[]},
+{"type":"error","name":"EPS_NOT_VALID","inputs":[]},
+{"type":"error","name":"EXCESSIVE_SLIPPAGE_DEVIATION","inputs":[{"name":"actualDeviation","type":"uint256","internalType":"uint256"},{"name":"maxAllowed","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"FEE_NOT_VALID","inputs":[]},
+{"type":"error","name":"HOOK_BALANCE_NOT_CLEARED","inputs":[{"name":"token","type":"address","internalType":"address"},{"name":"remaining","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"INCOMPLETE_HOOK_EXECUTION","inputs":[]},
+{"type":"error","name":"INSUFFICIENT_BALANCE","inputs":[]},
+{"type":"error","name":"INSUFFICIENT_OUTPUT_AMOUNT","inputs":[{"name":"actual","type":"uint256","internalType":"uint256"},{"name":"minimum","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"INVALID_ACTUAL_AMOUNT","inputs":[]},
+{"type":"error","name":"INVALID_ARRAY_LENGTH","inputs":[]},
+{"type":"error","name":"INVALID_ASSET","inputs":[]},
+{"type":"error","name":"INVALID_COMMAND","inputs":[]},
+{"type":"error","name":"INVALID_DATA_LENGTH","inputs":[]},
+{"type":"error","name":"INVALID_DEADLINE","inputs":[]},
+{"type":"error","name":"INVALID_DESTINATION_CALLER","inputs":[]},
+{"type":"error","name":"INVALID_DESTINATION_TOKEN","inputs":[]},
+{"type":"error","name":"INVALID_ENCODING","inputs":[]},
+{"type":"error","name":"INVALID_GUESS_PT_OUT","inputs":[]},
+{"type":"error","name":"INVALID_HOOK_DATA","inputs":[]},
+{"type":"error","name":"INVALID_IBT","inputs":[]},
+{"type":"error","name":"INVALID_INPUT_AMOUNT","inputs":[]},
+{"type":"error","name":"INVALID_LAST_COMMAND","inputs":[]},
+{"type":"error","name":"INVALID_MIN_ASSETS","inputs":[]},
+{"type":"error","name":"INVALID_MIN_SHARES","inputs":[]},
+{"type":"error","name":"INVALID_ORIGINAL_AMOUNTS","inputs":[]},
+{"type":"error","name":"INVALID_OUTPUT_AMOUNT","inputs":[]},
+{"type":"error","name":"INVALID_OUTPUT_DELTA","inputs":[]},
+{"type":"error","name":"INVALID_PREVIOUS_NATIVE_TRANSFER_HOOK_USAGE","inputs":[]},
+{"type":"error","name":"INVALID_PRICE_LIMIT","inputs":[]},
+{"type":"error","name":"INVALID_PT","inputs":[]},
+{"type":"error","name":"INVALID_RECEIVER","inputs":[]},
+{"type":"error","name":"INVALID_RECIPIENT","inputs":[]},
+{"type":"error","name":"INVALID_REMAINING_NATIVE_AMOUNT","inputs":[]},
+{"type":"error","name":"INVALID_REWARD_TOKEN","inputs":[]},
+{"type":"error","name":"INVALID_SELECTOR","inputs":[]},
+{"type":"error","name":"INVALID_SELECTOR_OFFSET","inputs":[]},
+{"type":"error","name":"INVALID_SWAP_TYPE","inputs":[]},
+{"type":"error","name":"INVALID_TOKEN_PAIR","inputs":[]},
+{"type":"error","name":"INVALID_TRANSFER_TOKEN","inputs":[]},
+{"type":"error","name":"InvalidTransferPayloadMagic","inputs":[{"name":"actualMagic","type":"bytes4","internalType":"bytes4"}]},
+{"type":"error","name":"InvalidTransferSpecMagic","inputs":[{"name":"actualMagic","type":"bytes4","internalType":"bytes4"}]},
+{"type":"error","name":"InvalidTransferSpecVersion","inputs":[{"name":"actualVersion","type":"uint32","internalType":"uint32"}]},
+{"type":"error","name":"LENGTH_MISMATCH","inputs":[]},
+{"type":"error","name":"LTV_RATIO_NOT_VALID","inputs":[]},
+{"type":"error","name":"MAKING_AMOUNT_NOT_VALID","inputs":[]},
+{"type":"error","name":"MARKET_NOT_VALID","inputs":[]},
+{"type":"error","name":"MIN_OUT_NOT_VALID","inputs":[]},
+{"type":"error","name":"MIN_TOKEN_OUT_NOT_VALID","inputs":[]},
+{"type":"error","name":"NOT_AUTHORIZED","inputs":[]},
+{"type":"error","name":"ORDER_EXPIRED","inputs":[]},
+{"type":"error","name":"OUTPUT_AMOUNT_DIFFERENT_THAN_TRUE","inputs":[]},
+{"type":"error","name":"PARTIAL_FILL_NOT_ALLOWED","inputs":[]},
+{"type":"error","name":"POST_EXECUTE_ALREADY_CALLED","inputs":[]},
+{"type":"error","name":"PRE_EXECUTE_ALREADY_CALLED","inputs":[]},
+{"type":"error","name":"QUOTE_DEVIATION_EXCEEDS_SAFETY_BOUNDS","inputs":[]},
+{"type":"error","name":"RECEIVER_NOT_VALID","inputs":[]},
+{"type":"error","name":"REWARD_TOKEN_ZERO_ADDRESS","inputs":[]},
+{"type":"error","name":"SafeCastOverflowedIntDowncast","inputs":[{"name":"bits","type":"uint8","internalType":"uint8"},{"name":"value","type":"int256","internalType":"int256"}]},
+{"type":"error","name":"SafeCastOverflowedUintDowncast","inputs":[{"name":"bits","type":"uint8","internalType":"uint8"},{"name":"value","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TOKEN_ADDRESS_INVALID","inputs":[]},
+{"type":"error","name":"TOKEN_OUT_NOT_VALID","inputs":[]},
+{"type":"error","name":"TransferPayloadDataTooShort","inputs":[{"name":"expectedMinimumLength","type":"uint256","internalType":"uint256"},{"name":"actualLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferPayloadHeaderTooShort","inputs":[{"name":"expectedMinimumLength","type":"uint256","internalType":"uint256"},{"name":"actualLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferPayloadOverallLengthMismatch","inputs":[{"name":"expectedTotalLength","type":"uint256","internalType":"uint256"},{"name":"actualTotalLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferPayloadSetElementHeaderTooShort","inputs":[{"name":"index","type":"uint32","internalType":"uint32"},{"name":"actualSetLength","type":"uint256","internalType":"uint256"},{"name":"requiredOffset","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferPayloadSetElementTooShort","inputs":[{"name":"index","type":"uint32","internalType":"uint32"},{"name":"actualSetLength","type":"uint256","internalType":"uint256"},{"name":"requiredOffset","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferPayloadSetHeaderTooShort","inputs":[{"name":"expectedMinimumLength","type":"uint256","internalType":"uint256"},{"name":"actualLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferPayloadSetInvalidElementMagic","inputs":[{"name":"index","type":"uint32","internalType":"uint32"},{"name":"actualMagic","type":"bytes4","internalType":"bytes4"}]},
+{"type":"error","name":"TransferPayloadSetOverallLengthMismatch","inputs":[{"name":"expectedTotalLength","type":"uint256","internalType":"uint256"},{"name":"actualTotalLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferSpecHeaderTooShort","inputs":[{"name":"expectedMinimumLength","type":"uint256","internalType":"uint256"},{"name":"actualLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"TransferSpecOverallLengthMismatch","inputs":[{"name":"expectedTotalLength","type":"uint256","internalType":"uint256"},{"name":"actualTotalLength","type":"uint256","internalType":"uint256"}]},
+{"type":"error","name":"UNAUTHORIZED_CALLBACK","inputs":[]},
+{"type":"error","name":"UNAUTHORIZED_CALLER","inputs":[]},
+{"type":"error","name":"YT_NOT_VALID","inputs":[]},
+{"type":"error","name":"ZERO_ADDRESS","inputs":[]},
+{"type":"error","name":"ZERO_LIQUIDITY","inputs":[]}
+]`
+
+// hookErrorSources lists the hooks declaring each error.
+var hookErrorSources = map[string][]string{
+	"ADDRESS_NOT_VALID()":                                   {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"AMOUNT_IN_NOT_VALID()":                                 {"PendleRouterSwapHook"},
+	"AMOUNT_NOT_VALID()":                                    {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"AMOUNT_UNDERFLOW()":                                    {"DeBridgeSendOrderAndExecuteOnDstHook"},
+	"ASSET_ZERO_ADDRESS()":                                  {"FluidClaimRewardHook", "GearboxClaimRewardHook", "YearnClaimOneRewardHook"},
+	"CANNOT_SET_OUT_AMOUNT()":                               {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"CursorOutOfBounds()":                                   {"CircleGatewayMinterHook"},
+	"DATA_NOT_VALID()":                                      {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook"},
+	"DESTINATION_TOKENS_DIFFER()":                           {"CircleGatewayMinterHook"},
+	"EPS_NOT_VALID()":                                       {"PendleRouterSwapHook"},
+	"EXCESSIVE_SLIPPAGE_DEVIATION(uint256,uint256)":         {"SwapUniswapV4Hook"},
+	"FEE_NOT_VALID()":                                       {"MerklClaimRewardHook"},
+	"HOOK_BALANCE_NOT_CLEARED(address,uint256)":             {"SwapUniswapV4Hook"},
+	"INCOMPLETE_HOOK_EXECUTION()":                           {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"INSUFFICIENT_BALANCE()":                                {"BatchTransferFromHook"},
+	"INSUFFICIENT_OUTPUT_AMOUNT(uint256,uint256)":           {"SwapUniswapV4Hook"},
+	"INVALID_ACTUAL_AMOUNT()":                               {"SwapUniswapV4Hook"},
+	"INVALID_ARRAY_LENGTH()":                                {"BatchTransferFromHook"},
+	"INVALID_ASSET()":                                       {"SpectraExchangeRedeemHook"},
+	"INVALID_COMMAND()":                                     {"SpectraExchangeDepositHook", "SpectraExchangeRedeemHook"},
+	"INVALID_DATA_LENGTH()":                                 {"CircleGatewayMinterHook", "PendleRouterRedeemHook"},
+	"INVALID_DEADLINE()":                                    {"SpectraExchangeDepositHook"},
+	"INVALID_DESTINATION_CALLER()":                          {"CircleGatewayMinterHook"},
+	"INVALID_DESTINATION_TOKEN()":                           {"Swap1InchHook"},
+	"INVALID_ENCODING()":                                    {"MerklClaimRewardHook"},
+	"INVALID_GUESS_PT_OUT()":                                {"PendleRouterSwapHook"},
+	"INVALID_HOOK_DATA()":                                   {"SwapUniswapV4Hook"},
+	"INVALID_IBT()":                                         {"SpectraExchangeDepositHook"},
+	"INVALID_INPUT_AMOUNT()":                                {"Swap1InchHook"},
+	"INVALID_LAST_COMMAND()":                                {"SpectraExchangeDepositHook"},
+	"INVALID_MIN_ASSETS()":                                  {"SpectraExchangeRedeemHook"},
+	"INVALID_MIN_SHARES()":                                  {"SpectraExchangeDepositHook"},
+	"INVALID_ORIGINAL_AMOUNTS()":                            {"SwapUniswapV4Hook"},
+	"INVALID_OUTPUT_AMOUNT()":                               {"Swap1InchHook"},
+	"INVALID_OUTPUT_DELTA()":                                {"SwapUniswapV4Hook"},
+	"INVALID_PREVIOUS_NATIVE_TRANSFER_HOOK_USAGE()":         {"SwapUniswapV4Hook"},
+	"INVALID_PRICE_LIMIT()":                                 {"SwapUniswapV4Hook"},
+	"INVALID_PT()":                                          {"SpectraExchangeDepositHook", "SpectraExchangeRedeemHook"},
+	"INVALID_RECEIVER()":                                    {"Swap1InchHook"},
+	"INVALID_RECIPIENT()":                                   {"SpectraExchangeDepositHook", "SpectraExchangeRedeemHook"},
+	"INVALID_REMAINING_NATIVE_AMOUNT()":                     {"SwapUniswapV4Hook"},
+	"INVALID_REWARD_TOKEN()":                                {"FluidClaimRewardHook", "GearboxClaimRewardHook", "YearnClaimOneRewardHook"},
+	"INVALID_SELECTOR()":                                    {"SpectraExchangeDepositHook", "Swap1InchHook"},
+	"INVALID_SELECTOR_OFFSET()":                             {"Swap1InchHook"},
+	"INVALID_SWAP_TYPE()":                                   {"PendleRouterSwapHook"},
+	"INVALID_TOKEN_PAIR()":                                  {"Swap1InchHook"},
+	"INVALID_TRANSFER_TOKEN()":                              {"SpectraExchangeDepositHook"},
+	"InvalidTransferPayloadMagic(bytes4)":                   {"CircleGatewayMinterHook"},
+	"InvalidTransferSpecMagic(bytes4)":                      {"CircleGatewayMinterHook"},
+	"InvalidTransferSpecVersion(uint32)":                    {"CircleGatewayMinterHook"},
+	"LENGTH_MISMATCH()":                                     {"BatchTransferHook", "OfframpTokensHook", "SpectraExchangeDepositHook"},
+	"LTV_RATIO_NOT_VALID()":                                 {"MorphoBorrowHook", "MorphoSupplyAndBorrowHook"},
+	"MAKING_AMOUNT_NOT_VALID()":                             {"PendleRouterSwapHook"},
+	"MARKET_NOT_VALID()":                                    {"PendleRouterSwapHook"},
+	"MIN_OUT_NOT_VALID()":                                   {"PendleRouterSwapHook"},
+	"MIN_TOKEN_OUT_NOT_VALID()":                             {"PendleRouterRedeemHook"},
+	"NOT_AUTHORIZED()":                                      {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"ORDER_EXPIRED()":                                       {"PendleRouterSwapHook"},
+	"OUTPUT_AMOUNT_DIFFERENT_THAN_TRUE()":                   {"SwapUniswapV4Hook"},
+	"PARTIAL_FILL_NOT_ALLOWED()":                            {"Swap1InchHook"},
+	"POST_EXECUTE_ALREADY_CALLED()":                         {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"PRE_EXECUTE_ALREADY_CALLED()":                          {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"QUOTE_DEVIATION_EXCEEDS_SAFETY_BOUNDS()":               {"SwapUniswapV4Hook"},
+	"RECEIVER_NOT_VALID()":                                  {"PendleRouterRedeemHook", "PendleRouterSwapHook"},
+	"REWARD_TOKEN_ZERO_ADDRESS()":                           {"FluidClaimRewardHook", "GearboxClaimRewardHook", "YearnClaimOneRewardHook"},
+	"SafeCastOverflowedIntDowncast(uint8,int256)":           {"Swap1InchHook"},
+	"SafeCastOverflowedUintDowncast(uint8,uint256)":         {"BatchTransferFromHook", "Swap1InchHook"},
+	"TOKEN_ADDRESS_INVALID()":                               {"CircleGatewayMinterHook"},
+	"TOKEN_OUT_NOT_VALID()":                                 {"PendleRouterRedeemHook"},
+	"TransferPayloadDataTooShort(uint256,uint256)":          {"CircleGatewayMinterHook"},
+	"TransferPayloadHeaderTooShort(uint256,uint256)":        {"CircleGatewayMinterHook"},
+	"TransferPayloadOverallLengthMismatch(uint256,uint256)": {"CircleGatewayMinterHook"},
+	"TransferPayloadSetElementHeaderTooShort(uint32,uint256,uint256)": {"CircleGatewayMinterHook"},
+	"TransferPayloadSetElementTooShort(uint32,uint256,uint256)":       {"CircleGatewayMinterHook"},
+	"TransferPayloadSetHeaderTooShort(uint256,uint256)":               {"CircleGatewayMinterHook"},
+	"TransferPayloadSetInvalidElementMagic(uint32,bytes4)":            {"CircleGatewayMinterHook"},
+	"TransferPayloadSetOverallLengthMismatch(uint256,uint256)":        {"CircleGatewayMinterHook"},
+	"TransferSpecHeaderTooShort(uint256,uint256)":                     {"CircleGatewayMinterHook"},
+	"TransferSpecOverallLengthMismatch(uint256,uint256)":              {"CircleGatewayMinterHook"},
+	"UNAUTHORIZED_CALLBACK()":                                         {"SwapUniswapV4Hook"},
+	"UNAUTHORIZED_CALLER()":                                           {"AcrossSendFundsAndExecuteOnDstHook", "ApproveAndAcrossSendFundsAndExecuteOnDstHook", "ApproveAndDeposit4626VaultHook", "ApproveAndDeposit5115VaultHook", "ApproveAndFluidStakeHook", "ApproveAndGearboxStakeHook", "ApproveAndRequestDeposit7540VaultHook", "ApproveAndSwapOdosV2Hook", "ApproveERC20Hook", "BatchTransferFromHook", "BatchTransferHook", "CancelDepositRequest7540Hook", "CancelRedeemRequest7540Hook", "CircleGatewayAddDelegateHook", "CircleGatewayMinterHook", "CircleGatewayRemoveDelegateHook", "CircleGatewayWalletHook", "ClaimCancelDepositRequest7540Hook", "ClaimCancelRedeemRequest7540Hook", "DeBridgeCancelOrderHook", "DeBridgeSendOrderAndExecuteOnDstHook", "Deposit4626VaultHook", "Deposit5115VaultHook", "Deposit7540VaultHook", "EthenaCooldownSharesHook", "EthenaUnstakeHook", "FluidClaimRewardHook", "FluidStakeHook", "FluidUnstakeHook", "GearboxClaimRewardHook", "GearboxStakeHook", "GearboxUnstakeHook", "MarkRootAsUsedHook", "MerklClaimRewardHook", "MorphoBorrowHook", "MorphoRepayAndWithdrawHook", "MorphoRepayHook", "MorphoSupplyAndBorrowHook", "OfframpTokensHook", "PendleRouterRedeemHook", "PendleRouterSwapHook", "Redeem4626VaultHook", "Redeem5115VaultHook", "Redeem7540VaultHook", "RequestDeposit7540VaultHook", "RequestRedeem7540VaultHook", "SpectraExchangeDepositHook", "SpectraExchangeRedeemHook", "Swap1InchHook", "SwapOdosV2Hook", "SwapUniswapV4Hook", "TransferERC20Hook", "YearnClaimOneRewardHook"},
+	"YT_NOT_VALID()":                                                  {"PendleRouterRedeemHook"},
+	"ZERO_ADDRESS()":                                                  {"Swap1InchHook"},
+	"ZERO_LIQUIDITY()":                                                {"SwapUniswapV4Hook"},
+}
@@ -0,0 +1,401 @@
+// Package reverts decodes revert data from Superform contracts into typed Go
+// errors.
+//
+// The 4-byte selector of every custom error declared in the ABIs under
+// contract_bindings and in the hook artifacts under script/ is indexed at
+// init. Decode turns raw revert data into an *Error (custom errors), a
+// *RevertString (Error(string)), a *Panic (Panic(uint256)) or an *Unknown;
+// Wrap does the same for errors returned by an RPC call, keeping the
+// original error reachable through errors.Unwrap:
+//
+//	_, err := binding.Execute(opts, data)
+//	var e *reverts.Error
+//	if errors.As(reverts.Wrap(err), &e) && e.Name() == "MALICIOUS_HOOK_DETECTED" {
+//		...
+//	}
+package reverts
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+// Definition is one custom error as declared in the indexed ABIs.
+type Definition struct {
+	Selector  [4]byte
+	Name      string
+	Signature string
+	Inputs    abi.Arguments
+	// Sources lists the contracts declaring the error, sorted.
+	Sources []string
+}
+
+// Message is the error name in plain words: MERKLE_ROOT_ALREADY_USED and
+// MerkleRootAlreadyUsed both read "merkle root already used".
+func (d *Definition) Message() string {
+	return humanize(d.Name)
+}
+
+// index maps selectors to their definitions; distinct signatures sharing a
+// selector are all kept and tried in order.
+var index = map[[4]byte][]*Definition{}
+
+func init() {
+	for _, b := range bindings {
+		parsed, err := b.meta.GetAbi()
+		if err != nil {
+			panic(fmt.Sprintf("reverts: %s ABI: %v", b.name, err))
+		}
+		for _, e := range parsed.Errors {
+			add(e, b.name)
+		}
+	}
+	hooks, err := abi.JSON(strings.NewReader(hookErrorsABI))
+	if err != nil {
+		panic(fmt.Sprintf("reverts: hook errors: %v", err))
+	}
+	for _, e := range hooks.Errors {
+		add(e, hookErrorSources[e.Sig]...)
+	}
+	for _, defs := range index {
+		for _, d := range defs {
+			sort.Strings(d.Sources)
+		}
+	}
+}
+
+func add(e abi.Error, sources ...string) {
+	var sel [4]byte
+	copy(sel[:], e.ID[:4])
+	for _, d := range index[sel] {
+		if d.Signature == e.Sig {
+			for _, s := range sources {
+				if !contains(d.Sources, s) {
+					d.Sources = append(d.Sources, s)
+				}
+			}
+			return
+		}
+	}
+	index[sel] = append(index[sel], &Definition{
+		Selector:  sel,
+		Name:      e.Name,
+		Signature: e.Sig,
+		Inputs:    e.Inputs,
+		Sources:   append([]string(nil), sources...),
+	})
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+// Lookup returns the definitions registered for selector.
+func Lookup(selector [4]byte) []*Definition {
+	return index[selector]
+}
+
+// ByName returns the definitions of every error called name.
+func ByName(name string) []*Definition {
+	var out []*Definition
+	for _, defs := range index {
+		for _, d := range defs {
+			if d.Name == name {
+				out = append(out, d)
+			}
+		}
+	}
+	sort.Slice(out, func(i, j int) bool { return out[i].Signature < out[j].Signature })
+	return out
+}
+
+// revert holds what every decoded revert carries.
+type revert struct {
+	// Data is the raw revert data.
+	Data []byte
+	// Cause is the RPC error the data was extracted from, if any.
+	Cause error
+}
+
+func (r revert) Unwrap() error { return r.Cause }
+
+// Error is a decoded custom error.
+type Error struct {
+	revert
+	Def *Definition
+	// Args are the decoded arguments in declaration order.
+	Args []any
+}
+
+// Name returns the error name.
+func (e *Error) Name() string { return e.Def.Name }
+
+// Arg returns the argument called name, or nil.
+func (e *Error) Arg(name string) any {
+	for i, in := range e.Def.Inputs {
+		if in.Name == name && i < len(e.Args) {
+			return e.Args[i]
+		}
+	}
+	return nil
+}
+
+// Error renders the error as "Name(arg=value, ...): plain words".
+func (e *Error) Error() string {
+	if len(e.Args) == 0 {
+		return e.Def.Name + ": " + e.Def.Message()
+	}
+	args := make([]string, len(e.Args))
+	for i, a := range e.Args {
+		name := e.Def.Inputs[i].Name
+		if name == "" {
+			name = fmt.Sprintf("arg%d", i)
+		}
+		args[i] = name + "=" + formatArg(a)
+	}
+	return fmt.Sprintf("%s(%s): %s", e.Def.Name, strings.Join(args, ", "), e.Def.Message())
+}
+
+// RevertString is a require/revert with a reason string, Error(string).
+type RevertString struct {
+	revert
+	Reason string
+}
+
+func (e *RevertString) Error() string { return "execution reverted: " + e.Reason }
+
+// Panic is a Solidity panic, Panic(uint256).
+type Panic struct {
+	revert
+	Code *big.Int
+}
+
+// panicReasons describes the compiler-inserted panic codes.
+var panicReasons = map[uint64]string{
+	0x00: "generic compiler panic",
+	0x01: "assertion failed",
+	0x11: "arithmetic underflow or overflow",
+	0x12: "division or modulo by zero",
+	0x21: "invalid enum value",
+	0x22: "invalid storage byte array encoding",
+	0x31: "pop on empty array",
+	0x32: "array index out of bounds",
+	0x41: "out of memory",
+	0x51: "call to zero-initialized internal function",
+}
+
+func (e *Panic) Error() string {
+	if e.Code.IsUint64() {
+		if reason, ok := panicReasons[e.Code.Uint64()]; ok {
+			return fmt.Sprintf("panic 0x%x: %s", e.Code, reason)
+		}
+	}
+	return fmt.Sprintf("panic 0x%x", e.Code)
+}
+
+// Unknown is revert data that matches no indexed error. Empty data, as from
+// revert() or running out of gas, decodes to an Unknown without selector.
+type Unknown struct {
+	revert
+}
+
+// Selector returns the first four bytes of the data, if present.
+func (e *Unknown) Selector() ([4]byte, bool) {
+	var sel [4]byte
+	if len(e.Data) < 4 {
+		return sel, false
+	}
+	copy(sel[:], e.Data)
+	return sel, true
+}
+
+func (e *Unknown) Error() string {
+	if sel, ok := e.Selector(); ok {
+		return fmt.Sprintf("execution reverted with unknown error 0x%x (%d bytes)", sel, len(e.Data))
+	}
+	if len(e.Data) == 0 {
+		return "execution reverted without data"
+	}
+	return fmt.Sprintf("execution reverted with %d bytes of data", len(e.Data))
+}
+
+var (
+	errorStringSelector = [4]byte{0x08, 0xc3, 0x79, 0xa0}
+	panicSelector       = [4]byte{0x4e, 0x48, 0x7b, 0x71}
+
+	stringArgs = abi.Arguments{{Type: mustType("string")}}
+	uintArgs   = abi.Arguments{{Type: mustType("uint256")}}
+)
+
+func mustType(t string) abi.Type {
+	typ, err := abi.NewType(t, "", nil)
+	if err != nil {
+		panic(err)
+	}
+	return typ
+}
+
+// Decode decodes revert data. The result is never nil.
+func Decode(data []byte) error {
+	return decode(data, nil)
+}
+
+func decode(data []byte, cause error) error {
+	r := revert{Data: data, Cause: cause}
+	if len(data) < 4 {
+		return &Unknown{r}
+	}
+	var sel [4]byte
+	copy(sel[:], data)
+	switch sel {
+	case errorStringSelector:
+		if vals, err := stringArgs.Unpack(data[4:]); err == nil {
+			return &RevertString{revert: r, Reason: vals[0].(string)}
+		}
+	case panicSelector:
+		if vals, err := uintArgs.Unpack(data[4:]); err == nil {
+			return &Panic{revert: r, Code: vals[0].(*big.Int)}
+		}
+	}
+	for _, d := range index[sel] {
+		vals, err := d.Inputs.Unpack(data[4:])
+		if err != nil {
+			continue
+		}
+		return &Error{revert: r, Def: d, Args: vals}
+	}
+	return &Unknown{r}
+}
+
+// Data extracts revert data from an RPC error implementing rpc.DataError,
+// as returned by eth_call and eth_estimateGas.
+func Data(err error) ([]byte, bool) {
+	var de interface{ ErrorData() interface{} }
+	if !errors.As(err, &de) {
+		return nil, false
+	}
+	switch v := de.ErrorData().(type) {
+	case string:
+		b, err := hexutil.Decode(v)
+		return b, err == nil
+	case []byte:
+		return v, true
+	}
+	return nil, false
+}
+
+// IsRevert reports whether err is an execution revert, with or without
+// revert data.
+func IsRevert(err error) bool {
+	if _, ok := Data(err); ok {
+		return true
+	}
+	return err != nil && strings.Contains(err.Error(), "execution reverted")
+}
+
+// IsEmptyRevert reports whether err is a revert without data, as a call to
+// a function the contract lacks returns. geth omits the data field of those
+// and answers the bare "execution reverted" message, so an RPC error with
+// exactly that message counts too; reverts with a reason or data do not.
+func IsEmptyRevert(err error) bool {
+	if data, ok := Data(err); ok {
+		return len(data) == 0
+	}
+	var rpcErr rpc.Error
+	return errors.As(err, &rpcErr) && rpcErr.Error() == vm.ErrExecutionReverted.Error()
+}
+
+// Wrap decodes the revert data carried by err. Errors without revert data,
+// including nil, are returned unchanged.
+func Wrap(err error) error {
+	data, ok := Data(err)
+	if !ok {
+		return err
+	}
+	return decode(data, err)
+}
+
+// Replay re-executes a mined transaction with eth_call on the state before
+// its block to recover the revert data its receipt does not carry. It
+// returns nil if the replay succeeds; later transactions in the same block
+// are not visible, so the result may differ from the original execution.
+func Replay(ctx context.Context, caller ethereum.ContractCaller, from common.Address, tx *types.Transaction, receipt *types.Receipt) error {
+	msg := ethereum.CallMsg{
+		From:       from,
+		To:         tx.To(),
+		Gas:        tx.Gas(),
+		Value:      tx.Value(),
+		Data:       tx.Data(),
+		AccessList: tx.AccessList(),
+	}
+	var block *big.Int
+	if receipt.BlockNumber != nil && receipt.BlockNumber.Sign() > 0 {
+		block = new(big.Int).Sub(receipt.BlockNumber, common.Big1)
+	}
+	_, err := caller.CallContract(ctx, msg, block)
+	return Wrap(err)
+}
+
+// Is reports whether err is, or wraps, a custom error called name.
+func Is(err error, name string) bool {
+	var e *Error
+	return errors.As(err, &e) && e.Def.Name == name
+}
+
+func formatArg(v any) string {
+	switch a := v.(type) {
+	case []byte:
+		return hexutil.Encode(a)
+	case [32]byte:
+		return hexutil.Encode(a[:])
+	case [4]byte:
+		return hexutil.Encode(a[:])
+	case common.Address:
+		return a.Hex()
+	}
+	return fmt.Sprint(v)
+}
+
+// humanize splits an UPPER_SNAKE or CamelCase identifier into lower-case
+// words, keeping acronyms such as "ERC" or "ID" upper-case.
+func humanize(name string) string {
+	if strings.ContainsRune(name, '_') || strings.ToUpper(name) == name {
+		return strings.ToLower(strings.ReplaceAll(strings.Trim(name, "_"), "_", " "))
+	}
+	rs := []rune(name)
+	var words []string
+	start := 0
+	for i := 1; i < len(rs); i++ {
+		prev, cur := rs[i-1], rs[i]
+		nextLower := i+1 < len(rs) && unicode.IsLower(rs[i+1])
+		if unicode.IsUpper(cur) && (unicode.IsLower(prev) || unicode.IsDigit(prev) || (unicode.IsUpper(prev) && nextLower)) {
+			words = append(words, string(rs[start:i]))
+			start = i
+		}
+	}
+	words = append(words, string(rs[start:]))
+	for i, w := range words {
+		if strings.ToUpper(w) != w || len(w) == 1 {
+			words[i] = strings.ToLower(w)
+		}
+	}
+	return strings.Join(words, " ")
+}
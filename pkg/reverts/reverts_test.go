@@ -0,0 +1,168 @@
+package reverts
+
+import (
+	"errors"
+	"math/big"
+	"strings"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func selector(sig string) []byte { return crypto.Keccak256([]byte(sig))[:4] }
+
+func TestDecodeCustomErrors(t *testing.T) {
+	err := Decode(selector("MALICIOUS_HOOK_DETECTED()"))
+	var e *Error
+	if !errors.As(err, &e) || e.Name() != "MALICIOUS_HOOK_DETECTED" || err.Error() != "MALICIOUS_HOOK_DETECTED: malicious hook detected" {
+		t.Fatalf("err = %v", err)
+	}
+	if !contains(e.Def.Sources, "SuperExecutor") {
+		t.Fatalf("sources = %v", e.Def.Sources)
+	}
+
+	account := common.HexToAddress("0x00000000000000000000000000000000000000aa")
+	data := append(selector("ModuleAlreadyInitialized(address)"), common.LeftPadBytes(account[:], 32)...)
+	err = Decode(data)
+	if !errors.As(err, &e) || e.Arg("smartAccount") != account {
+		t.Fatalf("err = %v", err)
+	}
+	if want := "ModuleAlreadyInitialized(smartAccount=" + account.Hex() + "): module already initialized"; err.Error() != want {
+		t.Fatalf("message = %q, want %q", err.Error(), want)
+	}
+
+	// Declared only by hook artifacts, which have no bindings.
+	if err := Decode(selector("AMOUNT_IN_NOT_VALID()")); !Is(err, "AMOUNT_IN_NOT_VALID") {
+		t.Fatalf("hook error = %v", err)
+	}
+	if defs := ByName("AMOUNT_IN_NOT_VALID"); len(defs) != 1 || defs[0].Sources[0] != "PendleRouterSwapHook" {
+		t.Fatalf("hook definition = %+v", defs)
+	}
+}
+
+func TestDecodeBuiltins(t *testing.T) {
+	reason, _ := stringArgs.Pack("nope")
+	err := Decode(append(selector("Error(string)"), reason...))
+	var rs *RevertString
+	if !errors.As(err, &rs) || rs.Reason != "nope" {
+		t.Fatalf("err = %v", err)
+	}
+
+	code, _ := uintArgs.Pack(big.NewInt(0x11))
+	if err := Decode(append(selector("Panic(uint256)"), code...)); err.Error() != "panic 0x11: arithmetic underflow or overflow" {
+		t.Fatalf("panic = %v", err)
+	}
+
+	var u *Unknown
+	if err := Decode([]byte{1, 2, 3, 4, 5}); !errors.As(err, &u) || !strings.Contains(err.Error(), "0x01020304") {
+		t.Fatalf("unknown = %v", err)
+	}
+	if err := Decode(nil); err.Error() != "execution reverted without data" {
+		t.Fatalf("empty = %v", err)
+	}
+}
+
+func TestHumanize(t *testing.T) {
+	for in, want := range map[string]string{
+		"INSUFFICIENT_BALANCE_FOR_FEE": "insufficient balance for fee",
+		"ModuleAlreadyInitialized":     "module already initialized",
+		"InvalidERC7579Module":         "invalid ERC7579 module",
+		"NotInitialized":               "not initialized",
+	} {
+		if got := humanize(in); got != want {
+			t.Errorf("humanize(%q) = %q, want %q", in, got, want)
+		}
+	}
+}
+
+type dataError struct{ data string }
+
+func (e dataError) Error() string          { return "execution reverted" }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+func TestWrap(t *testing.T) {
+	if err := Wrap(nil); err != nil {
+		t.Fatalf("Wrap(nil) = %v", err)
+	}
+	plain := errors.New("boom")
+	if Wrap(plain) != plain {
+		t.Fatal("error without data changed")
+	}
+	cause := dataError{hexutil.Encode(selector("MERKLE_ROOT_ALREADY_USED()"))}
+	err := Wrap(cause)
+	if !Is(err, "MERKLE_ROOT_ALREADY_USED") || !errors.Is(err, cause) {
+		t.Fatalf("err = %v", err)
+	}
+}
+
+// rpcError is an RPC error with optional revert data.
+type rpcError struct {
+	msg  string
+	data any
+}
+
+func (e *rpcError) Error() string  { return e.msg }
+func (e *rpcError) ErrorCode() int { return 3 }
+func (e *rpcError) ErrorData() any { return e.data }
+
+func TestIsEmptyRevert(t *testing.T) {
+	for _, c := range []struct {
+		err  error
+		want bool
+	}{
+		{&rpcError{msg: "execution reverted", data: "0x"}, true},
+		{&rpcError{msg: "execution reverted"}, true},
+		{&rpcError{msg: "execution reverted", data: "0x08c379a0"}, false},
+		{&rpcError{msg: "execution reverted: paused"}, false},
+		{errors.New("execution reverted"), false},
+		{errors.New("connection refused"), false},
+	} {
+		if got := IsEmptyRevert(c.err); got != c.want {
+			t.Errorf("IsEmptyRevert(%v) = %v", c.err, got)
+		}
+	}
+}
+
+func TestIsRevert(t *testing.T) {
+	for _, c := range []struct {
+		err  error
+		want bool
+	}{
+		{&rpcError{msg: "execution reverted"}, true},
+		{dataError{"0x08c379a0"}, true},
+		{errors.New("execution reverted: paused"), true},
+		{errors.New("connection refused"), false},
+		{nil, false},
+	} {
+		if got := IsRevert(c.err); got != c.want {
+			t.Errorf("IsRevert(%v) = %v", c.err, got)
+		}
+	}
+}
+
+// TestIndexCoversBindings checks every binding error is indexed under its
+// selector.
+func TestIndexCoversBindings(t *testing.T) {
+	for _, b := range bindings {
+		parsed, _ := b.meta.GetAbi()
+		for _, e := range parsed.Errors {
+			if !indexed(e) {
+				t.Errorf("%s: %s not indexed", b.name, e.Sig)
+			}
+		}
+	}
+}
+
+func indexed(e abi.Error) bool {
+	var sel [4]byte
+	copy(sel[:], e.ID[:4])
+	for _, d := range Lookup(sel) {
+		if d.Signature == e.Sig {
+			return true
+		}
+	}
+	return false
+}
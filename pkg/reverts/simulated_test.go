@@ -0,0 +1,44 @@
+package reverts_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/core/types"
+
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/reverts"
+)
+
+// TestSimulatedReverts decodes a SuperLedgerConfiguration revert from both an
+// eth_call and a mined transaction.
+func TestSimulatedReverts(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	auth, cfg := stack.Auth, stack.LedgerConfiguration
+
+	// Gas estimation runs the call and fails with the revert data.
+	_, err = cfg.AcceptYieldSourceOracleConfigProposal(auth, [][32]byte{})
+	if err = reverts.Wrap(err); !reverts.Is(err, "ZERO_LENGTH") {
+		t.Fatalf("estimate: %v", err)
+	}
+
+	opts := *auth
+	opts.GasLimit = 200_000
+	tx, err := cfg.AcceptYieldSourceOracleConfigProposal(&opts, [][32]byte{{1}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	stack.Backend.Commit()
+	receipt, err := bind.WaitMined(context.Background(), stack.Client, tx)
+	if err != nil || receipt.Status != types.ReceiptStatusFailed {
+		t.Fatalf("receipt = %+v, %v", receipt, err)
+	}
+	if err := reverts.Replay(context.Background(), stack.Client, auth.From, tx, receipt); !reverts.Is(err, "CONFIG_NOT_FOUND") {
+		t.Fatalf("replay: %v", err)
+	}
+}
@@ -0,0 +1,104 @@
+package userop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/pkg/executor"
+)
+
+// Execution mirrors the ERC-7579 Execution struct.
+type Execution struct {
+	Target   common.Address
+	Value    *big.Int
+	CallData []byte
+}
+
+// ERC-7579 execution modes as produced by ModeLib.encodeSimpleSingle and
+// encodeSimpleBatch: call type in the first byte, default exec type, no
+// selector or payload.
+var (
+	ModeSingle = [32]byte{0x00}
+	ModeBatch  = [32]byte{0x01}
+)
+
+// accountABI is the ERC-7579 execute entry point implemented by Nexus and
+// other modular accounts.
+var accountABI = func() abi.ABI {
+	bytesType, _ := abi.NewType("bytes", "", nil)
+	return abi.ABI{Methods: map[string]abi.Method{
+		"execute": abi.NewMethod("execute", "execute", abi.Function, "payable", false, true,
+			abi.Arguments{{Name: "mode", Type: bytes32Type}, {Name: "executionCalldata", Type: bytesType}}, nil),
+	}}
+}()
+
+var executionsArgs = func() abi.Arguments {
+	typ, err := abi.NewType("tuple[]", "struct Execution[]", []abi.ArgumentMarshaling{
+		{Name: "target", Type: "address"},
+		{Name: "value", Type: "uint256"},
+		{Name: "callData", Type: "bytes"},
+	})
+	if err != nil {
+		panic(err)
+	}
+	return abi.Arguments{{Type: typ}}
+}()
+
+// AccountExecuteCalldata returns the calldata for the account's
+// execute(mode, executionCalldata): ExecutionLib.encodeSingle for one
+// execution and encodeBatch for several.
+func AccountExecuteCalldata(execs ...Execution) ([]byte, error) {
+	switch len(execs) {
+	case 0:
+		return nil, errors.New("userop: no executions")
+	case 1:
+		e := execs[0]
+		packed := make([]byte, 0, 20+32+len(e.CallData))
+		packed = append(packed, e.Target[:]...)
+		packed = append(packed, common.BigToHash(orZero(e.Value)).Bytes()...)
+		packed = append(packed, e.CallData...)
+		return accountABI.Pack("execute", ModeSingle, packed)
+	}
+	type execution struct {
+		Target   common.Address
+		Value    *big.Int
+		CallData []byte
+	}
+	list := make([]execution, len(execs))
+	for i, e := range execs {
+		list[i] = execution{e.Target, orZero(e.Value), orEmpty(e.CallData)}
+	}
+	encoded, err := executionsArgs.Pack(list)
+	if err != nil {
+		return nil, fmt.Errorf("userop: encode batch: %w", err)
+	}
+	return accountABI.Pack("execute", ModeBatch, encoded)
+}
+
+// SuperExecutorCall returns the Execution calling superExecutor.execute with
+// entry, after the checks SuperExecutor would revert on.
+func SuperExecutorCall(superExecutor common.Address, entry *executor.ExecutorEntry) (Execution, error) {
+	if err := entry.Validate(); err != nil {
+		return Execution{}, err
+	}
+	data, err := entry.ExecuteCalldata()
+	if err != nil {
+		return Execution{}, err
+	}
+	return Execution{Target: superExecutor, Value: new(big.Int), CallData: data}, nil
+}
+
+// SetSuperExecutorCall sets u.CallData to the account executing entry
+// through superExecutor.
+func (u *UserOp) SetSuperExecutorCall(superExecutor common.Address, entry *executor.ExecutorEntry) error {
+	exec, err := SuperExecutorCall(superExecutor, entry)
+	if err != nil {
+		return err
+	}
+	u.CallData, err = AccountExecuteCalldata(exec)
+	return err
+}
@@ -0,0 +1,463 @@
+package userop
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperNativePaymaster"
+)
+
+type (
+	// ExecutionResult is IEntryPointSimulations.ExecutionResult.
+	ExecutionResult = SuperNativePaymaster.IEntryPointSimulationsExecutionResult
+	// ValidationResult is IEntryPointSimulations.ValidationResult.
+	ValidationResult = SuperNativePaymaster.IEntryPointSimulationsValidationResult
+)
+
+// OverrideCaller runs eth_call with state overrides; gethclient.Client
+// implements it.
+type OverrideCaller interface {
+	CallContract(ctx context.Context, msg ethereum.CallMsg, block *big.Int, overrides *map[common.Address]gethclient.OverrideAccount) ([]byte, error)
+}
+
+// Buffers are percentages added on top of simulated gas, per limit.
+type Buffers struct {
+	PreVerificationGas       uint64
+	VerificationGasLimit     uint64
+	CallGasLimit             uint64
+	PaymasterVerificationGas uint64
+	PaymasterPostOpGasLimit  uint64
+}
+
+// DefaultBuffers are used for an Estimator with zero Buffers.
+var DefaultBuffers = Buffers{
+	PreVerificationGas:       5,
+	VerificationGasLimit:     30,
+	CallGasLimit:             20,
+	PaymasterVerificationGas: 30,
+	PaymasterPostOpGasLimit:  30,
+}
+
+// Gas used while simulating; generous so nothing runs out of gas before the
+// real usage is measured.
+var (
+	simulationGasLimit          = big.NewInt(10_000_000)
+	simulationPaymasterGasLimit = big.NewInt(1_000_000)
+)
+
+// DefaultPaymasterPostOpGasLimit is the postOp limit used when the operation
+// does not set one: SuperNativePaymaster._postOp reads its deposit, may
+// withdraw a refund and emits two events.
+var DefaultPaymasterPostOpGasLimit = big.NewInt(150_000)
+
+// Estimate is the outcome of Estimator.Estimate.
+type Estimate struct {
+	PreVerificationGas            *big.Int `json:"preVerificationGas"`
+	VerificationGasLimit          *big.Int `json:"verificationGasLimit"`
+	CallGasLimit                  *big.Int `json:"callGasLimit"`
+	PaymasterVerificationGasLimit *big.Int `json:"paymasterVerificationGasLimit"`
+	PaymasterPostOpGasLimit       *big.Int `json:"paymasterPostOpGasLimit"`
+
+	// ValidationGas and ExecutionGas are the unbuffered measurements: gas up
+	// to the end of validation excluding preVerificationGas, and gas after
+	// it including postOp. PaymasterValidationGas is the part of
+	// ValidationGas the paymaster accounts for: ValidationGas less that of
+	// the operation simulated without the paymaster.
+	ValidationGas          *big.Int `json:"validationGas"`
+	PaymasterValidationGas *big.Int `json:"paymasterValidationGas"`
+	ExecutionGas           *big.Int `json:"executionGas"`
+
+	// MaxCost is the value a bundler sends with handleOps; PredictedCost is
+	// the expected actual cost at the operation's max fee including the
+	// premium, and PredictedRefund what SuperNativePaymaster.calculateRefund
+	// returns for it.
+	//
+	// Penalty is the v0.7 EntryPoint charge of 10% of the execution gas
+	// left unused, at the max fee. The EntryPoint adds it after postOp has
+	// computed the refund, so it comes out of the paymaster's deposit and
+	// neither PredictedRefund nor PredictedCost include it.
+	MaxCost         *big.Int `json:"maxCost"`
+	PredictedCost   *big.Int `json:"predictedCost"`
+	PredictedRefund *big.Int `json:"predictedRefund"`
+	Penalty         *big.Int `json:"penalty"`
+
+	Validation *ValidationResult `json:"validation"`
+	Execution  *ExecutionResult  `json:"execution"`
+}
+
+// Estimator sizes the gas limits of an operation by running
+// SuperNativePaymaster.simulateValidation and simulateHandleOp through
+// eth_call, with EntryPointSimulations code overriding the EntryPoint, and
+// EntryPointSimulations.simulateValidation on the operation without the
+// paymaster to split validation gas between account and paymaster.
+type Estimator struct {
+	Calls     OverrideCaller
+	Paymaster common.Address
+	// EntryPoint defaults to EntryPointV07.
+	EntryPoint common.Address
+	// SimulationCode is the EntryPointSimulations runtime bytecode matching
+	// the deployed EntryPoint.
+	SimulationCode []byte
+	// From is the simulated bundler. Its balance is overridden to cover the
+	// value the simulations send.
+	From common.Address
+	// PremiumBps and PostOpGas are written into the paymaster data.
+	PremiumBps *big.Int
+	PostOpGas  *big.Int
+	// Buffers default to DefaultBuffers when zero.
+	Buffers Buffers
+	// Block pins the simulation; nil for latest.
+	Block *big.Int
+}
+
+var paymasterABI, _ = SuperNativePaymaster.SuperNativePaymasterMetaData.GetAbi()
+
+// Estimate simulates u and returns buffered limits for it. u is not
+// modified; its fees are used for the refund prediction and its signature
+// must have the shape of a real one so validation costs the same.
+func (e *Estimator) Estimate(ctx context.Context, u *UserOp) (*Estimate, error) {
+	buf := e.Buffers
+	if buf == (Buffers{}) {
+		buf = DefaultBuffers
+	}
+	entryPoint := e.EntryPoint
+	if entryPoint == (common.Address{}) {
+		entryPoint = EntryPointV07
+	}
+	if len(e.SimulationCode) == 0 {
+		return nil, errors.New("userop: EntryPointSimulations code required")
+	}
+
+	postOpLimit := DefaultPaymasterPostOpGasLimit
+	if u.Paymaster != nil && u.Paymaster.PostOpGasLimit != nil && u.Paymaster.PostOpGasLimit.Sign() > 0 {
+		postOpLimit = u.Paymaster.PostOpGasLimit
+	}
+	// preVerificationGas is sized on the operation as submitted, paymaster
+	// data included, with the simulation limits standing in for the final
+	// ones.
+	sim := *u
+	sim.VerificationGasLimit = simulationGasLimit
+	sim.CallGasLimit = simulationGasLimit
+	if err := sim.SetSuperNativePaymaster(e.Paymaster, simulationPaymasterGasLimit, postOpLimit, e.premium(), e.PostOpGas); err != nil {
+		return nil, err
+	}
+	pvg, err := PreVerificationGas(&sim)
+	if err != nil {
+		return nil, err
+	}
+	// Simulate at a gas price of one so paid equals gas used.
+	sim.PreVerificationGas = pvg
+	sim.MaxFeePerGas, sim.MaxPriorityFeePerGas = big.NewInt(1), big.NewInt(1)
+	if err := sim.SetSuperNativePaymaster(e.Paymaster, simulationPaymasterGasLimit, postOpLimit, e.premium(), e.PostOpGas); err != nil {
+		return nil, err
+	}
+	packed, err := sim.Pack()
+	if err != nil {
+		return nil, err
+	}
+	value := sim.MaxCost()
+	overrides := map[common.Address]gethclient.OverrideAccount{
+		entryPoint: {Code: e.SimulationCode},
+		e.From:     {Balance: value},
+	}
+
+	var validation ValidationResult
+	if err := e.simulate(ctx, e.Paymaster, "simulateValidation", value, overrides, &validation, packed); err != nil {
+		return nil, err
+	}
+	var execution ExecutionResult
+	if err := e.simulate(ctx, e.Paymaster, "simulateHandleOp", value, overrides, &execution, packed, common.Address{}, []byte{}); err != nil {
+		return nil, err
+	}
+	// The account's share of validation, measured on the EntryPoint
+	// without the paymaster and at a zero fee so there is no prefund to
+	// pay. The EntryPoint bounds it by verificationGasLimit before it
+	// validates the paymaster under its own limit.
+	own := sim
+	own.Paymaster = nil
+	own.MaxFeePerGas, own.MaxPriorityFeePerGas = new(big.Int), new(big.Int)
+	ownPacked, err := own.Pack()
+	if err != nil {
+		return nil, err
+	}
+	var ownValidation ValidationResult
+	if err := e.simulate(ctx, entryPoint, "simulateValidation", nil, overrides, &ownValidation, ownPacked); err != nil {
+		return nil, err
+	}
+
+	est := &Estimate{Validation: &validation, Execution: &execution}
+	est.ValidationGas = new(big.Int).Sub(validation.ReturnInfo.PreOpGas, pvg)
+	accountGas := new(big.Int).Sub(ownValidation.ReturnInfo.PreOpGas, pvg)
+	if accountGas.Cmp(est.ValidationGas) > 0 {
+		accountGas.Set(est.ValidationGas)
+	}
+	est.PaymasterValidationGas = new(big.Int).Sub(est.ValidationGas, accountGas)
+	execLimit := new(big.Int).Add(sim.CallGasLimit, postOpLimit)
+	est.ExecutionGas = executionGasUsed(execution.Paid, execution.PreOpGas, execLimit)
+
+	est.PreVerificationGas = withBuffer(pvg, buf.PreVerificationGas)
+	est.VerificationGasLimit = withBuffer(accountGas, buf.VerificationGasLimit)
+	est.PaymasterVerificationGasLimit = withBuffer(est.PaymasterValidationGas, buf.PaymasterVerificationGas)
+	// postOp runs inside the execution gas; its limit covers the default
+	// or the operation's own.
+	est.CallGasLimit = withBuffer(est.ExecutionGas, buf.CallGasLimit)
+	est.PaymasterPostOpGasLimit = withBuffer(postOpLimit, buf.PaymasterPostOpGasLimit)
+
+	final := e.Apply(u, est)
+	if err := final.SetSuperNativePaymaster(e.Paymaster, est.PaymasterVerificationGasLimit, est.PaymasterPostOpGasLimit, e.premium(), e.PostOpGas); err != nil {
+		return nil, err
+	}
+	est.MaxCost = final.MaxCost()
+	maxFee := orZero(u.MaxFeePerGas)
+	actual := new(big.Int).Add(execution.PreOpGas, est.ExecutionGas)
+	actual.Add(actual, orZero(e.PostOpGas))
+	actual.Mul(actual, maxFee)
+	est.PredictedRefund, err = e.refund(ctx, final.TotalGasLimit(), maxFee, actual)
+	if err != nil {
+		return nil, err
+	}
+	est.PredictedCost = new(big.Int).Sub(est.MaxCost, est.PredictedRefund)
+	finalExecLimit := new(big.Int).Add(est.CallGasLimit, est.PaymasterPostOpGasLimit)
+	est.Penalty = unusedGasPenalty(finalExecLimit, est.ExecutionGas)
+	est.Penalty.Mul(est.Penalty, maxFee)
+	return est, nil
+}
+
+// Apply returns a copy of u with the estimated limits. The paymaster data
+// is left to the caller, since maxGasLimit depends on the final limits.
+func (e *Estimator) Apply(u *UserOp, est *Estimate) *UserOp {
+	out := *u
+	out.PreVerificationGas = est.PreVerificationGas
+	out.VerificationGasLimit = est.VerificationGasLimit
+	out.CallGasLimit = est.CallGasLimit
+	out.Paymaster = nil
+	return &out
+}
+
+func (e *Estimator) premium() *big.Int {
+	return orZero(e.PremiumBps)
+}
+
+// simulate calls method on to, the paymaster or the overridden EntryPoint,
+// and unpacks its single struct return value into out. Both share the
+// simulation method signatures.
+func (e *Estimator) simulate(ctx context.Context, to common.Address, method string, value *big.Int, overrides map[common.Address]gethclient.OverrideAccount, out any, args ...any) error {
+	data, err := paymasterABI.Pack(method, args...)
+	if err != nil {
+		return fmt.Errorf("userop: pack %s: %w", method, err)
+	}
+	msg := ethereum.CallMsg{From: e.From, To: &to, Value: value, Data: data}
+	ret, err := e.Calls.CallContract(ctx, msg, e.Block, &overrides)
+	if err != nil {
+		return &SimulationError{Method: method, Revert: DecodeSimulationRevert(RevertData(err)), Err: err}
+	}
+	vals, err := paymasterABI.Unpack(method, ret)
+	if err != nil {
+		return fmt.Errorf("userop: unpack %s: %w", method, err)
+	}
+	abi.ConvertType(vals[0], out)
+	return nil
+}
+
+func (e *Estimator) refund(ctx context.Context, maxGasLimit, maxFeePerGas, actualGasCost *big.Int) (*big.Int, error) {
+	data, err := paymasterABI.Pack("calculateRefund", maxGasLimit, maxFeePerGas, actualGasCost, e.premium())
+	if err != nil {
+		return nil, err
+	}
+	ret, err := e.Calls.CallContract(ctx, ethereum.CallMsg{To: &e.Paymaster, Data: data}, e.Block, nil)
+	if err != nil {
+		return nil, fmt.Errorf("userop: calculateRefund: %w", err)
+	}
+	vals, err := paymasterABI.Unpack("calculateRefund", ret)
+	if err != nil {
+		return nil, fmt.Errorf("userop: calculateRefund: %w", err)
+	}
+	return vals[0].(*big.Int), nil
+}
+
+// penaltyPercent is the v0.7 EntryPoint PENALTY_PERCENT.
+const penaltyPercent = 10
+
+// unusedGasPenalty is the gas the v0.7 EntryPoint adds for the part of the
+// execution gas limit left unused.
+func unusedGasPenalty(limit, used *big.Int) *big.Int {
+	unused := new(big.Int).Sub(limit, used)
+	if unused.Sign() <= 0 {
+		return new(big.Int)
+	}
+	unused.Mul(unused, big.NewInt(penaltyPercent))
+	return unused.Quo(unused, big.NewInt(100))
+}
+
+// executionGasUsed inverts the v0.7 EntryPoint unused-gas penalty. With a
+// gas price of one, paid = preOpGas + used + (limit - used) / 10, so
+// used = (10 * (paid - preOpGas) - limit) / 9, rounded up.
+func executionGasUsed(paid, preOpGas, limit *big.Int) *big.Int {
+	spent := new(big.Int).Sub(paid, preOpGas)
+	if spent.Sign() <= 0 {
+		return new(big.Int)
+	}
+	used := new(big.Int).Mul(spent, big.NewInt(10))
+	used.Sub(used, limit)
+	used.Add(used, big.NewInt(8))
+	used.Quo(used, big.NewInt(9))
+	if used.Sign() <= 0 || used.Cmp(spent) > 0 {
+		// Outside what the penalty can produce; fall back to the
+		// conservative figure.
+		return spent
+	}
+	return used
+}
+
+func withBuffer(v *big.Int, pct uint64) *big.Int {
+	out := new(big.Int).Mul(v, new(big.Int).SetUint64(100+pct))
+	out.Add(out, big.NewInt(99))
+	return out.Quo(out, big.NewInt(100))
+}
+
+// Calldata cost constants for PreVerificationGas, as used by the reference
+// bundler for a bundle of one.
+const (
+	pvgFixed       = 21_000
+	pvgPerUserOp   = 18_300
+	pvgPerWord     = 4
+	pvgZeroByte    = 4
+	pvgNonZeroByte = 16
+)
+
+// PreVerificationGas estimates the bundle overhead attributable to u when
+// submitted alone: intrinsic transaction gas plus the calldata cost of the
+// ABI-encoded packed operation.
+func PreVerificationGas(u *UserOp) (*big.Int, error) {
+	p, err := u.Pack()
+	if err != nil {
+		return nil, err
+	}
+	// Dummy values make the estimate independent of the fields being sized.
+	p.PreVerificationGas = big.NewInt(1e6)
+	if len(p.Signature) == 0 {
+		p.Signature = make([]byte, 65)
+	}
+	encoded, err := abi.Arguments{{Type: packedOpType}}.Pack(p)
+	if err != nil {
+		return nil, fmt.Errorf("userop: encode op: %w", err)
+	}
+	encoded = encoded[32:] // drop the tuple offset, as calldata for handleOps does
+	cost := uint64(pvgFixed + pvgPerUserOp)
+	for _, b := range encoded {
+		if b == 0 {
+			cost += pvgZeroByte
+		} else {
+			cost += pvgNonZeroByte
+		}
+	}
+	cost += pvgPerWord * uint64((len(encoded)+31)/32)
+	return new(big.Int).SetUint64(cost), nil
+}
+
+var packedOpType = paymasterABI.Methods["simulateValidation"].Inputs[0].Type
+
+// RevertData extracts revert data from an eth_call error, or nil.
+func RevertData(err error) []byte {
+	var de interface{ ErrorData() interface{} }
+	if !errors.As(err, &de) {
+		return nil
+	}
+	s, ok := de.ErrorData().(string)
+	if !ok {
+		return nil
+	}
+	b, err := hexutil.Decode(s)
+	if err != nil {
+		return nil
+	}
+	return b
+}
+
+// SimulationRevert is a decoded simulation revert.
+type SimulationRevert struct {
+	// Name is the error name, e.g. "FailedOp" or "EMPTY_MESSAGE_VALUE".
+	Name string
+	// OpIndex and Reason are set for FailedOp and FailedOpWithRevert; Reason
+	// carries the EntryPoint's AAxx code.
+	OpIndex *big.Int
+	Reason  string
+	// Inner is the nested revert data of FailedOpWithRevert.
+	Inner []byte
+}
+
+// SimulationError is returned when a simulation reverts.
+type SimulationError struct {
+	Method string
+	// Revert is nil when the revert data matched no known error.
+	Revert *SimulationRevert
+	Err    error
+}
+
+func (e *SimulationError) Error() string {
+	if e.Revert == nil {
+		return fmt.Sprintf("userop: %s: %v", e.Method, e.Err)
+	}
+	if e.Revert.Reason != "" {
+		return fmt.Sprintf("userop: %s: %s(%s)", e.Method, e.Revert.Name, e.Revert.Reason)
+	}
+	return fmt.Sprintf("userop: %s: %s", e.Method, e.Revert.Name)
+}
+
+func (e *SimulationError) Unwrap() error { return e.Err }
+
+// entryPointErrors are the EntryPoint v0.7 errors a simulation can revert with.
+var entryPointErrors = func() map[[4]byte]abi.Error {
+	uintT, _ := abi.NewType("uint256", "", nil)
+	strT, _ := abi.NewType("string", "", nil)
+	bytesT, _ := abi.NewType("bytes", "", nil)
+	errs := []abi.Error{
+		abi.NewError("FailedOp", abi.Arguments{{Name: "opIndex", Type: uintT}, {Name: "reason", Type: strT}}),
+		abi.NewError("FailedOpWithRevert", abi.Arguments{{Name: "opIndex", Type: uintT}, {Name: "reason", Type: strT}, {Name: "inner", Type: bytesT}}),
+	}
+	for _, e := range paymasterABI.Errors {
+		errs = append(errs, e)
+	}
+	out := make(map[[4]byte]abi.Error, len(errs))
+	for _, e := range errs {
+		var id [4]byte
+		copy(id[:], e.ID[:4])
+		out[id] = e
+	}
+	return out
+}()
+
+// DecodeSimulationRevert decodes revert data from the paymaster or the
+// EntryPoint, returning nil when it matches no known error.
+func DecodeSimulationRevert(data []byte) *SimulationRevert {
+	if len(data) < 4 {
+		return nil
+	}
+	var id [4]byte
+	copy(id[:], data[:4])
+	e, ok := entryPointErrors[id]
+	if !ok {
+		return nil
+	}
+	vals, err := e.Inputs.Unpack(data[4:])
+	if err != nil {
+		return nil
+	}
+	r := &SimulationRevert{Name: e.Name}
+	if len(vals) >= 2 {
+		r.OpIndex, _ = vals[0].(*big.Int)
+		r.Reason, _ = vals[1].(string)
+	}
+	if len(vals) == 3 {
+		r.Inner, _ = vals[2].([]byte)
+	}
+	return r
+}
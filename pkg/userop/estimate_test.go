@@ -0,0 +1,195 @@
+package userop
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/ethclient/gethclient"
+)
+
+type dataError struct{ data string }
+
+func (e dataError) Error() string          { return "execution reverted" }
+func (e dataError) ErrorData() interface{} { return e.data }
+
+// fakeSimulations answers the paymaster simulations with fixed gas figures,
+// the EntryPoint's own simulateValidation with the account's share of them,
+// and calculateRefund with the local mirror.
+type fakeSimulations struct {
+	accountGas, preOpGas, executionGas uint64
+	revert                             []byte
+}
+
+func (f *fakeSimulations) CallContract(_ context.Context, msg ethereum.CallMsg, _ *big.Int, overrides *map[common.Address]gethclient.OverrideAccount) ([]byte, error) {
+	m, err := paymasterABI.MethodById(msg.Data)
+	if err != nil {
+		return nil, err
+	}
+	args, err := m.Inputs.Unpack(msg.Data[4:])
+	if err != nil {
+		return nil, err
+	}
+	switch m.Name {
+	case "calculateRefund":
+		r, err := Refund(args[0].(*big.Int), args[1].(*big.Int), args[2].(*big.Int), args[3].(*big.Int))
+		if err != nil {
+			return nil, err
+		}
+		return m.Outputs.Pack(r)
+	}
+	if overrides == nil || len((*overrides)[EntryPointV07].Code) == 0 {
+		return nil, errors.New("missing EntryPoint override")
+	}
+	if f.revert != nil {
+		return nil, dataError{hexutil.Encode(f.revert)}
+	}
+	var packed PackedUserOperation
+	abi.ConvertType(args[0], &packed)
+	u, _ := Unpack(packed)
+	pvg := u.PreVerificationGas.Uint64()
+	if *msg.To == EntryPointV07 {
+		if m.Name != "simulateValidation" || u.Paymaster != nil || u.MaxFeePerGas.Sign() != 0 {
+			return nil, errors.New("unexpected EntryPoint simulation")
+		}
+		var res ValidationResult
+		res.ReturnInfo.PreOpGas = new(big.Int).SetUint64(pvg + f.accountGas)
+		res.ReturnInfo.Prefund, res.ReturnInfo.AccountValidationData, res.ReturnInfo.PaymasterValidationData = new(big.Int), new(big.Int), new(big.Int)
+		res.ReturnInfo.PaymasterContext = []byte{}
+		zeroStake(&res)
+		return m.Outputs.Pack(res)
+	}
+	if msg.Value == nil || msg.Value.Sign() == 0 {
+		return nil, errors.New("missing value")
+	}
+	if bal := (*overrides)[msg.From].Balance; bal == nil || bal.Cmp(msg.Value) < 0 {
+		return nil, errors.New("insufficient funds for value")
+	}
+	switch m.Name {
+	case "simulateValidation":
+		var res ValidationResult
+		res.ReturnInfo.PreOpGas = new(big.Int).SetUint64(pvg + f.preOpGas)
+		res.ReturnInfo.Prefund, res.ReturnInfo.AccountValidationData, res.ReturnInfo.PaymasterValidationData = new(big.Int), new(big.Int), new(big.Int)
+		res.ReturnInfo.PaymasterContext = []byte{}
+		zeroStake(&res)
+		return m.Outputs.Pack(res)
+	default:
+		limit := u.CallGasLimit.Uint64() + u.Paymaster.PostOpGasLimit.Uint64()
+		paid := pvg + f.preOpGas + f.executionGas + (limit-f.executionGas)/10
+		return m.Outputs.Pack(ExecutionResult{
+			PreOpGas:                new(big.Int).SetUint64(pvg + f.preOpGas),
+			Paid:                    new(big.Int).SetUint64(paid),
+			AccountValidationData:   new(big.Int),
+			PaymasterValidationData: new(big.Int),
+			TargetSuccess:           true,
+			TargetResult:            []byte{},
+		})
+	}
+}
+
+func zeroStake(r *ValidationResult) {
+	for _, s := range []*struct{ a, b **big.Int }{
+		{&r.SenderInfo.Stake, &r.SenderInfo.UnstakeDelaySec},
+		{&r.FactoryInfo.Stake, &r.FactoryInfo.UnstakeDelaySec},
+		{&r.PaymasterInfo.Stake, &r.PaymasterInfo.UnstakeDelaySec},
+		{&r.AggregatorInfo.StakeInfo.Stake, &r.AggregatorInfo.StakeInfo.UnstakeDelaySec},
+	} {
+		*s.a, *s.b = new(big.Int), new(big.Int)
+	}
+}
+
+func TestEstimate(t *testing.T) {
+	f := &fakeSimulations{accountGas: 60_000, preOpGas: 80_000, executionGas: 200_000}
+	e := &Estimator{
+		Calls:          f,
+		From:           common.Address{0xb0},
+		Paymaster:      common.Address{0x77},
+		SimulationCode: []byte{0x60, 0x00},
+		PremiumBps:     big.NewInt(500),
+		PostOpGas:      big.NewInt(50_000),
+	}
+	u := sampleOp()
+	u.Signature = make([]byte, 65)
+	est, err := e.Estimate(context.Background(), u)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if est.ValidationGas.Uint64() != 80_000 || est.PaymasterValidationGas.Uint64() != 20_000 {
+		t.Fatalf("validation gas = %s, paymaster %s", est.ValidationGas, est.PaymasterValidationGas)
+	}
+	if d := int64(est.ExecutionGas.Uint64()) - 200_000; d < 0 || d > 1 {
+		t.Fatalf("execution gas = %s, want ~200000", est.ExecutionGas)
+	}
+	if est.CallGasLimit.Uint64() != est.ExecutionGas.Uint64()*120/100 || est.VerificationGasLimit.Uint64() != 78_000 ||
+		est.PaymasterVerificationGasLimit.Uint64() != 26_000 {
+		t.Fatalf("limits = %+v", est)
+	}
+	if u.VerificationGasLimit.Int64() != 10e6 || u.Paymaster != nil {
+		t.Fatal("input op modified")
+	}
+
+	final := e.Apply(u, est)
+	if err := final.SetSuperNativePaymaster(e.Paymaster, est.PaymasterVerificationGasLimit, est.PaymasterPostOpGasLimit, e.PremiumBps, e.PostOpGas); err != nil {
+		t.Fatal(err)
+	}
+	if pvg, _ := PreVerificationGas(final); pvg.Cmp(est.PreVerificationGas) > 0 || pvg.Uint64() <= pvgFixed+pvgPerUserOp {
+		t.Fatalf("final op needs %s preVerificationGas, estimated %s", pvg, est.PreVerificationGas)
+	}
+	if est.MaxCost.Cmp(final.MaxCost()) != 0 {
+		t.Fatalf("max cost = %s, want %s", est.MaxCost, final.MaxCost())
+	}
+	if new(big.Int).Add(est.PredictedCost, est.PredictedRefund).Cmp(est.MaxCost) != 0 || est.PredictedRefund.Sign() <= 0 {
+		t.Fatalf("cost %s + refund %s != max %s", est.PredictedCost, est.PredictedRefund, est.MaxCost)
+	}
+	unused := new(big.Int).Add(est.CallGasLimit, est.PaymasterPostOpGasLimit)
+	unused.Sub(unused, est.ExecutionGas)
+	if want := new(big.Int).Mul(unused.Quo(unused, big.NewInt(10)), u.MaxFeePerGas); est.Penalty.Cmp(want) != 0 || want.Sign() <= 0 {
+		t.Fatalf("penalty = %s, want %s", est.Penalty, want)
+	}
+}
+
+func TestUnusedGasPenalty(t *testing.T) {
+	if got := unusedGasPenalty(big.NewInt(1_000_000), big.NewInt(300_000)); got.Int64() != 70_000 {
+		t.Fatalf("penalty = %s", got)
+	}
+	if got := unusedGasPenalty(big.NewInt(300_000), big.NewInt(300_001)); got.Sign() != 0 {
+		t.Fatalf("penalty over the limit = %s", got)
+	}
+}
+
+func TestEstimateRevert(t *testing.T) {
+	failed, _ := entryPointErrors[[4]byte{0x22, 0x02, 0x66, 0xb6}].Inputs.Pack(big.NewInt(0), "AA33 reverted")
+	f := &fakeSimulations{revert: append([]byte{0x22, 0x02, 0x66, 0xb6}, failed...)}
+	e := &Estimator{Calls: f, Paymaster: common.Address{0x77}, SimulationCode: []byte{0x00}}
+	_, err := e.Estimate(context.Background(), sampleOp())
+	var se *SimulationError
+	if !errors.As(err, &se) || se.Revert == nil || se.Revert.Name != "FailedOp" || se.Revert.Reason != "AA33 reverted" {
+		t.Fatalf("err = %v", err)
+	}
+
+	empty := paymasterABI.Errors["EMPTY_MESSAGE_VALUE"].ID
+	if r := DecodeSimulationRevert(empty[:4]); r == nil || r.Name != "EMPTY_MESSAGE_VALUE" {
+		t.Fatalf("paymaster error = %+v", r)
+	}
+	if DecodeSimulationRevert([]byte{1, 2, 3, 4}) != nil {
+		t.Fatal("unknown selector decoded")
+	}
+}
+
+func TestExecutionGasUsed(t *testing.T) {
+	// 10 * (paid - preOp) - limit = 9 * used
+	limit := big.NewInt(1_000_000)
+	paid := big.NewInt(1_000 + 300_000 + 70_000)
+	if got := executionGasUsed(paid, big.NewInt(1_000), limit); got.Int64() != 300_000 {
+		t.Fatalf("used = %s", got)
+	}
+	if got := executionGasUsed(big.NewInt(10), big.NewInt(10), limit); got.Sign() != 0 {
+		t.Fatalf("used = %s", got)
+	}
+}
@@ -0,0 +1,88 @@
+package userop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// MaxNodeOperatorPremium mirrors SuperNativePaymaster.MAX_NODE_OPERATOR_PREMIUM:
+// premiums are in basis points on top of the actual gas cost.
+const MaxNodeOperatorPremium = 10_000
+
+// ErrInvalidPremium mirrors ISuperNativePaymaster.INVALID_NODE_OPERATOR_PREMIUM.
+var ErrInvalidPremium = errors.New("userop: node operator premium above 10000 bps")
+
+// NativePaymasterData is the paymaster-specific part of paymasterAndData
+// decoded by SuperNativePaymaster._validatePaymasterUserOp.
+type NativePaymasterData struct {
+	// MaxGasLimit bounds the refund calculation; normally TotalGasLimit.
+	MaxGasLimit *big.Int
+	// NodeOperatorPremium is in basis points, at most MaxNodeOperatorPremium.
+	NodeOperatorPremium *big.Int
+	// PostOpGas is added to the actual gas cost in postOp.
+	PostOpGas *big.Int
+}
+
+var nativePaymasterArgs = abi.Arguments{{Type: uint256Type}, {Type: uint256Type}, {Type: uint256Type}}
+
+// Encode returns abi.encode(maxGasLimit, nodeOperatorPremium, postOpGas).
+func (d *NativePaymasterData) Encode() ([]byte, error) {
+	if d.NodeOperatorPremium != nil && d.NodeOperatorPremium.Cmp(big.NewInt(MaxNodeOperatorPremium)) > 0 {
+		return nil, ErrInvalidPremium
+	}
+	return nativePaymasterArgs.Pack(orZero(d.MaxGasLimit), orZero(d.NodeOperatorPremium), orZero(d.PostOpGas))
+}
+
+// DecodeNativePaymasterData decodes the data that follows the static
+// paymaster fields.
+func DecodeNativePaymasterData(data []byte) (*NativePaymasterData, error) {
+	vals, err := nativePaymasterArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("userop: decode paymaster data: %w", err)
+	}
+	return &NativePaymasterData{
+		MaxGasLimit:         vals[0].(*big.Int),
+		NodeOperatorPremium: vals[1].(*big.Int),
+		PostOpGas:           vals[2].(*big.Int),
+	}, nil
+}
+
+// SetSuperNativePaymaster attaches paymaster with the given gas limits and
+// premium. maxGasLimit is set to the operation's TotalGasLimit, so gas limits
+// must be final before calling it.
+func (u *UserOp) SetSuperNativePaymaster(paymaster common.Address, verificationGasLimit, postOpGasLimit, premiumBps, postOpGas *big.Int) error {
+	u.Paymaster = &Paymaster{Address: paymaster, VerificationGasLimit: verificationGasLimit, PostOpGasLimit: postOpGasLimit}
+	data, err := (&NativePaymasterData{MaxGasLimit: u.TotalGasLimit(), NodeOperatorPremium: premiumBps, PostOpGas: postOpGas}).Encode()
+	if err != nil {
+		u.Paymaster = nil
+		return err
+	}
+	u.Paymaster.Data = data
+	return nil
+}
+
+// MaxCost is the most the operation can cost at MaxFeePerGas, the amount a
+// bundler must send with SuperNativePaymaster.handleOps.
+func (u *UserOp) MaxCost() *big.Int {
+	return new(big.Int).Mul(u.TotalGasLimit(), orZero(u.MaxFeePerGas))
+}
+
+// Refund mirrors SuperNativePaymaster.calculateRefund. Nil arguments count
+// as zero.
+func Refund(maxGasLimit, maxFeePerGas, actualGasCost, premiumBps *big.Int) (*big.Int, error) {
+	maxGasLimit, maxFeePerGas, actualGasCost, premiumBps = orZero(maxGasLimit), orZero(maxFeePerGas), orZero(actualGasCost), orZero(premiumBps)
+	if premiumBps.Cmp(big.NewInt(MaxNodeOperatorPremium)) > 0 {
+		return nil, ErrInvalidPremium
+	}
+	cost := new(big.Int).Mul(actualGasCost, new(big.Int).Add(big.NewInt(MaxNodeOperatorPremium), premiumBps))
+	cost.Quo(cost, big.NewInt(MaxNodeOperatorPremium))
+	maxCost := new(big.Int).Mul(maxGasLimit, maxFeePerGas)
+	if cost.Cmp(maxCost) >= 0 {
+		return new(big.Int), nil
+	}
+	return maxCost.Sub(maxCost, cost), nil
+}
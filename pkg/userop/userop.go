@@ -0,0 +1,245 @@
+// Package userop builds ERC-4337 v0.7 user operations for Superform smart
+// accounts.
+//
+// A UserOp holds the unpacked fields; Pack produces the PackedUserOperation
+// the bindings and the EntryPoint take, with the gas limits and fees folded
+// into accountGasLimits/gasFees and the paymaster fields laid out in
+// paymasterAndData. Hash computes the v0.7 userOpHash that SuperValidator
+// leaves commit to.
+package userop
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperNativePaymaster"
+)
+
+// EntryPointV07 is the canonical v0.7 EntryPoint deployment.
+var EntryPointV07 = common.HexToAddress("0x0000000071727De22E5E9d8BAf0edAc6f37da032")
+
+// PackedUserOperation is the binding type shared by the EntryPoint-facing
+// methods of SuperNativePaymaster.
+type PackedUserOperation = SuperNativePaymaster.PackedUserOperation
+
+// Offsets of the static paymaster fields in paymasterAndData, mirroring
+// UserOperationLib.
+const (
+	PaymasterValidationGasOffset = 20
+	PaymasterPostOpGasOffset     = 36
+	PaymasterDataOffset          = 52
+)
+
+var (
+	// ErrUint128Overflow is returned when a packed gas or fee field does not
+	// fit in 128 bits.
+	ErrUint128Overflow = errors.New("userop: value exceeds uint128")
+	// ErrShortPaymasterAndData is returned for paymasterAndData shorter than
+	// its static fields.
+	ErrShortPaymasterAndData = errors.New("userop: paymasterAndData shorter than 52 bytes")
+)
+
+// Paymaster is the unpacked content of paymasterAndData.
+type Paymaster struct {
+	Address              common.Address
+	VerificationGasLimit *big.Int
+	PostOpGasLimit       *big.Int
+	Data                 []byte
+}
+
+// UserOp is an unpacked v0.7 user operation. Nil big.Int fields pack as zero.
+type UserOp struct {
+	Sender   common.Address
+	Nonce    *big.Int
+	InitCode []byte
+	CallData []byte
+
+	VerificationGasLimit *big.Int
+	CallGasLimit         *big.Int
+	PreVerificationGas   *big.Int
+	MaxPriorityFeePerGas *big.Int
+	MaxFeePerGas         *big.Int
+
+	// Paymaster is nil for a self-funded operation.
+	Paymaster *Paymaster
+	Signature []byte
+}
+
+// PackUint128s packs two uint128 values as bytes32(high << 128 | low), the
+// layout of accountGasLimits and gasFees.
+func PackUint128s(high, low *big.Int) ([32]byte, error) {
+	var out [32]byte
+	if err := putUint128(out[:16], high); err != nil {
+		return out, err
+	}
+	if err := putUint128(out[16:], low); err != nil {
+		return out, err
+	}
+	return out, nil
+}
+
+// UnpackUint128s mirrors UserOperationLib.unpackUints.
+func UnpackUint128s(packed [32]byte) (high, low *big.Int) {
+	return new(big.Int).SetBytes(packed[:16]), new(big.Int).SetBytes(packed[16:])
+}
+
+func putUint128(dst []byte, v *big.Int) error {
+	if v == nil {
+		return nil
+	}
+	if v.Sign() < 0 || v.BitLen() > 128 {
+		return fmt.Errorf("%w: %s", ErrUint128Overflow, v)
+	}
+	v.FillBytes(dst)
+	return nil
+}
+
+// Encode returns paymasterAndData: the paymaster address, both gas limits as
+// uint128 and the paymaster-specific data, packed.
+func (p *Paymaster) Encode() ([]byte, error) {
+	out := make([]byte, PaymasterDataOffset, PaymasterDataOffset+len(p.Data))
+	copy(out, p.Address[:])
+	if err := putUint128(out[PaymasterValidationGasOffset:PaymasterPostOpGasOffset], p.VerificationGasLimit); err != nil {
+		return nil, fmt.Errorf("paymaster verification gas: %w", err)
+	}
+	if err := putUint128(out[PaymasterPostOpGasOffset:PaymasterDataOffset], p.PostOpGasLimit); err != nil {
+		return nil, fmt.Errorf("paymaster postOp gas: %w", err)
+	}
+	return append(out, p.Data...), nil
+}
+
+// DecodePaymaster mirrors UserOperationLib.unpackPaymasterStaticFields. It
+// returns nil for empty paymasterAndData.
+func DecodePaymaster(paymasterAndData []byte) (*Paymaster, error) {
+	if len(paymasterAndData) == 0 {
+		return nil, nil
+	}
+	if len(paymasterAndData) < PaymasterDataOffset {
+		return nil, ErrShortPaymasterAndData
+	}
+	return &Paymaster{
+		Address:              common.BytesToAddress(paymasterAndData[:PaymasterValidationGasOffset]),
+		VerificationGasLimit: new(big.Int).SetBytes(paymasterAndData[PaymasterValidationGasOffset:PaymasterPostOpGasOffset]),
+		PostOpGasLimit:       new(big.Int).SetBytes(paymasterAndData[PaymasterPostOpGasOffset:PaymasterDataOffset]),
+		Data:                 common.CopyBytes(paymasterAndData[PaymasterDataOffset:]),
+	}, nil
+}
+
+// Pack returns the PackedUserOperation for u.
+func (u *UserOp) Pack() (PackedUserOperation, error) {
+	var p PackedUserOperation
+	var err error
+	if p.AccountGasLimits, err = PackUint128s(u.VerificationGasLimit, u.CallGasLimit); err != nil {
+		return p, fmt.Errorf("userop: accountGasLimits: %w", err)
+	}
+	if p.GasFees, err = PackUint128s(u.MaxPriorityFeePerGas, u.MaxFeePerGas); err != nil {
+		return p, fmt.Errorf("userop: gasFees: %w", err)
+	}
+	if u.Paymaster != nil {
+		if p.PaymasterAndData, err = u.Paymaster.Encode(); err != nil {
+			return p, fmt.Errorf("userop: %w", err)
+		}
+	}
+	p.Sender = u.Sender
+	p.Nonce = orZero(u.Nonce)
+	p.InitCode = orEmpty(u.InitCode)
+	p.CallData = orEmpty(u.CallData)
+	p.PreVerificationGas = orZero(u.PreVerificationGas)
+	p.PaymasterAndData = orEmpty(p.PaymasterAndData)
+	p.Signature = orEmpty(u.Signature)
+	return p, nil
+}
+
+// Unpack returns the UserOp packed in p.
+func Unpack(p PackedUserOperation) (*UserOp, error) {
+	pm, err := DecodePaymaster(p.PaymasterAndData)
+	if err != nil {
+		return nil, err
+	}
+	u := &UserOp{
+		Sender:             p.Sender,
+		Nonce:              new(big.Int).Set(orZero(p.Nonce)),
+		InitCode:           common.CopyBytes(p.InitCode),
+		CallData:           common.CopyBytes(p.CallData),
+		PreVerificationGas: new(big.Int).Set(orZero(p.PreVerificationGas)),
+		Paymaster:          pm,
+		Signature:          common.CopyBytes(p.Signature),
+	}
+	u.VerificationGasLimit, u.CallGasLimit = UnpackUint128s(p.AccountGasLimits)
+	u.MaxPriorityFeePerGas, u.MaxFeePerGas = UnpackUint128s(p.GasFees)
+	return u, nil
+}
+
+// Hash returns the v0.7 userOpHash of u for entryPoint on chainID.
+func (u *UserOp) Hash(entryPoint common.Address, chainID *big.Int) (common.Hash, error) {
+	p, err := u.Pack()
+	if err != nil {
+		return common.Hash{}, err
+	}
+	return HashPacked(p, entryPoint, chainID), nil
+}
+
+var (
+	bytes32Type, _ = abi.NewType("bytes32", "", nil)
+	uint256Type, _ = abi.NewType("uint256", "", nil)
+	addressType, _ = abi.NewType("address", "", nil)
+
+	// encodeArgs is UserOperationLib.encode.
+	encodeArgs = abi.Arguments{
+		{Type: addressType}, {Type: uint256Type}, {Type: bytes32Type}, {Type: bytes32Type},
+		{Type: bytes32Type}, {Type: uint256Type}, {Type: bytes32Type}, {Type: bytes32Type},
+	}
+	// hashArgs is EntryPoint.getUserOpHash's outer encoding.
+	hashArgs = abi.Arguments{{Type: bytes32Type}, {Type: addressType}, {Type: uint256Type}}
+)
+
+// HashPacked mirrors EntryPoint.getUserOpHash (v0.7):
+// keccak256(abi.encode(keccak256(UserOperationLib.encode(op)), entryPoint, chainId)).
+func HashPacked(p PackedUserOperation, entryPoint common.Address, chainID *big.Int) common.Hash {
+	inner, err := encodeArgs.Pack(
+		p.Sender, orZero(p.Nonce), crypto.Keccak256Hash(p.InitCode), crypto.Keccak256Hash(p.CallData),
+		p.AccountGasLimits, orZero(p.PreVerificationGas), p.GasFees, crypto.Keccak256Hash(p.PaymasterAndData),
+	)
+	if err != nil {
+		// Every argument is statically typed above; Pack cannot fail.
+		panic(err)
+	}
+	outer, err := hashArgs.Pack(crypto.Keccak256Hash(inner), entryPoint, orZero(chainID))
+	if err != nil {
+		panic(err)
+	}
+	return crypto.Keccak256Hash(outer)
+}
+
+// TotalGasLimit is the sum of every gas limit the operation can be charged
+// for, the value SuperNativePaymaster expects as maxGasLimit.
+func (u *UserOp) TotalGasLimit() *big.Int {
+	total := new(big.Int)
+	for _, v := range []*big.Int{u.VerificationGasLimit, u.CallGasLimit, u.PreVerificationGas} {
+		total.Add(total, orZero(v))
+	}
+	if u.Paymaster != nil {
+		total.Add(total, orZero(u.Paymaster.VerificationGasLimit))
+		total.Add(total, orZero(u.Paymaster.PostOpGasLimit))
+	}
+	return total
+}
+
+func orZero(v *big.Int) *big.Int {
+	if v == nil {
+		return new(big.Int)
+	}
+	return v
+}
+
+func orEmpty(b []byte) []byte {
+	if b == nil {
+		return []byte{}
+	}
+	return b
+}
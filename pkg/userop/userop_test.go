@@ -0,0 +1,165 @@
+package userop
+
+import (
+	"bytes"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+
+	"github.com/superform-xyz/v2-core/pkg/executor"
+)
+
+func sampleOp() *UserOp {
+	return &UserOp{
+		Sender:               common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Nonce:                big.NewInt(7),
+		CallData:             []byte{0xde, 0xad},
+		VerificationGasLimit: big.NewInt(10e6),
+		CallGasLimit:         big.NewInt(10e6),
+		PreVerificationGas:   big.NewInt(10e6),
+		MaxPriorityFeePerGas: big.NewInt(4e6),
+		MaxFeePerGas:         big.NewInt(1e8),
+		Signature:            []byte{0x12, 0x34},
+	}
+}
+
+// TestPaymasterLayout mirrors the PaymasterPoC setup: three 10e6 limits on
+// the op, two on the paymaster, zero premium and 10e6 postOp gas.
+func TestPaymasterLayout(t *testing.T) {
+	u := sampleOp()
+	pm := common.HexToAddress("0x2222222222222222222222222222222222222222")
+	if err := u.SetSuperNativePaymaster(pm, big.NewInt(10e6), big.NewInt(10e6), big.NewInt(0), big.NewInt(10e6)); err != nil {
+		t.Fatal(err)
+	}
+	p, err := u.Pack()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	want := append(append([]byte{}, pm[:]...), common.LeftPadBytes(big.NewInt(10e6).Bytes(), 16)...)
+	want = append(want, common.LeftPadBytes(big.NewInt(10e6).Bytes(), 16)...)
+	want = append(want, common.LeftPadBytes(big.NewInt(50e6).Bytes(), 32)...)
+	want = append(want, make([]byte, 32)...)
+	want = append(want, common.LeftPadBytes(big.NewInt(10e6).Bytes(), 32)...)
+	if !bytes.Equal(p.PaymasterAndData, want) {
+		t.Fatalf("paymasterAndData =\n%x\nwant\n%x", p.PaymasterAndData, want)
+	}
+	if got := hexutil.Encode(p.GasFees[:]); got != "0x000000000000000000000000003d090000000000000000000000000005f5e100" {
+		t.Fatalf("gasFees = %s", got)
+	}
+
+	back, err := Unpack(p)
+	if err != nil {
+		t.Fatal(err)
+	}
+	data, err := DecodeNativePaymasterData(back.Paymaster.Data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if back.CallGasLimit.Int64() != 10e6 || back.MaxFeePerGas.Int64() != 1e8 || data.MaxGasLimit.Int64() != 50e6 {
+		t.Fatalf("unpacked = %+v, data = %+v", back, data)
+	}
+
+	if err := u.SetSuperNativePaymaster(pm, nil, nil, big.NewInt(10_001), nil); err != ErrInvalidPremium {
+		t.Fatalf("premium: %v", err)
+	}
+	if _, err := PackUint128s(new(big.Int).Lsh(big.NewInt(1), 128), nil); err == nil {
+		t.Fatal("expected uint128 overflow")
+	}
+}
+
+// TestHash checks a fixed getUserOpHash vector. The tree carries no
+// EntryPoint bytecode to call, so the vector was computed outside Go from
+// UserOperationLib.encode and EntryPoint.getUserOpHash: keccak256(abi.encode(
+// keccak256(encode(op)), entryPoint, chainid)). Every field is distinct so
+// a swapped uint128 pair or field changes the hash.
+func TestHash(t *testing.T) {
+	u := &UserOp{
+		Sender:               common.HexToAddress("0x1111111111111111111111111111111111111111"),
+		Nonce:                big.NewInt(7),
+		InitCode:             common.FromHex("0x3333333333333333333333333333333333333333abcdef"),
+		CallData:             []byte{0xde, 0xad},
+		VerificationGasLimit: big.NewInt(1_000_000),
+		CallGasLimit:         big.NewInt(2_000_000),
+		PreVerificationGas:   big.NewInt(3_000_000),
+		MaxPriorityFeePerGas: big.NewInt(4e6),
+		MaxFeePerGas:         big.NewInt(1e8),
+		Paymaster: &Paymaster{
+			Address:              common.HexToAddress("0x2222222222222222222222222222222222222222"),
+			VerificationGasLimit: big.NewInt(500_000),
+			PostOpGasLimit:       big.NewInt(600_000),
+			Data:                 []byte{0xca, 0xfe},
+		},
+		Signature: []byte{0x12, 0x34},
+	}
+	want := common.HexToHash("0x0554ea668388488d629adc1fc81c1a621fa9554e98947fb69fd260f4b8c7b3c9")
+
+	got, err := u.Hash(EntryPointV07, big.NewInt(8453))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got != want {
+		t.Fatalf("hash = %s, want %s", got.Hex(), want.Hex())
+	}
+	// The signature is not part of the hash.
+	u.Signature = []byte{0xff}
+	if again, _ := u.Hash(EntryPointV07, big.NewInt(8453)); again != got {
+		t.Fatal("signature changed the hash")
+	}
+}
+
+func TestRefund(t *testing.T) {
+	for _, tc := range []struct {
+		actual  int64
+		premium *big.Int
+		want    int64
+	}{
+		{500, nil, 500},
+		{500, big.NewInt(0), 500},
+		{500, big.NewInt(1000), 450},
+		{1000, big.NewInt(1), 0},
+	} {
+		got, err := Refund(big.NewInt(100), big.NewInt(10), big.NewInt(tc.actual), tc.premium)
+		if err != nil || got.Int64() != tc.want {
+			t.Errorf("Refund(%d, %v) = %v, %v, want %d", tc.actual, tc.premium, got, err, tc.want)
+		}
+	}
+	if _, err := Refund(big.NewInt(100), big.NewInt(10), big.NewInt(1), big.NewInt(MaxNodeOperatorPremium+1)); !errors.Is(err, ErrInvalidPremium) {
+		t.Fatalf("premium over the cap: %v", err)
+	}
+}
+
+func TestAccountExecuteCalldata(t *testing.T) {
+	entry := executor.NewExecutorEntry().Append(common.Address{0x0a}, []byte{0x01})
+	superExecutor := common.Address{0xee}
+	u := sampleOp()
+	if err := u.SetSuperExecutorCall(superExecutor, entry); err != nil {
+		t.Fatal(err)
+	}
+	if sel := hexutil.Encode(u.CallData[:4]); sel != "0xe9ae5c53" {
+		t.Fatalf("selector = %s", sel)
+	}
+	args, err := accountABI.Methods["execute"].Inputs.Unpack(u.CallData[4:])
+	if err != nil {
+		t.Fatal(err)
+	}
+	packed := args[1].([]byte)
+	inner, _ := entry.ExecuteCalldata()
+	if args[0].([32]byte) != ModeSingle || common.BytesToAddress(packed[:20]) != superExecutor || !bytes.Equal(packed[52:], inner) {
+		t.Fatalf("single execution = %x", packed)
+	}
+
+	batch, err := AccountExecuteCalldata(Execution{Target: superExecutor, CallData: inner}, Execution{Target: common.Address{1}, Value: big.NewInt(1)})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if args, _ := accountABI.Methods["execute"].Inputs.Unpack(batch[4:]); args[0].([32]byte) != ModeBatch {
+		t.Fatal("batch mode not set")
+	}
+	if _, err := SuperExecutorCall(superExecutor, executor.NewExecutorEntry()); err != executor.ErrNoHooks {
+		t.Fatalf("empty entry: %v", err)
+	}
+}
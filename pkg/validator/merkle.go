@@ -0,0 +1,94 @@
+package validator
+
+import (
+	"bytes"
+	"errors"
+
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// ErrNoLeaves is returned when building a tree without leaves.
+var ErrNoLeaves = errors.New("validator: at least one leaf required")
+
+// Tree is a merkle tree of sorted-pair hashes. Leaves are padded to a power
+// of two by repeating the last leaf, matching the test suite's
+// MerkleTreeHelper._createValidatorMerkleTree.
+type Tree struct {
+	leaves int
+	levels [][][32]byte
+}
+
+// NewTree builds a tree over leaves.
+func NewTree(leaves [][32]byte) (*Tree, error) {
+	if len(leaves) == 0 {
+		return nil, ErrNoLeaves
+	}
+	n := 1
+	for n < len(leaves) {
+		n <<= 1
+	}
+	nodes := make([][32]byte, n)
+	copy(nodes, leaves)
+	for i := len(leaves); i < n; i++ {
+		nodes[i] = leaves[len(leaves)-1]
+	}
+
+	t := &Tree{leaves: len(leaves), levels: [][][32]byte{nodes}}
+	for level := nodes; len(level) > 1; {
+		next := make([][32]byte, len(level)/2)
+		for i := range next {
+			next[i] = hashPair(level[2*i], level[2*i+1])
+		}
+		t.levels = append(t.levels, next)
+		level = next
+	}
+	return t, nil
+}
+
+// Root returns the merkle root.
+func (t *Tree) Root() [32]byte {
+	return t.levels[len(t.levels)-1][0]
+}
+
+// Len returns the number of leaves the tree was built from, excluding padding.
+func (t *Tree) Len() int {
+	return t.leaves
+}
+
+// Leaf returns leaf i.
+func (t *Tree) Leaf(i int) [32]byte {
+	return t.levels[0][i]
+}
+
+// Proof returns the proof for leaf i.
+func (t *Tree) Proof(i int) [][32]byte {
+	proof := make([][32]byte, 0, len(t.levels)-1)
+	for _, level := range t.levels[:len(t.levels)-1] {
+		proof = append(proof, level[i^1])
+		i /= 2
+	}
+	return proof
+}
+
+// VerifyProof reports whether proof links leaf to root, as OpenZeppelin's
+// MerkleProof.verify does.
+func VerifyProof(proof [][32]byte, root, leaf [32]byte) bool {
+	return ProcessProof(proof, leaf) == root
+}
+
+// ProcessProof returns the root obtained by walking proof up from leaf.
+func ProcessProof(proof [][32]byte, leaf [32]byte) [32]byte {
+	h := leaf
+	for _, sibling := range proof {
+		h = hashPair(h, sibling)
+	}
+	return h
+}
+
+// hashPair hashes a and b in sorted order.
+func hashPair(a, b [32]byte) [32]byte {
+	if bytes.Compare(a[:], b[:]) > 0 {
+		a, b = b, a
+	}
+	return crypto.Keccak256Hash(a[:], b[:])
+}
@@ -0,0 +1,222 @@
+package validator
+
+import (
+	"crypto/ecdsa"
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+var (
+	sourceLeafArgs = abi.Arguments{
+		{Type: bytes32Type},
+		{Type: uint48Type},
+		{Type: uint48Type},
+		{Type: uint64ArrayType},
+		{Type: addressType},
+	}
+	destinationLeafArgs = abi.Arguments{
+		{Type: bytesType},
+		{Type: uint64Type},
+		{Type: addressType},
+		{Type: addressType},
+		{Type: addressArrayType},
+		{Type: uint256ArrayType},
+		{Type: uint48Type},
+		{Type: addressType},
+	}
+)
+
+// SourceLeaf computes SuperValidator._createLeaf for a userOp hash signed
+// for validator.
+func SourceLeaf(userOpHash [32]byte, validUntil, validAfter uint64, chainsWithDestinationExecution []uint64, validator common.Address) ([32]byte, error) {
+	if validUntil > MaxUint48 || validAfter > MaxUint48 {
+		return [32]byte{}, ErrTimestampOverflow
+	}
+	enc, err := sourceLeafArgs.Pack(
+		userOpHash,
+		new(big.Int).SetUint64(validUntil),
+		new(big.Int).SetUint64(validAfter),
+		orEmpty(chainsWithDestinationExecution),
+		validator,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return doubleHash(enc), nil
+}
+
+// DestinationLeaf computes SuperValidatorBase._createDestinationLeaf for d
+// checked by the destination validator.
+func DestinationLeaf(d *DestinationData, validUntil uint64, validator common.Address) ([32]byte, error) {
+	if validUntil > MaxUint48 {
+		return [32]byte{}, ErrTimestampOverflow
+	}
+	enc, err := destinationLeafArgs.Pack(
+		orEmpty(d.CallData),
+		d.ChainId,
+		d.Sender,
+		d.Executor,
+		orEmpty(d.DstTokens),
+		orEmpty(d.IntentAmounts),
+		new(big.Int).SetUint64(validUntil),
+		validator,
+	)
+	if err != nil {
+		return [32]byte{}, err
+	}
+	return doubleHash(enc), nil
+}
+
+// MessageHash returns the hash the owner signs for root:
+// keccak256(abi.encode(Namespace, root)).
+func MessageHash(root [32]byte) [32]byte {
+	enc, _ := namespacedArgs.Pack(Namespace, root)
+	return crypto.Keccak256Hash(enc)
+}
+
+// SignRoot signs root with an EOA key, producing the 65-byte r||s||v
+// signature the validators recover with ECDSA.recover.
+func SignRoot(key *ecdsa.PrivateKey, root [32]byte) ([]byte, error) {
+	msg := MessageHash(root)
+	sig, err := crypto.Sign(accounts.TextHash(msg[:]), key)
+	if err != nil {
+		return nil, err
+	}
+	sig[crypto.RecoveryIDOffset] += 27
+	return sig, nil
+}
+
+// RecoverSigner returns the EOA that signed root.
+func RecoverSigner(root [32]byte, sig []byte) (common.Address, error) {
+	if len(sig) != crypto.SignatureLength {
+		return common.Address{}, fmt.Errorf("validator: signature length %d", len(sig))
+	}
+	s := make([]byte, crypto.SignatureLength)
+	copy(s, sig)
+	if s[crypto.RecoveryIDOffset] >= 27 {
+		s[crypto.RecoveryIDOffset] -= 27
+	}
+	msg := MessageHash(root)
+	pub, err := crypto.SigToPub(accounts.TextHash(msg[:]), s)
+	if err != nil {
+		return common.Address{}, err
+	}
+	return crypto.PubkeyToAddress(*pub), nil
+}
+
+// Destination is one destination execution committed to by a userOp.
+type Destination struct {
+	ChainID uint64
+	Info    DstInfo
+}
+
+// UserOp is a source userOp to include in a signed batch.
+type UserOp struct {
+	Hash [32]byte
+	// Validator is the SuperValidator the userOp is validated by.
+	Validator common.Address
+	// Destinations lists the destination executions, in the order the
+	// signature's chainsWithDestinationExecution will list them.
+	Destinations []Destination
+}
+
+// Batch is a set of userOps and their destination executions signed under a
+// single merkle root.
+type Batch struct {
+	ValidUntil uint64
+	ValidAfter uint64
+	Ops        []UserOp
+}
+
+// SignedBatch is the result of signing a Batch.
+type SignedBatch struct {
+	Tree      *Tree
+	Signature []byte
+	// Signatures holds the SignatureData for each userOp, in batch order.
+	Signatures []*SignatureData
+}
+
+// Root returns the signed merkle root.
+func (s *SignedBatch) Root() [32]byte {
+	return s.Tree.Root()
+}
+
+// Sign builds the merkle tree over b, signs its root with key and assembles
+// the SignatureData of every userOp. Leaves are ordered as each userOp's
+// source leaf followed by its destination leaves.
+func (b *Batch) Sign(key *ecdsa.PrivateKey) (*SignedBatch, error) {
+	if len(b.Ops) == 0 {
+		return nil, errors.New("validator: empty batch")
+	}
+	type opIndex struct {
+		src int
+		dst []int
+	}
+	var (
+		leaves  [][32]byte
+		indexes = make([]opIndex, len(b.Ops))
+	)
+	for i, op := range b.Ops {
+		leaf, err := SourceLeaf(op.Hash, b.ValidUntil, b.ValidAfter, op.chains(), op.Validator)
+		if err != nil {
+			return nil, err
+		}
+		indexes[i].src = len(leaves)
+		leaves = append(leaves, leaf)
+		for _, d := range op.Destinations {
+			p := DstProof{DstChainId: d.ChainID, Info: d.Info}
+			leaf, err := DestinationLeaf(p.DestinationData(), b.ValidUntil, d.Info.Validator)
+			if err != nil {
+				return nil, err
+			}
+			indexes[i].dst = append(indexes[i].dst, len(leaves))
+			leaves = append(leaves, leaf)
+		}
+	}
+
+	tree, err := NewTree(leaves)
+	if err != nil {
+		return nil, err
+	}
+	sig, err := SignRoot(key, tree.Root())
+	if err != nil {
+		return nil, err
+	}
+
+	out := &SignedBatch{Tree: tree, Signature: sig, Signatures: make([]*SignatureData, len(b.Ops))}
+	for i, op := range b.Ops {
+		sd := &SignatureData{
+			ChainsWithDestinationExecution: op.chains(),
+			ValidUntil:                     b.ValidUntil,
+			ValidAfter:                     b.ValidAfter,
+			MerkleRoot:                     tree.Root(),
+			ProofSrc:                       tree.Proof(indexes[i].src),
+			ProofDst:                       make([]DstProof, len(op.Destinations)),
+			Signature:                      sig,
+		}
+		for j, d := range op.Destinations {
+			sd.ProofDst[j] = DstProof{Proof: tree.Proof(indexes[i].dst[j]), DstChainId: d.ChainID, Info: d.Info}
+		}
+		out.Signatures[i] = sd
+	}
+	return out, nil
+}
+
+func (op *UserOp) chains() []uint64 {
+	chains := make([]uint64, len(op.Destinations))
+	for i, d := range op.Destinations {
+		chains[i] = d.ChainID
+	}
+	return chains
+}
+
+func doubleHash(enc []byte) [32]byte {
+	inner := crypto.Keccak256(enc)
+	return crypto.Keccak256Hash(inner)
+}
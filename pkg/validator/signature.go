@@ -0,0 +1,288 @@
+// Package validator implements the merkle signature scheme checked by
+// SuperValidator and SuperDestinationValidator.
+//
+// A single owner signature covers a merkle root whose leaves are source
+// userOp hashes (see SourceLeaf) and destination executions (see
+// DestinationLeaf). Each leaf is proven with a sorted-pair merkle proof, as
+// verified by OpenZeppelin's MerkleProof.
+package validator
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// Namespace is the validator namespace mixed into the signed message hash.
+const Namespace = "SuperValidator"
+
+// MaxUint48 bounds validUntil and validAfter, which are uint48 on-chain.
+const MaxUint48 = 1<<48 - 1
+
+// ErrTimestampOverflow is returned when validUntil or validAfter exceed uint48.
+var ErrTimestampOverflow = errors.New("validator: timestamp exceeds uint48")
+
+// DstInfo mirrors ISuperValidator.DstInfo: the destination execution a
+// source signature commits to.
+type DstInfo struct {
+	Account       common.Address
+	Executor      common.Address
+	DstTokens     []common.Address
+	IntentAmounts []*big.Int
+	Validator     common.Address
+	Data          []byte
+}
+
+// DstProof mirrors ISuperValidator.DstProof.
+type DstProof struct {
+	Proof      [][32]byte
+	DstChainId uint64
+	Info       DstInfo
+}
+
+// DestinationData mirrors ISuperValidator.DestinationData, the payload
+// SuperDestinationExecutor hands to SuperDestinationValidator.
+type DestinationData struct {
+	CallData      []byte
+	ChainId       uint64
+	Sender        common.Address
+	Executor      common.Address
+	DstTokens     []common.Address
+	IntentAmounts []*big.Int
+}
+
+// DestinationData returns the destination data a DstProof commits to.
+func (p *DstProof) DestinationData() *DestinationData {
+	return &DestinationData{
+		CallData:      p.Info.Data,
+		ChainId:       p.DstChainId,
+		Sender:        p.Info.Account,
+		Executor:      p.Info.Executor,
+		DstTokens:     p.Info.DstTokens,
+		IntentAmounts: p.Info.IntentAmounts,
+	}
+}
+
+// SignatureData mirrors ISuperValidator.SignatureData.
+type SignatureData struct {
+	ChainsWithDestinationExecution []uint64
+	ValidUntil                     uint64
+	ValidAfter                     uint64
+	MerkleRoot                     [32]byte
+	ProofSrc                       [][32]byte
+	ProofDst                       []DstProof
+	Signature                      []byte
+}
+
+// ProofFor returns the first destination proof for chainID, the way
+// SuperDestinationValidator selects it.
+func (s *SignatureData) ProofFor(chainID uint64) (*DstProof, bool) {
+	for i := range s.ProofDst {
+		if s.ProofDst[i].DstChainId == chainID {
+			return &s.ProofDst[i], true
+		}
+	}
+	return nil, false
+}
+
+var (
+	dstProofArrayType, _ = abi.NewType("tuple[]", "struct ISuperValidator.DstProof[]", []abi.ArgumentMarshaling{
+		{Name: "proof", Type: "bytes32[]"},
+		{Name: "dstChainId", Type: "uint64"},
+		{Name: "info", Type: "tuple", InternalType: "struct ISuperValidator.DstInfo", Components: []abi.ArgumentMarshaling{
+			{Name: "account", Type: "address"},
+			{Name: "executor", Type: "address"},
+			{Name: "dstTokens", Type: "address[]"},
+			{Name: "intentAmounts", Type: "uint256[]"},
+			{Name: "validator", Type: "address"},
+			{Name: "data", Type: "bytes"},
+		}},
+	})
+	uint64ArrayType, _  = abi.NewType("uint64[]", "", nil)
+	uint64Type, _       = abi.NewType("uint64", "", nil)
+	uint48Type, _       = abi.NewType("uint48", "", nil)
+	bytes32Type, _      = abi.NewType("bytes32", "", nil)
+	bytes32ArrayType, _ = abi.NewType("bytes32[]", "", nil)
+	bytesType, _        = abi.NewType("bytes", "", nil)
+	addressType, _      = abi.NewType("address", "", nil)
+	addressArrayType, _ = abi.NewType("address[]", "", nil)
+	uint256ArrayType, _ = abi.NewType("uint256[]", "", nil)
+	stringType, _       = abi.NewType("string", "", nil)
+
+	// signatureDataArgs is the tuple _decodeSignatureData expects.
+	signatureDataArgs = abi.Arguments{
+		{Type: uint64ArrayType},
+		{Type: uint48Type},
+		{Type: uint48Type},
+		{Type: bytes32Type},
+		{Type: bytes32ArrayType},
+		{Type: dstProofArrayType},
+		{Type: bytesType},
+	}
+	// destinationDataArgs is the tuple _decodeDestinationData expects.
+	destinationDataArgs = abi.Arguments{
+		{Type: bytesType},
+		{Type: uint64Type},
+		{Type: addressType},
+		{Type: addressType},
+		{Type: addressArrayType},
+		{Type: uint256ArrayType},
+	}
+	bytesArgs      = abi.Arguments{{Type: bytesType}}
+	bytesPairArgs  = abi.Arguments{{Type: bytesType}, {Type: bytesType}}
+	namespacedArgs = abi.Arguments{{Type: stringType}, {Type: bytes32Type}}
+)
+
+// abiDstInfo and abiDstProof carry field names abi.Pack matches against the
+// tuple components.
+type abiDstInfo struct {
+	Account       common.Address
+	Executor      common.Address
+	DstTokens     []common.Address
+	IntentAmounts []*big.Int
+	Validator     common.Address
+	Data          []byte
+}
+
+type abiDstProof struct {
+	Proof      [][32]byte
+	DstChainId uint64
+	Info       abiDstInfo
+}
+
+// Encode ABI-encodes s as SuperValidatorBase._decodeSignatureData reads it.
+func (s *SignatureData) Encode() ([]byte, error) {
+	if s.ValidUntil > MaxUint48 || s.ValidAfter > MaxUint48 {
+		return nil, ErrTimestampOverflow
+	}
+	proofs := make([]abiDstProof, len(s.ProofDst))
+	for i, p := range s.ProofDst {
+		proofs[i] = abiDstProof{
+			Proof:      orEmpty(p.Proof),
+			DstChainId: p.DstChainId,
+			Info: abiDstInfo{
+				Account:       p.Info.Account,
+				Executor:      p.Info.Executor,
+				DstTokens:     orEmpty(p.Info.DstTokens),
+				IntentAmounts: orEmpty(p.Info.IntentAmounts),
+				Validator:     p.Info.Validator,
+				Data:          orEmpty(p.Info.Data),
+			},
+		}
+	}
+	return signatureDataArgs.Pack(
+		orEmpty(s.ChainsWithDestinationExecution),
+		new(big.Int).SetUint64(s.ValidUntil),
+		new(big.Int).SetUint64(s.ValidAfter),
+		s.MerkleRoot,
+		orEmpty(s.ProofSrc),
+		proofs,
+		orEmpty(s.Signature),
+	)
+}
+
+// DecodeSignatureData decodes ABI-encoded signature data.
+func DecodeSignatureData(data []byte) (*SignatureData, error) {
+	vals, err := signatureDataArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("validator: decode signature data: %w", err)
+	}
+	proofs := abi.ConvertType(vals[5], new([]abiDstProof)).(*[]abiDstProof)
+	s := &SignatureData{
+		ChainsWithDestinationExecution: vals[0].([]uint64),
+		ValidUntil:                     vals[1].(*big.Int).Uint64(),
+		ValidAfter:                     vals[2].(*big.Int).Uint64(),
+		MerkleRoot:                     vals[3].([32]byte),
+		ProofSrc:                       vals[4].([][32]byte),
+		ProofDst:                       make([]DstProof, len(*proofs)),
+		Signature:                      vals[6].([]byte),
+	}
+	for i, p := range *proofs {
+		s.ProofDst[i] = DstProof{Proof: p.Proof, DstChainId: p.DstChainId, Info: DstInfo(p.Info)}
+	}
+	return s, nil
+}
+
+// Encode ABI-encodes d as SuperDestinationValidator._decodeDestinationData
+// reads it.
+func (d *DestinationData) Encode() ([]byte, error) {
+	return destinationDataArgs.Pack(
+		orEmpty(d.CallData),
+		d.ChainId,
+		d.Sender,
+		d.Executor,
+		orEmpty(d.DstTokens),
+		orEmpty(d.IntentAmounts),
+	)
+}
+
+// DecodeDestinationData decodes ABI-encoded destination data.
+func DecodeDestinationData(data []byte) (*DestinationData, error) {
+	vals, err := destinationDataArgs.Unpack(data)
+	if err != nil {
+		return nil, fmt.Errorf("validator: decode destination data: %w", err)
+	}
+	return &DestinationData{
+		CallData:      vals[0].([]byte),
+		ChainId:       vals[1].(uint64),
+		Sender:        vals[2].(common.Address),
+		Executor:      vals[3].(common.Address),
+		DstTokens:     vals[4].([]common.Address),
+		IntentAmounts: vals[5].([]*big.Int),
+	}, nil
+}
+
+// EncodeERC1271Signature wraps encoded signature data the way
+// SuperValidator.isValidSignatureWithSender expects: abi.encode(bytes).
+func EncodeERC1271Signature(sig *SignatureData) ([]byte, error) {
+	raw, err := sig.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return bytesArgs.Pack(raw)
+}
+
+// EncodeDestinationSignature builds the data argument of
+// SuperDestinationValidator.isValidDestinationSignature:
+// abi.encode(bytes sigData, bytes destinationData).
+func EncodeDestinationSignature(sig *SignatureData, dst *DestinationData) ([]byte, error) {
+	rawSig, err := sig.Encode()
+	if err != nil {
+		return nil, err
+	}
+	rawDst, err := dst.Encode()
+	if err != nil {
+		return nil, err
+	}
+	return bytesPairArgs.Pack(rawSig, rawDst)
+}
+
+// DecodeDestinationSignature splits isValidDestinationSignature data back into
+// its signature and destination parts.
+func DecodeDestinationSignature(data []byte) (*SignatureData, *DestinationData, error) {
+	vals, err := bytesPairArgs.Unpack(data)
+	if err != nil {
+		return nil, nil, fmt.Errorf("validator: decode destination signature: %w", err)
+	}
+	sig, err := DecodeSignatureData(vals[0].([]byte))
+	if err != nil {
+		return nil, nil, err
+	}
+	dst, err := DecodeDestinationData(vals[1].([]byte))
+	if err != nil {
+		return nil, nil, err
+	}
+	return sig, dst, nil
+}
+
+// orEmpty replaces a nil slice with an empty one so the encoding matches
+// Solidity's for empty arrays.
+func orEmpty[T any](s []T) []T {
+	if s == nil {
+		return []T{}
+	}
+	return s
+}
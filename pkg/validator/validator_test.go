@@ -0,0 +1,162 @@
+package validator
+
+import (
+	"bytes"
+	"math/big"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+
+	"github.com/superform-xyz/v2-core/pkg/harness"
+)
+
+func TestTreeProofs(t *testing.T) {
+	for n := 1; n <= 9; n++ {
+		leaves := make([][32]byte, n)
+		for i := range leaves {
+			leaves[i] = crypto.Keccak256Hash([]byte{byte(i)})
+		}
+		tree, err := NewTree(leaves)
+		if err != nil {
+			t.Fatal(err)
+		}
+		for i, leaf := range leaves {
+			if !VerifyProof(tree.Proof(i), tree.Root(), leaf) {
+				t.Fatalf("n=%d: proof for leaf %d does not verify", n, i)
+			}
+		}
+		if n == 1 && (tree.Root() != leaves[0] || len(tree.Proof(0)) != 0) {
+			t.Fatal("single leaf tree must have the leaf as root and an empty proof")
+		}
+	}
+	if _, err := NewTree(nil); err != ErrNoLeaves {
+		t.Fatalf("err = %v, want ErrNoLeaves", err)
+	}
+}
+
+func TestSignatureDataRoundTrip(t *testing.T) {
+	sd := &SignatureData{
+		ChainsWithDestinationExecution: []uint64{8453},
+		ValidUntil:                     MaxUint48,
+		ValidAfter:                     1,
+		MerkleRoot:                     [32]byte{1},
+		ProofSrc:                       [][32]byte{{2}},
+		ProofDst: []DstProof{{
+			Proof:      [][32]byte{{3}},
+			DstChainId: 8453,
+			Info: DstInfo{
+				Account:       common.Address{4},
+				Executor:      common.Address{5},
+				DstTokens:     []common.Address{{6}},
+				IntentAmounts: []*big.Int{big.NewInt(7)},
+				Validator:     common.Address{8},
+				Data:          []byte{9},
+			},
+		}},
+		Signature: bytes.Repeat([]byte{10}, 65),
+	}
+	enc, err := sd.Encode()
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := DecodeSignatureData(enc)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, sd) {
+		t.Fatalf("round trip mismatch:\n got %+v\nwant %+v", got, sd)
+	}
+
+	sd.ValidUntil = MaxUint48 + 1
+	if _, err := sd.Encode(); err != ErrTimestampOverflow {
+		t.Fatalf("err = %v, want ErrTimestampOverflow", err)
+	}
+}
+
+func TestValidatorsAcceptSignedBatch(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	sim, chainID, auth := stack.Backend, stack.ChainID, stack.Auth
+	owner, ownerAddr := stack.Key, stack.Auth.From
+	src, srcAddr := stack.Validator, stack.Addresses["SuperValidator"]
+	dst, dstAddr := stack.DestinationValidator, stack.Addresses["SuperDestinationValidator"]
+
+	// The owner EOA stands in for the smart account: it installs both
+	// validators with itself as owner.
+	installData := common.LeftPadBytes(ownerAddr.Bytes(), 32)
+	if _, err := src.OnInstall(auth, installData); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := dst.OnInstall(auth, installData); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	destination := Destination{
+		ChainID: chainID.Uint64(),
+		Info: DstInfo{
+			Account:       ownerAddr,
+			Executor:      common.HexToAddress("0x00000000000000000000000000000000000000e1"),
+			DstTokens:     []common.Address{common.HexToAddress("0x00000000000000000000000000000000000000a1")},
+			IntentAmounts: []*big.Int{big.NewInt(1_000_000)},
+			Validator:     dstAddr,
+			Data:          []byte{0xde, 0xad},
+		},
+	}
+	batch := &Batch{
+		ValidUntil: uint64(time.Now().Add(time.Hour).Unix()),
+		Ops: []UserOp{
+			{Hash: crypto.Keccak256Hash([]byte("op-1")), Validator: srcAddr, Destinations: []Destination{destination}},
+			{Hash: crypto.Keccak256Hash([]byte("op-2")), Validator: srcAddr},
+		},
+	}
+	signed, err := batch.Sign(owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if signer, err := RecoverSigner(signed.Root(), signed.Signature); err != nil || signer != ownerAddr {
+		t.Fatalf("RecoverSigner = %s, %v", signer, err)
+	}
+
+	magic := [4]byte{0x16, 0x26, 0xba, 0x7e}
+	opts := &bind.CallOpts{From: ownerAddr}
+	for i, op := range batch.Ops {
+		data, err := EncodeERC1271Signature(signed.Signatures[i])
+		if err != nil {
+			t.Fatal(err)
+		}
+		got, err := src.IsValidSignatureWithSender(opts, ownerAddr, op.Hash, data)
+		if err != nil {
+			t.Fatalf("op %d: %v", i, err)
+		}
+		if got != magic {
+			t.Fatalf("op %d: isValidSignatureWithSender = %x", i, got)
+		}
+	}
+
+	// A hash outside the tree must fail the source proof.
+	data, _ := EncodeERC1271Signature(signed.Signatures[1])
+	if _, err := src.IsValidSignatureWithSender(opts, ownerAddr, [32]byte{0xff}, data); err == nil {
+		t.Fatal("expected revert for a userOp hash outside the tree")
+	}
+
+	sig := signed.Signatures[0]
+	data, err = EncodeDestinationSignature(sig, sig.ProofDst[0].DestinationData())
+	if err != nil {
+		t.Fatal(err)
+	}
+	got, err := dst.IsValidDestinationSignature(opts, ownerAddr, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if want := [4]byte{0x5c, 0x2e, 0xc0, 0xf3}; got != want {
+		t.Fatalf("isValidDestinationSignature = %x", got)
+	}
+}
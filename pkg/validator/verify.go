@@ -0,0 +1,222 @@
+package validator
+
+import (
+	"fmt"
+	"math/big"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// Reason identifies why a signature was rejected. Reasons that the contracts
+// revert with carry the custom error's name; the others correspond to the
+// validator returning a non-magic value.
+type Reason string
+
+const (
+	ReasonNotInitialized         Reason = "NOT_INITIALIZED"
+	ReasonInvalidProof           Reason = "INVALID_PROOF"
+	ReasonProofNotFound          Reason = "PROOF_NOT_FOUND"
+	ReasonEmptyDestinationProof  Reason = "EMPTY_DESTINATION_PROOF"
+	ReasonProofCountMismatch     Reason = "PROOF_COUNT_MISMATCH"
+	ReasonUnexpectedChainProof   Reason = "UNEXPECTED_CHAIN_PROOF"
+	ReasonInvalidMerkleProof     Reason = "INVALID_MERKLE_PROOF"
+	ReasonInvalidSignature       Reason = "ECDSAInvalidSignature"
+	ReasonInvalidSignatureLength Reason = "ECDSAInvalidSignatureLength"
+	ReasonInvalidSignatureS      Reason = "ECDSAInvalidSignatureS"
+	ReasonMalformed              Reason = "malformed signature data"
+	ReasonNotYetValid            Reason = "signature not yet valid"
+	ReasonExpired                Reason = "signature expired"
+	ReasonInvalidWindow          Reason = "validAfter after validUntil"
+	ReasonSignerMismatch         Reason = "signer is not the account owner"
+)
+
+// Reverts reports whether the contracts revert with a custom error for r,
+// rather than returning a non-magic value.
+func (r Reason) Reverts() bool {
+	switch r {
+	case ReasonMalformed, ReasonNotYetValid, ReasonExpired, ReasonInvalidWindow, ReasonSignerMismatch:
+		return false
+	}
+	return true
+}
+
+// VerifyError is returned when a signature would be rejected on-chain.
+type VerifyError struct {
+	Reason Reason
+	Detail string
+}
+
+func (e *VerifyError) Error() string {
+	if e.Detail == "" {
+		return "validator: " + string(e.Reason)
+	}
+	return fmt.Sprintf("validator: %s: %s", e.Reason, e.Detail)
+}
+
+func reject(r Reason, format string, args ...any) *VerifyError {
+	return &VerifyError{Reason: r, Detail: fmt.Sprintf(format, args...)}
+}
+
+// secp256k1 half order; OpenZeppelin's ECDSA rejects s above it.
+var secp256k1HalfN = new(big.Int).Rsh(crypto.S256().Params().N, 1)
+
+// Verifier checks signatures the way a deployed SuperValidator or
+// SuperDestinationValidator would, without an RPC round-trip.
+//
+// Only EOA owners are supported: EIP-1271 owners and EIP-7702 accounts need
+// on-chain code and are out of scope.
+type Verifier struct {
+	// Validator is the address of the validator contract; it is part of
+	// every leaf.
+	Validator common.Address
+	// ChainID is the chain the validator runs on.
+	ChainID uint64
+	// Now stands in for block.timestamp. time.Now is used when nil.
+	Now func() time.Time
+}
+
+// VerifyDestination mirrors SuperDestinationValidator.isValidDestinationSignature
+// for sender. owner is the validator's GetAccountOwner(sender); the zero
+// address means the validator is not installed. data is the call's data
+// argument, abi.encode(bytes sigData, bytes destinationData).
+func (v *Verifier) VerifyDestination(sender, owner common.Address, data []byte) error {
+	if owner == (common.Address{}) {
+		return reject(ReasonNotInitialized, "account %s", sender)
+	}
+	sig, dst, err := DecodeDestinationSignature(data)
+	if err != nil {
+		return &VerifyError{Reason: ReasonMalformed, Detail: err.Error()}
+	}
+	return v.VerifyDestinationData(sender, owner, sig, dst)
+}
+
+// VerifyDestinationData is VerifyDestination on already decoded data.
+func (v *Verifier) VerifyDestinationData(sender, owner common.Address, sig *SignatureData, dst *DestinationData) error {
+	if owner == (common.Address{}) {
+		return reject(ReasonNotInitialized, "account %s", sender)
+	}
+	leaf, err := DestinationLeaf(dst, sig.ValidUntil, v.Validator)
+	if err != nil {
+		return &VerifyError{Reason: ReasonMalformed, Detail: err.Error()}
+	}
+	proof, ok := sig.ProofFor(v.ChainID)
+	if !ok {
+		return reject(ReasonProofNotFound, "chain %d", v.ChainID)
+	}
+	if !VerifyProof(proof.Proof, sig.MerkleRoot, leaf) {
+		return reject(ReasonInvalidProof, "destination leaf %x", leaf)
+	}
+	signer, err := recoverECDSA(sig)
+	if err != nil {
+		return err
+	}
+	// The destination validator only checks validUntil.
+	return v.checkSigner(signer, owner, sig.ValidUntil, 0)
+}
+
+// VerifyERC1271 mirrors SuperValidator.isValidSignatureWithSender called by
+// sender for hash. data is abi.encode(bytes sigData).
+func (v *Verifier) VerifyERC1271(sender, owner common.Address, hash [32]byte, data []byte) error {
+	if owner == (common.Address{}) {
+		return reject(ReasonNotInitialized, "account %s", sender)
+	}
+	vals, err := bytesArgs.Unpack(data)
+	if err != nil {
+		return &VerifyError{Reason: ReasonMalformed, Detail: err.Error()}
+	}
+	sig, err := DecodeSignatureData(vals[0].([]byte))
+	if err != nil {
+		return &VerifyError{Reason: ReasonMalformed, Detail: err.Error()}
+	}
+	return v.verifySource(owner, hash, sig)
+}
+
+// VerifyUserOp mirrors SuperValidator.validateUserOp for a userOp hash and its
+// decoded signature, including the destination proofs it carries.
+func (v *Verifier) VerifyUserOp(sender, owner common.Address, userOpHash [32]byte, sig *SignatureData) error {
+	if owner == (common.Address{}) {
+		return reject(ReasonNotInitialized, "account %s", sender)
+	}
+	if err := v.verifySource(owner, userOpHash, sig); err != nil {
+		return err
+	}
+	if len(sig.ChainsWithDestinationExecution) == 0 {
+		return nil
+	}
+	if len(sig.ProofDst) == 0 {
+		return reject(ReasonEmptyDestinationProof, "")
+	}
+	if len(sig.ProofDst) != len(sig.ChainsWithDestinationExecution) {
+		return reject(ReasonProofCountMismatch, "%d proofs for %d chains", len(sig.ProofDst), len(sig.ChainsWithDestinationExecution))
+	}
+	for i := range sig.ProofDst {
+		p := &sig.ProofDst[i]
+		if p.DstChainId != sig.ChainsWithDestinationExecution[i] {
+			return reject(ReasonUnexpectedChainProof, "proof %d is for chain %d, want %d", i, p.DstChainId, sig.ChainsWithDestinationExecution[i])
+		}
+		leaf, err := DestinationLeaf(p.DestinationData(), sig.ValidUntil, p.Info.Validator)
+		if err != nil {
+			return &VerifyError{Reason: ReasonMalformed, Detail: err.Error()}
+		}
+		if !VerifyProof(p.Proof, sig.MerkleRoot, leaf) {
+			return reject(ReasonInvalidMerkleProof, "proof %d for chain %d", i, p.DstChainId)
+		}
+	}
+	return nil
+}
+
+func (v *Verifier) verifySource(owner common.Address, hash [32]byte, sig *SignatureData) error {
+	leaf, err := SourceLeaf(hash, sig.ValidUntil, sig.ValidAfter, sig.ChainsWithDestinationExecution, v.Validator)
+	if err != nil {
+		return &VerifyError{Reason: ReasonMalformed, Detail: err.Error()}
+	}
+	if !VerifyProof(sig.ProofSrc, sig.MerkleRoot, leaf) {
+		return reject(ReasonInvalidProof, "source leaf %x", leaf)
+	}
+	signer, err := recoverECDSA(sig)
+	if err != nil {
+		return err
+	}
+	return v.checkSigner(signer, owner, sig.ValidUntil, sig.ValidAfter)
+}
+
+// checkSigner mirrors SuperValidatorBase._isSignatureValid.
+func (v *Verifier) checkSigner(signer, owner common.Address, validUntil, validAfter uint64) error {
+	now := time.Now
+	if v.Now != nil {
+		now = v.Now
+	}
+	ts := uint64(now().Unix())
+	switch {
+	case ts < validAfter:
+		return reject(ReasonNotYetValid, "valid after %d, now %d", validAfter, ts)
+	case validUntil != 0 && validUntil < ts:
+		return reject(ReasonExpired, "valid until %d, now %d", validUntil, ts)
+	case validUntil != 0 && validAfter > validUntil:
+		return reject(ReasonInvalidWindow, "valid after %d, until %d", validAfter, validUntil)
+	case signer != owner:
+		return reject(ReasonSignerMismatch, "signer %s, owner %s", signer, owner)
+	}
+	return nil
+}
+
+// recoverECDSA mirrors SuperValidatorBase._processECDSASignature, including
+// the checks OpenZeppelin's ECDSA.recover reverts on.
+func recoverECDSA(sig *SignatureData) (common.Address, error) {
+	if len(sig.Signature) != crypto.SignatureLength {
+		return common.Address{}, reject(ReasonInvalidSignatureLength, "length %d", len(sig.Signature))
+	}
+	if new(big.Int).SetBytes(sig.Signature[32:64]).Cmp(secp256k1HalfN) > 0 {
+		return common.Address{}, reject(ReasonInvalidSignatureS, "")
+	}
+	if v := sig.Signature[crypto.RecoveryIDOffset]; v != 27 && v != 28 {
+		return common.Address{}, reject(ReasonInvalidSignature, "v = %d", v)
+	}
+	signer, err := RecoverSigner(sig.MerkleRoot, sig.Signature)
+	if err != nil {
+		return common.Address{}, reject(ReasonInvalidSignature, "%v", err)
+	}
+	return signer, nil
+}
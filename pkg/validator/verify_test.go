@@ -0,0 +1,102 @@
+package validator
+
+import (
+	"errors"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+func reasonOf(err error) Reason {
+	var verr *VerifyError
+	if errors.As(err, &verr) {
+		return verr.Reason
+	}
+	return ""
+}
+
+func TestVerifier(t *testing.T) {
+	owner, _ := crypto.GenerateKey()
+	ownerAddr := crypto.PubkeyToAddress(owner.PublicKey)
+	srcValidator := common.HexToAddress("0x0000000000000000000000000000000000005001")
+	dstValidator := common.HexToAddress("0x0000000000000000000000000000000000005002")
+	now := time.Unix(1_700_000_000, 0)
+
+	batch := &Batch{
+		ValidUntil: uint64(now.Add(time.Hour).Unix()),
+		ValidAfter: uint64(now.Add(-time.Hour).Unix()),
+		Ops: []UserOp{{
+			Hash:      crypto.Keccak256Hash([]byte("op")),
+			Validator: srcValidator,
+			Destinations: []Destination{{
+				ChainID: 8453,
+				Info: DstInfo{
+					Account:       ownerAddr,
+					Executor:      common.Address{0xe1},
+					DstTokens:     []common.Address{{0xa1}},
+					IntentAmounts: []*big.Int{big.NewInt(100)},
+					Validator:     dstValidator,
+					Data:          []byte{1, 2, 3},
+				},
+			}},
+		}},
+	}
+	signed, err := batch.Sign(owner)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sig := signed.Signatures[0]
+	dst := sig.ProofDst[0].DestinationData()
+
+	clock := func() time.Time { return now }
+	srcV := &Verifier{Validator: srcValidator, ChainID: 1, Now: clock}
+	dstV := &Verifier{Validator: dstValidator, ChainID: 8453, Now: clock}
+
+	if err := srcV.VerifyUserOp(ownerAddr, ownerAddr, batch.Ops[0].Hash, sig); err != nil {
+		t.Fatalf("source: %v", err)
+	}
+	data, _ := EncodeDestinationSignature(sig, dst)
+	if err := dstV.VerifyDestination(ownerAddr, ownerAddr, data); err != nil {
+		t.Fatalf("destination: %v", err)
+	}
+
+	tampered := *dst
+	tampered.IntentAmounts = []*big.Int{big.NewInt(101)}
+	late := &Verifier{Validator: dstValidator, ChainID: 8453, Now: func() time.Time { return now.Add(2 * time.Hour) }}
+	wrongChain := &Verifier{Validator: dstValidator, ChainID: 10, Now: clock}
+	stranger := common.Address{0x99}
+
+	badSig := *sig
+	badSig.Signature = append([]byte(nil), sig.Signature...)
+	badSig.Signature[64] = 0
+
+	shortProofs := *sig
+	shortProofs.ChainsWithDestinationExecution = []uint64{8453, 8453}
+
+	tests := []struct {
+		name string
+		err  error
+		want Reason
+	}{
+		{"not initialized", dstV.VerifyDestinationData(ownerAddr, common.Address{}, sig, dst), ReasonNotInitialized},
+		{"tampered destination", dstV.VerifyDestinationData(ownerAddr, ownerAddr, sig, &tampered), ReasonInvalidProof},
+		{"no proof for chain", wrongChain.VerifyDestinationData(ownerAddr, ownerAddr, sig, dst), ReasonProofNotFound},
+		{"expired", late.VerifyDestinationData(ownerAddr, ownerAddr, sig, dst), ReasonExpired},
+		{"wrong owner", dstV.VerifyDestinationData(ownerAddr, stranger, sig, dst), ReasonSignerMismatch},
+		{"bad v", dstV.VerifyDestinationData(ownerAddr, ownerAddr, &badSig, dst), ReasonInvalidSignature},
+		{"wrong userOp hash", srcV.VerifyUserOp(ownerAddr, ownerAddr, [32]byte{1}, sig), ReasonInvalidProof},
+		{"chains tampered", srcV.VerifyUserOp(ownerAddr, ownerAddr, batch.Ops[0].Hash, &shortProofs), ReasonInvalidProof},
+		{"malformed", dstV.VerifyDestination(ownerAddr, ownerAddr, []byte{1}), ReasonMalformed},
+	}
+	for _, tt := range tests {
+		if got := reasonOf(tt.err); got != tt.want {
+			t.Errorf("%s: reason = %q (%v), want %q", tt.name, got, tt.err, tt.want)
+		}
+	}
+	if ReasonExpired.Reverts() || !ReasonInvalidProof.Reverts() {
+		t.Error("Reverts misclassifies reasons")
+	}
+}
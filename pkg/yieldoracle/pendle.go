@@ -0,0 +1,177 @@
+package yieldoracle
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+// PendleIssue is a machine-readable problem found by PendleChecker.
+type PendleIssue string
+
+const (
+	// IssueIncreaseCardinality: the market's observation buffer is too small
+	// for the TWAP window; someone must call increaseObservationsCardinalityNext.
+	IssueIncreaseCardinality PendleIssue = "INCREASE_CARDINALITY"
+	// IssueOldestObservation: the buffer is large enough but does not yet
+	// reach back a full TWAP window, so the TWAP is not reliable yet.
+	IssueOldestObservation PendleIssue = "OLDEST_OBSERVATION_NOT_SATISFIED"
+	// IssuePriceDivergence: the oracle's price per share is further from
+	// PYLpOracle.getPtToAssetRate than the checker allows.
+	IssuePriceDivergence PendleIssue = "PRICE_DIVERGENCE"
+	// IssueZeroRate: PYLpOracle reported a zero PT-to-asset rate.
+	IssueZeroRate PendleIssue = "ZERO_RATE"
+	// IssueCallFailed: one of the calls reverted; see Errors.
+	IssueCallFailed PendleIssue = "CALL_FAILED"
+)
+
+// PendleStateOracle is the part of IPPYLpOracle the checker reads;
+// IPPYLpOracle.IPPYLpOracleCaller implements it.
+type PendleStateOracle interface {
+	GetOracleState(opts *bind.CallOpts, market common.Address, duration uint32) (struct {
+		IncreaseCardinalityRequired bool
+		CardinalityRequired         uint16
+		OldestObservationSatisfied  bool
+	}, error)
+	GetPtToAssetRate(opts *bind.CallOpts, market common.Address, duration uint32) (*big.Int, error)
+}
+
+// PendlePriceOracle is the part of PendlePT the checker reads.
+type PendlePriceOracle interface {
+	Address() common.Address
+	TWAPDuration(opts *bind.CallOpts) (uint32, error)
+	PricePerShare(opts *bind.CallOpts, market common.Address) (*big.Int, error)
+}
+
+// PendleMarketHealth is the health of one Pendle market used as a PT yield
+// source.
+type PendleMarketHealth struct {
+	Market                      common.Address `json:"market"`
+	IncreaseCardinalityRequired bool           `json:"increaseCardinalityRequired"`
+	CardinalityRequired         uint16         `json:"cardinalityRequired"`
+	OldestObservationSatisfied  bool           `json:"oldestObservationSatisfied"`
+	PricePerShare               *big.Int       `json:"pricePerShare"`
+	PtToAssetRate               *big.Int       `json:"ptToAssetRate"`
+	// DivergenceBps is |PricePerShare - PtToAssetRate| / PtToAssetRate in
+	// basis points, rounded up.
+	DivergenceBps uint64        `json:"divergenceBps"`
+	Healthy       bool          `json:"healthy"`
+	Issues        []PendleIssue `json:"issues,omitempty"`
+	Errors        []string      `json:"errors,omitempty"`
+}
+
+// PendleHealthReport is the result of PendleChecker.Check.
+type PendleHealthReport struct {
+	Oracle       common.Address       `json:"oracle"`
+	TWAPDuration uint32               `json:"twapDuration"`
+	Block        *big.Int             `json:"block,omitempty"`
+	Healthy      bool                 `json:"healthy"`
+	Markets      []PendleMarketHealth `json:"markets"`
+}
+
+// WriteJSON writes r as indented JSON.
+func (r *PendleHealthReport) WriteJSON(w io.Writer) error {
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+	return enc.Encode(r)
+}
+
+// DefaultMaxDivergenceBps is the divergence tolerated when
+// PendleChecker.MaxDivergenceBps is zero.
+const DefaultMaxDivergenceBps = 10
+
+// PendleChecker checks that the markets priced by a PendlePTYieldSourceOracle
+// can serve its TWAP window and that its prices agree with Pendle's own
+// PYLpOracle for the same window.
+type PendleChecker struct {
+	Oracle PendlePriceOracle
+	State  PendleStateOracle
+	// MaxDivergenceBps is the largest tolerated price divergence;
+	// DefaultMaxDivergenceBps when zero.
+	MaxDivergenceBps uint64
+	// Block pins the calls; nil for latest.
+	Block *big.Int
+}
+
+// Check reads the TWAP duration from the oracle once and checks every
+// market. Per-market call failures are reported as IssueCallFailed; the error
+// is reserved for failing to read the duration.
+func (c *PendleChecker) Check(ctx context.Context, markets []common.Address) (*PendleHealthReport, error) {
+	if c.Oracle == nil || c.State == nil {
+		return nil, errors.New("yieldoracle: pendle checker needs an oracle and a state oracle")
+	}
+	opts := &bind.CallOpts{Context: ctx, BlockNumber: c.Block}
+	duration, err := c.Oracle.TWAPDuration(opts)
+	if err != nil {
+		return nil, fmt.Errorf("yieldoracle: TWAP_DURATION: %w", err)
+	}
+	maxBps := c.MaxDivergenceBps
+	if maxBps == 0 {
+		maxBps = DefaultMaxDivergenceBps
+	}
+
+	rep := &PendleHealthReport{Oracle: c.Oracle.Address(), TWAPDuration: duration, Block: c.Block, Healthy: true}
+	for _, market := range markets {
+		h := PendleMarketHealth{Market: market}
+		fail := func(what string, err error) {
+			h.Errors = append(h.Errors, fmt.Sprintf("%s: %v", what, err))
+		}
+
+		if st, err := c.State.GetOracleState(opts, market, duration); err != nil {
+			fail("getOracleState", err)
+		} else {
+			h.IncreaseCardinalityRequired = st.IncreaseCardinalityRequired
+			h.CardinalityRequired = st.CardinalityRequired
+			h.OldestObservationSatisfied = st.OldestObservationSatisfied
+			if st.IncreaseCardinalityRequired {
+				h.Issues = append(h.Issues, IssueIncreaseCardinality)
+			}
+			if !st.OldestObservationSatisfied {
+				h.Issues = append(h.Issues, IssueOldestObservation)
+			}
+		}
+
+		if h.PricePerShare, err = c.Oracle.PricePerShare(opts, market); err != nil {
+			fail("getPricePerShare", err)
+		}
+		if h.PtToAssetRate, err = c.State.GetPtToAssetRate(opts, market, duration); err != nil {
+			fail("getPtToAssetRate", err)
+		}
+		if h.PricePerShare != nil && h.PtToAssetRate != nil {
+			if h.PtToAssetRate.Sign() == 0 {
+				h.Issues = append(h.Issues, IssueZeroRate)
+			} else if h.DivergenceBps = divergenceBps(h.PricePerShare, h.PtToAssetRate); h.DivergenceBps > maxBps {
+				h.Issues = append(h.Issues, IssuePriceDivergence)
+			}
+		}
+
+		if len(h.Errors) > 0 {
+			h.Issues = append(h.Issues, IssueCallFailed)
+		}
+		h.Healthy = len(h.Issues) == 0
+		rep.Healthy = rep.Healthy && h.Healthy
+		rep.Markets = append(rep.Markets, h)
+	}
+	return rep, nil
+}
+
+// divergenceBps returns |a - b| * 10000 / b rounded up, saturating at
+// MaxUint64.
+func divergenceBps(a, b *big.Int) uint64 {
+	diff := new(big.Int).Sub(a, b)
+	diff.Abs(diff).Mul(diff, big.NewInt(10_000))
+	q, r := new(big.Int).QuoRem(diff, b, new(big.Int))
+	if r.Sign() != 0 {
+		q.Add(q, big.NewInt(1))
+	}
+	if !q.IsUint64() {
+		return ^uint64(0)
+	}
+	return q.Uint64()
+}
@@ -0,0 +1,83 @@
+package yieldoracle
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+)
+
+type fakePendle struct {
+	pps             map[common.Address]*big.Int
+	rate            map[common.Address]*big.Int
+	needCardinality map[common.Address]bool
+}
+
+func (f *fakePendle) Address() common.Address                     { return common.Address{0x0b} }
+func (f *fakePendle) TWAPDuration(*bind.CallOpts) (uint32, error) { return 900, nil }
+
+func (f *fakePendle) PricePerShare(_ *bind.CallOpts, m common.Address) (*big.Int, error) {
+	if v, ok := f.pps[m]; ok {
+		return v, nil
+	}
+	return nil, errors.New("execution reverted")
+}
+
+func (f *fakePendle) GetOracleState(_ *bind.CallOpts, m common.Address, d uint32) (struct {
+	IncreaseCardinalityRequired bool
+	CardinalityRequired         uint16
+	OldestObservationSatisfied  bool
+}, error) {
+	st := struct {
+		IncreaseCardinalityRequired bool
+		CardinalityRequired         uint16
+		OldestObservationSatisfied  bool
+	}{CardinalityRequired: uint16(d / 12), OldestObservationSatisfied: true}
+	st.IncreaseCardinalityRequired = f.needCardinality[m]
+	return st, nil
+}
+
+func (f *fakePendle) GetPtToAssetRate(_ *bind.CallOpts, m common.Address, _ uint32) (*big.Int, error) {
+	return f.rate[m], nil
+}
+
+func TestPendleChecker(t *testing.T) {
+	ok, thin, drift, broken := common.Address{1}, common.Address{2}, common.Address{3}, common.Address{4}
+	e18 := big.NewInt(1e18)
+	f := &fakePendle{
+		pps:             map[common.Address]*big.Int{ok: e18, thin: e18, drift: big.NewInt(0.99e18)},
+		rate:            map[common.Address]*big.Int{ok: e18, thin: e18, drift: e18, broken: e18},
+		needCardinality: map[common.Address]bool{thin: true},
+	}
+	c := &PendleChecker{Oracle: f, State: f}
+	rep, err := c.Check(context.Background(), []common.Address{ok, thin, drift, broken})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if rep.Healthy || rep.TWAPDuration != 900 {
+		t.Fatalf("report = %+v", rep)
+	}
+	want := [][]PendleIssue{nil, {IssueIncreaseCardinality}, {IssuePriceDivergence}, {IssueCallFailed}}
+	for i, h := range rep.Markets {
+		if len(h.Issues) != len(want[i]) || (len(want[i]) > 0 && h.Issues[0] != want[i][0]) {
+			t.Errorf("market %s issues = %v, want %v", h.Market.Hex(), h.Issues, want[i])
+		}
+	}
+	if rep.Markets[2].DivergenceBps != 100 {
+		t.Errorf("divergence = %d bps", rep.Markets[2].DivergenceBps)
+	}
+
+	var buf bytes.Buffer
+	if err := rep.WriteJSON(&buf); err != nil {
+		t.Fatal(err)
+	}
+	var decoded PendleHealthReport
+	if err := json.Unmarshal(buf.Bytes(), &decoded); err != nil || len(decoded.Markets) != 4 {
+		t.Fatalf("round trip: %v", err)
+	}
+}
@@ -0,0 +1,317 @@
+// Package yieldoracle wraps the per-protocol yield source oracle bindings
+// behind one YieldSourceOracle interface.
+//
+// Every oracle under src/accounting/oracles implements IYieldSourceOracle, but
+// each has its own generated binding package. A Registry remembers which
+// contract is deployed at which address and binds the matching package, so a
+// caller holding only a yieldSourceOracleId (or the oracle address from its
+// SuperLedgerConfiguration config) gets a typed oracle back. Protocol-specific
+// views stay reachable through the concrete types, e.g. PendlePT.TWAPDuration.
+package yieldoracle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math/big"
+	"sort"
+	"sync"
+
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC4626YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC5115YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/ERC7540YieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/PendlePTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SpectraPTYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/StakingYieldSourceOracle"
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+)
+
+var (
+	// ErrUnknownKind is returned for a kind with no registered factory.
+	ErrUnknownKind = errors.New("yieldoracle: unknown kind")
+	// ErrUnknownOracle is returned for an oracle address with no known kind.
+	ErrUnknownOracle = errors.New("yieldoracle: unknown oracle")
+	// ErrNoConfig is returned by Registry.ForID for an ID with no configuration.
+	ErrNoConfig = errors.New("yieldoracle: no config for id")
+)
+
+// YieldSourceOracle is the IYieldSourceOracle surface shared by every oracle.
+// Amounts are in the units the oracle reports: shares in the yield source's
+// decimals and assets in the underlying asset's.
+type YieldSourceOracle interface {
+	// Address is the oracle contract.
+	Address() common.Address
+	// Kind is the Solidity contract name, e.g. "ERC4626YieldSourceOracle".
+	Kind() string
+
+	Decimals(opts *bind.CallOpts, yieldSource common.Address) (uint8, error)
+	PricePerShare(opts *bind.CallOpts, yieldSource common.Address) (*big.Int, error)
+	ShareOutput(opts *bind.CallOpts, yieldSource, assetIn common.Address, assetsIn *big.Int) (*big.Int, error)
+	// WithdrawalShareOutput is the shares burnt withdrawing assetsIn.
+	WithdrawalShareOutput(opts *bind.CallOpts, yieldSource, assetIn common.Address, assetsIn *big.Int) (*big.Int, error)
+	AssetOutput(opts *bind.CallOpts, yieldSource, assetOut common.Address, sharesIn *big.Int) (*big.Int, error)
+	AssetOutputWithFees(opts *bind.CallOpts, id [32]byte, yieldSource, assetOut, user common.Address, usedShares *big.Int) (*big.Int, error)
+	BalanceOfOwner(opts *bind.CallOpts, yieldSource, owner common.Address) (*big.Int, error)
+	TVL(opts *bind.CallOpts, yieldSource common.Address) (*big.Int, error)
+	TVLByOwnerOfShares(opts *bind.CallOpts, yieldSource, owner common.Address) (*big.Int, error)
+	// LedgerConfiguration is the SuperLedgerConfiguration the oracle reads
+	// fees from in AssetOutputWithFees.
+	LedgerConfiguration(opts *bind.CallOpts) (common.Address, error)
+}
+
+// Factory binds an oracle of one kind at addr.
+type Factory func(addr common.Address, caller bind.ContractCaller) (YieldSourceOracle, error)
+
+var (
+	kindsMu sync.RWMutex
+	kinds   = make(map[string]Factory)
+)
+
+// RegisterKind makes a kind available to every Registry. Registering an
+// existing kind replaces its factory, which lets callers swap in a wrapper.
+func RegisterKind(kind string, f Factory) {
+	kindsMu.Lock()
+	defer kindsMu.Unlock()
+	kinds[kind] = f
+}
+
+// Kinds returns the registered kinds, sorted.
+func Kinds() []string {
+	kindsMu.RLock()
+	defer kindsMu.RUnlock()
+	out := make([]string, 0, len(kinds))
+	for k := range kinds {
+		out = append(out, k)
+	}
+	sort.Strings(out)
+	return out
+}
+
+func factoryFor(kind string) (Factory, bool) {
+	kindsMu.RLock()
+	defer kindsMu.RUnlock()
+	f, ok := kinds[kind]
+	return f, ok
+}
+
+// Bind binds addr as an oracle of the given kind.
+func Bind(kind string, addr common.Address, caller bind.ContractCaller) (YieldSourceOracle, error) {
+	f, ok := factoryFor(kind)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+	return f(addr, caller)
+}
+
+// Registry maps oracle addresses on one chain to their kinds and binds them
+// on demand. Bound oracles are cached; a Registry is safe for concurrent use.
+type Registry struct {
+	caller        bind.ContractCaller
+	configuration *SuperLedgerConfiguration.SuperLedgerConfigurationCaller
+
+	mu     sync.Mutex
+	kinds  map[common.Address]string
+	oracle map[common.Address]YieldSourceOracle
+}
+
+// NewRegistry returns an empty Registry. configuration may be nil when ForID
+// is not needed.
+func NewRegistry(caller bind.ContractCaller, configuration *SuperLedgerConfiguration.SuperLedgerConfigurationCaller) *Registry {
+	return &Registry{
+		caller:        caller,
+		configuration: configuration,
+		kinds:         make(map[common.Address]string),
+		oracle:        make(map[common.Address]YieldSourceOracle),
+	}
+}
+
+// FromChain returns a Registry holding every oracle deployed on c whose
+// contract name is a registered kind, bound to c's SuperLedgerConfiguration.
+func FromChain(c *addressbook.Chain, caller bind.ContractCaller) (*Registry, error) {
+	var cfg *SuperLedgerConfiguration.SuperLedgerConfigurationCaller
+	if addr, err := c.Address("SuperLedgerConfiguration"); err == nil {
+		if cfg, err = SuperLedgerConfiguration.NewSuperLedgerConfigurationCaller(addr, caller); err != nil {
+			return nil, err
+		}
+	}
+	r := NewRegistry(caller, cfg)
+	for _, name := range c.Names() {
+		if _, ok := factoryFor(name); ok {
+			r.kinds[c.Contracts[name]] = name
+		}
+	}
+	return r, nil
+}
+
+// Add records that the oracle at addr is of the given kind.
+func (r *Registry) Add(addr common.Address, kind string) error {
+	if _, ok := factoryFor(kind); !ok {
+		return fmt.Errorf("%w: %s", ErrUnknownKind, kind)
+	}
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if r.kinds[addr] != kind {
+		delete(r.oracle, addr)
+	}
+	r.kinds[addr] = kind
+	return nil
+}
+
+// Kind returns the kind recorded for addr.
+func (r *Registry) Kind(addr common.Address) (string, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	k, ok := r.kinds[addr]
+	return k, ok
+}
+
+// Oracle returns the oracle at addr, bound with the package of its kind.
+func (r *Registry) Oracle(addr common.Address) (YieldSourceOracle, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if o, ok := r.oracle[addr]; ok {
+		return o, nil
+	}
+	kind, ok := r.kinds[addr]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnknownOracle, addr.Hex())
+	}
+	o, err := Bind(kind, addr, r.caller)
+	if err != nil {
+		return nil, err
+	}
+	r.oracle[addr] = o
+	return o, nil
+}
+
+// ForID looks up the configuration stored under id and returns its oracle.
+func (r *Registry) ForID(ctx context.Context, id [32]byte) (YieldSourceOracle, error) {
+	if r.configuration == nil {
+		return nil, errors.New("yieldoracle: registry has no SuperLedgerConfiguration")
+	}
+	cfg, err := r.configuration.GetYieldSourceOracleConfig(&bind.CallOpts{Context: ctx}, id)
+	if err != nil {
+		return nil, fmt.Errorf("yieldoracle: getYieldSourceOracleConfig: %w", err)
+	}
+	if cfg.YieldSourceOracle == (common.Address{}) {
+		return nil, fmt.Errorf("%w: %x", ErrNoConfig, id)
+	}
+	return r.Oracle(cfg.YieldSourceOracle)
+}
+
+// caller is the read surface every generated oracle binding shares.
+type caller interface {
+	SUPERLEDGERCONFIGURATION(opts *bind.CallOpts) (common.Address, error)
+	Decimals(opts *bind.CallOpts, yieldSourceAddress common.Address) (uint8, error)
+	GetAssetOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, sharesIn *big.Int) (*big.Int, error)
+	GetAssetOutputWithFees(opts *bind.CallOpts, yieldSourceOracleId [32]byte, yieldSourceAddress common.Address, assetOut common.Address, user common.Address, usedShares *big.Int) (*big.Int, error)
+	GetBalanceOfOwner(opts *bind.CallOpts, yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error)
+	GetPricePerShare(opts *bind.CallOpts, yieldSourceAddress common.Address) (*big.Int, error)
+	GetShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error)
+	GetTVL(opts *bind.CallOpts, yieldSourceAddress common.Address) (*big.Int, error)
+	GetTVLByOwnerOfShares(opts *bind.CallOpts, yieldSourceAddress common.Address, ownerOfShares common.Address) (*big.Int, error)
+	GetWithdrawalShareOutput(opts *bind.CallOpts, yieldSourceAddress common.Address, arg1 common.Address, assetsIn *big.Int) (*big.Int, error)
+}
+
+// Oracle adapts a generated binding to YieldSourceOracle. It is the
+// implementation for every built-in kind without extras.
+type Oracle struct {
+	kind string
+	addr common.Address
+	c    caller
+}
+
+func (o *Oracle) Address() common.Address { return o.addr }
+func (o *Oracle) Kind() string            { return o.kind }
+
+func (o *Oracle) Decimals(opts *bind.CallOpts, yieldSource common.Address) (uint8, error) {
+	return o.c.Decimals(opts, yieldSource)
+}
+
+func (o *Oracle) PricePerShare(opts *bind.CallOpts, yieldSource common.Address) (*big.Int, error) {
+	return o.c.GetPricePerShare(opts, yieldSource)
+}
+
+func (o *Oracle) ShareOutput(opts *bind.CallOpts, yieldSource, assetIn common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return o.c.GetShareOutput(opts, yieldSource, assetIn, assetsIn)
+}
+
+func (o *Oracle) WithdrawalShareOutput(opts *bind.CallOpts, yieldSource, assetIn common.Address, assetsIn *big.Int) (*big.Int, error) {
+	return o.c.GetWithdrawalShareOutput(opts, yieldSource, assetIn, assetsIn)
+}
+
+func (o *Oracle) AssetOutput(opts *bind.CallOpts, yieldSource, assetOut common.Address, sharesIn *big.Int) (*big.Int, error) {
+	return o.c.GetAssetOutput(opts, yieldSource, assetOut, sharesIn)
+}
+
+func (o *Oracle) AssetOutputWithFees(opts *bind.CallOpts, id [32]byte, yieldSource, assetOut, user common.Address, usedShares *big.Int) (*big.Int, error) {
+	return o.c.GetAssetOutputWithFees(opts, id, yieldSource, assetOut, user, usedShares)
+}
+
+func (o *Oracle) BalanceOfOwner(opts *bind.CallOpts, yieldSource, owner common.Address) (*big.Int, error) {
+	return o.c.GetBalanceOfOwner(opts, yieldSource, owner)
+}
+
+func (o *Oracle) TVL(opts *bind.CallOpts, yieldSource common.Address) (*big.Int, error) {
+	return o.c.GetTVL(opts, yieldSource)
+}
+
+func (o *Oracle) TVLByOwnerOfShares(opts *bind.CallOpts, yieldSource, owner common.Address) (*big.Int, error) {
+	return o.c.GetTVLByOwnerOfShares(opts, yieldSource, owner)
+}
+
+func (o *Oracle) LedgerConfiguration(opts *bind.CallOpts) (common.Address, error) {
+	return o.c.SUPERLEDGERCONFIGURATION(opts)
+}
+
+// PendlePT is a PendlePTYieldSourceOracle, whose prices are TWAPs over the
+// market's PT-to-asset rate.
+type PendlePT struct {
+	Oracle
+	binding *PendlePTYieldSourceOracle.PendlePTYieldSourceOracleCaller
+}
+
+// TWAPDuration returns the TWAP window, in seconds, used for PT prices.
+func (p *PendlePT) TWAPDuration(opts *bind.CallOpts) (uint32, error) {
+	return p.binding.TWAPDURATION(opts)
+}
+
+// Kind names of the built-in oracles.
+const (
+	KindERC4626   = "ERC4626YieldSourceOracle"
+	KindERC5115   = "ERC5115YieldSourceOracle"
+	KindERC7540   = "ERC7540YieldSourceOracle"
+	KindPendlePT  = "PendlePTYieldSourceOracle"
+	KindSpectraPT = "SpectraPTYieldSourceOracle"
+	KindStaking   = "StakingYieldSourceOracle"
+)
+
+// plain returns a Factory for a kind without extras.
+func plain[T caller](kind string, newFn func(common.Address, bind.ContractCaller) (T, error)) Factory {
+	return func(addr common.Address, c bind.ContractCaller) (YieldSourceOracle, error) {
+		b, err := newFn(addr, c)
+		if err != nil {
+			return nil, err
+		}
+		return &Oracle{kind: kind, addr: addr, c: b}, nil
+	}
+}
+
+func init() {
+	RegisterKind(KindERC4626, plain(KindERC4626, ERC4626YieldSourceOracle.NewERC4626YieldSourceOracleCaller))
+	RegisterKind(KindERC5115, plain(KindERC5115, ERC5115YieldSourceOracle.NewERC5115YieldSourceOracleCaller))
+	RegisterKind(KindERC7540, plain(KindERC7540, ERC7540YieldSourceOracle.NewERC7540YieldSourceOracleCaller))
+	RegisterKind(KindSpectraPT, plain(KindSpectraPT, SpectraPTYieldSourceOracle.NewSpectraPTYieldSourceOracleCaller))
+	RegisterKind(KindStaking, plain(KindStaking, StakingYieldSourceOracle.NewStakingYieldSourceOracleCaller))
+	RegisterKind(KindPendlePT, func(addr common.Address, c bind.ContractCaller) (YieldSourceOracle, error) {
+		b, err := PendlePTYieldSourceOracle.NewPendlePTYieldSourceOracleCaller(addr, c)
+		if err != nil {
+			return nil, err
+		}
+		return &PendlePT{Oracle: Oracle{kind: KindPendlePT, addr: addr, c: b}, binding: b}, nil
+	})
+}
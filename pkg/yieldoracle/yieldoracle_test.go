@@ -0,0 +1,78 @@
+package yieldoracle
+
+import (
+	"context"
+	"errors"
+	"math/big"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/superform-xyz/v2-core/contract_bindings/SuperLedgerConfiguration"
+	"github.com/superform-xyz/v2-core/pkg/addressbook"
+	"github.com/superform-xyz/v2-core/pkg/harness"
+	"github.com/superform-xyz/v2-core/pkg/oracleid"
+)
+
+func TestRegistry(t *testing.T) {
+	stack, err := harness.New()
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer stack.Close()
+	sim, client, chainID, auth, sender := stack.Backend, stack.Client, stack.ChainID, stack.Auth, stack.Auth.From
+	cfg, cfgAddr := stack.LedgerConfiguration, stack.Addresses["SuperLedgerConfiguration"]
+	pendle, staking := stack.Addresses[KindPendlePT], stack.Addresses[KindStaking]
+
+	salt := [32]byte{1}
+	if _, err := cfg.SetYieldSourceOracles(auth, [][32]byte{salt}, []SuperLedgerConfiguration.ISuperLedgerConfigurationYieldSourceOracleConfigArgs{{
+		YieldSourceOracle: pendle, FeePercent: big.NewInt(100), FeeRecipient: common.Address{0xc1}, Ledger: common.Address{0xd1},
+	}}); err != nil {
+		t.Fatal(err)
+	}
+	sim.Commit()
+
+	chain := &addressbook.Chain{Name: "Sim", ChainID: chainID.Uint64(), Contracts: map[string]common.Address{
+		"SuperLedgerConfiguration":  cfgAddr,
+		"PendlePTYieldSourceOracle": pendle,
+		"StakingYieldSourceOracle":  staking,
+		"SuperExecutor":             {0xee},
+	}}
+	r, err := FromChain(chain, client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := r.Oracle(common.Address{0xee}); !errors.Is(err, ErrUnknownOracle) {
+		t.Fatalf("non-oracle contract resolved: %v", err)
+	}
+
+	id := oracleid.DeriveID(salt, sender)
+	o, err := r.ForID(context.Background(), id)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p, ok := o.(*PendlePT)
+	if !ok || o.Kind() != KindPendlePT || o.Address() != pendle {
+		t.Fatalf("ForID = %T %s", o, o.Kind())
+	}
+	if d, err := p.TWAPDuration(nil); err != nil || d != 900 {
+		t.Fatalf("TWAPDuration = %d, %v", d, err)
+	}
+	if _, err := r.ForID(context.Background(), [32]byte{0xff}); !errors.Is(err, ErrNoConfig) {
+		t.Fatalf("unset id: %v", err)
+	}
+
+	s, err := r.Oracle(staking)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, ok := s.(*PendlePT); ok || s.Kind() != KindStaking {
+		t.Fatalf("staking oracle = %T %s", s, s.Kind())
+	}
+	if got, err := s.LedgerConfiguration(nil); err != nil || got != cfgAddr {
+		t.Fatalf("LedgerConfiguration = %s, %v", got.Hex(), err)
+	}
+	if shares, err := s.WithdrawalShareOutput(nil, common.Address{0x51}, common.Address{0xa5}, big.NewInt(123)); err != nil || shares.Int64() != 123 {
+		t.Fatalf("WithdrawalShareOutput = %v, %v", shares, err)
+	}
+}
@@ -1,43 +1,132 @@
 #!/usr/bin/env bash
 
+# Usage:
+#   ./script/run/generate-contract-bindings.sh           # from ./out (run retrieve-abis.sh first)
+#   ./script/run/generate-contract-bindings.sh --locked  # from script/locked-bytecode,
+#                                                        # script/generated-bytecode,
+#                                                        # script/locked-bytecode-other and
+#                                                        # script/derived-abi, no build needed
+#
+# Bindings get Deploy* functions whenever creation bytecode is available.
+# script/derived-abi holds ABI-only artifacts written from the sources for
+# hooks with no locked bytecode and for the test mocks pkg/harness deploys;
+# regenerate them from ./out when it exists, which also gives the mocks
+# their forge bytecode.
+#
+# Fails when a contract under src/hooks gets no binding.
+
 set -e
 
 # Create the base directory if it doesn't exist
 mkdir -p contract_bindings
 
-# Find all ABI files directly
-find ./out -name "*.abi" | while read abi_file; do
-  # Extract contract name and directory from the ABI file path
-  dir_path=$(dirname "$abi_file")
-  base_name=$(basename "$abi_file" .abi)
-  
-  # Extract directory name to check if it's a test file
-  dir_name=$(basename "$dir_path")
-  
-  # Convert to lowercase for comparison
-  base_name_lower=$(echo "$base_name" | tr '[:upper:]' '[:lower:]')
-  dir_name_lower=$(echo "$dir_name" | tr '[:upper:]' '[:lower:]')
+tmp_dir=$(mktemp -d)
+trap 'rm -rf "$tmp_dir"' EXIT
+touch "$tmp_dir/generated"
+
+# Returns 0 if the contract gets a binding
+want_binding() {
+  local base_name=$1
+  local dir_name=$2
+
+  if [[ "$dir_name" == *.t.sol ]]; then
+    return 1
+  fi
+
+  # Test mocks deployed by pkg/harness
+  if [[ "$base_name" == MockSuperOracle || "$base_name" == MockYieldSourceOracle ]]; then
+    return 0
+  fi
+
+  # Hooks get a binding when declared under src/hooks, which leaves out test
+  # mocks and hooks from dependencies
+  if [[ "$base_name" == *Hook ]]; then
+    [[ -n $(find src/hooks -name "${base_name}.sol" -print -quit) ]]
+    return
+  fi
 
   if [[ "$base_name" != Super* && "$base_name" != Periphery* && "$base_name" != *Oracle && "$base_name" != *Adapter ]]; then
-    continue
+    return 1
   fi
-  
-  # Only process contracts that start with Super and don't end with hook
-  if [[ "$base_name_lower" == *hook || "$dir_name" == *.t.sol ]]; then
-    continue
+
+  # Convert to lowercase for comparison
+  local base_name_lower=$(echo "$base_name" | tr '[:upper:]' '[:lower:]')
+
+  # Skip anything else ending with hook, e.g. SuperHook interfaces
+  if [[ "$base_name_lower" == *hook ]]; then
+    return 1
   fi
-  
+  return 0
+}
+
+# generate NAME ABI_FILE [BIN_FILE]
+generate() {
+  local base_name=$1
+  local abi_file=$2
+  local bin_file=$3
+
   # Create directory for this contract
   mkdir -p "contract_bindings/${base_name}"
-  
 
-    abigen --abi "$abi_file" \
-        --pkg "$base_name" \
-        --type "$base_name" \
-        --out "contract_bindings/${base_name}/${base_name}.go"
-done
+  local bin_args=()
+  # Skip empty and "0x" bytecode (interfaces, abstract contracts)
+  if [[ -n "$bin_file" && $(wc -c < "$bin_file") -gt 3 ]]; then
+    bin_args=(--bin "$bin_file")
+  fi
+
+  abigen --abi "$abi_file" "${bin_args[@]}" \
+      --pkg "$base_name" \
+      --type "$base_name" \
+      --out "contract_bindings/${base_name}/${base_name}.go"
+  echo "$base_name" >> "$tmp_dir/generated"
+}
+
+# Lists the concrete contracts declared under src/hooks
+hook_contracts() {
+  grep -rhoE '^contract [A-Za-z0-9_]+' src/hooks | awk '{print $2}' | sort -u
+}
+
+if [[ "$1" == "--locked" ]]; then
+  # Earlier directories win for contracts in several of them
+  for artifact in script/locked-bytecode/*.json script/generated-bytecode/*.json script/locked-bytecode-other/*.json script/derived-abi/*.json; do
+    base_name=$(basename "$artifact" .json)
+    if ! want_binding "$base_name" "" || [[ -f "$tmp_dir/${base_name}.abi" ]]; then
+      continue
+    fi
+    jq '.abi' "$artifact" > "$tmp_dir/${base_name}.abi"
+    jq -r '.bytecode.object // .bytecode // empty' "$artifact" > "$tmp_dir/${base_name}.bin"
+    generate "$base_name" "$tmp_dir/${base_name}.abi" "$tmp_dir/${base_name}.bin"
+  done
+else
+  # Find all ABI files directly
+  find ./out -name "*.abi" | while read abi_file; do
+    # Extract contract name and directory from the ABI file path
+    dir_path=$(dirname "$abi_file")
+    base_name=$(basename "$abi_file" .abi)
+
+    # Extract directory name to check if it's a test file
+    dir_name=$(basename "$dir_path")
+
+    if ! want_binding "$base_name" "$dir_name"; then
+      continue
+    fi
+
+    bin_file="${dir_path}/${base_name}.bin"
+    if [[ ! -f "$bin_file" ]]; then
+      bin_file=""
+    fi
+    generate "$base_name" "$abi_file" "$bin_file"
+  done
+fi
 
 # Disable debugging
 set +x
 
-echo "Contract bindings generated successfully" 
\ No newline at end of file
+missing=$(comm -23 <(hook_contracts) <(sort -u "$tmp_dir/generated"))
+if [[ -n "$missing" ]]; then
+  echo "No artifact for hooks under src/hooks:" >&2
+  echo "$missing" >&2
+  exit 1
+fi
+
+echo "Contract bindings generated successfully" 
@@ -20,6 +20,10 @@ for contract_dir in */; do
 
         # Extract the ABI from the JSON and write it to the ABI file
         jq '.abi' "$json_file" > "$abi_file"
+
+        # Extract the creation bytecode next to it; interfaces and abstract
+        # contracts have none, so they keep ABI-only bindings
+        jq -r '.bytecode.object // empty' "$json_file" > "${contract_dir}${contract_name}.bin"
       fi
     fi
   done